@@ -0,0 +1,154 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/environment (interfaces: Client)
+
+// Package azdosdkmocks is a generated GoMock package.
+package azdosdkmocks
+
+import (
+	context "context"
+	gomock "github.com/golang/mock/gomock"
+	taskagent "github.com/microsoft/azure-devops-go-api/azuredevops/taskagent"
+	environment "github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/environment"
+	reflect "reflect"
+)
+
+// MockEnvironmentClient is a mock of Client interface
+type MockEnvironmentClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockEnvironmentClientMockRecorder
+}
+
+// MockEnvironmentClientMockRecorder is the mock recorder for MockEnvironmentClient
+type MockEnvironmentClientMockRecorder struct {
+	mock *MockEnvironmentClient
+}
+
+// NewMockEnvironmentClient creates a new mock instance
+func NewMockEnvironmentClient(ctrl *gomock.Controller) *MockEnvironmentClient {
+	mock := &MockEnvironmentClient{ctrl: ctrl}
+	mock.recorder = &MockEnvironmentClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockEnvironmentClient) EXPECT() *MockEnvironmentClientMockRecorder {
+	return m.recorder
+}
+
+// AddEnvironment mocks base method
+func (m *MockEnvironmentClient) AddEnvironment(arg0 context.Context, arg1 environment.AddEnvironmentArgs) (*taskagent.EnvironmentInstance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddEnvironment", arg0, arg1)
+	ret0, _ := ret[0].(*taskagent.EnvironmentInstance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddEnvironment indicates an expected call of AddEnvironment
+func (mr *MockEnvironmentClientMockRecorder) AddEnvironment(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddEnvironment", reflect.TypeOf((*MockEnvironmentClient)(nil).AddEnvironment), arg0, arg1)
+}
+
+// AddKubernetesResource mocks base method
+func (m *MockEnvironmentClient) AddKubernetesResource(arg0 context.Context, arg1 environment.AddKubernetesResourceArgs) (*environment.KubernetesResource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddKubernetesResource", arg0, arg1)
+	ret0, _ := ret[0].(*environment.KubernetesResource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddKubernetesResource indicates an expected call of AddKubernetesResource
+func (mr *MockEnvironmentClientMockRecorder) AddKubernetesResource(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddKubernetesResource", reflect.TypeOf((*MockEnvironmentClient)(nil).AddKubernetesResource), arg0, arg1)
+}
+
+// DeleteEnvironment mocks base method
+func (m *MockEnvironmentClient) DeleteEnvironment(arg0 context.Context, arg1 environment.DeleteEnvironmentArgs) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEnvironment", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteEnvironment indicates an expected call of DeleteEnvironment
+func (mr *MockEnvironmentClientMockRecorder) DeleteEnvironment(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEnvironment", reflect.TypeOf((*MockEnvironmentClient)(nil).DeleteEnvironment), arg0, arg1)
+}
+
+// DeleteKubernetesResource mocks base method
+func (m *MockEnvironmentClient) DeleteKubernetesResource(arg0 context.Context, arg1 environment.DeleteKubernetesResourceArgs) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteKubernetesResource", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteKubernetesResource indicates an expected call of DeleteKubernetesResource
+func (mr *MockEnvironmentClientMockRecorder) DeleteKubernetesResource(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteKubernetesResource", reflect.TypeOf((*MockEnvironmentClient)(nil).DeleteKubernetesResource), arg0, arg1)
+}
+
+// GetEnvironmentById mocks base method
+func (m *MockEnvironmentClient) GetEnvironmentById(arg0 context.Context, arg1 environment.GetEnvironmentByIdArgs) (*taskagent.EnvironmentInstance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEnvironmentById", arg0, arg1)
+	ret0, _ := ret[0].(*taskagent.EnvironmentInstance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEnvironmentById indicates an expected call of GetEnvironmentById
+func (mr *MockEnvironmentClientMockRecorder) GetEnvironmentById(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEnvironmentById", reflect.TypeOf((*MockEnvironmentClient)(nil).GetEnvironmentById), arg0, arg1)
+}
+
+// GetEnvironments mocks base method
+func (m *MockEnvironmentClient) GetEnvironments(arg0 context.Context, arg1 environment.GetEnvironmentsArgs) (*[]taskagent.EnvironmentInstance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEnvironments", arg0, arg1)
+	ret0, _ := ret[0].(*[]taskagent.EnvironmentInstance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEnvironments indicates an expected call of GetEnvironments
+func (mr *MockEnvironmentClientMockRecorder) GetEnvironments(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEnvironments", reflect.TypeOf((*MockEnvironmentClient)(nil).GetEnvironments), arg0, arg1)
+}
+
+// GetKubernetesResource mocks base method
+func (m *MockEnvironmentClient) GetKubernetesResource(arg0 context.Context, arg1 environment.GetKubernetesResourceArgs) (*environment.KubernetesResource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetKubernetesResource", arg0, arg1)
+	ret0, _ := ret[0].(*environment.KubernetesResource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetKubernetesResource indicates an expected call of GetKubernetesResource
+func (mr *MockEnvironmentClientMockRecorder) GetKubernetesResource(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKubernetesResource", reflect.TypeOf((*MockEnvironmentClient)(nil).GetKubernetesResource), arg0, arg1)
+}
+
+// UpdateEnvironment mocks base method
+func (m *MockEnvironmentClient) UpdateEnvironment(arg0 context.Context, arg1 environment.UpdateEnvironmentArgs) (*taskagent.EnvironmentInstance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEnvironment", arg0, arg1)
+	ret0, _ := ret[0].(*taskagent.EnvironmentInstance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateEnvironment indicates an expected call of UpdateEnvironment
+func (mr *MockEnvironmentClientMockRecorder) UpdateEnvironment(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEnvironment", reflect.TypeOf((*MockEnvironmentClient)(nil).UpdateEnvironment), arg0, arg1)
+}
@@ -0,0 +1,109 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/microsoft/azure-devops-go-api/azuredevops/extensionmanagement (interfaces: Client)
+
+// Package azdosdkmocks is a generated GoMock package.
+package azdosdkmocks
+
+import (
+	context "context"
+	gomock "github.com/golang/mock/gomock"
+	extensionmanagement "github.com/microsoft/azure-devops-go-api/azuredevops/extensionmanagement"
+	reflect "reflect"
+)
+
+// MockExtensionManagementClient is a mock of Client interface
+type MockExtensionManagementClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockExtensionManagementClientMockRecorder
+}
+
+// MockExtensionManagementClientMockRecorder is the mock recorder for MockExtensionManagementClient
+type MockExtensionManagementClientMockRecorder struct {
+	mock *MockExtensionManagementClient
+}
+
+// NewMockExtensionManagementClient creates a new mock instance
+func NewMockExtensionManagementClient(ctrl *gomock.Controller) *MockExtensionManagementClient {
+	mock := &MockExtensionManagementClient{ctrl: ctrl}
+	mock.recorder = &MockExtensionManagementClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockExtensionManagementClient) EXPECT() *MockExtensionManagementClientMockRecorder {
+	return m.recorder
+}
+
+// GetInstalledExtensionByName mocks base method
+func (m *MockExtensionManagementClient) GetInstalledExtensionByName(arg0 context.Context, arg1 extensionmanagement.GetInstalledExtensionByNameArgs) (*extensionmanagement.InstalledExtension, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInstalledExtensionByName", arg0, arg1)
+	ret0, _ := ret[0].(*extensionmanagement.InstalledExtension)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInstalledExtensionByName indicates an expected call of GetInstalledExtensionByName
+func (mr *MockExtensionManagementClientMockRecorder) GetInstalledExtensionByName(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInstalledExtensionByName", reflect.TypeOf((*MockExtensionManagementClient)(nil).GetInstalledExtensionByName), arg0, arg1)
+}
+
+// GetInstalledExtensions mocks base method
+func (m *MockExtensionManagementClient) GetInstalledExtensions(arg0 context.Context, arg1 extensionmanagement.GetInstalledExtensionsArgs) (*[]extensionmanagement.InstalledExtension, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInstalledExtensions", arg0, arg1)
+	ret0, _ := ret[0].(*[]extensionmanagement.InstalledExtension)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInstalledExtensions indicates an expected call of GetInstalledExtensions
+func (mr *MockExtensionManagementClientMockRecorder) GetInstalledExtensions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInstalledExtensions", reflect.TypeOf((*MockExtensionManagementClient)(nil).GetInstalledExtensions), arg0, arg1)
+}
+
+// InstallExtensionByName mocks base method
+func (m *MockExtensionManagementClient) InstallExtensionByName(arg0 context.Context, arg1 extensionmanagement.InstallExtensionByNameArgs) (*extensionmanagement.InstalledExtension, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InstallExtensionByName", arg0, arg1)
+	ret0, _ := ret[0].(*extensionmanagement.InstalledExtension)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InstallExtensionByName indicates an expected call of InstallExtensionByName
+func (mr *MockExtensionManagementClientMockRecorder) InstallExtensionByName(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallExtensionByName", reflect.TypeOf((*MockExtensionManagementClient)(nil).InstallExtensionByName), arg0, arg1)
+}
+
+// UninstallExtensionByName mocks base method
+func (m *MockExtensionManagementClient) UninstallExtensionByName(arg0 context.Context, arg1 extensionmanagement.UninstallExtensionByNameArgs) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UninstallExtensionByName", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UninstallExtensionByName indicates an expected call of UninstallExtensionByName
+func (mr *MockExtensionManagementClientMockRecorder) UninstallExtensionByName(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UninstallExtensionByName", reflect.TypeOf((*MockExtensionManagementClient)(nil).UninstallExtensionByName), arg0, arg1)
+}
+
+// UpdateInstalledExtension mocks base method
+func (m *MockExtensionManagementClient) UpdateInstalledExtension(arg0 context.Context, arg1 extensionmanagement.UpdateInstalledExtensionArgs) (*extensionmanagement.InstalledExtension, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateInstalledExtension", arg0, arg1)
+	ret0, _ := ret[0].(*extensionmanagement.InstalledExtension)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateInstalledExtension indicates an expected call of UpdateInstalledExtension
+func (mr *MockExtensionManagementClientMockRecorder) UpdateInstalledExtension(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateInstalledExtension", reflect.TypeOf((*MockExtensionManagementClient)(nil).UpdateInstalledExtension), arg0, arg1)
+}
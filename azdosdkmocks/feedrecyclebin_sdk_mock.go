@@ -0,0 +1,65 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/feedrecyclebin (interfaces: Client)
+
+// Package azdosdkmocks is a generated GoMock package.
+package azdosdkmocks
+
+import (
+	context "context"
+	gomock "github.com/golang/mock/gomock"
+	feed "github.com/microsoft/azure-devops-go-api/azuredevops/feed"
+	feedrecyclebin "github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/feedrecyclebin"
+	reflect "reflect"
+)
+
+// MockFeedRecycleBinClient is a mock of Client interface
+type MockFeedRecycleBinClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockFeedRecycleBinClientMockRecorder
+}
+
+// MockFeedRecycleBinClientMockRecorder is the mock recorder for MockFeedRecycleBinClient
+type MockFeedRecycleBinClientMockRecorder struct {
+	mock *MockFeedRecycleBinClient
+}
+
+// NewMockFeedRecycleBinClient creates a new mock instance
+func NewMockFeedRecycleBinClient(ctrl *gomock.Controller) *MockFeedRecycleBinClient {
+	mock := &MockFeedRecycleBinClient{ctrl: ctrl}
+	mock.recorder = &MockFeedRecycleBinClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockFeedRecycleBinClient) EXPECT() *MockFeedRecycleBinClientMockRecorder {
+	return m.recorder
+}
+
+// GetFeedsFromRecycleBin mocks base method
+func (m *MockFeedRecycleBinClient) GetFeedsFromRecycleBin(arg0 context.Context, arg1 feedrecyclebin.GetFeedsFromRecycleBinArgs) (*[]feed.Feed, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFeedsFromRecycleBin", arg0, arg1)
+	ret0, _ := ret[0].(*[]feed.Feed)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFeedsFromRecycleBin indicates an expected call of GetFeedsFromRecycleBin
+func (mr *MockFeedRecycleBinClientMockRecorder) GetFeedsFromRecycleBin(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeedsFromRecycleBin", reflect.TypeOf((*MockFeedRecycleBinClient)(nil).GetFeedsFromRecycleBin), arg0, arg1)
+}
+
+// PermanentDeleteFeed mocks base method
+func (m *MockFeedRecycleBinClient) PermanentDeleteFeed(arg0 context.Context, arg1 feedrecyclebin.PermanentDeleteFeedArgs) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PermanentDeleteFeed", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PermanentDeleteFeed indicates an expected call of PermanentDeleteFeed
+func (mr *MockFeedRecycleBinClientMockRecorder) PermanentDeleteFeed(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PermanentDeleteFeed", reflect.TypeOf((*MockFeedRecycleBinClient)(nil).PermanentDeleteFeed), arg0, arg1)
+}
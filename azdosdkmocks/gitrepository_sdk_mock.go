@@ -0,0 +1,80 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/gitrepository (interfaces: Client)
+
+// Package azdosdkmocks is a generated GoMock package.
+package azdosdkmocks
+
+import (
+	context "context"
+	gomock "github.com/golang/mock/gomock"
+	gitrepository "github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/gitrepository"
+	reflect "reflect"
+)
+
+// MockGitRepositoryClient is a mock of Client interface
+type MockGitRepositoryClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockGitRepositoryClientMockRecorder
+}
+
+// MockGitRepositoryClientMockRecorder is the mock recorder for MockGitRepositoryClient
+type MockGitRepositoryClientMockRecorder struct {
+	mock *MockGitRepositoryClient
+}
+
+// NewMockGitRepositoryClient creates a new mock instance
+func NewMockGitRepositoryClient(ctrl *gomock.Controller) *MockGitRepositoryClient {
+	mock := &MockGitRepositoryClient{ctrl: ctrl}
+	mock.recorder = &MockGitRepositoryClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockGitRepositoryClient) EXPECT() *MockGitRepositoryClientMockRecorder {
+	return m.recorder
+}
+
+// GetRepositoryState mocks base method
+func (m *MockGitRepositoryClient) GetRepositoryState(arg0 context.Context, arg1 gitrepository.GetRepositoryStateArgs) (*gitrepository.RepositoryState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRepositoryState", arg0, arg1)
+	ret0, _ := ret[0].(*gitrepository.RepositoryState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRepositoryState indicates an expected call of GetRepositoryState
+func (mr *MockGitRepositoryClientMockRecorder) GetRepositoryState(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRepositoryState", reflect.TypeOf((*MockGitRepositoryClient)(nil).GetRepositoryState), arg0, arg1)
+}
+
+// GetRepositoryStates mocks base method
+func (m *MockGitRepositoryClient) GetRepositoryStates(arg0 context.Context, arg1 gitrepository.GetRepositoryStatesArgs) (*[]gitrepository.RepositoryState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRepositoryStates", arg0, arg1)
+	ret0, _ := ret[0].(*[]gitrepository.RepositoryState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRepositoryStates indicates an expected call of GetRepositoryStates
+func (mr *MockGitRepositoryClientMockRecorder) GetRepositoryStates(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRepositoryStates", reflect.TypeOf((*MockGitRepositoryClient)(nil).GetRepositoryStates), arg0, arg1)
+}
+
+// SetRepositoryDisabled mocks base method
+func (m *MockGitRepositoryClient) SetRepositoryDisabled(arg0 context.Context, arg1 gitrepository.SetRepositoryDisabledArgs) (*gitrepository.RepositoryState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRepositoryDisabled", arg0, arg1)
+	ret0, _ := ret[0].(*gitrepository.RepositoryState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetRepositoryDisabled indicates an expected call of SetRepositoryDisabled
+func (mr *MockGitRepositoryClientMockRecorder) SetRepositoryDisabled(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRepositoryDisabled", reflect.TypeOf((*MockGitRepositoryClient)(nil).SetRepositoryDisabled), arg0, arg1)
+}
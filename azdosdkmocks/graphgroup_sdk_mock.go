@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/graphgroup (interfaces: Client)
+
+// Package azdosdkmocks is a generated GoMock package.
+package azdosdkmocks
+
+import (
+	context "context"
+	gomock "github.com/golang/mock/gomock"
+	graph "github.com/microsoft/azure-devops-go-api/azuredevops/graph"
+	graphgroup "github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/graphgroup"
+	reflect "reflect"
+)
+
+// MockGraphgroupClient is a mock of Client interface
+type MockGraphgroupClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockGraphgroupClientMockRecorder
+}
+
+// MockGraphgroupClientMockRecorder is the mock recorder for MockGraphgroupClient
+type MockGraphgroupClientMockRecorder struct {
+	mock *MockGraphgroupClient
+}
+
+// NewMockGraphgroupClient creates a new mock instance
+func NewMockGraphgroupClient(ctrl *gomock.Controller) *MockGraphgroupClient {
+	mock := &MockGraphgroupClient{ctrl: ctrl}
+	mock.recorder = &MockGraphgroupClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockGraphgroupClient) EXPECT() *MockGraphgroupClientMockRecorder {
+	return m.recorder
+}
+
+// CreateGroup mocks base method
+func (m *MockGraphgroupClient) CreateGroup(arg0 context.Context, arg1 graphgroup.CreateGroupArgs) (*graph.GraphGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateGroup", arg0, arg1)
+	ret0, _ := ret[0].(*graph.GraphGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateGroup indicates an expected call of CreateGroup
+func (mr *MockGraphgroupClientMockRecorder) CreateGroup(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGroup", reflect.TypeOf((*MockGraphgroupClient)(nil).CreateGroup), arg0, arg1)
+}
@@ -0,0 +1,257 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/microsoft/azure-devops-go-api/azuredevops/memberentitlementmanagement (interfaces: Client)
+
+// Package azdosdkmocks is a generated GoMock package.
+package azdosdkmocks
+
+import (
+	context "context"
+	gomock "github.com/golang/mock/gomock"
+	memberentitlementmanagement "github.com/microsoft/azure-devops-go-api/azuredevops/memberentitlementmanagement"
+	reflect "reflect"
+)
+
+// MockMemberEntitlementManagementClient is a mock of Client interface
+type MockMemberEntitlementManagementClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockMemberEntitlementManagementClientMockRecorder
+}
+
+// MockMemberEntitlementManagementClientMockRecorder is the mock recorder for MockMemberEntitlementManagementClient
+type MockMemberEntitlementManagementClientMockRecorder struct {
+	mock *MockMemberEntitlementManagementClient
+}
+
+// NewMockMemberEntitlementManagementClient creates a new mock instance
+func NewMockMemberEntitlementManagementClient(ctrl *gomock.Controller) *MockMemberEntitlementManagementClient {
+	mock := &MockMemberEntitlementManagementClient{ctrl: ctrl}
+	mock.recorder = &MockMemberEntitlementManagementClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockMemberEntitlementManagementClient) EXPECT() *MockMemberEntitlementManagementClientMockRecorder {
+	return m.recorder
+}
+
+// AddGroupEntitlement mocks base method
+func (m *MockMemberEntitlementManagementClient) AddGroupEntitlement(arg0 context.Context, arg1 memberentitlementmanagement.AddGroupEntitlementArgs) (*memberentitlementmanagement.GroupEntitlementOperationReference, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddGroupEntitlement", arg0, arg1)
+	ret0, _ := ret[0].(*memberentitlementmanagement.GroupEntitlementOperationReference)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddGroupEntitlement indicates an expected call of AddGroupEntitlement
+func (mr *MockMemberEntitlementManagementClientMockRecorder) AddGroupEntitlement(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddGroupEntitlement", reflect.TypeOf((*MockMemberEntitlementManagementClient)(nil).AddGroupEntitlement), arg0, arg1)
+}
+
+// AddMemberToGroup mocks base method
+func (m *MockMemberEntitlementManagementClient) AddMemberToGroup(arg0 context.Context, arg1 memberentitlementmanagement.AddMemberToGroupArgs) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddMemberToGroup", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddMemberToGroup indicates an expected call of AddMemberToGroup
+func (mr *MockMemberEntitlementManagementClientMockRecorder) AddMemberToGroup(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddMemberToGroup", reflect.TypeOf((*MockMemberEntitlementManagementClient)(nil).AddMemberToGroup), arg0, arg1)
+}
+
+// AddUserEntitlement mocks base method
+func (m *MockMemberEntitlementManagementClient) AddUserEntitlement(arg0 context.Context, arg1 memberentitlementmanagement.AddUserEntitlementArgs) (*memberentitlementmanagement.UserEntitlementsPostResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddUserEntitlement", arg0, arg1)
+	ret0, _ := ret[0].(*memberentitlementmanagement.UserEntitlementsPostResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddUserEntitlement indicates an expected call of AddUserEntitlement
+func (mr *MockMemberEntitlementManagementClientMockRecorder) AddUserEntitlement(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddUserEntitlement", reflect.TypeOf((*MockMemberEntitlementManagementClient)(nil).AddUserEntitlement), arg0, arg1)
+}
+
+// DeleteGroupEntitlement mocks base method
+func (m *MockMemberEntitlementManagementClient) DeleteGroupEntitlement(arg0 context.Context, arg1 memberentitlementmanagement.DeleteGroupEntitlementArgs) (*memberentitlementmanagement.GroupEntitlementOperationReference, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteGroupEntitlement", arg0, arg1)
+	ret0, _ := ret[0].(*memberentitlementmanagement.GroupEntitlementOperationReference)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteGroupEntitlement indicates an expected call of DeleteGroupEntitlement
+func (mr *MockMemberEntitlementManagementClientMockRecorder) DeleteGroupEntitlement(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGroupEntitlement", reflect.TypeOf((*MockMemberEntitlementManagementClient)(nil).DeleteGroupEntitlement), arg0, arg1)
+}
+
+// DeleteUserEntitlement mocks base method
+func (m *MockMemberEntitlementManagementClient) DeleteUserEntitlement(arg0 context.Context, arg1 memberentitlementmanagement.DeleteUserEntitlementArgs) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUserEntitlement", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUserEntitlement indicates an expected call of DeleteUserEntitlement
+func (mr *MockMemberEntitlementManagementClientMockRecorder) DeleteUserEntitlement(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserEntitlement", reflect.TypeOf((*MockMemberEntitlementManagementClient)(nil).DeleteUserEntitlement), arg0, arg1)
+}
+
+// GetGroupEntitlement mocks base method
+func (m *MockMemberEntitlementManagementClient) GetGroupEntitlement(arg0 context.Context, arg1 memberentitlementmanagement.GetGroupEntitlementArgs) (*memberentitlementmanagement.GroupEntitlement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroupEntitlement", arg0, arg1)
+	ret0, _ := ret[0].(*memberentitlementmanagement.GroupEntitlement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGroupEntitlement indicates an expected call of GetGroupEntitlement
+func (mr *MockMemberEntitlementManagementClientMockRecorder) GetGroupEntitlement(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupEntitlement", reflect.TypeOf((*MockMemberEntitlementManagementClient)(nil).GetGroupEntitlement), arg0, arg1)
+}
+
+// GetGroupEntitlements mocks base method
+func (m *MockMemberEntitlementManagementClient) GetGroupEntitlements(arg0 context.Context, arg1 memberentitlementmanagement.GetGroupEntitlementsArgs) (*[]memberentitlementmanagement.GroupEntitlement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroupEntitlements", arg0, arg1)
+	ret0, _ := ret[0].(*[]memberentitlementmanagement.GroupEntitlement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGroupEntitlements indicates an expected call of GetGroupEntitlements
+func (mr *MockMemberEntitlementManagementClientMockRecorder) GetGroupEntitlements(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupEntitlements", reflect.TypeOf((*MockMemberEntitlementManagementClient)(nil).GetGroupEntitlements), arg0, arg1)
+}
+
+// GetGroupMembers mocks base method
+func (m *MockMemberEntitlementManagementClient) GetGroupMembers(arg0 context.Context, arg1 memberentitlementmanagement.GetGroupMembersArgs) (*memberentitlementmanagement.PagedGraphMemberList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroupMembers", arg0, arg1)
+	ret0, _ := ret[0].(*memberentitlementmanagement.PagedGraphMemberList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGroupMembers indicates an expected call of GetGroupMembers
+func (mr *MockMemberEntitlementManagementClientMockRecorder) GetGroupMembers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupMembers", reflect.TypeOf((*MockMemberEntitlementManagementClient)(nil).GetGroupMembers), arg0, arg1)
+}
+
+// GetUserEntitlement mocks base method
+func (m *MockMemberEntitlementManagementClient) GetUserEntitlement(arg0 context.Context, arg1 memberentitlementmanagement.GetUserEntitlementArgs) (*memberentitlementmanagement.UserEntitlement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserEntitlement", arg0, arg1)
+	ret0, _ := ret[0].(*memberentitlementmanagement.UserEntitlement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserEntitlement indicates an expected call of GetUserEntitlement
+func (mr *MockMemberEntitlementManagementClientMockRecorder) GetUserEntitlement(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserEntitlement", reflect.TypeOf((*MockMemberEntitlementManagementClient)(nil).GetUserEntitlement), arg0, arg1)
+}
+
+// GetUserEntitlements mocks base method
+func (m *MockMemberEntitlementManagementClient) GetUserEntitlements(arg0 context.Context, arg1 memberentitlementmanagement.GetUserEntitlementsArgs) (*memberentitlementmanagement.PagedGraphMemberList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserEntitlements", arg0, arg1)
+	ret0, _ := ret[0].(*memberentitlementmanagement.PagedGraphMemberList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserEntitlements indicates an expected call of GetUserEntitlements
+func (mr *MockMemberEntitlementManagementClientMockRecorder) GetUserEntitlements(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserEntitlements", reflect.TypeOf((*MockMemberEntitlementManagementClient)(nil).GetUserEntitlements), arg0, arg1)
+}
+
+// GetUsersSummary mocks base method
+func (m *MockMemberEntitlementManagementClient) GetUsersSummary(arg0 context.Context, arg1 memberentitlementmanagement.GetUsersSummaryArgs) (*memberentitlementmanagement.UsersSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUsersSummary", arg0, arg1)
+	ret0, _ := ret[0].(*memberentitlementmanagement.UsersSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUsersSummary indicates an expected call of GetUsersSummary
+func (mr *MockMemberEntitlementManagementClientMockRecorder) GetUsersSummary(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsersSummary", reflect.TypeOf((*MockMemberEntitlementManagementClient)(nil).GetUsersSummary), arg0, arg1)
+}
+
+// RemoveMemberFromGroup mocks base method
+func (m *MockMemberEntitlementManagementClient) RemoveMemberFromGroup(arg0 context.Context, arg1 memberentitlementmanagement.RemoveMemberFromGroupArgs) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveMemberFromGroup", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveMemberFromGroup indicates an expected call of RemoveMemberFromGroup
+func (mr *MockMemberEntitlementManagementClientMockRecorder) RemoveMemberFromGroup(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveMemberFromGroup", reflect.TypeOf((*MockMemberEntitlementManagementClient)(nil).RemoveMemberFromGroup), arg0, arg1)
+}
+
+// UpdateGroupEntitlement mocks base method
+func (m *MockMemberEntitlementManagementClient) UpdateGroupEntitlement(arg0 context.Context, arg1 memberentitlementmanagement.UpdateGroupEntitlementArgs) (*memberentitlementmanagement.GroupEntitlementOperationReference, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateGroupEntitlement", arg0, arg1)
+	ret0, _ := ret[0].(*memberentitlementmanagement.GroupEntitlementOperationReference)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateGroupEntitlement indicates an expected call of UpdateGroupEntitlement
+func (mr *MockMemberEntitlementManagementClientMockRecorder) UpdateGroupEntitlement(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGroupEntitlement", reflect.TypeOf((*MockMemberEntitlementManagementClient)(nil).UpdateGroupEntitlement), arg0, arg1)
+}
+
+// UpdateUserEntitlement mocks base method
+func (m *MockMemberEntitlementManagementClient) UpdateUserEntitlement(arg0 context.Context, arg1 memberentitlementmanagement.UpdateUserEntitlementArgs) (*memberentitlementmanagement.UserEntitlementsPatchResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserEntitlement", arg0, arg1)
+	ret0, _ := ret[0].(*memberentitlementmanagement.UserEntitlementsPatchResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUserEntitlement indicates an expected call of UpdateUserEntitlement
+func (mr *MockMemberEntitlementManagementClientMockRecorder) UpdateUserEntitlement(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserEntitlement", reflect.TypeOf((*MockMemberEntitlementManagementClient)(nil).UpdateUserEntitlement), arg0, arg1)
+}
+
+// UpdateUserEntitlements mocks base method
+func (m *MockMemberEntitlementManagementClient) UpdateUserEntitlements(arg0 context.Context, arg1 memberentitlementmanagement.UpdateUserEntitlementsArgs) (*memberentitlementmanagement.UserEntitlementOperationReference, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserEntitlements", arg0, arg1)
+	ret0, _ := ret[0].(*memberentitlementmanagement.UserEntitlementOperationReference)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUserEntitlements indicates an expected call of UpdateUserEntitlements
+func (mr *MockMemberEntitlementManagementClientMockRecorder) UpdateUserEntitlements(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserEntitlements", reflect.TypeOf((*MockMemberEntitlementManagementClient)(nil).UpdateUserEntitlements), arg0, arg1)
+}
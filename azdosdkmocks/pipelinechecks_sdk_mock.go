@@ -0,0 +1,94 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/pipelinechecks (interfaces: Client)
+
+// Package azdosdkmocks is a generated GoMock package.
+package azdosdkmocks
+
+import (
+	context "context"
+	gomock "github.com/golang/mock/gomock"
+	pipelinechecks "github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/pipelinechecks"
+	reflect "reflect"
+)
+
+// MockPipelineChecksClient is a mock of Client interface
+type MockPipelineChecksClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockPipelineChecksClientMockRecorder
+}
+
+// MockPipelineChecksClientMockRecorder is the mock recorder for MockPipelineChecksClient
+type MockPipelineChecksClientMockRecorder struct {
+	mock *MockPipelineChecksClient
+}
+
+// NewMockPipelineChecksClient creates a new mock instance
+func NewMockPipelineChecksClient(ctrl *gomock.Controller) *MockPipelineChecksClient {
+	mock := &MockPipelineChecksClient{ctrl: ctrl}
+	mock.recorder = &MockPipelineChecksClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockPipelineChecksClient) EXPECT() *MockPipelineChecksClientMockRecorder {
+	return m.recorder
+}
+
+// AddCheckConfiguration mocks base method
+func (m *MockPipelineChecksClient) AddCheckConfiguration(arg0 context.Context, arg1 pipelinechecks.AddCheckConfigurationArgs) (*pipelinechecks.CheckConfiguration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddCheckConfiguration", arg0, arg1)
+	ret0, _ := ret[0].(*pipelinechecks.CheckConfiguration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddCheckConfiguration indicates an expected call of AddCheckConfiguration
+func (mr *MockPipelineChecksClientMockRecorder) AddCheckConfiguration(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCheckConfiguration", reflect.TypeOf((*MockPipelineChecksClient)(nil).AddCheckConfiguration), arg0, arg1)
+}
+
+// DeleteCheckConfiguration mocks base method
+func (m *MockPipelineChecksClient) DeleteCheckConfiguration(arg0 context.Context, arg1 pipelinechecks.DeleteCheckConfigurationArgs) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCheckConfiguration", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCheckConfiguration indicates an expected call of DeleteCheckConfiguration
+func (mr *MockPipelineChecksClientMockRecorder) DeleteCheckConfiguration(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCheckConfiguration", reflect.TypeOf((*MockPipelineChecksClient)(nil).DeleteCheckConfiguration), arg0, arg1)
+}
+
+// GetCheckConfiguration mocks base method
+func (m *MockPipelineChecksClient) GetCheckConfiguration(arg0 context.Context, arg1 pipelinechecks.GetCheckConfigurationArgs) (*pipelinechecks.CheckConfiguration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCheckConfiguration", arg0, arg1)
+	ret0, _ := ret[0].(*pipelinechecks.CheckConfiguration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCheckConfiguration indicates an expected call of GetCheckConfiguration
+func (mr *MockPipelineChecksClientMockRecorder) GetCheckConfiguration(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCheckConfiguration", reflect.TypeOf((*MockPipelineChecksClient)(nil).GetCheckConfiguration), arg0, arg1)
+}
+
+// UpdateCheckConfiguration mocks base method
+func (m *MockPipelineChecksClient) UpdateCheckConfiguration(arg0 context.Context, arg1 pipelinechecks.UpdateCheckConfigurationArgs) (*pipelinechecks.CheckConfiguration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCheckConfiguration", arg0, arg1)
+	ret0, _ := ret[0].(*pipelinechecks.CheckConfiguration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCheckConfiguration indicates an expected call of UpdateCheckConfiguration
+func (mr *MockPipelineChecksClientMockRecorder) UpdateCheckConfiguration(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCheckConfiguration", reflect.TypeOf((*MockPipelineChecksClient)(nil).UpdateCheckConfiguration), arg0, arg1)
+}
@@ -0,0 +1,65 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/pipelinesettings (interfaces: Client)
+
+// Package azdosdkmocks is a generated GoMock package.
+package azdosdkmocks
+
+import (
+	context "context"
+	gomock "github.com/golang/mock/gomock"
+	pipelinesettings "github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/pipelinesettings"
+	reflect "reflect"
+)
+
+// MockPipelineSettingsClient is a mock of Client interface
+type MockPipelineSettingsClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockPipelineSettingsClientMockRecorder
+}
+
+// MockPipelineSettingsClientMockRecorder is the mock recorder for MockPipelineSettingsClient
+type MockPipelineSettingsClientMockRecorder struct {
+	mock *MockPipelineSettingsClient
+}
+
+// NewMockPipelineSettingsClient creates a new mock instance
+func NewMockPipelineSettingsClient(ctrl *gomock.Controller) *MockPipelineSettingsClient {
+	mock := &MockPipelineSettingsClient{ctrl: ctrl}
+	mock.recorder = &MockPipelineSettingsClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockPipelineSettingsClient) EXPECT() *MockPipelineSettingsClientMockRecorder {
+	return m.recorder
+}
+
+// GetGeneralSettings mocks base method
+func (m *MockPipelineSettingsClient) GetGeneralSettings(arg0 context.Context, arg1 pipelinesettings.GetGeneralSettingsArgs) (*pipelinesettings.GeneralSettings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGeneralSettings", arg0, arg1)
+	ret0, _ := ret[0].(*pipelinesettings.GeneralSettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGeneralSettings indicates an expected call of GetGeneralSettings
+func (mr *MockPipelineSettingsClientMockRecorder) GetGeneralSettings(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGeneralSettings", reflect.TypeOf((*MockPipelineSettingsClient)(nil).GetGeneralSettings), arg0, arg1)
+}
+
+// UpdateGeneralSettings mocks base method
+func (m *MockPipelineSettingsClient) UpdateGeneralSettings(arg0 context.Context, arg1 pipelinesettings.UpdateGeneralSettingsArgs) (*pipelinesettings.GeneralSettings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateGeneralSettings", arg0, arg1)
+	ret0, _ := ret[0].(*pipelinesettings.GeneralSettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateGeneralSettings indicates an expected call of UpdateGeneralSettings
+func (mr *MockPipelineSettingsClientMockRecorder) UpdateGeneralSettings(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGeneralSettings", reflect.TypeOf((*MockPipelineSettingsClient)(nil).UpdateGeneralSettings), arg0, arg1)
+}
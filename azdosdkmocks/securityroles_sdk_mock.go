@@ -0,0 +1,79 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/securityroles (interfaces: Client)
+
+// Package azdosdkmocks is a generated GoMock package.
+package azdosdkmocks
+
+import (
+	context "context"
+	gomock "github.com/golang/mock/gomock"
+	securityroles "github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/securityroles"
+	reflect "reflect"
+)
+
+// MockSecurityRolesClient is a mock of Client interface
+type MockSecurityRolesClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockSecurityRolesClientMockRecorder
+}
+
+// MockSecurityRolesClientMockRecorder is the mock recorder for MockSecurityRolesClient
+type MockSecurityRolesClientMockRecorder struct {
+	mock *MockSecurityRolesClient
+}
+
+// NewMockSecurityRolesClient creates a new mock instance
+func NewMockSecurityRolesClient(ctrl *gomock.Controller) *MockSecurityRolesClient {
+	mock := &MockSecurityRolesClient{ctrl: ctrl}
+	mock.recorder = &MockSecurityRolesClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockSecurityRolesClient) EXPECT() *MockSecurityRolesClientMockRecorder {
+	return m.recorder
+}
+
+// ListRoleAssignments mocks base method
+func (m *MockSecurityRolesClient) ListRoleAssignments(arg0 context.Context, arg1 securityroles.ListRoleAssignmentsArgs) (*[]securityroles.RoleAssignment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRoleAssignments", arg0, arg1)
+	ret0, _ := ret[0].(*[]securityroles.RoleAssignment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRoleAssignments indicates an expected call of ListRoleAssignments
+func (mr *MockSecurityRolesClientMockRecorder) ListRoleAssignments(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoleAssignments", reflect.TypeOf((*MockSecurityRolesClient)(nil).ListRoleAssignments), arg0, arg1)
+}
+
+// RemoveRoleAssignments mocks base method
+func (m *MockSecurityRolesClient) RemoveRoleAssignments(arg0 context.Context, arg1 securityroles.RemoveRoleAssignmentsArgs) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveRoleAssignments", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveRoleAssignments indicates an expected call of RemoveRoleAssignments
+func (mr *MockSecurityRolesClientMockRecorder) RemoveRoleAssignments(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveRoleAssignments", reflect.TypeOf((*MockSecurityRolesClient)(nil).RemoveRoleAssignments), arg0, arg1)
+}
+
+// SetRoleAssignments mocks base method
+func (m *MockSecurityRolesClient) SetRoleAssignments(arg0 context.Context, arg1 securityroles.SetRoleAssignmentsArgs) (*[]securityroles.RoleAssignment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRoleAssignments", arg0, arg1)
+	ret0, _ := ret[0].(*[]securityroles.RoleAssignment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetRoleAssignments indicates an expected call of SetRoleAssignments
+func (mr *MockSecurityRolesClientMockRecorder) SetRoleAssignments(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRoleAssignments", reflect.TypeOf((*MockSecurityRolesClient)(nil).SetRoleAssignments), arg0, arg1)
+}
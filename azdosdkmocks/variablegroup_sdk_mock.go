@@ -0,0 +1,80 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/variablegroup (interfaces: Client)
+
+// Package azdosdkmocks is a generated GoMock package.
+package azdosdkmocks
+
+import (
+	context "context"
+	gomock "github.com/golang/mock/gomock"
+	variablegroup "github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/variablegroup"
+	reflect "reflect"
+)
+
+// MockVariablegroupClient is a mock of Client interface
+type MockVariablegroupClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockVariablegroupClientMockRecorder
+}
+
+// MockVariablegroupClientMockRecorder is the mock recorder for MockVariablegroupClient
+type MockVariablegroupClientMockRecorder struct {
+	mock *MockVariablegroupClient
+}
+
+// NewMockVariablegroupClient creates a new mock instance
+func NewMockVariablegroupClient(ctrl *gomock.Controller) *MockVariablegroupClient {
+	mock := &MockVariablegroupClient{ctrl: ctrl}
+	mock.recorder = &MockVariablegroupClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockVariablegroupClient) EXPECT() *MockVariablegroupClientMockRecorder {
+	return m.recorder
+}
+
+// AddVariableGroup mocks base method
+func (m *MockVariablegroupClient) AddVariableGroup(arg0 context.Context, arg1 variablegroup.AddVariableGroupArgs) (*variablegroup.VariableGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddVariableGroup", arg0, arg1)
+	ret0, _ := ret[0].(*variablegroup.VariableGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddVariableGroup indicates an expected call of AddVariableGroup
+func (mr *MockVariablegroupClientMockRecorder) AddVariableGroup(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddVariableGroup", reflect.TypeOf((*MockVariablegroupClient)(nil).AddVariableGroup), arg0, arg1)
+}
+
+// GetVariableGroup mocks base method
+func (m *MockVariablegroupClient) GetVariableGroup(arg0 context.Context, arg1 variablegroup.GetVariableGroupArgs) (*variablegroup.VariableGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVariableGroup", arg0, arg1)
+	ret0, _ := ret[0].(*variablegroup.VariableGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVariableGroup indicates an expected call of GetVariableGroup
+func (mr *MockVariablegroupClientMockRecorder) GetVariableGroup(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVariableGroup", reflect.TypeOf((*MockVariablegroupClient)(nil).GetVariableGroup), arg0, arg1)
+}
+
+// UpdateVariableGroup mocks base method
+func (m *MockVariablegroupClient) UpdateVariableGroup(arg0 context.Context, arg1 variablegroup.UpdateVariableGroupArgs) (*variablegroup.VariableGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVariableGroup", arg0, arg1)
+	ret0, _ := ret[0].(*variablegroup.VariableGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateVariableGroup indicates an expected call of UpdateVariableGroup
+func (mr *MockVariablegroupClientMockRecorder) UpdateVariableGroup(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVariableGroup", reflect.TypeOf((*MockVariablegroupClient)(nil).UpdateVariableGroup), arg0, arg1)
+}
@@ -0,0 +1,65 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/yamlpipeline (interfaces: Client)
+
+// Package azdosdkmocks is a generated GoMock package.
+package azdosdkmocks
+
+import (
+	context "context"
+	gomock "github.com/golang/mock/gomock"
+	yamlpipeline "github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/yamlpipeline"
+	reflect "reflect"
+)
+
+// MockYamlPipelineClient is a mock of Client interface
+type MockYamlPipelineClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockYamlPipelineClientMockRecorder
+}
+
+// MockYamlPipelineClientMockRecorder is the mock recorder for MockYamlPipelineClient
+type MockYamlPipelineClientMockRecorder struct {
+	mock *MockYamlPipelineClient
+}
+
+// NewMockYamlPipelineClient creates a new mock instance
+func NewMockYamlPipelineClient(ctrl *gomock.Controller) *MockYamlPipelineClient {
+	mock := &MockYamlPipelineClient{ctrl: ctrl}
+	mock.recorder = &MockYamlPipelineClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockYamlPipelineClient) EXPECT() *MockYamlPipelineClientMockRecorder {
+	return m.recorder
+}
+
+// CreatePipeline mocks base method
+func (m *MockYamlPipelineClient) CreatePipeline(arg0 context.Context, arg1 yamlpipeline.CreatePipelineArgs) (*yamlpipeline.Pipeline, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePipeline", arg0, arg1)
+	ret0, _ := ret[0].(*yamlpipeline.Pipeline)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePipeline indicates an expected call of CreatePipeline
+func (mr *MockYamlPipelineClientMockRecorder) CreatePipeline(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePipeline", reflect.TypeOf((*MockYamlPipelineClient)(nil).CreatePipeline), arg0, arg1)
+}
+
+// GetPipeline mocks base method
+func (m *MockYamlPipelineClient) GetPipeline(arg0 context.Context, arg1 yamlpipeline.GetPipelineArgs) (*yamlpipeline.Pipeline, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPipeline", arg0, arg1)
+	ret0, _ := ret[0].(*yamlpipeline.Pipeline)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPipeline indicates an expected call of GetPipeline
+func (mr *MockYamlPipelineClientMockRecorder) GetPipeline(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPipeline", reflect.TypeOf((*MockYamlPipelineClient)(nil).GetPipeline), arg0, arg1)
+}
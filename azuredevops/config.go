@@ -2,16 +2,52 @@ package azuredevops
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
+	"io/ioutil"
 	"log"
+	"net"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"time"
+	"unsafe"
 
+	"github.com/hashicorp/terraform-plugin-sdk/helper/logging"
 	"github.com/microsoft/azure-devops-go-api/azuredevops"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/build"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/core"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/dashboard"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/extensionmanagement"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/featuremanagement"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/feed"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/git"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/graph"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/identity"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/memberentitlementmanagement"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/operations"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/policy"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/security"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/serviceendpoint"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/taskagent"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/wiki"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/workitemtracking"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/azdoerror"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/environment"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/feedrecyclebin"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/gitrepository"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/graphgroup"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/httplog"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/httpretry"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/msi"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/pipelinechecks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/pipelinesettings"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/secretmemo"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/securityroles"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/variablegroup"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/yamlpipeline"
 )
 
 // Aggregates all of the underlying clients into a single data
@@ -22,27 +58,348 @@ import (
 // allow for mocking to support unit testing of the funcs that invoke the
 // Azure DevOps client.
 type aggregatedClient struct {
-	CoreClient            core.Client
-	BuildClient           build.Client
-	GitReposClient        git.Client
-	GraphClient           graph.Client
-	OperationsClient      operations.Client
-	ServiceEndpointClient serviceendpoint.Client
-	ctx                   context.Context
+	CoreClient              core.Client
+	BuildClient             build.Client
+	DashboardClient         dashboard.Client
+	EnvironmentClient       environment.Client
+	ExtensionClient         extensionmanagement.Client
+	FeatureManagementClient featuremanagement.Client
+	FeedClient              feed.Client
+	FeedRecycleBinClient    feedrecyclebin.Client
+	GitReposClient          git.Client
+	GitRepositoryClient     gitrepository.Client
+	GraphClient             graph.Client
+	GraphGroupClient        graphgroup.Client
+	IdentityClient          identity.Client
+	MemberEntitlementClient memberentitlementmanagement.Client
+	OperationsClient        operations.Client
+	PipelineChecksClient    pipelinechecks.Client
+	PipelineSettingsClient  pipelinesettings.Client
+	PolicyClient            policy.Client
+	SecurityClient          security.Client
+	SecurityRolesClient     securityroles.Client
+	ServiceEndpointClient   serviceendpoint.Client
+	TaskAgentClient         taskagent.Client
+	VariableGroupClient     variablegroup.Client
+	WikiClient              wiki.Client
+	WorkItemTrackingClient  workitemtracking.Client
+	YamlPipelineClient      yamlpipeline.Client
+	ctx                     context.Context
+	// the algorithm and cost the hashes of secrets stored in the state are calculated with
+	secretSettings *secretmemo.Settings
+	// the organization the clients are connected to and how they authenticate, exposed for debugging
+	organizationURL string
+	authMethod      string
 }
 
-func getAzdoClient(azdoPAT string, organizationURL string) (*aggregatedClient, error) {
-	ctx := context.Background()
+// Returns a copy of the clients whose API calls are cancelled once the timeout elapses. This bounds the
+// overall duration of an operation, including any polling for asynchronous operations to complete.
+func (clients *aggregatedClient) withTimeout(timeout time.Duration) (*aggregatedClient, context.CancelFunc) {
+	parent := clients.ctx
+	if parent == nil {
+		parent = context.Background()
+	}
+	ctx, cancel := context.WithTimeout(parent, timeout)
+	scoped := *clients
+	scoped.ctx = ctx
+	return &scoped, cancel
+}
+
+// Settings that tune the HTTP transport shared by all of the underlying clients
+type transportSettings struct {
+	maxRetries     int
+	retryBaseDelay time.Duration
+	clientTimeout  time.Duration
+	proxyURL       string
+	caCertFile     string
+	caCertPEM      string
+	// bound the idle connections kept open for reuse, a value of 0 uses the default
+	maxIdleConns        int
+	maxIdleConnsPerHost int
+	idleConnTimeout     time.Duration
+	// appended to the User-Agent of every request, e.g. to identify the automation that runs Terraform
+	userAgentSuffix string
+	// logs every request and response, which only takes effect if Terraform logs at the DEBUG level or above
+	logHTTP bool
+}
+
+// The defaults of the idle connection pool. http.DefaultTransport keeps only 2 idle connections per host, but
+// all requests of the provider go to the same host, so connections would be closed and the TLS handshake
+// repeated whenever more than 2 requests run concurrently.
+const (
+	defaultMaxIdleConns        = 100
+	defaultMaxIdleConnsPerHost = 100
+	defaultIdleConnTimeout     = 90 * time.Second
+)
+
+// The version of the provider. Release builds set it through -ldflags "-X <package>.providerVersion=<version>".
+var providerVersion = "dev"
+
+// Returns the User-Agent that identifies requests made by the provider. The SDK prefixes it with its own
+// User-Agent, and the configured suffix is appended to it.
+func userAgent(suffix string) string {
+	agent := "terraform-provider-azuredevops/" + providerVersion
+	if suffix = strings.TrimSpace(suffix); suffix != "" {
+		agent += " " + suffix
+	}
+	return agent
+}
+
+// The AzDO SDK creates an http.Client for each URL the clients of a connection send requests to and caches it in
+// the connection, but neither sets nor exposes its transport, so every request would be sent through
+// http.DefaultTransport. To keep the transports of several configured providers, e.g. aliased providers for
+// different organizations, apart, the transport of a provider is set on the http.Clients cached in its connection.
+// Clients created afterwards do not use the transport, so this is repeated once all clients have been created.
+func setConnectionTransport(connection *azuredevops.Connection, transport http.RoundTripper) error {
+	clientCache := reflect.ValueOf(connection).Elem().FieldByName("clientCache")
+	if clientCache.Kind() != reflect.Map {
+		return fmt.Errorf("the transport cannot be set, the connection of the Azure DevOps SDK has no client cache")
+	}
+
+	httpClientType := reflect.TypeOf(&http.Client{})
+	entries := clientCache.MapRange()
+	for entries.Next() {
+		httpClient := entries.Value().FieldByName("client")
+		if !httpClient.IsValid() || httpClient.Type() != httpClientType {
+			return fmt.Errorf("the transport cannot be set, the client of the Azure DevOps SDK has no http client")
+		}
+		(*http.Client)(unsafe.Pointer(httpClient.Pointer())).Transport = transport
+	}
+	return nil
+}
+
+// msiTokens is nil unless the provider authenticates with a managed identity, in which case the bearer
+// token of every request is refreshed from it. Errors returned by the API carry the HTTP status and the ID
+// of the request once all retries are exhausted, regardless of the client that made the request. If HTTP
+// logging is enabled, each attempt is logged as it is sent, after the token has been added to it.
+func newTransport(settings *transportSettings, msiTokens *msi.TokenSource) (http.RoundTripper, error) {
+	httpTransport, err := newHTTPTransport(settings)
+	if err != nil {
+		return nil, err
+	}
+
+	var transport http.RoundTripper = httpTransport
+	if isHTTPLoggingEnabled(settings) {
+		transport = httplog.NewRoundTripper(transport)
+	}
+	transport = httpretry.NewRoundTripper(transport, settings.maxRetries, settings.retryBaseDelay)
+	transport = azdoerror.NewRoundTripper(transport)
+	if msiTokens != nil {
+		transport = msi.NewRoundTripper(transport, msiTokens)
+	}
+	return transport, nil
+}
+
+// Requests are only logged if they are asked for and Terraform logs at the DEBUG level or above, as the log
+// entries are verbose and would otherwise be discarded anyway
+func isHTTPLoggingEnabled(settings *transportSettings) bool {
+	return settings.logHTTP && logging.IsDebugOrHigher()
+}
+
+// Creates a transport with the same defaults as http.DefaultTransport that sends requests through the
+// configured proxy, or the proxy named by the HTTP_PROXY/HTTPS_PROXY/NO_PROXY environment variables if none
+// is configured, and that additionally trusts the configured CA certificates. Unlike http.DefaultTransport,
+// it keeps enough idle connections to the organization to reuse them across concurrent requests.
+func newHTTPTransport(settings *transportSettings) (*http.Transport, error) {
+	proxy := http.ProxyFromEnvironment
+	if settings.proxyURL != "" {
+		proxyURL, err := url.Parse(settings.proxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("the proxy url %s is invalid: %v", settings.proxyURL, err)
+		}
+		proxy = http.ProxyURL(proxyURL)
+	}
+
+	tlsConfig, err := newTLSConfig(settings)
+	if err != nil {
+		return nil, err
+	}
+
+	return &http.Transport{
+		Proxy: proxy,
+		DialContext: (&net.Dialer{
+			Timeout:   30 * time.Second,
+			KeepAlive: 30 * time.Second,
+		}).DialContext,
+		MaxIdleConns:          intOrDefault(settings.maxIdleConns, defaultMaxIdleConns),
+		MaxIdleConnsPerHost:   intOrDefault(settings.maxIdleConnsPerHost, defaultMaxIdleConnsPerHost),
+		IdleConnTimeout:       durationOrDefault(settings.idleConnTimeout, defaultIdleConnTimeout),
+		TLSHandshakeTimeout:   10 * time.Second,
+		ExpectContinueTimeout: 1 * time.Second,
+		TLSClientConfig:       tlsConfig,
+	}, nil
+}
+
+func intOrDefault(value int, defaultValue int) int {
+	if value == 0 {
+		return defaultValue
+	}
+	return value
+}
+
+func durationOrDefault(value time.Duration, defaultValue time.Duration) time.Duration {
+	if value == 0 {
+		return defaultValue
+	}
+	return value
+}
+
+// Returns a TLS configuration that trusts the system certificates plus the configured CA certificates,
+// or nil if no CA certificates are configured
+func newTLSConfig(settings *transportSettings) (*tls.Config, error) {
+	var bundles [][]byte
+	if settings.caCertFile != "" {
+		bundle, err := ioutil.ReadFile(settings.caCertFile)
+		if err != nil {
+			return nil, fmt.Errorf("Error reading the CA certificate file %s: %v", settings.caCertFile, err)
+		}
+		bundles = append(bundles, bundle)
+	}
+	if settings.caCertPEM != "" {
+		bundles = append(bundles, []byte(settings.caCertPEM))
+	}
+
+	if len(bundles) == 0 {
+		return nil, nil
+	}
+
+	pool, err := x509.SystemCertPool()
+	if err != nil || pool == nil {
+		log.Printf("newTLSConfig(): system certificate pool is not available, only the configured CA certificates will be trusted.")
+		pool = x509.NewCertPool()
+	}
+
+	for _, bundle := range bundles {
+		if !pool.AppendCertsFromPEM(bundle) {
+			return nil, fmt.Errorf("no valid PEM encoded CA certificate was found in the configured CA certificates")
+		}
+	}
+
+	return &tls.Config{RootCAs: pool}, nil
+}
+
+// Credentials used to authenticate against Azure DevOps. Exactly one of them must be set.
+type authSettings struct {
+	personalAccessToken string
+	aadToken            string
+	useMSI              bool
+	msiClientID         string
+}
+
+// The methods of authenticating against Azure DevOps
+const (
+	authMethodPersonalAccessToken = "personal_access_token"
+	authMethodAADToken            = "aad_token"
+	authMethodManagedIdentity     = "managed_identity"
+)
+
+// Returns the method of authentication that is used with these credentials, which is the one that
+// newConnection authenticates with if exactly one credential is set
+func (auth *authSettings) method() string {
+	switch {
+	case auth.useMSI:
+		return authMethodManagedIdentity
+	case auth.aadToken != "":
+		return authMethodAADToken
+	default:
+		return authMethodPersonalAccessToken
+	}
+}
+
+// Provides AAD access tokens, e.g. from a managed identity
+type tokenSource interface {
+	Token() (string, error)
+}
+
+// Creates a connection that authenticates with whichever credential has been configured. The msiTokens
+// are only used if authentication with a managed identity has been enabled.
+func newConnection(auth *authSettings, organizationURL string, msiTokens tokenSource) (*azuredevops.Connection, error) {
+	configured := 0
+	for _, isSet := range []bool{auth.personalAccessToken != "", auth.aadToken != "", auth.useMSI} {
+		if isSet {
+			configured++
+		}
+	}
 
-	if azdoPAT == "" {
-		return nil, fmt.Errorf("the personal access token is required")
+	if configured > 1 {
+		return nil, fmt.Errorf("only one of the personal access token, the AAD token or the managed identity can be configured")
 	}
 
+	if auth.useMSI {
+		token, err := msiTokens.Token()
+		if err != nil {
+			return nil, fmt.Errorf("Error acquiring a token for the managed identity: %v", err)
+		}
+		return newBearerConnection(organizationURL, token), nil
+	}
+
+	if auth.aadToken != "" {
+		return newBearerConnection(organizationURL, auth.aadToken), nil
+	}
+
+	if auth.personalAccessToken != "" {
+		return azuredevops.NewPatConnection(organizationURL, auth.personalAccessToken), nil
+	}
+
+	return nil, fmt.Errorf("one of the personal access token, the AAD token or the managed identity is required")
+}
+
+func newBearerConnection(organizationURL string, token string) *azuredevops.Connection {
+	return &azuredevops.Connection{
+		AuthorizationString:     "Bearer " + token,
+		BaseUrl:                 strings.ToLower(strings.TrimRight(organizationURL, "/")),
+		SuppressFedAuthRedirect: true,
+	}
+}
+
+// Creates the clients of one configured provider. All state of the clients is contained in the returned
+// aggregatedClient, so that several providers can be configured side by side.
+func getAzdoClient(auth *authSettings, organizationURL string, settings *transportSettings, secretSettings *secretmemo.Settings) (*aggregatedClient, error) {
 	if organizationURL == "" {
 		return nil, fmt.Errorf("the url of the Azure DevOps is required")
 	}
 
-	connection := azuredevops.NewPatConnection(organizationURL, azdoPAT)
+	if settings.maxRetries < 0 {
+		return nil, fmt.Errorf("the maximum number of retries cannot be negative")
+	}
+
+	if settings.clientTimeout < 0 {
+		return nil, fmt.Errorf("the client timeout cannot be negative")
+	}
+
+	if settings.maxIdleConns < 0 || settings.maxIdleConnsPerHost < 0 || settings.idleConnTimeout < 0 {
+		return nil, fmt.Errorf("the limits of idle connections cannot be negative")
+	}
+
+	var msiTokens *msi.TokenSource
+	if auth.useMSI {
+		// the token endpoint must not be called through the transport that refreshes tokens from it
+		msiTokens = msi.NewTokenSource(&http.Client{Timeout: 30 * time.Second}, msi.DefaultEndpoint, auth.msiClientID)
+	}
+
+	connection, err := newConnection(auth, organizationURL, msiTokens)
+	if err != nil {
+		return nil, err
+	}
+
+	transport, err := newTransport(settings, msiTokens)
+	if err != nil {
+		return nil, err
+	}
+
+	connection.UserAgent = userAgent(settings.userAgentSuffix)
+
+	if settings.clientTimeout > 0 {
+		// bounds each individual API call made by any of the clients created from this connection
+		connection.Timeout = &settings.clientTimeout
+	}
+
+	// the client for the organization itself looks up the resource areas while the other clients are created
+	connection.GetClientByUrl(connection.BaseUrl)
+	if err := setConnectionTransport(connection, transport); err != nil {
+		return nil, err
+	}
+	ctx := context.Background()
 
 	// client for these APIs (includes CRUD for AzDO projects...):
 	//	https://docs.microsoft.com/en-us/rest/api/azure/devops/core/?view=azure-devops-rest-5.1
@@ -60,6 +417,10 @@ func getAzdoClient(azdoPAT string, organizationURL string) (*aggregatedClient, e
 		return nil, err
 	}
 
+	// client for these APIs (enables and disables features like Boards or Repos for a project...):
+	//	https://docs.microsoft.com/en-us/rest/api/azure/devops/featuremanagement/?view=azure-devops-rest-5.1
+	featureManagementClient := featuremanagement.NewClient(ctx, connection)
+
 	// client for these APIs (monitor async operations...):
 	//	https://docs.microsoft.com/en-us/rest/api/azure/devops/operations/operations?view=azure-devops-rest-5.1
 	operationsClient := operations.NewClient(ctx, connection)
@@ -80,6 +441,13 @@ func getAzdoClient(azdoPAT string, organizationURL string) (*aggregatedClient, e
 		return nil, err
 	}
 
+	// client for the same repository APIs that, unlike the git client, reads and changes the disabled state of a repository
+	gitRepositoryClient, err := gitrepository.NewClient(ctx, connection)
+	if err != nil {
+		log.Printf("getAzdoClient(): gitrepository.NewClient failed.")
+		return nil, err
+	}
+
 	//  https://docs.microsoft.com/en-us/rest/api/azure/devops/graph/?view=azure-devops-rest-5.1
 	graphClient, err := graph.NewClient(ctx, connection)
 	if err != nil {
@@ -87,16 +455,166 @@ func getAzdoClient(azdoPAT string, organizationURL string) (*aggregatedClient, e
 		return nil, err
 	}
 
+	// client for the same group APIs that, unlike the graph client, is able to name and describe new groups
+	graphGroupClient, err := graphgroup.NewClient(ctx, connection)
+	if err != nil {
+		log.Printf("getAzdoClient(): graphgroup.NewClient failed.")
+		return nil, err
+	}
+
+	// client for these APIs (resolves the identities of users and groups...):
+	//	https://docs.microsoft.com/en-us/rest/api/azure/devops/ims/?view=azure-devops-rest-5.1
+	identityClient, err := identity.NewClient(ctx, connection)
+	if err != nil {
+		log.Printf("getAzdoClient(): identity.NewClient failed.")
+		return nil, err
+	}
+
+	// client for these APIs (includes management of access control entries...):
+	//	https://docs.microsoft.com/en-us/rest/api/azure/devops/security/?view=azure-devops-rest-5.1
+	securityClient := security.NewClient(ctx, connection)
+
+	// client for these APIs (includes CRUD for AzDO branch policies...):
+	//	https://docs.microsoft.com/en-us/rest/api/azure/devops/policy/?view=azure-devops-rest-5.1
+	policyClient, err := policy.NewClient(ctx, connection)
+	if err != nil {
+		log.Printf("getAzdoClient(): policy.NewClient failed.")
+		return nil, err
+	}
+
+	// client for these APIs (includes CRUD for AzDO variable groups...):
+	//	https://docs.microsoft.com/en-us/rest/api/azure/devops/distributedtask/?view=azure-devops-rest-5.1
+	taskAgentClient, err := taskagent.NewClient(ctx, connection)
+	if err != nil {
+		log.Printf("getAzdoClient(): taskagent.NewClient failed.")
+		return nil, err
+	}
+
+	// client for the same variable group APIs that, unlike the taskagent client, keeps the Azure Key Vault linkage of a group
+	variableGroupClient, err := variablegroup.NewClient(ctx, connection)
+	if err != nil {
+		log.Printf("getAzdoClient(): variablegroup.NewClient failed.")
+		return nil, err
+	}
+
+	// client for the environment APIs of the distributed task service, which the taskagent client only declares the models of
+	environmentClient, err := environment.NewClient(ctx, connection)
+	if err != nil {
+		log.Printf("getAzdoClient(): environment.NewClient failed.")
+		return nil, err
+	}
+
+	// client for the approvals and checks of protected resources like environments, which the SDK has no client for:
+	//	https://docs.microsoft.com/en-us/rest/api/azure/devops/approvalsandchecks/?view=azure-devops-rest-5.1
+	pipelineChecksClient := pipelinechecks.NewClient(ctx, connection)
+
+	// client for the general pipeline settings of projects, which the build client of the SDK has no operations for
+	pipelineSettingsClient, err := pipelinesettings.NewClient(ctx, connection)
+	if err != nil {
+		log.Printf("getAzdoClient(): pipelinesettings.NewClient failed.")
+		return nil, err
+	}
+
+	// client for the roles assigned on agent pools, agent queues, environments and other objects, which the SDK has no client for:
+	//	https://docs.microsoft.com/en-us/rest/api/azure/devops/securityroles/?view=azure-devops-rest-5.1
+	securityRolesClient := securityroles.NewClient(ctx, connection)
+
+	// client for the YAML pipelines of the pipelines service, which the pipelines client of the SDK cannot create:
+	//	https://docs.microsoft.com/en-us/rest/api/azure/devops/pipelines/pipelines?view=azure-devops-rest-5.1
+	yamlPipelineClient := yamlpipeline.NewClient(ctx, connection)
+
+	// client for these APIs (includes CRUD for Azure Artifacts feeds and their permissions...):
+	//	https://docs.microsoft.com/en-us/rest/api/azure/devops/artifacts/?view=azure-devops-rest-5.1
+	feedClient, err := feed.NewClient(ctx, connection)
+	if err != nil {
+		log.Printf("getAzdoClient(): feed.NewClient failed.")
+		return nil, err
+	}
+
+	// client for the recycle bin of deleted feeds, which the feed client has no operations for
+	feedRecycleBinClient, err := feedrecyclebin.NewClient(ctx, connection)
+	if err != nil {
+		log.Printf("getAzdoClient(): feedrecyclebin.NewClient failed.")
+		return nil, err
+	}
+
+	// client for these APIs (includes CRUD for the area and iteration paths of work items...):
+	//	https://docs.microsoft.com/en-us/rest/api/azure/devops/wit/?view=azure-devops-rest-5.1
+	workItemTrackingClient, err := workitemtracking.NewClient(ctx, connection)
+	if err != nil {
+		log.Printf("getAzdoClient(): workitemtracking.NewClient failed.")
+		return nil, err
+	}
+
+	// client for these APIs (includes CRUD for AzDO dashboards and their widgets...):
+	//	https://docs.microsoft.com/en-us/rest/api/azure/devops/dashboard/?view=azure-devops-rest-5.1
+	dashboardClient, err := dashboard.NewClient(ctx, connection)
+	if err != nil {
+		log.Printf("getAzdoClient(): dashboard.NewClient failed.")
+		return nil, err
+	}
+
+	// client for these APIs (includes CRUD for AzDO project and code wikis...):
+	//	https://docs.microsoft.com/en-us/rest/api/azure/devops/wiki/?view=azure-devops-rest-5.1
+	wikiClient, err := wiki.NewClient(ctx, connection)
+	if err != nil {
+		log.Printf("getAzdoClient(): wiki.NewClient failed.")
+		return nil, err
+	}
+
+	// client for these APIs (includes CRUD for the licenses and extensions assigned to users...):
+	//	https://docs.microsoft.com/en-us/rest/api/azure/devops/memberentitlementmanagement/?view=azure-devops-rest-5.1
+	memberEntitlementClient, err := memberentitlementmanagement.NewClient(ctx, connection)
+	if err != nil {
+		log.Printf("getAzdoClient(): memberentitlementmanagement.NewClient failed.")
+		return nil, err
+	}
+
+	// client for these APIs (includes CRUD for the extensions installed in the organization...):
+	//	https://docs.microsoft.com/en-us/rest/api/azure/devops/extensionmanagement/?view=azure-devops-rest-5.1
+	extensionClient, err := extensionmanagement.NewClient(ctx, connection)
+	if err != nil {
+		log.Printf("getAzdoClient(): extensionmanagement.NewClient failed.")
+		return nil, err
+	}
+
+	if err := setConnectionTransport(connection, transport); err != nil {
+		return nil, err
+	}
+
 	aggregatedClient := &aggregatedClient{
-		CoreClient:            coreClient,
-		BuildClient:           buildClient,
-		GitReposClient:        gitReposClient,
-		GraphClient:           graphClient,
-		OperationsClient:      operationsClient,
-		ServiceEndpointClient: serviceEndpointClient,
-		ctx:                   ctx,
+		CoreClient:              coreClient,
+		BuildClient:             buildClient,
+		DashboardClient:         dashboardClient,
+		EnvironmentClient:       environmentClient,
+		ExtensionClient:         extensionClient,
+		FeatureManagementClient: featureManagementClient,
+		FeedClient:              feedClient,
+		FeedRecycleBinClient:    feedRecycleBinClient,
+		GitReposClient:          gitReposClient,
+		GitRepositoryClient:     gitRepositoryClient,
+		GraphClient:             graphClient,
+		GraphGroupClient:        graphGroupClient,
+		IdentityClient:          identityClient,
+		MemberEntitlementClient: memberEntitlementClient,
+		OperationsClient:        operationsClient,
+		PipelineChecksClient:    pipelineChecksClient,
+		PipelineSettingsClient:  pipelineSettingsClient,
+		PolicyClient:            policyClient,
+		SecurityClient:          securityClient,
+		SecurityRolesClient:     securityRolesClient,
+		ServiceEndpointClient:   serviceEndpointClient,
+		TaskAgentClient:         taskAgentClient,
+		VariableGroupClient:     variableGroupClient,
+		WikiClient:              wikiClient,
+		WorkItemTrackingClient:  workItemTrackingClient,
+		YamlPipelineClient:      yamlPipelineClient,
+		ctx:                     ctx,
+		secretSettings:          secretSettings,
+		organizationURL:         connection.BaseUrl,
+		authMethod:              auth.method(),
 	}
 
-	log.Printf("getAzdoClient(): Created core, build, operations, and serviceendpoint clients successfully!")
+	log.Printf("getAzdoClient(): Created core, build, dashboard, environment, extensionmanagement, featuremanagement, feed, feedrecyclebin, gitrepository, operations, pipelinechecks, pipelinesettings, policy, graph, graphgroup, identity, memberentitlementmanagement, security, securityroles, serviceendpoint, taskagent, variablegroup, wiki, workitemtracking, and yamlpipeline clients successfully!")
 	return aggregatedClient, nil
 }
@@ -0,0 +1,453 @@
+package azuredevops
+
+import (
+	"bytes"
+	"context"
+	"encoding/pem"
+	"errors"
+	"io/ioutil"
+	"log"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/gitrepository"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/secretmemo"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestAzureDevOpsConfig_NewConnection_UsesBasicAuthForPersonalAccessToken(t *testing.T) {
+	connection, err := newConnection(&authSettings{personalAccessToken: "pat"}, "https://dev.azure.com/org/", nil)
+
+	require.Nil(t, err)
+	require.Equal(t, "Basic OnBhdA==", connection.AuthorizationString)
+	require.Equal(t, "https://dev.azure.com/org", connection.BaseUrl)
+}
+
+func TestAzureDevOpsConfig_NewConnection_UsesBearerAuthForAADToken(t *testing.T) {
+	connection, err := newConnection(&authSettings{aadToken: "token"}, "https://dev.azure.com/Org/", nil)
+
+	require.Nil(t, err)
+	require.Equal(t, "Bearer token", connection.AuthorizationString)
+	require.Equal(t, "https://dev.azure.com/org", connection.BaseUrl)
+	require.True(t, connection.SuppressFedAuthRedirect)
+}
+
+func TestAzureDevOpsConfig_NewConnection_RequiresExactlyOneCredential(t *testing.T) {
+	_, err := newConnection(&authSettings{}, "https://dev.azure.com/org", nil)
+	require.NotNil(t, err)
+
+	_, err = newConnection(&authSettings{personalAccessToken: "pat", aadToken: "token"}, "https://dev.azure.com/org", nil)
+	require.NotNil(t, err)
+}
+
+type staticTokenSource struct {
+	token string
+	err   error
+}
+
+func (s staticTokenSource) Token() (string, error) {
+	return s.token, s.err
+}
+
+func TestAzureDevOpsConfig_NewConnection_UsesBearerAuthForManagedIdentity(t *testing.T) {
+	connection, err := newConnection(&authSettings{useMSI: true}, "https://dev.azure.com/org", staticTokenSource{token: "msi-token"})
+
+	require.Nil(t, err)
+	require.Equal(t, "Bearer msi-token", connection.AuthorizationString)
+}
+
+func TestAzureDevOpsConfig_NewConnection_DoesNotSwallowManagedIdentityError(t *testing.T) {
+	_, err := newConnection(&authSettings{useMSI: true}, "https://dev.azure.com/org", staticTokenSource{err: errors.New("IMDS unreachable")})
+
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "IMDS unreachable")
+}
+
+func TestAzureDevOpsConfig_NewConnection_RejectsManagedIdentityCombinedWithToken(t *testing.T) {
+	_, err := newConnection(&authSettings{useMSI: true, personalAccessToken: "pat"}, "https://dev.azure.com/org", staticTokenSource{token: "msi-token"})
+
+	require.NotNil(t, err)
+}
+
+func TestAzureDevOpsConfig_NewHTTPTransport_UsesConfiguredProxy(t *testing.T) {
+	transport, err := newHTTPTransport(&transportSettings{proxyURL: "http://proxy.example.com:8080"})
+	require.Nil(t, err)
+
+	req, _ := http.NewRequest(http.MethodGet, "https://dev.azure.com/org", nil)
+	proxyURL, err := transport.Proxy(req)
+
+	require.Nil(t, err)
+	require.Equal(t, &url.URL{Scheme: "http", Host: "proxy.example.com:8080"}, proxyURL)
+}
+
+func TestAzureDevOpsConfig_NewHTTPTransport_TrustsConfiguredCACertificate(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	caCertPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	transport, err := newHTTPTransport(&transportSettings{caCertPEM: string(caCertPEM)})
+	require.Nil(t, err)
+
+	resp, err := (&http.Client{Transport: transport}).Get(server.URL)
+	require.Nil(t, err)
+	resp.Body.Close()
+}
+
+func TestAzureDevOpsConfig_NewHTTPTransport_RejectsInvalidCACertificate(t *testing.T) {
+	_, err := newHTTPTransport(&transportSettings{caCertPEM: "not a certificate"})
+	require.NotNil(t, err)
+
+	_, err = newHTTPTransport(&transportSettings{caCertFile: "/this/file/does/not/exist.pem"})
+	require.NotNil(t, err)
+}
+
+func TestAzureDevOpsConfig_NewHTTPTransport_ConfiguresIdleConnectionPool(t *testing.T) {
+	transport, err := newHTTPTransport(&transportSettings{})
+	require.Nil(t, err)
+	require.Equal(t, defaultMaxIdleConns, transport.MaxIdleConns)
+	require.Equal(t, defaultMaxIdleConnsPerHost, transport.MaxIdleConnsPerHost)
+	require.Equal(t, defaultIdleConnTimeout, transport.IdleConnTimeout)
+
+	transport, err = newHTTPTransport(&transportSettings{maxIdleConns: 10, maxIdleConnsPerHost: 5, idleConnTimeout: time.Minute})
+	require.Nil(t, err)
+	require.Equal(t, 10, transport.MaxIdleConns)
+	require.Equal(t, 5, transport.MaxIdleConnsPerHost)
+	require.Equal(t, time.Minute, transport.IdleConnTimeout)
+}
+
+func TestAzureDevOpsConfig_GetAzdoClient_RejectsNegativeIdleConnectionLimits(t *testing.T) {
+	for _, settings := range []*transportSettings{{maxIdleConns: -1}, {maxIdleConnsPerHost: -1}, {idleConnTimeout: -time.Second}} {
+		_, err := getAzdoClient(&authSettings{personalAccessToken: "pat"}, "https://dev.azure.com/org", settings, secretmemo.DefaultSettings())
+		require.NotNil(t, err)
+	}
+}
+
+// verifies that the errors of every client carry the HTTP status and the ID of the request
+func TestAzureDevOpsConfig_NewTransport_AnnotatesErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("ActivityId", "4f1e7c0a")
+		w.Header().Set("Content-Type", "text/plain")
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte("Access denied"))
+	}))
+	defer server.Close()
+
+	transport, err := newTransport(&transportSettings{}, nil)
+	require.Nil(t, err)
+	resp, err := (&http.Client{Transport: transport}).Get(server.URL)
+	require.Nil(t, err)
+
+	err = (&azuredevops.Client{}).UnwrapError(resp)
+	require.Equal(t, "HTTP 403 (request 4f1e7c0a): Access denied", err.Error())
+}
+
+// Sends a request with credentials through the transport created for the settings, while Terraform logs at the
+// given level, and returns what was logged
+func sendLoggedRequest(t *testing.T, settings *transportSettings, logLevel string) string {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("ActivityId", "4f1e7c0a")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	previousLogLevel, wasSet := os.LookupEnv("TF_LOG")
+	os.Setenv("TF_LOG", logLevel)
+	defer func() {
+		if wasSet {
+			os.Setenv("TF_LOG", previousLogLevel)
+		} else {
+			os.Unsetenv("TF_LOG")
+		}
+	}()
+
+	var output bytes.Buffer
+	log.SetOutput(&output)
+	defer log.SetOutput(os.Stderr)
+
+	transport, err := newTransport(settings, nil)
+	require.Nil(t, err)
+	req, err := http.NewRequest(http.MethodGet, server.URL+"/_apis/projects", nil)
+	require.Nil(t, err)
+	req.Header.Set("Authorization", "Basic OnBhdA==")
+	resp, err := (&http.Client{Transport: transport}).Do(req)
+	require.Nil(t, err)
+	resp.Body.Close()
+
+	return output.String()
+}
+
+func TestAzureDevOpsConfig_NewTransport_LogsRequestsIfEnabled(t *testing.T) {
+	output := sendLoggedRequest(t, &transportSettings{logHTTP: true}, "DEBUG")
+
+	require.Contains(t, output, "AzDO request #1: GET http://127.0.0.1")
+	require.Contains(t, output, "Authorization: [REDACTED]")
+	require.NotContains(t, output, "OnBhdA==")
+	require.Contains(t, output, "(request 4f1e7c0a)")
+}
+
+func TestAzureDevOpsConfig_NewTransport_DoesNotLogRequestsIfDisabled(t *testing.T) {
+	for settings, logLevel := range map[*transportSettings]string{
+		{logHTTP: false}: "DEBUG",
+		{logHTTP: true}:  "INFO",
+		{logHTTP: true}:  "",
+	} {
+		output := sendLoggedRequest(t, settings, logLevel)
+		require.NotContains(t, output, "AzDO request", "log level %q", logLevel)
+	}
+}
+
+// Counts the connections a test server accepts
+type connectionCounter struct {
+	lock        sync.Mutex
+	connections int
+}
+
+func (c *connectionCounter) onStateChange(_ net.Conn, state http.ConnState) {
+	if state == http.StateNew {
+		c.lock.Lock()
+		c.connections++
+		c.lock.Unlock()
+	}
+}
+
+func (c *connectionCounter) count() int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.connections
+}
+
+// Starts a TLS server that counts the connections it accepts, and returns a transport that trusts it
+func newConnectionCountingServer(tb testing.TB) (*httptest.Server, *connectionCounter, *http.Transport) {
+	counter := &connectionCounter{}
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"count":0,"value":[]}`))
+	}))
+	server.Config.ConnState = counter.onStateChange
+	server.StartTLS()
+
+	caCertPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	transport, err := newHTTPTransport(&transportSettings{caCertPEM: string(caCertPEM)})
+	if err != nil {
+		server.Close()
+		tb.Fatal(err)
+	}
+	return server, counter, transport
+}
+
+// Sends a batch of concurrent requests through the transport, like the provider does while Terraform
+// creates many resources in parallel
+func sendConcurrentRequests(tb testing.TB, transport http.RoundTripper, url string, concurrency int) {
+	client := &http.Client{Transport: transport}
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := client.Get(url)
+			if err != nil {
+				tb.Error(err)
+				return
+			}
+			ioutil.ReadAll(resp.Body)
+			resp.Body.Close()
+		}()
+	}
+	wg.Wait()
+}
+
+// verifies that the connections opened for one batch of concurrent requests are reused by the following
+// batches instead of being closed, which would require a new TLS handshake for every request
+func TestAzureDevOpsConfig_NewHTTPTransport_ReusesConnectionsAcrossConcurrentRequests(t *testing.T) {
+	server, counter, transport := newConnectionCountingServer(t)
+	defer server.Close()
+	defer transport.CloseIdleConnections()
+
+	// Terraform applies up to 10 changes in parallel by default
+	const concurrency, batches = 10, 20
+	for i := 0; i < batches; i++ {
+		sendConcurrentRequests(t, transport, server.URL, concurrency)
+	}
+
+	require.True(t, counter.count() <= concurrency, "%d connections were opened for %d batches of %d requests", counter.count(), batches, concurrency)
+}
+
+func BenchmarkAzureDevOpsConfig_NewHTTPTransport_ConcurrentRequests(b *testing.B) {
+	server, counter, transport := newConnectionCountingServer(b)
+	defer server.Close()
+	defer transport.CloseIdleConnections()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		sendConcurrentRequests(b, transport, server.URL, 10)
+	}
+	b.Logf("%d connections were opened for %d batches of 10 requests", counter.count(), b.N)
+}
+
+// Records the requests sent through it and answers them with an empty JSON collection
+type recordingRoundTripper struct {
+	requests []*http.Request
+}
+
+func (r *recordingRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	r.requests = append(r.requests, req)
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       ioutil.NopCloser(strings.NewReader(`{"count":0,"value":[]}`)),
+		Request:    req,
+	}, nil
+}
+
+func TestAzureDevOpsConfig_UserAgent_IdentifiesProviderAndSuffix(t *testing.T) {
+	require.Equal(t, "terraform-provider-azuredevops/"+providerVersion, userAgent(""))
+	require.Equal(t, "terraform-provider-azuredevops/"+providerVersion+" pipeline/1.0", userAgent(" pipeline/1.0 "))
+}
+
+func TestAzureDevOpsConfig_UserAgent_IsSentWithRequests(t *testing.T) {
+	roundTripper := &recordingRoundTripper{}
+	originalTransport := http.DefaultTransport
+	http.DefaultTransport = roundTripper
+	defer func() { http.DefaultTransport = originalTransport }()
+
+	connection, err := newConnection(&authSettings{personalAccessToken: "pat"}, "https://dev.azure.com/org", nil)
+	require.Nil(t, err)
+	connection.UserAgent = userAgent("pipeline/1.0")
+
+	// the answer does not matter, only the request that is sent to look up the API locations
+	connection.GetClientByUrl(connection.BaseUrl).GetResourceAreas(context.Background())
+
+	require.NotEmpty(t, roundTripper.requests)
+	sentUserAgent := roundTripper.requests[0].Header.Get("User-Agent")
+	require.Contains(t, sentUserAgent, "azure-devops-go-api")
+	require.True(t, strings.HasSuffix(sentUserAgent, " terraform-provider-azuredevops/"+providerVersion+" pipeline/1.0"), sentUserAgent)
+}
+
+func TestAzureDevOpsConfig_WithTimeout_AppliesDeadlineToClientContext(t *testing.T) {
+	clients := &aggregatedClient{ctx: context.Background()}
+
+	scoped, cancel := clients.withTimeout(time.Minute)
+	defer cancel()
+
+	_, ok := scoped.ctx.Deadline()
+	require.True(t, ok)
+
+	_, ok = clients.ctx.Deadline()
+	require.False(t, ok, "the original client context should not be modified")
+
+	cancel()
+	require.NotNil(t, scoped.ctx.Err())
+}
+
+// the resource location of the resource areas, which the SDK looks up while the clients are created
+const testResourceAreasLocation = `{
+	"count": 1,
+	"value": [{
+		"id": "e81700f7-3be2-46de-8624-2eb35882fcaa",
+		"area": "Location",
+		"resourceName": "ResourceAreas",
+		"routeTemplate": "_apis/{resource}/{areaId}",
+		"resourceVersion": 1,
+		"minVersion": "5.1",
+		"maxVersion": "5.1",
+		"releasedVersion": "0.0"
+	}]
+}`
+
+// Acts like an organization without any resource areas, which is how on premise servers answer, and records
+// the host and the authorization of every request it receives
+type fakeOrganization struct {
+	lock     sync.Mutex
+	requests []*http.Request
+}
+
+func (f *fakeOrganization) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.lock.Lock()
+	f.requests = append(f.requests, r)
+	f.lock.Unlock()
+
+	if r.Method == http.MethodOptions {
+		w.Write([]byte(testResourceAreasLocation))
+		return
+	}
+	w.Write([]byte(`{"count":0,"value":[]}`))
+}
+
+func (f *fakeOrganization) received() []*http.Request {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	return append([]*http.Request{}, f.requests...)
+}
+
+// verifies that the clients of several providers, e.g. aliased providers for different organizations, can be
+// created concurrently, and that each of them keeps its own organization, credentials, transport and secret
+// hashing settings
+func TestAzureDevOpsConfig_GetAzdoClient_KeepsProvidersApart(t *testing.T) {
+	defaultTransport := http.DefaultTransport
+	proxy, organizationA, organizationB := &fakeOrganization{}, &fakeOrganization{}, &fakeOrganization{}
+	proxyServer := httptest.NewServer(proxy)
+	defer proxyServer.Close()
+	serverA := httptest.NewServer(organizationA)
+	defer serverA.Close()
+	serverB := httptest.NewServer(organizationB)
+	defer serverB.Close()
+
+	auths := []*authSettings{{personalAccessToken: "pat-a"}, {aadToken: "token-b"}}
+	urls := []string{serverA.URL, serverB.URL}
+	// only the first organization is reached through the proxy
+	settings := []*transportSettings{{proxyURL: proxyServer.URL}, {}}
+	saltedSettings, err := secretmemo.NewSettings(secretmemo.AlgorithmSaltedSHA256, bcrypt.MinCost)
+	require.Nil(t, err)
+	bcryptSettings, err := secretmemo.NewSettings(secretmemo.AlgorithmBcrypt, bcrypt.MinCost+1)
+	require.Nil(t, err)
+	secretSettings := []*secretmemo.Settings{saltedSettings, bcryptSettings}
+
+	clients := make([]*aggregatedClient, 2)
+	errs := make([]error, 2)
+	var wg sync.WaitGroup
+	for i := range clients {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			clients[i], errs[i] = getAzdoClient(auths[i], urls[i], settings[i], secretSettings[i])
+		}(i)
+	}
+	wg.Wait()
+
+	for i := range clients {
+		require.Nil(t, errs[i])
+		require.Equal(t, strings.ToLower(urls[i]), clients[i].organizationURL)
+		require.Equal(t, secretSettings[i], clients[i].secretSettings)
+	}
+	require.Equal(t, authMethodPersonalAccessToken, clients[0].authMethod)
+	require.Equal(t, authMethodAADToken, clients[1].authMethod)
+
+	// requests of the clients go through the transport of their provider, also after the clients have been created
+	for i := range clients {
+		sdkClient := clients[i].GitRepositoryClient.(*gitrepository.ClientImpl).Client
+		_, err := sdkClient.GetResourceAreas(clients[i].ctx)
+		require.Nil(t, err)
+	}
+
+	require.Empty(t, organizationA.received(), "the first organization should only be reached through the proxy")
+	require.NotEmpty(t, proxy.received())
+	for _, req := range proxy.received() {
+		require.Equal(t, strings.TrimPrefix(serverA.URL, "http://"), req.Host)
+	}
+
+	require.NotEmpty(t, organizationB.received())
+	for _, req := range organizationB.received() {
+		if req.Header.Get("Authorization") != "" {
+			require.Equal(t, "Bearer token-b", req.Header.Get("Authorization"))
+		}
+	}
+	require.Equal(t, defaultTransport, http.DefaultTransport, "the transport of the process should not be replaced")
+}
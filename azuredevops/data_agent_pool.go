@@ -0,0 +1,68 @@
+package azuredevops
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+)
+
+func dataAgentPool() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAgentPoolRead,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"pool_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"auto_provision": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAgentPoolRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	name := d.Get("name").(string)
+
+	agentPool, err := getAgentPoolByName(clients, name)
+	if err != nil {
+		return fmt.Errorf("Error looking up agent pool with name %s. Error: %v", name, err)
+	}
+	if agentPool == nil {
+		return fmt.Errorf("Agent pool with name %s does not exist", name)
+	}
+
+	flattenAgentPool(d, agentPool)
+	return nil
+}
+
+// Returns the pool with the given name, or nil if there is none. Pool names are unique within an organization
+// regardless of their case.
+func getAgentPoolByName(clients *aggregatedClient, name string) (*taskagent.TaskAgentPool, error) {
+	agentPools, err := clients.TaskAgentClient.GetAgentPools(clients.ctx, taskagent.GetAgentPoolsArgs{
+		PoolName: &name,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if agentPools != nil {
+		for _, agentPool := range *agentPools {
+			if agentPool.Id != nil && strings.EqualFold(converter.ToString(agentPool.Name, ""), name) {
+				return &agentPool, nil
+			}
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,130 @@
+package azuredevops
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that a pool is resolved by its name, ignoring pools whose name only starts with it
+func TestAgentPoolDataSource_Read_ResolvesPoolByName(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskAgentClient := azdosdkmocks.NewMockTaskagentClient(ctrl)
+	clients := &aggregatedClient{TaskAgentClient: taskAgentClient, ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, dataAgentPool().Schema, nil)
+	resourceData.Set("name", "Shared")
+
+	deployment := taskagent.TaskAgentPoolTypeValues.Deployment
+	taskAgentClient.
+		EXPECT().
+		GetAgentPools(clients.ctx, taskagent.GetAgentPoolsArgs{PoolName: converter.String("Shared")}).
+		Return(&[]taskagent.TaskAgentPool{
+			{Id: converter.Int(3), Name: converter.String("Shared Linux"), PoolType: &deployment, AutoProvision: converter.Bool(false)},
+			{Id: converter.Int(7), Name: converter.String("shared"), PoolType: &deployment, AutoProvision: converter.Bool(true)},
+		}, nil).
+		Times(1)
+
+	err := dataSourceAgentPoolRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "7", resourceData.Id())
+	require.Equal(t, "shared", resourceData.Get("name"))
+	require.Equal(t, string(deployment), resourceData.Get("pool_type"))
+	require.Equal(t, true, resourceData.Get("auto_provision"))
+}
+
+// verifies that a clear error is returned if no pool has the name
+func TestAgentPoolDataSource_Read_ReportsMissingPool(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskAgentClient := azdosdkmocks.NewMockTaskagentClient(ctrl)
+	clients := &aggregatedClient{TaskAgentClient: taskAgentClient, ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, dataAgentPool().Schema, nil)
+	resourceData.Set("name", "Missing")
+
+	taskAgentClient.
+		EXPECT().
+		GetAgentPools(clients.ctx, gomock.Any()).
+		Return(&[]taskagent.TaskAgentPool{}, nil).
+		Times(1)
+
+	err := dataSourceAgentPoolRead(resourceData, clients)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "Agent pool with name Missing does not exist")
+}
+
+// verifies that the agent pool lookup functionality has proper error handling
+func TestAgentPoolDataSource_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskAgentClient := azdosdkmocks.NewMockTaskagentClient(ctrl)
+	clients := &aggregatedClient{TaskAgentClient: taskAgentClient, ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, dataAgentPool().Schema, nil)
+	resourceData.Set("name", "Shared")
+
+	taskAgentClient.
+		EXPECT().
+		GetAgentPools(clients.ctx, gomock.Any()).
+		Return(nil, errors.New("GetAgentPools() Failed")).
+		Times(1)
+
+	err := dataSourceAgentPoolRead(resourceData, clients)
+	require.Contains(t, err.Error(), "GetAgentPools() Failed")
+}
+
+/**
+ * Begin acceptance tests
+ */
+
+// Validates that a configuration containing an agent pool lookup is able to resolve the pool by its name
+func TestAccAgentPoolDataSource_Read_HappyPath(t *testing.T) {
+	poolName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	tfNode := "data.azuredevops_agent_pool.pool"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAgentPoolDataSource(poolName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(tfNode, "id", "azuredevops_agent_pool.pool", "id"),
+					resource.TestCheckResourceAttr(tfNode, "name", poolName),
+					resource.TestCheckResourceAttr(tfNode, "pool_type", "automation"),
+					resource.TestCheckResourceAttr(tfNode, "auto_provision", strconv.FormatBool(false)),
+				),
+			},
+		},
+	})
+}
+
+// HCL describing a lookup of the agent pool created by the configuration
+func testAccAgentPoolDataSource(poolName string) string {
+	dataSource := `
+data "azuredevops_agent_pool" "pool" {
+	name = azuredevops_agent_pool.pool.name
+}`
+
+	return fmt.Sprintf("%s\n%s", testAccAgentPoolResource(poolName, false), dataSource)
+}
@@ -0,0 +1,88 @@
+package azuredevops
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+)
+
+func dataAgentPools() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAgentPoolsRead,
+		Schema: map[string]*schema.Schema{
+			"agent_pools": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"pool_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"auto_provision": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAgentPoolsRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+
+	agentPools, err := clients.TaskAgentClient.GetAgentPools(clients.ctx, taskagent.GetAgentPoolsArgs{})
+	if err != nil {
+		return fmt.Errorf("Error listing agent pools. Error: %v", err)
+	}
+
+	d.SetId("agentpools")
+	return d.Set("agent_pools", flattenAgentPools(agentPools))
+}
+
+// Convert AzDO data structure to internal Terraform data structure. Pools are sorted by name so that the order of
+// the list does not depend on the order in which the service returns them.
+func flattenAgentPools(agentPools *[]taskagent.TaskAgentPool) []interface{} {
+	if agentPools == nil {
+		return []interface{}{}
+	}
+
+	var pools []taskagent.TaskAgentPool
+	for _, agentPool := range *agentPools {
+		if agentPool.Id != nil {
+			pools = append(pools, agentPool)
+		}
+	}
+	sort.SliceStable(pools, func(i, j int) bool {
+		return strings.ToLower(converter.ToString(pools[i].Name, "")) < strings.ToLower(converter.ToString(pools[j].Name, ""))
+	})
+
+	results := make([]interface{}, 0, len(pools))
+	for _, agentPool := range pools {
+		poolType := ""
+		if agentPool.PoolType != nil {
+			poolType = string(*agentPool.PoolType)
+		}
+		results = append(results, map[string]interface{}{
+			"id":             *agentPool.Id,
+			"name":           converter.ToString(agentPool.Name, ""),
+			"pool_type":      poolType,
+			"auto_provision": converter.ToBool(agentPool.AutoProvision, false),
+		})
+	}
+	return results
+}
@@ -0,0 +1,123 @@
+package azuredevops
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that all pools are listed and exported in a stable order
+func TestAgentPoolsDataSource_Read_ListsPoolsSortedByName(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskAgentClient := azdosdkmocks.NewMockTaskagentClient(ctrl)
+	clients := &aggregatedClient{TaskAgentClient: taskAgentClient, ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, dataAgentPools().Schema, nil)
+
+	automation := taskagent.TaskAgentPoolTypeValues.Automation
+	taskAgentClient.
+		EXPECT().
+		GetAgentPools(clients.ctx, taskagent.GetAgentPoolsArgs{}).
+		Return(&[]taskagent.TaskAgentPool{
+			{Id: converter.Int(9), Name: converter.String("Hosted Ubuntu 1604"), PoolType: &automation, AutoProvision: converter.Bool(true)},
+			{Id: converter.Int(1), Name: converter.String("Default"), PoolType: &automation, AutoProvision: converter.Bool(false)},
+		}, nil).
+		Times(1)
+
+	err := dataSourceAgentPoolsRead(resourceData, clients)
+	require.Nil(t, err)
+	require.NotEqual(t, "", resourceData.Id())
+	require.Equal(t, 2, resourceData.Get("agent_pools.#"))
+	require.Equal(t, 1, resourceData.Get("agent_pools.0.id"))
+	require.Equal(t, "Default", resourceData.Get("agent_pools.0.name"))
+	require.Equal(t, false, resourceData.Get("agent_pools.0.auto_provision"))
+	require.Equal(t, 9, resourceData.Get("agent_pools.1.id"))
+	require.Equal(t, "Hosted Ubuntu 1604", resourceData.Get("agent_pools.1.name"))
+	require.Equal(t, string(automation), resourceData.Get("agent_pools.1.pool_type"))
+	require.Equal(t, true, resourceData.Get("agent_pools.1.auto_provision"))
+}
+
+// verifies that the agent pool listing functionality has proper error handling
+func TestAgentPoolsDataSource_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskAgentClient := azdosdkmocks.NewMockTaskagentClient(ctrl)
+	clients := &aggregatedClient{TaskAgentClient: taskAgentClient, ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, dataAgentPools().Schema, nil)
+
+	taskAgentClient.
+		EXPECT().
+		GetAgentPools(clients.ctx, gomock.Any()).
+		Return(nil, errors.New("GetAgentPools() Failed")).
+		Times(1)
+
+	err := dataSourceAgentPoolsRead(resourceData, clients)
+	require.Contains(t, err.Error(), "GetAgentPools() Failed")
+}
+
+/**
+ * Begin acceptance tests
+ */
+
+// Validates that a configuration containing an agent pool listing contains the pool created by the configuration
+func TestAccAgentPoolsDataSource_Read_HappyPath(t *testing.T) {
+	poolName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAgentPoolsDataSource(poolName),
+				Check:  testAccCheckAgentPoolsContain("data.azuredevops_agent_pools.pools", poolName),
+			},
+		},
+	})
+}
+
+// HCL describing a listing of all agent pools, which depends on the agent pool created by the configuration
+func testAccAgentPoolsDataSource(poolName string) string {
+	dataSource := `
+data "azuredevops_agent_pools" "pools" {
+	depends_on = [azuredevops_agent_pool.pool]
+}`
+
+	return fmt.Sprintf("%s\n%s", testAccAgentPoolResource(poolName, false), dataSource)
+}
+
+// verifies that the listing of agent pools contains a pool with the given name
+func testAccCheckAgentPoolsContain(tfNode string, poolName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		res, ok := s.RootModule().Resources[tfNode]
+		if !ok {
+			return fmt.Errorf("Did not find the agent pools in the TF state")
+		}
+
+		for key, value := range res.Primary.Attributes {
+			if value == poolName && strings.HasSuffix(key, ".name") {
+				return nil
+			}
+		}
+		return fmt.Errorf("Agent pool %s is not contained in the listing", poolName)
+	}
+}
@@ -0,0 +1,259 @@
+package azuredevops
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/build"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+)
+
+func dataBuildDefinition() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceBuildDefinitionRead,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ValidateFunc:  validation.NoZeroValues,
+				ConflictsWith: []string{"definition_id"},
+			},
+			"definition_id": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				ValidateFunc:  validation.IntAtLeast(1),
+				ConflictsWith: []string{"name"},
+			},
+			"path": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"revision": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"agent_pool_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"repository": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"repo_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"repo_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"repo_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"branch_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"yml_path": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"variable": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"is_secret": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"allow_override": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Performs a lookup of a build definition by either its name or its ID. Lookups by name can be narrowed down
+// to a folder by specifying the path of the build definition.
+func dataSourceBuildDefinitionRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	projectID := d.Get("project_id").(string)
+	name := d.Get("name").(string)
+	definitionID := d.Get("definition_id").(int)
+
+	if (name == "") == (definitionID == 0) {
+		return fmt.Errorf("Exactly one of name or definition_id must be specified")
+	}
+
+	if definitionID == 0 {
+		definitionRef, err := findBuildDefinitionByName(clients, projectID, name, d.Get("path").(string))
+		if err != nil {
+			return err
+		}
+		definitionID = *definitionRef.Id
+	}
+
+	buildDefinition, err := clients.BuildClient.GetDefinition(clients.ctx, build.GetDefinitionArgs{
+		Project:      &projectID,
+		DefinitionId: &definitionID,
+	})
+	if err != nil {
+		return fmt.Errorf("Error looking up build definition with ID %d in project %s. Error: %v", definitionID, projectID, err)
+	}
+
+	d.SetId(strconv.Itoa(*buildDefinition.Id))
+	d.Set("definition_id", *buildDefinition.Id)
+	d.Set("name", converter.ToString(buildDefinition.Name, ""))
+	d.Set("path", converter.ToString(buildDefinition.Path, ""))
+	d.Set("revision", buildDefinition.Revision)
+	d.Set("agent_pool_name", flattenAgentPoolName(buildDefinition))
+	d.Set("repository", flattenBuildDefinitionRepositoryReference(buildDefinition))
+	d.Set("variable", flattenBuildDefinitionVariableReferences(buildDefinition))
+	return nil
+}
+
+// Finds the single build definition with the given name. Build definition names are only unique within a folder,
+// so the lookup fails if the name is ambiguous and no path has been given.
+func findBuildDefinitionByName(clients *aggregatedClient, projectID string, name string, path string) (*build.BuildDefinitionReference, error) {
+	var matches []build.BuildDefinitionReference
+	var continuationToken string
+
+	for hasMore := true; hasMore; {
+		args := build.GetDefinitionsArgs{
+			Project: &projectID,
+			Name:    &name,
+		}
+		if path != "" {
+			args.Path = converter.String(normalizeBuildDefinitionPath(path))
+		}
+		if continuationToken != "" {
+			args.ContinuationToken = &continuationToken
+		}
+
+		response, err := clients.BuildClient.GetDefinitions(clients.ctx, args)
+		if err != nil {
+			return nil, fmt.Errorf("Error listing build definitions in project %s. Error: %v", projectID, err)
+		}
+
+		for _, definition := range response.Value {
+			if !strings.EqualFold(converter.ToString(definition.Name, ""), name) {
+				continue
+			}
+			if path != "" && !strings.EqualFold(normalizeBuildDefinitionPath(converter.ToString(definition.Path, "")), normalizeBuildDefinitionPath(path)) {
+				continue
+			}
+			matches = append(matches, definition)
+		}
+
+		continuationToken = response.ContinuationToken
+		hasMore = continuationToken != ""
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("Could not find build definition with name %s in project %s", name, projectID)
+	case 1:
+		return &matches[0], nil
+	default:
+		paths := make([]string, len(matches))
+		for i, match := range matches {
+			paths[i] = converter.ToString(match.Path, "")
+		}
+		return nil, fmt.Errorf("Found multiple build definitions with name %s in project %s (paths: %s). Specify the path to select one", name, projectID, strings.Join(paths, ", "))
+	}
+}
+
+// Azure DevOps stores folder paths with backslashes and a leading separator (e.g. \Folder\Subfolder), while
+// users often write them with forward slashes. This converts a path into the form used by the service.
+func normalizeBuildDefinitionPath(path string) string {
+	path = strings.ReplaceAll(path, "/", `\`)
+	path = strings.Trim(path, `\`)
+	return `\` + path
+}
+
+func flattenAgentPoolName(buildDefinition *build.BuildDefinition) string {
+	if buildDefinition.Queue == nil || buildDefinition.Queue.Pool == nil {
+		return ""
+	}
+	return converter.ToString(buildDefinition.Queue.Pool.Name, "")
+}
+
+func flattenBuildDefinitionRepositoryReference(buildDefinition *build.BuildDefinition) []interface{} {
+	if buildDefinition.Repository == nil {
+		return nil
+	}
+
+	repository := buildDefinition.Repository
+	return []interface{}{map[string]interface{}{
+		"repo_id":     converter.ToString(repository.Id, ""),
+		"repo_name":   converter.ToString(repository.Name, ""),
+		"repo_type":   converter.ToString(repository.Type, ""),
+		"branch_name": converter.ToString(repository.DefaultBranch, ""),
+		"yml_path":    flattenYamlFilePath(buildDefinition),
+	}}
+}
+
+// Flattens the variables of a build definition. The values of secret variables are never returned by the
+// service, so only their names and settings are exported.
+func flattenBuildDefinitionVariableReferences(buildDefinition *build.BuildDefinition) []interface{} {
+	if buildDefinition.Variables == nil {
+		return nil
+	}
+
+	names := make([]string, 0, len(*buildDefinition.Variables))
+	for name := range *buildDefinition.Variables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	variables := make([]interface{}, len(names))
+	for i, name := range names {
+		variable := (*buildDefinition.Variables)[name]
+		isSecret := variable.IsSecret != nil && *variable.IsSecret
+
+		value := ""
+		if !isSecret {
+			value = converter.ToString(variable.Value, "")
+		}
+
+		variables[i] = map[string]interface{}{
+			"name":           name,
+			"value":          value,
+			"is_secret":      isSecret,
+			"allow_override": variable.AllowOverride != nil && *variable.AllowOverride,
+		}
+	}
+	return variables
+}
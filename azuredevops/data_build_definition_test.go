@@ -0,0 +1,207 @@
+package azuredevops
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/build"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that a build definition in a folder can be looked up by name and path
+func TestBuildDefinitionDataSource_Read_UsesNameAndPath(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	buildClient := azdosdkmocks.NewMockBuildClient(ctrl)
+	clients := &aggregatedClient{BuildClient: buildClient, ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, dataBuildDefinition().Schema, nil)
+	resourceData.Set("project_id", testProjectID)
+	resourceData.Set("name", "Name")
+	resourceData.Set("path", "/Folder/Sub/")
+
+	buildClient.
+		EXPECT().
+		GetDefinitions(clients.ctx, build.GetDefinitionsArgs{
+			Project: &testProjectID,
+			Name:    converter.String("Name"),
+			Path:    converter.String(`\Folder\Sub`),
+		}).
+		Return(&build.GetDefinitionsResponseValue{
+			Value: []build.BuildDefinitionReference{{Id: converter.Int(100), Name: converter.String("Name"), Path: converter.String(`\Folder\Sub`)}},
+		}, nil).
+		Times(1)
+
+	definition := testBuildDefinition
+	definition.Path = converter.String(`\Folder\Sub`)
+	definition.Variables = &map[string]build.BuildDefinitionVariable{
+		"b": {Value: converter.String("not a secret"), AllowOverride: converter.Bool(true)},
+		"a": {Value: converter.String("secret"), IsSecret: converter.Bool(true)},
+	}
+	buildClient.
+		EXPECT().
+		GetDefinition(clients.ctx, build.GetDefinitionArgs{Project: &testProjectID, DefinitionId: converter.Int(100)}).
+		Return(&definition, nil).
+		Times(1)
+
+	err := dataSourceBuildDefinitionRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "100", resourceData.Id())
+	require.Equal(t, `\Folder\Sub`, resourceData.Get("path"))
+	require.Equal(t, "BuildPoolName", resourceData.Get("agent_pool_name"))
+	require.Equal(t, "RepoId", resourceData.Get("repository.0.repo_id"))
+	require.Equal(t, "GitHub", resourceData.Get("repository.0.repo_type"))
+	require.Equal(t, "RepoBranchName", resourceData.Get("repository.0.branch_name"))
+	require.Equal(t, "YamlFilename", resourceData.Get("repository.0.yml_path"))
+
+	require.Equal(t, "a", resourceData.Get("variable.0.name"))
+	require.Equal(t, "", resourceData.Get("variable.0.value"))
+	require.Equal(t, true, resourceData.Get("variable.0.is_secret"))
+	require.Equal(t, "b", resourceData.Get("variable.1.name"))
+	require.Equal(t, "not a secret", resourceData.Get("variable.1.value"))
+	require.Equal(t, true, resourceData.Get("variable.1.allow_override"))
+}
+
+// verifies that the lookup by ID does not list build definitions
+func TestBuildDefinitionDataSource_Read_UsesDefinitionID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	buildClient := azdosdkmocks.NewMockBuildClient(ctrl)
+	clients := &aggregatedClient{BuildClient: buildClient, ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, dataBuildDefinition().Schema, nil)
+	resourceData.Set("project_id", testProjectID)
+	resourceData.Set("definition_id", 100)
+
+	buildClient.
+		EXPECT().
+		GetDefinition(clients.ctx, build.GetDefinitionArgs{Project: &testProjectID, DefinitionId: converter.Int(100)}).
+		Return(&testBuildDefinition, nil).
+		Times(1)
+
+	err := dataSourceBuildDefinitionRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "Name", resourceData.Get("name"))
+}
+
+// verifies that a name which exists in several folders is reported as ambiguous
+func TestBuildDefinitionDataSource_Read_FailsIfNameIsAmbiguous(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	buildClient := azdosdkmocks.NewMockBuildClient(ctrl)
+	clients := &aggregatedClient{BuildClient: buildClient, ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, dataBuildDefinition().Schema, nil)
+	resourceData.Set("project_id", testProjectID)
+	resourceData.Set("name", "Name")
+
+	buildClient.
+		EXPECT().
+		GetDefinitions(clients.ctx, gomock.Any()).
+		Return(&build.GetDefinitionsResponseValue{
+			Value: []build.BuildDefinitionReference{
+				{Id: converter.Int(1), Name: converter.String("Name"), Path: converter.String(`\`)},
+				{Id: converter.Int(2), Name: converter.String("Name"), Path: converter.String(`\Folder`)},
+			},
+		}, nil).
+		Times(1)
+
+	err := dataSourceBuildDefinitionRead(resourceData, clients)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "Found multiple build definitions")
+}
+
+// verifies that exactly one of name or definition_id has to be given
+func TestBuildDefinitionDataSource_Read_RequiresExactlyOneIdentifier(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, dataBuildDefinition().Schema, nil)
+	resourceData.Set("project_id", testProjectID)
+
+	err := dataSourceBuildDefinitionRead(resourceData, &aggregatedClient{})
+	require.NotNil(t, err)
+}
+
+// verifies that the build definition lookup functionality has proper error handling
+func TestBuildDefinitionDataSource_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	buildClient := azdosdkmocks.NewMockBuildClient(ctrl)
+	clients := &aggregatedClient{BuildClient: buildClient, ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, dataBuildDefinition().Schema, nil)
+	resourceData.Set("project_id", testProjectID)
+	resourceData.Set("name", "Name")
+
+	buildClient.
+		EXPECT().
+		GetDefinitions(clients.ctx, gomock.Any()).
+		Return(nil, errors.New("GetDefinitions() Failed")).
+		Times(1)
+
+	err := dataSourceBuildDefinitionRead(resourceData, clients)
+	require.Contains(t, err.Error(), "GetDefinitions() Failed")
+}
+
+// verifies that folder paths are converted into the form used by the service
+func TestBuildDefinitionDataSource_NormalizePath(t *testing.T) {
+	require.Equal(t, `\`, normalizeBuildDefinitionPath(`\`))
+	require.Equal(t, `\`, normalizeBuildDefinitionPath("/"))
+	require.Equal(t, `\Folder\Sub`, normalizeBuildDefinitionPath("Folder/Sub"))
+	require.Equal(t, `\Folder\Sub`, normalizeBuildDefinitionPath(`\Folder\Sub\`))
+}
+
+/**
+ * Begin acceptance tests
+ */
+
+// Validates that a configuration containing a build definition lookup is able to read the resource correctly.
+// Because this is a data source, there are no resources to inspect in AzDO
+func TestAccBuildDefinitionDataSource_Read_HappyPath(t *testing.T) {
+	projectName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	buildDefinitionName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	tfNode := "data.azuredevops_build_definition.build"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBuildDefinitionDataSource(projectName, buildDefinitionName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "name", buildDefinitionName),
+					resource.TestCheckResourceAttrPair(tfNode, "id", "azuredevops_build_definition.build", "id"),
+					resource.TestCheckResourceAttr(tfNode, "path", `\`),
+					resource.TestCheckResourceAttr(tfNode, "repository.0.repo_type", "GitHub"),
+					resource.TestCheckResourceAttr(tfNode, "repository.0.yml_path", "path/to/yaml"),
+				),
+			},
+		},
+	})
+}
+
+// HCL describing a build definition lookup by name
+func testAccBuildDefinitionDataSource(projectName string, buildDefinitionName string) string {
+	dataSource := `
+data "azuredevops_build_definition" "build" {
+	project_id = azuredevops_project.project.id
+	name       = azuredevops_build_definition.build.name
+}`
+
+	buildDefinitionResource := testAccBuildDefinitionResource(projectName, buildDefinitionName)
+	return fmt.Sprintf("%s\n%s", buildDefinitionResource, dataSource)
+}
@@ -0,0 +1,44 @@
+package azuredevops
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// Exposes how the provider is configured, e.g. to verify which organization a run targets when the
+// configuration is spread across environment variables and the provider block. Secrets are never exported.
+func dataClientConfig() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceClientConfigRead,
+		Schema: map[string]*schema.Schema{
+			"organization_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"auth_method": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"personal_access_token_configured": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"aad_token_configured": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceClientConfigRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+
+	d.SetId(fmt.Sprintf("clientconfig-%s", clients.organizationURL))
+	d.Set("organization_url", clients.organizationURL)
+	d.Set("auth_method", clients.authMethod)
+	d.Set("personal_access_token_configured", clients.authMethod == authMethodPersonalAccessToken)
+	d.Set("aad_token_configured", clients.authMethod == authMethodAADToken)
+	return nil
+}
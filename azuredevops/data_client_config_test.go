@@ -0,0 +1,64 @@
+package azuredevops
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that the organization and the method of authentication are exported
+func TestClientConfigDataSource_Read_ExportsConfiguration(t *testing.T) {
+	clients := &aggregatedClient{
+		organizationURL: "https://dev.azure.com/org",
+		authMethod:      authMethodAADToken,
+		ctx:             context.Background(),
+	}
+	resourceData := schema.TestResourceDataRaw(t, dataClientConfig().Schema, nil)
+
+	err := dataSourceClientConfigRead(resourceData, clients)
+	require.Nil(t, err)
+	require.NotEqual(t, "", resourceData.Id())
+	require.Equal(t, "https://dev.azure.com/org", resourceData.Get("organization_url"))
+	require.Equal(t, authMethodAADToken, resourceData.Get("auth_method"))
+	require.Equal(t, false, resourceData.Get("personal_access_token_configured"))
+	require.Equal(t, true, resourceData.Get("aad_token_configured"))
+}
+
+// verifies that the method of authentication matches the credential that is configured
+func TestClientConfig_AuthMethod_MatchesConfiguredCredential(t *testing.T) {
+	require.Equal(t, authMethodPersonalAccessToken, (&authSettings{personalAccessToken: "pat"}).method())
+	require.Equal(t, authMethodAADToken, (&authSettings{aadToken: "token"}).method())
+	require.Equal(t, authMethodManagedIdentity, (&authSettings{useMSI: true, msiClientID: "client"}).method())
+}
+
+/**
+ * Begin acceptance tests
+ */
+
+// validates that the organization configured for the acceptance tests is the one the provider is connected to
+func TestAccClientConfigDataSource_Read(t *testing.T) {
+	tfNode := "data.azuredevops_client_config.config"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "azuredevops_client_config" "config" {}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "organization_url", strings.ToLower(strings.TrimRight(os.Getenv("AZDO_ORG_SERVICE_URL"), "/"))),
+					resource.TestCheckResourceAttr(tfNode, "auth_method", authMethodPersonalAccessToken),
+					resource.TestCheckResourceAttr(tfNode, "personal_access_token_configured", "true"),
+				),
+			},
+		},
+	})
+}
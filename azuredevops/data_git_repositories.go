@@ -0,0 +1,133 @@
+package azuredevops
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/git"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/gitrepository"
+)
+
+func dataGitRepositories() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGitRepositoriesRead,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"include_hidden": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"repositories": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"default_branch": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"size": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"disabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"is_fork": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Lists the repositories of a project. The service hides disabled repositories unless hidden repositories are
+// requested, and only reports which of them are disabled through the repository states.
+func dataSourceGitRepositoriesRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	projectID := d.Get("project_id").(string)
+	includeHidden := d.Get("include_hidden").(bool)
+
+	repos, err := clients.GitReposClient.GetRepositories(clients.ctx, git.GetRepositoriesArgs{
+		Project:       converter.String(projectID),
+		IncludeHidden: converter.Bool(includeHidden),
+	})
+	if err != nil {
+		return fmt.Errorf("Error listing repositories in project %s. Error: %v", projectID, err)
+	}
+
+	disabledRepos := map[string]bool{}
+	if includeHidden {
+		states, err := clients.GitRepositoryClient.GetRepositoryStates(clients.ctx, gitrepository.GetRepositoryStatesArgs{
+			Project:       converter.String(projectID),
+			IncludeHidden: converter.Bool(true),
+		})
+		if err != nil {
+			return fmt.Errorf("Error looking up the disabled repositories in project %s. Error: %v", projectID, err)
+		}
+		for _, state := range *states {
+			if state.Id != nil && state.IsDisabled != nil && *state.IsDisabled {
+				disabledRepos[state.Id.String()] = true
+			}
+		}
+	}
+
+	d.SetId("repositories-" + projectID)
+	return d.Set("repositories", flattenGitRepositories(repos, disabledRepos))
+}
+
+// Convert AzDO data structure to internal Terraform data structure. Repositories are sorted by name so that the
+// order of the list does not depend on the order in which the service returns them.
+func flattenGitRepositories(gitRepos *[]git.GitRepository, disabledRepos map[string]bool) []interface{} {
+	if gitRepos == nil {
+		return []interface{}{}
+	}
+
+	var repos []git.GitRepository
+	for _, repo := range *gitRepos {
+		if repo.Id != nil {
+			repos = append(repos, repo)
+		}
+	}
+	sort.SliceStable(repos, func(i, j int) bool {
+		return strings.ToLower(converter.ToString(repos[i].Name, "")) < strings.ToLower(converter.ToString(repos[j].Name, ""))
+	})
+
+	results := make([]interface{}, 0, len(repos))
+	for _, repo := range repos {
+		size := 0
+		if repo.Size != nil {
+			size = int(*repo.Size)
+		}
+		results = append(results, map[string]interface{}{
+			"id":             repo.Id.String(),
+			"name":           converter.ToString(repo.Name, ""),
+			"default_branch": converter.ToString(repo.DefaultBranch, ""),
+			"size":           size,
+			"disabled":       disabledRepos[repo.Id.String()],
+			"is_fork":        repo.IsFork != nil && *repo.IsFork,
+		})
+	}
+	return results
+}
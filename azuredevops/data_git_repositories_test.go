@@ -0,0 +1,189 @@
+package azuredevops
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/git"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/gitrepository"
+	"github.com/stretchr/testify/require"
+)
+
+var testGitRepositoriesProjectID = uuid.New().String()
+var testGitRepositoriesEnabledID = uuid.New()
+var testGitRepositoriesDisabledID = uuid.New()
+var testGitRepositoriesSize = uint64(1024)
+
+var testGitRepositories = []git.GitRepository{
+	{
+		Id:            &testGitRepositoriesEnabledID,
+		Name:          converter.String("zeta"),
+		DefaultBranch: converter.String("refs/heads/master"),
+		Size:          &testGitRepositoriesSize,
+		IsFork:        converter.Bool(true),
+	},
+	{
+		Id:   &testGitRepositoriesDisabledID,
+		Name: converter.String("Alpha"),
+	},
+	// repositories without an ID are skipped
+	{Name: converter.String("invalid")},
+}
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that the repositories are sorted by name and that the disabled ones are reported as such
+func TestGitRepositoriesDataSource_Read_IncludesHiddenRepositories(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	repositoryClient := azdosdkmocks.NewMockGitRepositoryClient(ctrl)
+	clients := &aggregatedClient{GitReposClient: reposClient, GitRepositoryClient: repositoryClient, ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, dataGitRepositories().Schema, map[string]interface{}{
+		"project_id":     testGitRepositoriesProjectID,
+		"include_hidden": true,
+	})
+
+	reposClient.
+		EXPECT().
+		GetRepositories(clients.ctx, git.GetRepositoriesArgs{
+			Project:       converter.String(testGitRepositoriesProjectID),
+			IncludeHidden: converter.Bool(true),
+		}).
+		Return(&testGitRepositories, nil).
+		Times(1)
+	repositoryClient.
+		EXPECT().
+		GetRepositoryStates(clients.ctx, gitrepository.GetRepositoryStatesArgs{
+			Project:       converter.String(testGitRepositoriesProjectID),
+			IncludeHidden: converter.Bool(true),
+		}).
+		Return(&[]gitrepository.RepositoryState{
+			{Id: &testGitRepositoriesEnabledID, IsDisabled: converter.Bool(false)},
+			{Id: &testGitRepositoriesDisabledID, IsDisabled: converter.Bool(true)},
+		}, nil).
+		Times(1)
+
+	err := dataSourceGitRepositoriesRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "repositories-"+testGitRepositoriesProjectID, resourceData.Id())
+	require.Equal(t, []interface{}{
+		map[string]interface{}{
+			"id":             testGitRepositoriesDisabledID.String(),
+			"name":           "Alpha",
+			"default_branch": "",
+			"size":           0,
+			"disabled":       true,
+			"is_fork":        false,
+		},
+		map[string]interface{}{
+			"id":             testGitRepositoriesEnabledID.String(),
+			"name":           "zeta",
+			"default_branch": "refs/heads/master",
+			"size":           1024,
+			"disabled":       false,
+			"is_fork":        true,
+		},
+	}, resourceData.Get("repositories"))
+}
+
+// verifies that the states of the repositories are not looked up if hidden repositories are not listed
+func TestGitRepositoriesDataSource_Read_ExcludesHiddenRepositoriesByDefault(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	repositoryClient := azdosdkmocks.NewMockGitRepositoryClient(ctrl)
+	clients := &aggregatedClient{GitReposClient: reposClient, GitRepositoryClient: repositoryClient, ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, dataGitRepositories().Schema, map[string]interface{}{
+		"project_id": testGitRepositoriesProjectID,
+	})
+
+	reposClient.
+		EXPECT().
+		GetRepositories(clients.ctx, git.GetRepositoriesArgs{
+			Project:       converter.String(testGitRepositoriesProjectID),
+			IncludeHidden: converter.Bool(false),
+		}).
+		Return(&[]git.GitRepository{testGitRepositories[0]}, nil).
+		Times(1)
+
+	err := dataSourceGitRepositoriesRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Len(t, resourceData.Get("repositories"), 1)
+	require.Equal(t, false, resourceData.Get("repositories.0.disabled"))
+}
+
+// verifies that the repositories lookup functionality has proper error handling
+func TestGitRepositoriesDataSource_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &aggregatedClient{GitReposClient: reposClient, ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, dataGitRepositories().Schema, map[string]interface{}{
+		"project_id": testGitRepositoriesProjectID,
+	})
+
+	reposClient.
+		EXPECT().
+		GetRepositories(clients.ctx, gomock.Any()).
+		Return(nil, errors.New("GetRepositories() Failed")).
+		Times(1)
+
+	err := dataSourceGitRepositoriesRead(resourceData, clients)
+	require.Contains(t, err.Error(), "GetRepositories() Failed")
+}
+
+/**
+ * Begin acceptance tests
+ */
+
+// validates that the repositories of a project, including its default repository, are listed
+func TestAccGitRepositoriesDataSource_Read(t *testing.T) {
+	projectName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	gitRepoName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	tfNode := "data.azuredevops_git_repositories.repositories"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGitRepositoriesDataSource(projectName, gitRepoName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "repositories.#", "2"),
+					resource.TestCheckResourceAttrSet(tfNode, "repositories.0.id"),
+					resource.TestCheckResourceAttr(tfNode, "repositories.0.disabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+// HCL describing a data source listing the repositories of a project with an additional repository
+func testAccGitRepositoriesDataSource(projectName string, gitRepoName string) string {
+	dataSource := `
+data "azuredevops_git_repositories" "repositories" {
+	project_id     = azuredevops_azure_git_repository.gitrepo.project_id
+	include_hidden = true
+}`
+
+	gitRepoResource := testAccAzureGitRepoResource(projectName, gitRepoName)
+	return fmt.Sprintf("%s\n%s", gitRepoResource, dataSource)
+}
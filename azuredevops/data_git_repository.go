@@ -0,0 +1,84 @@
+package azuredevops
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func dataGitRepository() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGitRepositoryRead,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ValidateFunc:  validation.NoZeroValues,
+				ConflictsWith: []string{"repository_id"},
+			},
+			"repository_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ValidateFunc:  validation.NoZeroValues,
+				ConflictsWith: []string{"name"},
+			},
+			"default_branch": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"is_fork": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"remote_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"ssh_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"web_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Performs a lookup of a repository by either its name or its ID
+func dataSourceGitRepositoryRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	projectID := d.Get("project_id").(string)
+	repoName := d.Get("name").(string)
+	repoID := d.Get("repository_id").(string)
+
+	if (repoName == "") == (repoID == "") {
+		return fmt.Errorf("Exactly one of name or repository_id must be specified")
+	}
+
+	repo, err := azureGitRepositoryRead(clients, repoID, repoName, projectID)
+	if err != nil {
+		return fmt.Errorf("Error looking up repository with ID %s and Name %s in project with ID %s. Error: %v", repoID, repoName, projectID, err)
+	}
+
+	flattenAzureGitRepository(d, repo)
+	d.Set("repository_id", repo.Id.String())
+	return nil
+}
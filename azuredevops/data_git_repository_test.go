@@ -0,0 +1,155 @@
+package azuredevops
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/git"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that a repository can be looked up by its ID
+func TestGitRepositoryDataSource_Read_UsesRepositoryID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &aggregatedClient{GitReposClient: reposClient, ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, dataGitRepository().Schema, nil)
+	resourceData.Set("project_id", testRepoProjectID.String())
+	resourceData.Set("repository_id", testRepoID.String())
+
+	size := uint64(1024)
+	repo := testAzureGitRepository
+	repo.DefaultBranch = converter.String("refs/heads/master")
+	repo.Size = &size
+
+	expectedArgs := git.GetRepositoryArgs{RepositoryId: converter.String(testRepoID.String()), Project: converter.String(testRepoProjectID.String())}
+	reposClient.
+		EXPECT().
+		GetRepository(clients.ctx, expectedArgs).
+		Return(&repo, nil).
+		Times(1)
+
+	err := dataSourceGitRepositoryRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, testRepoID.String(), resourceData.Id())
+	require.Equal(t, "RepoName", resourceData.Get("name"))
+	require.Equal(t, "refs/heads/master", resourceData.Get("default_branch"))
+	require.Equal(t, 1024, resourceData.Get("size"))
+}
+
+// verifies that a repository can be looked up by its name
+func TestGitRepositoryDataSource_Read_UsesName(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &aggregatedClient{GitReposClient: reposClient, ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, dataGitRepository().Schema, nil)
+	resourceData.Set("project_id", testRepoProjectID.String())
+	resourceData.Set("name", "RepoName")
+
+	expectedArgs := git.GetRepositoryArgs{RepositoryId: converter.String("RepoName"), Project: converter.String(testRepoProjectID.String())}
+	reposClient.
+		EXPECT().
+		GetRepository(clients.ctx, expectedArgs).
+		Return(&testAzureGitRepository, nil).
+		Times(1)
+
+	err := dataSourceGitRepositoryRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, testRepoID.String(), resourceData.Get("repository_id"))
+}
+
+// verifies that exactly one of name or repository_id has to be given
+func TestGitRepositoryDataSource_Read_RequiresExactlyOneIdentifier(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, dataGitRepository().Schema, nil)
+	resourceData.Set("project_id", testRepoProjectID.String())
+
+	err := dataSourceGitRepositoryRead(resourceData, &aggregatedClient{})
+	require.NotNil(t, err)
+
+	resourceData.Set("name", "RepoName")
+	resourceData.Set("repository_id", testRepoID.String())
+
+	err = dataSourceGitRepositoryRead(resourceData, &aggregatedClient{})
+	require.NotNil(t, err)
+}
+
+// verifies that the repository lookup functionality has proper error handling
+func TestGitRepositoryDataSource_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &aggregatedClient{GitReposClient: reposClient, ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, dataGitRepository().Schema, nil)
+	resourceData.Set("project_id", testRepoProjectID.String())
+	resourceData.Set("name", "RepoName")
+
+	reposClient.
+		EXPECT().
+		GetRepository(clients.ctx, gomock.Any()).
+		Return(nil, errors.New("GetRepository() Failed")).
+		Times(1)
+
+	err := dataSourceGitRepositoryRead(resourceData, clients)
+	require.Contains(t, err.Error(), "GetRepository() Failed")
+}
+
+/**
+ * Begin acceptance tests
+ */
+
+// Validates that a configuration containing a repository lookup is able to read the resource correctly.
+// Because this is a data source, there are no resources to inspect in AzDO
+func TestAccGitRepositoryDataSource_Read_HappyPath(t *testing.T) {
+	projectName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	gitRepoName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	tfNode := "data.azuredevops_git_repository.repo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGitRepositoryDataSource(projectName, gitRepoName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "name", gitRepoName),
+					resource.TestCheckResourceAttrPair(tfNode, "id", "azuredevops_azure_git_repository.gitrepo", "id"),
+					resource.TestCheckResourceAttrSet(tfNode, "remote_url"),
+					resource.TestCheckResourceAttrSet(tfNode, "ssh_url"),
+					resource.TestCheckResourceAttrSet(tfNode, "web_url"),
+				),
+			},
+		},
+	})
+}
+
+// HCL describing a repository lookup by name
+func testAccGitRepositoryDataSource(projectName string, gitRepoName string) string {
+	dataSource := `
+data "azuredevops_git_repository" "repo" {
+	project_id = azuredevops_project.project.id
+	name       = azuredevops_azure_git_repository.gitrepo.name
+}`
+
+	gitRepoResource := testAccAzureGitRepoResource(projectName, gitRepoName)
+	return fmt.Sprintf("%s\n%s", gitRepoResource, dataSource)
+}
@@ -94,7 +94,11 @@ func getGroupsForDescriptor(clients *aggregatedClient, projectDescriptor string)
 }
 
 func getGroupsWithContinuationToken(clients *aggregatedClient, projectDescriptor string, continuationToken string) (*[]graph.GraphGroup, string, error) {
-	args := graph.ListGroupsArgs{ScopeDescriptor: &projectDescriptor}
+	// the groups of the whole organization are listed if no scope is given
+	args := graph.ListGroupsArgs{}
+	if projectDescriptor != "" {
+		args.ScopeDescriptor = &projectDescriptor
+	}
 	if continuationToken != "" {
 		args.ContinuationToken = &continuationToken
 	}
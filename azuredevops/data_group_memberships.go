@@ -0,0 +1,199 @@
+package azuredevops
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/graph"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+)
+
+// The directions in which memberships are traversed. Down lists the members of a group, while up lists the
+// groups a subject is a member of.
+const (
+	membershipDirectionUp   = "up"
+	membershipDirectionDown = "down"
+)
+
+func dataGroupMemberships() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGroupMembershipsRead,
+		Schema: map[string]*schema.Schema{
+			"group": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"direction": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      membershipDirectionDown,
+				ValidateFunc: validation.StringInSlice([]string{membershipDirectionUp, membershipDirectionDown}, false),
+			},
+			"depth": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"members": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"descriptor": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"display_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"subject_kind": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Lists the memberships of a group. The service only returns direct memberships, so nested groups are
+// traversed level by level until the configured depth is reached. A depth of 0 traverses all levels.
+func dataSourceGroupMembershipsRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	group := d.Get("group").(string)
+	direction := d.Get("direction").(string)
+	depth := d.Get("depth").(int)
+
+	subjects, err := getGroupMembershipSubjects(clients, group, direction, depth)
+	if err != nil {
+		return fmt.Errorf("Error listing the memberships of group %s. Error: %v", group, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%d", group, direction, depth))
+	if err := d.Set("members", flattenGroupMembershipSubjects(subjects)); err != nil {
+		return fmt.Errorf("Error setting members of group %s: %+v", group, err)
+	}
+	return nil
+}
+
+// Returns the subjects related to a group in the given direction. Every subject is returned once, even if it is
+// reachable through several nested groups, and the group itself is never part of the result.
+func getGroupMembershipSubjects(clients *aggregatedClient, group string, direction string, depth int) (map[string]graph.GraphSubject, error) {
+	subjects := map[string]graph.GraphSubject{}
+	visited := map[string]bool{group: true}
+	pending := []string{group}
+
+	for level := 1; len(pending) > 0 && (depth == 0 || level <= depth); level++ {
+		var discovered []string
+		for _, descriptor := range pending {
+			related, err := listDirectMemberships(clients, descriptor, direction)
+			if err != nil {
+				return nil, err
+			}
+			for _, relatedDescriptor := range related {
+				if !visited[relatedDescriptor] {
+					visited[relatedDescriptor] = true
+					discovered = append(discovered, relatedDescriptor)
+				}
+			}
+		}
+
+		resolved, err := lookupGraphSubjects(clients, discovered)
+		if err != nil {
+			return nil, err
+		}
+
+		// only groups have members, so only groups need to be traversed further
+		pending = nil
+		for _, descriptor := range discovered {
+			subjects[descriptor] = resolved[descriptor]
+			if direction == membershipDirectionUp || strings.EqualFold(converter.ToString(resolved[descriptor].SubjectKind, ""), "group") {
+				pending = append(pending, descriptor)
+			}
+		}
+	}
+	return subjects, nil
+}
+
+// Returns the descriptors of the subjects that are directly related to a subject in the given direction
+func listDirectMemberships(clients *aggregatedClient, descriptor string, direction string) ([]string, error) {
+	traversal := graph.GraphTraversalDirectionValues.Down
+	if direction == membershipDirectionUp {
+		traversal = graph.GraphTraversalDirectionValues.Up
+	}
+
+	memberships, err := clients.GraphClient.ListMemberships(clients.ctx, graph.ListMembershipsArgs{
+		SubjectDescriptor: converter.String(descriptor),
+		Direction:         &traversal,
+		Depth:             converter.Int(1),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if memberships == nil {
+		return nil, nil
+	}
+
+	var related []string
+	for _, membership := range *memberships {
+		relatedDescriptor := membership.MemberDescriptor
+		if direction == membershipDirectionUp {
+			relatedDescriptor = membership.ContainerDescriptor
+		}
+		if relatedDescriptor != nil && *relatedDescriptor != "" {
+			related = append(related, *relatedDescriptor)
+		}
+	}
+	return related, nil
+}
+
+// Resolves the display names and kinds of subjects, keyed by their descriptors
+func lookupGraphSubjects(clients *aggregatedClient, descriptors []string) (map[string]graph.GraphSubject, error) {
+	if len(descriptors) == 0 {
+		return map[string]graph.GraphSubject{}, nil
+	}
+
+	keys := make([]graph.GraphSubjectLookupKey, len(descriptors))
+	for i, descriptor := range descriptors {
+		keys[i] = graph.GraphSubjectLookupKey{Descriptor: converter.String(descriptor)}
+	}
+
+	subjects, err := clients.GraphClient.LookupSubjects(clients.ctx, graph.LookupSubjectsArgs{
+		SubjectLookup: &graph.GraphSubjectLookup{LookupKeys: &keys},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if subjects == nil {
+		return map[string]graph.GraphSubject{}, nil
+	}
+	return *subjects, nil
+}
+
+// Convert AzDO data structure to internal Terraform data structure. Subjects are sorted by their descriptors so
+// that the result is stable.
+func flattenGroupMembershipSubjects(subjects map[string]graph.GraphSubject) []interface{} {
+	descriptors := make([]string, 0, len(subjects))
+	for descriptor := range subjects {
+		descriptors = append(descriptors, descriptor)
+	}
+	sort.Strings(descriptors)
+
+	results := make([]interface{}, len(descriptors))
+	for i, descriptor := range descriptors {
+		subject := subjects[descriptor]
+		results[i] = map[string]interface{}{
+			"descriptor":   descriptor,
+			"display_name": converter.ToString(subject.DisplayName, ""),
+			"subject_kind": converter.ToString(subject.SubjectKind, ""),
+		}
+	}
+	return results
+}
@@ -0,0 +1,188 @@
+package azuredevops
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/graph"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+/**
+ * Begin unit tests
+ */
+
+func expectListDirectMemberships(graphClient *azdosdkmocks.MockGraphClient, descriptor string, direction graph.GraphTraversalDirection, memberships []graph.GraphMembership) {
+	graphClient.
+		EXPECT().
+		ListMemberships(gomock.Any(), graph.ListMembershipsArgs{
+			SubjectDescriptor: converter.String(descriptor),
+			Direction:         &direction,
+			Depth:             converter.Int(1),
+		}).
+		Return(&memberships, nil).
+		Times(1)
+}
+
+func testGraphSubject(descriptor string, displayName string, kind string) graph.GraphSubject {
+	return graph.GraphSubject{
+		Descriptor:  converter.String(descriptor),
+		DisplayName: converter.String(displayName),
+		SubjectKind: converter.String(kind),
+	}
+}
+
+// verifies that nested groups are traversed and that subjects reachable through several groups are listed once
+func TestGroupMembershipsDataSource_Read_FlattensNestedGroups(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	graphClient := azdosdkmocks.NewMockGraphClient(ctrl)
+	clients := &aggregatedClient{GraphClient: graphClient, ctx: context.Background()}
+
+	down := graph.GraphTraversalDirectionValues.Down
+	expectListDirectMemberships(graphClient, "vssgp.root", down, []graph.GraphMembership{
+		{ContainerDescriptor: converter.String("vssgp.root"), MemberDescriptor: converter.String("vssgp.nested")},
+		{ContainerDescriptor: converter.String("vssgp.root"), MemberDescriptor: converter.String("aad.alice")},
+	})
+	expectListDirectMemberships(graphClient, "vssgp.nested", down, []graph.GraphMembership{
+		{ContainerDescriptor: converter.String("vssgp.nested"), MemberDescriptor: converter.String("aad.alice")},
+		{ContainerDescriptor: converter.String("vssgp.nested"), MemberDescriptor: converter.String("aad.bob")},
+		{ContainerDescriptor: converter.String("vssgp.nested"), MemberDescriptor: converter.String("vssgp.root")},
+	})
+
+	gomock.InOrder(
+		graphClient.
+			EXPECT().
+			LookupSubjects(clients.ctx, gomock.Any()).
+			Return(&map[string]graph.GraphSubject{
+				"vssgp.nested": testGraphSubject("vssgp.nested", "Nested", "group"),
+				"aad.alice":    testGraphSubject("aad.alice", "Alice", "user"),
+			}, nil),
+		graphClient.
+			EXPECT().
+			LookupSubjects(clients.ctx, graph.LookupSubjectsArgs{
+				SubjectLookup: &graph.GraphSubjectLookup{LookupKeys: &[]graph.GraphSubjectLookupKey{
+					{Descriptor: converter.String("aad.bob")},
+				}},
+			}).
+			Return(&map[string]graph.GraphSubject{
+				"aad.bob": testGraphSubject("aad.bob", "Bob", "user"),
+			}, nil),
+	)
+
+	resourceData := schema.TestResourceDataRaw(t, dataGroupMemberships().Schema, map[string]interface{}{
+		"group": "vssgp.root",
+		"depth": 0,
+	})
+	err := dataSourceGroupMembershipsRead(resourceData, clients)
+	require.Nil(t, err)
+
+	members := resourceData.Get("members").([]interface{})
+	require.Len(t, members, 3)
+	require.Equal(t, "aad.alice", members[0].(map[string]interface{})["descriptor"])
+	require.Equal(t, "Alice", members[0].(map[string]interface{})["display_name"])
+	require.Equal(t, "aad.bob", members[1].(map[string]interface{})["descriptor"])
+	require.Equal(t, "vssgp.nested", members[2].(map[string]interface{})["descriptor"])
+	require.Equal(t, "group", members[2].(map[string]interface{})["subject_kind"])
+}
+
+// verifies that only direct memberships are listed by default
+func TestGroupMembershipsDataSource_Read_StopsAtDepth(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	graphClient := azdosdkmocks.NewMockGraphClient(ctrl)
+	clients := &aggregatedClient{GraphClient: graphClient, ctx: context.Background()}
+
+	up := graph.GraphTraversalDirectionValues.Up
+	expectListDirectMemberships(graphClient, "aad.alice", up, []graph.GraphMembership{
+		{ContainerDescriptor: converter.String("vssgp.team"), MemberDescriptor: converter.String("aad.alice")},
+	})
+	graphClient.
+		EXPECT().
+		LookupSubjects(clients.ctx, gomock.Any()).
+		Return(&map[string]graph.GraphSubject{
+			"vssgp.team": testGraphSubject("vssgp.team", "Team", "group"),
+		}, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, dataGroupMemberships().Schema, map[string]interface{}{
+		"group":     "aad.alice",
+		"direction": "up",
+	})
+	err := dataSourceGroupMembershipsRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "aad.alice/up/1", resourceData.Id())
+	require.Len(t, resourceData.Get("members").([]interface{}), 1)
+}
+
+// verifies that errors listing memberships are surfaced
+func TestGroupMembershipsDataSource_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	graphClient := azdosdkmocks.NewMockGraphClient(ctrl)
+	clients := &aggregatedClient{GraphClient: graphClient, ctx: context.Background()}
+
+	graphClient.
+		EXPECT().
+		ListMemberships(clients.ctx, gomock.Any()).
+		Return(nil, errors.New("ListMemberships() Failed")).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, dataGroupMemberships().Schema, map[string]interface{}{
+		"group": "vssgp.root",
+	})
+	err := dataSourceGroupMembershipsRead(resourceData, clients)
+	require.Contains(t, err.Error(), "ListMemberships() Failed")
+}
+
+/**
+ * Begin acceptance tests
+ */
+
+// Verifies that the members of a group can be read
+func TestAccGroupMembershipsDataSource_Read(t *testing.T) {
+	projectName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	tfNode := "data.azuredevops_group_memberships.memberships"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupMembershipsDataSource(projectName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfNode, "id"),
+					resource.TestCheckResourceAttrSet(tfNode, "members.#"),
+				),
+			},
+		},
+	})
+}
+
+// HCL describing a data source that lists the members of the contributors group of a project
+func testAccGroupMembershipsDataSource(projectName string) string {
+	dataSource := `
+data "azuredevops_group" "contributors" {
+	project_id = azuredevops_project.project.id
+	name       = "Contributors"
+}
+
+data "azuredevops_group_memberships" "memberships" {
+	group = data.azuredevops_group.contributors.descriptor
+	depth = 0
+}`
+
+	projectResource := testAccProjectResource(projectName)
+	return fmt.Sprintf("%s\n%s", projectResource, dataSource)
+}
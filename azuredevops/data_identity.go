@@ -0,0 +1,122 @@
+package azuredevops
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/identity"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+)
+
+// The identity of a user or a group links the subject descriptor, which is used by the graph APIs and the
+// membership resources, to the identity descriptor, which is used by the access control lists of the
+// security namespaces
+func dataIdentity() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIdentityRead,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"descriptor": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"subject_descriptor": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"is_group": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"is_active": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Performs a lookup of an identity by either its name, its identity descriptor or its subject descriptor
+func dataSourceIdentityRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	name := d.Get("name").(string)
+	descriptor := d.Get("descriptor").(string)
+	subjectDescriptor := d.Get("subject_descriptor").(string)
+
+	configured := 0
+	for _, value := range []string{name, descriptor, subjectDescriptor} {
+		if value != "" {
+			configured++
+		}
+	}
+	if configured != 1 {
+		return fmt.Errorf("Exactly one of name, descriptor or subject_descriptor must be specified")
+	}
+
+	args := identity.ReadIdentitiesArgs{}
+	switch {
+	case descriptor != "":
+		args.Descriptors = &descriptor
+	case subjectDescriptor != "":
+		args.SubjectDescriptors = &subjectDescriptor
+	default:
+		args.SearchFilter = converter.String("General")
+		args.FilterValue = &name
+	}
+
+	identities, err := readIdentities(clients, args)
+	if err != nil {
+		return err
+	}
+
+	found, err := selectIdentity(identities, name, descriptor+subjectDescriptor)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(found.Id.String())
+	d.Set("name", converter.ToString(found.ProviderDisplayName, ""))
+	d.Set("descriptor", converter.ToString(found.Descriptor, ""))
+	d.Set("subject_descriptor", converter.ToString(found.SubjectDescriptor, ""))
+	d.Set("is_group", converter.ToBool(found.IsContainer, false))
+	d.Set("is_active", converter.ToBool(found.IsActive, false))
+	return nil
+}
+
+// Selects the single identity of a lookup. The service returns an empty entry for every descriptor that does not
+// resolve to an identity, and a search by name also returns identities whose name only starts with the given
+// name, so names are matched case insensitively.
+func selectIdentity(identities []identity.Identity, name string, identifier string) (*identity.Identity, error) {
+	var matches []identity.Identity
+	for _, candidate := range identities {
+		if candidate.Id == nil {
+			continue
+		}
+		if name != "" && !strings.EqualFold(converter.ToString(candidate.ProviderDisplayName, ""), name) {
+			continue
+		}
+		matches = append(matches, candidate)
+	}
+
+	if name != "" {
+		identifier = name
+	}
+	if len(matches) == 0 {
+		return nil, fmt.Errorf("Could not find an identity identified by %s", identifier)
+	}
+	if len(matches) > 1 {
+		return nil, fmt.Errorf("Found %d identities identified by %s, but expected exactly one", len(matches), identifier)
+	}
+	return &matches[0], nil
+}
@@ -0,0 +1,187 @@
+package azuredevops
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/identity"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var testIdentityID = uuid.New()
+var testOtherIdentityID = uuid.New()
+
+var testIdentities = []identity.Identity{
+	{
+		Id:                  &testIdentityID,
+		Descriptor:          converter.String("Microsoft.TeamFoundation.Identity;S-1-9-1551374245-1"),
+		SubjectDescriptor:   converter.String("vssgp.first"),
+		ProviderDisplayName: converter.String("[Project]\\Contributors"),
+		IsContainer:         converter.Bool(true),
+		IsActive:            converter.Bool(true),
+	},
+	{
+		Id:                  &testOtherIdentityID,
+		Descriptor:          converter.String("Microsoft.TeamFoundation.Identity;S-1-9-1551374245-2"),
+		SubjectDescriptor:   converter.String("vssgp.second"),
+		ProviderDisplayName: converter.String("[Project]\\Contributors Reviewers"),
+		IsContainer:         converter.Bool(true),
+		IsActive:            converter.Bool(true),
+	},
+}
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that a subject descriptor is resolved to the identity descriptor used by the security namespaces
+func TestIdentityDataSource_Read_BySubjectDescriptor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	identityClient := azdosdkmocks.NewMockIdentityClient(ctrl)
+	clients := &aggregatedClient{IdentityClient: identityClient, ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, dataIdentity().Schema, map[string]interface{}{
+		"subject_descriptor": "vssgp.first",
+	})
+
+	identityClient.
+		EXPECT().
+		ReadIdentities(clients.ctx, identity.ReadIdentitiesArgs{SubjectDescriptors: converter.String("vssgp.first")}).
+		Return(&[]identity.Identity{testIdentities[0]}, nil).
+		Times(1)
+
+	err := dataSourceIdentityRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, testIdentityID.String(), resourceData.Id())
+	require.Equal(t, "Microsoft.TeamFoundation.Identity;S-1-9-1551374245-1", resourceData.Get("descriptor"))
+	require.Equal(t, "[Project]\\Contributors", resourceData.Get("name"))
+	require.True(t, resourceData.Get("is_group").(bool))
+}
+
+// verifies that a search by name only selects the identity with exactly that name
+func TestIdentityDataSource_Read_ByNameIgnoresPartialMatches(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	identityClient := azdosdkmocks.NewMockIdentityClient(ctrl)
+	clients := &aggregatedClient{IdentityClient: identityClient, ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, dataIdentity().Schema, map[string]interface{}{
+		"name": "[project]\\contributors",
+	})
+
+	identityClient.
+		EXPECT().
+		ReadIdentities(clients.ctx, identity.ReadIdentitiesArgs{
+			SearchFilter: converter.String("General"),
+			FilterValue:  converter.String("[project]\\contributors"),
+		}).
+		Return(&testIdentities, nil).
+		Times(1)
+
+	err := dataSourceIdentityRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "vssgp.first", resourceData.Get("subject_descriptor"))
+}
+
+// verifies that the empty entries returned for descriptors that do not resolve are not selected
+func TestIdentityDataSource_SelectIdentity(t *testing.T) {
+	_, err := selectIdentity([]identity.Identity{{}}, "", "vssgp.missing")
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "Could not find an identity identified by vssgp.missing")
+
+	_, err = selectIdentity(testIdentities, "", "vssgp.first,vssgp.second")
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "Found 2 identities")
+}
+
+// verifies that exactly one identifier has to be given
+func TestIdentityDataSource_Read_RequiresExactlyOneIdentifier(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, dataIdentity().Schema, nil)
+	err := dataSourceIdentityRead(resourceData, &aggregatedClient{})
+	require.NotNil(t, err)
+
+	resourceData = schema.TestResourceDataRaw(t, dataIdentity().Schema, map[string]interface{}{
+		"name":               "[Project]\\Contributors",
+		"subject_descriptor": "vssgp.first",
+	})
+	err = dataSourceIdentityRead(resourceData, &aggregatedClient{})
+	require.NotNil(t, err)
+}
+
+// verifies that the identity lookup functionality has proper error handling
+func TestIdentityDataSource_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	identityClient := azdosdkmocks.NewMockIdentityClient(ctrl)
+	clients := &aggregatedClient{IdentityClient: identityClient, ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, dataIdentity().Schema, map[string]interface{}{
+		"descriptor": "Microsoft.TeamFoundation.Identity;S-1-9-1551374245-1",
+	})
+
+	identityClient.
+		EXPECT().
+		ReadIdentities(clients.ctx, gomock.Any()).
+		Return(nil, errors.New("ReadIdentities() Failed"))
+
+	err := dataSourceIdentityRead(resourceData, clients)
+	require.Contains(t, err.Error(), "ReadIdentities() Failed")
+}
+
+/**
+ * Begin acceptance tests
+ */
+
+// Validates that the identity of a group can be looked up by the subject descriptor of the group and by its name
+func TestAccIdentityDataSource_Read_HappyPath(t *testing.T) {
+	projectName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	groupName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	tfNode := "data.azuredevops_identity.identity"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityDataSource(projectName, groupName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfNode, "descriptor"),
+					resource.TestCheckResourceAttr(tfNode, "name", fmt.Sprintf("[%s]\\%s", projectName, groupName)),
+					resource.TestCheckResourceAttr(tfNode, "is_group", "true"),
+					resource.TestCheckResourceAttrPair(tfNode, "subject_descriptor", "azuredevops_group.group", "descriptor"),
+					resource.TestCheckResourceAttrPair("data.azuredevops_identity.by_name", "descriptor", tfNode, "descriptor"),
+				),
+			},
+		},
+	})
+}
+
+// HCL describing the lookups of the identity of a group by its subject descriptor and by its name
+func testAccIdentityDataSource(projectName string, groupName string) string {
+	identityDataSource := fmt.Sprintf(`
+data "azuredevops_identity" "identity" {
+	subject_descriptor = azuredevops_group.group.descriptor
+}
+
+data "azuredevops_identity" "by_name" {
+	name = "[${azuredevops_project.project.project_name}]\\%s"
+
+	depends_on = [azuredevops_group.group]
+}`, groupName)
+
+	groupResource := testAccGroupResource(projectName, groupName, "description")
+	return fmt.Sprintf("%s\n%s", groupResource, identityDataSource)
+}
@@ -0,0 +1,104 @@
+package azuredevops
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/core"
+)
+
+func dataProject() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceProjectRead,
+		Schema: map[string]*schema.Schema{
+			"project_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"visibility": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version_control": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"work_item_template": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"process_template_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Performs a lookup of a project by its name. Like the project resource, names are compared case insensitively.
+func dataSourceProjectRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	projectName := d.Get("project_name").(string)
+
+	projects, err := getProjects(clients)
+	if err != nil {
+		return fmt.Errorf("Error listing projects. Error: %v", err)
+	}
+
+	projectRef := selectProject(projects, projectName)
+	if projectRef == nil {
+		return fmt.Errorf("Could not find project with name %s", projectName)
+	}
+
+	// the capabilities of a project are only included when a single project is looked up
+	project, err := projectRead(clients, projectRef.Id.String(), "")
+	if err != nil {
+		return fmt.Errorf("Error looking up project with ID %s. Error: %v", projectRef.Id.String(), err)
+	}
+
+	err = flattenProject(clients, d, project)
+	if err != nil {
+		return fmt.Errorf("Error flattening project: %v", err)
+	}
+	return nil
+}
+
+// Lists all projects of the organization. This involves querying a paginated API, so multiple API calls may be needed.
+func getProjects(clients *aggregatedClient) ([]core.TeamProjectReference, error) {
+	var projects []core.TeamProjectReference
+	var continuationToken string
+
+	for hasMore := true; hasMore; {
+		args := core.GetProjectsArgs{}
+		if continuationToken != "" {
+			args.ContinuationToken = &continuationToken
+		}
+
+		response, err := clients.CoreClient.GetProjects(clients.ctx, args)
+		if err != nil {
+			return nil, err
+		}
+
+		projects = append(projects, response.Value...)
+		continuationToken = response.ContinuationToken
+		hasMore = continuationToken != ""
+	}
+
+	return projects, nil
+}
+
+func selectProject(projects []core.TeamProjectReference, projectName string) *core.TeamProjectReference {
+	for _, project := range projects {
+		if strings.EqualFold(*project.Name, projectName) {
+			return &project
+		}
+	}
+	return nil
+}
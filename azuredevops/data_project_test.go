@@ -0,0 +1,158 @@
+package azuredevops
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/core"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that projects are matched case insensitively and that every page of projects is searched
+func TestProjectDataSource_Read_MatchesNameCaseInsensitively(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreClient := azdosdkmocks.NewMockCoreClient(ctrl)
+	clients := &aggregatedClient{CoreClient: coreClient, ctx: context.Background()}
+
+	otherID := uuid.New()
+	firstPage := core.GetProjectsResponseValue{
+		Value:             []core.TeamProjectReference{{Id: &otherID, Name: converter.String("Other")}},
+		ContinuationToken: "next",
+	}
+	secondPage := core.GetProjectsResponseValue{
+		Value: []core.TeamProjectReference{{Id: testProject.Id, Name: converter.String("NAME")}},
+	}
+	coreClient.
+		EXPECT().
+		GetProjects(clients.ctx, core.GetProjectsArgs{}).
+		Return(&firstPage, nil).
+		Times(1)
+	coreClient.
+		EXPECT().
+		GetProjects(clients.ctx, core.GetProjectsArgs{ContinuationToken: converter.String("next")}).
+		Return(&secondPage, nil).
+		Times(1)
+
+	coreClient.
+		EXPECT().
+		GetProject(clients.ctx, core.GetProjectArgs{
+			ProjectId:           converter.String(testProject.Id.String()),
+			IncludeCapabilities: converter.Bool(true),
+			IncludeHistory:      converter.Bool(false),
+		}).
+		Return(&testProject, nil).
+		Times(1)
+
+	processName := "Agile"
+	coreClient.
+		EXPECT().
+		GetProcessById(clients.ctx, gomock.Any()).
+		Return(&core.Process{Name: &processName}, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, dataProject().Schema, nil)
+	resourceData.Set("project_name", "name")
+
+	err := dataSourceProjectRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, testProject.Id.String(), resourceData.Id())
+	require.Equal(t, "Description", resourceData.Get("description"))
+	require.Equal(t, "Agile", resourceData.Get("work_item_template"))
+}
+
+// verifies that a clear error is returned if no project matches the name
+func TestProjectDataSource_Read_ReportsMissingProject(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreClient := azdosdkmocks.NewMockCoreClient(ctrl)
+	clients := &aggregatedClient{CoreClient: coreClient, ctx: context.Background()}
+
+	coreClient.
+		EXPECT().
+		GetProjects(clients.ctx, gomock.Any()).
+		Return(&core.GetProjectsResponseValue{}, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, dataProject().Schema, nil)
+	resourceData.Set("project_name", "missing")
+
+	err := dataSourceProjectRead(resourceData, clients)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "Could not find project with name missing")
+}
+
+// verifies that the project lookup functionality has proper error handling
+func TestProjectDataSource_Read_DoesNotSwallowListProjectsError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreClient := azdosdkmocks.NewMockCoreClient(ctrl)
+	clients := &aggregatedClient{CoreClient: coreClient, ctx: context.Background()}
+
+	coreClient.
+		EXPECT().
+		GetProjects(clients.ctx, gomock.Any()).
+		Return(nil, errors.New("GetProjects() Failed")).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, dataProject().Schema, nil)
+	resourceData.Set("project_name", "name")
+
+	err := dataSourceProjectRead(resourceData, clients)
+	require.Contains(t, err.Error(), "GetProjects() Failed")
+}
+
+/**
+ * Begin acceptance tests
+ */
+
+// Validates that a configuration containing a project lookup is able to read the resource correctly.
+// Because this is a data source, there are no resources to inspect in AzDO
+func TestAccProjectDataSource_Read_HappyPath(t *testing.T) {
+	projectName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	tfNode := "data.azuredevops_project.project"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectDataSource(projectName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "project_name", projectName),
+					resource.TestCheckResourceAttrPair(tfNode, "id", "azuredevops_project.project", "id"),
+					resource.TestCheckResourceAttr(tfNode, "version_control", "Git"),
+					resource.TestCheckResourceAttr(tfNode, "visibility", "private"),
+					resource.TestCheckResourceAttr(tfNode, "work_item_template", "Agile"),
+				),
+			},
+		},
+	})
+}
+
+// HCL describing a project lookup. The project name is given in lower case to verify that the lookup is case insensitive.
+func testAccProjectDataSource(projectName string) string {
+	dataSource := `
+data "azuredevops_project" "project" {
+	project_name = lower(azuredevops_project.project.project_name)
+}`
+
+	projectResource := testAccProjectResource(projectName)
+	return fmt.Sprintf("%s\n%s", projectResource, dataSource)
+}
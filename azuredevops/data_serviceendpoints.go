@@ -0,0 +1,130 @@
+package azuredevops
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/serviceendpoint"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+)
+
+func dataServiceEndpoints() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceServiceEndpointsRead,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"service_endpoints": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"owner": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"is_ready": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceServiceEndpointsRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	projectID := d.Get("project_id").(string)
+	endpointType := d.Get("type").(string)
+
+	serviceEndpoints, err := getServiceEndpoints(clients, projectID, endpointType)
+	if err != nil {
+		return fmt.Errorf("Error listing service endpoints of project %s. Error: %v", projectID, err)
+	}
+
+	d.SetId(strings.TrimSuffix(projectID+"/"+endpointType, "/"))
+	return d.Set("service_endpoints", flattenServiceEndpoints(serviceEndpoints))
+}
+
+// Lists all service endpoints of a project, optionally only those of the given type. Version 5.1 of the service
+// endpoints API does not page its results, neither through continuation tokens nor through $top/$skip, and
+// returns all endpoints of the project in a single response.
+func getServiceEndpoints(clients *aggregatedClient, projectID string, endpointType string) ([]serviceendpoint.ServiceEndpoint, error) {
+	args := serviceendpoint.GetServiceEndpointsArgs{
+		Project: &projectID,
+	}
+	if endpointType != "" {
+		args.Type = &endpointType
+	}
+
+	serviceEndpoints, err := clients.ServiceEndpointClient.GetServiceEndpoints(clients.ctx, args)
+	if err != nil {
+		return nil, err
+	}
+	if serviceEndpoints == nil {
+		return nil, nil
+	}
+	return *serviceEndpoints, nil
+}
+
+// Convert AzDO data structure to internal Terraform data structure. Endpoints are sorted by name, and then by ID,
+// so that the order of the list does not depend on the order in which the service returns them.
+func flattenServiceEndpoints(endpoints []serviceendpoint.ServiceEndpoint) []interface{} {
+	var serviceEndpoints []serviceendpoint.ServiceEndpoint
+	for _, serviceEndpoint := range endpoints {
+		if serviceEndpoint.Id != nil {
+			serviceEndpoints = append(serviceEndpoints, serviceEndpoint)
+		}
+	}
+
+	sort.SliceStable(serviceEndpoints, func(i, j int) bool {
+		nameI := strings.ToLower(converter.ToString(serviceEndpoints[i].Name, ""))
+		nameJ := strings.ToLower(converter.ToString(serviceEndpoints[j].Name, ""))
+		if nameI != nameJ {
+			return nameI < nameJ
+		}
+		return serviceEndpoints[i].Id.String() < serviceEndpoints[j].Id.String()
+	})
+
+	results := make([]interface{}, 0, len(serviceEndpoints))
+	for _, serviceEndpoint := range serviceEndpoints {
+		results = append(results, map[string]interface{}{
+			"id":       serviceEndpoint.Id.String(),
+			"name":     converter.ToString(serviceEndpoint.Name, ""),
+			"type":     converter.ToString(serviceEndpoint.Type, ""),
+			"url":      converter.ToString(serviceEndpoint.Url, ""),
+			"owner":    converter.ToString(serviceEndpoint.Owner, ""),
+			"is_ready": converter.ToBool(serviceEndpoint.IsReady, false),
+		})
+	}
+	return results
+}
@@ -0,0 +1,169 @@
+package azuredevops
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/serviceendpoint"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+/**
+ * Begin unit tests
+ */
+
+var testServiceEndpointsProjectID = uuid.New().String()
+
+// verifies that the type filter is passed through and that the endpoints are exported in a stable order
+func TestServiceEndpointsDataSource_Read_FiltersByTypeAndSortsByName(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	serviceEndpointClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &aggregatedClient{ServiceEndpointClient: serviceEndpointClient, ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, dataServiceEndpoints().Schema, nil)
+	resourceData.Set("project_id", testServiceEndpointsProjectID)
+	resourceData.Set("type", "azurerm")
+
+	firstID := uuid.New()
+	secondID := uuid.New()
+	serviceEndpoints := []serviceendpoint.ServiceEndpoint{
+		{
+			Id:      &secondID,
+			Name:    converter.String("Production"),
+			Type:    converter.String("azurerm"),
+			Url:     converter.String("https://management.azure.com/"),
+			Owner:   converter.String("Library"),
+			IsReady: converter.Bool(false),
+		},
+		{
+			Id:      &firstID,
+			Name:    converter.String("Development"),
+			Type:    converter.String("azurerm"),
+			Url:     converter.String("https://management.azure.com/"),
+			Owner:   converter.String("Library"),
+			IsReady: converter.Bool(true),
+		},
+	}
+
+	expectedArgs := serviceendpoint.GetServiceEndpointsArgs{
+		Project: converter.String(testServiceEndpointsProjectID),
+		Type:    converter.String("azurerm"),
+	}
+	serviceEndpointClient.
+		EXPECT().
+		GetServiceEndpoints(clients.ctx, expectedArgs).
+		Return(&serviceEndpoints, nil).
+		Times(1)
+
+	err := dataSourceServiceEndpointsRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, testServiceEndpointsProjectID+"/azurerm", resourceData.Id())
+	require.Equal(t, 2, resourceData.Get("service_endpoints.#"))
+	require.Equal(t, firstID.String(), resourceData.Get("service_endpoints.0.id"))
+	require.Equal(t, "Development", resourceData.Get("service_endpoints.0.name"))
+	require.Equal(t, true, resourceData.Get("service_endpoints.0.is_ready"))
+	require.Equal(t, secondID.String(), resourceData.Get("service_endpoints.1.id"))
+	require.Equal(t, "Production", resourceData.Get("service_endpoints.1.name"))
+	require.Equal(t, "azurerm", resourceData.Get("service_endpoints.1.type"))
+	require.Equal(t, "https://management.azure.com/", resourceData.Get("service_endpoints.1.url"))
+	require.Equal(t, "Library", resourceData.Get("service_endpoints.1.owner"))
+	require.Equal(t, false, resourceData.Get("service_endpoints.1.is_ready"))
+}
+
+// verifies that all endpoints of the project are requested if no type is given
+func TestServiceEndpointsDataSource_Read_WithoutTypeFilter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	serviceEndpointClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &aggregatedClient{ServiceEndpointClient: serviceEndpointClient, ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, dataServiceEndpoints().Schema, nil)
+	resourceData.Set("project_id", testServiceEndpointsProjectID)
+
+	expectedArgs := serviceendpoint.GetServiceEndpointsArgs{
+		Project: converter.String(testServiceEndpointsProjectID),
+	}
+	serviceEndpointClient.
+		EXPECT().
+		GetServiceEndpoints(clients.ctx, expectedArgs).
+		Return(&[]serviceendpoint.ServiceEndpoint{}, nil).
+		Times(1)
+
+	err := dataSourceServiceEndpointsRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, testServiceEndpointsProjectID, resourceData.Id())
+	require.Equal(t, 0, resourceData.Get("service_endpoints.#"))
+}
+
+// verifies that the service endpoint lookup functionality has proper error handling
+func TestServiceEndpointsDataSource_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	serviceEndpointClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &aggregatedClient{ServiceEndpointClient: serviceEndpointClient, ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, dataServiceEndpoints().Schema, nil)
+	resourceData.Set("project_id", testServiceEndpointsProjectID)
+
+	serviceEndpointClient.
+		EXPECT().
+		GetServiceEndpoints(clients.ctx, gomock.Any()).
+		Return(nil, errors.New("GetServiceEndpoints() Failed")).
+		Times(1)
+
+	err := dataSourceServiceEndpointsRead(resourceData, clients)
+	require.Contains(t, err.Error(), "GetServiceEndpoints() Failed")
+}
+
+/**
+ * Begin acceptance tests
+ */
+
+// Validates that a configuration containing a service endpoint listing is able to read the endpoints of a project.
+// Because this is a data source, there are no resources to inspect in AzDO
+func TestAccServiceEndpointsDataSource_Read_HappyPath(t *testing.T) {
+	projectName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	serviceEndpointName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	tfNode := "data.azuredevops_serviceendpoints.endpoints"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceEndpointsDataSource(projectName, serviceEndpointName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "service_endpoints.#", "1"),
+					resource.TestCheckResourceAttr(tfNode, "service_endpoints.0.name", serviceEndpointName),
+					resource.TestCheckResourceAttr(tfNode, "service_endpoints.0.type", "github"),
+					resource.TestCheckResourceAttrPair(tfNode, "service_endpoints.0.id", "azuredevops_serviceendpoint_github.serviceendpoint", "id"),
+				),
+			},
+		},
+	})
+}
+
+// HCL describing a listing of the GitHub service endpoints of a project
+func testAccServiceEndpointsDataSource(projectName string, serviceEndpointName string) string {
+	dataSource := `
+data "azuredevops_serviceendpoints" "endpoints" {
+	project_id = azuredevops_serviceendpoint_github.serviceendpoint.project_id
+	type       = "github"
+}`
+
+	serviceEndpointResource := testAccServiceEndpointGitHubResource(projectName, serviceEndpointName)
+	return fmt.Sprintf("%s\n%s", serviceEndpointResource, dataSource)
+}
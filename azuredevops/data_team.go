@@ -0,0 +1,138 @@
+package azuredevops
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/core"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/azdoerror"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+)
+
+func dataTeam() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTeamRead,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"descriptor": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"administrators": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"members": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+		},
+	}
+}
+
+func dataSourceTeamRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	projectID := d.Get("project_id").(string)
+	name := d.Get("name").(string)
+
+	team, err := getTeamByName(clients, projectID, name)
+	if err != nil {
+		return fmt.Errorf("Error looking up team with name %s in project %s. Error: %v", name, projectID, err)
+	}
+	if team == nil {
+		return fmt.Errorf("Team with name %s does not exist in project %s", name, projectID)
+	}
+
+	details, err := flattenTeamDetails(clients, projectID, team)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(team.Id.String())
+	for key, value := range details {
+		if key != "id" {
+			d.Set(key, value)
+		}
+	}
+	return nil
+}
+
+// Returns the team with the given name, or nil if there is none. The default team of a project is named after the
+// project, e.g. "Sample Project Team", and can be looked up by this name even if it has been renamed since.
+func getTeamByName(clients *aggregatedClient, projectID string, name string) (*core.WebApiTeam, error) {
+	team, err := clients.CoreClient.GetTeam(clients.ctx, core.GetTeamArgs{
+		ProjectId: &projectID,
+		TeamId:    &name,
+	})
+	if err == nil {
+		return team, nil
+	}
+	if !azdoerror.IsNotFound(err) {
+		return nil, err
+	}
+
+	project, err := clients.CoreClient.GetProject(clients.ctx, core.GetProjectArgs{
+		ProjectId:           &projectID,
+		IncludeCapabilities: converter.Bool(false),
+		IncludeHistory:      converter.Bool(false),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if project.DefaultTeam == nil || project.DefaultTeam.Id == nil ||
+		!strings.EqualFold(name, converter.ToString(project.Name, "")+" Team") {
+		return nil, nil
+	}
+
+	return clients.CoreClient.GetTeam(clients.ctx, core.GetTeamArgs{
+		ProjectId: &projectID,
+		TeamId:    converter.String(project.DefaultTeam.Id.String()),
+	})
+}
+
+// Convert AzDO data structure to internal Terraform data structure, including the descriptor, the members and the
+// administrators of the team
+func flattenTeamDetails(clients *aggregatedClient, projectID string, team *core.WebApiTeam) (map[string]interface{}, error) {
+	descriptor, err := getTeamDescriptor(clients, team.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := getGroupMembers(clients, descriptor)
+	if err != nil {
+		return nil, fmt.Errorf("Error listing the members of team %s. Error: %v", team.Id, err)
+	}
+
+	administrators, err := getTeamAdministrators(clients, projectID, team.Id.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"id":             team.Id.String(),
+		"name":           converter.ToString(team.Name, ""),
+		"description":    converter.ToString(team.Description, ""),
+		"descriptor":     descriptor,
+		"administrators": administrators,
+		"members":        members,
+	}, nil
+}
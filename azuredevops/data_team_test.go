@@ -0,0 +1,197 @@
+package azuredevops
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/core"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/identity"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that a team is resolved by its name, along with its descriptor, members and administrators
+func TestTeamDataSource_Read_ResolvesTeamByName(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mocks, clients := createTeamMocks(ctrl)
+	resourceData := createTeamDataSourceData(t, "Team")
+
+	mocks.core.
+		EXPECT().
+		GetTeam(clients.ctx, core.GetTeamArgs{ProjectId: &testTeamProjectID, TeamId: converter.String("Team")}).
+		Return(&testTeam, nil).
+		Times(1)
+	expectTeamDetails(mocks)
+
+	err := dataSourceTeamRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, testTeamID.String(), resourceData.Id())
+	require.Equal(t, "Description", resourceData.Get("description"))
+	require.Equal(t, testMembershipGroup, resourceData.Get("descriptor"))
+	require.ElementsMatch(t, []interface{}{"aad.member"}, resourceData.Get("members").(*schema.Set).List())
+	require.ElementsMatch(t, []interface{}{"aad.admin"}, resourceData.Get("administrators").(*schema.Set).List())
+}
+
+// verifies that the default team of a project can be looked up by its default name after it has been renamed
+func TestTeamDataSource_Read_ResolvesDefaultTeamByProjectName(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mocks, clients := createTeamMocks(ctrl)
+	resourceData := createTeamDataSourceData(t, "Sample Project Team")
+
+	statusCode := http.StatusNotFound
+	mocks.core.
+		EXPECT().
+		GetTeam(clients.ctx, core.GetTeamArgs{ProjectId: &testTeamProjectID, TeamId: converter.String("Sample Project Team")}).
+		Return(nil, azuredevops.WrappedError{StatusCode: &statusCode}).
+		Times(1)
+	mocks.core.
+		EXPECT().
+		GetProject(clients.ctx, gomock.Any()).
+		Return(&core.TeamProject{
+			Name:        converter.String("Sample Project"),
+			DefaultTeam: &core.WebApiTeamRef{Id: &testTeamID},
+		}, nil).
+		Times(1)
+	mocks.core.
+		EXPECT().
+		GetTeam(clients.ctx, core.GetTeamArgs{ProjectId: &testTeamProjectID, TeamId: converter.String(testTeamID.String())}).
+		Return(&testTeam, nil).
+		Times(1)
+	expectTeamDetails(mocks)
+
+	err := dataSourceTeamRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, testTeamID.String(), resourceData.Id())
+}
+
+// verifies that a clear error is returned if no team has the name
+func TestTeamDataSource_Read_ReportsMissingTeam(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mocks, clients := createTeamMocks(ctrl)
+	resourceData := createTeamDataSourceData(t, "Missing")
+
+	statusCode := http.StatusNotFound
+	mocks.core.
+		EXPECT().
+		GetTeam(clients.ctx, gomock.Any()).
+		Return(nil, azuredevops.WrappedError{StatusCode: &statusCode}).
+		Times(1)
+	mocks.core.
+		EXPECT().
+		GetProject(clients.ctx, gomock.Any()).
+		Return(&core.TeamProject{
+			Name:        converter.String("Sample Project"),
+			DefaultTeam: &core.WebApiTeamRef{Id: &testTeamID},
+		}, nil).
+		Times(1)
+
+	err := dataSourceTeamRead(resourceData, clients)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "Team with name Missing does not exist")
+}
+
+// verifies that the team lookup functionality has proper error handling
+func TestTeamDataSource_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mocks, clients := createTeamMocks(ctrl)
+	resourceData := createTeamDataSourceData(t, "Team")
+
+	mocks.core.
+		EXPECT().
+		GetTeam(clients.ctx, gomock.Any()).
+		Return(nil, errors.New("GetTeam() Failed")).
+		Times(1)
+
+	err := dataSourceTeamRead(resourceData, clients)
+	require.Contains(t, err.Error(), "GetTeam() Failed")
+}
+
+func createTeamDataSourceData(t *testing.T, name string) *schema.ResourceData {
+	return schema.TestResourceDataRaw(t, dataTeam().Schema, map[string]interface{}{
+		"project_id": testTeamProjectID,
+		"name":       name,
+	})
+}
+
+// the test team has a single member and a single administrator
+func expectTeamDetails(mocks *teamMocks) {
+	expectTeamDescriptor(mocks).Times(1)
+	expectListMemberships(mocks.graph, "aad.member")
+	expectTeamAccessControlLists(mocks, "identity.admin")
+	mocks.identity.
+		EXPECT().
+		ReadIdentities(gomock.Any(), identity.ReadIdentitiesArgs{Descriptors: converter.String("identity.admin")}).
+		Return(&[]identity.Identity{{SubjectDescriptor: converter.String("aad.admin")}}, nil).
+		Times(1)
+}
+
+/**
+ * Begin acceptance tests
+ */
+
+// validates that a team and the default team of a project can be looked up by name
+func TestAccTeamDataSource_Read(t *testing.T) {
+	projectName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	teamName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	tfTeamNode := "data.azuredevops_team.team"
+	tfDefaultTeamNode := "data.azuredevops_team.default"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTeamDataSource(projectName, teamName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(tfTeamNode, "id", "azuredevops_team.team", "id"),
+					resource.TestCheckResourceAttrPair(tfTeamNode, "descriptor", "azuredevops_team.team", "descriptor"),
+					resource.TestCheckResourceAttr(tfTeamNode, "description", "Team description"),
+					resource.TestCheckResourceAttrSet(tfDefaultTeamNode, "id"),
+					resource.TestCheckResourceAttrSet(tfDefaultTeamNode, "descriptor"),
+				),
+			},
+		},
+	})
+}
+
+// HCL describing data sources for a team and for the default team of a project
+func testAccTeamDataSource(projectName string, teamName string) string {
+	dataSources := fmt.Sprintf(`
+resource "azuredevops_team" "team" {
+	project_id  = azuredevops_project.project.id
+	name        = "%s"
+	description = "Team description"
+}
+
+data "azuredevops_team" "team" {
+	project_id = azuredevops_project.project.id
+	name       = azuredevops_team.team.name
+}
+
+data "azuredevops_team" "default" {
+	project_id = azuredevops_project.project.id
+	name       = "%s Team"
+}`, teamName, projectName)
+
+	projectResource := testAccProjectResource(projectName)
+	return fmt.Sprintf("%s\n%s", projectResource, dataSources)
+}
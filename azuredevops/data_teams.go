@@ -0,0 +1,119 @@
+package azuredevops
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/core"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+)
+
+// The number of teams requested per page. The service does not return more than 100 teams per request.
+const teamsPageSize = 100
+
+func dataTeams() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTeamsRead,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"teams": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"descriptor": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"administrators": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+						"members": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTeamsRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	projectID := d.Get("project_id").(string)
+
+	teams, err := getTeams(clients, projectID)
+	if err != nil {
+		return fmt.Errorf("Error listing the teams of project %s. Error: %v", projectID, err)
+	}
+
+	results := make([]interface{}, 0, len(teams))
+	for _, team := range teams {
+		details, err := flattenTeamDetails(clients, projectID, &team)
+		if err != nil {
+			return err
+		}
+		results = append(results, details)
+	}
+
+	d.SetId("teams-" + projectID)
+	return d.Set("teams", results)
+}
+
+// Lists all teams of a project, sorted by name so that the order of the list does not depend on the order in which
+// the service returns them
+func getTeams(clients *aggregatedClient, projectID string) ([]core.WebApiTeam, error) {
+	var teams []core.WebApiTeam
+	for skip := 0; ; skip += teamsPageSize {
+		page, err := clients.CoreClient.GetTeams(clients.ctx, core.GetTeamsArgs{
+			ProjectId: &projectID,
+			Top:       converter.Int(teamsPageSize),
+			Skip:      converter.Int(skip),
+		})
+		if err != nil {
+			return nil, err
+		}
+		if page == nil {
+			break
+		}
+
+		for _, team := range *page {
+			if team.Id != nil {
+				teams = append(teams, team)
+			}
+		}
+		if len(*page) < teamsPageSize {
+			break
+		}
+	}
+
+	sort.SliceStable(teams, func(i, j int) bool {
+		return strings.ToLower(converter.ToString(teams[i].Name, "")) < strings.ToLower(converter.ToString(teams[j].Name, ""))
+	})
+	return teams, nil
+}
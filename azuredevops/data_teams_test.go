@@ -0,0 +1,150 @@
+package azuredevops
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/core"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that the teams of all pages are listed
+func TestTeamsDataSource_Read_ListsAllPages(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mocks, clients := createTeamMocks(ctrl)
+
+	firstPage := make([]core.WebApiTeam, teamsPageSize)
+	for i := range firstPage {
+		firstPage[i] = core.WebApiTeam{Name: converter.String(fmt.Sprintf("Team %d", i))}
+	}
+	firstPage[0] = testTeam
+
+	first := mocks.core.
+		EXPECT().
+		GetTeams(clients.ctx, core.GetTeamsArgs{
+			ProjectId: &testTeamProjectID,
+			Top:       converter.Int(teamsPageSize),
+			Skip:      converter.Int(0),
+		}).
+		Return(&firstPage, nil).
+		Times(1)
+	mocks.core.
+		EXPECT().
+		GetTeams(clients.ctx, core.GetTeamsArgs{
+			ProjectId: &testTeamProjectID,
+			Top:       converter.Int(teamsPageSize),
+			Skip:      converter.Int(teamsPageSize),
+		}).
+		Return(&[]core.WebApiTeam{}, nil).
+		After(first).
+		Times(1)
+
+	teams, err := getTeams(clients, testTeamProjectID)
+	require.Nil(t, err)
+	// teams without an ID are skipped
+	require.Len(t, teams, 1)
+	require.Equal(t, testTeamID, *teams[0].Id)
+}
+
+// verifies that the teams are listed with their descriptor, members and administrators
+func TestTeamsDataSource_Read_FlattensTeams(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mocks, clients := createTeamMocks(ctrl)
+	resourceData := schema.TestResourceDataRaw(t, dataTeams().Schema, map[string]interface{}{
+		"project_id": testTeamProjectID,
+	})
+
+	mocks.core.
+		EXPECT().
+		GetTeams(clients.ctx, gomock.Any()).
+		Return(&[]core.WebApiTeam{testTeam}, nil).
+		Times(1)
+	expectTeamDetails(mocks)
+
+	err := dataSourceTeamsRead(resourceData, clients)
+	require.Nil(t, err)
+
+	teams := resourceData.Get("teams").([]interface{})
+	require.Len(t, teams, 1)
+	team := teams[0].(map[string]interface{})
+	require.Equal(t, testTeamID.String(), team["id"])
+	require.Equal(t, "Team", team["name"])
+	require.Equal(t, testMembershipGroup, team["descriptor"])
+	require.ElementsMatch(t, []interface{}{"aad.member"}, team["members"].(*schema.Set).List())
+	require.ElementsMatch(t, []interface{}{"aad.admin"}, team["administrators"].(*schema.Set).List())
+}
+
+// verifies that the teams lookup functionality has proper error handling
+func TestTeamsDataSource_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mocks, clients := createTeamMocks(ctrl)
+	resourceData := schema.TestResourceDataRaw(t, dataTeams().Schema, map[string]interface{}{
+		"project_id": testTeamProjectID,
+	})
+
+	mocks.core.
+		EXPECT().
+		GetTeams(clients.ctx, gomock.Any()).
+		Return(nil, errors.New("GetTeams() Failed")).
+		Times(1)
+
+	err := dataSourceTeamsRead(resourceData, clients)
+	require.Contains(t, err.Error(), "GetTeams() Failed")
+}
+
+/**
+ * Begin acceptance tests
+ */
+
+// validates that the teams of a project, including its default team, are listed
+func TestAccTeamsDataSource_Read(t *testing.T) {
+	projectName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	teamName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	tfNode := "data.azuredevops_teams.teams"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTeamsDataSource(projectName, teamName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "teams.#", "2"),
+					resource.TestCheckResourceAttrSet(tfNode, "teams.0.descriptor"),
+				),
+			},
+		},
+	})
+}
+
+// HCL describing a data source listing the teams of a project with an additional team
+func testAccTeamsDataSource(projectName string, teamName string) string {
+	dataSource := fmt.Sprintf(`
+resource "azuredevops_team" "team" {
+	project_id = azuredevops_project.project.id
+	name       = "%s"
+}
+
+data "azuredevops_teams" "teams" {
+	project_id = azuredevops_team.team.project_id
+}`, teamName)
+
+	projectResource := testAccProjectResource(projectName)
+	return fmt.Sprintf("%s\n%s", projectResource, dataSource)
+}
@@ -0,0 +1,176 @@
+package azuredevops
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/graph"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+)
+
+func dataUser() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceUserRead,
+		Schema: map[string]*schema.Schema{
+			"principal_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"display_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"origin_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"subject_types": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.NoZeroValues,
+				},
+				Set: schema.HashString,
+			},
+			"descriptor": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"origin": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"mail_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"subject_kind": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Performs a lookup of a user by either its principal name, its display name or its origin ID. The users
+// of the organization are listed page by page until a matching user is found.
+func dataSourceUserRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	principalName := d.Get("principal_name").(string)
+	displayName := d.Get("display_name").(string)
+	originID := d.Get("origin_id").(string)
+
+	configured := 0
+	for _, value := range []string{principalName, displayName, originID} {
+		if value != "" {
+			configured++
+		}
+	}
+	if configured != 1 {
+		return fmt.Errorf("Exactly one of principal_name, display_name or origin_id must be specified")
+	}
+
+	var subjectTypes []string
+	for _, subjectType := range d.Get("subject_types").(*schema.Set).List() {
+		subjectTypes = append(subjectTypes, subjectType.(string))
+	}
+
+	users, err := getUsers(clients, subjectTypes)
+	if err != nil {
+		return fmt.Errorf("Error listing users. Error: %v", err)
+	}
+
+	user, err := selectUser(users, principalName, displayName, originID)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(*user.Descriptor)
+	d.Set("descriptor", *user.Descriptor)
+	d.Set("principal_name", converter.ToString(user.PrincipalName, ""))
+	d.Set("display_name", converter.ToString(user.DisplayName, ""))
+	d.Set("origin_id", converter.ToString(user.OriginId, ""))
+	d.Set("origin", converter.ToString(user.Origin, ""))
+	d.Set("mail_address", converter.ToString(user.MailAddress, ""))
+	d.Set("subject_kind", converter.ToString(user.SubjectKind, ""))
+	return nil
+}
+
+func getUsers(clients *aggregatedClient, subjectTypes []string) ([]graph.GraphUser, error) {
+	var users []graph.GraphUser
+	var currentToken string
+
+	for hasMore := true; hasMore; {
+		newUsers, latestToken, err := getUsersWithContinuationToken(clients, subjectTypes, currentToken)
+		if err != nil {
+			return nil, err
+		}
+
+		users = append(users, newUsers...)
+		currentToken = latestToken
+		hasMore = currentToken != ""
+	}
+
+	return users, nil
+}
+
+func getUsersWithContinuationToken(clients *aggregatedClient, subjectTypes []string, continuationToken string) ([]graph.GraphUser, string, error) {
+	args := graph.ListUsersArgs{}
+	if len(subjectTypes) > 0 {
+		args.SubjectTypes = &subjectTypes
+	}
+	if continuationToken != "" {
+		args.ContinuationToken = &continuationToken
+	}
+
+	response, err := clients.GraphClient.ListUsers(clients.ctx, args)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if response.ContinuationToken != nil && len(*response.ContinuationToken) > 1 {
+		return nil, "", fmt.Errorf("Expected at most 1 continuation token, but found %d", len(*response.ContinuationToken))
+	}
+
+	var newToken string
+	if response.ContinuationToken != nil && len(*response.ContinuationToken) > 0 {
+		newToken = (*response.ContinuationToken)[0]
+	}
+
+	if response.GraphUsers == nil {
+		return nil, newToken, nil
+	}
+	return *response.GraphUsers, newToken, nil
+}
+
+// Selects the single user that matches the given principal name, display name or origin ID. Names are
+// matched case insensitively. Display names are not unique, so a lookup by display name may be ambiguous.
+func selectUser(users []graph.GraphUser, principalName string, displayName string, originID string) (*graph.GraphUser, error) {
+	var matches []graph.GraphUser
+	for _, user := range users {
+		switch {
+		case principalName != "" && strings.EqualFold(converter.ToString(user.PrincipalName, ""), principalName),
+			displayName != "" && strings.EqualFold(converter.ToString(user.DisplayName, ""), displayName),
+			originID != "" && converter.ToString(user.OriginId, "") == originID:
+			matches = append(matches, user)
+		}
+	}
+
+	identifier := principalName + displayName + originID
+	if len(matches) == 0 {
+		return nil, fmt.Errorf("Could not find a user identified by %s", identifier)
+	}
+	if len(matches) > 1 {
+		return nil, fmt.Errorf("Found %d users identified by %s, but expected exactly one", len(matches), identifier)
+	}
+	return &matches[0], nil
+}
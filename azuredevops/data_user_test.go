@@ -0,0 +1,176 @@
+package azuredevops
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/graph"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var testUsers = []graph.GraphUser{
+	{
+		Descriptor:    converter.String("aad.first"),
+		DisplayName:   converter.String("First User"),
+		PrincipalName: converter.String("first@contoso.com"),
+		OriginId:      converter.String("origin-first"),
+		Origin:        converter.String("aad"),
+		MailAddress:   converter.String("first@contoso.com"),
+		SubjectKind:   converter.String("user"),
+	},
+	{
+		Descriptor:    converter.String("msa.second"),
+		DisplayName:   converter.String("Same Name"),
+		PrincipalName: converter.String("second@outlook.com"),
+		OriginId:      converter.String("origin-second"),
+	},
+	{
+		Descriptor:    converter.String("msa.third"),
+		DisplayName:   converter.String("Same Name"),
+		PrincipalName: converter.String("third@outlook.com"),
+		OriginId:      converter.String("origin-third"),
+	},
+}
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that all pages of users are searched and that principal names are matched case insensitively
+func TestUserDataSource_Read_HandlesContinuationToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	graphClient := azdosdkmocks.NewMockGraphClient(ctrl)
+	clients := &aggregatedClient{GraphClient: graphClient, ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, dataUser().Schema, map[string]interface{}{
+		"principal_name": "FIRST@contoso.com",
+		"subject_types":  []interface{}{"aad"},
+	})
+
+	continuationToken := "continuation-token"
+	firstCall := graphClient.
+		EXPECT().
+		ListUsers(clients.ctx, graph.ListUsersArgs{SubjectTypes: &[]string{"aad"}}).
+		Return(&graph.PagedGraphUsers{
+			ContinuationToken: &[]string{continuationToken},
+			GraphUsers:        &[]graph.GraphUser{testUsers[1]},
+		}, nil)
+	secondCall := graphClient.
+		EXPECT().
+		ListUsers(clients.ctx, graph.ListUsersArgs{SubjectTypes: &[]string{"aad"}, ContinuationToken: &continuationToken}).
+		Return(&graph.PagedGraphUsers{
+			GraphUsers: &[]graph.GraphUser{testUsers[0]},
+		}, nil)
+	gomock.InOrder(firstCall, secondCall)
+
+	err := dataSourceUserRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "aad.first", resourceData.Id())
+	require.Equal(t, "aad", resourceData.Get("origin"))
+	require.Equal(t, "first@contoso.com", resourceData.Get("mail_address"))
+	require.Equal(t, "user", resourceData.Get("subject_kind"))
+}
+
+// verifies that users can be selected by each of their identifiers and that ambiguous matches are reported
+func TestUserDataSource_SelectUser(t *testing.T) {
+	user, err := selectUser(testUsers, "", "", "origin-second")
+	require.Nil(t, err)
+	require.Equal(t, "msa.second", *user.Descriptor)
+
+	user, err = selectUser(testUsers, "", "first user", "")
+	require.Nil(t, err)
+	require.Equal(t, "aad.first", *user.Descriptor)
+
+	_, err = selectUser(testUsers, "", "Same Name", "")
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "Found 2 users")
+
+	_, err = selectUser(testUsers, "missing@contoso.com", "", "")
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "Could not find a user")
+}
+
+// verifies that exactly one identifier has to be given
+func TestUserDataSource_Read_RequiresExactlyOneIdentifier(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, dataUser().Schema, nil)
+	err := dataSourceUserRead(resourceData, &aggregatedClient{})
+	require.NotNil(t, err)
+
+	resourceData = schema.TestResourceDataRaw(t, dataUser().Schema, map[string]interface{}{
+		"principal_name": "first@contoso.com",
+		"origin_id":      "origin-first",
+	})
+	err = dataSourceUserRead(resourceData, &aggregatedClient{})
+	require.NotNil(t, err)
+}
+
+// verifies that the user lookup functionality has proper error handling
+func TestUserDataSource_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	graphClient := azdosdkmocks.NewMockGraphClient(ctrl)
+	clients := &aggregatedClient{GraphClient: graphClient, ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, dataUser().Schema, map[string]interface{}{
+		"principal_name": "first@contoso.com",
+	})
+
+	graphClient.
+		EXPECT().
+		ListUsers(clients.ctx, gomock.Any()).
+		Return(nil, errors.New("ListUsers() Failed"))
+
+	err := dataSourceUserRead(resourceData, clients)
+	require.Contains(t, err.Error(), "ListUsers() Failed")
+}
+
+/**
+ * Begin acceptance tests
+ */
+
+// Validates that a user can be looked up by its principal name. The principal name of an existing user
+// of the organization is read from the AZDO_TEST_USER_PRINCIPAL_NAME environment variable.
+func TestAccUserDataSource_Read_HappyPath(t *testing.T) {
+	principalName := os.Getenv("AZDO_TEST_USER_PRINCIPAL_NAME")
+	tfNode := "data.azuredevops_user.user"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			if principalName == "" {
+				t.Skip("AZDO_TEST_USER_PRINCIPAL_NAME must be set for this acceptance test")
+			}
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserDataSource(principalName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfNode, "descriptor"),
+					resource.TestCheckResourceAttrSet(tfNode, "origin"),
+					resource.TestCheckResourceAttrSet(tfNode, "origin_id"),
+					resource.TestCheckResourceAttr(tfNode, "subject_kind", "user"),
+				),
+			},
+		},
+	})
+}
+
+// HCL describing a user lookup by principal name
+func testAccUserDataSource(principalName string) string {
+	return fmt.Sprintf(`
+data "azuredevops_user" "user" {
+	principal_name = "%s"
+}`, principalName)
+}
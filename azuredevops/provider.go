@@ -1,20 +1,97 @@
 package azuredevops
 
 import (
+	"time"
+
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/secretmemo"
+	"golang.org/x/crypto/bcrypt"
 )
 
 // Provider - The top level Azure DevOps Provider definition.
 func Provider() *schema.Provider {
 	p := &schema.Provider{
 		ResourcesMap: map[string]*schema.Resource{
-			"azuredevops_build_definition":     resourceBuildDefinition(),
-			"azuredevops_project":              resourceProject(),
-			"azuredevops_serviceendpoint":      resourceServiceEndpoint(),
-			"azuredevops_azure_git_repository": resourceAzureGitRepository(),
+			"azuredevops_branch_policy_build_validation":    resourceBranchPolicyBuildValidation(),
+			"azuredevops_branch_policy_min_reviewers":       resourceBranchPolicyMinReviewers(),
+			"azuredevops_build_definition":                  resourceBuildDefinition(),
+			"azuredevops_project":                           resourceProject(),
+			"azuredevops_serviceendpoint":                   resourceServiceEndpoint(),
+			"azuredevops_serviceendpoint_aws":               resourceServiceEndpointAws(),
+			"azuredevops_serviceendpoint_dockerregistry":    resourceServiceEndpointDockerRegistry(),
+			"azuredevops_serviceendpoint_generic":           resourceServiceEndpointGeneric(),
+			"azuredevops_serviceendpoint_github":            resourceServiceEndpointGitHub(),
+			"azuredevops_serviceendpoint_kubernetes":        resourceServiceEndpointKubernetes(),
+			"azuredevops_azure_git_repository":              resourceAzureGitRepository(),
+			"azuredevops_git_repository_branch":             resourceGitRepositoryBranch(),
+			"azuredevops_variable_group":                    resourceVariableGroup(),
+			"azuredevops_group":                             resourceGroup(),
+			"azuredevops_group_membership":                  resourceGroupMembership(),
+			"azuredevops_team":                              resourceTeam(),
+			"azuredevops_project_features":                  resourceProjectFeatures(),
+			"azuredevops_agent_pool":                        resourceAgentPool(),
+			"azuredevops_agent_queue":                       resourceAgentQueue(),
+			"azuredevops_project_permissions":               resourceProjectPermissions(),
+			"azuredevops_git_permissions":                   resourceGitPermissions(),
+			"azuredevops_build_folder":                      resourceBuildFolder(),
+			"azuredevops_pipeline_authorization":            resourcePipelineAuthorization(),
+			"azuredevops_serviceendpoint_azurerm":           resourceServiceEndpointAzureRM(),
+			"azuredevops_serviceendpoint_ssh":               resourceServiceEndpointSSH(),
+			"azuredevops_serviceendpoint_nuget":             resourceServiceEndpointNuGet(),
+			"azuredevops_serviceendpoint_npm":               resourceServiceEndpointNpm(),
+			"azuredevops_area_path":                         resourceAreaPath(),
+			"azuredevops_iteration_path":                    resourceIterationPath(),
+			"azuredevops_dashboard":                         resourceDashboard(),
+			"azuredevops_wiki":                              resourceWiki(),
+			"azuredevops_git_repository_file":               resourceGitRepositoryFile(),
+			"azuredevops_user_entitlement":                  resourceUserEntitlement(),
+			"azuredevops_serviceendpoint_servicefabric":     resourceServiceEndpointServiceFabric(),
+			"azuredevops_serviceendpoint_sonarqube":         resourceServiceEndpointSonarQube(),
+			"azuredevops_branch_policy_comment_resolution":  resourceBranchPolicyCommentResolution(),
+			"azuredevops_branch_policy_merge_types":         resourceBranchPolicyMergeTypes(),
+			"azuredevops_branch_policy_status_check":        resourceBranchPolicyStatusCheck(),
+			"azuredevops_project_properties":                resourceProjectProperties(),
+			"azuredevops_environment":                       resourceEnvironment(),
+			"azuredevops_environment_approval":              resourceEnvironmentApproval(),
+			"azuredevops_environment_check":                 resourceEnvironmentCheck(),
+			"azuredevops_environment_kubernetes":            resourceEnvironmentKubernetes(),
+			"azuredevops_serviceendpoint_bitbucket":         resourceServiceEndpointBitbucket(),
+			"azuredevops_serviceendpoint_gcp":               resourceServiceEndpointGcp(),
+			"azuredevops_feed":                              resourceFeed(),
+			"azuredevops_feed_permission":                   resourceFeedPermission(),
+			"azuredevops_serviceendpoint_jenkins":           resourceServiceEndpointJenkins(),
+			"azuredevops_pipeline":                          resourcePipeline(),
+			"azuredevops_serviceendpoint_azurecr":           resourceServiceEndpointAzureCR(),
+			"azuredevops_resource_authorization":            resourceResourceAuthorization(),
+			"azuredevops_securityrole_assignment":           resourceSecurityRoleAssignment(),
+			"azuredevops_serviceendpoint_azure_service_bus": resourceServiceEndpointAzureServiceBus(),
+			"azuredevops_check_business_hours":              resourceCheckBusinessHours(),
+			"azuredevops_check_required_template":           resourceCheckRequiredTemplate(),
+			"azuredevops_git_repository_tag":                resourceGitRepositoryTag(),
+			"azuredevops_extension":                         resourceExtension(),
+			"azuredevops_serviceendpoint_externaltfs":       resourceServiceEndpointExternalTFS(),
+			"azuredevops_team_administrators":               resourceTeamAdministrators(),
+			"azuredevops_team_members":                      resourceTeamMembers(),
+			"azuredevops_project_pipeline_settings":         resourceProjectPipelineSettings(),
+			"azuredevops_serviceendpoint_maven":             resourceServiceEndpointMaven(),
+			"azuredevops_serviceendpoint_incomingwebhook":   resourceServiceEndpointIncomingWebhook(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
-			"azuredevops_group": dataGroup(),
+			"azuredevops_build_definition":  dataBuildDefinition(),
+			"azuredevops_git_repository":    dataGitRepository(),
+			"azuredevops_group":             dataGroup(),
+			"azuredevops_project":           dataProject(),
+			"azuredevops_user":              dataUser(),
+			"azuredevops_serviceendpoints":  dataServiceEndpoints(),
+			"azuredevops_group_memberships": dataGroupMemberships(),
+			"azuredevops_agent_pool":        dataAgentPool(),
+			"azuredevops_agent_pools":       dataAgentPools(),
+			"azuredevops_client_config":     dataClientConfig(),
+			"azuredevops_team":              dataTeam(),
+			"azuredevops_teams":             dataTeams(),
+			"azuredevops_git_repositories":  dataGitRepositories(),
+			"azuredevops_identity":          dataIdentity(),
 		},
 		Schema: map[string]*schema.Schema{
 			"org_service_url": {
@@ -24,11 +101,116 @@ func Provider() *schema.Provider {
 				Description: "The url of the Azure DevOps instance which should be used.",
 			},
 			"personal_access_token": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				DefaultFunc:   schema.EnvDefaultFunc("AZDO_PERSONAL_ACCESS_TOKEN", nil),
+				Description:   "The personal access token which should be used.",
+				Sensitive:     true,
+				ConflictsWith: []string{"aad_token", "use_msi"},
+			},
+			"aad_token": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				DefaultFunc:   schema.EnvDefaultFunc("AZDO_AAD_TOKEN", nil),
+				Description:   "An Azure Active Directory access token which should be used instead of a personal access token.",
+				Sensitive:     true,
+				ConflictsWith: []string{"personal_access_token", "use_msi"},
+			},
+			"use_msi": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				DefaultFunc:   schema.EnvDefaultFunc("AZDO_USE_MSI", false),
+				Description:   "Authenticate with the managed identity of the Azure resource the provider runs on.",
+				ConflictsWith: []string{"personal_access_token", "aad_token"},
+			},
+			"msi_client_id": {
 				Type:        schema.TypeString,
-				Required:    true,
-				DefaultFunc: schema.EnvDefaultFunc("AZDO_PERSONAL_ACCESS_TOKEN", nil),
-				Description: "The personal access token which should be used.",
-				Sensitive:   true,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("AZURE_CLIENT_ID", ""),
+				Description: "The client ID of a user-assigned managed identity. The system-assigned identity is used if it is not set.",
+			},
+			"max_retries": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("AZDO_MAX_RETRIES", 3),
+				Description: "The maximum number of times a request is retried when it is throttled or fails with a transient error.",
+			},
+			"retry_base_delay_ms": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("AZDO_RETRY_BASE_DELAY_MS", 500),
+				Description: "The delay in milliseconds before the first retry. The delay doubles with each subsequent retry unless the service requests a specific delay.",
+			},
+			"client_timeout_seconds": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("AZDO_CLIENT_TIMEOUT_SECONDS", 0),
+				Description: "The maximum duration in seconds of a single API call, including retries. A value of 0 disables the timeout.",
+			},
+			"max_idle_conns": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("AZDO_MAX_IDLE_CONNS", defaultMaxIdleConns),
+				Description:  "The maximum number of idle connections which are kept open for reuse. A value of 0 uses the default.",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"max_idle_conns_per_host": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("AZDO_MAX_IDLE_CONNS_PER_HOST", defaultMaxIdleConnsPerHost),
+				Description:  "The maximum number of idle connections to a single host which are kept open for reuse. A value of 0 uses the default.",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"idle_conn_timeout_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("AZDO_IDLE_CONN_TIMEOUT_SECONDS", int(defaultIdleConnTimeout/time.Second)),
+				Description:  "The duration in seconds after which an idle connection is closed. A value of 0 uses the default.",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"proxy_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("AZDO_PROXY_URL", ""),
+				Description: "The url of the proxy which should be used. The HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables are honored if it is not set.",
+			},
+			"ca_cert_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("AZDO_CA_CERT_FILE", ""),
+				Description: "The path of a file containing PEM encoded CA certificates which should be trusted in addition to the system certificates.",
+			},
+			"ca_cert_pem": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("AZDO_CA_CERT_PEM", ""),
+				Description: "PEM encoded CA certificates which should be trusted in addition to the system certificates.",
+			},
+			"user_agent_suffix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("AZDO_USER_AGENT_SUFFIX", ""),
+				Description: "A string which is appended to the User-Agent of every request, e.g. the name of the application that runs Terraform.",
+			},
+			"http_logging": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("AZDO_HTTP_LOGGING", false),
+				Description: "Log the method, URL, status and request ID of every API call, with credentials redacted. Requires TF_LOG to be set to DEBUG or TRACE.",
+			},
+			"secret_hashing_algorithm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("AZDO_SECRET_HASHING_ALGORITHM", secretmemo.AlgorithmBcrypt),
+				Description:  "The algorithm used to hash secrets stored in the state, either bcrypt or salted-sha256. salted-sha256 is cheaper, but far weaker than bcrypt. Hashes calculated with another algorithm are still recognized.",
+				ValidateFunc: validation.StringInSlice([]string{secretmemo.AlgorithmBcrypt, secretmemo.AlgorithmSaltedSHA256}, false),
+			},
+			"secret_hashing_bcrypt_cost": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("AZDO_SECRET_HASHING_BCRYPT_COST", bcrypt.MinCost),
+				Description:  "The cost of hashing secrets stored in the state with bcrypt.",
+				ValidateFunc: validation.IntBetween(bcrypt.MinCost, bcrypt.MaxCost),
 			},
 		},
 	}
@@ -40,7 +222,31 @@ func Provider() *schema.Provider {
 
 func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
 	return func(d *schema.ResourceData) (interface{}, error) {
-		client, err := getAzdoClient(d.Get("personal_access_token").(string), d.Get("org_service_url").(string))
+		settings := &transportSettings{
+			maxRetries:          d.Get("max_retries").(int),
+			retryBaseDelay:      time.Duration(d.Get("retry_base_delay_ms").(int)) * time.Millisecond,
+			clientTimeout:       time.Duration(d.Get("client_timeout_seconds").(int)) * time.Second,
+			proxyURL:            d.Get("proxy_url").(string),
+			caCertFile:          d.Get("ca_cert_file").(string),
+			caCertPEM:           d.Get("ca_cert_pem").(string),
+			maxIdleConns:        d.Get("max_idle_conns").(int),
+			maxIdleConnsPerHost: d.Get("max_idle_conns_per_host").(int),
+			idleConnTimeout:     time.Duration(d.Get("idle_conn_timeout_seconds").(int)) * time.Second,
+			userAgentSuffix:     d.Get("user_agent_suffix").(string),
+			logHTTP:             d.Get("http_logging").(bool),
+		}
+		auth := &authSettings{
+			personalAccessToken: d.Get("personal_access_token").(string),
+			aadToken:            d.Get("aad_token").(string),
+			useMSI:              d.Get("use_msi").(bool),
+			msiClientID:         d.Get("msi_client_id").(string),
+		}
+		secretSettings, err := secretmemo.NewSettings(d.Get("secret_hashing_algorithm").(string), d.Get("secret_hashing_bcrypt_cost").(int))
+		if err != nil {
+			return nil, err
+		}
+
+		client, err := getAzdoClient(auth, d.Get("org_service_url").(string), settings, secretSettings)
 		return client, err
 	}
 }
@@ -1,12 +1,16 @@
 package azuredevops
 
 import (
+	"net/http/httptest"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/secretmemo"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
 )
 
 var provider = Provider()
@@ -15,10 +19,69 @@ var provider = Provider()
 
 func TestAzureDevOpsProvider_HasChildResources(t *testing.T) {
 	expectedResources := []string{
+		"azuredevops_branch_policy_build_validation",
+		"azuredevops_branch_policy_min_reviewers",
 		"azuredevops_build_definition",
 		"azuredevops_project",
 		"azuredevops_serviceendpoint",
+		"azuredevops_serviceendpoint_aws",
+		"azuredevops_serviceendpoint_dockerregistry",
+		"azuredevops_serviceendpoint_generic",
+		"azuredevops_serviceendpoint_github",
+		"azuredevops_serviceendpoint_kubernetes",
 		"azuredevops_azure_git_repository",
+		"azuredevops_git_repository_branch",
+		"azuredevops_variable_group",
+		"azuredevops_group",
+		"azuredevops_group_membership",
+		"azuredevops_team",
+		"azuredevops_project_features",
+		"azuredevops_agent_pool",
+		"azuredevops_agent_queue",
+		"azuredevops_project_permissions",
+		"azuredevops_git_permissions",
+		"azuredevops_build_folder",
+		"azuredevops_pipeline_authorization",
+		"azuredevops_serviceendpoint_azurerm",
+		"azuredevops_serviceendpoint_ssh",
+		"azuredevops_serviceendpoint_nuget",
+		"azuredevops_serviceendpoint_npm",
+		"azuredevops_area_path",
+		"azuredevops_iteration_path",
+		"azuredevops_dashboard",
+		"azuredevops_wiki",
+		"azuredevops_git_repository_file",
+		"azuredevops_user_entitlement",
+		"azuredevops_serviceendpoint_servicefabric",
+		"azuredevops_serviceendpoint_sonarqube",
+		"azuredevops_branch_policy_comment_resolution",
+		"azuredevops_branch_policy_merge_types",
+		"azuredevops_branch_policy_status_check",
+		"azuredevops_project_properties",
+		"azuredevops_environment",
+		"azuredevops_environment_approval",
+		"azuredevops_environment_check",
+		"azuredevops_environment_kubernetes",
+		"azuredevops_serviceendpoint_bitbucket",
+		"azuredevops_serviceendpoint_gcp",
+		"azuredevops_feed",
+		"azuredevops_feed_permission",
+		"azuredevops_serviceendpoint_jenkins",
+		"azuredevops_pipeline",
+		"azuredevops_serviceendpoint_azurecr",
+		"azuredevops_resource_authorization",
+		"azuredevops_securityrole_assignment",
+		"azuredevops_serviceendpoint_azure_service_bus",
+		"azuredevops_check_business_hours",
+		"azuredevops_check_required_template",
+		"azuredevops_git_repository_tag",
+		"azuredevops_extension",
+		"azuredevops_serviceendpoint_externaltfs",
+		"azuredevops_team_administrators",
+		"azuredevops_team_members",
+		"azuredevops_project_pipeline_settings",
+		"azuredevops_serviceendpoint_maven",
+		"azuredevops_serviceendpoint_incomingwebhook",
 	}
 
 	resources := provider.ResourcesMap
@@ -32,7 +95,20 @@ func TestAzureDevOpsProvider_HasChildResources(t *testing.T) {
 
 func TestAzureDevOpsProvider_HasChildDataSources(t *testing.T) {
 	expectedDataSources := []string{
+		"azuredevops_build_definition",
+		"azuredevops_git_repository",
 		"azuredevops_group",
+		"azuredevops_project",
+		"azuredevops_user",
+		"azuredevops_serviceendpoints",
+		"azuredevops_group_memberships",
+		"azuredevops_agent_pool",
+		"azuredevops_agent_pools",
+		"azuredevops_client_config",
+		"azuredevops_team",
+		"azuredevops_teams",
+		"azuredevops_git_repositories",
+		"azuredevops_identity",
 	}
 
 	dataSources := provider.DataSourcesMap
@@ -54,7 +130,23 @@ func TestAzureDevOpsProvider_SchemaIsValid(t *testing.T) {
 
 	tests := []testParams{
 		{"org_service_url", true, "AZDO_ORG_SERVICE_URL", false},
-		{"personal_access_token", true, "AZDO_PERSONAL_ACCESS_TOKEN", true},
+		{"personal_access_token", false, "AZDO_PERSONAL_ACCESS_TOKEN", true},
+		{"aad_token", false, "AZDO_AAD_TOKEN", true},
+		{"use_msi", false, "AZDO_USE_MSI", false},
+		{"msi_client_id", false, "AZURE_CLIENT_ID", false},
+		{"max_retries", false, "AZDO_MAX_RETRIES", false},
+		{"retry_base_delay_ms", false, "AZDO_RETRY_BASE_DELAY_MS", false},
+		{"client_timeout_seconds", false, "AZDO_CLIENT_TIMEOUT_SECONDS", false},
+		{"max_idle_conns", false, "AZDO_MAX_IDLE_CONNS", false},
+		{"max_idle_conns_per_host", false, "AZDO_MAX_IDLE_CONNS_PER_HOST", false},
+		{"idle_conn_timeout_seconds", false, "AZDO_IDLE_CONN_TIMEOUT_SECONDS", false},
+		{"proxy_url", false, "AZDO_PROXY_URL", false},
+		{"ca_cert_file", false, "AZDO_CA_CERT_FILE", false},
+		{"ca_cert_pem", false, "AZDO_CA_CERT_PEM", false},
+		{"user_agent_suffix", false, "AZDO_USER_AGENT_SUFFIX", false},
+		{"http_logging", false, "AZDO_HTTP_LOGGING", false},
+		{"secret_hashing_algorithm", false, "AZDO_SECRET_HASHING_ALGORITHM", false},
+		{"secret_hashing_bcrypt_cost", false, "AZDO_SECRET_HASHING_BCRYPT_COST", false},
 	}
 
 	schema := provider.Schema
@@ -76,6 +168,57 @@ func TestAzureDevOpsProvider_SchemaIsValid(t *testing.T) {
 	}
 }
 
+// Clears the environment variables that provide defaults for the provider settings, e.g. the ones set by
+// TestAzureDevOpsProvider_SchemaIsValid, and returns a function that restores them
+func clearProviderEnvironment() func() {
+	saved := map[string]string{}
+	for _, env := range os.Environ() {
+		name := strings.SplitN(env, "=", 2)[0]
+		if strings.HasPrefix(name, "AZDO_") || name == "AZURE_CLIENT_ID" {
+			saved[name] = os.Getenv(name)
+			os.Unsetenv(name)
+		}
+	}
+	return func() {
+		for name, value := range saved {
+			os.Setenv(name, value)
+		}
+	}
+}
+
+// verifies that two providers, e.g. aliased ones, that are configured with different secret hashing settings
+// hash the secrets of their resources with their own settings
+func TestAzureDevOpsProvider_Configure_HashesSecretsWithSettingsOfProvider(t *testing.T) {
+	defer clearProviderEnvironment()()
+	server := httptest.NewServer(&fakeOrganization{})
+	defer server.Close()
+
+	configure := func(algorithm string, bcryptCost int) *aggregatedClient {
+		p := Provider()
+		err := p.Configure(terraform.NewResourceConfigRaw(map[string]interface{}{
+			"org_service_url":            server.URL,
+			"personal_access_token":      "pat",
+			"secret_hashing_algorithm":   algorithm,
+			"secret_hashing_bcrypt_cost": bcryptCost,
+		}))
+		require.Nil(t, err)
+		return p.Meta().(*aggregatedClient)
+	}
+	saltedClients := configure(secretmemo.AlgorithmSaltedSHA256, bcrypt.MinCost)
+	bcryptClients := configure(secretmemo.AlgorithmBcrypt, bcrypt.MinCost+1)
+
+	passwordHash := func(clients *aggregatedClient) string {
+		resourceData := schema.TestResourceDataRaw(t, resourceServiceEndpointGeneric().Schema, map[string]interface{}{"password": "mysecret"})
+		serviceEndpointGenericArgs.flatten(resourceData, &genericTestServiceEndpoint, genericTestServiceEndpointProjectID, clients.secretSettings)
+		return resourceData.Get("password_hash").(string)
+	}
+
+	require.True(t, strings.HasPrefix(passwordHash(saltedClients), "$salted-sha256$"))
+	cost, err := bcrypt.Cost([]byte(passwordHash(bcryptClients)))
+	require.Nil(t, err)
+	require.Equal(t, bcrypt.MinCost+1, cost)
+}
+
 // The configuration below can be used for every acceptance test in the project. For that reason,
 // it will be defined once and will live in this file.
 
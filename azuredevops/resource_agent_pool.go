@@ -0,0 +1,153 @@
+package azuredevops
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/azdoerror"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+)
+
+func resourceAgentPool() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAgentPoolCreate,
+		Read:   resourceAgentPoolRead,
+		Update: resourceAgentPoolUpdate,
+		Delete: resourceAgentPoolDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"auto_provision": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"pool_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  string(taskagent.TaskAgentPoolTypeValues.Automation),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(taskagent.TaskAgentPoolTypeValues.Automation),
+					string(taskagent.TaskAgentPoolTypeValues.Deployment),
+				}, false),
+			},
+		},
+	}
+}
+
+func resourceAgentPoolCreate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+
+	agentPool, err := clients.TaskAgentClient.AddAgentPool(clients.ctx, taskagent.AddAgentPoolArgs{
+		Pool: expandAgentPool(d),
+	})
+	if err != nil {
+		return fmt.Errorf("Error creating agent pool %s. Error: %v", d.Get("name"), err)
+	}
+
+	d.SetId(strconv.Itoa(*agentPool.Id))
+	return resourceAgentPoolRead(d, m)
+}
+
+func resourceAgentPoolRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	poolID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return fmt.Errorf("Error parsing the agent pool ID %s: %v", d.Id(), err)
+	}
+
+	agentPool, err := clients.TaskAgentClient.GetAgentPool(clients.ctx, taskagent.GetAgentPoolArgs{
+		PoolId: &poolID,
+	})
+	if err != nil {
+		if azdoerror.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error looking up agent pool with ID %d. Error: %v", poolID, err)
+	}
+	if agentPool == nil || agentPool.Id == nil {
+		d.SetId("")
+		return nil
+	}
+
+	flattenAgentPool(d, agentPool)
+	return nil
+}
+
+func resourceAgentPoolUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	poolID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return fmt.Errorf("Error parsing the agent pool ID %s: %v", d.Id(), err)
+	}
+
+	_, err = clients.TaskAgentClient.UpdateAgentPool(clients.ctx, taskagent.UpdateAgentPoolArgs{
+		Pool:   expandAgentPool(d),
+		PoolId: &poolID,
+	})
+	if err != nil {
+		return fmt.Errorf("Error updating agent pool with ID %d. Error: %v", poolID, err)
+	}
+
+	return resourceAgentPoolRead(d, m)
+}
+
+// Deleting a pool would orphan the agents that are registered with it, so pools with agents are not deleted
+func resourceAgentPoolDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	poolID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return fmt.Errorf("Error parsing the agent pool ID %s: %v", d.Id(), err)
+	}
+
+	agents, err := clients.TaskAgentClient.GetAgents(clients.ctx, taskagent.GetAgentsArgs{
+		PoolId: &poolID,
+	})
+	if err != nil {
+		return fmt.Errorf("Error listing the agents of agent pool with ID %d. Error: %v", poolID, err)
+	}
+	if agents != nil && len(*agents) > 0 {
+		return fmt.Errorf("Agent pool with ID %d cannot be deleted because %d agents are still registered with it. Remove the agents from the pool first", poolID, len(*agents))
+	}
+
+	err = clients.TaskAgentClient.DeleteAgentPool(clients.ctx, taskagent.DeleteAgentPoolArgs{
+		PoolId: &poolID,
+	})
+	if err != nil {
+		return fmt.Errorf("Error deleting agent pool with ID %d. Error: %v", poolID, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// Convert internal Terraform data structure to an AzDO data structure
+func expandAgentPool(d *schema.ResourceData) *taskagent.TaskAgentPool {
+	poolType := taskagent.TaskAgentPoolType(d.Get("pool_type").(string))
+	return &taskagent.TaskAgentPool{
+		Name:          converter.String(d.Get("name").(string)),
+		AutoProvision: converter.Bool(d.Get("auto_provision").(bool)),
+		PoolType:      &poolType,
+	}
+}
+
+// Convert AzDO data structure to internal Terraform data structure
+func flattenAgentPool(d *schema.ResourceData, agentPool *taskagent.TaskAgentPool) {
+	d.SetId(strconv.Itoa(*agentPool.Id))
+	d.Set("name", converter.ToString(agentPool.Name, ""))
+	d.Set("auto_provision", converter.ToBool(agentPool.AutoProvision, false))
+	if agentPool.PoolType != nil {
+		d.Set("pool_type", string(*agentPool.PoolType))
+	}
+}
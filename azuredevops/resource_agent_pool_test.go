@@ -0,0 +1,213 @@
+package azuredevops
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/microsoft/azure-devops-go-api/azuredevops"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var testAgentPoolID = 42
+var testAgentPoolType = taskagent.TaskAgentPoolTypeValues.Deployment
+
+var testAgentPool = taskagent.TaskAgentPool{
+	Id:            &testAgentPoolID,
+	Name:          converter.String("Pool"),
+	AutoProvision: converter.Bool(true),
+	PoolType:      &testAgentPoolType,
+}
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that the flatten/expand round trip yields the same agent pool
+func TestAzureDevOpsAgentPool_ExpandFlatten_Roundtrip(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, resourceAgentPool().Schema, nil)
+	flattenAgentPool(resourceData, &testAgentPool)
+
+	agentPoolAfterRoundTrip := expandAgentPool(resourceData)
+	require.Equal(t, strconv.Itoa(testAgentPoolID), resourceData.Id())
+	require.Equal(t, *testAgentPool.Name, *agentPoolAfterRoundTrip.Name)
+	require.Equal(t, *testAgentPool.AutoProvision, *agentPoolAfterRoundTrip.AutoProvision)
+	require.Equal(t, *testAgentPool.PoolType, *agentPoolAfterRoundTrip.PoolType)
+}
+
+// verifies that a pool is not deleted while agents are registered with it
+func TestAzureDevOpsAgentPool_Delete_FailsIfAgentsAreRegistered(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskAgentClient := azdosdkmocks.NewMockTaskagentClient(ctrl)
+	clients := &aggregatedClient{TaskAgentClient: taskAgentClient, ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceAgentPool().Schema, nil)
+	flattenAgentPool(resourceData, &testAgentPool)
+
+	taskAgentClient.
+		EXPECT().
+		GetAgents(clients.ctx, taskagent.GetAgentsArgs{PoolId: &testAgentPoolID}).
+		Return(&[]taskagent.TaskAgent{{Name: converter.String("agent")}}, nil).
+		Times(1)
+	taskAgentClient.
+		EXPECT().
+		DeleteAgentPool(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	err := resourceAgentPoolDelete(resourceData, clients)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "1 agents are still registered")
+}
+
+// verifies that an empty pool is deleted
+func TestAzureDevOpsAgentPool_Delete_DeletesEmptyPool(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskAgentClient := azdosdkmocks.NewMockTaskagentClient(ctrl)
+	clients := &aggregatedClient{TaskAgentClient: taskAgentClient, ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceAgentPool().Schema, nil)
+	flattenAgentPool(resourceData, &testAgentPool)
+
+	taskAgentClient.
+		EXPECT().
+		GetAgents(clients.ctx, taskagent.GetAgentsArgs{PoolId: &testAgentPoolID}).
+		Return(&[]taskagent.TaskAgent{}, nil).
+		Times(1)
+	taskAgentClient.
+		EXPECT().
+		DeleteAgentPool(clients.ctx, taskagent.DeleteAgentPoolArgs{PoolId: &testAgentPoolID}).
+		Return(nil).
+		Times(1)
+
+	err := resourceAgentPoolDelete(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "", resourceData.Id())
+}
+
+// verifies that a pool that no longer exists is removed from the state
+func TestAzureDevOpsAgentPool_Read_ClearsIDOfMissingPool(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskAgentClient := azdosdkmocks.NewMockTaskagentClient(ctrl)
+	clients := &aggregatedClient{TaskAgentClient: taskAgentClient, ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceAgentPool().Schema, nil)
+	resourceData.SetId(strconv.Itoa(testAgentPoolID))
+
+	statusCode := http.StatusNotFound
+	taskAgentClient.
+		EXPECT().
+		GetAgentPool(clients.ctx, gomock.Any()).
+		Return(nil, azuredevops.WrappedError{StatusCode: &statusCode}).
+		Times(1)
+
+	err := resourceAgentPoolRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "", resourceData.Id())
+}
+
+// verifies that if an error is produced on create, the error is not swallowed
+func TestAzureDevOpsAgentPool_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskAgentClient := azdosdkmocks.NewMockTaskagentClient(ctrl)
+	clients := &aggregatedClient{TaskAgentClient: taskAgentClient, ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceAgentPool().Schema, nil)
+	flattenAgentPool(resourceData, &testAgentPool)
+
+	taskAgentClient.
+		EXPECT().
+		AddAgentPool(clients.ctx, taskagent.AddAgentPoolArgs{Pool: expandAgentPool(resourceData)}).
+		Return(nil, errors.New("AddAgentPool() Failed")).
+		Times(1)
+
+	err := resourceAgentPoolCreate(resourceData, clients)
+	require.Contains(t, err.Error(), "AddAgentPool() Failed")
+}
+
+/**
+ * Begin acceptance tests
+ */
+
+// Verifies that an agent pool can be created, updated and imported
+func TestAccAzureDevOpsAgentPool_CreateAndUpdate(t *testing.T) {
+	poolName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	poolNameUpdated := poolName + "-updated"
+	tfNode := "azuredevops_agent_pool.pool"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccAgentPoolCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAgentPoolResource(poolName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfNode, "id"),
+					resource.TestCheckResourceAttr(tfNode, "name", poolName),
+					resource.TestCheckResourceAttr(tfNode, "auto_provision", "false"),
+					resource.TestCheckResourceAttr(tfNode, "pool_type", "automation"),
+				),
+			}, {
+				Config: testAccAgentPoolResource(poolNameUpdated, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "name", poolNameUpdated),
+					resource.TestCheckResourceAttr(tfNode, "auto_provision", "true"),
+				),
+			}, {
+				ResourceName:      tfNode,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// HCL describing an agent pool
+func testAccAgentPoolResource(poolName string, autoProvision bool) string {
+	return fmt.Sprintf(`
+resource "azuredevops_agent_pool" "pool" {
+	name           = "%s"
+	auto_provision = %t
+}`, poolName, autoProvision)
+}
+
+// verifies that all agent pools referenced in the state are destroyed
+func testAccAgentPoolCheckDestroy(s *terraform.State) error {
+	clients := testAccProvider.Meta().(*aggregatedClient)
+
+	for _, res := range s.RootModule().Resources {
+		if res.Type != "azuredevops_agent_pool" {
+			continue
+		}
+
+		poolID, err := strconv.Atoi(res.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		pool, err := clients.TaskAgentClient.GetAgentPool(clients.ctx, taskagent.GetAgentPoolArgs{PoolId: &poolID})
+		if err == nil && pool != nil && pool.Id != nil {
+			return fmt.Errorf("Agent pool with ID %d should not exist", poolID)
+		}
+	}
+	return nil
+}
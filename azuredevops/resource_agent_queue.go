@@ -0,0 +1,176 @@
+package azuredevops
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/azdoerror"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+)
+
+func resourceAgentQueue() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAgentQueueCreate,
+		Read:   resourceAgentQueueRead,
+		Delete: resourceAgentQueueDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAgentQueueImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"agent_pool_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAgentQueueCreate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	projectID := d.Get("project_id").(string)
+	poolID := d.Get("agent_pool_id").(int)
+
+	// pools that are provisioned automatically already have a queue in the project, which is used instead
+	queue, err := findAgentQueueForPool(clients, projectID, poolID)
+	if err != nil {
+		return err
+	}
+
+	if queue == nil {
+		pool, err := clients.TaskAgentClient.GetAgentPool(clients.ctx, taskagent.GetAgentPoolArgs{PoolId: &poolID})
+		if err != nil {
+			return fmt.Errorf("Error looking up agent pool with ID %d. Error: %v", poolID, err)
+		}
+
+		queue, err = clients.TaskAgentClient.AddAgentQueue(clients.ctx, taskagent.AddAgentQueueArgs{
+			Queue: &taskagent.TaskAgentQueue{
+				Name: pool.Name,
+				Pool: &taskagent.TaskAgentPoolReference{Id: &poolID},
+			},
+			Project:            &projectID,
+			AuthorizePipelines: converter.Bool(false),
+		})
+		if err != nil {
+			return fmt.Errorf("Error creating agent queue for agent pool with ID %d in project %s. Error: %v", poolID, projectID, err)
+		}
+	}
+
+	d.SetId(strconv.Itoa(*queue.Id))
+	return resourceAgentQueueRead(d, m)
+}
+
+func resourceAgentQueueRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	projectID, queueID, err := parseAgentQueueIdentifiers(d)
+	if err != nil {
+		return err
+	}
+
+	queue, err := clients.TaskAgentClient.GetAgentQueue(clients.ctx, taskagent.GetAgentQueueArgs{
+		QueueId: &queueID,
+		Project: &projectID,
+	})
+	if err != nil {
+		if azdoerror.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error looking up agent queue with ID %d in project %s. Error: %v", queueID, projectID, err)
+	}
+
+	// the service responds without a queue rather than with an error if the queue does not exist
+	if queue == nil || queue.Id == nil {
+		d.SetId("")
+		return nil
+	}
+
+	flattenAgentQueue(d, queue)
+	return nil
+}
+
+func resourceAgentQueueDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	projectID, queueID, err := parseAgentQueueIdentifiers(d)
+	if err != nil {
+		return err
+	}
+
+	err = clients.TaskAgentClient.DeleteAgentQueue(clients.ctx, taskagent.DeleteAgentQueueArgs{
+		QueueId: &queueID,
+		Project: &projectID,
+	})
+	if err != nil {
+		return fmt.Errorf("Error deleting agent queue with ID %d in project %s. Error: %v", queueID, projectID, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// Imports an agent queue given an ID of the form <projectID>/<queueID>
+func resourceAgentQueueImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%s), expected projectid/queueid", d.Id())
+	}
+
+	if _, err := strconv.Atoi(parts[1]); err != nil {
+		return nil, fmt.Errorf("Agent queue ID (%s) is not a valid integer", parts[1])
+	}
+
+	d.Set("project_id", parts[0])
+	d.SetId(parts[1])
+	return []*schema.ResourceData{d}, nil
+}
+
+// Returns the queue in the project that references the pool, or nil if there is none
+func findAgentQueueForPool(clients *aggregatedClient, projectID string, poolID int) (*taskagent.TaskAgentQueue, error) {
+	queues, err := clients.TaskAgentClient.GetAgentQueues(clients.ctx, taskagent.GetAgentQueuesArgs{
+		Project: &projectID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error listing the agent queues of project %s. Error: %v", projectID, err)
+	}
+
+	if queues != nil {
+		for _, queue := range *queues {
+			if queue.Pool != nil && queue.Pool.Id != nil && *queue.Pool.Id == poolID {
+				return &queue, nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+func parseAgentQueueIdentifiers(d *schema.ResourceData) (string, int, error) {
+	projectID := d.Get("project_id").(string)
+	queueID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return "", 0, fmt.Errorf("Error parsing the agent queue ID %s: %v", d.Id(), err)
+	}
+	return projectID, queueID, nil
+}
+
+// Convert AzDO data structure to internal Terraform data structure
+func flattenAgentQueue(d *schema.ResourceData, queue *taskagent.TaskAgentQueue) {
+	d.SetId(strconv.Itoa(*queue.Id))
+	d.Set("name", converter.ToString(queue.Name, ""))
+	if queue.Pool != nil && queue.Pool.Id != nil {
+		d.Set("agent_pool_id", *queue.Pool.Id)
+	}
+}
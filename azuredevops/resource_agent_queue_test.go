@@ -0,0 +1,236 @@
+package azuredevops
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var testAgentQueueProjectID = "project-id"
+var testAgentQueueID = 7
+
+var testAgentQueue = taskagent.TaskAgentQueue{
+	Id:   &testAgentQueueID,
+	Name: converter.String("Pool"),
+	Pool: &taskagent.TaskAgentPoolReference{Id: &testAgentPoolID},
+}
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that a queue is created for the pool if the project has no queue for it yet
+func TestAzureDevOpsAgentQueue_Create_CreatesQueue(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskAgentClient := azdosdkmocks.NewMockTaskagentClient(ctrl)
+	clients := &aggregatedClient{TaskAgentClient: taskAgentClient, ctx: context.Background()}
+
+	resourceData := createAgentQueueResourceData(t)
+
+	taskAgentClient.
+		EXPECT().
+		GetAgentQueues(clients.ctx, taskagent.GetAgentQueuesArgs{Project: &testAgentQueueProjectID}).
+		Return(&[]taskagent.TaskAgentQueue{}, nil).
+		Times(1)
+	taskAgentClient.
+		EXPECT().
+		GetAgentPool(clients.ctx, taskagent.GetAgentPoolArgs{PoolId: &testAgentPoolID}).
+		Return(&testAgentPool, nil).
+		Times(1)
+	taskAgentClient.
+		EXPECT().
+		AddAgentQueue(clients.ctx, taskagent.AddAgentQueueArgs{
+			Queue: &taskagent.TaskAgentQueue{
+				Name: testAgentPool.Name,
+				Pool: &taskagent.TaskAgentPoolReference{Id: &testAgentPoolID},
+			},
+			Project:            &testAgentQueueProjectID,
+			AuthorizePipelines: converter.Bool(false),
+		}).
+		Return(&testAgentQueue, nil).
+		Times(1)
+	expectGetAgentQueue(taskAgentClient, &testAgentQueue)
+
+	err := resourceAgentQueueCreate(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, strconv.Itoa(testAgentQueueID), resourceData.Id())
+	require.Equal(t, "Pool", resourceData.Get("name"))
+}
+
+// verifies that the queue that has been provisioned automatically for the pool is used
+func TestAzureDevOpsAgentQueue_Create_UsesProvisionedQueue(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskAgentClient := azdosdkmocks.NewMockTaskagentClient(ctrl)
+	clients := &aggregatedClient{TaskAgentClient: taskAgentClient, ctx: context.Background()}
+
+	resourceData := createAgentQueueResourceData(t)
+
+	defaultQueue := taskagent.TaskAgentQueue{
+		Id:   converter.Int(1),
+		Name: converter.String("Default"),
+		Pool: &taskagent.TaskAgentPoolReference{Id: &testAgentPoolID},
+	}
+	taskAgentClient.
+		EXPECT().
+		GetAgentQueues(clients.ctx, gomock.Any()).
+		Return(&[]taskagent.TaskAgentQueue{defaultQueue}, nil).
+		Times(1)
+	taskAgentClient.
+		EXPECT().
+		AddAgentQueue(gomock.Any(), gomock.Any()).
+		Times(0)
+	expectGetAgentQueue(taskAgentClient, &defaultQueue)
+
+	err := resourceAgentQueueCreate(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "1", resourceData.Id())
+	require.Equal(t, "Default", resourceData.Get("name"))
+}
+
+// verifies that a queue that no longer exists is removed from the state
+func TestAzureDevOpsAgentQueue_Read_ClearsIDOfMissingQueue(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskAgentClient := azdosdkmocks.NewMockTaskagentClient(ctrl)
+	clients := &aggregatedClient{TaskAgentClient: taskAgentClient, ctx: context.Background()}
+
+	resourceData := createAgentQueueResourceData(t)
+	resourceData.SetId(strconv.Itoa(testAgentQueueID))
+
+	taskAgentClient.
+		EXPECT().
+		GetAgentQueue(clients.ctx, gomock.Any()).
+		Return(nil, nil).
+		Times(1)
+
+	err := resourceAgentQueueRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "", resourceData.Id())
+}
+
+// verifies that a queue can be imported by the project ID and queue ID
+func TestAzureDevOpsAgentQueue_Import_ParsesID(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, resourceAgentQueue().Schema, nil)
+	resourceData.SetId(testAgentQueueProjectID + "/7")
+
+	result, err := resourceAgentQueueImport(resourceData, nil)
+	require.Nil(t, err)
+	require.Len(t, result, 1)
+	require.Equal(t, "7", result[0].Id())
+	require.Equal(t, testAgentQueueProjectID, result[0].Get("project_id"))
+
+	for _, id := range []string{"7", "project/", "project/queue"} {
+		resourceData.SetId(id)
+		_, err = resourceAgentQueueImport(resourceData, nil)
+		require.NotNil(t, err, id)
+	}
+}
+
+// verifies that if an error is produced on delete, the error is not swallowed
+func TestAzureDevOpsAgentQueue_Delete_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskAgentClient := azdosdkmocks.NewMockTaskagentClient(ctrl)
+	clients := &aggregatedClient{TaskAgentClient: taskAgentClient, ctx: context.Background()}
+
+	resourceData := createAgentQueueResourceData(t)
+	resourceData.SetId(strconv.Itoa(testAgentQueueID))
+
+	taskAgentClient.
+		EXPECT().
+		DeleteAgentQueue(clients.ctx, taskagent.DeleteAgentQueueArgs{
+			QueueId: &testAgentQueueID,
+			Project: &testAgentQueueProjectID,
+		}).
+		Return(errors.New("DeleteAgentQueue() Failed")).
+		Times(1)
+
+	err := resourceAgentQueueDelete(resourceData, clients)
+	require.Contains(t, err.Error(), "DeleteAgentQueue() Failed")
+}
+
+func createAgentQueueResourceData(t *testing.T) *schema.ResourceData {
+	return schema.TestResourceDataRaw(t, resourceAgentQueue().Schema, map[string]interface{}{
+		"project_id":    testAgentQueueProjectID,
+		"agent_pool_id": testAgentPoolID,
+	})
+}
+
+func expectGetAgentQueue(taskAgentClient *azdosdkmocks.MockTaskagentClient, queue *taskagent.TaskAgentQueue) *gomock.Call {
+	return taskAgentClient.
+		EXPECT().
+		GetAgentQueue(gomock.Any(), taskagent.GetAgentQueueArgs{
+			QueueId: queue.Id,
+			Project: &testAgentQueueProjectID,
+		}).
+		Return(queue, nil).
+		Times(1)
+}
+
+/**
+ * Begin acceptance tests
+ */
+
+// Verifies that a queue can be created for an agent pool and that it can be imported
+func TestAccAzureDevOpsAgentQueue_CreateAndImport(t *testing.T) {
+	projectName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	poolName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	tfNode := "azuredevops_agent_queue.queue"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAgentQueueResource(projectName, poolName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfNode, "id"),
+					resource.TestCheckResourceAttr(tfNode, "name", poolName),
+				),
+			}, {
+				ResourceName:      tfNode,
+				ImportState:       true,
+				ImportStateIdFunc: testAccAgentQueueImportStateID(tfNode),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// HCL describing a queue for an agent pool in a project
+func testAccAgentQueueResource(projectName string, poolName string) string {
+	queueResource := `
+resource "azuredevops_agent_queue" "queue" {
+	project_id    = azuredevops_project.project.id
+	agent_pool_id = azuredevops_agent_pool.pool.id
+}`
+
+	projectResource := testAccProjectResource(projectName)
+	poolResource := testAccAgentPoolResource(poolName, false)
+	return fmt.Sprintf("%s\n%s\n%s", projectResource, poolResource, queueResource)
+}
+
+func testAccAgentQueueImportStateID(tfNode string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		res := s.RootModule().Resources[tfNode]
+		return fmt.Sprintf("%s/%s", res.Primary.Attributes["project_id"], res.Primary.ID), nil
+	}
+}
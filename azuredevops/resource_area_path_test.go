@@ -0,0 +1,355 @@
+package azuredevops
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/microsoft/azure-devops-go-api/azuredevops"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/workitemtracking"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var testAreaPathProjectID = "project-id"
+var testAreaPathGroup = workitemtracking.TreeStructureGroupValues.Areas
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that paths are split into node names and joined to the form used by the service
+func TestAzureDevOpsAreaPath_SplitAndJoinPath(t *testing.T) {
+	tests := map[string]string{
+		`Team`:         `\Team`,
+		`\Team\`:       `\Team`,
+		`Team/Backend`: `\Team\Backend`,
+		`\\Team\\\Sub`: `\Team\Sub`,
+		`\`:            `\`,
+		``:             `\`,
+	}
+
+	for path, expected := range tests {
+		require.Equal(t, expected, joinClassificationNodePath(splitClassificationNodePath(path)), path)
+	}
+
+	require.Equal(t, "Team/Backend", *classificationNodeRoute(splitClassificationNodePath(`\Team\Backend`)))
+	require.Equal(t, "", *classificationNodeRoute(splitClassificationNodePath(`\`)))
+	require.True(t, suppressEquivalentClassificationNodePaths("path", `\Team`, "team/", nil))
+	require.False(t, suppressEquivalentClassificationNodePaths("path", `\Team`, `\Team\Backend`, nil))
+
+	_, errs := validateClassificationNodeName(`Team\Backend`, "name")
+	require.Len(t, errs, 1)
+	_, errs = validateClassificationNodeName(" ", "name")
+	require.Len(t, errs, 1)
+}
+
+// verifies that a node is created below its parent, and that its path is flattened relative to the root
+func TestAzureDevOpsAreaPath_Create_CreatesNodeBelowParent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	witClient := azdosdkmocks.NewMockWorkitemtrackingClient(ctrl)
+	clients := &aggregatedClient{WorkItemTrackingClient: witClient, ctx: context.Background()}
+
+	resourceData := createAreaPathResourceData(t, `Team/`, "Backend")
+
+	expectGetClassificationNode(witClient, "Team", testClassificationNode(10, `\Project\Area\Team`))
+	witClient.
+		EXPECT().
+		CreateOrUpdateClassificationNode(clients.ctx, workitemtracking.CreateOrUpdateClassificationNodeArgs{
+			PostedNode:     &workitemtracking.WorkItemClassificationNode{Name: converter.String("Backend")},
+			Project:        &testAreaPathProjectID,
+			StructureGroup: &testAreaPathGroup,
+			Path:           converter.String("Team"),
+		}).
+		Return(testClassificationNode(11, `\Project\Area\Team\Backend`), nil).
+		Times(1)
+
+	err := resourceAreaPath().Create(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "11", resourceData.Id())
+	require.Equal(t, `\Team`, resourceData.Get("path"))
+	require.Equal(t, "Backend", resourceData.Get("name"))
+}
+
+// verifies that a node is not created if its parent does not exist
+func TestAzureDevOpsAreaPath_Create_ErrorsIfParentIsMissing(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	witClient := azdosdkmocks.NewMockWorkitemtrackingClient(ctrl)
+	clients := &aggregatedClient{WorkItemTrackingClient: witClient, ctx: context.Background()}
+
+	resourceData := createAreaPathResourceData(t, `\Team`, "Backend")
+
+	notFound := http.StatusNotFound
+	witClient.
+		EXPECT().
+		GetClassificationNode(clients.ctx, gomock.Any()).
+		Return(nil, azuredevops.WrappedError{StatusCode: &notFound}).
+		Times(1)
+	witClient.
+		EXPECT().
+		CreateOrUpdateClassificationNode(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	err := resourceAreaPath().Create(resourceData, clients)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), `parent area path \Team does not exist`)
+}
+
+// verifies that a node that no longer exists is removed from the state
+func TestAzureDevOpsAreaPath_Read_RemovesMissingNodeFromState(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	witClient := azdosdkmocks.NewMockWorkitemtrackingClient(ctrl)
+	clients := &aggregatedClient{WorkItemTrackingClient: witClient, ctx: context.Background()}
+
+	resourceData := createAreaPathResourceData(t, `\Team`, "Backend")
+	resourceData.SetId("11")
+
+	notFound := http.StatusNotFound
+	witClient.
+		EXPECT().
+		GetClassificationNode(clients.ctx, workitemtracking.GetClassificationNodeArgs{
+			Project:        &testAreaPathProjectID,
+			StructureGroup: &testAreaPathGroup,
+			Path:           converter.String("Team/Backend"),
+		}).
+		Return(nil, azuredevops.WrappedError{StatusCode: &notFound}).
+		Times(1)
+
+	err := resourceAreaPath().Read(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "", resourceData.Id())
+}
+
+// verifies that the lookup of a node has proper error handling
+func TestAzureDevOpsAreaPath_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	witClient := azdosdkmocks.NewMockWorkitemtrackingClient(ctrl)
+	clients := &aggregatedClient{WorkItemTrackingClient: witClient, ctx: context.Background()}
+
+	resourceData := createAreaPathResourceData(t, `\Team`, "Backend")
+	resourceData.SetId("11")
+
+	witClient.
+		EXPECT().
+		GetClassificationNode(clients.ctx, gomock.Any()).
+		Return(nil, errors.New("GetClassificationNode() Failed")).
+		Times(1)
+
+	err := resourceAreaPath().Read(resourceData, clients)
+	require.Contains(t, err.Error(), "GetClassificationNode() Failed")
+}
+
+// verifies that a node is moved to its new parent before it is renamed
+func TestAzureDevOpsAreaPath_Update_MovesAndRenamesNode(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	witClient := azdosdkmocks.NewMockWorkitemtrackingClient(ctrl)
+	clients := &aggregatedClient{WorkItemTrackingClient: witClient, ctx: context.Background()}
+
+	resourceData := updateAreaPathResourceData(t, `\Team`, "Backend", `\Platform`, "Services")
+
+	nodeID := 11
+	gomock.InOrder(
+		expectGetClassificationNode(witClient, "Platform", testClassificationNode(12, `\Project\Area\Platform`)),
+		witClient.
+			EXPECT().
+			CreateOrUpdateClassificationNode(clients.ctx, workitemtracking.CreateOrUpdateClassificationNodeArgs{
+				PostedNode:     &workitemtracking.WorkItemClassificationNode{Id: &nodeID},
+				Project:        &testAreaPathProjectID,
+				StructureGroup: &testAreaPathGroup,
+				Path:           converter.String("Platform"),
+			}).
+			Return(testClassificationNode(11, `\Project\Area\Platform\Backend`), nil).
+			Times(1),
+		witClient.
+			EXPECT().
+			UpdateClassificationNode(clients.ctx, workitemtracking.UpdateClassificationNodeArgs{
+				PostedNode:     &workitemtracking.WorkItemClassificationNode{Name: converter.String("Services")},
+				Project:        &testAreaPathProjectID,
+				StructureGroup: &testAreaPathGroup,
+				Path:           converter.String("Platform/Backend"),
+			}).
+			Return(testClassificationNode(11, `\Project\Area\Platform\Services`), nil).
+			Times(1),
+	)
+
+	err := resourceAreaPath().Update(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, `\Platform`, resourceData.Get("path"))
+	require.Equal(t, "Services", resourceData.Get("name"))
+}
+
+// verifies that the work items of a deleted node are moved to its parent
+func TestAzureDevOpsAreaPath_Delete_ReclassifiesToParent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	witClient := azdosdkmocks.NewMockWorkitemtrackingClient(ctrl)
+	clients := &aggregatedClient{WorkItemTrackingClient: witClient, ctx: context.Background()}
+
+	resourceData := createAreaPathResourceData(t, `\Team`, "Backend")
+	resourceData.SetId("11")
+
+	parentID := 10
+	expectGetClassificationNode(witClient, "Team", testClassificationNode(parentID, `\Project\Area\Team`))
+	witClient.
+		EXPECT().
+		DeleteClassificationNode(clients.ctx, workitemtracking.DeleteClassificationNodeArgs{
+			Project:        &testAreaPathProjectID,
+			StructureGroup: &testAreaPathGroup,
+			Path:           converter.String("Team/Backend"),
+			ReclassifyId:   &parentID,
+		}).
+		Return(errors.New("DeleteClassificationNode() Failed")).
+		Times(1)
+
+	err := resourceAreaPath().Delete(resourceData, clients)
+	require.Contains(t, err.Error(), "DeleteClassificationNode() Failed")
+}
+
+func createAreaPathResourceData(t *testing.T, path string, name string) *schema.ResourceData {
+	return schema.TestResourceDataRaw(t, resourceAreaPath().Schema, map[string]interface{}{
+		"project_id": testAreaPathProjectID,
+		"path":       path,
+		"name":       name,
+	})
+}
+
+// Creates the data of an update from a node at path/name to newPath/newName
+func updateAreaPathResourceData(t *testing.T, path string, name string, newPath string, newName string) *schema.ResourceData {
+	nodeSchema := schema.InternalMap(resourceAreaPath().Schema)
+	state := &terraform.InstanceState{
+		ID: "11",
+		Attributes: map[string]string{
+			"project_id": testAreaPathProjectID,
+			"path":       path,
+			"name":       name,
+		},
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"project_id": testAreaPathProjectID,
+		"path":       newPath,
+		"name":       newName,
+	})
+
+	diff, err := nodeSchema.Diff(state, config, nil, nil, true)
+	require.Nil(t, err)
+	resourceData, err := nodeSchema.Data(state, diff)
+	require.Nil(t, err)
+	return resourceData
+}
+
+func testClassificationNode(id int, path string) *workitemtracking.WorkItemClassificationNode {
+	segments := splitClassificationNodePath(path)
+	return &workitemtracking.WorkItemClassificationNode{
+		Id:   &id,
+		Name: converter.String(segments[len(segments)-1]),
+		Path: &path,
+	}
+}
+
+func expectGetClassificationNode(witClient *azdosdkmocks.MockWorkitemtrackingClient, path string, node *workitemtracking.WorkItemClassificationNode) *gomock.Call {
+	return witClient.
+		EXPECT().
+		GetClassificationNode(gomock.Any(), workitemtracking.GetClassificationNodeArgs{
+			Project:        &testAreaPathProjectID,
+			StructureGroup: &testAreaPathGroup,
+			Path:           &path,
+		}).
+		Return(node, nil).
+		Times(1)
+}
+
+/**
+ * Begin acceptance tests
+ */
+
+// Verifies that nested area paths can be created, and that a node can be moved to the root and renamed
+func TestAccAzureDevOpsAreaPath_CreateAndUpdate(t *testing.T) {
+	projectName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	tfNode := "azuredevops_area_path.child"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccClassificationNodeCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAreaPathResource(projectName, `"\\${azuredevops_area_path.parent.name}"`, "Backend"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "path", `\Team`),
+					resource.TestCheckResourceAttr(tfNode, "name", "Backend"),
+				),
+			}, {
+				Config: testAccAreaPathResource(projectName, `"\\"`, "Services"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "path", `\`),
+					resource.TestCheckResourceAttr(tfNode, "name", "Services"),
+				),
+			},
+		},
+	})
+}
+
+// HCL describing an area path and a second area path whose parent is given by an expression
+func testAccAreaPathResource(projectName string, childPath string, childName string) string {
+	areaPathResources := fmt.Sprintf(`
+resource "azuredevops_area_path" "parent" {
+	project_id = azuredevops_project.project.id
+	name       = "Team"
+}
+
+resource "azuredevops_area_path" "child" {
+	project_id = azuredevops_project.project.id
+	path       = %s
+	name       = "%s"
+}`, childPath, childName)
+
+	projectResource := testAccProjectResource(projectName)
+	return fmt.Sprintf("%s\n%s", projectResource, areaPathResources)
+}
+
+// verifies that all area and iteration paths referenced in the state are destroyed
+func testAccClassificationNodeCheckDestroy(s *terraform.State) error {
+	clients := testAccProvider.Meta().(*aggregatedClient)
+
+	for _, res := range s.RootModule().Resources {
+		group := workitemtracking.TreeStructureGroupValues.Areas
+		switch res.Type {
+		case "azuredevops_area_path":
+		case "azuredevops_iteration_path":
+			group = workitemtracking.TreeStructureGroupValues.Iterations
+		default:
+			continue
+		}
+
+		projectID := res.Primary.Attributes["project_id"]
+		path := append(splitClassificationNodePath(res.Primary.Attributes["path"]), res.Primary.Attributes["name"])
+		_, err := clients.WorkItemTrackingClient.GetClassificationNode(clients.ctx, workitemtracking.GetClassificationNodeArgs{
+			Project:        &projectID,
+			StructureGroup: &group,
+			Path:           classificationNodeRoute(path),
+		})
+		if err == nil {
+			return fmt.Errorf("%s %s should not exist", res.Type, joinClassificationNodePath(path))
+		}
+	}
+	return nil
+}
@@ -1,13 +1,21 @@
 package azuredevops
 
 import (
+	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/core"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/git"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/policy"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/azdoerror"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/gitrepository"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/tfhelper"
 )
 
 func resourceAzureGitRepository() *schema.Resource {
@@ -16,6 +24,16 @@ func resourceAzureGitRepository() *schema.Resource {
 		Read:   resourceAzureGitRepositoryRead,
 		Update: resourceAzureGitRepositoryUpdate,
 		Delete: resourceAzureGitRepositoryDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAzureGitRepositoryImport,
+		},
+		CustomizeDiff: customizeDiffAzureGitRepository,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"project_id": {
@@ -28,8 +46,19 @@ func resourceAzureGitRepository() *schema.Resource {
 				Required: true,
 			},
 			"default_branch": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validation.NoZeroValues,
+				DiffSuppressFunc: suppressRefsHeadsPrefixDiff,
+			},
+			"parent_repository_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ValidateFunc:  validation.NoZeroValues,
+				ConflictsWith: []string{"initialization"},
 			},
 			"is_fork": {
 				Type:     schema.TypeBool,
@@ -55,25 +84,302 @@ func resourceAzureGitRepository() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"disabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"disable_on_delete": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"recover_soft_deleted": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{recoverSoftDeletedRestore, recoverSoftDeletedDelete}, false),
+			},
+			"delete_branch_policies": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"initialization": {
+				Type:             schema.TypeList,
+				Optional:         true,
+				MaxItems:         1,
+				DiffSuppressFunc: suppressInitializationDiffAfterCreate,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"init_type": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateFunc:     validation.StringInSlice([]string{initTypeUninitialized, initTypeImport}, false),
+							DiffSuppressFunc: suppressInitializationDiffAfterCreate,
+						},
+						"source_type": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateFunc:     validation.StringInSlice([]string{sourceTypeGit}, false),
+							DiffSuppressFunc: suppressInitializationDiffAfterCreate,
+						},
+						"source_url": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateFunc:     validation.NoZeroValues,
+							DiffSuppressFunc: suppressInitializationDiffAfterCreate,
+						},
+						"service_connection_id": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateFunc:     validation.NoZeroValues,
+							DiffSuppressFunc: suppressInitializationDiffAfterCreate,
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
+// The ways of recovering from a create that conflicts with a deleted repository of the same name, which is kept
+// in the recycle bin of the project for a while
+const (
+	recoverSoftDeletedRestore = "restore"
+	recoverSoftDeletedDelete  = "delete"
+)
+
+const (
+	initTypeUninitialized = "Uninitialized"
+	initTypeImport        = "Import"
+	sourceTypeGit         = "Git"
+)
+
+type repoInitializationMeta struct {
+	initType            string
+	sourceType          string
+	sourceURL           string
+	serviceConnectionID string
+}
+
+// The initialization of a repository only happens when it is created. Afterwards, and for repositories that
+// were imported into the state, changes to the block are ignored so that they do not produce a diff.
+func suppressInitializationDiffAfterCreate(k, old, new string, d *schema.ResourceData) bool {
+	return d.Id() != ""
+}
+
+func customizeDiffAzureGitRepository(d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() != "" {
+		return nil
+	}
+
+	initType := ""
+	initialization := d.Get("initialization").([]interface{})
+	if len(initialization) == 1 && initialization[0] != nil {
+		initType = initialization[0].(map[string]interface{})["init_type"].(string)
+	}
+
+	// the default branch of a new repository can only be set once the initialization or the fork created a commit
+	_, isFork := d.GetOk("parent_repository_id")
+	if defaultBranch, ok := d.GetOk("default_branch"); ok && initType != initTypeImport && !isFork {
+		return fmt.Errorf("default_branch %s cannot be set on a new repository without commits, init_type must be %s or parent_repository_id must be set", defaultBranch, initTypeImport)
+	}
+
+	if initType != initTypeImport {
+		return nil
+	}
+
+	initValues := initialization[0].(map[string]interface{})
+
+	if initValues["source_type"].(string) == "" {
+		return fmt.Errorf("source_type must be set when init_type is %s", initTypeImport)
+	}
+	if initValues["source_url"].(string) == "" {
+		return fmt.Errorf("source_url must be set when init_type is %s", initTypeImport)
+	}
+	return nil
+}
+
 func resourceAzureGitRepositoryCreate(d *schema.ResourceData, m interface{}) error {
-	clients := m.(*aggregatedClient)
+	clients, cancel := m.(*aggregatedClient).withTimeout(d.Timeout(schema.TimeoutCreate))
+	defer cancel()
 	repo, projectID, err := expandAzureGitRepository(d)
+	if err != nil {
+		return fmt.Errorf("Error converting terraform data model to AzDO project reference: %+v", err)
+	}
+
+	initialization, err := expandRepoInitialization(d)
+	if err != nil {
+		return err
+	}
+	defaultBranch := d.Get("default_branch").(string)
 
-	createdRepo, err := createAzureGitRepository(clients, repo.Name, projectID)
+	var parentRepo *git.GitRepository
+	if parentRepoID, ok := d.GetOk("parent_repository_id"); ok {
+		parentRepo, err = getAzureGitRepositoryParent(clients, parentRepoID.(string))
+		if err != nil {
+			return err
+		}
+	}
+
+	restored := false
+	createdRepo, err := createAzureGitRepository(clients, repo.Name, projectID, parentRepo)
+	if err != nil && azdoerror.IsGitRepositoryNameAlreadyExists(err) {
+		createdRepo, restored, err = recoverSoftDeletedAzureGitRepository(clients, d.Get("recover_soft_deleted").(string), repo.Name, projectID, parentRepo, defaultBranch, err)
+	}
 	if err != nil {
 		return fmt.Errorf("Error creating project in Azure DevOps: %+v", err)
 	}
 
 	flattenAzureGitRepository(d, createdRepo)
 
+	// a restored repository keeps its content, so it is neither imported into nor initialized again
+	if restored {
+		return resourceAzureGitRepositoryRead(d, m)
+	}
+
+	if initialization != nil && initialization.initType == initTypeImport {
+		err = importAzureGitRepository(clients, createdRepo, initialization)
+		if err != nil {
+			return fmt.Errorf("Error importing repository from %s: %v", initialization.sourceURL, err)
+		}
+	}
+
+	if defaultBranch != "" {
+		err = setAzureGitRepositoryDefaultBranch(clients, createdRepo, withRefsHeadsPrefix(defaultBranch))
+		if err != nil {
+			return fmt.Errorf("Error setting the default branch of repository %s: %v", createdRepo.Id, err)
+		}
+	}
+
 	return resourceAzureGitRepositoryRead(d, m)
 }
 
-func createAzureGitRepository(clients *aggregatedClient, repoName *string, projectID *uuid.UUID) (*git.GitRepository, error) {
+// Makes a branch the default branch of a new repository. The default branch can only be set once the
+// repository has a commit, so this waits until the initialization of the repository created its branches.
+func setAzureGitRepositoryDefaultBranch(clients *aggregatedClient, repo *git.GitRepository, defaultBranch string) error {
+	repoID := repo.Id.String()
+	if err := waitForAzureGitRepositoryBranches(clients, repoID); err != nil {
+		return err
+	}
+
+	_, err := updateAzureGitRepository(clients, &git.GitRepository{
+		Id:            repo.Id,
+		DefaultBranch: converter.String(defaultBranch),
+	}, repo.Project.Id)
+	return err
+}
+
+// Polls the branches of a repository until there is at least one. The wait is bounded by the context.
+func waitForAzureGitRepositoryBranches(clients *aggregatedClient, repoID string) error {
+	ticker := time.NewTicker(1 * time.Second)
+	defer ticker.Stop()
+
+	for {
+		refs, err := clients.GitReposClient.GetRefs(clients.ctx, git.GetRefsArgs{
+			RepositoryId: converter.String(repoID),
+			Filter:       converter.String(strings.TrimPrefix(refsHeadsPrefix, "refs/")),
+		})
+		if err != nil {
+			return err
+		}
+		if refs != nil && len(refs.Value) > 0 {
+			return nil
+		}
+
+		select {
+		case <-ticker.C:
+		case <-clients.ctx.Done():
+			return fmt.Errorf("Repository %s has no commits yet: %v", repoID, clients.ctx.Err())
+		}
+	}
+}
+
+// Imports the content of a remote repository into a newly created repository and waits for the import to finish
+func importAzureGitRepository(clients *aggregatedClient, repo *git.GitRepository, initialization *repoInitializationMeta) error {
+	parameters := &git.GitImportRequestParameters{
+		GitSource: &git.GitImportGitSource{
+			Url: converter.String(initialization.sourceURL),
+		},
+	}
+	if initialization.serviceConnectionID != "" {
+		serviceConnectionID, err := uuid.Parse(initialization.serviceConnectionID)
+		if err != nil {
+			return fmt.Errorf("Invalid service_connection_id UUID: %s", initialization.serviceConnectionID)
+		}
+		parameters.ServiceEndpointId = &serviceConnectionID
+	}
+
+	repoID := repo.Id.String()
+	projectID := repo.Project.Id.String()
+	importRequest, err := clients.GitReposClient.CreateImportRequest(clients.ctx, git.CreateImportRequestArgs{
+		ImportRequest: &git.GitImportRequest{Parameters: parameters},
+		Project:       &projectID,
+		RepositoryId:  &repoID,
+	})
+	if err != nil {
+		return err
+	}
+
+	return waitForImportRequestCompletion(clients, projectID, repoID, importRequest)
+}
+
+// Polls an import request until it completes. Import requests are tracked by the Git service itself rather than
+// by the Operations service, so they are polled through GetImportRequest. The wait is bounded by the context.
+func waitForImportRequestCompletion(clients *aggregatedClient, projectID string, repoID string, importRequest *git.GitImportRequest) error {
+	ticker := time.NewTicker(1 * time.Second)
+	defer ticker.Stop()
+
+	for {
+		if importRequest.Status != nil {
+			switch *importRequest.Status {
+			case git.GitAsyncOperationStatusValues.Completed:
+				return nil
+			case git.GitAsyncOperationStatusValues.Failed, git.GitAsyncOperationStatusValues.Abandoned:
+				if importRequest.DetailedStatus != nil && importRequest.DetailedStatus.ErrorMessage != nil {
+					return errors.New(*importRequest.DetailedStatus.ErrorMessage)
+				}
+				return fmt.Errorf("Import request finished with status %s", *importRequest.Status)
+			}
+		}
+
+		select {
+		case <-ticker.C:
+			var err error
+			importRequest, err = clients.GitReposClient.GetImportRequest(clients.ctx, git.GetImportRequestArgs{
+				Project:         &projectID,
+				RepositoryId:    &repoID,
+				ImportRequestId: importRequest.ImportRequestId,
+			})
+			if err != nil {
+				return err
+			}
+		case <-clients.ctx.Done():
+			return fmt.Errorf("Operation was cancelled before it completed: %v", clients.ctx.Err())
+		}
+	}
+}
+
+// Looks up the repository a new repository is forked from. The parent may belong to any project of the
+// organization, so it is looked up by its ID alone.
+func getAzureGitRepositoryParent(clients *aggregatedClient, parentRepoID string) (*git.GitRepository, error) {
+	if _, err := uuid.Parse(parentRepoID); err != nil {
+		return nil, fmt.Errorf("Invalid parent_repository_id UUID: %s", parentRepoID)
+	}
+
+	parentRepo, err := clients.GitReposClient.GetRepository(clients.ctx, git.GetRepositoryArgs{
+		RepositoryId: converter.String(parentRepoID),
+	})
+	if err != nil {
+		if azdoerror.IsNotFound(err) {
+			return nil, fmt.Errorf("Parent repository %s does not exist", parentRepoID)
+		}
+		return nil, fmt.Errorf("Error looking up parent repository %s. Error: %v", parentRepoID, err)
+	}
+	return parentRepo, nil
+}
+
+// Creates a repository, which is a fork of parentRepo with all of its branches if parentRepo is set
+func createAzureGitRepository(clients *aggregatedClient, repoName *string, projectID *uuid.UUID, parentRepo *git.GitRepository) (*git.GitRepository, error) {
 	args := git.CreateRepositoryArgs{
 		GitRepositoryToCreate: &git.GitRepositoryCreateOptions{
 			Name: repoName,
@@ -82,11 +388,71 @@ func createAzureGitRepository(clients *aggregatedClient, repoName *string, proje
 			},
 		},
 	}
+	if parentRepo != nil {
+		args.GitRepositoryToCreate.ParentRepository = &git.GitRepositoryRef{
+			Id:      parentRepo.Id,
+			Project: parentRepo.Project,
+		}
+	}
 	createdRepository, err := clients.GitReposClient.CreateRepository(clients.ctx, args)
 
 	return createdRepository, err
 }
 
+// Handles a create that conflicts with a repository of the same name in the recycle bin of the project. Depending on
+// the recover_soft_deleted setting, the deleted repository is either restored and managed instead of a new one, or
+// it is deleted permanently and the create is retried. Conflicts with active repositories are returned unchanged.
+// A restored repository keeps its parent and its default branch, so it cannot be restored if either is configured.
+func recoverSoftDeletedAzureGitRepository(clients *aggregatedClient, recovery string, repoName *string, projectID *uuid.UUID, parentRepo *git.GitRepository, defaultBranch string, createErr error) (*git.GitRepository, bool, error) {
+	project := projectID.String()
+	deletedRepos, err := clients.GitReposClient.GetRecycleBinRepositories(clients.ctx, git.GetRecycleBinRepositoriesArgs{
+		Project: &project,
+	})
+	if err != nil || deletedRepos == nil {
+		return nil, false, createErr
+	}
+
+	var deletedRepo *git.GitDeletedRepository
+	for i, candidate := range *deletedRepos {
+		if strings.EqualFold(converter.ToString(candidate.Name, ""), *repoName) {
+			deletedRepo = &(*deletedRepos)[i]
+			break
+		}
+	}
+	if deletedRepo == nil {
+		return nil, false, createErr
+	}
+
+	switch recovery {
+	case recoverSoftDeletedRestore:
+		if parentRepo != nil || defaultBranch != "" {
+			return nil, false, fmt.Errorf("The deleted repository %s cannot be restored because parent_repository_id or default_branch is set, which cannot be applied to a restored repository. Set recover_soft_deleted to %q to delete it permanently instead. Error: %v",
+				*repoName, recoverSoftDeletedDelete, createErr)
+		}
+		restoredRepo, err := clients.GitReposClient.RestoreRepositoryFromRecycleBin(clients.ctx, git.RestoreRepositoryFromRecycleBinArgs{
+			RepositoryDetails: &git.GitRecycleBinRepositoryDetails{Deleted: converter.Bool(false)},
+			Project:           &project,
+			RepositoryId:      deletedRepo.Id,
+		})
+		if err != nil {
+			return nil, false, fmt.Errorf("Error restoring deleted repository %s. Error: %v", deletedRepo.Id, err)
+		}
+		return restoredRepo, true, nil
+	case recoverSoftDeletedDelete:
+		err := clients.GitReposClient.DeleteRepositoryFromRecycleBin(clients.ctx, git.DeleteRepositoryFromRecycleBinArgs{
+			Project:      &project,
+			RepositoryId: deletedRepo.Id,
+		})
+		if err != nil {
+			return nil, false, fmt.Errorf("Error permanently deleting repository %s. Error: %v", deletedRepo.Id, err)
+		}
+		createdRepo, err := createAzureGitRepository(clients, repoName, projectID, parentRepo)
+		return createdRepo, false, err
+	}
+	return nil, false, fmt.Errorf("A deleted repository named %s is in the recycle bin of the project. Set recover_soft_deleted to %q to restore it or to %q to delete it permanently. Error: %v",
+		*repoName, recoverSoftDeletedRestore, recoverSoftDeletedDelete, createErr)
+}
+
 func resourceAzureGitRepositoryRead(d *schema.ResourceData, m interface{}) error {
 	repoID := d.Id()
 	repoName := d.Get("name").(string)
@@ -95,15 +461,31 @@ func resourceAzureGitRepositoryRead(d *schema.ResourceData, m interface{}) error
 	clients := m.(*aggregatedClient)
 	repo, err := azureGitRepositoryRead(clients, repoID, repoName, projectID)
 	if err != nil {
+		if tfhelper.ClearIDIfNotFound(d, err) {
+			return nil
+		}
 		return fmt.Errorf("Error looking up repository with ID %s and Name %s. Error: %v", repoID, repoName, err)
 	}
 
+	state, err := clients.GitRepositoryClient.GetRepositoryState(clients.ctx, gitrepository.GetRepositoryStateArgs{
+		Project:      converter.String(repo.Project.Id.String()),
+		RepositoryId: repo.Id,
+	})
+	if err != nil {
+		if tfhelper.ClearIDIfNotFound(d, err) {
+			return nil
+		}
+		return fmt.Errorf("Error looking up the state of repository with ID %s. Error: %v", d.Id(), err)
+	}
+
 	flattenAzureGitRepository(d, repo)
+	d.Set("disabled", converter.ToBool(state.IsDisabled, false))
 	return nil
 }
 
 func resourceAzureGitRepositoryUpdate(d *schema.ResourceData, m interface{}) error {
-	clients := m.(*aggregatedClient)
+	clients, cancel := m.(*aggregatedClient).withTimeout(d.Timeout(schema.TimeoutUpdate))
+	defer cancel()
 	repo, projectID, err := expandAzureGitRepository(d)
 	if err != nil {
 		return fmt.Errorf("Error converting terraform data model to AzDO project reference: %+v", err)
@@ -129,12 +511,102 @@ func updateAzureGitRepository(clients *aggregatedClient, repository *git.GitRepo
 		})
 }
 
+// Deletes the repository, or only disables it if disable_on_delete is set. A repository that is referenced
+// by branch policies cannot be deleted, so these policies are deleted first if delete_branch_policies is set.
 func resourceAzureGitRepositoryDelete(d *schema.ResourceData, m interface{}) error {
 	repoID := d.Id()
-	clients := m.(*aggregatedClient)
+	projectID := d.Get("project_id").(string)
+	clients, cancel := m.(*aggregatedClient).withTimeout(d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+
+	if d.Get("delete_branch_policies").(bool) {
+		if err := deleteAzureGitRepositoryPolicies(clients, projectID, repoID); err != nil {
+			return err
+		}
+	}
+	if d.Get("disable_on_delete").(bool) {
+		return disableAzureGitRepository(clients, projectID, repoID)
+	}
 	return deleteAzureGitRepository(clients, repoID)
 }
 
+// Deletes the policies that are scoped to the repository only. Policies that also apply to other
+// repositories, or to all repositories of the project, are kept.
+func deleteAzureGitRepositoryPolicies(clients *aggregatedClient, projectID string, repoID string) error {
+	if _, err := uuid.Parse(repoID); err != nil {
+		return fmt.Errorf("Invalid repositoryId UUID: %s", repoID)
+	}
+
+	var policyIDs []int
+	var continuationToken string
+	for hasMore := true; hasMore; {
+		args := policy.GetPolicyConfigurationsArgs{
+			Project: converter.String(projectID),
+		}
+		if continuationToken != "" {
+			args.ContinuationToken = &continuationToken
+		}
+
+		response, err := clients.PolicyClient.GetPolicyConfigurations(clients.ctx, args)
+		if err != nil {
+			return fmt.Errorf("Error listing the policies of project %s. Error: %v", projectID, err)
+		}
+
+		for _, policyConfig := range response.Value {
+			if policyConfig.Id != nil && isPolicyScopedToRepository(&policyConfig, repoID) {
+				policyIDs = append(policyIDs, *policyConfig.Id)
+			}
+		}
+
+		continuationToken = response.ContinuationToken
+		hasMore = continuationToken != ""
+	}
+
+	for _, policyID := range policyIDs {
+		err := clients.PolicyClient.DeletePolicyConfiguration(clients.ctx, policy.DeletePolicyConfigurationArgs{
+			ConfigurationId: converter.Int(policyID),
+			Project:         converter.String(projectID),
+		})
+		if err != nil && !azdoerror.IsNotFound(err) {
+			return fmt.Errorf("Error deleting policy %d of repository %s. Error: %v", policyID, repoID, err)
+		}
+	}
+	return nil
+}
+
+func isPolicyScopedToRepository(policyConfig *policy.PolicyConfiguration, repoID string) bool {
+	settings := struct {
+		Scope []policyScope `json:"scope"`
+	}{}
+	if err := decodePolicySettings(policyConfig, &settings); err != nil || len(settings.Scope) == 0 {
+		return false
+	}
+	for _, scope := range settings.Scope {
+		if !strings.EqualFold(scope.RepositoryID, repoID) {
+			return false
+		}
+	}
+	return true
+}
+
+// Disables the repository instead of deleting it, which keeps its content and everything that references it
+func disableAzureGitRepository(clients *aggregatedClient, projectID string, repoID string) error {
+	id, err := uuid.Parse(repoID)
+	if err != nil {
+		return fmt.Errorf("Invalid repositoryId UUID: %s", repoID)
+	}
+
+	_, err = clients.GitRepositoryClient.SetRepositoryDisabled(clients.ctx, gitrepository.SetRepositoryDisabledArgs{
+		Project:      converter.String(projectID),
+		RepositoryId: &id,
+		IsDisabled:   converter.Bool(true),
+	})
+	if err != nil {
+		return fmt.Errorf("Error disabling repository %s. Error: %v", repoID, err)
+	}
+	return nil
+}
+
 func deleteAzureGitRepository(clients *aggregatedClient, repoID string) error {
 	uuid, err := uuid.Parse(repoID)
 	if err != nil {
@@ -146,6 +618,32 @@ func deleteAzureGitRepository(clients *aggregatedClient, repoID string) error {
 	})
 }
 
+// Imports a repository given an ID of the form <projectID>/<repositoryID>. The project may also be given
+// by name, as the service resolves both.
+func resourceAzureGitRepositoryImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%s), expected projectid/repositoryid", d.Id())
+	}
+
+	projectID, repoID := parts[0], parts[1]
+	if _, err := uuid.Parse(repoID); err != nil {
+		return nil, fmt.Errorf("Invalid repositoryId UUID: %s", repoID)
+	}
+
+	clients := m.(*aggregatedClient)
+	repo, err := azureGitRepositoryRead(clients, repoID, "", projectID)
+	if err != nil {
+		return nil, fmt.Errorf("Error looking up repository with ID %s in project %s. Error: %v", repoID, projectID, err)
+	}
+
+	flattenAzureGitRepository(d, repo)
+	// the options of the delete only exist in the configuration, so they start out with their defaults
+	d.Set("disable_on_delete", false)
+	d.Set("delete_branch_policies", false)
+	return []*schema.ResourceData{d}, nil
+}
+
 // Lookup an Azure Git Repository using the ID, or name if the ID is not set.
 func azureGitRepositoryRead(clients *aggregatedClient, repoID string, repoName string, projectID string) (*git.GitRepository, error) {
 	identifier := repoID
@@ -166,6 +664,11 @@ func flattenAzureGitRepository(d *schema.ResourceData, repository *git.GitReposi
 	d.Set("project_id", repository.Project.Id.String())
 	d.Set("default_branch", converter.ToString(repository.DefaultBranch, ""))
 	d.Set("is_fork", repository.IsFork)
+	parentRepoID := ""
+	if repository.ParentRepository != nil && repository.ParentRepository.Id != nil {
+		parentRepoID = repository.ParentRepository.Id.String()
+	}
+	d.Set("parent_repository_id", parentRepoID)
 	d.Set("remote_url", converter.ToString(repository.RemoteUrl, ""))
 	d.Set("size", repository.Size)
 	d.Set("ssh_url", converter.ToString(repository.SshUrl, ""))
@@ -192,6 +695,30 @@ func expandAzureGitRepository(d *schema.ResourceData) (*git.GitRepository, *uuid
 		Id:   repoID,
 		Name: converter.String(d.Get("name").(string)),
 	}
+	if d.HasChange("default_branch") && d.Get("default_branch").(string) != "" {
+		repo.DefaultBranch = converter.String(withRefsHeadsPrefix(d.Get("default_branch").(string)))
+	}
 
 	return repo, &projectID, nil
 }
+
+func expandRepoInitialization(d *schema.ResourceData) (*repoInitializationMeta, error) {
+	initialization := d.Get("initialization").([]interface{})
+	if len(initialization) != 1 || initialization[0] == nil {
+		return nil, nil
+	}
+
+	initValues := initialization[0].(map[string]interface{})
+	initType := initValues["init_type"].(string)
+	sourceURL := initValues["source_url"].(string)
+	if initType == initTypeImport && sourceURL == "" {
+		return nil, fmt.Errorf("source_url must be set when init_type is %s", initTypeImport)
+	}
+
+	return &repoInitializationMeta{
+		initType:            initType,
+		sourceType:          initValues["source_type"].(string),
+		sourceURL:           sourceURL,
+		serviceConnectionID: initValues["service_connection_id"].(string),
+	}, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
@@ -13,11 +14,14 @@ import (
 
 	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/gitrepository"
 
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
+	"github.com/microsoft/azure-devops-go-api/azuredevops"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/core"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/git"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/policy"
 	"github.com/stretchr/testify/require"
 )
 
@@ -62,7 +66,7 @@ func TestAzureGitRepo_Create_DoesNotSwallowErrorFromFailedCreateCall(t *testing.
 	}
 	reposClient.
 		EXPECT().
-		CreateRepository(clients.ctx, expectedArgs).
+		CreateRepository(gomock.Any(), expectedArgs).
 		Return(nil, errors.New("CreateAzureGitRepository() Failed")).
 		Times(1)
 
@@ -70,6 +74,114 @@ func TestAzureGitRepo_Create_DoesNotSwallowErrorFromFailedCreateCall(t *testing.
 	require.Regexp(t, ".*CreateAzureGitRepository\\(\\) Failed$", err.Error())
 }
 
+// verifies that a repository initialized by an import waits for the import request and surfaces its error message
+func TestAzureGitRepo_Create_ImportSurfacesImportFailure(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	resourceData := schema.TestResourceDataRaw(t, resourceAzureGitRepository().Schema, map[string]interface{}{
+		"project_id": testRepoProjectID.String(),
+		"name":       "RepoName",
+		"initialization": []interface{}{map[string]interface{}{
+			"init_type":   "Import",
+			"source_type": "Git",
+			"source_url":  "https://github.com/microsoft/terraform-provider-azuredevops.git",
+		}},
+	})
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &aggregatedClient{GitReposClient: reposClient, ctx: context.Background()}
+
+	reposClient.
+		EXPECT().
+		CreateRepository(gomock.Any(), gomock.Any()).
+		Return(&testAzureGitRepository, nil).
+		Times(1)
+
+	importRequestID := 1
+	queued := git.GitAsyncOperationStatusValues.Queued
+	expectedImportArgs := git.CreateImportRequestArgs{
+		ImportRequest: &git.GitImportRequest{
+			Parameters: &git.GitImportRequestParameters{
+				GitSource: &git.GitImportGitSource{
+					Url: converter.String("https://github.com/microsoft/terraform-provider-azuredevops.git"),
+				},
+			},
+		},
+		Project:      converter.String(testRepoProjectID.String()),
+		RepositoryId: converter.String(testRepoID.String()),
+	}
+	reposClient.
+		EXPECT().
+		CreateImportRequest(gomock.Any(), expectedImportArgs).
+		Return(&git.GitImportRequest{ImportRequestId: &importRequestID, Status: &queued}, nil).
+		Times(1)
+
+	failed := git.GitAsyncOperationStatusValues.Failed
+	reposClient.
+		EXPECT().
+		GetImportRequest(gomock.Any(), git.GetImportRequestArgs{
+			Project:         converter.String(testRepoProjectID.String()),
+			RepositoryId:    converter.String(testRepoID.String()),
+			ImportRequestId: &importRequestID,
+		}).
+		Return(&git.GitImportRequest{
+			ImportRequestId: &importRequestID,
+			Status:          &failed,
+			DetailedStatus:  &git.GitImportStatusDetail{ErrorMessage: converter.String("The source repository could not be found")},
+		}, nil).
+		Times(1)
+
+	err := resourceAzureGitRepositoryCreate(resourceData, clients)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "The source repository could not be found")
+	require.Equal(t, testRepoID.String(), resourceData.Id())
+}
+
+// verifies that the service connection used to access private sources is passed along with the import request
+func TestAzureGitRepo_Import_PassesServiceConnection(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &aggregatedClient{GitReposClient: reposClient, ctx: context.Background()}
+
+	serviceConnectionID := uuid.New()
+	completed := git.GitAsyncOperationStatusValues.Completed
+	reposClient.
+		EXPECT().
+		CreateImportRequest(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, args git.CreateImportRequestArgs) (*git.GitImportRequest, error) {
+			require.Equal(t, serviceConnectionID, *args.ImportRequest.Parameters.ServiceEndpointId)
+			return &git.GitImportRequest{Status: &completed}, nil
+		}).
+		Times(1)
+
+	err := importAzureGitRepository(clients, &testAzureGitRepository, &repoInitializationMeta{
+		initType:            "Import",
+		sourceType:          "Git",
+		sourceURL:           "https://dev.azure.com/org/project/_git/repo",
+		serviceConnectionID: serviceConnectionID.String(),
+	})
+	require.Nil(t, err)
+}
+
+// verifies that an import initialization without a source URL is rejected before the repository is created
+func TestAzureGitRepo_Create_ImportRequiresSourceURL(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, resourceAzureGitRepository().Schema, map[string]interface{}{
+		"project_id": testRepoProjectID.String(),
+		"name":       "RepoName",
+		"initialization": []interface{}{map[string]interface{}{
+			"init_type":   "Import",
+			"source_type": "Git",
+		}},
+	})
+
+	err := resourceAzureGitRepositoryCreate(resourceData, &aggregatedClient{ctx: context.Background()})
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "source_url must be set")
+}
+
 // verifies that the update operation is considered failed if the initial API
 // call fails.
 func TestAzureGitRepo_Update_DoesNotSwallowErrorFromFailedCreateCall(t *testing.T) {
@@ -84,7 +196,7 @@ func TestAzureGitRepo_Update_DoesNotSwallowErrorFromFailedCreateCall(t *testing.
 
 	reposClient.
 		EXPECT().
-		UpdateRepository(clients.ctx, gomock.Any()).
+		UpdateRepository(gomock.Any(), gomock.Any()).
 		Return(nil, errors.New("UpdateAzureGitRepository() Failed")).
 		Times(1)
 
@@ -139,6 +251,33 @@ func TestAzureGitRepo_Read_DoesNotSwallowErrorFromFailedReadCall(t *testing.T) {
 	require.Contains(t, err.Error(), "GetRepository() Failed")
 }
 
+// verifies that a repository that was deleted outside of Terraform is removed from the state on read
+func TestAzureGitRepo_Read_ClearsIDIfRepositoryDoesNotExist(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &aggregatedClient{
+		GitReposClient: reposClient,
+		ctx:            context.Background(),
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceAzureGitRepository().Schema, nil)
+	resourceData.SetId("an-id")
+	resourceData.Set("project_id", "a-project")
+
+	statusCode := http.StatusNotFound
+	reposClient.
+		EXPECT().
+		GetRepository(clients.ctx, gomock.Any()).
+		Return(nil, azuredevops.WrappedError{StatusCode: &statusCode}).
+		Times(1)
+
+	err := resourceAzureGitRepositoryRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "", resourceData.Id())
+}
+
 // verifies that the resource ID is used for reads if the ID is set
 func TestAzureGitRepo_Read_UsesIdIfSet(t *testing.T) {
 	ctrl := gomock.NewController(t)
@@ -190,7 +329,7 @@ func TestAzureGitRepo_Delete_DoesNotSwallowErrorFromFailedDeleteCall(t *testing.
 	expectedArgs := git.DeleteRepositoryArgs{RepositoryId: &id}
 	reposClient.
 		EXPECT().
-		DeleteRepository(clients.ctx, expectedArgs).
+		DeleteRepository(gomock.Any(), expectedArgs).
 		Return(fmt.Errorf("DeleteRepository() Failed")).
 		Times(1)
 
@@ -198,6 +337,145 @@ func TestAzureGitRepo_Delete_DoesNotSwallowErrorFromFailedDeleteCall(t *testing.
 	require.Contains(t, err.Error(), "DeleteRepository() Failed")
 }
 
+// verifies that the disabled state of the repository is read
+func TestAzureGitRepo_Read_SetsDisabledState(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	repositoryClient := azdosdkmocks.NewMockGitRepositoryClient(ctrl)
+	clients := &aggregatedClient{
+		GitReposClient:      reposClient,
+		GitRepositoryClient: repositoryClient,
+		ctx:                 context.Background(),
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceAzureGitRepository().Schema, nil)
+	resourceData.SetId(testRepoID.String())
+	resourceData.Set("project_id", testRepoProjectID.String())
+
+	reposClient.
+		EXPECT().
+		GetRepository(clients.ctx, gomock.Any()).
+		Return(&testAzureGitRepository, nil).
+		Times(1)
+
+	expectedArgs := gitrepository.GetRepositoryStateArgs{Project: converter.String(testRepoProjectID.String()), RepositoryId: &testRepoID}
+	repositoryClient.
+		EXPECT().
+		GetRepositoryState(clients.ctx, expectedArgs).
+		Return(&gitrepository.RepositoryState{Id: &testRepoID, IsDisabled: converter.Bool(true)}, nil).
+		Times(1)
+
+	err := resourceAzureGitRepositoryRead(resourceData, clients)
+	require.Nil(t, err)
+	require.True(t, resourceData.Get("disabled").(bool))
+}
+
+// verifies that the repository is disabled instead of deleted if disable_on_delete is set
+func TestAzureGitRepo_Delete_DisablesRepositoryIfConfigured(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	repositoryClient := azdosdkmocks.NewMockGitRepositoryClient(ctrl)
+	clients := &aggregatedClient{
+		GitReposClient:      reposClient,
+		GitRepositoryClient: repositoryClient,
+		ctx:                 context.Background(),
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceAzureGitRepository().Schema, nil)
+	resourceData.SetId(testRepoID.String())
+	resourceData.Set("project_id", testRepoProjectID.String())
+	resourceData.Set("disable_on_delete", true)
+
+	expectedArgs := gitrepository.SetRepositoryDisabledArgs{
+		Project:      converter.String(testRepoProjectID.String()),
+		RepositoryId: &testRepoID,
+		IsDisabled:   converter.Bool(true),
+	}
+	repositoryClient.
+		EXPECT().
+		SetRepositoryDisabled(gomock.Any(), expectedArgs).
+		Return(&gitrepository.RepositoryState{Id: &testRepoID, IsDisabled: converter.Bool(true)}, nil).
+		Times(1)
+	reposClient.
+		EXPECT().
+		DeleteRepository(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	err := resourceAzureGitRepositoryDelete(resourceData, clients)
+	require.Nil(t, err)
+}
+
+// verifies that only the policies scoped to the repository alone are deleted before the repository
+func TestAzureGitRepo_Delete_DeletesBranchPoliciesIfConfigured(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	policyClient := azdosdkmocks.NewMockPolicyClient(ctrl)
+	clients := &aggregatedClient{
+		GitReposClient: reposClient,
+		PolicyClient:   policyClient,
+		ctx:            context.Background(),
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceAzureGitRepository().Schema, nil)
+	resourceData.SetId(testRepoID.String())
+	resourceData.Set("project_id", testRepoProjectID.String())
+	resourceData.Set("delete_branch_policies", true)
+
+	scopedTo := func(repositoryIDs ...interface{}) map[string]interface{} {
+		scopes := []interface{}{}
+		for _, repositoryID := range repositoryIDs {
+			scopes = append(scopes, map[string]interface{}{"repositoryId": repositoryID, "refName": "refs/heads/master", "matchKind": "Exact"})
+		}
+		return map[string]interface{}{"scope": scopes}
+	}
+	otherRepoID := uuid.New().String()
+
+	policyClient.
+		EXPECT().
+		GetPolicyConfigurations(gomock.Any(), policy.GetPolicyConfigurationsArgs{Project: converter.String(testRepoProjectID.String())}).
+		Return(&policy.GetPolicyConfigurationsResponseValue{
+			Value: []policy.PolicyConfiguration{
+				{Id: converter.Int(1), Settings: scopedTo(testRepoID.String())},
+				{Id: converter.Int(2), Settings: scopedTo(otherRepoID)},
+			},
+			ContinuationToken: "next",
+		}, nil).
+		Times(1)
+	policyClient.
+		EXPECT().
+		GetPolicyConfigurations(gomock.Any(), policy.GetPolicyConfigurationsArgs{Project: converter.String(testRepoProjectID.String()), ContinuationToken: converter.String("next")}).
+		Return(&policy.GetPolicyConfigurationsResponseValue{
+			Value: []policy.PolicyConfiguration{
+				{Id: converter.Int(3), Settings: scopedTo(nil)},
+				{Id: converter.Int(4), Settings: scopedTo(testRepoID.String(), otherRepoID)},
+				{Id: converter.Int(5), Settings: scopedTo(testRepoID.String())},
+			},
+		}, nil).
+		Times(1)
+
+	for _, policyID := range []int{1, 5} {
+		policyClient.
+			EXPECT().
+			DeletePolicyConfiguration(gomock.Any(), policy.DeletePolicyConfigurationArgs{ConfigurationId: converter.Int(policyID), Project: converter.String(testRepoProjectID.String())}).
+			Return(nil).
+			Times(1)
+	}
+	reposClient.
+		EXPECT().
+		DeleteRepository(gomock.Any(), git.DeleteRepositoryArgs{RepositoryId: &testRepoID}).
+		Return(nil).
+		Times(1)
+
+	err := resourceAzureGitRepositoryDelete(resourceData, clients)
+	require.Nil(t, err)
+}
+
 // verifies that the name is used for reads if the ID is not set
 func TestAzureGitRepo_Read_UsesNameIfIdNotSet(t *testing.T) {
 	ctrl := gomock.NewController(t)
@@ -223,6 +501,502 @@ func TestAzureGitRepo_Read_UsesNameIfIdNotSet(t *testing.T) {
 	resourceAzureGitRepositoryRead(resourceData, clients)
 }
 
+// verifies that the import ID is split into the project and repository IDs
+func TestAzureGitRepo_Import_UsesProjectAndRepositoryID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &aggregatedClient{
+		GitReposClient: reposClient,
+		ctx:            context.Background(),
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceAzureGitRepository().Schema, nil)
+	resourceData.SetId(testRepoProjectID.String() + "/" + testRepoID.String())
+
+	expectedArgs := git.GetRepositoryArgs{RepositoryId: converter.String(testRepoID.String()), Project: converter.String(testRepoProjectID.String())}
+	reposClient.
+		EXPECT().
+		GetRepository(clients.ctx, expectedArgs).
+		Return(&testAzureGitRepository, nil).
+		Times(1)
+
+	imported, err := resourceAzureGitRepositoryImport(resourceData, clients)
+	require.Nil(t, err)
+	require.Len(t, imported, 1)
+	require.Equal(t, testRepoID.String(), imported[0].Id())
+	require.Equal(t, testRepoProjectID.String(), imported[0].Get("project_id"))
+	require.Equal(t, "RepoName", imported[0].Get("name"))
+}
+
+// verifies that malformed import IDs are rejected without calling the service
+func TestAzureGitRepo_Import_ChecksIDFormat(t *testing.T) {
+	for _, id := range []string{"", testRepoID.String(), "/" + testRepoID.String(), testRepoProjectID.String() + "/", testRepoProjectID.String() + "/not-a-uuid"} {
+		resourceData := schema.TestResourceDataRaw(t, resourceAzureGitRepository().Schema, nil)
+		resourceData.SetId(id)
+
+		_, err := resourceAzureGitRepositoryImport(resourceData, &aggregatedClient{})
+		require.NotNil(t, err, "Expected an error for import ID '%s'", id)
+	}
+}
+
+// verifies that the import fails if the repository cannot be found
+func TestAzureGitRepo_Import_DoesNotSwallowErrorFromFailedReadCall(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &aggregatedClient{
+		GitReposClient: reposClient,
+		ctx:            context.Background(),
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceAzureGitRepository().Schema, nil)
+	resourceData.SetId(testRepoProjectID.String() + "/" + testRepoID.String())
+
+	reposClient.
+		EXPECT().
+		GetRepository(clients.ctx, gomock.Any()).
+		Return(nil, errors.New("GetRepository() Failed")).
+		Times(1)
+
+	_, err := resourceAzureGitRepositoryImport(resourceData, clients)
+	require.Contains(t, err.Error(), "GetRepository() Failed")
+}
+
+// verifies that the default branch of an imported repository is set once the import created its branches
+// verifies that a create that conflicts with a deleted repository fails with a hint if no recovery is configured
+func TestAzureGitRepo_Create_ConflictWithDeletedRepositoryRequiresRecovery(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	resourceData := createDeletedAzureGitRepositoryResourceData(t, "")
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &aggregatedClient{GitReposClient: reposClient, ctx: context.Background()}
+
+	expectCreateConflictsWithDeletedAzureGitRepository(reposClient)
+	reposClient.
+		EXPECT().
+		RestoreRepositoryFromRecycleBin(gomock.Any(), gomock.Any()).
+		Times(0)
+	reposClient.
+		EXPECT().
+		DeleteRepositoryFromRecycleBin(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	err := resourceAzureGitRepositoryCreate(resourceData, clients)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "Set recover_soft_deleted")
+}
+
+// verifies that the deleted repository is deleted permanently before the create is retried, and that the new
+// repository is initialized
+func TestAzureGitRepo_Create_DeletesDeletedRepositoryPermanently(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	resourceData := createDeletedAzureGitRepositoryResourceData(t, "delete")
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	repositoryClient := azdosdkmocks.NewMockGitRepositoryClient(ctrl)
+	clients := &aggregatedClient{GitReposClient: reposClient, GitRepositoryClient: repositoryClient, ctx: context.Background()}
+
+	firstCreate := expectCreateConflictsWithDeletedAzureGitRepository(reposClient)
+	deletion := reposClient.
+		EXPECT().
+		DeleteRepositoryFromRecycleBin(gomock.Any(), git.DeleteRepositoryFromRecycleBinArgs{
+			Project:      converter.String(testRepoProjectID.String()),
+			RepositoryId: &testRepoID,
+		}).
+		Return(nil).
+		After(firstCreate).
+		Times(1)
+	reposClient.
+		EXPECT().
+		CreateRepository(gomock.Any(), gomock.Any()).
+		Return(&testAzureGitRepository, nil).
+		After(deletion).
+		Times(1)
+	completed := git.GitAsyncOperationStatusValues.Completed
+	reposClient.
+		EXPECT().
+		CreateImportRequest(gomock.Any(), gomock.Any()).
+		Return(&git.GitImportRequest{Status: &completed}, nil).
+		Times(1)
+	expectReadAzureGitRepository(reposClient, repositoryClient)
+
+	err := resourceAzureGitRepositoryCreate(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, testRepoID.String(), resourceData.Id())
+}
+
+// verifies that the deleted repository is restored and managed instead of a new one
+func TestAzureGitRepo_Create_RestoresDeletedRepository(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	resourceData := createDeletedAzureGitRepositoryResourceData(t, "restore")
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	repositoryClient := azdosdkmocks.NewMockGitRepositoryClient(ctrl)
+	clients := &aggregatedClient{GitReposClient: reposClient, GitRepositoryClient: repositoryClient, ctx: context.Background()}
+
+	expectCreateConflictsWithDeletedAzureGitRepository(reposClient)
+	reposClient.
+		EXPECT().
+		RestoreRepositoryFromRecycleBin(gomock.Any(), git.RestoreRepositoryFromRecycleBinArgs{
+			RepositoryDetails: &git.GitRecycleBinRepositoryDetails{Deleted: converter.Bool(false)},
+			Project:           converter.String(testRepoProjectID.String()),
+			RepositoryId:      &testRepoID,
+		}).
+		Return(&testAzureGitRepository, nil).
+		Times(1)
+	reposClient.
+		EXPECT().
+		CreateImportRequest(gomock.Any(), gomock.Any()).
+		Times(0)
+	expectReadAzureGitRepository(reposClient, repositoryClient)
+
+	err := resourceAzureGitRepositoryCreate(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, testRepoID.String(), resourceData.Id())
+}
+
+// verifies that a deleted repository is not restored if a default branch is configured, which cannot be applied to it
+func TestAzureGitRepo_Create_DoesNotRestoreDeletedRepositoryWithDefaultBranch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	resourceData := createDeletedAzureGitRepositoryResourceData(t, "restore")
+	resourceData.Set("default_branch", "main")
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &aggregatedClient{GitReposClient: reposClient, ctx: context.Background()}
+
+	expectCreateConflictsWithDeletedAzureGitRepository(reposClient)
+	reposClient.
+		EXPECT().
+		RestoreRepositoryFromRecycleBin(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	err := resourceAzureGitRepositoryCreate(resourceData, clients)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "cannot be restored")
+}
+
+// verifies that conflicts other than a repository with the same name do not look for deleted repositories
+func TestAzureGitRepo_Create_OtherConflictsAreNotRecovered(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	resourceData := createDeletedAzureGitRepositoryResourceData(t, "restore")
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &aggregatedClient{GitReposClient: reposClient, ctx: context.Background()}
+
+	statusCode := http.StatusConflict
+	reposClient.
+		EXPECT().
+		CreateRepository(gomock.Any(), gomock.Any()).
+		Return(nil, azuredevops.WrappedError{StatusCode: &statusCode, Message: converter.String("Conflict")}).
+		Times(1)
+	reposClient.
+		EXPECT().
+		GetRecycleBinRepositories(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	err := resourceAzureGitRepositoryCreate(resourceData, clients)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "Conflict")
+}
+
+// Creates the resource data of a repository that is imported, and whose name is used by a deleted repository
+func createDeletedAzureGitRepositoryResourceData(t *testing.T, recovery string) *schema.ResourceData {
+	return schema.TestResourceDataRaw(t, resourceAzureGitRepository().Schema, map[string]interface{}{
+		"project_id":           testRepoProjectID.String(),
+		"name":                 "RepoName",
+		"recover_soft_deleted": recovery,
+		"initialization": []interface{}{map[string]interface{}{
+			"init_type":   "Import",
+			"source_type": "Git",
+			"source_url":  "https://github.com/microsoft/terraform-provider-azuredevops.git",
+		}},
+	})
+}
+
+func expectCreateConflictsWithDeletedAzureGitRepository(reposClient *azdosdkmocks.MockGitClient) *gomock.Call {
+	statusCode := http.StatusConflict
+	create := reposClient.
+		EXPECT().
+		CreateRepository(gomock.Any(), gomock.Any()).
+		Return(nil, azuredevops.WrappedError{
+			StatusCode: &statusCode,
+			TypeKey:    converter.String("GitRepositoryNameAlreadyExistsException"),
+		}).
+		Times(1)
+	reposClient.
+		EXPECT().
+		GetRecycleBinRepositories(gomock.Any(), git.GetRecycleBinRepositoriesArgs{Project: converter.String(testRepoProjectID.String())}).
+		Return(&[]git.GitDeletedRepository{{Id: &testRepoID, Name: converter.String("reponame")}}, nil).
+		After(create).
+		Times(1)
+	return create
+}
+
+func expectReadAzureGitRepository(reposClient *azdosdkmocks.MockGitClient, repositoryClient *azdosdkmocks.MockGitRepositoryClient) {
+	reposClient.
+		EXPECT().
+		GetRepository(gomock.Any(), gomock.Any()).
+		Return(&testAzureGitRepository, nil).
+		Times(1)
+	repositoryClient.
+		EXPECT().
+		GetRepositoryState(gomock.Any(), gomock.Any()).
+		Return(&gitrepository.RepositoryState{IsDisabled: converter.Bool(false)}, nil).
+		Times(1)
+}
+
+func TestAzureGitRepo_Create_SetsDefaultBranchAfterInitialization(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	resourceData := schema.TestResourceDataRaw(t, resourceAzureGitRepository().Schema, map[string]interface{}{
+		"project_id":     testRepoProjectID.String(),
+		"name":           "RepoName",
+		"default_branch": "main",
+		"initialization": []interface{}{map[string]interface{}{
+			"init_type":   "Import",
+			"source_type": "Git",
+			"source_url":  "https://github.com/microsoft/terraform-provider-azuredevops.git",
+		}},
+	})
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	repositoryClient := azdosdkmocks.NewMockGitRepositoryClient(ctrl)
+	clients := &aggregatedClient{
+		GitReposClient:      reposClient,
+		GitRepositoryClient: repositoryClient,
+		ctx:                 context.Background(),
+	}
+
+	reposClient.
+		EXPECT().
+		CreateRepository(gomock.Any(), gomock.Any()).
+		Return(&testAzureGitRepository, nil).
+		Times(1)
+	completed := git.GitAsyncOperationStatusValues.Completed
+	reposClient.
+		EXPECT().
+		CreateImportRequest(gomock.Any(), gomock.Any()).
+		Return(&git.GitImportRequest{Status: &completed}, nil).
+		Times(1)
+
+	// the branches of the import only show up after a while
+	refsArgs := git.GetRefsArgs{RepositoryId: converter.String(testRepoID.String()), Filter: converter.String("heads/")}
+	gomock.InOrder(
+		reposClient.EXPECT().GetRefs(gomock.Any(), refsArgs).Return(&git.GetRefsResponseValue{}, nil).Times(1),
+		reposClient.EXPECT().GetRefs(gomock.Any(), refsArgs).Return(&git.GetRefsResponseValue{
+			Value: []git.GitRef{{Name: converter.String("refs/heads/main")}},
+		}, nil).Times(1),
+	)
+
+	reposClient.
+		EXPECT().
+		UpdateRepository(gomock.Any(), git.UpdateRepositoryArgs{
+			NewRepositoryInfo: &git.GitRepository{Id: &testRepoID, DefaultBranch: converter.String("refs/heads/main")},
+			RepositoryId:      &testRepoID,
+			Project:           converter.String(testRepoProjectID.String()),
+		}).
+		Return(&testAzureGitRepository, nil).
+		Times(1)
+
+	repoWithDefaultBranch := testAzureGitRepository
+	repoWithDefaultBranch.DefaultBranch = converter.String("refs/heads/main")
+	reposClient.
+		EXPECT().
+		GetRepository(gomock.Any(), gomock.Any()).
+		Return(&repoWithDefaultBranch, nil).
+		Times(1)
+	repositoryClient.
+		EXPECT().
+		GetRepositoryState(gomock.Any(), gomock.Any()).
+		Return(&gitrepository.RepositoryState{IsDisabled: converter.Bool(false)}, nil).
+		Times(1)
+
+	err := resourceAzureGitRepositoryCreate(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "refs/heads/main", resourceData.Get("default_branch"))
+}
+
+// verifies that the default branch of a new repository requires an initialization that creates commits
+func TestAzureGitRepo_CustomizeDiff_DefaultBranchRequiresImport(t *testing.T) {
+	diffWithInitType := func(initType string) error {
+		_, err := resourceAzureGitRepository().Diff(nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+			"project_id":     testRepoProjectID.String(),
+			"name":           "RepoName",
+			"default_branch": "refs/heads/main",
+			"initialization": []interface{}{map[string]interface{}{
+				"init_type":   initType,
+				"source_type": "Git",
+				"source_url":  "https://github.com/microsoft/terraform-provider-azuredevops.git",
+			}},
+		}), nil)
+		return err
+	}
+
+	require.Nil(t, diffWithInitType("Import"))
+	err := diffWithInitType("Uninitialized")
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "default_branch")
+}
+
+// verifies that a fork is created from a parent repository in another project
+func TestAzureGitRepo_Create_ForksParentRepository(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	parentProjectID := uuid.New()
+	parentRepoID := uuid.New()
+	parentRepo := git.GitRepository{
+		Id:      &parentRepoID,
+		Name:    converter.String("ParentRepo"),
+		Project: &core.TeamProjectReference{Id: &parentProjectID},
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceAzureGitRepository().Schema, map[string]interface{}{
+		"project_id":           testRepoProjectID.String(),
+		"name":                 "RepoName",
+		"parent_repository_id": parentRepoID.String(),
+	})
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	repositoryClient := azdosdkmocks.NewMockGitRepositoryClient(ctrl)
+	clients := &aggregatedClient{
+		GitReposClient:      reposClient,
+		GitRepositoryClient: repositoryClient,
+		ctx:                 context.Background(),
+	}
+
+	reposClient.
+		EXPECT().
+		GetRepository(gomock.Any(), git.GetRepositoryArgs{RepositoryId: converter.String(parentRepoID.String())}).
+		Return(&parentRepo, nil).
+		Times(1)
+	reposClient.
+		EXPECT().
+		CreateRepository(gomock.Any(), git.CreateRepositoryArgs{
+			GitRepositoryToCreate: &git.GitRepositoryCreateOptions{
+				Name:             converter.String("RepoName"),
+				Project:          &core.TeamProjectReference{Id: &testRepoProjectID},
+				ParentRepository: &git.GitRepositoryRef{Id: &parentRepoID, Project: parentRepo.Project},
+			},
+		}).
+		Return(&testAzureGitRepository, nil).
+		Times(1)
+
+	fork := testAzureGitRepository
+	fork.IsFork = converter.Bool(true)
+	fork.ParentRepository = &git.GitRepositoryRef{Id: &parentRepoID}
+	reposClient.
+		EXPECT().
+		GetRepository(gomock.Any(), git.GetRepositoryArgs{
+			RepositoryId: converter.String(testRepoID.String()),
+			Project:      converter.String(testRepoProjectID.String()),
+		}).
+		Return(&fork, nil).
+		Times(1)
+	repositoryClient.
+		EXPECT().
+		GetRepositoryState(gomock.Any(), gomock.Any()).
+		Return(&gitrepository.RepositoryState{IsDisabled: converter.Bool(false)}, nil).
+		Times(1)
+
+	err := resourceAzureGitRepositoryCreate(resourceData, clients)
+	require.Nil(t, err)
+	require.True(t, resourceData.Get("is_fork").(bool))
+	require.Equal(t, parentRepoID.String(), resourceData.Get("parent_repository_id"))
+}
+
+// verifies that no fork is attempted if the parent repository does not exist
+func TestAzureGitRepo_Create_FailsIfParentRepositoryDoesNotExist(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	parentRepoID := uuid.New()
+	resourceData := schema.TestResourceDataRaw(t, resourceAzureGitRepository().Schema, map[string]interface{}{
+		"project_id":           testRepoProjectID.String(),
+		"name":                 "RepoName",
+		"parent_repository_id": parentRepoID.String(),
+	})
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &aggregatedClient{GitReposClient: reposClient, ctx: context.Background()}
+
+	statusCode := http.StatusNotFound
+	reposClient.
+		EXPECT().
+		GetRepository(gomock.Any(), gomock.Any()).
+		Return(nil, azuredevops.WrappedError{StatusCode: &statusCode}).
+		Times(1)
+	reposClient.
+		EXPECT().
+		CreateRepository(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	err := resourceAzureGitRepositoryCreate(resourceData, clients)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "Parent repository "+parentRepoID.String()+" does not exist")
+}
+
+// verifies that a fork cannot also be initialized, and that its default branch can be set as it has commits
+func TestAzureGitRepo_CustomizeDiff_ValidatesFork(t *testing.T) {
+	_, err := resourceAzureGitRepository().Diff(nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"project_id":           testRepoProjectID.String(),
+		"name":                 "RepoName",
+		"parent_repository_id": testRepoID.String(),
+		"default_branch":       "refs/heads/main",
+	}), nil)
+	require.Nil(t, err)
+
+	_, errs := resourceAzureGitRepository().Validate(terraform.NewResourceConfigRaw(map[string]interface{}{
+		"project_id":           testRepoProjectID.String(),
+		"name":                 "RepoName",
+		"parent_repository_id": testRepoID.String(),
+		"initialization":       []interface{}{map[string]interface{}{"init_type": "Uninitialized"}},
+	}))
+	require.NotEmpty(t, errs)
+}
+
+// verifies that a changed default branch is sent with the update, and an unchanged one is not
+func TestAzureGitRepo_Update_ChangesDefaultBranch(t *testing.T) {
+	repoSchema := schema.InternalMap(resourceAzureGitRepository().Schema)
+	state := &terraform.InstanceState{
+		ID: testRepoID.String(),
+		Attributes: map[string]string{
+			"project_id":     testRepoProjectID.String(),
+			"name":           "RepoName",
+			"default_branch": "refs/heads/master",
+		},
+	}
+
+	for configuredBranch, expectedBranch := range map[string]*string{
+		"main":              converter.String("refs/heads/main"),
+		"refs/heads/master": nil,
+		"master":            nil,
+	} {
+		config := terraform.NewResourceConfigRaw(map[string]interface{}{
+			"project_id":     testRepoProjectID.String(),
+			"name":           "RepoName",
+			"default_branch": configuredBranch,
+		})
+		diff, err := repoSchema.Diff(state, config, nil, nil, true)
+		require.Nil(t, err)
+		resourceData, err := repoSchema.Data(state, diff)
+		require.Nil(t, err)
+
+		repo, _, err := expandAzureGitRepository(resourceData)
+		require.Nil(t, err)
+		require.Equal(t, expectedBranch, repo.DefaultBranch, configuredBranch)
+	}
+}
+
 /**
  * Begin acceptance tests
  */
@@ -256,6 +1030,7 @@ func TestAccAzureGitRepo_CreateAndUpdate(t *testing.T) {
 					resource.TestCheckResourceAttrSet(tfRepoNode, "ssh_url"),
 					resource.TestCheckResourceAttrSet(tfRepoNode, "url"),
 					resource.TestCheckResourceAttrSet(tfRepoNode, "web_url"),
+					resource.TestCheckResourceAttr(tfRepoNode, "disabled", "false"),
 				),
 			},
 			{
@@ -270,12 +1045,80 @@ func TestAccAzureGitRepo_CreateAndUpdate(t *testing.T) {
 					resource.TestCheckResourceAttrSet(tfRepoNode, "ssh_url"),
 					resource.TestCheckResourceAttrSet(tfRepoNode, "url"),
 					resource.TestCheckResourceAttrSet(tfRepoNode, "web_url"),
+					resource.TestCheckResourceAttr(tfRepoNode, "disabled", "false"),
+				),
+			},
+			{
+				ResourceName:      tfRepoNode,
+				ImportStateIdFunc: testAccAzureGitRepoImportStateIDFunc(tfRepoNode),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// Verifies that a repository can be initialized by importing a public Git repository
+func TestAccAzureGitRepo_CreateFromImport(t *testing.T) {
+	projectName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	gitRepoName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	tfRepoNode := "azuredevops_azure_git_repository.gitrepo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccAzureGitRepoCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureGitRepoResourceWithImport(projectName, gitRepoName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfRepoNode, "name", gitRepoName),
+					resource.TestCheckResourceAttr(tfRepoNode, "initialization.0.init_type", "Import"),
+					resource.TestCheckResourceAttrSet(tfRepoNode, "default_branch"),
+					testAccCheckAzureGitRepoResourceExists(gitRepoName),
+				),
+			},
+		},
+	})
+}
+
+// Verifies that a repository can be forked from a repository of another project
+func TestAccAzureGitRepo_CreateFork(t *testing.T) {
+	projectName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	forkProjectName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	gitRepoName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	forkRepoName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	tfForkNode := "azuredevops_azure_git_repository.fork"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccAzureGitRepoCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureGitRepoResourceWithFork(projectName, gitRepoName, forkProjectName, forkRepoName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfForkNode, "name", forkRepoName),
+					resource.TestCheckResourceAttr(tfForkNode, "is_fork", "true"),
+					resource.TestCheckResourceAttrPair(tfForkNode, "parent_repository_id", "azuredevops_azure_git_repository.gitrepo", "id"),
+					resource.TestCheckResourceAttrPair(tfForkNode, "project_id", "azuredevops_project.fork_project", "id"),
 				),
 			},
 		},
 	})
 }
 
+// Builds the <projectID>/<repositoryID> identifier needed to import a repository
+func testAccAzureGitRepoImportStateIDFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		res, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Did not find a repo definition in the TF state")
+		}
+		return fmt.Sprintf("%s/%s", res.Primary.Attributes["project_id"], res.Primary.ID), nil
+	}
+}
+
 // Given the name of an AzDO git repository, this will return a function that will check whether
 // or not the definition (1) exists in the state and (2) exist in AzDO and (3) has the correct name
 func testAccCheckAzureGitRepoResourceExists(expectedName string) resource.TestCheckFunc {
@@ -314,6 +1157,38 @@ resource "azuredevops_azure_git_repository" "gitrepo" {
 	return fmt.Sprintf("%s\n%s", projectResource, azureGitRepoResource)
 }
 
+func testAccAzureGitRepoResourceWithImport(projectName string, gitRepoName string) string {
+	azureGitRepoResource := fmt.Sprintf(`
+resource "azuredevops_azure_git_repository" "gitrepo" {
+	project_id      = azuredevops_project.project.id
+	name            = "%s"
+	initialization {
+		init_type   = "Import"
+		source_type = "Git"
+		source_url  = "https://github.com/microsoft/terraform-provider-azuredevops.git"
+	}
+}`, gitRepoName)
+
+	projectResource := testAccProjectResource(projectName)
+	return fmt.Sprintf("%s\n%s", projectResource, azureGitRepoResource)
+}
+
+// HCL describing a fork, in a separate project, of a repository that was initialized by an import
+func testAccAzureGitRepoResourceWithFork(projectName string, gitRepoName string, forkProjectName string, forkRepoName string) string {
+	forkResources := fmt.Sprintf(`
+resource "azuredevops_project" "fork_project" {
+	project_name = "%s"
+}
+
+resource "azuredevops_azure_git_repository" "fork" {
+	project_id           = azuredevops_project.fork_project.id
+	name                 = "%s"
+	parent_repository_id = azuredevops_azure_git_repository.gitrepo.id
+}`, forkProjectName, forkRepoName)
+
+	return fmt.Sprintf("%s\n%s", testAccAzureGitRepoResourceWithImport(projectName, gitRepoName), forkResources)
+}
+
 func testAccAzureGitRepoCheckDestroy(s *terraform.State) error {
 	clients := testAccProvider.Meta().(*aggregatedClient)
 
@@ -0,0 +1,137 @@
+package azuredevops
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/build"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/policy"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/azdoerror"
+)
+
+// The ID of the "Build" policy type
+var buildValidationPolicyTypeID = uuid.MustParse("0609b952-1397-4640-95ec-e00a01b2c241")
+
+type buildValidationPolicySettings struct {
+	BuildDefinitionID       int      `json:"buildDefinitionId"`
+	DisplayName             string   `json:"displayName"`
+	ValidDuration           float64  `json:"validDuration"`
+	FilenamePatterns        []string `json:"filenamePatterns"`
+	QueueOnSourceUpdateOnly bool     `json:"queueOnSourceUpdateOnly"`
+}
+
+func resourceBranchPolicyBuildValidation() *schema.Resource {
+	r := genBasePolicyResource(flattenBranchPolicyBuildValidation, expandBranchPolicyBuildValidation)
+	r.Read = genBuildValidationReadFunc(r.Read)
+
+	r.Schema["build_definition_id"] = &schema.Schema{
+		Type:         schema.TypeInt,
+		Required:     true,
+		Description:  "The ID of the build definition that has to succeed.",
+		ValidateFunc: validation.IntAtLeast(1),
+	}
+	r.Schema["display_name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Default:     "",
+		Description: "The name of the build validation shown in pull requests.",
+	}
+	r.Schema["valid_duration"] = &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Default:      720,
+		Description:  "The number of minutes a successful build stays valid. 0 means the build never expires.",
+		ValidateFunc: validation.IntAtLeast(0),
+	}
+	r.Schema["filename_patterns"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "The paths that trigger the build. Paths prefixed with ! are excluded.",
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validation.NoZeroValues,
+		},
+	}
+	r.Schema["queue_on_source_update_only"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     true,
+		Description: "Only queue the build when the source branch of the pull request is updated.",
+	}
+
+	return r
+}
+
+// Wraps the read of the policy to also detect a build definition that was deleted underneath the policy. The
+// ID of a deleted build definition is cleared so that the next apply points the policy to the configured one.
+func genBuildValidationReadFunc(readFunc schema.ReadFunc) schema.ReadFunc {
+	return func(d *schema.ResourceData, m interface{}) error {
+		if err := readFunc(d, m); err != nil || d.Id() == "" {
+			return err
+		}
+
+		clients := m.(*aggregatedClient)
+		projectID := d.Get("project_id").(string)
+		buildDefinitionID := d.Get("build_definition_id").(int)
+
+		_, err := clients.BuildClient.GetDefinition(clients.ctx, build.GetDefinitionArgs{
+			Project:      &projectID,
+			DefinitionId: &buildDefinitionID,
+		})
+		if azdoerror.IsNotFound(err) {
+			log.Printf("[WARN] Build definition %d of build validation policy %s no longer exists", buildDefinitionID, d.Id())
+			d.Set("build_definition_id", 0)
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("Error looking up build definition with ID %d of build validation policy %s: %+v", buildDefinitionID, d.Id(), err)
+		}
+		return nil
+	}
+}
+
+// Convert internal Terraform data structure to an AzDO data structure
+func expandBranchPolicyBuildValidation(d *schema.ResourceData) (*policy.PolicyConfiguration, *string, error) {
+	policyConfig, projectID, err := doBasePolicyExpansion(d, buildValidationPolicyTypeID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	settings := policySettings(policyConfig)
+	settings["buildDefinitionId"] = d.Get("build_definition_id").(int)
+	settings["displayName"] = d.Get("display_name").(string)
+	settings["validDuration"] = d.Get("valid_duration").(int)
+	settings["queueOnSourceUpdateOnly"] = d.Get("queue_on_source_update_only").(bool)
+
+	if patterns := d.Get("filename_patterns").([]interface{}); len(patterns) > 0 {
+		filenamePatterns := make([]string, len(patterns))
+		for i, pattern := range patterns {
+			filenamePatterns[i] = pattern.(string)
+		}
+		settings["filenamePatterns"] = filenamePatterns
+	}
+
+	return policyConfig, projectID, nil
+}
+
+// Convert AzDO data structure to internal Terraform data structure
+func flattenBranchPolicyBuildValidation(d *schema.ResourceData, policyConfig *policy.PolicyConfiguration, projectID *string) error {
+	if err := doBasePolicyFlattening(d, policyConfig, projectID); err != nil {
+		return err
+	}
+
+	var settings buildValidationPolicySettings
+	if err := decodePolicySettings(policyConfig, &settings); err != nil {
+		return err
+	}
+
+	d.Set("build_definition_id", settings.BuildDefinitionID)
+	d.Set("display_name", settings.DisplayName)
+	d.Set("valid_duration", int(settings.ValidDuration))
+	d.Set("filename_patterns", settings.FilenamePatterns)
+	d.Set("queue_on_source_update_only", settings.QueueOnSourceUpdateOnly)
+	return nil
+}
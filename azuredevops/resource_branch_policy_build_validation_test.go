@@ -0,0 +1,319 @@
+package azuredevops
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/build"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/policy"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var buildValidationTestPolicyID = 7
+var buildValidationTestBuildDefinitionID = 12
+var buildValidationTestProjectID = uuid.New().String()
+var buildValidationTestRepositoryID = uuid.New().String()
+
+var buildValidationTestPolicy = policy.PolicyConfiguration{
+	Id:         &buildValidationTestPolicyID,
+	Type:       &policy.PolicyTypeRef{Id: &buildValidationPolicyTypeID},
+	IsEnabled:  converter.Bool(true),
+	IsBlocking: converter.Bool(true),
+	Settings: map[string]interface{}{
+		"scope": []policyScope{{
+			RepositoryID: buildValidationTestRepositoryID,
+			RefName:      "refs/heads/master",
+			MatchKind:    policyMatchTypeExact,
+		}},
+		"buildDefinitionId":       buildValidationTestBuildDefinitionID,
+		"displayName":             "CI",
+		"validDuration":           720,
+		"queueOnSourceUpdateOnly": true,
+		"filenamePatterns":        []string{"/src/*", "!/src/docs/*"},
+	},
+}
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that the flatten/expand round trip yields the same policy configuration
+func TestAzureDevOpsBranchPolicyBuildValidation_ExpandFlatten_Roundtrip(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, resourceBranchPolicyBuildValidation().Schema, nil)
+	err := flattenBranchPolicyBuildValidation(resourceData, &buildValidationTestPolicy, &buildValidationTestProjectID)
+	require.Nil(t, err)
+
+	policyAfterRoundTrip, projectID, err := expandBranchPolicyBuildValidation(resourceData)
+	require.Nil(t, err)
+	require.Equal(t, buildValidationTestPolicy, *policyAfterRoundTrip)
+	require.Equal(t, buildValidationTestProjectID, *projectID)
+}
+
+// verifies that settings changed outside of Terraform are detected. The settings are decoded from
+// JSON by the SDK, which is why the test data is decoded from JSON as well.
+func TestAzureDevOpsBranchPolicyBuildValidation_Flatten_DetectsDrift(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, resourceBranchPolicyBuildValidation().Schema, nil)
+	err := flattenBranchPolicyBuildValidation(resourceData, &buildValidationTestPolicy, &buildValidationTestProjectID)
+	require.Nil(t, err)
+
+	var settings interface{}
+	err = json.Unmarshal([]byte(fmt.Sprintf(`{
+		"scope": [{"repositoryId": "%s", "refName": "refs/heads/master", "matchKind": "Exact"}],
+		"buildDefinitionId": 13,
+		"displayName": "Changed",
+		"validDuration": 0.0,
+		"queueOnSourceUpdateOnly": false
+	}`, buildValidationTestRepositoryID)), &settings)
+	require.Nil(t, err)
+
+	changedPolicy := buildValidationTestPolicy
+	changedPolicy.Settings = settings
+	err = flattenBranchPolicyBuildValidation(resourceData, &changedPolicy, &buildValidationTestProjectID)
+	require.Nil(t, err)
+
+	require.Equal(t, 13, resourceData.Get("build_definition_id"))
+	require.Equal(t, "Changed", resourceData.Get("display_name"))
+	require.Equal(t, 0, resourceData.Get("valid_duration"))
+	require.Equal(t, false, resourceData.Get("queue_on_source_update_only"))
+	require.Empty(t, resourceData.Get("filename_patterns"))
+}
+
+// verifies that a build definition deleted underneath the policy is detected so that the policy is updated
+func TestAzureDevOpsBranchPolicyBuildValidation_Read_ClearsDeletedBuildDefinition(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := resourceBranchPolicyBuildValidation()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	flattenBranchPolicyBuildValidation(resourceData, &buildValidationTestPolicy, &buildValidationTestProjectID)
+
+	policyClient := azdosdkmocks.NewMockPolicyClient(ctrl)
+	buildClient := azdosdkmocks.NewMockBuildClient(ctrl)
+	clients := &aggregatedClient{PolicyClient: policyClient, BuildClient: buildClient, ctx: context.Background()}
+
+	policyClient.
+		EXPECT().
+		GetPolicyConfiguration(clients.ctx, gomock.Any()).
+		Return(&buildValidationTestPolicy, nil).
+		Times(1)
+
+	notFound := http.StatusNotFound
+	expectedArgs := build.GetDefinitionArgs{Project: &buildValidationTestProjectID, DefinitionId: &buildValidationTestBuildDefinitionID}
+	buildClient.
+		EXPECT().
+		GetDefinition(clients.ctx, expectedArgs).
+		Return(nil, azuredevops.WrappedError{StatusCode: &notFound}).
+		Times(1)
+
+	err := r.Read(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "7", resourceData.Id())
+	require.Equal(t, 0, resourceData.Get("build_definition_id"))
+}
+
+// verifies that an error looking up the build definition is not swallowed
+func TestAzureDevOpsBranchPolicyBuildValidation_Read_DoesNotSwallowBuildDefinitionError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := resourceBranchPolicyBuildValidation()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	flattenBranchPolicyBuildValidation(resourceData, &buildValidationTestPolicy, &buildValidationTestProjectID)
+
+	policyClient := azdosdkmocks.NewMockPolicyClient(ctrl)
+	buildClient := azdosdkmocks.NewMockBuildClient(ctrl)
+	clients := &aggregatedClient{PolicyClient: policyClient, BuildClient: buildClient, ctx: context.Background()}
+
+	policyClient.
+		EXPECT().
+		GetPolicyConfiguration(clients.ctx, gomock.Any()).
+		Return(&buildValidationTestPolicy, nil).
+		Times(1)
+
+	buildClient.
+		EXPECT().
+		GetDefinition(clients.ctx, gomock.Any()).
+		Return(nil, errors.New("GetDefinition() Failed")).
+		Times(1)
+
+	err := r.Read(resourceData, clients)
+	require.Contains(t, err.Error(), "GetDefinition() Failed")
+}
+
+// verifies that if an error is produced on create, the error is not swallowed
+func TestAzureDevOpsBranchPolicyBuildValidation_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := resourceBranchPolicyBuildValidation()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	flattenBranchPolicyBuildValidation(resourceData, &buildValidationTestPolicy, &buildValidationTestProjectID)
+	resourceData.SetId("")
+
+	policyClient := azdosdkmocks.NewMockPolicyClient(ctrl)
+	clients := &aggregatedClient{PolicyClient: policyClient, ctx: context.Background()}
+
+	expectedPolicy := buildValidationTestPolicy
+	expectedPolicy.Id = nil
+	expectedArgs := policy.CreatePolicyConfigurationArgs{Configuration: &expectedPolicy, Project: &buildValidationTestProjectID}
+	policyClient.
+		EXPECT().
+		CreatePolicyConfiguration(clients.ctx, expectedArgs).
+		Return(nil, errors.New("CreatePolicyConfiguration() Failed")).
+		Times(1)
+
+	err := r.Create(resourceData, clients)
+	require.Contains(t, err.Error(), "CreatePolicyConfiguration() Failed")
+}
+
+// verifies that if an error is produced on a read, it is not swallowed
+func TestAzureDevOpsBranchPolicyBuildValidation_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := resourceBranchPolicyBuildValidation()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	flattenBranchPolicyBuildValidation(resourceData, &buildValidationTestPolicy, &buildValidationTestProjectID)
+
+	policyClient := azdosdkmocks.NewMockPolicyClient(ctrl)
+	clients := &aggregatedClient{PolicyClient: policyClient, ctx: context.Background()}
+
+	expectedArgs := policy.GetPolicyConfigurationArgs{ConfigurationId: &buildValidationTestPolicyID, Project: &buildValidationTestProjectID}
+	policyClient.
+		EXPECT().
+		GetPolicyConfiguration(clients.ctx, expectedArgs).
+		Return(nil, errors.New("GetPolicyConfiguration() Failed")).
+		Times(1)
+
+	err := r.Read(resourceData, clients)
+	require.Contains(t, err.Error(), "GetPolicyConfiguration() Failed")
+}
+
+// verifies that updates target the policy configuration stored in the state
+func TestAzureDevOpsBranchPolicyBuildValidation_Update_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := resourceBranchPolicyBuildValidation()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	flattenBranchPolicyBuildValidation(resourceData, &buildValidationTestPolicy, &buildValidationTestProjectID)
+
+	policyClient := azdosdkmocks.NewMockPolicyClient(ctrl)
+	clients := &aggregatedClient{PolicyClient: policyClient, ctx: context.Background()}
+
+	expectedArgs := policy.UpdatePolicyConfigurationArgs{
+		ConfigurationId: &buildValidationTestPolicyID,
+		Configuration:   &buildValidationTestPolicy,
+		Project:         &buildValidationTestProjectID,
+	}
+	policyClient.
+		EXPECT().
+		UpdatePolicyConfiguration(clients.ctx, expectedArgs).
+		Return(nil, errors.New("UpdatePolicyConfiguration() Failed")).
+		Times(1)
+
+	err := r.Update(resourceData, clients)
+	require.Contains(t, err.Error(), "UpdatePolicyConfiguration() Failed")
+}
+
+// verifies that if an error is produced on a delete, it is not swallowed
+func TestAzureDevOpsBranchPolicyBuildValidation_Delete_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := resourceBranchPolicyBuildValidation()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	flattenBranchPolicyBuildValidation(resourceData, &buildValidationTestPolicy, &buildValidationTestProjectID)
+
+	policyClient := azdosdkmocks.NewMockPolicyClient(ctrl)
+	clients := &aggregatedClient{PolicyClient: policyClient, ctx: context.Background()}
+
+	expectedArgs := policy.DeletePolicyConfigurationArgs{ConfigurationId: &buildValidationTestPolicyID, Project: &buildValidationTestProjectID}
+	policyClient.
+		EXPECT().
+		DeletePolicyConfiguration(clients.ctx, expectedArgs).
+		Return(errors.New("DeletePolicyConfiguration() Failed")).
+		Times(1)
+
+	err := r.Delete(resourceData, clients)
+	require.Contains(t, err.Error(), "DeletePolicyConfiguration() Failed")
+}
+
+/**
+ * Begin acceptance tests
+ */
+
+// validates that an apply followed by another apply (i.e., resource update) will be reflected in AzDO and the
+// underlying terraform state.
+func TestAccAzureDevOpsBranchPolicyBuildValidation_CreateAndUpdate(t *testing.T) {
+	projectName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	gitRepoName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	buildDefinitionName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	tfNode := "azuredevops_branch_policy_build_validation.policy"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccBranchPolicyCheckDestroyByType("azuredevops_branch_policy_build_validation"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBranchPolicyBuildValidationResource(projectName, gitRepoName, buildDefinitionName, "CI"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfNode, "project_id"),
+					resource.TestCheckResourceAttrSet(tfNode, "repository_id"),
+					resource.TestCheckResourceAttrSet(tfNode, "build_definition_id"),
+					resource.TestCheckResourceAttr(tfNode, "display_name", "CI"),
+					resource.TestCheckResourceAttr(tfNode, "filename_patterns.#", "1"),
+					testAccCheckBranchPolicyResourceExistsByNode(tfNode),
+				),
+			}, {
+				Config: testAccBranchPolicyBuildValidationResource(projectName, gitRepoName, buildDefinitionName, "PR build"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "display_name", "PR build"),
+					testAccCheckBranchPolicyResourceExistsByNode(tfNode),
+				),
+			},
+		},
+	})
+}
+
+// HCL describing a build validation policy
+func testAccBranchPolicyBuildValidationResource(projectName string, gitRepoName string, buildDefinitionName string, displayName string) string {
+	policyResource := fmt.Sprintf(`
+resource "azuredevops_build_definition" "build" {
+	project_id      = azuredevops_project.project.id
+	name            = "%s"
+	agent_pool_name = "Hosted Ubuntu 1604"
+
+	repository {
+	  repo_type             = "TfsGit"
+	  repo_name             = azuredevops_azure_git_repository.gitrepo.name
+	  branch_name           = "branch"
+	  yml_path              = "path/to/yaml"
+	}
+}
+
+resource "azuredevops_branch_policy_build_validation" "policy" {
+	project_id          = azuredevops_project.project.id
+	repository_id       = azuredevops_azure_git_repository.gitrepo.id
+	branch              = "master"
+	build_definition_id = azuredevops_build_definition.build.id
+	display_name        = "%s"
+	filename_patterns   = ["/src/*"]
+}`, buildDefinitionName, displayName)
+
+	gitRepoResource := testAccAzureGitRepoResource(projectName, gitRepoName)
+	return fmt.Sprintf("%s\n%s", gitRepoResource, policyResource)
+}
@@ -0,0 +1,30 @@
+package azuredevops
+
+import (
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/policy"
+)
+
+// The ID of the "Comment requirements" policy type
+var commentResolutionPolicyTypeID = uuid.MustParse("c6a1889d-b943-4856-b76f-9e46bb6b0df2")
+
+// The policy has no settings besides its scope. Every policy on a branch is a configuration of its own, so
+// creating it leaves the other policies of the branch untouched.
+func resourceBranchPolicyCommentResolution() *schema.Resource {
+	r := genBasePolicyResource(flattenBranchPolicyCommentResolution, expandBranchPolicyCommentResolution)
+	r.Importer = &schema.ResourceImporter{
+		State: genPolicyImportFunc(commentResolutionPolicyTypeID),
+	}
+	return r
+}
+
+// Convert internal Terraform data structure to an AzDO data structure
+func expandBranchPolicyCommentResolution(d *schema.ResourceData) (*policy.PolicyConfiguration, *string, error) {
+	return doBasePolicyExpansion(d, commentResolutionPolicyTypeID)
+}
+
+// Convert AzDO data structure to internal Terraform data structure
+func flattenBranchPolicyCommentResolution(d *schema.ResourceData, policyConfig *policy.PolicyConfiguration, projectID *string) error {
+	return doBasePolicyFlattening(d, policyConfig, projectID)
+}
@@ -0,0 +1,212 @@
+package azuredevops
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/policy"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var commentResolutionTestPolicyID = 42
+var commentResolutionTestProjectID = uuid.New().String()
+var commentResolutionTestRepositoryID = uuid.New().String()
+
+var commentResolutionTestPolicy = policy.PolicyConfiguration{
+	Id:         &commentResolutionTestPolicyID,
+	Type:       &policy.PolicyTypeRef{Id: &commentResolutionPolicyTypeID},
+	IsEnabled:  converter.Bool(true),
+	IsBlocking: converter.Bool(false),
+	Settings: map[string]interface{}{
+		"scope": []policyScope{{
+			RepositoryID: commentResolutionTestRepositoryID,
+			RefName:      "refs/heads/release",
+			MatchKind:    policyMatchTypePrefix,
+		}},
+	},
+}
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that the flatten/expand round trip yields the same policy configuration
+func TestAzureDevOpsBranchPolicyCommentResolution_ExpandFlatten_Roundtrip(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, resourceBranchPolicyCommentResolution().Schema, nil)
+	err := flattenBranchPolicyCommentResolution(resourceData, &commentResolutionTestPolicy, &commentResolutionTestProjectID)
+	require.Nil(t, err)
+
+	policyAfterRoundTrip, projectID, err := expandBranchPolicyCommentResolution(resourceData)
+	require.Nil(t, err)
+	require.Equal(t, commentResolutionTestPolicy, *policyAfterRoundTrip)
+	require.Equal(t, commentResolutionTestProjectID, *projectID)
+}
+
+// verifies that changes made outside of Terraform are detected
+func TestAzureDevOpsBranchPolicyCommentResolution_Flatten_DetectsDrift(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, resourceBranchPolicyCommentResolution().Schema, nil)
+	err := flattenBranchPolicyCommentResolution(resourceData, &commentResolutionTestPolicy, &commentResolutionTestProjectID)
+	require.Nil(t, err)
+
+	changedPolicy := commentResolutionTestPolicy
+	changedPolicy.IsEnabled = converter.Bool(false)
+	changedPolicy.IsBlocking = converter.Bool(true)
+	changedPolicy.Settings = map[string]interface{}{
+		"scope": []policyScope{{
+			RepositoryID: commentResolutionTestRepositoryID,
+			RefName:      "refs/heads/master",
+			MatchKind:    policyMatchTypeExact,
+		}},
+	}
+	err = flattenBranchPolicyCommentResolution(resourceData, &changedPolicy, &commentResolutionTestProjectID)
+	require.Nil(t, err)
+
+	require.Equal(t, false, resourceData.Get("enabled"))
+	require.Equal(t, true, resourceData.Get("blocking"))
+	require.Equal(t, "refs/heads/master", resourceData.Get("branch"))
+	require.Equal(t, policyMatchTypeExact, resourceData.Get("match_type"))
+}
+
+// verifies that a policy deleted outside of Terraform is removed from the state
+func TestAzureDevOpsBranchPolicyCommentResolution_Read_ClearsIdIfPolicyWasDeleted(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := resourceBranchPolicyCommentResolution()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	flattenBranchPolicyCommentResolution(resourceData, &commentResolutionTestPolicy, &commentResolutionTestProjectID)
+
+	policyClient := azdosdkmocks.NewMockPolicyClient(ctrl)
+	clients := &aggregatedClient{PolicyClient: policyClient, ctx: context.Background()}
+
+	deletedPolicy := commentResolutionTestPolicy
+	deletedPolicy.IsDeleted = converter.Bool(true)
+	policyClient.
+		EXPECT().
+		GetPolicyConfiguration(clients.ctx, gomock.Any()).
+		Return(&deletedPolicy, nil).
+		Times(1)
+
+	err := r.Read(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "", resourceData.Id())
+}
+
+// verifies that if an error is produced on create, the error is not swallowed
+func TestAzureDevOpsBranchPolicyCommentResolution_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := resourceBranchPolicyCommentResolution()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	flattenBranchPolicyCommentResolution(resourceData, &commentResolutionTestPolicy, &commentResolutionTestProjectID)
+	resourceData.SetId("")
+
+	policyClient := azdosdkmocks.NewMockPolicyClient(ctrl)
+	clients := &aggregatedClient{PolicyClient: policyClient, ctx: context.Background()}
+
+	expectedPolicy := commentResolutionTestPolicy
+	expectedPolicy.Id = nil
+	expectedArgs := policy.CreatePolicyConfigurationArgs{Configuration: &expectedPolicy, Project: &commentResolutionTestProjectID}
+	policyClient.
+		EXPECT().
+		CreatePolicyConfiguration(clients.ctx, expectedArgs).
+		Return(nil, errors.New("CreatePolicyConfiguration() Failed")).
+		Times(1)
+
+	err := r.Create(resourceData, clients)
+	require.Contains(t, err.Error(), "CreatePolicyConfiguration() Failed")
+}
+
+// verifies that if an error is produced on an update, it is not swallowed
+func TestAzureDevOpsBranchPolicyCommentResolution_Update_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := resourceBranchPolicyCommentResolution()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	flattenBranchPolicyCommentResolution(resourceData, &commentResolutionTestPolicy, &commentResolutionTestProjectID)
+
+	policyClient := azdosdkmocks.NewMockPolicyClient(ctrl)
+	clients := &aggregatedClient{PolicyClient: policyClient, ctx: context.Background()}
+
+	expectedArgs := policy.UpdatePolicyConfigurationArgs{
+		ConfigurationId: &commentResolutionTestPolicyID,
+		Configuration:   &commentResolutionTestPolicy,
+		Project:         &commentResolutionTestProjectID,
+	}
+	policyClient.
+		EXPECT().
+		UpdatePolicyConfiguration(clients.ctx, expectedArgs).
+		Return(nil, errors.New("UpdatePolicyConfiguration() Failed")).
+		Times(1)
+
+	err := r.Update(resourceData, clients)
+	require.Contains(t, err.Error(), "UpdatePolicyConfiguration() Failed")
+}
+
+/**
+ * Begin acceptance tests
+ */
+
+// validates that the policy can be created next to another policy on the same branch, updated and imported
+func TestAccAzureDevOpsBranchPolicyCommentResolution_CreateUpdateAndImport(t *testing.T) {
+	projectName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	gitRepoName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	tfNode := "azuredevops_branch_policy_comment_resolution.policy"
+	tfMinReviewersNode := "azuredevops_branch_policy_min_reviewers.policy"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccBranchPolicyCheckDestroyByType("azuredevops_branch_policy_comment_resolution"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBranchPolicyCommentResolutionResource(projectName, gitRepoName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfNode, "project_id"),
+					resource.TestCheckResourceAttrSet(tfNode, "repository_id"),
+					resource.TestCheckResourceAttr(tfNode, "branch", "master"),
+					resource.TestCheckResourceAttr(tfNode, "blocking", "true"),
+					testAccCheckBranchPolicyResourceExistsByNode(tfNode),
+					testAccCheckBranchPolicyResourceExistsByNode(tfMinReviewersNode),
+				),
+			}, {
+				Config: testAccBranchPolicyCommentResolutionResource(projectName, gitRepoName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "blocking", "false"),
+					testAccCheckBranchPolicyResourceExistsByNode(tfNode),
+					testAccCheckBranchPolicyResourceExistsByNode(tfMinReviewersNode),
+				),
+			}, {
+				ResourceName:      tfNode,
+				ImportState:       true,
+				ImportStateIdFunc: testAccBranchPolicyImportStateID(tfNode),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// HCL describing a comment resolution policy next to a minimum number of reviewers policy on the same branch
+func testAccBranchPolicyCommentResolutionResource(projectName string, gitRepoName string, blocking bool) string {
+	policyResource := fmt.Sprintf(`
+resource "azuredevops_branch_policy_comment_resolution" "policy" {
+	project_id    = azuredevops_project.project.id
+	repository_id = azuredevops_azure_git_repository.gitrepo.id
+	branch        = "master"
+	blocking      = %t
+}`, blocking)
+
+	minReviewersResource := testAccBranchPolicyMinReviewersResource(projectName, gitRepoName, 1)
+	return fmt.Sprintf("%s\n%s", minReviewersResource, policyResource)
+}
@@ -0,0 +1,273 @@
+package azuredevops
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/policy"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+)
+
+const (
+	policyMatchTypeExact  = "Exact"
+	policyMatchTypePrefix = "Prefix"
+)
+
+// policyFlatFunc converts an AzDO policy configuration into the Terraform data structure of a specific policy type
+type policyFlatFunc func(d *schema.ResourceData, policyConfig *policy.PolicyConfiguration, projectID *string) error
+
+// policyExpandFunc converts the Terraform data structure of a specific policy type into an AzDO policy configuration
+type policyExpandFunc func(d *schema.ResourceData) (*policy.PolicyConfiguration, *string, error)
+
+// The scope of a branch policy. It limits the policy to the branches of a repository that match the ref name.
+type policyScope struct {
+	RepositoryID string `json:"repositoryId"`
+	RefName      string `json:"refName"`
+	MatchKind    string `json:"matchKind"`
+}
+
+// genBasePolicyResource creates a resource that shares the CRUD operations and the common schema
+// of every branch policy type. Callers add the schema elements specific to their policy type.
+func genBasePolicyResource(f policyFlatFunc, e policyExpandFunc) *schema.Resource {
+	return &schema.Resource{
+		Create: genPolicyCreateFunc(f, e),
+		Read:   genPolicyReadFunc(f),
+		Update: genPolicyUpdateFunc(f, e),
+		Delete: genPolicyDeleteFunc(),
+		Schema: basePolicySchema(),
+	}
+}
+
+func basePolicySchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"project_id": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.NoZeroValues,
+		},
+		"enabled": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+		"blocking": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+		"repository_id": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.NoZeroValues,
+		},
+		"branch": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.NoZeroValues,
+		},
+		"match_type": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      policyMatchTypeExact,
+			ValidateFunc: validation.StringInSlice([]string{policyMatchTypeExact, policyMatchTypePrefix}, false),
+		},
+	}
+}
+
+// doBasePolicyExpansion expands the attributes shared by every policy type. The settings of the
+// returned policy configuration contain the scope of the policy and are extended by the callers.
+func doBasePolicyExpansion(d *schema.ResourceData, policyTypeID uuid.UUID) (*policy.PolicyConfiguration, *string, error) {
+	projectID := converter.String(d.Get("project_id").(string))
+	policyConfig := &policy.PolicyConfiguration{
+		Type:       &policy.PolicyTypeRef{Id: &policyTypeID},
+		IsEnabled:  converter.Bool(d.Get("enabled").(bool)),
+		IsBlocking: converter.Bool(d.Get("blocking").(bool)),
+		Settings: map[string]interface{}{
+			"scope": []policyScope{{
+				RepositoryID: d.Get("repository_id").(string),
+				RefName:      withRefsHeadsPrefix(d.Get("branch").(string)),
+				MatchKind:    d.Get("match_type").(string),
+			}},
+		},
+	}
+
+	if d.Id() != "" {
+		policyID, err := strconv.Atoi(d.Id())
+		if err != nil {
+			return nil, nil, fmt.Errorf("Error parsing policy configuration ID %s: %+v", d.Id(), err)
+		}
+		policyConfig.Id = &policyID
+	}
+
+	return policyConfig, projectID, nil
+}
+
+// doBasePolicyFlattening flattens the attributes shared by every policy type
+func doBasePolicyFlattening(d *schema.ResourceData, policyConfig *policy.PolicyConfiguration, projectID *string) error {
+	settings := struct {
+		Scope []policyScope `json:"scope"`
+	}{}
+	if err := decodePolicySettings(policyConfig, &settings); err != nil {
+		return err
+	}
+	if len(settings.Scope) != 1 {
+		return fmt.Errorf("Expected the policy to be scoped to exactly one branch but found %d scopes", len(settings.Scope))
+	}
+
+	d.SetId(strconv.Itoa(*policyConfig.Id))
+	d.Set("project_id", projectID)
+	d.Set("enabled", converter.ToBool(policyConfig.IsEnabled, false))
+	d.Set("blocking", converter.ToBool(policyConfig.IsBlocking, false))
+
+	scope := settings.Scope[0]
+	d.Set("repository_id", scope.RepositoryID)
+	d.Set("match_type", scope.MatchKind)
+	// keep the branch as configured if it only differs by the implicit "refs/heads/" prefix
+	if withRefsHeadsPrefix(d.Get("branch").(string)) != scope.RefName {
+		d.Set("branch", scope.RefName)
+	}
+	return nil
+}
+
+// Decodes the loosely typed settings of a policy configuration into a struct describing the settings
+func decodePolicySettings(policyConfig *policy.PolicyConfiguration, settings interface{}) error {
+	raw, err := json.Marshal(policyConfig.Settings)
+	if err != nil {
+		return fmt.Errorf("Error reading the settings of the policy configuration: %+v", err)
+	}
+	if err := json.Unmarshal(raw, settings); err != nil {
+		return fmt.Errorf("Error reading the settings of the policy configuration: %+v", err)
+	}
+	return nil
+}
+
+// Returns the settings of a policy configuration created by doBasePolicyExpansion so that they can be extended
+func policySettings(policyConfig *policy.PolicyConfiguration) map[string]interface{} {
+	return policyConfig.Settings.(map[string]interface{})
+}
+
+func genPolicyCreateFunc(flatFunc policyFlatFunc, expandFunc policyExpandFunc) func(d *schema.ResourceData, m interface{}) error {
+	return func(d *schema.ResourceData, m interface{}) error {
+		clients := m.(*aggregatedClient)
+		policyConfig, projectID, err := expandFunc(d)
+		if err != nil {
+			return fmt.Errorf("Error converting terraform data model to AzDO policy configuration: %+v", err)
+		}
+
+		createdPolicy, err := clients.PolicyClient.CreatePolicyConfiguration(clients.ctx, policy.CreatePolicyConfigurationArgs{
+			Configuration: policyConfig,
+			Project:       projectID,
+		})
+		if err != nil {
+			return fmt.Errorf("Error creating policy in Azure DevOps: %+v", err)
+		}
+
+		return flatFunc(d, createdPolicy, projectID)
+	}
+}
+
+func genPolicyReadFunc(flatFunc policyFlatFunc) func(d *schema.ResourceData, m interface{}) error {
+	return func(d *schema.ResourceData, m interface{}) error {
+		clients := m.(*aggregatedClient)
+		projectID := converter.String(d.Get("project_id").(string))
+		policyID, err := strconv.Atoi(d.Id())
+		if err != nil {
+			return fmt.Errorf("Error parsing policy configuration ID %s: %+v", d.Id(), err)
+		}
+
+		policyConfig, err := clients.PolicyClient.GetPolicyConfiguration(clients.ctx, policy.GetPolicyConfigurationArgs{
+			ConfigurationId: &policyID,
+			Project:         projectID,
+		})
+		if err != nil {
+			return fmt.Errorf("Error looking up policy configuration with ID (%v) and project ID (%v): %v", policyID, *projectID, err)
+		}
+
+		// the policy was deleted outside of Terraform and needs to be created again
+		if policyConfig.IsDeleted != nil && *policyConfig.IsDeleted {
+			d.SetId("")
+			return nil
+		}
+
+		return flatFunc(d, policyConfig, projectID)
+	}
+}
+
+func genPolicyUpdateFunc(flatFunc policyFlatFunc, expandFunc policyExpandFunc) func(d *schema.ResourceData, m interface{}) error {
+	return func(d *schema.ResourceData, m interface{}) error {
+		clients := m.(*aggregatedClient)
+		policyConfig, projectID, err := expandFunc(d)
+		if err != nil {
+			return fmt.Errorf("Error converting terraform data model to AzDO policy configuration: %+v", err)
+		}
+
+		updatedPolicy, err := clients.PolicyClient.UpdatePolicyConfiguration(clients.ctx, policy.UpdatePolicyConfigurationArgs{
+			ConfigurationId: policyConfig.Id,
+			Configuration:   policyConfig,
+			Project:         projectID,
+		})
+		if err != nil {
+			return fmt.Errorf("Error updating policy in Azure DevOps: %+v", err)
+		}
+
+		return flatFunc(d, updatedPolicy, projectID)
+	}
+}
+
+func genPolicyDeleteFunc() func(d *schema.ResourceData, m interface{}) error {
+	return func(d *schema.ResourceData, m interface{}) error {
+		clients := m.(*aggregatedClient)
+		projectID := converter.String(d.Get("project_id").(string))
+		policyID, err := strconv.Atoi(d.Id())
+		if err != nil {
+			return fmt.Errorf("Error parsing policy configuration ID %s: %+v", d.Id(), err)
+		}
+
+		err = clients.PolicyClient.DeletePolicyConfiguration(clients.ctx, policy.DeletePolicyConfigurationArgs{
+			ConfigurationId: &policyID,
+			Project:         projectID,
+		})
+		if err != nil {
+			return fmt.Errorf("Error deleting policy in Azure DevOps: %+v", err)
+		}
+		return nil
+	}
+}
+
+// genPolicyImportFunc creates an importer for policies of a specific type. Policies are imported by the
+// project ID and the policy configuration ID, and importing a policy of another type is rejected.
+func genPolicyImportFunc(policyTypeID uuid.UUID) func(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	return func(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+		parts := strings.SplitN(d.Id(), "/", 2)
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return nil, fmt.Errorf("Unexpected format of ID (%s), expected projectid/policyid", d.Id())
+		}
+
+		policyID, err := strconv.Atoi(parts[1])
+		if err != nil {
+			return nil, fmt.Errorf("Policy configuration ID (%s) is not a valid integer", parts[1])
+		}
+
+		clients := m.(*aggregatedClient)
+		policyConfig, err := clients.PolicyClient.GetPolicyConfiguration(clients.ctx, policy.GetPolicyConfigurationArgs{
+			ConfigurationId: &policyID,
+			Project:         &parts[0],
+		})
+		if err != nil {
+			return nil, fmt.Errorf("Error looking up policy configuration with ID (%v) and project ID (%v): %v", policyID, parts[0], err)
+		}
+		if policyConfig.Type == nil || policyConfig.Type.Id == nil || *policyConfig.Type.Id != policyTypeID {
+			return nil, fmt.Errorf("Policy configuration with ID (%v) is not a policy of type %s", policyID, policyTypeID)
+		}
+
+		d.Set("project_id", parts[0])
+		d.SetId(parts[1])
+		return []*schema.ResourceData{d}, nil
+	}
+}
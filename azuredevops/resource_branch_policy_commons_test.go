@@ -0,0 +1,149 @@
+package azuredevops
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/policy"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/stretchr/testify/require"
+)
+
+// verifies that a policy scoped to more than one branch is reported as an error instead of being partially flattened
+func TestAzureDevOpsBranchPolicy_Flatten_RequiresExactlyOneScope(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, basePolicySchema(), nil)
+	policyConfig := minReviewerTestPolicy
+	policyConfig.Settings = map[string]interface{}{
+		"scope": []policyScope{
+			{RepositoryID: minReviewerTestRepositoryID, RefName: "refs/heads/master", MatchKind: policyMatchTypeExact},
+			{RepositoryID: minReviewerTestRepositoryID, RefName: "refs/heads/develop", MatchKind: policyMatchTypeExact},
+		},
+	}
+
+	err := doBasePolicyFlattening(resourceData, &policyConfig, &minReviewerTestProjectID)
+	require.NotNil(t, err)
+}
+
+// verifies that a policy can be imported by the project ID and policy ID
+func TestAzureDevOpsBranchPolicy_Import_ParsesID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	policyClient := azdosdkmocks.NewMockPolicyClient(ctrl)
+	clients := &aggregatedClient{PolicyClient: policyClient, ctx: context.Background()}
+
+	expectedArgs := policy.GetPolicyConfigurationArgs{ConfigurationId: &minReviewerTestPolicyID, Project: &minReviewerTestProjectID}
+	policyClient.
+		EXPECT().
+		GetPolicyConfiguration(clients.ctx, expectedArgs).
+		Return(&minReviewerTestPolicy, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, basePolicySchema(), nil)
+	resourceData.SetId(fmt.Sprintf("%s/%d", minReviewerTestProjectID, minReviewerTestPolicyID))
+
+	result, err := genPolicyImportFunc(minReviewerPolicyTypeID)(resourceData, clients)
+	require.Nil(t, err)
+	require.Len(t, result, 1)
+	require.Equal(t, strconv.Itoa(minReviewerTestPolicyID), result[0].Id())
+	require.Equal(t, minReviewerTestProjectID, result[0].Get("project_id"))
+
+	for _, id := range []string{"42", "project/", "project/policy"} {
+		resourceData.SetId(id)
+		_, err = genPolicyImportFunc(minReviewerPolicyTypeID)(resourceData, clients)
+		require.NotNil(t, err, id)
+	}
+}
+
+// verifies that importing a policy of another type is rejected
+func TestAzureDevOpsBranchPolicy_Import_RejectsOtherPolicyType(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	policyClient := azdosdkmocks.NewMockPolicyClient(ctrl)
+	clients := &aggregatedClient{PolicyClient: policyClient, ctx: context.Background()}
+
+	policyClient.
+		EXPECT().
+		GetPolicyConfiguration(clients.ctx, gomock.Any()).
+		Return(&minReviewerTestPolicy, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, basePolicySchema(), nil)
+	resourceData.SetId(fmt.Sprintf("%s/%d", minReviewerTestProjectID, minReviewerTestPolicyID))
+
+	_, err := genPolicyImportFunc(commentResolutionPolicyTypeID)(resourceData, clients)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "is not a policy of type")
+}
+
+// Given the address of a branch policy in the TF state, this will return a function that will check
+// whether or not the resource (1) exists in the state and (2) exist in AzDO
+func testAccCheckBranchPolicyResourceExistsByNode(tfNode string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		policyDef, ok := s.RootModule().Resources[tfNode]
+		if !ok {
+			return fmt.Errorf("Did not find a branch policy in the TF state")
+		}
+
+		policyConfig, err := getBranchPolicyFromResource(policyDef)
+		if err != nil {
+			return err
+		}
+
+		if policyConfig.IsDeleted != nil && *policyConfig.IsDeleted {
+			return fmt.Errorf("Branch policy with ID %d is deleted", *policyConfig.Id)
+		}
+
+		return nil
+	}
+}
+
+// verifies that all branch policies of the given type referenced in the state are destroyed. This will be
+// invoked *after* terrafform destroys the resource but *before* the state is wiped clean.
+func testAccBranchPolicyCheckDestroyByType(resourceType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, resource := range s.RootModule().Resources {
+			if resource.Type != resourceType {
+				continue
+			}
+
+			// indicates the branch policy still exists - this should fail the test
+			policyConfig, err := getBranchPolicyFromResource(resource)
+			if err == nil && (policyConfig.IsDeleted == nil || !*policyConfig.IsDeleted) {
+				return fmt.Errorf("Unexpectedly found a branch policy that should be deleted")
+			}
+		}
+
+		return nil
+	}
+}
+
+// given a resource from the state, return a policy configuration (and error)
+func getBranchPolicyFromResource(resource *terraform.ResourceState) (*policy.PolicyConfiguration, error) {
+	policyID, err := strconv.Atoi(resource.Primary.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	projectID := resource.Primary.Attributes["project_id"]
+	clients := testAccProvider.Meta().(*aggregatedClient)
+	return clients.PolicyClient.GetPolicyConfiguration(clients.ctx, policy.GetPolicyConfigurationArgs{
+		Project:         &projectID,
+		ConfigurationId: &policyID,
+	})
+}
+
+// returns the ID used to import the branch policy at the given address of the TF state
+func testAccBranchPolicyImportStateID(tfNode string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		res := s.RootModule().Resources[tfNode]
+		return fmt.Sprintf("%s/%s", res.Primary.Attributes["project_id"], res.Primary.ID), nil
+	}
+}
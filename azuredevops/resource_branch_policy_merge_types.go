@@ -0,0 +1,108 @@
+package azuredevops
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/policy"
+)
+
+// The ID of the "Require a merge strategy" policy type
+var mergeTypesPolicyTypeID = uuid.MustParse("fa4e907d-c16b-4a4c-9dfa-4916e5d171ab")
+
+type mergeTypesPolicySettings struct {
+	AllowSquash        bool `json:"allowSquash"`
+	AllowRebase        bool `json:"allowRebase"`
+	AllowRebaseMerge   bool `json:"allowRebaseMerge"`
+	AllowNoFastForward bool `json:"allowNoFastForward"`
+}
+
+// maps the arguments of the resource to the settings of the policy
+var mergeTypesPolicyArguments = []string{
+	"allow_squash",
+	"allow_rebase",
+	"allow_rebase_merge",
+	"allow_basic_no_fast_forward",
+}
+
+func resourceBranchPolicyMergeTypes() *schema.Resource {
+	r := genBasePolicyResource(flattenBranchPolicyMergeTypes, expandBranchPolicyMergeTypes)
+	r.Importer = &schema.ResourceImporter{
+		State: genPolicyImportFunc(mergeTypesPolicyTypeID),
+	}
+	r.CustomizeDiff = customizeDiffBranchPolicyMergeTypes
+
+	r.Schema["allow_squash"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Allow squash merges, which combine the commits of the source branch into a single commit.",
+	}
+	r.Schema["allow_rebase"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Allow rebasing the commits of the source branch onto the target branch and fast-forwarding it.",
+	}
+	r.Schema["allow_rebase_merge"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Allow rebasing the commits of the source branch onto the target branch and creating a merge commit.",
+	}
+	r.Schema["allow_basic_no_fast_forward"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Allow merges that preserve the nonlinear history of the source branch with a merge commit.",
+	}
+
+	return r
+}
+
+// Verifies at plan time that at least one merge type is allowed, as the service rejects policies that
+// allow none of them
+func customizeDiffBranchPolicyMergeTypes(d *schema.ResourceDiff, m interface{}) error {
+	for _, argument := range mergeTypesPolicyArguments {
+		if !d.NewValueKnown(argument) || d.Get(argument).(bool) {
+			return nil
+		}
+	}
+	return fmt.Errorf("at least one of allow_squash, allow_rebase, allow_rebase_merge or allow_basic_no_fast_forward must be true")
+}
+
+// Convert internal Terraform data structure to an AzDO data structure
+func expandBranchPolicyMergeTypes(d *schema.ResourceData) (*policy.PolicyConfiguration, *string, error) {
+	policyConfig, projectID, err := doBasePolicyExpansion(d, mergeTypesPolicyTypeID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	settings := policySettings(policyConfig)
+	settings["allowSquash"] = d.Get("allow_squash").(bool)
+	settings["allowRebase"] = d.Get("allow_rebase").(bool)
+	settings["allowRebaseMerge"] = d.Get("allow_rebase_merge").(bool)
+	settings["allowNoFastForward"] = d.Get("allow_basic_no_fast_forward").(bool)
+
+	return policyConfig, projectID, nil
+}
+
+// Convert AzDO data structure to internal Terraform data structure. Merge types that are missing from the
+// settings are not allowed.
+func flattenBranchPolicyMergeTypes(d *schema.ResourceData, policyConfig *policy.PolicyConfiguration, projectID *string) error {
+	if err := doBasePolicyFlattening(d, policyConfig, projectID); err != nil {
+		return err
+	}
+
+	var settings mergeTypesPolicySettings
+	if err := decodePolicySettings(policyConfig, &settings); err != nil {
+		return err
+	}
+
+	d.Set("allow_squash", settings.AllowSquash)
+	d.Set("allow_rebase", settings.AllowRebase)
+	d.Set("allow_rebase_merge", settings.AllowRebaseMerge)
+	d.Set("allow_basic_no_fast_forward", settings.AllowNoFastForward)
+	return nil
+}
@@ -0,0 +1,215 @@
+package azuredevops
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/policy"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var mergeTypesTestPolicyID = 42
+var mergeTypesTestProjectID = uuid.New().String()
+var mergeTypesTestRepositoryID = uuid.New().String()
+
+var mergeTypesTestPolicy = policy.PolicyConfiguration{
+	Id:         &mergeTypesTestPolicyID,
+	Type:       &policy.PolicyTypeRef{Id: &mergeTypesPolicyTypeID},
+	IsEnabled:  converter.Bool(true),
+	IsBlocking: converter.Bool(false),
+	Settings: map[string]interface{}{
+		"scope": []policyScope{{
+			RepositoryID: mergeTypesTestRepositoryID,
+			RefName:      "refs/heads/release",
+			MatchKind:    policyMatchTypePrefix,
+		}},
+		"allowSquash":        true,
+		"allowRebase":        false,
+		"allowRebaseMerge":   true,
+		"allowNoFastForward": false,
+	},
+}
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that the flatten/expand round trip yields the same policy configuration
+func TestAzureDevOpsBranchPolicyMergeTypes_ExpandFlatten_Roundtrip(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, resourceBranchPolicyMergeTypes().Schema, nil)
+	err := flattenBranchPolicyMergeTypes(resourceData, &mergeTypesTestPolicy, &mergeTypesTestProjectID)
+	require.Nil(t, err)
+
+	policyAfterRoundTrip, projectID, err := expandBranchPolicyMergeTypes(resourceData)
+	require.Nil(t, err)
+	require.Equal(t, mergeTypesTestPolicy, *policyAfterRoundTrip)
+	require.Equal(t, mergeTypesTestProjectID, *projectID)
+}
+
+// verifies that merge types changed outside of Terraform are detected. Merge types missing from the
+// settings are not allowed. The settings are decoded from JSON by the SDK, which is why the test data
+// is decoded from JSON as well.
+func TestAzureDevOpsBranchPolicyMergeTypes_Flatten_DetectsDrift(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, resourceBranchPolicyMergeTypes().Schema, nil)
+	err := flattenBranchPolicyMergeTypes(resourceData, &mergeTypesTestPolicy, &mergeTypesTestProjectID)
+	require.Nil(t, err)
+
+	var settings interface{}
+	err = json.Unmarshal([]byte(fmt.Sprintf(`{
+		"scope": [{"repositoryId": "%s", "refName": "refs/heads/release", "matchKind": "Prefix"}],
+		"allowRebase": true,
+		"allowNoFastForward": true
+	}`, mergeTypesTestRepositoryID)), &settings)
+	require.Nil(t, err)
+
+	changedPolicy := mergeTypesTestPolicy
+	changedPolicy.Settings = settings
+	err = flattenBranchPolicyMergeTypes(resourceData, &changedPolicy, &mergeTypesTestProjectID)
+	require.Nil(t, err)
+
+	require.Equal(t, false, resourceData.Get("allow_squash"))
+	require.Equal(t, true, resourceData.Get("allow_rebase"))
+	require.Equal(t, false, resourceData.Get("allow_rebase_merge"))
+	require.Equal(t, true, resourceData.Get("allow_basic_no_fast_forward"))
+}
+
+// verifies that at least one merge type has to be allowed
+func TestAzureDevOpsBranchPolicyMergeTypes_RequiresOneMergeType(t *testing.T) {
+	diffWithMergeTypes := func(mergeTypes map[string]interface{}) error {
+		config := map[string]interface{}{
+			"project_id":    "project",
+			"repository_id": "repository",
+			"branch":        "master",
+		}
+		for key, value := range mergeTypes {
+			config[key] = value
+		}
+		_, err := resourceBranchPolicyMergeTypes().Diff(nil, terraform.NewResourceConfigRaw(config), nil)
+		return err
+	}
+
+	require.Nil(t, diffWithMergeTypes(map[string]interface{}{"allow_squash": true}))
+	require.Nil(t, diffWithMergeTypes(map[string]interface{}{"allow_squash": false, "allow_basic_no_fast_forward": true}))
+	require.NotNil(t, diffWithMergeTypes(map[string]interface{}{}))
+	require.NotNil(t, diffWithMergeTypes(map[string]interface{}{"allow_squash": false, "allow_rebase": false}))
+}
+
+// verifies that if an error is produced on create, the error is not swallowed
+func TestAzureDevOpsBranchPolicyMergeTypes_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := resourceBranchPolicyMergeTypes()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	flattenBranchPolicyMergeTypes(resourceData, &mergeTypesTestPolicy, &mergeTypesTestProjectID)
+	resourceData.SetId("")
+
+	policyClient := azdosdkmocks.NewMockPolicyClient(ctrl)
+	clients := &aggregatedClient{PolicyClient: policyClient, ctx: context.Background()}
+
+	expectedPolicy := mergeTypesTestPolicy
+	expectedPolicy.Id = nil
+	expectedArgs := policy.CreatePolicyConfigurationArgs{Configuration: &expectedPolicy, Project: &mergeTypesTestProjectID}
+	policyClient.
+		EXPECT().
+		CreatePolicyConfiguration(clients.ctx, expectedArgs).
+		Return(nil, errors.New("CreatePolicyConfiguration() Failed")).
+		Times(1)
+
+	err := r.Create(resourceData, clients)
+	require.Contains(t, err.Error(), "CreatePolicyConfiguration() Failed")
+}
+
+// verifies that if an error is produced on an update, it is not swallowed
+func TestAzureDevOpsBranchPolicyMergeTypes_Update_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := resourceBranchPolicyMergeTypes()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	flattenBranchPolicyMergeTypes(resourceData, &mergeTypesTestPolicy, &mergeTypesTestProjectID)
+
+	policyClient := azdosdkmocks.NewMockPolicyClient(ctrl)
+	clients := &aggregatedClient{PolicyClient: policyClient, ctx: context.Background()}
+
+	expectedArgs := policy.UpdatePolicyConfigurationArgs{
+		ConfigurationId: &mergeTypesTestPolicyID,
+		Configuration:   &mergeTypesTestPolicy,
+		Project:         &mergeTypesTestProjectID,
+	}
+	policyClient.
+		EXPECT().
+		UpdatePolicyConfiguration(clients.ctx, expectedArgs).
+		Return(nil, errors.New("UpdatePolicyConfiguration() Failed")).
+		Times(1)
+
+	err := r.Update(resourceData, clients)
+	require.Contains(t, err.Error(), "UpdatePolicyConfiguration() Failed")
+}
+
+/**
+ * Begin acceptance tests
+ */
+
+// validates that an apply followed by another apply (i.e., resource update) will be reflected in AzDO and the
+// underlying terraform state, and that the policy can be imported
+func TestAccAzureDevOpsBranchPolicyMergeTypes_CreateUpdateAndImport(t *testing.T) {
+	projectName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	gitRepoName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	tfNode := "azuredevops_branch_policy_merge_types.policy"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccBranchPolicyCheckDestroyByType("azuredevops_branch_policy_merge_types"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBranchPolicyMergeTypesResource(projectName, gitRepoName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfNode, "project_id"),
+					resource.TestCheckResourceAttr(tfNode, "allow_squash", "true"),
+					resource.TestCheckResourceAttr(tfNode, "allow_rebase", "false"),
+					testAccCheckBranchPolicyResourceExistsByNode(tfNode),
+				),
+			}, {
+				Config: testAccBranchPolicyMergeTypesResource(projectName, gitRepoName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "allow_squash", "true"),
+					resource.TestCheckResourceAttr(tfNode, "allow_rebase", "true"),
+					testAccCheckBranchPolicyResourceExistsByNode(tfNode),
+				),
+			}, {
+				ResourceName:      tfNode,
+				ImportState:       true,
+				ImportStateIdFunc: testAccBranchPolicyImportStateID(tfNode),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// HCL describing a merge types policy
+func testAccBranchPolicyMergeTypesResource(projectName string, gitRepoName string, allowRebase bool) string {
+	policyResource := fmt.Sprintf(`
+resource "azuredevops_branch_policy_merge_types" "policy" {
+	project_id    = azuredevops_project.project.id
+	repository_id = azuredevops_azure_git_repository.gitrepo.id
+	branch        = "master"
+	allow_squash  = true
+	allow_rebase  = %t
+}`, allowRebase)
+
+	gitRepoResource := testAccAzureGitRepoResource(projectName, gitRepoName)
+	return fmt.Sprintf("%s\n%s", gitRepoResource, policyResource)
+}
@@ -0,0 +1,83 @@
+package azuredevops
+
+import (
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/policy"
+)
+
+// The ID of the "Minimum number of reviewers" policy type
+var minReviewerPolicyTypeID = uuid.MustParse("fa4e907d-c16b-4a4c-9dfa-4906e5d171dd")
+
+type minReviewerPolicySettings struct {
+	MinimumApproverCount int  `json:"minimumApproverCount"`
+	CreatorVoteCounts    bool `json:"creatorVoteCounts"`
+	BlockLastPusherVote  bool `json:"blockLastPusherVote"`
+	AllowDownvotes       bool `json:"allowDownvotes"`
+}
+
+func resourceBranchPolicyMinReviewers() *schema.Resource {
+	r := genBasePolicyResource(flattenBranchPolicyMinReviewers, expandBranchPolicyMinReviewers)
+
+	r.Schema["reviewer_count"] = &schema.Schema{
+		Type:         schema.TypeInt,
+		Required:     true,
+		Description:  "The minimum number of reviewers that have to approve a pull request.",
+		ValidateFunc: validation.IntBetween(1, 10),
+	}
+	r.Schema["submitter_can_vote"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Allow the requestor of a pull request to approve their own changes.",
+	}
+	r.Schema["last_pusher_cannot_approve"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Prohibit the most recent pusher from approving their own changes.",
+	}
+	r.Schema["allow_completion_with_rejects_or_waits"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Allow completion even if some reviewers vote to wait or reject.",
+	}
+
+	return r
+}
+
+// Convert internal Terraform data structure to an AzDO data structure
+func expandBranchPolicyMinReviewers(d *schema.ResourceData) (*policy.PolicyConfiguration, *string, error) {
+	policyConfig, projectID, err := doBasePolicyExpansion(d, minReviewerPolicyTypeID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	settings := policySettings(policyConfig)
+	settings["minimumApproverCount"] = d.Get("reviewer_count").(int)
+	settings["creatorVoteCounts"] = d.Get("submitter_can_vote").(bool)
+	settings["blockLastPusherVote"] = d.Get("last_pusher_cannot_approve").(bool)
+	settings["allowDownvotes"] = d.Get("allow_completion_with_rejects_or_waits").(bool)
+
+	return policyConfig, projectID, nil
+}
+
+// Convert AzDO data structure to internal Terraform data structure
+func flattenBranchPolicyMinReviewers(d *schema.ResourceData, policyConfig *policy.PolicyConfiguration, projectID *string) error {
+	if err := doBasePolicyFlattening(d, policyConfig, projectID); err != nil {
+		return err
+	}
+
+	var settings minReviewerPolicySettings
+	if err := decodePolicySettings(policyConfig, &settings); err != nil {
+		return err
+	}
+
+	d.Set("reviewer_count", settings.MinimumApproverCount)
+	d.Set("submitter_can_vote", settings.CreatorVoteCounts)
+	d.Set("last_pusher_cannot_approve", settings.BlockLastPusherVote)
+	d.Set("allow_completion_with_rejects_or_waits", settings.AllowDownvotes)
+	return nil
+}
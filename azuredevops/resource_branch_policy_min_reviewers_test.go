@@ -0,0 +1,272 @@
+package azuredevops
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/policy"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var minReviewerTestPolicyID = 42
+var minReviewerTestProjectID = uuid.New().String()
+var minReviewerTestRepositoryID = uuid.New().String()
+
+var minReviewerTestPolicy = policy.PolicyConfiguration{
+	Id:         &minReviewerTestPolicyID,
+	Type:       &policy.PolicyTypeRef{Id: &minReviewerPolicyTypeID},
+	IsEnabled:  converter.Bool(true),
+	IsBlocking: converter.Bool(false),
+	Settings: map[string]interface{}{
+		"scope": []policyScope{{
+			RepositoryID: minReviewerTestRepositoryID,
+			RefName:      "refs/heads/release",
+			MatchKind:    policyMatchTypePrefix,
+		}},
+		"minimumApproverCount": 2,
+		"creatorVoteCounts":    true,
+		"blockLastPusherVote":  true,
+		"allowDownvotes":       false,
+	},
+}
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that the flatten/expand round trip yields the same policy configuration
+func TestAzureDevOpsBranchPolicyMinReviewers_ExpandFlatten_Roundtrip(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, resourceBranchPolicyMinReviewers().Schema, nil)
+	err := flattenBranchPolicyMinReviewers(resourceData, &minReviewerTestPolicy, &minReviewerTestProjectID)
+	require.Nil(t, err)
+
+	policyAfterRoundTrip, projectID, err := expandBranchPolicyMinReviewers(resourceData)
+	require.Nil(t, err)
+	require.Equal(t, minReviewerTestPolicy, *policyAfterRoundTrip)
+	require.Equal(t, minReviewerTestProjectID, *projectID)
+}
+
+// verifies that settings changed outside of Terraform are detected. The settings are decoded from
+// JSON by the SDK, which is why the test data is decoded from JSON as well.
+func TestAzureDevOpsBranchPolicyMinReviewers_Flatten_DetectsDrift(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, resourceBranchPolicyMinReviewers().Schema, nil)
+	err := flattenBranchPolicyMinReviewers(resourceData, &minReviewerTestPolicy, &minReviewerTestProjectID)
+	require.Nil(t, err)
+
+	var settings interface{}
+	err = json.Unmarshal([]byte(fmt.Sprintf(`{
+		"scope": [{"repositoryId": "%s", "refName": "refs/heads/master", "matchKind": "Exact"}],
+		"minimumApproverCount": 4,
+		"creatorVoteCounts": false,
+		"blockLastPusherVote": false,
+		"allowDownvotes": true
+	}`, minReviewerTestRepositoryID)), &settings)
+	require.Nil(t, err)
+
+	changedPolicy := minReviewerTestPolicy
+	changedPolicy.Settings = settings
+	err = flattenBranchPolicyMinReviewers(resourceData, &changedPolicy, &minReviewerTestProjectID)
+	require.Nil(t, err)
+
+	require.Equal(t, "refs/heads/master", resourceData.Get("branch"))
+	require.Equal(t, policyMatchTypeExact, resourceData.Get("match_type"))
+	require.Equal(t, 4, resourceData.Get("reviewer_count"))
+	require.Equal(t, false, resourceData.Get("submitter_can_vote"))
+	require.Equal(t, false, resourceData.Get("last_pusher_cannot_approve"))
+	require.Equal(t, true, resourceData.Get("allow_completion_with_rejects_or_waits"))
+}
+
+// verifies that the branch is kept as configured if it only lacks the "refs/heads/" prefix
+func TestAzureDevOpsBranchPolicyMinReviewers_Flatten_KeepsBranchWithoutPrefix(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, resourceBranchPolicyMinReviewers().Schema, nil)
+	resourceData.Set("branch", "release")
+
+	err := flattenBranchPolicyMinReviewers(resourceData, &minReviewerTestPolicy, &minReviewerTestProjectID)
+	require.Nil(t, err)
+	require.Equal(t, "release", resourceData.Get("branch"))
+}
+
+// verifies that a policy deleted outside of Terraform is removed from the state
+func TestAzureDevOpsBranchPolicyMinReviewers_Read_ClearsIdIfPolicyWasDeleted(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := resourceBranchPolicyMinReviewers()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	flattenBranchPolicyMinReviewers(resourceData, &minReviewerTestPolicy, &minReviewerTestProjectID)
+
+	policyClient := azdosdkmocks.NewMockPolicyClient(ctrl)
+	clients := &aggregatedClient{PolicyClient: policyClient, ctx: context.Background()}
+
+	deletedPolicy := minReviewerTestPolicy
+	deletedPolicy.IsDeleted = converter.Bool(true)
+	policyClient.
+		EXPECT().
+		GetPolicyConfiguration(clients.ctx, gomock.Any()).
+		Return(&deletedPolicy, nil).
+		Times(1)
+
+	err := r.Read(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "", resourceData.Id())
+}
+
+// verifies that if an error is produced on create, the error is not swallowed
+func TestAzureDevOpsBranchPolicyMinReviewers_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := resourceBranchPolicyMinReviewers()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	flattenBranchPolicyMinReviewers(resourceData, &minReviewerTestPolicy, &minReviewerTestProjectID)
+	resourceData.SetId("")
+
+	policyClient := azdosdkmocks.NewMockPolicyClient(ctrl)
+	clients := &aggregatedClient{PolicyClient: policyClient, ctx: context.Background()}
+
+	expectedPolicy := minReviewerTestPolicy
+	expectedPolicy.Id = nil
+	expectedArgs := policy.CreatePolicyConfigurationArgs{Configuration: &expectedPolicy, Project: &minReviewerTestProjectID}
+	policyClient.
+		EXPECT().
+		CreatePolicyConfiguration(clients.ctx, expectedArgs).
+		Return(nil, errors.New("CreatePolicyConfiguration() Failed")).
+		Times(1)
+
+	err := r.Create(resourceData, clients)
+	require.Contains(t, err.Error(), "CreatePolicyConfiguration() Failed")
+}
+
+// verifies that if an error is produced on a read, it is not swallowed
+func TestAzureDevOpsBranchPolicyMinReviewers_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := resourceBranchPolicyMinReviewers()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	flattenBranchPolicyMinReviewers(resourceData, &minReviewerTestPolicy, &minReviewerTestProjectID)
+
+	policyClient := azdosdkmocks.NewMockPolicyClient(ctrl)
+	clients := &aggregatedClient{PolicyClient: policyClient, ctx: context.Background()}
+
+	expectedArgs := policy.GetPolicyConfigurationArgs{ConfigurationId: &minReviewerTestPolicyID, Project: &minReviewerTestProjectID}
+	policyClient.
+		EXPECT().
+		GetPolicyConfiguration(clients.ctx, expectedArgs).
+		Return(nil, errors.New("GetPolicyConfiguration() Failed")).
+		Times(1)
+
+	err := r.Read(resourceData, clients)
+	require.Contains(t, err.Error(), "GetPolicyConfiguration() Failed")
+}
+
+// verifies that if an error is produced on a delete, it is not swallowed
+func TestAzureDevOpsBranchPolicyMinReviewers_Delete_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := resourceBranchPolicyMinReviewers()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	flattenBranchPolicyMinReviewers(resourceData, &minReviewerTestPolicy, &minReviewerTestProjectID)
+
+	policyClient := azdosdkmocks.NewMockPolicyClient(ctrl)
+	clients := &aggregatedClient{PolicyClient: policyClient, ctx: context.Background()}
+
+	expectedArgs := policy.DeletePolicyConfigurationArgs{ConfigurationId: &minReviewerTestPolicyID, Project: &minReviewerTestProjectID}
+	policyClient.
+		EXPECT().
+		DeletePolicyConfiguration(clients.ctx, expectedArgs).
+		Return(errors.New("DeletePolicyConfiguration() Failed")).
+		Times(1)
+
+	err := r.Delete(resourceData, clients)
+	require.Contains(t, err.Error(), "DeletePolicyConfiguration() Failed")
+}
+
+// verifies that if an error is produced on an update, it is not swallowed
+func TestAzureDevOpsBranchPolicyMinReviewers_Update_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := resourceBranchPolicyMinReviewers()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	flattenBranchPolicyMinReviewers(resourceData, &minReviewerTestPolicy, &minReviewerTestProjectID)
+
+	policyClient := azdosdkmocks.NewMockPolicyClient(ctrl)
+	clients := &aggregatedClient{PolicyClient: policyClient, ctx: context.Background()}
+
+	expectedArgs := policy.UpdatePolicyConfigurationArgs{
+		ConfigurationId: &minReviewerTestPolicyID,
+		Configuration:   &minReviewerTestPolicy,
+		Project:         &minReviewerTestProjectID,
+	}
+	policyClient.
+		EXPECT().
+		UpdatePolicyConfiguration(clients.ctx, expectedArgs).
+		Return(nil, errors.New("UpdatePolicyConfiguration() Failed")).
+		Times(1)
+
+	err := r.Update(resourceData, clients)
+	require.Contains(t, err.Error(), "UpdatePolicyConfiguration() Failed")
+}
+
+/**
+ * Begin acceptance tests
+ */
+
+// validates that an apply followed by another apply (i.e., resource update) will be reflected in AzDO and the
+// underlying terraform state.
+func TestAccAzureDevOpsBranchPolicyMinReviewers_CreateAndUpdate(t *testing.T) {
+	projectName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	gitRepoName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	tfNode := "azuredevops_branch_policy_min_reviewers.policy"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccBranchPolicyCheckDestroyByType("azuredevops_branch_policy_min_reviewers"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBranchPolicyMinReviewersResource(projectName, gitRepoName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfNode, "project_id"),
+					resource.TestCheckResourceAttrSet(tfNode, "repository_id"),
+					resource.TestCheckResourceAttr(tfNode, "branch", "master"),
+					resource.TestCheckResourceAttr(tfNode, "reviewer_count", "1"),
+					testAccCheckBranchPolicyResourceExistsByNode(tfNode),
+				),
+			}, {
+				Config: testAccBranchPolicyMinReviewersResource(projectName, gitRepoName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "reviewer_count", "2"),
+					testAccCheckBranchPolicyResourceExistsByNode(tfNode),
+				),
+			},
+		},
+	})
+}
+
+// HCL describing a minimum number of reviewers policy
+func testAccBranchPolicyMinReviewersResource(projectName string, gitRepoName string, reviewerCount int) string {
+	policyResource := fmt.Sprintf(`
+resource "azuredevops_branch_policy_min_reviewers" "policy" {
+	project_id         = azuredevops_project.project.id
+	repository_id      = azuredevops_azure_git_repository.gitrepo.id
+	branch             = "master"
+	reviewer_count     = %d
+	submitter_can_vote = true
+}`, reviewerCount)
+
+	gitRepoResource := testAccAzureGitRepoResource(projectName, gitRepoName)
+	return fmt.Sprintf("%s\n%s", gitRepoResource, policyResource)
+}
@@ -0,0 +1,138 @@
+package azuredevops
+
+import (
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/policy"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/tfhelper"
+)
+
+// The ID of the "Status" policy type
+var statusCheckPolicyTypeID = uuid.MustParse("cbdc66da-9728-4af8-aada-9a5a32e4a226")
+
+// Whether the policy applies to every pull request or only to those that a service posted the status for.
+// The service omits the applicability of policies that apply by default.
+const (
+	statusCheckApplicabilityDefault     = "default"
+	statusCheckApplicabilityConditional = "conditional"
+)
+
+const statusCheckPolicyApplicabilityConditional = 1
+
+type statusCheckPolicySettings struct {
+	StatusName               string   `json:"statusName"`
+	StatusGenre              string   `json:"statusGenre"`
+	AuthorID                 string   `json:"authorId"`
+	InvalidateOnSourceUpdate bool     `json:"invalidateOnSourceUpdate"`
+	FilenamePatterns         []string `json:"filenamePatterns"`
+	PolicyApplicability      *int     `json:"policyApplicability"`
+}
+
+func resourceBranchPolicyStatusCheck() *schema.Resource {
+	r := genBasePolicyResource(flattenBranchPolicyStatusCheck, expandBranchPolicyStatusCheck)
+	r.Importer = &schema.ResourceImporter{
+		State: genPolicyImportFunc(statusCheckPolicyTypeID),
+	}
+
+	r.Schema["status_name"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		Description:  "The name of the status that has to succeed.",
+		ValidateFunc: validation.NoZeroValues,
+	}
+	r.Schema["status_genre"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Default:     "",
+		Description: "The genre of the status, which groups statuses posted by the same service.",
+	}
+	r.Schema["author_id"] = &schema.Schema{
+		Type:             schema.TypeString,
+		Optional:         true,
+		Default:          "",
+		Description:      "The ID of the identity that has to post the status. Any identity may post it if unset.",
+		DiffSuppressFunc: tfhelper.DiffFuncSupressCaseSensitivity,
+	}
+	r.Schema["invalidate_on_source_update"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Reset the status when the source branch of a pull request is updated.",
+	}
+	r.Schema["filename_patterns"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "Only require the status if a pull request changes files matching the patterns. Patterns starting with ! exclude files.",
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validation.NoZeroValues,
+		},
+	}
+	r.Schema["applicability"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Default:      statusCheckApplicabilityDefault,
+		Description:  "Whether the policy applies to every pull request or only once the status is posted for a pull request.",
+		ValidateFunc: validation.StringInSlice([]string{statusCheckApplicabilityDefault, statusCheckApplicabilityConditional}, false),
+	}
+
+	return r
+}
+
+// Convert internal Terraform data structure to an AzDO data structure. Optional settings are only sent if they
+// are configured, which is how the service stores them as well.
+func expandBranchPolicyStatusCheck(d *schema.ResourceData) (*policy.PolicyConfiguration, *string, error) {
+	policyConfig, projectID, err := doBasePolicyExpansion(d, statusCheckPolicyTypeID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	settings := policySettings(policyConfig)
+	settings["statusName"] = d.Get("status_name").(string)
+	settings["statusGenre"] = d.Get("status_genre").(string)
+	settings["invalidateOnSourceUpdate"] = d.Get("invalidate_on_source_update").(bool)
+
+	if authorID := d.Get("author_id").(string); authorID != "" {
+		settings["authorId"] = authorID
+	}
+
+	if patterns := d.Get("filename_patterns").([]interface{}); len(patterns) > 0 {
+		filenamePatterns := make([]string, len(patterns))
+		for i, pattern := range patterns {
+			filenamePatterns[i] = pattern.(string)
+		}
+		settings["filenamePatterns"] = filenamePatterns
+	}
+
+	if d.Get("applicability").(string) == statusCheckApplicabilityConditional {
+		settings["policyApplicability"] = statusCheckPolicyApplicabilityConditional
+	}
+
+	return policyConfig, projectID, nil
+}
+
+// Convert AzDO data structure to internal Terraform data structure
+func flattenBranchPolicyStatusCheck(d *schema.ResourceData, policyConfig *policy.PolicyConfiguration, projectID *string) error {
+	if err := doBasePolicyFlattening(d, policyConfig, projectID); err != nil {
+		return err
+	}
+
+	var settings statusCheckPolicySettings
+	if err := decodePolicySettings(policyConfig, &settings); err != nil {
+		return err
+	}
+
+	applicability := statusCheckApplicabilityDefault
+	if settings.PolicyApplicability != nil && *settings.PolicyApplicability == statusCheckPolicyApplicabilityConditional {
+		applicability = statusCheckApplicabilityConditional
+	}
+
+	d.Set("status_name", settings.StatusName)
+	d.Set("status_genre", settings.StatusGenre)
+	d.Set("author_id", settings.AuthorID)
+	d.Set("invalidate_on_source_update", settings.InvalidateOnSourceUpdate)
+	d.Set("filename_patterns", settings.FilenamePatterns)
+	d.Set("applicability", applicability)
+	return nil
+}
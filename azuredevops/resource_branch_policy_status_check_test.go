@@ -0,0 +1,210 @@
+package azuredevops
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/policy"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var statusCheckTestPolicyID = 42
+var statusCheckTestProjectID = uuid.New().String()
+var statusCheckTestRepositoryID = uuid.New().String()
+var statusCheckTestAuthorID = uuid.New().String()
+
+var statusCheckTestPolicy = policy.PolicyConfiguration{
+	Id:         &statusCheckTestPolicyID,
+	Type:       &policy.PolicyTypeRef{Id: &statusCheckPolicyTypeID},
+	IsEnabled:  converter.Bool(true),
+	IsBlocking: converter.Bool(false),
+	Settings: map[string]interface{}{
+		"scope": []policyScope{{
+			RepositoryID: statusCheckTestRepositoryID,
+			RefName:      "refs/heads/release",
+			MatchKind:    policyMatchTypePrefix,
+		}},
+		"statusName":               "build",
+		"statusGenre":              "ci",
+		"authorId":                 statusCheckTestAuthorID,
+		"invalidateOnSourceUpdate": true,
+		"filenamePatterns":         []string{"/src/*", "!/src/*.md"},
+		"policyApplicability":      statusCheckPolicyApplicabilityConditional,
+	},
+}
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that the flatten/expand round trip yields the same policy configuration
+func TestAzureDevOpsBranchPolicyStatusCheck_ExpandFlatten_Roundtrip(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, resourceBranchPolicyStatusCheck().Schema, nil)
+	err := flattenBranchPolicyStatusCheck(resourceData, &statusCheckTestPolicy, &statusCheckTestProjectID)
+	require.Nil(t, err)
+
+	policyAfterRoundTrip, projectID, err := expandBranchPolicyStatusCheck(resourceData)
+	require.Nil(t, err)
+	require.Equal(t, statusCheckTestPolicy, *policyAfterRoundTrip)
+	require.Equal(t, statusCheckTestProjectID, *projectID)
+}
+
+// verifies that optional settings the service omits are flattened to the defaults of the schema, so that
+// they do not show up as changes, and that they are omitted again when expanded
+func TestAzureDevOpsBranchPolicyStatusCheck_ExpandFlatten_OmitsUnsetSettings(t *testing.T) {
+	var settings interface{}
+	err := json.Unmarshal([]byte(fmt.Sprintf(`{
+		"scope": [{"repositoryId": "%s", "refName": "refs/heads/master", "matchKind": "Exact"}],
+		"statusName": "build",
+		"statusGenre": "",
+		"invalidateOnSourceUpdate": false
+	}`, statusCheckTestRepositoryID)), &settings)
+	require.Nil(t, err)
+
+	defaultPolicy := statusCheckTestPolicy
+	defaultPolicy.Settings = settings
+
+	resourceData := schema.TestResourceDataRaw(t, resourceBranchPolicyStatusCheck().Schema, nil)
+	err = flattenBranchPolicyStatusCheck(resourceData, &defaultPolicy, &statusCheckTestProjectID)
+	require.Nil(t, err)
+
+	require.Equal(t, "", resourceData.Get("author_id"))
+	require.Empty(t, resourceData.Get("filename_patterns"))
+	require.Equal(t, statusCheckApplicabilityDefault, resourceData.Get("applicability"))
+
+	policyAfterRoundTrip, _, err := expandBranchPolicyStatusCheck(resourceData)
+	require.Nil(t, err)
+
+	expandedSettings := policySettings(policyAfterRoundTrip)
+	require.NotContains(t, expandedSettings, "authorId")
+	require.NotContains(t, expandedSettings, "filenamePatterns")
+	require.NotContains(t, expandedSettings, "policyApplicability")
+}
+
+// verifies that an author ID that only differs in case is not reported as a change
+func TestAzureDevOpsBranchPolicyStatusCheck_AuthorID_IgnoresCase(t *testing.T) {
+	authorID := resourceBranchPolicyStatusCheck().Schema["author_id"]
+	require.True(t, authorID.DiffSuppressFunc("author_id", strings.ToUpper(statusCheckTestAuthorID), statusCheckTestAuthorID, nil))
+}
+
+// verifies that if an error is produced on create, the error is not swallowed
+func TestAzureDevOpsBranchPolicyStatusCheck_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := resourceBranchPolicyStatusCheck()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	flattenBranchPolicyStatusCheck(resourceData, &statusCheckTestPolicy, &statusCheckTestProjectID)
+	resourceData.SetId("")
+
+	policyClient := azdosdkmocks.NewMockPolicyClient(ctrl)
+	clients := &aggregatedClient{PolicyClient: policyClient, ctx: context.Background()}
+
+	expectedPolicy := statusCheckTestPolicy
+	expectedPolicy.Id = nil
+	expectedArgs := policy.CreatePolicyConfigurationArgs{Configuration: &expectedPolicy, Project: &statusCheckTestProjectID}
+	policyClient.
+		EXPECT().
+		CreatePolicyConfiguration(clients.ctx, expectedArgs).
+		Return(nil, errors.New("CreatePolicyConfiguration() Failed")).
+		Times(1)
+
+	err := r.Create(resourceData, clients)
+	require.Contains(t, err.Error(), "CreatePolicyConfiguration() Failed")
+}
+
+// verifies that updates are sent for the stored policy configuration ID and that errors are not swallowed
+func TestAzureDevOpsBranchPolicyStatusCheck_Update_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := resourceBranchPolicyStatusCheck()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	flattenBranchPolicyStatusCheck(resourceData, &statusCheckTestPolicy, &statusCheckTestProjectID)
+
+	policyClient := azdosdkmocks.NewMockPolicyClient(ctrl)
+	clients := &aggregatedClient{PolicyClient: policyClient, ctx: context.Background()}
+
+	expectedArgs := policy.UpdatePolicyConfigurationArgs{
+		ConfigurationId: &statusCheckTestPolicyID,
+		Configuration:   &statusCheckTestPolicy,
+		Project:         &statusCheckTestProjectID,
+	}
+	policyClient.
+		EXPECT().
+		UpdatePolicyConfiguration(clients.ctx, expectedArgs).
+		Return(nil, errors.New("UpdatePolicyConfiguration() Failed")).
+		Times(1)
+
+	err := r.Update(resourceData, clients)
+	require.Contains(t, err.Error(), "UpdatePolicyConfiguration() Failed")
+}
+
+/**
+ * Begin acceptance tests
+ */
+
+// validates that an apply followed by another apply (i.e., resource update) will be reflected in AzDO and the
+// underlying terraform state, and that the policy can be imported
+func TestAccAzureDevOpsBranchPolicyStatusCheck_CreateUpdateAndImport(t *testing.T) {
+	projectName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	gitRepoName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	tfNode := "azuredevops_branch_policy_status_check.policy"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccBranchPolicyCheckDestroyByType("azuredevops_branch_policy_status_check"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBranchPolicyStatusCheckResource(projectName, gitRepoName, statusCheckApplicabilityDefault),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfNode, "project_id"),
+					resource.TestCheckResourceAttr(tfNode, "status_name", "build"),
+					resource.TestCheckResourceAttr(tfNode, "author_id", ""),
+					resource.TestCheckResourceAttr(tfNode, "applicability", statusCheckApplicabilityDefault),
+					testAccCheckBranchPolicyResourceExistsByNode(tfNode),
+				),
+			}, {
+				Config: testAccBranchPolicyStatusCheckResource(projectName, gitRepoName, statusCheckApplicabilityConditional),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "applicability", statusCheckApplicabilityConditional),
+					testAccCheckBranchPolicyResourceExistsByNode(tfNode),
+				),
+			}, {
+				ResourceName:      tfNode,
+				ImportState:       true,
+				ImportStateIdFunc: testAccBranchPolicyImportStateID(tfNode),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// HCL describing a status check policy
+func testAccBranchPolicyStatusCheckResource(projectName string, gitRepoName string, applicability string) string {
+	policyResource := fmt.Sprintf(`
+resource "azuredevops_branch_policy_status_check" "policy" {
+	project_id        = azuredevops_project.project.id
+	repository_id     = azuredevops_azure_git_repository.gitrepo.id
+	branch            = "master"
+	status_name       = "build"
+	status_genre      = "ci"
+	filename_patterns = ["/src/*"]
+	applicability     = "%s"
+}`, applicability)
+
+	gitRepoResource := testAccAzureGitRepoResource(projectName, gitRepoName)
+	return fmt.Sprintf("%s\n%s", gitRepoResource, policyResource)
+}
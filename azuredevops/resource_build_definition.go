@@ -1,11 +1,16 @@
 package azuredevops
 
 import (
+	"encoding/json"
 	"fmt"
+	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/secretmemo"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/tfhelper"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
@@ -19,6 +24,8 @@ func resourceBuildDefinition() *schema.Resource {
 		Update: resourceBuildDefinitionUpdate,
 		Delete: resourceBuildDefinitionDelete,
 
+		CustomizeDiff: customizeDiffBuildDefinition,
+
 		Schema: map[string]*schema.Schema{
 			"project_id": {
 				Type:     schema.TypeString,
@@ -39,6 +46,24 @@ func resourceBuildDefinition() *schema.Resource {
 				Optional: true,
 				Default:  "Hosted Ubuntu 1604",
 			},
+			"job_authorization_scope": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  string(build.BuildAuthorizationScopeValues.ProjectCollection),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(build.BuildAuthorizationScopeValues.Project),
+					string(build.BuildAuthorizationScopeValues.ProjectCollection),
+				}, false),
+			},
+			"demand": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateFunc:     validateBuildDefinitionDemand,
+					DiffSuppressFunc: suppressEquivalentBuildDefinitionDemands,
+				},
+			},
 			"repository": {
 				Type:     schema.TypeSet,
 				Required: true,
@@ -57,7 +82,7 @@ func resourceBuildDefinition() *schema.Resource {
 						"repo_type": {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: validation.StringInSlice([]string{"GitHub", "TfsGit"}, false),
+							ValidateFunc: validation.StringInSlice([]string{repoTypeGitHub, repoTypeTfsGit}, false),
 						},
 						"branch_name": {
 							Type:     schema.TypeString,
@@ -72,10 +97,387 @@ func resourceBuildDefinition() *schema.Resource {
 					},
 				},
 			},
+			"ci_trigger": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"use_yaml": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"override": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"batch": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  true,
+									},
+									"branch_filter": buildDefinitionFilterSchema(),
+									"path_filter":   buildDefinitionPathFilterSchema(),
+									"polling_interval": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      0,
+										ValidateFunc: validation.IntAtLeast(0),
+									},
+									"max_concurrent_builds_per_branch": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      1,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"pull_request_trigger": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"use_yaml": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"initial_branch": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "Managed by Terraform",
+						},
+						"forks": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"enabled": {
+										Type:     schema.TypeBool,
+										Required: true,
+									},
+									"share_secrets": {
+										Type:     schema.TypeBool,
+										Required: true,
+									},
+								},
+							},
+						},
+						"comment_required_setting": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{commentRequiredAll, commentRequiredNonTeamMembers}, false),
+						},
+						"override": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"auto_cancel": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  true,
+									},
+									"branch_filter": buildDefinitionFilterSchema(),
+									"path_filter":   buildDefinitionPathFilterSchema(),
+								},
+							},
+						},
+					},
+				},
+			},
+			"variable": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "",
+						},
+						"secret_value": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Sensitive:        true,
+							Default:          "",
+							DiffSuppressFunc: tfhelper.DiffFuncSupressSecretChanged,
+						},
+						variableSecretHashKey: variableSecretHashSchema,
+						"is_secret": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"allow_override": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+					},
+				},
+			},
+			"schedules": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"branch_filter": buildDefinitionFilterSchema(),
+						"days_to_build": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice(scheduleDayNames, false),
+							},
+							Set: schema.HashString,
+						},
+						"schedule_only_with_changes": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"start_hours": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      0,
+							ValidateFunc: validation.IntBetween(0, 23),
+						},
+						"start_minutes": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      0,
+							ValidateFunc: validation.IntBetween(0, 59),
+						},
+						"time_zone": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "UTC",
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"schedule_job_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"retention": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"days_to_keep": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"minimum_to_keep": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"delete_build_record": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"delete_test_results": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
+// Branch and path filters are stored by the service as a single list in which included entries are
+// prefixed with "+" and excluded entries are prefixed with "-"
+func buildDefinitionFilterSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"include": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.NoZeroValues,
+					},
+				},
+				"exclude": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.NoZeroValues,
+					},
+				},
+			},
+		},
+	}
+}
+
+// Path filters are paths in the repository, e.g. /src/app. Both forward slashes and backslashes are accepted
+// as separators, and whether a path is included or excluded follows from the block it is listed in.
+func buildDefinitionPathFilterSchema() *schema.Schema {
+	filterSchema := buildDefinitionFilterSchema()
+	for _, key := range []string{"include", "exclude"} {
+		filterSchema.Elem.(*schema.Resource).Schema[key].Elem = &schema.Schema{
+			Type:             schema.TypeString,
+			ValidateFunc:     validateBuildDefinitionPathFilter,
+			DiffSuppressFunc: suppressEquivalentBuildDefinitionPathFilters,
+		}
+	}
+	return filterSchema
+}
+
+// Rejects paths that would be stored with a wrong prefix by the service, which otherwise fails with an
+// unspecific bad request error
+func validateBuildDefinitionPathFilter(i interface{}, k string) ([]string, []error) {
+	path, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %q to be string", k)}
+	}
+	path = strings.TrimSpace(path)
+	if path == "" {
+		return nil, []error{fmt.Errorf("%q must not be empty", k)}
+	}
+	if strings.HasPrefix(path, "+") || strings.HasPrefix(path, "-") {
+		return nil, []error{fmt.Errorf("%q must not start with + or -, list the path in the include or exclude block instead, got %q", k, path)}
+	}
+	if !strings.HasPrefix(path, "/") && !strings.HasPrefix(path, `\`) {
+		return nil, []error{fmt.Errorf("%q must be an absolute path in the repository starting with /, e.g. /src, got %q", k, path)}
+	}
+	return nil, nil
+}
+
+// Normalizes a path filter to the form used by the service, i.e. /a/b. Duplicate and trailing separators
+// are ignored.
+func normalizeBuildDefinitionPathFilter(path string) string {
+	segments := strings.FieldsFunc(strings.TrimSpace(path), func(r rune) bool {
+		return r == '\\' || r == '/'
+	})
+	return "/" + strings.Join(segments, "/")
+}
+
+func suppressEquivalentBuildDefinitionPathFilters(k, old, new string, d *schema.ResourceData) bool {
+	return normalizeBuildDefinitionPathFilter(old) == normalizeBuildDefinitionPathFilter(new)
+}
+
+// The agents of the pool must either have a capability with the name of a demand, e.g. java, or a capability
+// with the name and the value of the demand, e.g. Agent.OS -equals Linux
+var buildDefinitionDemandRegex = regexp.MustCompile(`^\s*(\S+)(?:\s+-equals\s+(\S.*?))?\s*$`)
+
+func validateBuildDefinitionDemand(i interface{}, k string) ([]string, []error) {
+	demand, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %q to be string", k)}
+	}
+	if !buildDefinitionDemandRegex.MatchString(demand) || strings.HasPrefix(strings.TrimSpace(demand), "-") {
+		return nil, []error{fmt.Errorf("%q must be the name of a capability, optionally followed by -equals and a value, e.g. Agent.OS -equals Linux, got %q", k, demand)}
+	}
+	return nil, nil
+}
+
+// Normalizes a demand to the form used by the service, i.e. name -equals value
+func normalizeBuildDefinitionDemand(demand string) string {
+	match := buildDefinitionDemandRegex.FindStringSubmatch(demand)
+	if match == nil {
+		return demand
+	}
+	if match[2] == "" {
+		return match[1]
+	}
+	return fmt.Sprintf("%s -equals %s", match[1], match[2])
+}
+
+func suppressEquivalentBuildDefinitionDemands(k, old, new string, d *schema.ResourceData) bool {
+	return normalizeBuildDefinitionDemand(old) == normalizeBuildDefinitionDemand(new)
+}
+
+var variableSecretHashKey, variableSecretHashSchema = tfhelper.GenerateSecreteMemoSchema("secret_value")
+
+func customizeDiffBuildDefinition(d *schema.ResourceDiff, m interface{}) error {
+	if err := validateRepositoryServiceConnection(d); err != nil {
+		return err
+	}
+	return validateSecretVariables(d.Get("variable").([]interface{}))
+}
+
+// Checks that repositories hosted outside of Azure DevOps reference the service connection used to access them.
+// A service connection that is only known once it has been created, e.g. one created in the same apply, is accepted.
+func validateRepositoryServiceConnection(d *schema.ResourceDiff) error {
+	for _, item := range d.Get("repository").(*schema.Set).List() {
+		repository := item.(map[string]interface{})
+		if !strings.EqualFold(repository["repo_type"].(string), repoTypeGitHub) || repository["service_connection_id"].(string) != "" {
+			continue
+		}
+		return fmt.Errorf("service_connection_id must be set for repositories of type %s", repoTypeGitHub)
+	}
+	return nil
+}
+
+// Checks that each variable only uses the field that matches whether or not it is a secret
+func validateSecretVariables(variables []interface{}) error {
+	for _, item := range variables {
+		variable := item.(map[string]interface{})
+		name := variable["name"].(string)
+		if variable["value"].(string) != "" && variable["secret_value"].(string) != "" {
+			return fmt.Errorf("Only one of value or secret_value can be set for variable %s", name)
+		}
+		if variable["is_secret"].(bool) && variable["value"].(string) != "" {
+			return fmt.Errorf("Variable %s is a secret, use secret_value instead of value", name)
+		}
+		if !variable["is_secret"].(bool) && variable["secret_value"].(string) != "" {
+			return fmt.Errorf("Variable %s is not a secret, use value instead of secret_value or set is_secret", name)
+		}
+	}
+	return nil
+}
+
+const (
+	repoTypeGitHub = "GitHub"
+	repoTypeTfsGit = "TfsGit"
+)
+
+// The repository property that references the service endpoint used to connect to an external repository
+const repositoryPropertyConnectedServiceID = "connectedServiceId"
+
+const (
+	commentRequiredAll            = "All"
+	commentRequiredNonTeamMembers = "NonTeamMembers"
+)
+
+// Indicates where the settings of a trigger come from: the build definition itself, or the YAML file of the pipeline
+const (
+	triggerSettingsSourceTypeDefinition = 1
+	triggerSettingsSourceTypeYaml       = 2
+)
+
 func resourceBuildDefinitionCreate(d *schema.ResourceData, m interface{}) error {
 	clients := m.(*aggregatedClient)
 	buildDefinition, projectID, err := expandBuildDefinition(d)
@@ -83,16 +485,19 @@ func resourceBuildDefinitionCreate(d *schema.ResourceData, m interface{}) error
 		return err
 	}
 
+	if err := validateBuildDefinitionRetention(clients, buildDefinition, projectID); err != nil {
+		return err
+	}
+
 	createdBuildDefinition, err := createBuildDefinition(clients, buildDefinition, projectID)
 	if err != nil {
 		return err
 	}
 
-	flattenBuildDefinition(d, createdBuildDefinition, projectID)
-	return nil
+	return flattenBuildDefinition(d, createdBuildDefinition, projectID, clients.secretSettings)
 }
 
-func flattenBuildDefinition(d *schema.ResourceData, buildDefinition *build.BuildDefinition, projectID string) {
+func flattenBuildDefinition(d *schema.ResourceData, buildDefinition *build.BuildDefinition, projectID string, secretSettings *secretmemo.Settings) error {
 	d.SetId(strconv.Itoa(*buildDefinition.Id))
 
 	d.Set("project_id", projectID)
@@ -106,6 +511,12 @@ func flattenBuildDefinition(d *schema.ResourceData, buildDefinition *build.Build
 	}
 
 	d.Set("revision", revision)
+	d.Set("job_authorization_scope", flattenBuildDefinitionJobAuthorizationScope(buildDefinition.JobAuthorizationScope))
+	d.Set("demand", flattenBuildDefinitionDemands(buildDefinition.Demands))
+
+	d.Set("variable", flattenBuildDefinitionVariables(d, buildDefinition, secretSettings))
+	d.Set("retention", flattenBuildDefinitionRetention(buildDefinition.RetentionRules))
+	return flattenBuildDefinitionTriggers(d, buildDefinition)
 }
 
 func createBuildDefinition(clients *aggregatedClient, buildDefinition *build.BuildDefinition, project string) (*build.BuildDefinition, error) {
@@ -134,8 +545,7 @@ func resourceBuildDefinitionRead(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
-	flattenBuildDefinition(d, buildDefinition, projectID)
-	return nil
+	return flattenBuildDefinition(d, buildDefinition, projectID, clients.secretSettings)
 }
 
 func resourceBuildDefinitionDelete(d *schema.ResourceData, m interface{}) error {
@@ -164,6 +574,10 @@ func resourceBuildDefinitionUpdate(d *schema.ResourceData, m interface{}) error
 		return err
 	}
 
+	if err := validateBuildDefinitionRetention(clients, buildDefinition, projectID); err != nil {
+		return err
+	}
+
 	updatedBuildDefinition, err := clients.BuildClient.UpdateDefinition(m.(*aggregatedClient).ctx, build.UpdateDefinitionArgs{
 		Definition:   buildDefinition,
 		Project:      &projectID,
@@ -174,8 +588,7 @@ func resourceBuildDefinitionUpdate(d *schema.ResourceData, m interface{}) error
 		return err
 	}
 
-	flattenBuildDefinition(d, updatedBuildDefinition, projectID)
-	return nil
+	return flattenBuildDefinition(d, updatedBuildDefinition, projectID, clients.secretSettings)
 }
 
 func parseIdentifiers(d *schema.ResourceData) (string, int, error) {
@@ -186,26 +599,37 @@ func parseIdentifiers(d *schema.ResourceData) (string, int, error) {
 }
 
 func flattenRepository(buildDefiniton *build.BuildDefinition) interface{} {
+	// The connection to the service endpoint is only part of the repository properties for
+	// repositories that are hosted outside of Azure DevOps, e.g. on GitHub
+	serviceConnectionID := ""
+	if buildDefiniton.Repository.Properties != nil {
+		serviceConnectionID = (*buildDefiniton.Repository.Properties)[repositoryPropertyConnectedServiceID]
+	}
+
+	return []map[string]interface{}{{
+		"yml_path":              flattenYamlFilePath(buildDefiniton),
+		"repo_name":             *buildDefiniton.Repository.Name,
+		"repo_type":             *buildDefiniton.Repository.Type,
+		"branch_name":           converter.ToString(buildDefiniton.Repository.DefaultBranch, ""),
+		"service_connection_id": serviceConnectionID,
+	}}
+}
+
+func flattenYamlFilePath(buildDefiniton *build.BuildDefinition) string {
 	yamlFilePath := ""
 
 	// The process member can be of many types -- the only typing information
 	// available from the compiler is `interface{}` so we can probe for known
 	// implementations
 	if processMap, ok := buildDefiniton.Process.(map[string]interface{}); ok {
-		yamlFilePath = processMap["yamlFilename"].(string)
+		yamlFilePath, _ = processMap["yamlFilename"].(string)
 	}
 
 	if yamlProcess, ok := buildDefiniton.Process.(*build.YamlProcess); ok {
 		yamlFilePath = *yamlProcess.YamlFilename
 	}
 
-	return []map[string]interface{}{{
-		"yml_path":              yamlFilePath,
-		"repo_name":             *buildDefiniton.Repository.Name,
-		"repo_type":             *buildDefiniton.Repository.Type,
-		"branch_name":           *buildDefiniton.Repository.DefaultBranch,
-		"service_connection_id": (*buildDefiniton.Repository.Properties)["connectedServiceId"],
-	}}
+	return yamlFilePath
 }
 
 func expandBuildDefinition(d *schema.ResourceData) (*build.BuildDefinition, string, error) {
@@ -221,8 +645,9 @@ func expandBuildDefinition(d *schema.ResourceData) (*build.BuildDefinition, stri
 
 	repoName := repository["repo_name"].(string)
 	repoType := repository["repo_type"].(string)
+	serviceConnectionID := repository["service_connection_id"].(string)
 	repoURL := ""
-	if strings.EqualFold(repoType, "github") {
+	if strings.EqualFold(repoType, repoTypeGitHub) {
 		repoURL = fmt.Sprintf("https://github.com/%s.git", repoName)
 	}
 
@@ -236,7 +661,13 @@ func expandBuildDefinition(d *schema.ResourceData) (*build.BuildDefinition, stri
 		buildDefinitionReference = nil
 	}
 
+	triggers, err := expandBuildDefinitionTriggers(d)
+	if err != nil {
+		return nil, "", err
+	}
+
 	agentPoolName := d.Get("agent_pool_name").(string)
+	jobAuthorizationScope := build.BuildAuthorizationScope(d.Get("job_authorization_scope").(string))
 	buildDefinition := build.BuildDefinition{
 		Id:       buildDefinitionReference,
 		Name:     converter.String(d.Get("name").(string)),
@@ -248,7 +679,7 @@ func expandBuildDefinition(d *schema.ResourceData) (*build.BuildDefinition, stri
 			DefaultBranch: converter.String(repository["branch_name"].(string)),
 			Type:          &repoType,
 			Properties: &map[string]string{
-				"connectedServiceId": repository["service_connection_id"].(string),
+				repositoryPropertyConnectedServiceID: serviceConnectionID,
 			},
 		},
 		Process: &build.YamlProcess{
@@ -260,10 +691,675 @@ func expandBuildDefinition(d *schema.ResourceData) (*build.BuildDefinition, stri
 				Name: &agentPoolName,
 			},
 		},
-		QueueStatus: &build.DefinitionQueueStatusValues.Enabled,
-		Type:        &build.DefinitionTypeValues.Build,
-		Quality:     &build.DefinitionQualityValues.Definition,
+		QueueStatus:           &build.DefinitionQueueStatusValues.Enabled,
+		Type:                  &build.DefinitionTypeValues.Build,
+		Quality:               &build.DefinitionQualityValues.Definition,
+		Triggers:              &triggers,
+		Variables:             expandBuildDefinitionVariables(d),
+		RetentionRules:        expandBuildDefinitionRetention(d.Get("retention").([]interface{})),
+		JobAuthorizationScope: &jobAuthorizationScope,
+		Demands:               expandBuildDefinitionDemands(d.Get("demand").([]interface{})),
 	}
 
 	return &buildDefinition, projectID, nil
 }
+
+// The triggers of a build definition are untyped in the API model, and the typed trigger models lack the
+// trigger type discriminator, so triggers are expanded into plain maps
+func expandBuildDefinitionTriggers(d *schema.ResourceData) ([]interface{}, error) {
+	triggers := []interface{}{}
+
+	if ciTrigger := singleBlock(d.Get("ci_trigger")); ciTrigger != nil {
+		trigger, err := expandBuildDefinitionCITrigger(ciTrigger)
+		if err != nil {
+			return nil, err
+		}
+		triggers = append(triggers, trigger)
+	}
+
+	if prTrigger := singleBlock(d.Get("pull_request_trigger")); prTrigger != nil {
+		trigger, err := expandBuildDefinitionPullRequestTrigger(prTrigger)
+		if err != nil {
+			return nil, err
+		}
+		triggers = append(triggers, trigger)
+	}
+
+	if schedules := d.Get("schedules").([]interface{}); len(schedules) > 0 {
+		triggers = append(triggers, map[string]interface{}{
+			"triggerType": string(build.DefinitionTriggerTypeValues.Schedule),
+			"schedules":   expandBuildDefinitionSchedules(schedules),
+		})
+	}
+
+	return triggers, nil
+}
+
+func expandBuildDefinitionCITrigger(ciTrigger map[string]interface{}) (map[string]interface{}, error) {
+	override := singleBlock(ciTrigger["override"])
+	if ciTrigger["use_yaml"].(bool) {
+		if override != nil {
+			return nil, fmt.Errorf("ci_trigger.override can not be set when ci_trigger.use_yaml is true")
+		}
+		return map[string]interface{}{
+			"triggerType":                  string(build.DefinitionTriggerTypeValues.ContinuousIntegration),
+			"settingsSourceType":           triggerSettingsSourceTypeYaml,
+			"batchChanges":                 false,
+			"branchFilters":                []string{},
+			"pathFilters":                  []string{},
+			"maxConcurrentBuildsPerBranch": 1,
+			"pollingInterval":              0,
+		}, nil
+	}
+
+	if override == nil {
+		return nil, fmt.Errorf("ci_trigger.override must be set when ci_trigger.use_yaml is false")
+	}
+	return map[string]interface{}{
+		"triggerType":                  string(build.DefinitionTriggerTypeValues.ContinuousIntegration),
+		"settingsSourceType":           triggerSettingsSourceTypeDefinition,
+		"batchChanges":                 override["batch"].(bool),
+		"branchFilters":                expandBuildDefinitionFilter(override["branch_filter"]),
+		"pathFilters":                  expandBuildDefinitionPathFilter(override["path_filter"]),
+		"maxConcurrentBuildsPerBranch": override["max_concurrent_builds_per_branch"].(int),
+		"pollingInterval":              override["polling_interval"].(int),
+	}, nil
+}
+
+func expandBuildDefinitionPullRequestTrigger(prTrigger map[string]interface{}) (map[string]interface{}, error) {
+	trigger := map[string]interface{}{
+		"triggerType": string(build.DefinitionTriggerTypeValues.PullRequest),
+	}
+
+	forks := singleBlock(prTrigger["forks"])
+	if forks == nil {
+		return nil, fmt.Errorf("pull_request_trigger.forks must be set")
+	}
+	trigger["forks"] = map[string]interface{}{
+		"enabled":      forks["enabled"].(bool),
+		"allowSecrets": forks["share_secrets"].(bool),
+	}
+
+	commentRequired := prTrigger["comment_required_setting"].(string)
+	trigger["isCommentRequiredForPullRequest"] = commentRequired != ""
+	trigger["requireCommentsForNonTeamMembersOnly"] = commentRequired == commentRequiredNonTeamMembers
+
+	override := singleBlock(prTrigger["override"])
+	if prTrigger["use_yaml"].(bool) {
+		if override != nil {
+			return nil, fmt.Errorf("pull_request_trigger.override can not be set when pull_request_trigger.use_yaml is true")
+		}
+		// the service requires at least one branch filter, even though the filters of the YAML file are used
+		trigger["settingsSourceType"] = triggerSettingsSourceTypeYaml
+		trigger["branchFilters"] = []string{prTrigger["initial_branch"].(string)}
+		trigger["pathFilters"] = []string{}
+		return trigger, nil
+	}
+
+	if override == nil {
+		return nil, fmt.Errorf("pull_request_trigger.override must be set when pull_request_trigger.use_yaml is false")
+	}
+	trigger["settingsSourceType"] = triggerSettingsSourceTypeDefinition
+	trigger["autoCancel"] = override["auto_cancel"].(bool)
+	trigger["branchFilters"] = expandBuildDefinitionFilter(override["branch_filter"])
+	trigger["pathFilters"] = expandBuildDefinitionPathFilter(override["path_filter"])
+	return trigger, nil
+}
+
+func expandBuildDefinitionFilter(filter interface{}) []string {
+	filters := []string{}
+	filterBlock := singleBlock(filter)
+	if filterBlock == nil {
+		return filters
+	}
+
+	for _, include := range filterBlock["include"].([]interface{}) {
+		filters = append(filters, "+"+include.(string))
+	}
+	for _, exclude := range filterBlock["exclude"].([]interface{}) {
+		filters = append(filters, "-"+exclude.(string))
+	}
+	return filters
+}
+
+// Expands path filters, which are sent to the service in their normalized form
+func expandBuildDefinitionPathFilter(filter interface{}) []string {
+	filters := expandBuildDefinitionFilter(filter)
+	for i, filter := range filters {
+		filters[i] = filter[:1] + normalizeBuildDefinitionPathFilter(filter[1:])
+	}
+	return filters
+}
+
+// The trigger type discriminator that is part of every trigger returned by the service
+type buildDefinitionTriggerType struct {
+	TriggerType build.DefinitionTriggerType `json:"triggerType"`
+}
+
+func flattenBuildDefinitionTriggers(d *schema.ResourceData, buildDefinition *build.BuildDefinition) error {
+	var ciTrigger, prTrigger, schedules, triggers []interface{}
+	if buildDefinition.Triggers != nil {
+		triggers = *buildDefinition.Triggers
+	}
+
+	for _, trigger := range triggers {
+		var triggerType buildDefinitionTriggerType
+		if err := decodeBuildDefinitionTrigger(trigger, &triggerType); err != nil {
+			return err
+		}
+
+		switch triggerType.TriggerType {
+		case build.DefinitionTriggerTypeValues.ContinuousIntegration:
+			var typedTrigger build.ContinuousIntegrationTrigger
+			if err := decodeBuildDefinitionTrigger(trigger, &typedTrigger); err != nil {
+				return err
+			}
+			ciTrigger = []interface{}{flattenBuildDefinitionCITrigger(&typedTrigger)}
+		case build.DefinitionTriggerTypeValues.PullRequest:
+			var typedTrigger build.PullRequestTrigger
+			if err := decodeBuildDefinitionTrigger(trigger, &typedTrigger); err != nil {
+				return err
+			}
+			prTrigger = []interface{}{flattenBuildDefinitionPullRequestTrigger(&typedTrigger)}
+		case build.DefinitionTriggerTypeValues.Schedule:
+			var typedTrigger buildDefinitionScheduleTrigger
+			if err := decodeBuildDefinitionTrigger(trigger, &typedTrigger); err != nil {
+				return err
+			}
+			flattened, err := flattenBuildDefinitionSchedules(typedTrigger.Schedules)
+			if err != nil {
+				return err
+			}
+			schedules = append(schedules, flattened...)
+		}
+	}
+
+	d.Set("ci_trigger", ciTrigger)
+	d.Set("pull_request_trigger", prTrigger)
+	d.Set("schedules", orderBuildDefinitionSchedules(d.Get("schedules").([]interface{}), schedules))
+	return nil
+}
+
+func flattenBuildDefinitionCITrigger(trigger *build.ContinuousIntegrationTrigger) map[string]interface{} {
+	if trigger.SettingsSourceType != nil && *trigger.SettingsSourceType == triggerSettingsSourceTypeYaml {
+		return map[string]interface{}{
+			"use_yaml": true,
+		}
+	}
+
+	maxConcurrentBuilds := 1
+	if trigger.MaxConcurrentBuildsPerBranch != nil {
+		maxConcurrentBuilds = *trigger.MaxConcurrentBuildsPerBranch
+	}
+	pollingInterval := 0
+	if trigger.PollingInterval != nil {
+		pollingInterval = *trigger.PollingInterval
+	}
+
+	return map[string]interface{}{
+		"use_yaml": false,
+		"override": []interface{}{map[string]interface{}{
+			"batch":                            converter.ToBool(trigger.BatchChanges, false),
+			"branch_filter":                    flattenBuildDefinitionFilter(trigger.BranchFilters),
+			"path_filter":                      flattenBuildDefinitionFilter(trigger.PathFilters),
+			"polling_interval":                 pollingInterval,
+			"max_concurrent_builds_per_branch": maxConcurrentBuilds,
+		}},
+	}
+}
+
+func flattenBuildDefinitionPullRequestTrigger(trigger *build.PullRequestTrigger) map[string]interface{} {
+	flattened := map[string]interface{}{
+		"use_yaml":       false,
+		"initial_branch": "Managed by Terraform",
+	}
+
+	forks := map[string]interface{}{
+		"enabled":       false,
+		"share_secrets": false,
+	}
+	if trigger.Forks != nil {
+		forks["enabled"] = converter.ToBool(trigger.Forks.Enabled, false)
+		forks["share_secrets"] = converter.ToBool(trigger.Forks.AllowSecrets, false)
+	}
+	flattened["forks"] = []interface{}{forks}
+
+	commentRequired := ""
+	if converter.ToBool(trigger.IsCommentRequiredForPullRequest, false) {
+		commentRequired = commentRequiredAll
+		if converter.ToBool(trigger.RequireCommentsForNonTeamMembersOnly, false) {
+			commentRequired = commentRequiredNonTeamMembers
+		}
+	}
+	flattened["comment_required_setting"] = commentRequired
+
+	if trigger.SettingsSourceType != nil && *trigger.SettingsSourceType == triggerSettingsSourceTypeYaml {
+		flattened["use_yaml"] = true
+		if trigger.BranchFilters != nil && len(*trigger.BranchFilters) > 0 {
+			flattened["initial_branch"] = (*trigger.BranchFilters)[0]
+		}
+		return flattened
+	}
+
+	flattened["override"] = []interface{}{map[string]interface{}{
+		"auto_cancel":   converter.ToBool(trigger.AutoCancel, false),
+		"branch_filter": flattenBuildDefinitionFilter(trigger.BranchFilters),
+		"path_filter":   flattenBuildDefinitionFilter(trigger.PathFilters),
+	}}
+	return flattened
+}
+
+func flattenBuildDefinitionFilter(filters *[]string) []interface{} {
+	if filters == nil || len(*filters) == 0 {
+		return nil
+	}
+
+	include := []interface{}{}
+	exclude := []interface{}{}
+	for _, filter := range *filters {
+		if strings.HasPrefix(filter, "-") {
+			exclude = append(exclude, strings.TrimPrefix(filter, "-"))
+		} else {
+			include = append(include, strings.TrimPrefix(filter, "+"))
+		}
+	}
+
+	return []interface{}{map[string]interface{}{
+		"include": include,
+		"exclude": exclude,
+	}}
+}
+
+// Triggers are returned by the service as generic maps, so they are converted into typed models by a JSON round trip
+func decodeBuildDefinitionTrigger(trigger interface{}, typedTrigger interface{}) error {
+	raw, err := json.Marshal(trigger)
+	if err != nil {
+		return fmt.Errorf("Error encoding build definition trigger: %+v", err)
+	}
+	if err := json.Unmarshal(raw, typedTrigger); err != nil {
+		return fmt.Errorf("Error decoding build definition trigger: %+v", err)
+	}
+	return nil
+}
+
+// Returns the content of a block that is limited to a single item, or nil if the block is not configured
+func singleBlock(block interface{}) map[string]interface{} {
+	items, ok := block.([]interface{})
+	if !ok || len(items) != 1 || items[0] == nil {
+		return nil
+	}
+	return items[0].(map[string]interface{})
+}
+
+// The days of a schedule are a flags enum. The names are listed in the order of their flag values.
+var scheduleDayNames = []string{"Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday", "Sunday"}
+
+// The models of the SDK describe the days of a schedule as a string, while the service returns them as the
+// numeric value of the flags enum, so schedules are decoded into this model instead.
+type buildDefinitionScheduleTrigger struct {
+	Schedules []buildDefinitionSchedule `json:"schedules"`
+}
+
+type buildDefinitionSchedule struct {
+	BranchFilters           *[]string   `json:"branchFilters"`
+	DaysToBuild             interface{} `json:"daysToBuild"`
+	ScheduleJobID           *string     `json:"scheduleJobId"`
+	ScheduleOnlyWithChanges *bool       `json:"scheduleOnlyWithChanges"`
+	StartHours              *int        `json:"startHours"`
+	StartMinutes            *int        `json:"startMinutes"`
+	TimeZoneID              *string     `json:"timeZoneId"`
+}
+
+func expandBuildDefinitionSchedules(schedules []interface{}) []interface{} {
+	expanded := make([]interface{}, len(schedules))
+	for i, item := range schedules {
+		schedule := item.(map[string]interface{})
+		expandedSchedule := map[string]interface{}{
+			"branchFilters":           expandBuildDefinitionFilter(schedule["branch_filter"]),
+			"daysToBuild":             expandScheduleDays(schedule["days_to_build"].(*schema.Set).List()),
+			"scheduleOnlyWithChanges": schedule["schedule_only_with_changes"].(bool),
+			"startHours":              schedule["start_hours"].(int),
+			"startMinutes":            schedule["start_minutes"].(int),
+			"timeZoneId":              schedule["time_zone"].(string),
+		}
+		// reusing the job of an existing schedule keeps the service from rescheduling it
+		if jobID := schedule["schedule_job_id"].(string); jobID != "" {
+			expandedSchedule["scheduleJobId"] = jobID
+		}
+		expanded[i] = expandedSchedule
+	}
+	return expanded
+}
+
+func expandScheduleDays(days []interface{}) int {
+	flags := 0
+	for _, day := range days {
+		for i, name := range scheduleDayNames {
+			if day.(string) == name {
+				flags |= 1 << uint(i)
+			}
+		}
+	}
+	return flags
+}
+
+func flattenBuildDefinitionSchedules(schedules []buildDefinitionSchedule) ([]interface{}, error) {
+	flattened := make([]interface{}, len(schedules))
+	for i, schedule := range schedules {
+		days, err := flattenScheduleDays(schedule.DaysToBuild)
+		if err != nil {
+			return nil, err
+		}
+
+		startHours, startMinutes := 0, 0
+		if schedule.StartHours != nil {
+			startHours = *schedule.StartHours
+		}
+		if schedule.StartMinutes != nil {
+			startMinutes = *schedule.StartMinutes
+		}
+
+		flattened[i] = map[string]interface{}{
+			"branch_filter":              flattenBuildDefinitionFilter(schedule.BranchFilters),
+			"days_to_build":              schema.NewSet(schema.HashString, days),
+			"schedule_only_with_changes": converter.ToBool(schedule.ScheduleOnlyWithChanges, false),
+			"start_hours":                startHours,
+			"start_minutes":              startMinutes,
+			"time_zone":                  converter.ToString(schedule.TimeZoneID, ""),
+			"schedule_job_id":            converter.ToString(schedule.ScheduleJobID, ""),
+		}
+	}
+	return flattened, nil
+}
+
+// Converts the days of a schedule into their names. The service returns the numeric value of the flags enum,
+// but the names of the flags (e.g. "monday, friday") are understood as well.
+func flattenScheduleDays(daysToBuild interface{}) ([]interface{}, error) {
+	flags := 0
+	switch days := daysToBuild.(type) {
+	case nil:
+	case float64:
+		flags = int(days)
+	case string:
+		for _, day := range strings.Split(days, ",") {
+			day = strings.TrimSpace(day)
+			switch {
+			case strings.EqualFold(day, "all"):
+				flags = 1<<uint(len(scheduleDayNames)) - 1
+			case strings.EqualFold(day, "none"), day == "":
+			default:
+				index := -1
+				for i, name := range scheduleDayNames {
+					if strings.EqualFold(day, name) {
+						index = i
+					}
+				}
+				if index < 0 {
+					return nil, fmt.Errorf("Unexpected day in build definition schedule: %s", day)
+				}
+				flags |= 1 << uint(index)
+			}
+		}
+	default:
+		return nil, fmt.Errorf("Unexpected type of days in build definition schedule: %T", daysToBuild)
+	}
+
+	names := []interface{}{}
+	for i, name := range scheduleDayNames {
+		if flags&(1<<uint(i)) != 0 {
+			names = append(names, name)
+		}
+	}
+	return names, nil
+}
+
+// The service does not guarantee the order of schedules, so the schedules read from the service are put into
+// the order of the schedules that are already known. Schedules are matched by their job ID if it is known and
+// by their settings otherwise. Schedules that do not match are appended in a deterministic order.
+func orderBuildDefinitionSchedules(known []interface{}, schedules []interface{}) []interface{} {
+	ordered := make([]interface{}, 0, len(schedules))
+	remaining := append([]interface{}{}, schedules...)
+
+	for _, knownSchedule := range known {
+		for i, schedule := range remaining {
+			if buildDefinitionSchedulesMatch(knownSchedule.(map[string]interface{}), schedule.(map[string]interface{})) {
+				ordered = append(ordered, schedule)
+				remaining = append(remaining[:i], remaining[i+1:]...)
+				break
+			}
+		}
+	}
+
+	sort.SliceStable(remaining, func(i, j int) bool {
+		return buildDefinitionScheduleSortKey(remaining[i]) < buildDefinitionScheduleSortKey(remaining[j])
+	})
+	return append(ordered, remaining...)
+}
+
+func buildDefinitionSchedulesMatch(known map[string]interface{}, schedule map[string]interface{}) bool {
+	if knownJobID := known["schedule_job_id"].(string); knownJobID != "" {
+		return strings.EqualFold(knownJobID, schedule["schedule_job_id"].(string))
+	}
+	return buildDefinitionScheduleSortKey(known) == buildDefinitionScheduleSortKey(schedule)
+}
+
+func buildDefinitionScheduleSortKey(item interface{}) string {
+	schedule := item.(map[string]interface{})
+	days := schedule["days_to_build"].(*schema.Set).List()
+	return fmt.Sprintf("%02d:%02d|%s|%d|%v|%v",
+		schedule["start_hours"].(int),
+		schedule["start_minutes"].(int),
+		schedule["time_zone"].(string),
+		expandScheduleDays(days),
+		schedule["schedule_only_with_changes"].(bool),
+		expandBuildDefinitionFilter(schedule["branch_filter"]))
+}
+
+func expandBuildDefinitionVariables(d *schema.ResourceData) *map[string]build.BuildDefinitionVariable {
+	variables := map[string]build.BuildDefinitionVariable{}
+	for _, item := range d.Get("variable").([]interface{}) {
+		variable := item.(map[string]interface{})
+		isSecret := variable["is_secret"].(bool)
+
+		// the service keeps the stored value of a secret variable if no value is sent
+		var value *string
+		if isSecret {
+			if secretValue := variable["secret_value"].(string); secretValue != "" {
+				value = converter.String(secretValue)
+			}
+		} else {
+			value = converter.String(variable["value"].(string))
+		}
+
+		variables[variable["name"].(string)] = build.BuildDefinitionVariable{
+			Value:         value,
+			IsSecret:      converter.Bool(isSecret),
+			AllowOverride: converter.Bool(variable["allow_override"].(bool)),
+		}
+	}
+	return &variables
+}
+
+// Flattens the variables of a build definition in the order in which they are known, followed by any other
+// variables ordered by name. The service never returns the values of secret variables, so the hash of the
+// configured secret value is kept in the state to detect changes.
+func flattenBuildDefinitionVariables(d *schema.ResourceData, buildDefinition *build.BuildDefinition, secretSettings *secretmemo.Settings) []interface{} {
+	if buildDefinition.Variables == nil {
+		return nil
+	}
+
+	var existingNames []string
+	for name := range *buildDefinition.Variables {
+		existingNames = append(existingNames, name)
+	}
+	names, knownIndexes := orderVariableNames(d.Get("variable").([]interface{}), existingNames)
+
+	variables := make([]interface{}, len(names))
+	for i, name := range names {
+		variable := (*buildDefinition.Variables)[name]
+		isSecret := converter.ToBool(variable.IsSecret, false)
+
+		flattened := map[string]interface{}{
+			"name":           name,
+			"value":          "",
+			"secret_value":   "",
+			"is_secret":      isSecret,
+			"allow_override": converter.ToBool(variable.AllowOverride, false),
+		}
+		if !isSecret {
+			flattened["value"] = converter.ToString(variable.Value, "")
+		} else if index, ok := knownIndexes[name]; ok {
+			tfhelper.HelpFlattenSecretNestedAt(d, "variable", index, flattened, "secret_value", secretSettings)
+		}
+		variables[i] = flattened
+	}
+	return variables
+}
+
+// Orders the names of existing variables by the position of the variables in the configuration or current state,
+// followed by any other variables ordered by name. The returned map holds the position of each known variable.
+func orderVariableNames(known []interface{}, existingNames []string) ([]string, map[string]int) {
+	existing := map[string]bool{}
+	for _, name := range existingNames {
+		existing[name] = true
+	}
+
+	knownIndexes := map[string]int{}
+	var names []string
+	for i, item := range known {
+		name := item.(map[string]interface{})["name"].(string)
+		if _, seen := knownIndexes[name]; existing[name] && !seen {
+			knownIndexes[name] = i
+			names = append(names, name)
+		}
+	}
+
+	var otherNames []string
+	for _, name := range existingNames {
+		if _, ok := knownIndexes[name]; !ok {
+			otherNames = append(otherNames, name)
+		}
+	}
+	sort.Strings(otherNames)
+	return append(names, otherNames...), knownIndexes
+}
+
+// The branches and artifacts the retention rule of a build definition applies to, which are the values used by
+// the web UI when a retention rule is added to a definition
+var (
+	retentionRuleBranches              = []string{"+refs/heads/*"}
+	retentionRuleArtifacts             = []string{"build.SourceLabel"}
+	retentionRuleArtifactTypesToDelete = []string{"FilePath", "SymbolStore"}
+)
+
+// Definitions that were created without a job authorization scope use the scope of the project collection
+func flattenBuildDefinitionJobAuthorizationScope(scope *build.BuildAuthorizationScope) string {
+	if scope == nil || *scope == "" {
+		return string(build.BuildAuthorizationScopeValues.ProjectCollection)
+	}
+	return string(*scope)
+}
+
+// The demands of a definition are untyped in the API model. The service sends and accepts them as strings in the
+// form name -equals value.
+func expandBuildDefinitionDemands(demands []interface{}) *[]interface{} {
+	expanded := []interface{}{}
+	for _, demand := range demands {
+		expanded = append(expanded, normalizeBuildDefinitionDemand(demand.(string)))
+	}
+	return &expanded
+}
+
+func flattenBuildDefinitionDemands(demands *[]interface{}) []interface{} {
+	if demands == nil {
+		return nil
+	}
+
+	flattened := []interface{}{}
+	for _, demand := range *demands {
+		switch typedDemand := demand.(type) {
+		case string:
+			flattened = append(flattened, normalizeBuildDefinitionDemand(typedDemand))
+		case map[string]interface{}:
+			// demands that are not serialized as strings carry the name and the value as separate properties
+			name, _ := typedDemand["name"].(string)
+			value, _ := typedDemand["value"].(string)
+			if value == "" {
+				flattened = append(flattened, name)
+			} else {
+				flattened = append(flattened, fmt.Sprintf("%s -equals %s", name, value))
+			}
+		}
+	}
+	return flattened
+}
+
+// Convert the retention block to the retention rules of a build definition. Without a retention block the
+// definition has no rules of its own, and the retention settings of the project apply.
+func expandBuildDefinitionRetention(retention []interface{}) *[]build.RetentionPolicy {
+	rules := []build.RetentionPolicy{}
+	for _, item := range retention {
+		rule := item.(map[string]interface{})
+		branches := append([]string{}, retentionRuleBranches...)
+		artifacts := append([]string{}, retentionRuleArtifacts...)
+		artifactTypesToDelete := append([]string{}, retentionRuleArtifactTypesToDelete...)
+		rules = append(rules, build.RetentionPolicy{
+			Branches:              &branches,
+			Artifacts:             &artifacts,
+			ArtifactTypesToDelete: &artifactTypesToDelete,
+			DaysToKeep:            converter.Int(rule["days_to_keep"].(int)),
+			MinimumToKeep:         converter.Int(rule["minimum_to_keep"].(int)),
+			DeleteBuildRecord:     converter.Bool(rule["delete_build_record"].(bool)),
+			DeleteTestResults:     converter.Bool(rule["delete_test_results"].(bool)),
+		})
+	}
+	return &rules
+}
+
+// Every retention rule of the definition is flattened, so that rules added outside of Terraform show up as a
+// difference instead of being dropped silently on the next update
+func flattenBuildDefinitionRetention(rules *[]build.RetentionPolicy) []interface{} {
+	if rules == nil {
+		return nil
+	}
+
+	retention := make([]interface{}, len(*rules))
+	for i, rule := range *rules {
+		retention[i] = map[string]interface{}{
+			"days_to_keep":        converter.ToInt(rule.DaysToKeep, 0),
+			"minimum_to_keep":     converter.ToInt(rule.MinimumToKeep, 0),
+			"delete_build_record": converter.ToBool(rule.DeleteBuildRecord, false),
+			"delete_test_results": converter.ToBool(rule.DeleteTestResults, false),
+		}
+	}
+	return retention
+}
+
+// Verifies that the retention rules do not exceed the maximum retention policy of the organization, which the
+// service would otherwise reduce the rules to without an error
+func validateBuildDefinitionRetention(clients *aggregatedClient, buildDefinition *build.BuildDefinition, projectID string) error {
+	if buildDefinition.RetentionRules == nil || len(*buildDefinition.RetentionRules) == 0 {
+		return nil
+	}
+
+	settings, err := clients.BuildClient.GetBuildSettings(clients.ctx, build.GetBuildSettingsArgs{
+		Project: &projectID,
+	})
+	if err != nil {
+		return fmt.Errorf("Error looking up the build settings of project %s: %+v", projectID, err)
+	}
+	if settings == nil || settings.MaximumRetentionPolicy == nil {
+		return nil
+	}
+
+	maximum := settings.MaximumRetentionPolicy
+	for _, rule := range *buildDefinition.RetentionRules {
+		if maximum.MinimumToKeep != nil && *rule.MinimumToKeep > *maximum.MinimumToKeep {
+			return fmt.Errorf("retention minimum_to_keep %d exceeds the maximum of %d allowed by the organization", *rule.MinimumToKeep, *maximum.MinimumToKeep)
+		}
+		if maximum.DaysToKeep != nil && *rule.DaysToKeep > *maximum.DaysToKeep {
+			return fmt.Errorf("retention days_to_keep %d exceeds the maximum of %d allowed by the organization", *rule.DaysToKeep, *maximum.DaysToKeep)
+		}
+	}
+	return nil
+}
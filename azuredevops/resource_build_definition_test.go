@@ -2,10 +2,12 @@ package azuredevops
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"os"
 	"strconv"
 	"testing"
 
@@ -17,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/build"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
 )
 
 var testProjectID = uuid.New().String()
@@ -50,6 +53,40 @@ var testBuildDefinition = build.BuildDefinition{
 	QueueStatus: &build.DefinitionQueueStatusValues.Enabled,
 	Type:        &build.DefinitionTypeValues.Build,
 	Quality:     &build.DefinitionQualityValues.Definition,
+	Triggers: &[]interface{}{
+		map[string]interface{}{
+			"triggerType":                  "continuousIntegration",
+			"settingsSourceType":           1,
+			"batchChanges":                 true,
+			"branchFilters":                []string{"+master", "-releases/old*"},
+			"pathFilters":                  []string{"+/src"},
+			"maxConcurrentBuildsPerBranch": 2,
+			"pollingInterval":              0,
+		},
+		map[string]interface{}{
+			"triggerType":                          "pullRequest",
+			"settingsSourceType":                   2,
+			"branchFilters":                        []string{"Managed by Terraform"},
+			"pathFilters":                          []string{},
+			"forks":                                map[string]interface{}{"enabled": true, "allowSecrets": false},
+			"isCommentRequiredForPullRequest":      true,
+			"requireCommentsForNonTeamMembersOnly": true,
+		},
+	},
+	Variables: &map[string]build.BuildDefinitionVariable{
+		"FOO": {
+			Value:         converter.String("bar"),
+			IsSecret:      converter.Bool(false),
+			AllowOverride: converter.Bool(true),
+		},
+		"SECRET": {
+			IsSecret:      converter.Bool(true),
+			AllowOverride: converter.Bool(false),
+		},
+	},
+	RetentionRules:        &[]build.RetentionPolicy{},
+	JobAuthorizationScope: &build.BuildAuthorizationScopeValues.Project,
+	Demands:               &[]interface{}{"Agent.OS -equals Linux", "java"},
 }
 
 /**
@@ -71,7 +108,7 @@ func TestAzureDevOpsBuildDefinition_RepoTypeListIsCorrect(t *testing.T) {
 // verifies that the flatten/expand round trip yields the same build definition
 func TestAzureDevOpsBuildDefinition_ExpandFlatten_Roundtrip(t *testing.T) {
 	resourceData := schema.TestResourceDataRaw(t, resourceBuildDefinition().Schema, nil)
-	flattenBuildDefinition(resourceData, &testBuildDefinition, testProjectID)
+	flattenBuildDefinition(resourceData, &testBuildDefinition, testProjectID, nil)
 
 	buildDefinitionAfterRoundTrip, projectID, err := expandBuildDefinition(resourceData)
 
@@ -80,6 +117,209 @@ func TestAzureDevOpsBuildDefinition_ExpandFlatten_Roundtrip(t *testing.T) {
 	require.Equal(t, testProjectID, projectID)
 }
 
+// verifies that triggers returned by the service as generic JSON are reconstructed faithfully
+func TestAzureDevOpsBuildDefinition_Flatten_ReconstructsTriggersFromJSON(t *testing.T) {
+	var triggers []interface{}
+	err := json.Unmarshal([]byte(`[
+		{"triggerType": "continuousIntegration", "settingsSourceType": 2, "batchChanges": false, "branchFilters": [], "pathFilters": [], "maxConcurrentBuildsPerBranch": 1, "pollingInterval": 0},
+		{"triggerType": "pullRequest", "settingsSourceType": 1, "autoCancel": false, "branchFilters": ["+master", "-feature/*"], "pathFilters": [],
+			"forks": {"enabled": false, "allowSecrets": false}, "isCommentRequiredForPullRequest": true, "requireCommentsForNonTeamMembersOnly": false}
+	]`), &triggers)
+	require.Nil(t, err)
+
+	definition := testBuildDefinition
+	definition.Triggers = &triggers
+
+	resourceData := schema.TestResourceDataRaw(t, resourceBuildDefinition().Schema, nil)
+	err = flattenBuildDefinition(resourceData, &definition, testProjectID, nil)
+	require.Nil(t, err)
+
+	require.Equal(t, true, resourceData.Get("ci_trigger.0.use_yaml"))
+	require.Equal(t, 0, resourceData.Get("ci_trigger.0.override.#"))
+	require.Equal(t, false, resourceData.Get("pull_request_trigger.0.use_yaml"))
+	require.Equal(t, "All", resourceData.Get("pull_request_trigger.0.comment_required_setting"))
+	require.Equal(t, false, resourceData.Get("pull_request_trigger.0.override.0.auto_cancel"))
+	require.Equal(t, []interface{}{"master"}, resourceData.Get("pull_request_trigger.0.override.0.branch_filter.0.include"))
+	require.Equal(t, []interface{}{"feature/*"}, resourceData.Get("pull_request_trigger.0.override.0.branch_filter.0.exclude"))
+
+	// the reconstructed state expands into the same triggers
+	expanded, _, err := expandBuildDefinition(resourceData)
+	require.Nil(t, err)
+	var expandedTriggers []interface{}
+	raw, _ := json.Marshal(expanded.Triggers)
+	json.Unmarshal(raw, &expandedTriggers)
+	require.Equal(t, triggers, expandedTriggers)
+}
+
+// verifies that schedules are read in the order in which they are configured, regardless of the order returned by the service
+func TestAzureDevOpsBuildDefinition_Flatten_KeepsOrderOfSchedules(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, resourceBuildDefinition().Schema, map[string]interface{}{
+		"schedules": []interface{}{
+			map[string]interface{}{"days_to_build": []interface{}{"Saturday", "Sunday"}, "start_hours": 8},
+			map[string]interface{}{"days_to_build": []interface{}{"Monday", "Friday"}, "start_hours": 22, "time_zone": "Pacific Standard Time"},
+		},
+	})
+
+	expanded, err := expandBuildDefinitionTriggers(resourceData)
+	require.Nil(t, err)
+	require.Len(t, expanded, 1)
+	schedules := expanded[0].(map[string]interface{})["schedules"].([]interface{})
+	require.Equal(t, 96, schedules[0].(map[string]interface{})["daysToBuild"])
+	require.Equal(t, 17, schedules[1].(map[string]interface{})["daysToBuild"])
+
+	var triggers []interface{}
+	err = json.Unmarshal([]byte(`[{"triggerType": "schedule", "schedules": [
+		{"branchFilters": ["+master"], "daysToBuild": 17, "scheduleJobId": "5d8e4dfa-6a4d-4e0f-9c2b-2a1a4a9e3c11", "scheduleOnlyWithChanges": true, "startHours": 22, "startMinutes": 0, "timeZoneId": "Pacific Standard Time"},
+		{"branchFilters": [], "daysToBuild": "saturday, sunday", "scheduleJobId": "0f1c3a52-8a7d-4c4e-9f3e-7a2b1c5d6e70", "scheduleOnlyWithChanges": true, "startHours": 8, "startMinutes": 0, "timeZoneId": "UTC"}
+	]}]`), &triggers)
+	require.Nil(t, err)
+
+	definition := testBuildDefinition
+	definition.Triggers = &triggers
+	err = flattenBuildDefinition(resourceData, &definition, testProjectID, nil)
+	require.Nil(t, err)
+
+	require.Equal(t, 2, resourceData.Get("schedules.#"))
+	require.Equal(t, 8, resourceData.Get("schedules.0.start_hours"))
+	require.ElementsMatch(t, []interface{}{"Saturday", "Sunday"}, resourceData.Get("schedules.0.days_to_build").(*schema.Set).List())
+	require.Equal(t, "0f1c3a52-8a7d-4c4e-9f3e-7a2b1c5d6e70", resourceData.Get("schedules.0.schedule_job_id"))
+	require.Equal(t, 22, resourceData.Get("schedules.1.start_hours"))
+	require.ElementsMatch(t, []interface{}{"Monday", "Friday"}, resourceData.Get("schedules.1.days_to_build").(*schema.Set).List())
+	require.Equal(t, []interface{}{"master"}, resourceData.Get("schedules.1.branch_filter.0.include"))
+
+	// once the job IDs are known, they are used to match the schedules
+	reversed := []interface{}{triggers[0].(map[string]interface{})}
+	schedulesFromService := reversed[0].(map[string]interface{})["schedules"].([]interface{})
+	schedulesFromService[0], schedulesFromService[1] = schedulesFromService[1], schedulesFromService[0]
+	definition.Triggers = &reversed
+	err = flattenBuildDefinition(resourceData, &definition, testProjectID, nil)
+	require.Nil(t, err)
+	require.Equal(t, "0f1c3a52-8a7d-4c4e-9f3e-7a2b1c5d6e70", resourceData.Get("schedules.0.schedule_job_id"))
+	require.Equal(t, "5d8e4dfa-6a4d-4e0f-9c2b-2a1a4a9e3c11", resourceData.Get("schedules.1.schedule_job_id"))
+}
+
+// verifies that secret variables keep the hash of their configured value, as the service never returns secret values
+func TestAzureDevOpsBuildDefinition_Flatten_StoresHashOfSecretVariables(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, resourceBuildDefinition().Schema, map[string]interface{}{
+		"variable": []interface{}{
+			map[string]interface{}{"name": "SECRET", "secret_value": "s3cr3t", "is_secret": true},
+			map[string]interface{}{"name": "FOO", "value": "bar"},
+		},
+	})
+
+	variables := *expandBuildDefinitionVariables(resourceData)
+	require.Equal(t, "s3cr3t", *variables["SECRET"].Value)
+	require.Equal(t, "bar", *variables["FOO"].Value)
+
+	definition := testBuildDefinition
+	definition.Variables = &map[string]build.BuildDefinitionVariable{
+		"FOO":    {Value: converter.String("bar"), IsSecret: converter.Bool(false), AllowOverride: converter.Bool(true)},
+		"SECRET": {IsSecret: converter.Bool(true), AllowOverride: converter.Bool(true)},
+		"OTHER":  {Value: converter.String("set elsewhere")},
+	}
+	err := flattenBuildDefinition(resourceData, &definition, testProjectID, nil)
+	require.Nil(t, err)
+
+	require.Equal(t, 3, resourceData.Get("variable.#"))
+	require.Equal(t, "SECRET", resourceData.Get("variable.0.name"))
+	require.Equal(t, true, resourceData.Get("variable.0.is_secret"))
+	hash := resourceData.Get("variable.0.secret_value_hash").(string)
+	require.Nil(t, bcrypt.CompareHashAndPassword([]byte(hash), []byte("s3cr3t")))
+	require.Equal(t, "FOO", resourceData.Get("variable.1.name"))
+	require.Equal(t, "bar", resourceData.Get("variable.1.value"))
+	require.Equal(t, "OTHER", resourceData.Get("variable.2.name"))
+}
+
+// verifies that a variable can either have a value or a secret value, but not both
+func TestAzureDevOpsBuildDefinition_CustomizeDiff_ValueAndSecretValueConflict(t *testing.T) {
+	invalidVariables := []map[string]interface{}{
+		{"name": "A", "value": "plain", "secret_value": "secret", "is_secret": true},
+		{"name": "A", "value": "plain", "is_secret": true},
+		{"name": "A", "secret_value": "secret", "is_secret": false},
+	}
+
+	diffWithVariable := func(variable map[string]interface{}) error {
+		_, err := resourceBuildDefinition().Diff(nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+			"project_id": testProjectID,
+			"repository": []interface{}{map[string]interface{}{"yml_path": "a.yml", "repo_name": "repo", "repo_type": "TfsGit"}},
+			"variable":   []interface{}{variable},
+		}), nil)
+		return err
+	}
+
+	for _, variable := range invalidVariables {
+		require.NotNil(t, diffWithVariable(variable), "Expected an error for variable %v", variable)
+	}
+	require.Nil(t, diffWithVariable(map[string]interface{}{"name": "A", "secret_value": "secret", "is_secret": true}))
+	require.Nil(t, diffWithVariable(map[string]interface{}{"name": "A", "value": "plain"}))
+}
+
+// verifies that a trigger that is not managed through the YAML file needs an override block
+func TestAzureDevOpsBuildDefinition_Expand_TriggerRequiresOverrideWithoutYaml(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, resourceBuildDefinition().Schema, nil)
+	flattenBuildDefinition(resourceData, &testBuildDefinition, testProjectID, nil)
+	resourceData.Set("ci_trigger", []interface{}{map[string]interface{}{"use_yaml": false}})
+
+	_, _, err := expandBuildDefinition(resourceData)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "ci_trigger.override must be set")
+}
+
+// verifies that a GitHub repository without a service connection is rejected at plan time
+func TestAzureDevOpsBuildDefinition_CustomizeDiff_GitHubRequiresServiceConnection(t *testing.T) {
+	diffWithRepository := func(repoType string, serviceConnectionID string) error {
+		_, err := resourceBuildDefinition().Diff(nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+			"project_id": testProjectID,
+			"repository": []interface{}{map[string]interface{}{
+				"yml_path":              "a.yml",
+				"repo_name":             "org/repo",
+				"repo_type":             repoType,
+				"service_connection_id": serviceConnectionID,
+			}},
+		}), nil)
+		return err
+	}
+
+	err := diffWithRepository(repoTypeGitHub, "")
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "service_connection_id must be set")
+
+	require.Nil(t, diffWithRepository(repoTypeGitHub, "ServiceConnectionID"))
+	require.Nil(t, diffWithRepository(repoTypeTfsGit, ""))
+	// the ID of a service connection that is created in the same apply is unknown at plan time
+	require.Nil(t, diffWithRepository(repoTypeGitHub, "74D93920-ED26-11E3-AC10-0800200C9A66"))
+}
+
+// verifies that the service connection of a GitHub repository is sent as a repository property
+func TestAzureDevOpsBuildDefinition_Expand_GitHubServiceConnectionIsRepositoryProperty(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, resourceBuildDefinition().Schema, nil)
+	flattenBuildDefinition(resourceData, &testBuildDefinition, testProjectID, nil)
+
+	buildDefinition, _, err := expandBuildDefinition(resourceData)
+	require.Nil(t, err)
+	require.Equal(t, "ServiceConnectionID", (*buildDefinition.Repository.Properties)[repositoryPropertyConnectedServiceID])
+	require.Equal(t, "https://github.com/RepoId.git", *buildDefinition.Repository.Url)
+}
+
+// verifies that repositories without any properties, e.g. TfsGit repositories, can be flattened
+func TestAzureDevOpsBuildDefinition_Flatten_RepositoryWithoutProperties(t *testing.T) {
+	definition := testBuildDefinition
+	definition.Repository = &build.BuildRepository{
+		Id:            converter.String("RepoId"),
+		Name:          converter.String("RepoName"),
+		DefaultBranch: converter.String("master"),
+		Type:          converter.String(repoTypeTfsGit),
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceBuildDefinition().Schema, nil)
+	err := flattenBuildDefinition(resourceData, &definition, testProjectID, nil)
+	require.Nil(t, err)
+
+	repository := resourceData.Get("repository").(*schema.Set).List()[0].(map[string]interface{})
+	require.Equal(t, repoTypeTfsGit, repository["repo_type"])
+	require.Equal(t, "", repository["service_connection_id"])
+}
+
 // verifies that an expand will fail if there is insufficient configuration data found in the resource
 func TestAzureDevOpsBuildDefinition_Expand_FailsIfNotEnoughData(t *testing.T) {
 	resourceData := schema.TestResourceDataRaw(t, resourceBuildDefinition().Schema, nil)
@@ -93,7 +333,7 @@ func TestAzureDevOpsBuildDefinition_Create_DoesNotSwallowError(t *testing.T) {
 	defer ctrl.Finish()
 
 	resourceData := schema.TestResourceDataRaw(t, resourceBuildDefinition().Schema, nil)
-	flattenBuildDefinition(resourceData, &testBuildDefinition, testProjectID)
+	flattenBuildDefinition(resourceData, &testBuildDefinition, testProjectID, nil)
 
 	buildClient := azdosdkmocks.NewMockBuildClient(ctrl)
 	clients := &aggregatedClient{BuildClient: buildClient, ctx: context.Background()}
@@ -115,7 +355,7 @@ func TestAzureDevOpsBuildDefinition_Read_DoesNotSwallowError(t *testing.T) {
 	defer ctrl.Finish()
 
 	resourceData := schema.TestResourceDataRaw(t, resourceBuildDefinition().Schema, nil)
-	flattenBuildDefinition(resourceData, &testBuildDefinition, testProjectID)
+	flattenBuildDefinition(resourceData, &testBuildDefinition, testProjectID, nil)
 
 	buildClient := azdosdkmocks.NewMockBuildClient(ctrl)
 	clients := &aggregatedClient{BuildClient: buildClient, ctx: context.Background()}
@@ -137,7 +377,7 @@ func TestAzureDevOpsBuildDefinition_Delete_DoesNotSwallowError(t *testing.T) {
 	defer ctrl.Finish()
 
 	resourceData := schema.TestResourceDataRaw(t, resourceBuildDefinition().Schema, nil)
-	flattenBuildDefinition(resourceData, &testBuildDefinition, testProjectID)
+	flattenBuildDefinition(resourceData, &testBuildDefinition, testProjectID, nil)
 
 	buildClient := azdosdkmocks.NewMockBuildClient(ctrl)
 	clients := &aggregatedClient{BuildClient: buildClient, ctx: context.Background()}
@@ -159,7 +399,7 @@ func TestAzureDevOpsBuildDefinition_Update_DoesNotSwallowError(t *testing.T) {
 	defer ctrl.Finish()
 
 	resourceData := schema.TestResourceDataRaw(t, resourceBuildDefinition().Schema, nil)
-	flattenBuildDefinition(resourceData, &testBuildDefinition, testProjectID)
+	flattenBuildDefinition(resourceData, &testBuildDefinition, testProjectID, nil)
 
 	buildClient := azdosdkmocks.NewMockBuildClient(ctrl)
 	clients := &aggregatedClient{BuildClient: buildClient, ctx: context.Background()}
@@ -180,6 +420,164 @@ func TestAzureDevOpsBuildDefinition_Update_DoesNotSwallowError(t *testing.T) {
 	require.Equal(t, "UpdateDefinition() Failed", err.Error())
 }
 
+// verifies that the retention block round trips through the retention rules of the definition
+func TestAzureDevOpsBuildDefinition_ExpandFlatten_RetentionRoundtrip(t *testing.T) {
+	retention := []interface{}{map[string]interface{}{
+		"days_to_keep":        30,
+		"minimum_to_keep":     5,
+		"delete_build_record": true,
+		"delete_test_results": false,
+	}}
+
+	rules := expandBuildDefinitionRetention(retention)
+	require.Len(t, *rules, 1)
+	require.Equal(t, []string{"+refs/heads/*"}, *(*rules)[0].Branches)
+	require.Equal(t, 30, *(*rules)[0].DaysToKeep)
+	require.Equal(t, 5, *(*rules)[0].MinimumToKeep)
+	require.Equal(t, retention, flattenBuildDefinitionRetention(rules))
+
+	require.Equal(t, &[]build.RetentionPolicy{}, expandBuildDefinitionRetention(nil))
+}
+
+// verifies that retention rules added outside of Terraform are not dropped on read
+func TestAzureDevOpsBuildDefinition_Flatten_KeepsAllRetentionRules(t *testing.T) {
+	rules := []build.RetentionPolicy{
+		{DaysToKeep: converter.Int(10), MinimumToKeep: converter.Int(1)},
+		{DaysToKeep: converter.Int(20), MinimumToKeep: converter.Int(2), DeleteBuildRecord: converter.Bool(true)},
+	}
+
+	retention := flattenBuildDefinitionRetention(&rules)
+	require.Len(t, retention, 2)
+	require.Equal(t, 20, retention[1].(map[string]interface{})["days_to_keep"])
+	require.Equal(t, true, retention[1].(map[string]interface{})["delete_build_record"])
+	require.Equal(t, false, retention[0].(map[string]interface{})["delete_test_results"])
+}
+
+// verifies that retention rules exceeding the maximum retention policy of the organization are rejected
+func TestAzureDevOpsBuildDefinition_Create_ValidatesMaximumRetention(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	resourceData := schema.TestResourceDataRaw(t, resourceBuildDefinition().Schema, nil)
+	flattenBuildDefinition(resourceData, &testBuildDefinition, testProjectID, nil)
+	resourceData.Set("retention", []interface{}{map[string]interface{}{
+		"days_to_keep":        30,
+		"minimum_to_keep":     100,
+		"delete_build_record": true,
+		"delete_test_results": true,
+	}})
+
+	buildClient := azdosdkmocks.NewMockBuildClient(ctrl)
+	clients := &aggregatedClient{BuildClient: buildClient, ctx: context.Background()}
+
+	buildClient.
+		EXPECT().
+		GetBuildSettings(clients.ctx, build.GetBuildSettingsArgs{Project: &testProjectID}).
+		Return(&build.BuildSettings{
+			MaximumRetentionPolicy: &build.RetentionPolicy{DaysToKeep: converter.Int(30), MinimumToKeep: converter.Int(50)},
+		}, nil).
+		Times(1)
+	buildClient.
+		EXPECT().
+		CreateDefinition(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	err := resourceBuildDefinitionCreate(resourceData, clients)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "minimum_to_keep 100 exceeds the maximum of 50")
+}
+
+// verifies that malformed path filters are rejected at plan time
+func TestAzureDevOpsBuildDefinition_Validate_PathFilters(t *testing.T) {
+	validPaths := []string{"/", "/src", "/src/*", `\src\app`}
+	for _, path := range validPaths {
+		_, errors := validateBuildDefinitionPathFilter(path, "include")
+		require.Empty(t, errors, "expected %q to be valid", path)
+	}
+
+	invalidPaths := []string{"", "src", "+/src", "-/docs", "*.md"}
+	for _, path := range invalidPaths {
+		_, errors := validateBuildDefinitionPathFilter(path, "include")
+		require.NotEmpty(t, errors, "expected %q to be invalid", path)
+	}
+}
+
+// verifies that the validation of path filters is part of the schema of the CI trigger
+func TestAzureDevOpsBuildDefinition_Validate_CITriggerPathFilter(t *testing.T) {
+	cfg := map[string]interface{}{
+		"project_id": testProjectID,
+		"repository": []interface{}{map[string]interface{}{
+			"repo_type": "TfsGit",
+			"repo_name": "repo",
+			"yml_path":  "azure-pipelines.yml",
+		}},
+		"ci_trigger": []interface{}{map[string]interface{}{
+			"override": []interface{}{map[string]interface{}{
+				"path_filter": []interface{}{map[string]interface{}{
+					"include": []interface{}{"+/src"},
+				}},
+			}},
+		}},
+	}
+
+	_, errs := resourceBuildDefinition().Validate(terraform.NewResourceConfigRaw(cfg))
+	require.NotEmpty(t, errs)
+	require.Contains(t, errs[0].Error(), "must not start with + or -")
+}
+
+// verifies that path filters are sent to the service with forward slashes, while branch filters are kept as is
+func TestAzureDevOpsBuildDefinition_Expand_NormalizesPathFilters(t *testing.T) {
+	filter := []interface{}{map[string]interface{}{
+		"include": []interface{}{`\\src\\app\\`, "/docs//*"},
+		"exclude": []interface{}{"/src/app/tests/"},
+	}}
+
+	require.Equal(t, []string{"+/src/app", "+/docs/*", "-/src/app/tests"}, expandBuildDefinitionPathFilter(filter))
+	require.Equal(t, []string{"+/src/app/tests/"}, expandBuildDefinitionFilter([]interface{}{map[string]interface{}{
+		"include": []interface{}{"/src/app/tests/"},
+		"exclude": []interface{}{},
+	}}))
+	require.True(t, suppressEquivalentBuildDefinitionPathFilters("", "/src/app", `\\src\\app`, nil))
+}
+
+// verifies that malformed demands are rejected at plan time
+func TestAzureDevOpsBuildDefinition_Validate_Demands(t *testing.T) {
+	validDemands := []string{"java", "Agent.OS -equals Linux", "Agent.Version  -equals 2.160.0 ", "npm -equals Visual Studio"}
+	for _, demand := range validDemands {
+		_, errors := validateBuildDefinitionDemand(demand, "demand")
+		require.Empty(t, errors, "expected %q to be valid", demand)
+	}
+
+	invalidDemands := []string{"", " ", "Agent.OS -equals", "Agent.OS Linux", "Agent.OS -exists Linux", "-equals Linux"}
+	for _, demand := range invalidDemands {
+		_, errors := validateBuildDefinitionDemand(demand, "demand")
+		require.NotEmpty(t, errors, "expected %q to be invalid", demand)
+	}
+}
+
+// verifies that demands are read back in the configured form, whether the service returns them as strings or objects
+func TestAzureDevOpsBuildDefinition_Flatten_Demands(t *testing.T) {
+	demands := []interface{}{
+		"Agent.OS  -equals Linux",
+		map[string]interface{}{"name": "Agent.Version", "value": "2.160.0"},
+		map[string]interface{}{"name": "java"},
+	}
+
+	require.Equal(t, []interface{}{"Agent.OS -equals Linux", "Agent.Version -equals 2.160.0", "java"}, flattenBuildDefinitionDemands(&demands))
+	require.True(t, suppressEquivalentBuildDefinitionDemands("", "Agent.OS -equals Linux", " Agent.OS   -equals Linux", nil))
+}
+
+// verifies that definitions without a job authorization scope are read with the default scope of the service
+func TestAzureDevOpsBuildDefinition_Flatten_DefaultsJobAuthorizationScope(t *testing.T) {
+	definition := testBuildDefinition
+	definition.JobAuthorizationScope = nil
+
+	resourceData := schema.TestResourceDataRaw(t, resourceBuildDefinition().Schema, nil)
+	flattenBuildDefinition(resourceData, &definition, testProjectID, nil)
+
+	require.Equal(t, "projectCollection", resourceData.Get("job_authorization_scope"))
+}
+
 /**
  * Begin acceptance tests
  */
@@ -218,6 +616,144 @@ func TestAccAzureDevOpsBuildDefinition_CreateAndUpdate(t *testing.T) {
 	})
 }
 
+// validates that a build definition of a GitHub repository references the GitHub service connection in AzDO. The
+// service connection authenticates with the token in the AZDO_GITHUB_SERVICE_CONNECTION_PAT environment variable.
+func TestAccAzureDevOpsBuildDefinition_GitHubServiceConnection(t *testing.T) {
+	projectName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	serviceEndpointName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	buildDefinitionName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	tfBuildDefNode := "azuredevops_build_definition.build"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			if os.Getenv("AZDO_GITHUB_SERVICE_CONNECTION_PAT") == "" {
+				t.Skip("AZDO_GITHUB_SERVICE_CONNECTION_PAT must be set for this acceptance test")
+			}
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccBuildDefinitionCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBuildDefinitionResourceGitHub(projectName, serviceEndpointName, buildDefinitionName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfBuildDefNode, "name", buildDefinitionName),
+					testAccCheckBuildDefinitionServiceConnection("azuredevops_serviceendpoint_github.serviceendpoint"),
+					testAccCheckBuildDefinitionResourceExists(buildDefinitionName),
+				),
+			},
+		},
+	})
+}
+
+// Verifies that the triggers, variables and retention of a build definition are stored in AzDO and read back without drift
+func TestAccAzureDevOpsBuildDefinition_WithTriggers(t *testing.T) {
+	projectName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	buildDefinitionName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	tfBuildDefNode := "azuredevops_build_definition.build"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccBuildDefinitionCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBuildDefinitionResourceWithTriggers(projectName, buildDefinitionName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfBuildDefNode, "ci_trigger.0.use_yaml", "false"),
+					resource.TestCheckResourceAttr(tfBuildDefNode, "ci_trigger.0.override.0.branch_filter.0.include.0", "master"),
+					resource.TestCheckResourceAttr(tfBuildDefNode, "pull_request_trigger.0.use_yaml", "true"),
+					resource.TestCheckResourceAttr(tfBuildDefNode, "pull_request_trigger.0.comment_required_setting", "NonTeamMembers"),
+					resource.TestCheckResourceAttr(tfBuildDefNode, "schedules.#", "2"),
+					resource.TestCheckResourceAttr(tfBuildDefNode, "schedules.0.start_hours", "22"),
+					resource.TestCheckResourceAttr(tfBuildDefNode, "schedules.1.schedule_only_with_changes", "false"),
+					resource.TestCheckResourceAttr(tfBuildDefNode, "variable.0.value", "plain value"),
+					resource.TestCheckResourceAttrSet(tfBuildDefNode, "variable.1.secret_value_hash"),
+					resource.TestCheckResourceAttr(tfBuildDefNode, "retention.0.days_to_keep", "10"),
+					resource.TestCheckResourceAttr(tfBuildDefNode, "job_authorization_scope", "project"),
+					resource.TestCheckResourceAttr(tfBuildDefNode, "demand.0", "Agent.OS -equals Linux"),
+					resource.TestCheckResourceAttr(tfBuildDefNode, "retention.0.delete_test_results", "false"),
+					testAccCheckBuildDefinitionResourceExists(buildDefinitionName),
+				),
+			},
+		},
+	})
+}
+
+// HCL describing an AzDO build definition with CI, pull request and scheduled triggers, variables, demands and retention
+func testAccBuildDefinitionResourceWithTriggers(projectName string, buildDefinitionName string) string {
+	buildDefinitionResource := fmt.Sprintf(`
+resource "azuredevops_build_definition" "build" {
+	project_id      = azuredevops_project.project.id
+	name            = "%s"
+	agent_pool_name = "Hosted Ubuntu 1604"
+
+	repository {
+	  repo_type             = "TfsGit"
+	  repo_name             = azuredevops_azure_git_repository.gitrepo.name
+	  branch_name           = "branch"
+	  yml_path              = "path/to/yaml"
+	}
+
+	ci_trigger {
+	  override {
+	    batch = true
+	    branch_filter {
+	      include = ["master"]
+	      exclude = ["releases/old*"]
+	    }
+	    max_concurrent_builds_per_branch = 2
+	  }
+	}
+
+	pull_request_trigger {
+	  use_yaml                 = true
+	  comment_required_setting = "NonTeamMembers"
+	  forks {
+	    enabled       = true
+	    share_secrets = false
+	  }
+	}
+
+	schedules {
+	  branch_filter {
+	    include = ["master"]
+	  }
+	  days_to_build = ["Monday", "Wednesday", "Friday"]
+	  start_hours   = 22
+	  time_zone     = "Pacific Standard Time"
+	}
+
+	schedules {
+	  days_to_build              = ["Saturday"]
+	  schedule_only_with_changes = false
+	}
+
+	variable {
+	  name  = "PLAIN"
+	  value = "plain value"
+	}
+
+	variable {
+	  name         = "SECRET"
+	  secret_value = "secret value"
+	  is_secret    = true
+	}
+
+	job_authorization_scope = "project"
+	demand                  = ["Agent.OS -equals Linux"]
+
+	retention {
+	  days_to_keep        = 10
+	  minimum_to_keep     = 1
+	  delete_test_results = false
+	}
+}`, buildDefinitionName)
+
+	gitRepoResource := testAccAzureGitRepoResource(projectName, projectName+"-repo")
+	return fmt.Sprintf("%s\n%s", gitRepoResource, buildDefinitionResource)
+}
+
 // HCL describing an AzDO build definition
 func testAccBuildDefinitionResource(projectName string, buildDefinitionName string) string {
 	buildDefinitionResource := fmt.Sprintf(`
@@ -227,15 +763,36 @@ resource "azuredevops_build_definition" "build" {
 	agent_pool_name = "Hosted Ubuntu 1604"
   
 	repository {
-	  repo_type             = "GitHub"
-	  repo_name             = "repoOrg/repoName"
+	  repo_type             = "TfsGit"
+	  repo_name             = azuredevops_azure_git_repository.gitrepo.name
 	  branch_name           = "branch"
 	  yml_path              = "path/to/yaml"
 	}
 }`, buildDefinitionName)
 
-	projectResource := testAccProjectResource(projectName)
-	return fmt.Sprintf("%s\n%s", projectResource, buildDefinitionResource)
+	gitRepoResource := testAccAzureGitRepoResource(projectName, projectName+"-repo")
+	return fmt.Sprintf("%s\n%s", gitRepoResource, buildDefinitionResource)
+}
+
+// HCL describing an AzDO build definition of a GitHub repository that is accessed through a GitHub service connection
+func testAccBuildDefinitionResourceGitHub(projectName string, serviceEndpointName string, buildDefinitionName string) string {
+	buildDefinitionResource := fmt.Sprintf(`
+resource "azuredevops_build_definition" "build" {
+	project_id      = azuredevops_project.project.id
+	name            = "%s"
+	agent_pool_name = "Hosted Ubuntu 1604"
+
+	repository {
+	  repo_type             = "GitHub"
+	  repo_name             = "microsoft/terraform-provider-azuredevops"
+	  branch_name           = "master"
+	  yml_path              = "azure-pipelines.yml"
+	  service_connection_id = azuredevops_serviceendpoint_github.serviceendpoint.id
+	}
+}`, buildDefinitionName)
+
+	serviceEndpointResource := testAccServiceEndpointGitHubResource(projectName, serviceEndpointName)
+	return fmt.Sprintf("%s\n%s", serviceEndpointResource, buildDefinitionResource)
 }
 
 // Given the name of an AzDO build definition, this will return a function that will check whether
@@ -260,6 +817,35 @@ func testAccCheckBuildDefinitionResourceExists(expectedName string) resource.Tes
 	}
 }
 
+// verifies that the repository of the build definition in AzDO references the given service endpoint
+func testAccCheckBuildDefinitionServiceConnection(serviceEndpointNode string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		buildDef, ok := s.RootModule().Resources["azuredevops_build_definition.build"]
+		if !ok {
+			return fmt.Errorf("Did not find a build definition in the TF state")
+		}
+		serviceEndpoint, ok := s.RootModule().Resources[serviceEndpointNode]
+		if !ok {
+			return fmt.Errorf("Did not find a service endpoint in the TF state")
+		}
+
+		buildDefinition, err := getBuildDefinitionFromResource(buildDef)
+		if err != nil {
+			return err
+		}
+
+		serviceConnectionID := ""
+		if buildDefinition.Repository.Properties != nil {
+			serviceConnectionID = (*buildDefinition.Repository.Properties)[repositoryPropertyConnectedServiceID]
+		}
+		if serviceConnectionID != serviceEndpoint.Primary.ID {
+			return fmt.Errorf("Build Definition repository has service connection %s, but expected %s", serviceConnectionID, serviceEndpoint.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
 // verifies that all build definitions referenced in the state are destroyed. This will be invoked
 // *after* terrafform destroys the resource but *before* the state is wiped clean.
 func testAccBuildDefinitionCheckDestroy(s *terraform.State) error {
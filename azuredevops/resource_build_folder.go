@@ -0,0 +1,171 @@
+package azuredevops
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/build"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/azdoerror"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+)
+
+func resourceBuildFolder() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBuildFolderCreate,
+		Read:   resourceBuildFolderRead,
+		Update: resourceBuildFolderUpdate,
+		Delete: resourceBuildFolderDelete,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"path": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validateBuildFolderPath,
+				DiffSuppressFunc: suppressEquivalentBuildFolderPaths,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "",
+			},
+		},
+	}
+}
+
+func resourceBuildFolderCreate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	projectID := d.Get("project_id").(string)
+	path := normalizeBuildFolderPath(d.Get("path").(string))
+
+	folder, err := clients.BuildClient.CreateFolder(clients.ctx, build.CreateFolderArgs{
+		Folder:  expandBuildFolder(d),
+		Project: &projectID,
+		Path:    &path,
+	})
+	if err != nil {
+		return fmt.Errorf("Error creating build folder %s in project %s. Error: %v", path, projectID, err)
+	}
+
+	d.SetId(converter.ToString(folder.Path, path))
+	return resourceBuildFolderRead(d, m)
+}
+
+// Folders are identified by their path only, so a folder that has been moved or renamed outside of
+// Terraform can no longer be found and is removed from the state
+func resourceBuildFolderRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	projectID := d.Get("project_id").(string)
+	path := d.Id()
+
+	folders, err := clients.BuildClient.GetFolders(clients.ctx, build.GetFoldersArgs{
+		Project: &projectID,
+		Path:    &path,
+	})
+	if err != nil {
+		if azdoerror.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error looking up build folder %s in project %s. Error: %v", path, projectID, err)
+	}
+
+	folder := findBuildFolder(folders, path)
+	if folder == nil {
+		d.SetId("")
+		return nil
+	}
+
+	d.SetId(converter.ToString(folder.Path, path))
+	d.Set("path", converter.ToString(folder.Path, path))
+	d.Set("description", converter.ToString(folder.Description, ""))
+	return nil
+}
+
+// Moves or renames the folder if its path has changed
+func resourceBuildFolderUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	projectID := d.Get("project_id").(string)
+	path := d.Id()
+
+	folder, err := clients.BuildClient.UpdateFolder(clients.ctx, build.UpdateFolderArgs{
+		Folder:  expandBuildFolder(d),
+		Project: &projectID,
+		Path:    &path,
+	})
+	if err != nil {
+		return fmt.Errorf("Error updating build folder %s in project %s. Error: %v", path, projectID, err)
+	}
+
+	d.SetId(converter.ToString(folder.Path, normalizeBuildFolderPath(d.Get("path").(string))))
+	return resourceBuildFolderRead(d, m)
+}
+
+func resourceBuildFolderDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	projectID := d.Get("project_id").(string)
+	path := d.Id()
+
+	err := clients.BuildClient.DeleteFolder(clients.ctx, build.DeleteFolderArgs{
+		Project: &projectID,
+		Path:    &path,
+	})
+	if err != nil {
+		return fmt.Errorf("Error deleting build folder %s in project %s. Error: %v", path, projectID, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// Returns the folder with the given path. Listing a folder also returns all of its subfolders.
+func findBuildFolder(folders *[]build.Folder, path string) *build.Folder {
+	if folders == nil {
+		return nil
+	}
+	for _, folder := range *folders {
+		if strings.EqualFold(normalizeBuildFolderPath(converter.ToString(folder.Path, "")), normalizeBuildFolderPath(path)) {
+			return &folder
+		}
+	}
+	return nil
+}
+
+// Normalizes a folder path to the form used by the service, i.e. \a\b. Both forward slashes and
+// backslashes are accepted as separators, and duplicate and trailing separators are ignored.
+func normalizeBuildFolderPath(path string) string {
+	segments := strings.FieldsFunc(path, func(r rune) bool {
+		return r == '\\' || r == '/'
+	})
+	return `\` + strings.Join(segments, `\`)
+}
+
+// Folder names are not case sensitive
+func suppressEquivalentBuildFolderPaths(k, old, new string, d *schema.ResourceData) bool {
+	return strings.EqualFold(normalizeBuildFolderPath(old), normalizeBuildFolderPath(new))
+}
+
+func validateBuildFolderPath(i interface{}, k string) ([]string, []error) {
+	path, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %q to be string", k)}
+	}
+	if normalizeBuildFolderPath(path) == `\` {
+		return nil, []error{fmt.Errorf("%q must not be the root folder, got %q", k, path)}
+	}
+	return nil, nil
+}
+
+// Convert internal Terraform data structure to an AzDO data structure
+func expandBuildFolder(d *schema.ResourceData) *build.Folder {
+	return &build.Folder{
+		Path:        converter.String(normalizeBuildFolderPath(d.Get("path").(string))),
+		Description: converter.String(d.Get("description").(string)),
+	}
+}
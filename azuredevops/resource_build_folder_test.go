@@ -0,0 +1,222 @@
+package azuredevops
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/build"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var testBuildFolderProjectID = "project-id"
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that folder paths are normalized to the form used by the service
+func TestAzureDevOpsBuildFolder_NormalizePath(t *testing.T) {
+	tests := map[string]string{
+		`CI`:          `\CI`,
+		`\CI`:         `\CI`,
+		`\CI\`:        `\CI`,
+		`CI/Release`:  `\CI\Release`,
+		`\\CI\\\Rel`:  `\CI\Rel`,
+		`/CI/Release`: `\CI\Release`,
+		``:            `\`,
+	}
+
+	for path, expected := range tests {
+		require.Equal(t, expected, normalizeBuildFolderPath(path), path)
+	}
+
+	require.True(t, suppressEquivalentBuildFolderPaths("path", `\CI`, "ci", nil))
+	require.False(t, suppressEquivalentBuildFolderPaths("path", `\CI`, `\CI\Release`, nil))
+
+	_, errs := validateBuildFolderPath(`\\`, "path")
+	require.Len(t, errs, 1)
+}
+
+// verifies that a folder is created at its normalized path
+func TestAzureDevOpsBuildFolder_Create_NormalizesPath(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	buildClient := azdosdkmocks.NewMockBuildClient(ctrl)
+	clients := &aggregatedClient{BuildClient: buildClient, ctx: context.Background()}
+
+	resourceData := createBuildFolderResourceData(t, "CI//Release")
+
+	folder := build.Folder{Path: converter.String(`\CI\Release`), Description: converter.String("Description")}
+	buildClient.
+		EXPECT().
+		CreateFolder(clients.ctx, build.CreateFolderArgs{
+			Folder:  &folder,
+			Project: &testBuildFolderProjectID,
+			Path:    converter.String(`\CI\Release`),
+		}).
+		Return(&folder, nil).
+		Times(1)
+	expectGetBuildFolders(buildClient, `\CI\Release`, folder)
+
+	err := resourceBuildFolderCreate(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, `\CI\Release`, resourceData.Id())
+	require.Equal(t, `\CI\Release`, resourceData.Get("path"))
+}
+
+// verifies that a folder which has been moved or renamed is removed from the state
+func TestAzureDevOpsBuildFolder_Read_ClearsIDOfMovedFolder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	buildClient := azdosdkmocks.NewMockBuildClient(ctrl)
+	clients := &aggregatedClient{BuildClient: buildClient, ctx: context.Background()}
+
+	resourceData := createBuildFolderResourceData(t, `\CI`)
+	resourceData.SetId(`\CI`)
+
+	// listing a folder that no longer exists may still return folders that share its prefix
+	expectGetBuildFolders(buildClient, `\CI`, build.Folder{Path: converter.String(`\CI-Old`)})
+
+	err := resourceBuildFolderRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "", resourceData.Id())
+}
+
+// verifies that a folder is moved by updating it at its previous path
+func TestAzureDevOpsBuildFolder_Update_MovesFolder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	buildClient := azdosdkmocks.NewMockBuildClient(ctrl)
+	clients := &aggregatedClient{BuildClient: buildClient, ctx: context.Background()}
+
+	resourceData := createBuildFolderResourceData(t, `\Pipelines\CI`)
+	resourceData.SetId(`\CI`)
+
+	folder := build.Folder{Path: converter.String(`\Pipelines\CI`), Description: converter.String("Description")}
+	buildClient.
+		EXPECT().
+		UpdateFolder(clients.ctx, build.UpdateFolderArgs{
+			Folder:  &folder,
+			Project: &testBuildFolderProjectID,
+			Path:    converter.String(`\CI`),
+		}).
+		Return(&folder, nil).
+		Times(1)
+	expectGetBuildFolders(buildClient, `\Pipelines\CI`, folder)
+
+	err := resourceBuildFolderUpdate(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, `\Pipelines\CI`, resourceData.Id())
+}
+
+// verifies that if an error is produced on delete, the error is not swallowed
+func TestAzureDevOpsBuildFolder_Delete_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	buildClient := azdosdkmocks.NewMockBuildClient(ctrl)
+	clients := &aggregatedClient{BuildClient: buildClient, ctx: context.Background()}
+
+	resourceData := createBuildFolderResourceData(t, `\CI`)
+	resourceData.SetId(`\CI`)
+
+	buildClient.
+		EXPECT().
+		DeleteFolder(clients.ctx, build.DeleteFolderArgs{Project: &testBuildFolderProjectID, Path: converter.String(`\CI`)}).
+		Return(errors.New("DeleteFolder() Failed")).
+		Times(1)
+
+	err := resourceBuildFolderDelete(resourceData, clients)
+	require.Contains(t, err.Error(), "DeleteFolder() Failed")
+}
+
+func createBuildFolderResourceData(t *testing.T, path string) *schema.ResourceData {
+	return schema.TestResourceDataRaw(t, resourceBuildFolder().Schema, map[string]interface{}{
+		"project_id":  testBuildFolderProjectID,
+		"path":        path,
+		"description": "Description",
+	})
+}
+
+func expectGetBuildFolders(buildClient *azdosdkmocks.MockBuildClient, path string, folders ...build.Folder) *gomock.Call {
+	return buildClient.
+		EXPECT().
+		GetFolders(gomock.Any(), build.GetFoldersArgs{Project: &testBuildFolderProjectID, Path: &path}).
+		Return(&folders, nil).
+		Times(1)
+}
+
+/**
+ * Begin acceptance tests
+ */
+
+// Verifies that a build folder can be created and moved
+func TestAccAzureDevOpsBuildFolder_CreateAndUpdate(t *testing.T) {
+	projectName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	tfNode := "azuredevops_build_folder.folder"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccBuildFolderCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBuildFolderResource(projectName, "CI"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "path", `\CI`),
+				),
+			}, {
+				Config: testAccBuildFolderResource(projectName, "Pipelines/CI"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "path", `\Pipelines\CI`),
+				),
+			},
+		},
+	})
+}
+
+// HCL describing a build folder
+func testAccBuildFolderResource(projectName string, path string) string {
+	folderResource := fmt.Sprintf(`
+resource "azuredevops_build_folder" "folder" {
+	project_id  = azuredevops_project.project.id
+	path        = "%s"
+	description = "Managed by Terraform"
+}`, path)
+
+	projectResource := testAccProjectResource(projectName)
+	return fmt.Sprintf("%s\n%s", projectResource, folderResource)
+}
+
+// verifies that all build folders referenced in the state are destroyed
+func testAccBuildFolderCheckDestroy(s *terraform.State) error {
+	clients := testAccProvider.Meta().(*aggregatedClient)
+
+	for _, res := range s.RootModule().Resources {
+		if res.Type != "azuredevops_build_folder" {
+			continue
+		}
+
+		projectID := res.Primary.Attributes["project_id"]
+		folders, err := clients.BuildClient.GetFolders(clients.ctx, build.GetFoldersArgs{
+			Project: &projectID,
+			Path:    &res.Primary.ID,
+		})
+		if err == nil && findBuildFolder(folders, res.Primary.ID) != nil {
+			return fmt.Errorf("Build folder %s should not exist", res.Primary.ID)
+		}
+	}
+	return nil
+}
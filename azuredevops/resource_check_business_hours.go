@@ -0,0 +1,53 @@
+package azuredevops
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/pipelinechecks"
+)
+
+// Unlike azuredevops_environment_check, a business hours check can also be configured on a service
+// connection or a variable group. The attributes of the business hours are part of the resource itself.
+func resourceCheckBusinessHours() *schema.Resource {
+	r := genBaseCheckResource(flattenCheckBusinessHours, expandCheckBusinessHours, targetResourceCheckTarget)
+	r.Importer = &schema.ResourceImporter{
+		State: genCheckImportFunc(targetResourceCheckTarget, pipelinechecks.TaskCheckTypeID),
+	}
+
+	for key, elem := range businessHoursSchema() {
+		r.Schema[key] = elem
+	}
+	return r
+}
+
+// Convert internal Terraform data structure to an AzDO data structure
+func expandCheckBusinessHours(d *schema.ResourceData, clients *aggregatedClient) (*pipelinechecks.CheckType, interface{}, error) {
+	checkType := &pipelinechecks.CheckType{
+		Id:   &pipelinechecks.TaskCheckTypeID,
+		Name: converter.String("Task Check"),
+	}
+	return checkType, expandBusinessHours(map[string]interface{}{
+		"days":       d.Get("days"),
+		"start_time": d.Get("start_time"),
+		"end_time":   d.Get("end_time"),
+		"time_zone":  d.Get("time_zone"),
+	}), nil
+}
+
+// Convert AzDO data structure to internal Terraform data structure
+func flattenCheckBusinessHours(d *schema.ResourceData, check *pipelinechecks.CheckConfiguration, clients *aggregatedClient) error {
+	if !isCheckOfType(check, pipelinechecks.TaskCheckTypeID) {
+		return fmt.Errorf("Check with ID (%s) is not a business hours check", d.Id())
+	}
+
+	businessHours, err := flattenBusinessHours(d, check)
+	if err != nil {
+		return err
+	}
+	for key, value := range businessHours {
+		d.Set(key, value)
+	}
+	return nil
+}
@@ -0,0 +1,178 @@
+package azuredevops
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/pipelinechecks"
+	"github.com/stretchr/testify/require"
+)
+
+var testCheckVariableGroupID = "42"
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that a business hours check is configured on the target resource and flattened back
+func TestAzureDevOpsCheckBusinessHours_ExpandFlatten_Roundtrip(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, resourceCheckBusinessHours().Schema, map[string]interface{}{
+		"project_id": testApprovalProjectID,
+		"target_resource": []interface{}{map[string]interface{}{
+			"type": "variablegroup",
+			"id":   testCheckVariableGroupID,
+		}},
+		"days":       []interface{}{"Tuesday", "Monday"},
+		"start_time": "08:00",
+		"end_time":   "17:30",
+		"time_zone":  "UTC",
+	})
+
+	check, projectID, err := doEnvironmentCheckExpansion(resourceData, nil, expandCheckBusinessHours, targetResourceCheckTarget)
+	require.Nil(t, err)
+	require.Equal(t, testApprovalProjectID, *projectID)
+	require.Equal(t, &pipelinechecks.Resource{Type: converter.String("variablegroup"), Id: &testCheckVariableGroupID}, check.Resource)
+	require.Equal(t, "Monday,Tuesday", check.Settings.(taskCheckSettings).Inputs["businessDays"])
+
+	check.Id = &testEnvironmentCheckID
+	flattenedData := schema.TestResourceDataRaw(t, resourceCheckBusinessHours().Schema, nil)
+	err = doBaseEnvironmentCheckFlattening(flattenedData, check, projectID, targetResourceCheckTarget)
+	require.Nil(t, err)
+	err = flattenCheckBusinessHours(flattenedData, check, nil)
+	require.Nil(t, err)
+	require.Equal(t, "variablegroup", flattenedData.Get("target_resource.0.type"))
+	require.Equal(t, testCheckVariableGroupID, flattenedData.Get("target_resource.0.id"))
+	require.Equal(t, resourceData.Get("days").(*schema.Set).List(), flattenedData.Get("days").(*schema.Set).List())
+	require.Equal(t, "08:00", flattenedData.Get("start_time"))
+	require.Equal(t, "17:30", flattenedData.Get("end_time"))
+	require.Equal(t, "UTC", flattenedData.Get("time_zone"))
+}
+
+// verifies that settings edited outside of Terraform are read in the form used by the schema
+func TestAzureDevOpsCheckBusinessHours_Flatten_NormalizesSettings(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, resourceCheckBusinessHours().Schema, nil)
+	check := &pipelinechecks.CheckConfiguration{
+		Id:   &testEnvironmentCheckID,
+		Type: &pipelinechecks.CheckType{Id: &pipelinechecks.TaskCheckTypeID},
+		Settings: map[string]interface{}{
+			"definitionRef": map[string]interface{}{"id": businessHoursTaskID.String(), "name": "evaluatebusinesshours"},
+			"inputs": map[string]interface{}{
+				"businessDays": "monday, FRIDAY",
+				"startTime":    "8:00",
+				"endTime":      "17:05",
+				"timeZone":     "UTC",
+			},
+		},
+	}
+
+	err := flattenCheckBusinessHours(resourceData, check, nil)
+	require.Nil(t, err)
+	require.ElementsMatch(t, []interface{}{"Monday", "Friday"}, resourceData.Get("days").(*schema.Set).List())
+	require.Equal(t, "08:00", resourceData.Get("start_time"))
+	require.Equal(t, "17:05", resourceData.Get("end_time"))
+}
+
+// verifies that a check of another type is reported instead of being misread as business hours
+func TestAzureDevOpsCheckBusinessHours_Flatten_RejectsOtherCheckTypes(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, resourceCheckBusinessHours().Schema, nil)
+	check := &pipelinechecks.CheckConfiguration{
+		Id:       &testEnvironmentCheckID,
+		Type:     &pipelinechecks.CheckType{Id: &pipelinechecks.ExtendsCheckTypeID},
+		Settings: map[string]interface{}{"extendsChecks": []interface{}{}},
+	}
+
+	err := flattenCheckBusinessHours(resourceData, check, nil)
+	require.NotNil(t, err)
+}
+
+// verifies that checks of resources of other types than the target resource types cannot be imported
+func TestAzureDevOpsCheckBusinessHours_Import_RejectsOtherResourceTypes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	checksClient := azdosdkmocks.NewMockPipelineChecksClient(ctrl)
+	clients := &aggregatedClient{PipelineChecksClient: checksClient, ctx: context.Background()}
+
+	checksClient.
+		EXPECT().
+		GetCheckConfiguration(clients.ctx, pipelinechecks.GetCheckConfigurationArgs{Project: &testApprovalProjectID, Id: &testEnvironmentCheckID}).
+		Return(&pipelinechecks.CheckConfiguration{
+			Id:       &testEnvironmentCheckID,
+			Type:     &pipelinechecks.CheckType{Id: &pipelinechecks.TaskCheckTypeID},
+			Resource: &pipelinechecks.Resource{Type: converter.String("securefile"), Id: converter.String("1")},
+		}, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, resourceCheckBusinessHours().Schema, nil)
+	resourceData.SetId(fmt.Sprintf("%s/%d", testApprovalProjectID, testEnvironmentCheckID))
+	_, err := resourceCheckBusinessHours().Importer.State(resourceData, clients)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "is not a check of a resource of type")
+}
+
+/**
+ * Begin acceptance tests
+ */
+
+// validates that business hours can be configured on a variable group, updated and imported
+func TestAccAzureDevOpsCheckBusinessHours_CreateUpdateImport(t *testing.T) {
+	projectName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	variableGroupName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	tfNode := "azuredevops_check_business_hours.check"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccEnvironmentCheckCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckBusinessHoursResource(projectName, variableGroupName, "17:00"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfNode, "id"),
+					resource.TestCheckResourceAttr(tfNode, "target_resource.0.type", "variablegroup"),
+					resource.TestCheckResourceAttrPair(tfNode, "target_resource.0.id", "azuredevops_variable_group.vg", "id"),
+					resource.TestCheckResourceAttr(tfNode, "days.#", "2"),
+					resource.TestCheckResourceAttr(tfNode, "end_time", "17:00"),
+				),
+			}, {
+				Config: testAccCheckBusinessHoursResource(projectName, variableGroupName, "18:00"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "end_time", "18:00"),
+				),
+			}, {
+				ResourceName:      tfNode,
+				ImportStateIdFunc: testAccEnvironmentCheckImportStateID(tfNode),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// HCL describing business hours of a variable group
+func testAccCheckBusinessHoursResource(projectName string, variableGroupName string, endTime string) string {
+	checkResource := fmt.Sprintf(`
+resource "azuredevops_check_business_hours" "check" {
+	project_id = azuredevops_project.project.id
+
+	target_resource {
+		type = "variablegroup"
+		id   = azuredevops_variable_group.vg.id
+	}
+
+	days       = ["Monday", "Tuesday"]
+	start_time = "08:00"
+	end_time   = "%s"
+	time_zone  = "UTC"
+}`, endTime)
+
+	variableGroupResource := testAccVariableGroupResource(projectName, variableGroupName, true)
+	return fmt.Sprintf("%s\n%s", variableGroupResource, checkResource)
+}
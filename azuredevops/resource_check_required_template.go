@@ -0,0 +1,45 @@
+package azuredevops
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/pipelinechecks"
+)
+
+// Unlike azuredevops_environment_check, a required template check can also be configured on a service
+// connection or a variable group
+func resourceCheckRequiredTemplate() *schema.Resource {
+	r := genBaseCheckResource(flattenCheckRequiredTemplate, expandCheckRequiredTemplate, targetResourceCheckTarget)
+	r.Importer = &schema.ResourceImporter{
+		State: genCheckImportFunc(targetResourceCheckTarget, pipelinechecks.ExtendsCheckTypeID),
+	}
+
+	r.Schema["required_template"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Required:    true,
+		MinItems:    1,
+		Description: "Only lets pipelines run that extend one of the templates",
+		Elem:        requiredTemplateResource(),
+	}
+	return r
+}
+
+// Convert internal Terraform data structure to an AzDO data structure
+func expandCheckRequiredTemplate(d *schema.ResourceData, clients *aggregatedClient) (*pipelinechecks.CheckType, interface{}, error) {
+	return expandRequiredTemplates(d.Get("required_template").([]interface{}))
+}
+
+// Convert AzDO data structure to internal Terraform data structure
+func flattenCheckRequiredTemplate(d *schema.ResourceData, check *pipelinechecks.CheckConfiguration, clients *aggregatedClient) error {
+	if !isCheckOfType(check, pipelinechecks.ExtendsCheckTypeID) {
+		return fmt.Errorf("Check with ID (%s) is not a required template check", d.Id())
+	}
+
+	templates, err := flattenRequiredTemplates(check)
+	if err != nil {
+		return err
+	}
+	d.Set("required_template", templates)
+	return nil
+}
@@ -0,0 +1,159 @@
+package azuredevops
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/pipelinechecks"
+	"github.com/stretchr/testify/require"
+)
+
+var testCheckServiceEndpointID = "1d5e4ad5-8bd4-4d8e-b2b0-5a2e2fbd0b27"
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that a required template check is created on a service connection and read back afterwards
+func TestAzureDevOpsCheckRequiredTemplate_Create_ConfiguresCheckOnServiceConnection(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	checksClient := azdosdkmocks.NewMockPipelineChecksClient(ctrl)
+	clients := &aggregatedClient{PipelineChecksClient: checksClient, ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceCheckRequiredTemplate().Schema, map[string]interface{}{
+		"project_id": testApprovalProjectID,
+		"target_resource": []interface{}{map[string]interface{}{
+			"type": "endpoint",
+			"id":   testCheckServiceEndpointID,
+		}},
+		"required_template": []interface{}{map[string]interface{}{
+			"repository_name": "project/templates",
+			"repository_ref":  "refs/heads/master",
+			"template_path":   "deploy.yml",
+		}},
+	})
+
+	expectedResource := &pipelinechecks.Resource{Type: converter.String("endpoint"), Id: &testCheckServiceEndpointID}
+	expectedSettings := extendsCheckSettings{ExtendsChecks: []requiredTemplate{{
+		RepositoryType: "git",
+		RepositoryName: "project/templates",
+		RepositoryRef:  "refs/heads/master",
+		TemplatePath:   "deploy.yml",
+	}}}
+	checksClient.
+		EXPECT().
+		AddCheckConfiguration(clients.ctx, pipelinechecks.AddCheckConfigurationArgs{
+			Project: &testApprovalProjectID,
+			Configuration: &pipelinechecks.CheckConfiguration{
+				Type:     &pipelinechecks.CheckType{Id: &pipelinechecks.ExtendsCheckTypeID, Name: converter.String("ExtendsCheck")},
+				Resource: expectedResource,
+				Settings: expectedSettings,
+				Timeout:  converter.Int(checkMaxTimeout),
+			},
+		}).
+		Return(&pipelinechecks.CheckConfiguration{Id: &testEnvironmentCheckID, Resource: expectedResource}, nil).
+		Times(1)
+	checksClient.
+		EXPECT().
+		GetCheckConfiguration(clients.ctx, pipelinechecks.GetCheckConfigurationArgs{Project: &testApprovalProjectID, Id: &testEnvironmentCheckID}).
+		Return(&pipelinechecks.CheckConfiguration{
+			Id:       &testEnvironmentCheckID,
+			Type:     &pipelinechecks.CheckType{Id: &pipelinechecks.ExtendsCheckTypeID},
+			Resource: expectedResource,
+			Settings: map[string]interface{}{
+				"extendsChecks": []interface{}{map[string]interface{}{
+					"repositoryType": "Git",
+					"repositoryName": "project/templates",
+					"repositoryRef":  "refs/heads/master",
+					"templatePath":   "deploy.yml",
+				}},
+			},
+			Timeout: converter.Int(checkMaxTimeout),
+		}, nil).
+		Times(1)
+
+	err := resourceCheckRequiredTemplate().Create(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, strconv.Itoa(testEnvironmentCheckID), resourceData.Id())
+	require.Equal(t, "endpoint", resourceData.Get("target_resource.0.type"))
+	require.Equal(t, testCheckServiceEndpointID, resourceData.Get("target_resource.0.id"))
+	require.Equal(t, "git", resourceData.Get("required_template.0.repository_type"))
+	require.Equal(t, "deploy.yml", resourceData.Get("required_template.0.template_path"))
+}
+
+// verifies that a check of another type is reported instead of being misread as required templates
+func TestAzureDevOpsCheckRequiredTemplate_Flatten_RejectsOtherCheckTypes(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, resourceCheckRequiredTemplate().Schema, nil)
+	check := &pipelinechecks.CheckConfiguration{
+		Id:   &testEnvironmentCheckID,
+		Type: &pipelinechecks.CheckType{Id: &pipelinechecks.TaskCheckTypeID},
+	}
+
+	err := flattenCheckRequiredTemplate(resourceData, check, nil)
+	require.NotNil(t, err)
+}
+
+/**
+ * Begin acceptance tests
+ */
+
+// validates that a required template check can be configured on an environment and imported
+func TestAccAzureDevOpsCheckRequiredTemplate_CreateImport(t *testing.T) {
+	projectName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	environmentName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	tfNode := "azuredevops_check_required_template.check"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccEnvironmentCheckCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckRequiredTemplateResource(projectName, environmentName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfNode, "id"),
+					resource.TestCheckResourceAttr(tfNode, "target_resource.0.type", "environment"),
+					resource.TestCheckResourceAttr(tfNode, "required_template.#", "1"),
+					resource.TestCheckResourceAttr(tfNode, "required_template.0.template_path", "deploy.yml"),
+				),
+			}, {
+				ResourceName:      tfNode,
+				ImportStateIdFunc: testAccEnvironmentCheckImportStateID(tfNode),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// HCL describing a required template check of an environment
+func testAccCheckRequiredTemplateResource(projectName string, environmentName string) string {
+	checkResource := fmt.Sprintf(`
+resource "azuredevops_check_required_template" "check" {
+	project_id = azuredevops_project.project.id
+
+	target_resource {
+		type = "environment"
+		id   = azuredevops_environment.environment.id
+	}
+
+	required_template {
+		repository_name = "%s/templates"
+		repository_ref  = "refs/heads/master"
+		template_path   = "deploy.yml"
+	}
+}`, projectName)
+
+	environmentResource := testAccEnvironmentResource(projectName, environmentName, "")
+	return fmt.Sprintf("%s\n%s", environmentResource, checkResource)
+}
@@ -0,0 +1,271 @@
+package azuredevops
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/workitemtracking"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/azdoerror"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+)
+
+// classificationNodeExpandFunc converts the Terraform attributes of a specific node type into the attributes of an
+// AzDO classification node, e.g. the dates of an iteration
+type classificationNodeExpandFunc func(d *schema.ResourceData) *map[string]interface{}
+
+// classificationNodeFlatFunc converts the attributes of an AzDO classification node into the Terraform attributes
+// of a specific node type
+type classificationNodeFlatFunc func(d *schema.ResourceData, node *workitemtracking.WorkItemClassificationNode)
+
+// genBaseClassificationNodeResource creates a resource that manages the nodes of one of the classification trees
+// (areas or iterations) of a project. Callers add the schema elements specific to their node type.
+//
+// The SDK sends the IDs passed to GetClassificationNodes in a query parameter the service does not know, so nodes
+// can not be looked up by ID. A node is looked up by its path instead, and a node that has been moved or renamed
+// outside of Terraform is no longer found and will be recreated.
+func genBaseClassificationNodeResource(group workitemtracking.TreeStructureGroup, f classificationNodeFlatFunc, e classificationNodeExpandFunc) *schema.Resource {
+	return &schema.Resource{
+		Create: genClassificationNodeCreateFunc(group, f, e),
+		Read:   genClassificationNodeReadFunc(group, f),
+		Update: genClassificationNodeUpdateFunc(group, f, e),
+		Delete: genClassificationNodeDeleteFunc(group),
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"path": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          `\`,
+				DiffSuppressFunc: suppressEquivalentClassificationNodePaths,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateClassificationNodeName,
+			},
+		},
+	}
+}
+
+func genClassificationNodeCreateFunc(group workitemtracking.TreeStructureGroup, flatFunc classificationNodeFlatFunc, expandFunc classificationNodeExpandFunc) func(d *schema.ResourceData, m interface{}) error {
+	return func(d *schema.ResourceData, m interface{}) error {
+		clients := m.(*aggregatedClient)
+		projectID := d.Get("project_id").(string)
+		parentPath := splitClassificationNodePath(d.Get("path").(string))
+
+		if _, err := getClassificationNodeParent(clients, projectID, group, parentPath); err != nil {
+			return err
+		}
+
+		node, err := clients.WorkItemTrackingClient.CreateOrUpdateClassificationNode(clients.ctx, workitemtracking.CreateOrUpdateClassificationNodeArgs{
+			PostedNode: &workitemtracking.WorkItemClassificationNode{
+				Name:       converter.String(d.Get("name").(string)),
+				Attributes: expandClassificationNodeAttributes(d, expandFunc),
+			},
+			Project:        &projectID,
+			StructureGroup: &group,
+			Path:           classificationNodeRoute(parentPath),
+		})
+		if err != nil {
+			return fmt.Errorf("Error creating %s %s in project %s. Error: %v", classificationNodeKind(group), d.Get("name").(string), projectID, err)
+		}
+
+		return flattenClassificationNode(d, node, flatFunc)
+	}
+}
+
+func genClassificationNodeReadFunc(group workitemtracking.TreeStructureGroup, flatFunc classificationNodeFlatFunc) func(d *schema.ResourceData, m interface{}) error {
+	return func(d *schema.ResourceData, m interface{}) error {
+		clients := m.(*aggregatedClient)
+		projectID := d.Get("project_id").(string)
+		path := append(splitClassificationNodePath(d.Get("path").(string)), d.Get("name").(string))
+
+		node, err := clients.WorkItemTrackingClient.GetClassificationNode(clients.ctx, workitemtracking.GetClassificationNodeArgs{
+			Project:        &projectID,
+			StructureGroup: &group,
+			Path:           classificationNodeRoute(path),
+		})
+		if err != nil {
+			if azdoerror.IsNotFound(err) {
+				d.SetId("")
+				return nil
+			}
+			return fmt.Errorf("Error looking up %s %s in project %s. Error: %v", classificationNodeKind(group), joinClassificationNodePath(path), projectID, err)
+		}
+
+		return flattenClassificationNode(d, node, flatFunc)
+	}
+}
+
+// Moves the node if its parent has changed, and then updates its name and attributes
+func genClassificationNodeUpdateFunc(group workitemtracking.TreeStructureGroup, flatFunc classificationNodeFlatFunc, expandFunc classificationNodeExpandFunc) func(d *schema.ResourceData, m interface{}) error {
+	return func(d *schema.ResourceData, m interface{}) error {
+		clients := m.(*aggregatedClient)
+		projectID := d.Get("project_id").(string)
+		nodeID, err := strconv.Atoi(d.Id())
+		if err != nil {
+			return fmt.Errorf("Error parsing classification node ID %s: %+v", d.Id(), err)
+		}
+
+		oldPath, newPath := d.GetChange("path")
+		parentPath := splitClassificationNodePath(oldPath.(string))
+		if d.HasChange("path") {
+			parentPath = splitClassificationNodePath(newPath.(string))
+			if _, err := getClassificationNodeParent(clients, projectID, group, parentPath); err != nil {
+				return err
+			}
+
+			_, err = clients.WorkItemTrackingClient.CreateOrUpdateClassificationNode(clients.ctx, workitemtracking.CreateOrUpdateClassificationNodeArgs{
+				PostedNode:     &workitemtracking.WorkItemClassificationNode{Id: &nodeID},
+				Project:        &projectID,
+				StructureGroup: &group,
+				Path:           classificationNodeRoute(parentPath),
+			})
+			if err != nil {
+				return fmt.Errorf("Error moving %s %d to %s in project %s. Error: %v", classificationNodeKind(group), nodeID, joinClassificationNodePath(parentPath), projectID, err)
+			}
+		}
+
+		oldName, _ := d.GetChange("name")
+		node, err := clients.WorkItemTrackingClient.UpdateClassificationNode(clients.ctx, workitemtracking.UpdateClassificationNodeArgs{
+			PostedNode: &workitemtracking.WorkItemClassificationNode{
+				Name:       converter.String(d.Get("name").(string)),
+				Attributes: expandClassificationNodeAttributes(d, expandFunc),
+			},
+			Project:        &projectID,
+			StructureGroup: &group,
+			Path:           classificationNodeRoute(append(parentPath, oldName.(string))),
+		})
+		if err != nil {
+			return fmt.Errorf("Error updating %s %d in project %s. Error: %v", classificationNodeKind(group), nodeID, projectID, err)
+		}
+
+		return flattenClassificationNode(d, node, flatFunc)
+	}
+}
+
+// Deletes the node and all of its children. Work items that are assigned to any of the deleted nodes are moved
+// to the parent of the node.
+func genClassificationNodeDeleteFunc(group workitemtracking.TreeStructureGroup) func(d *schema.ResourceData, m interface{}) error {
+	return func(d *schema.ResourceData, m interface{}) error {
+		clients := m.(*aggregatedClient)
+		projectID := d.Get("project_id").(string)
+		parentPath := splitClassificationNodePath(d.Get("path").(string))
+		path := append(parentPath, d.Get("name").(string))
+
+		parent, err := getClassificationNodeParent(clients, projectID, group, parentPath)
+		if err != nil {
+			return err
+		}
+
+		err = clients.WorkItemTrackingClient.DeleteClassificationNode(clients.ctx, workitemtracking.DeleteClassificationNodeArgs{
+			Project:        &projectID,
+			StructureGroup: &group,
+			Path:           classificationNodeRoute(path),
+			ReclassifyId:   parent.Id,
+		})
+		if err != nil {
+			return fmt.Errorf("Error deleting %s %s in project %s. Error: %v", classificationNodeKind(group), joinClassificationNodePath(path), projectID, err)
+		}
+
+		d.SetId("")
+		return nil
+	}
+}
+
+// Looks up the parent of a node, which is the root node of the tree if the parent path is empty. Parents are
+// not created on demand, so that every node of the tree is either managed explicitly or not at all.
+func getClassificationNodeParent(clients *aggregatedClient, projectID string, group workitemtracking.TreeStructureGroup, parentPath []string) (*workitemtracking.WorkItemClassificationNode, error) {
+	parent, err := clients.WorkItemTrackingClient.GetClassificationNode(clients.ctx, workitemtracking.GetClassificationNodeArgs{
+		Project:        &projectID,
+		StructureGroup: &group,
+		Path:           classificationNodeRoute(parentPath),
+	})
+	if err != nil {
+		if azdoerror.IsNotFound(err) {
+			return nil, fmt.Errorf("The parent %s %s does not exist in project %s. Parent paths have to be created before their children", classificationNodeKind(group), joinClassificationNodePath(parentPath), projectID)
+		}
+		return nil, fmt.Errorf("Error looking up %s %s in project %s. Error: %v", classificationNodeKind(group), joinClassificationNodePath(parentPath), projectID, err)
+	}
+	return parent, nil
+}
+
+func expandClassificationNodeAttributes(d *schema.ResourceData, expandFunc classificationNodeExpandFunc) *map[string]interface{} {
+	if expandFunc == nil {
+		return nil
+	}
+	return expandFunc(d)
+}
+
+// Convert AzDO data structure to internal Terraform data structure
+func flattenClassificationNode(d *schema.ResourceData, node *workitemtracking.WorkItemClassificationNode, flatFunc classificationNodeFlatFunc) error {
+	if node == nil || node.Id == nil {
+		return fmt.Errorf("Classification node was not returned by the service")
+	}
+
+	d.SetId(strconv.Itoa(*node.Id))
+	d.Set("name", converter.ToString(node.Name, ""))
+
+	// the path of a node starts with the name of the project and the name of its tree, e.g. \Project\Area\Team
+	path := splitClassificationNodePath(converter.ToString(node.Path, ""))
+	if len(path) >= 3 {
+		d.Set("path", joinClassificationNodePath(path[2:len(path)-1]))
+	}
+
+	if flatFunc != nil {
+		flatFunc(d, node)
+	}
+	return nil
+}
+
+// Splits a path into the names of its nodes. Both forward slashes and backslashes are accepted as separators,
+// and duplicate and trailing separators are ignored.
+func splitClassificationNodePath(path string) []string {
+	return strings.FieldsFunc(path, func(r rune) bool {
+		return r == '\\' || r == '/'
+	})
+}
+
+// Joins the names of nodes to the form used by the service, i.e. \a\b
+func joinClassificationNodePath(path []string) string {
+	return `\` + strings.Join(path, `\`)
+}
+
+// Returns the path of a node as expected in the route of a request, which is empty for the root node
+func classificationNodeRoute(path []string) *string {
+	return converter.String(strings.Join(path, "/"))
+}
+
+// Node names are not case sensitive
+func suppressEquivalentClassificationNodePaths(k, old, new string, d *schema.ResourceData) bool {
+	return strings.EqualFold(joinClassificationNodePath(splitClassificationNodePath(old)), joinClassificationNodePath(splitClassificationNodePath(new)))
+}
+
+func validateClassificationNodeName(i interface{}, k string) ([]string, []error) {
+	name, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %q to be string", k)}
+	}
+	if strings.TrimSpace(name) == "" {
+		return nil, []error{fmt.Errorf("%q must not be empty", k)}
+	}
+	if strings.ContainsAny(name, `\/`) {
+		return nil, []error{fmt.Errorf("%q must not contain path separators, got %q. Use the path to nest nodes", k, name)}
+	}
+	return nil, nil
+}
+
+// Returns a human readable name for the nodes of a tree, used in error messages
+func classificationNodeKind(group workitemtracking.TreeStructureGroup) string {
+	if group == workitemtracking.TreeStructureGroupValues.Iterations {
+		return "iteration path"
+	}
+	return "area path"
+}
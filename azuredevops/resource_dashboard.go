@@ -0,0 +1,410 @@
+package azuredevops
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/dashboard"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/azdoerror"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+)
+
+func resourceDashboard() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDashboardCreate,
+		Read:   resourceDashboardRead,
+		Update: resourceDashboardUpdate,
+		Delete: resourceDashboardDelete,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"team_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "",
+			},
+			"refresh_interval": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"widget": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "",
+						},
+						"position": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"row": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"column": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+								},
+							},
+						},
+						"size": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"row_span": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"column_span": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+								},
+							},
+						},
+						"settings": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Default:          "",
+							DiffSuppressFunc: structure.SuppressJsonDiff,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceDashboardCreate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	projectID, teamID := getDashboardScope(d)
+
+	createdDashboard, err := clients.DashboardClient.CreateDashboard(clients.ctx, dashboard.CreateDashboardArgs{
+		Dashboard: expandDashboard(d, nil),
+		Project:   projectID,
+		Team:      teamID,
+	})
+	if err != nil {
+		return fmt.Errorf("Error creating dashboard %s in project %s. Error: %v", d.Get("name").(string), *projectID, err)
+	}
+
+	return flattenDashboard(d, createdDashboard)
+}
+
+func resourceDashboardRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	dashboardID, err := uuid.Parse(d.Id())
+	if err != nil {
+		return fmt.Errorf("Error parsing dashboard ID %s: %+v", d.Id(), err)
+	}
+
+	dashboardToFlatten, err := getDashboard(clients, d, dashboardID)
+	if err != nil {
+		if azdoerror.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	return flattenDashboard(d, dashboardToFlatten)
+}
+
+// Replaces the dashboard and its widgets. The current state is read first because the service rejects the
+// replacement of a dashboard or widget that is not tagged with its current version.
+func resourceDashboardUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	projectID, teamID := getDashboardScope(d)
+	dashboardID, err := uuid.Parse(d.Id())
+	if err != nil {
+		return fmt.Errorf("Error parsing dashboard ID %s: %+v", d.Id(), err)
+	}
+
+	current, err := getDashboard(clients, d, dashboardID)
+	if err != nil {
+		return err
+	}
+
+	updatedDashboard, err := clients.DashboardClient.ReplaceDashboard(clients.ctx, dashboard.ReplaceDashboardArgs{
+		Dashboard:   expandDashboard(d, current),
+		Project:     projectID,
+		DashboardId: &dashboardID,
+		Team:        teamID,
+	})
+	if err != nil {
+		return fmt.Errorf("Error updating dashboard %s in project %s. Error: %v", dashboardID, *projectID, err)
+	}
+
+	return flattenDashboard(d, updatedDashboard)
+}
+
+func resourceDashboardDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	projectID, teamID := getDashboardScope(d)
+	dashboardID, err := uuid.Parse(d.Id())
+	if err != nil {
+		return fmt.Errorf("Error parsing dashboard ID %s: %+v", d.Id(), err)
+	}
+
+	err = clients.DashboardClient.DeleteDashboard(clients.ctx, dashboard.DeleteDashboardArgs{
+		Project:     projectID,
+		DashboardId: &dashboardID,
+		Team:        teamID,
+	})
+	if err != nil {
+		return fmt.Errorf("Error deleting dashboard %s in project %s. Error: %v", dashboardID, *projectID, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func getDashboard(clients *aggregatedClient, d *schema.ResourceData, dashboardID uuid.UUID) (*dashboard.Dashboard, error) {
+	projectID, teamID := getDashboardScope(d)
+	result, err := clients.DashboardClient.GetDashboard(clients.ctx, dashboard.GetDashboardArgs{
+		Project:     projectID,
+		DashboardId: &dashboardID,
+		Team:        teamID,
+	})
+	if err != nil && !azdoerror.IsNotFound(err) {
+		return nil, fmt.Errorf("Error looking up dashboard %s in project %s. Error: %v", dashboardID, *projectID, err)
+	}
+	return result, err
+}
+
+// Returns the project and the team that own the dashboard. Dashboards without a team belong to the default
+// team of the project.
+func getDashboardScope(d *schema.ResourceData) (*string, *string) {
+	projectID := converter.String(d.Get("project_id").(string))
+	if teamID := d.Get("team_id").(string); teamID != "" {
+		return projectID, &teamID
+	}
+	return projectID, nil
+}
+
+// Convert internal Terraform data structure to an AzDO data structure. The versions of the current dashboard
+// and of its widgets are carried over if the dashboard is replaced.
+func expandDashboard(d *schema.ResourceData, current *dashboard.Dashboard) *dashboard.Dashboard {
+	currentWidgets := map[string]dashboard.Widget{}
+	if current != nil && current.Widgets != nil {
+		for _, widget := range *current.Widgets {
+			if widget.Id != nil {
+				currentWidgets[widget.Id.String()] = widget
+			}
+		}
+	}
+
+	widgets := []dashboard.Widget{}
+	for _, raw := range d.Get("widget").([]interface{}) {
+		widget := expandDashboardWidget(raw.(map[string]interface{}))
+		if widget.Id != nil {
+			if currentWidget, ok := currentWidgets[widget.Id.String()]; ok {
+				widget.ETag = currentWidget.ETag
+			} else {
+				// the widget was removed outside of Terraform and has to be added again
+				widget.Id = nil
+			}
+		}
+		widgets = append(widgets, widget)
+	}
+
+	result := &dashboard.Dashboard{
+		Name:            converter.String(d.Get("name").(string)),
+		Description:     converter.String(d.Get("description").(string)),
+		RefreshInterval: converter.Int(d.Get("refresh_interval").(int)),
+		Widgets:         &widgets,
+	}
+	if current != nil {
+		result.Id = current.Id
+		result.ETag = current.ETag
+	}
+	return result
+}
+
+func expandDashboardWidget(widget map[string]interface{}) dashboard.Widget {
+	position := widget["position"].([]interface{})[0].(map[string]interface{})
+	size := widget["size"].([]interface{})[0].(map[string]interface{})
+
+	result := dashboard.Widget{
+		ContributionId: converter.String(widget["type"].(string)),
+		Name:           converter.String(widget["name"].(string)),
+		Position: &dashboard.WidgetPosition{
+			Row:    converter.Int(position["row"].(int)),
+			Column: converter.Int(position["column"].(int)),
+		},
+		Size: &dashboard.WidgetSize{
+			RowSpan:    converter.Int(size["row_span"].(int)),
+			ColumnSpan: converter.Int(size["column_span"].(int)),
+		},
+	}
+	if settings := widget["settings"].(string); settings != "" {
+		result.Settings = &settings
+	}
+	if widgetID, err := uuid.Parse(widget["id"].(string)); err == nil {
+		result.Id = &widgetID
+	}
+	return result
+}
+
+// Convert AzDO data structure to internal Terraform data structure
+func flattenDashboard(d *schema.ResourceData, dashboardToFlatten *dashboard.Dashboard) error {
+	if dashboardToFlatten == nil || dashboardToFlatten.Id == nil {
+		return fmt.Errorf("Dashboard was not returned by the service")
+	}
+
+	d.SetId(dashboardToFlatten.Id.String())
+	d.Set("name", converter.ToString(dashboardToFlatten.Name, ""))
+	d.Set("description", converter.ToString(dashboardToFlatten.Description, ""))
+	if dashboardToFlatten.RefreshInterval != nil {
+		d.Set("refresh_interval", *dashboardToFlatten.RefreshInterval)
+	} else {
+		d.Set("refresh_interval", 0)
+	}
+
+	var widgets []dashboard.Widget
+	if dashboardToFlatten.Widgets != nil {
+		widgets = *dashboardToFlatten.Widgets
+	}
+	return d.Set("widget", flattenDashboardWidgets(sortDashboardWidgets(widgets, d.Get("widget").([]interface{}))))
+}
+
+// Orders the widgets returned by the service like the widgets in the configuration, so that the order in which
+// the service returns them does not cause a diff. Widgets are matched by their ID, and widgets that have no ID
+// yet by their position. Widgets that do not match any configured widget follow in the order of their position.
+func sortDashboardWidgets(widgets []dashboard.Widget, configured []interface{}) []dashboard.Widget {
+	rank := func(widget dashboard.Widget) int {
+		for i, raw := range configured {
+			configuredWidget := raw.(map[string]interface{})
+			if widget.Id != nil && configuredWidget["id"].(string) == widget.Id.String() {
+				return i
+			}
+		}
+		for i, raw := range configured {
+			configuredWidget := raw.(map[string]interface{})
+			if configuredWidget["id"].(string) == "" && samePosition(widget, configuredWidget) {
+				return i
+			}
+		}
+		return len(configured)
+	}
+
+	sorted := make([]dashboard.Widget, len(widgets))
+	copy(sorted, widgets)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		rankI, rankJ := rank(sorted[i]), rank(sorted[j])
+		if rankI != rankJ {
+			return rankI < rankJ
+		}
+		rowI, rowJ := widgetRow(sorted[i]), widgetRow(sorted[j])
+		if rowI != rowJ {
+			return rowI < rowJ
+		}
+		columnI, columnJ := widgetColumn(sorted[i]), widgetColumn(sorted[j])
+		if columnI != columnJ {
+			return columnI < columnJ
+		}
+		return strings.ToLower(converter.ToString(sorted[i].Name, "")) < strings.ToLower(converter.ToString(sorted[j].Name, ""))
+	})
+	return sorted
+}
+
+func samePosition(widget dashboard.Widget, configuredWidget map[string]interface{}) bool {
+	positions, ok := configuredWidget["position"].([]interface{})
+	if !ok || len(positions) != 1 || positions[0] == nil {
+		return false
+	}
+	position := positions[0].(map[string]interface{})
+	return widgetRow(widget) == position["row"].(int) && widgetColumn(widget) == position["column"].(int)
+}
+
+func widgetRow(widget dashboard.Widget) int {
+	if widget.Position == nil || widget.Position.Row == nil {
+		return 0
+	}
+	return *widget.Position.Row
+}
+
+func widgetColumn(widget dashboard.Widget) int {
+	if widget.Position == nil || widget.Position.Column == nil {
+		return 0
+	}
+	return *widget.Position.Column
+}
+
+func flattenDashboardWidgets(widgets []dashboard.Widget) []interface{} {
+	results := make([]interface{}, 0, len(widgets))
+	for _, widget := range widgets {
+		id := ""
+		if widget.Id != nil {
+			id = widget.Id.String()
+		}
+
+		var rowSpan, columnSpan int
+		if widget.Size != nil && widget.Size.RowSpan != nil {
+			rowSpan = *widget.Size.RowSpan
+		}
+		if widget.Size != nil && widget.Size.ColumnSpan != nil {
+			columnSpan = *widget.Size.ColumnSpan
+		}
+		settings := converter.ToString(widget.Settings, "")
+		if settings == "null" {
+			settings = ""
+		}
+
+		results = append(results, map[string]interface{}{
+			"id":       id,
+			"type":     converter.ToString(widget.ContributionId, ""),
+			"name":     converter.ToString(widget.Name, ""),
+			"position": []interface{}{map[string]interface{}{"row": widgetRow(widget), "column": widgetColumn(widget)}},
+			"size":     []interface{}{map[string]interface{}{"row_span": rowSpan, "column_span": columnSpan}},
+			"settings": settings,
+		})
+	}
+	return results
+}
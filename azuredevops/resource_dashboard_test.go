@@ -0,0 +1,338 @@
+package azuredevops
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/microsoft/azure-devops-go-api/azuredevops"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/dashboard"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var testDashboardProjectID = uuid.New().String()
+var testDashboardID = uuid.New()
+
+const testMarkdownWidgetType = "ms.vss-dashboards-web.Microsoft.VisualStudioOnline.Dashboards.MarkdownWidget"
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that a dashboard is created with its widgets in the scope of the team
+func TestAzureDevOpsDashboard_Create_SendsWidgets(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dashboardClient := azdosdkmocks.NewMockDashboardClient(ctrl)
+	clients := &aggregatedClient{DashboardClient: dashboardClient, ctx: context.Background()}
+
+	resourceData := createDashboardResourceData(t, "team-id", []interface{}{
+		testDashboardWidgetConfig("Notes", 1, 1, `# Notes`),
+	})
+
+	expectedWidgets := []dashboard.Widget{testDashboardWidget(nil, "Notes", 1, 1, `# Notes`)}
+	expectedArgs := dashboard.CreateDashboardArgs{
+		Dashboard: &dashboard.Dashboard{
+			Name:            converter.String("Dashboard"),
+			Description:     converter.String("Description"),
+			RefreshInterval: converter.Int(5),
+			Widgets:         &expectedWidgets,
+		},
+		Project: &testDashboardProjectID,
+		Team:    converter.String("team-id"),
+	}
+
+	widgetID := uuid.New()
+	createdWidgets := []dashboard.Widget{testDashboardWidget(&widgetID, "Notes", 1, 1, `# Notes`)}
+	dashboardClient.
+		EXPECT().
+		CreateDashboard(clients.ctx, expectedArgs).
+		Return(testDashboard(createdWidgets), nil).
+		Times(1)
+
+	err := resourceDashboardCreate(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, testDashboardID.String(), resourceData.Id())
+	require.Equal(t, widgetID.String(), resourceData.Get("widget.0.id"))
+	require.Equal(t, 1, resourceData.Get("widget.0.size.0.row_span"))
+	require.Equal(t, `# Notes`, resourceData.Get("widget.0.settings"))
+}
+
+// verifies that the widgets returned by the service are ordered like the configured widgets
+func TestAzureDevOpsDashboard_Flatten_KeepsConfiguredWidgetOrder(t *testing.T) {
+	resourceData := createDashboardResourceData(t, "", []interface{}{
+		testDashboardWidgetConfig("Second", 1, 3, ""),
+		testDashboardWidgetConfig("First", 1, 1, ""),
+	})
+
+	firstID, secondID, unknownID := uuid.New(), uuid.New(), uuid.New()
+	widgets := []dashboard.Widget{
+		testDashboardWidget(&unknownID, "Unknown", 2, 1, ""),
+		testDashboardWidget(&firstID, "First", 1, 1, ""),
+		testDashboardWidget(&secondID, "Second", 1, 3, ""),
+	}
+
+	err := flattenDashboard(resourceData, testDashboard(widgets))
+	require.Nil(t, err)
+	require.Equal(t, secondID.String(), resourceData.Get("widget.0.id"))
+	require.Equal(t, firstID.String(), resourceData.Get("widget.1.id"))
+	require.Equal(t, unknownID.String(), resourceData.Get("widget.2.id"))
+
+	// once the widgets have IDs they are matched by ID, even if the service moved them
+	movedWidgets := []dashboard.Widget{
+		testDashboardWidget(&firstID, "First", 3, 1, ""),
+		testDashboardWidget(&secondID, "Second", 3, 3, ""),
+	}
+	resourceData.Set("widget", resourceData.Get("widget").([]interface{})[:2])
+
+	err = flattenDashboard(resourceData, testDashboard(movedWidgets))
+	require.Nil(t, err)
+	require.Equal(t, secondID.String(), resourceData.Get("widget.0.id"))
+	require.Equal(t, 3, resourceData.Get("widget.0.position.0.row"))
+	require.Equal(t, firstID.String(), resourceData.Get("widget.1.id"))
+}
+
+// verifies that the dashboard and its widgets are replaced with the versions of the current dashboard
+func TestAzureDevOpsDashboard_Update_UsesCurrentVersions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dashboardClient := azdosdkmocks.NewMockDashboardClient(ctrl)
+	clients := &aggregatedClient{DashboardClient: dashboardClient, ctx: context.Background()}
+
+	widgetID, removedWidgetID := uuid.New(), uuid.New()
+	notesConfig := testDashboardWidgetConfig("Notes", 1, 1, `# Notes`)
+	notesConfig["id"] = widgetID.String()
+	removedConfig := testDashboardWidgetConfig("Removed", 1, 3, "")
+	removedConfig["id"] = removedWidgetID.String()
+	resourceData := createDashboardResourceData(t, "", []interface{}{notesConfig, removedConfig})
+	resourceData.SetId(testDashboardID.String())
+
+	currentWidget := testDashboardWidget(&widgetID, "Notes", 1, 1, `# Old Notes`)
+	currentWidget.ETag = converter.String("widget-etag")
+	current := testDashboard([]dashboard.Widget{currentWidget})
+	current.ETag = converter.String("dashboard-etag")
+	dashboardClient.
+		EXPECT().
+		GetDashboard(clients.ctx, dashboard.GetDashboardArgs{Project: &testDashboardProjectID, DashboardId: &testDashboardID}).
+		Return(current, nil).
+		Times(1)
+
+	expectedWidget := testDashboardWidget(&widgetID, "Notes", 1, 1, `# Notes`)
+	expectedWidget.ETag = converter.String("widget-etag")
+	expectedWidgets := []dashboard.Widget{expectedWidget, testDashboardWidget(nil, "Removed", 1, 3, "")}
+	dashboardClient.
+		EXPECT().
+		ReplaceDashboard(clients.ctx, dashboard.ReplaceDashboardArgs{
+			Dashboard: &dashboard.Dashboard{
+				Id:              &testDashboardID,
+				ETag:            converter.String("dashboard-etag"),
+				Name:            converter.String("Dashboard"),
+				Description:     converter.String("Description"),
+				RefreshInterval: converter.Int(5),
+				Widgets:         &expectedWidgets,
+			},
+			Project:     &testDashboardProjectID,
+			DashboardId: &testDashboardID,
+		}).
+		Return(nil, errors.New("ReplaceDashboard() Failed")).
+		Times(1)
+
+	err := resourceDashboardUpdate(resourceData, clients)
+	require.Contains(t, err.Error(), "ReplaceDashboard() Failed")
+}
+
+// verifies that a dashboard that no longer exists is removed from the state
+func TestAzureDevOpsDashboard_Read_RemovesMissingDashboardFromState(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dashboardClient := azdosdkmocks.NewMockDashboardClient(ctrl)
+	clients := &aggregatedClient{DashboardClient: dashboardClient, ctx: context.Background()}
+
+	resourceData := createDashboardResourceData(t, "", nil)
+	resourceData.SetId(testDashboardID.String())
+
+	notFound := http.StatusNotFound
+	dashboardClient.
+		EXPECT().
+		GetDashboard(clients.ctx, gomock.Any()).
+		Return(nil, azuredevops.WrappedError{StatusCode: &notFound}).
+		Times(1)
+
+	err := resourceDashboardRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "", resourceData.Id())
+}
+
+// verifies that if an error is produced on delete, the error is not swallowed
+func TestAzureDevOpsDashboard_Delete_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dashboardClient := azdosdkmocks.NewMockDashboardClient(ctrl)
+	clients := &aggregatedClient{DashboardClient: dashboardClient, ctx: context.Background()}
+
+	resourceData := createDashboardResourceData(t, "team-id", nil)
+	resourceData.SetId(testDashboardID.String())
+
+	dashboardClient.
+		EXPECT().
+		DeleteDashboard(clients.ctx, dashboard.DeleteDashboardArgs{
+			Project:     &testDashboardProjectID,
+			DashboardId: &testDashboardID,
+			Team:        converter.String("team-id"),
+		}).
+		Return(errors.New("DeleteDashboard() Failed")).
+		Times(1)
+
+	err := resourceDashboardDelete(resourceData, clients)
+	require.Contains(t, err.Error(), "DeleteDashboard() Failed")
+}
+
+func createDashboardResourceData(t *testing.T, teamID string, widgets []interface{}) *schema.ResourceData {
+	raw := map[string]interface{}{
+		"project_id":       testDashboardProjectID,
+		"name":             "Dashboard",
+		"description":      "Description",
+		"refresh_interval": 5,
+		"widget":           widgets,
+	}
+	if teamID != "" {
+		raw["team_id"] = teamID
+	}
+	return schema.TestResourceDataRaw(t, resourceDashboard().Schema, raw)
+}
+
+func testDashboardWidgetConfig(name string, row int, column int, settings string) map[string]interface{} {
+	return map[string]interface{}{
+		"type":     testMarkdownWidgetType,
+		"name":     name,
+		"position": []interface{}{map[string]interface{}{"row": row, "column": column}},
+		"size":     []interface{}{map[string]interface{}{"row_span": 1, "column_span": 2}},
+		"settings": settings,
+	}
+}
+
+func testDashboardWidget(id *uuid.UUID, name string, row int, column int, settings string) dashboard.Widget {
+	widget := dashboard.Widget{
+		Id:             id,
+		ContributionId: converter.String(testMarkdownWidgetType),
+		Name:           converter.String(name),
+		Position:       &dashboard.WidgetPosition{Row: converter.Int(row), Column: converter.Int(column)},
+		Size:           &dashboard.WidgetSize{RowSpan: converter.Int(1), ColumnSpan: converter.Int(2)},
+	}
+	if settings != "" {
+		widget.Settings = &settings
+	}
+	return widget
+}
+
+func testDashboard(widgets []dashboard.Widget) *dashboard.Dashboard {
+	return &dashboard.Dashboard{
+		Id:              &testDashboardID,
+		Name:            converter.String("Dashboard"),
+		Description:     converter.String("Description"),
+		RefreshInterval: converter.Int(5),
+		Widgets:         &widgets,
+	}
+}
+
+/**
+ * Begin acceptance tests
+ */
+
+// Verifies that a dashboard can be created, and that its widgets can be updated
+func TestAccAzureDevOpsDashboard_CreateAndUpdate(t *testing.T) {
+	projectName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	dashboardName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	tfNode := "azuredevops_dashboard.dashboard"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccDashboardCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDashboardResource(projectName, dashboardName, "# Notes"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "name", dashboardName),
+					resource.TestCheckResourceAttr(tfNode, "widget.#", "1"),
+					resource.TestCheckResourceAttrSet(tfNode, "widget.0.id"),
+					resource.TestCheckResourceAttr(tfNode, "widget.0.settings", "# Notes"),
+				),
+			}, {
+				Config: testAccDashboardResource(projectName, dashboardName, "# Updated Notes"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "widget.#", "1"),
+					resource.TestCheckResourceAttr(tfNode, "widget.0.settings", "# Updated Notes"),
+				),
+			},
+		},
+	})
+}
+
+// HCL describing a dashboard with a markdown widget
+func testAccDashboardResource(projectName string, dashboardName string, markdown string) string {
+	dashboardResource := fmt.Sprintf(`
+resource "azuredevops_dashboard" "dashboard" {
+	project_id  = azuredevops_project.project.id
+	name        = "%s"
+	description = "Managed by Terraform"
+
+	widget {
+		type     = "%s"
+		name     = "Notes"
+		settings = "%s"
+
+		position {
+			row    = 1
+			column = 1
+		}
+
+		size {
+			row_span    = 2
+			column_span = 2
+		}
+	}
+}`, dashboardName, testMarkdownWidgetType, markdown)
+
+	projectResource := testAccProjectResource(projectName)
+	return fmt.Sprintf("%s\n%s", projectResource, dashboardResource)
+}
+
+// verifies that all dashboards referenced in the state are destroyed
+func testAccDashboardCheckDestroy(s *terraform.State) error {
+	clients := testAccProvider.Meta().(*aggregatedClient)
+
+	for _, res := range s.RootModule().Resources {
+		if res.Type != "azuredevops_dashboard" {
+			continue
+		}
+
+		dashboardID, err := uuid.Parse(res.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Dashboard ID %s is not a UUID", res.Primary.ID)
+		}
+		projectID := res.Primary.Attributes["project_id"]
+		_, err = clients.DashboardClient.GetDashboard(clients.ctx, dashboard.GetDashboardArgs{
+			Project:     &projectID,
+			DashboardId: &dashboardID,
+		})
+		if err == nil {
+			return fmt.Errorf("Dashboard %s should not exist", res.Primary.ID)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,175 @@
+package azuredevops
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/azdoerror"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/environment"
+)
+
+func resourceEnvironment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceEnvironmentCreate,
+		Read:   resourceEnvironmentRead,
+		Update: resourceEnvironmentUpdate,
+		Delete: resourceEnvironmentDelete,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "",
+			},
+		},
+	}
+}
+
+// A pipeline run that deploys to an environment that does not exist creates the environment, so an environment
+// with the configured name is adopted rather than created if it already exists
+func resourceEnvironmentCreate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	projectID := d.Get("project_id").(string)
+	name := d.Get("name").(string)
+
+	existing, err := findEnvironmentByName(clients, projectID, name)
+	if err != nil {
+		return err
+	}
+
+	if existing != nil {
+		d.SetId(strconv.Itoa(*existing.Id))
+		if converter.ToString(existing.Description, "") != d.Get("description").(string) {
+			return resourceEnvironmentUpdate(d, m)
+		}
+		return resourceEnvironmentRead(d, m)
+	}
+
+	created, err := clients.EnvironmentClient.AddEnvironment(clients.ctx, environment.AddEnvironmentArgs{
+		EnvironmentCreateParameter: &taskagent.EnvironmentCreateParameter{
+			Name:        converter.String(name),
+			Description: converter.String(d.Get("description").(string)),
+		},
+		Project: &projectID,
+	})
+	if err != nil {
+		return fmt.Errorf("Error creating environment %s in project %s. Error: %v", name, projectID, err)
+	}
+
+	d.SetId(strconv.Itoa(*created.Id))
+	return resourceEnvironmentRead(d, m)
+}
+
+func resourceEnvironmentRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	projectID := d.Get("project_id").(string)
+	environmentID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return fmt.Errorf("Error parsing the environment ID %s: %v", d.Id(), err)
+	}
+
+	env, err := clients.EnvironmentClient.GetEnvironmentById(clients.ctx, environment.GetEnvironmentByIdArgs{
+		Project:       &projectID,
+		EnvironmentId: &environmentID,
+	})
+	if err != nil {
+		if azdoerror.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error looking up environment with ID %d in project %s. Error: %v", environmentID, projectID, err)
+	}
+	if env == nil || env.Id == nil {
+		d.SetId("")
+		return nil
+	}
+
+	flattenEnvironment(d, env)
+	return nil
+}
+
+func resourceEnvironmentUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	projectID := d.Get("project_id").(string)
+	environmentID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return fmt.Errorf("Error parsing the environment ID %s: %v", d.Id(), err)
+	}
+
+	_, err = clients.EnvironmentClient.UpdateEnvironment(clients.ctx, environment.UpdateEnvironmentArgs{
+		EnvironmentUpdateParameter: &taskagent.EnvironmentUpdateParameter{
+			Name:        converter.String(d.Get("name").(string)),
+			Description: converter.String(d.Get("description").(string)),
+		},
+		Project:       &projectID,
+		EnvironmentId: &environmentID,
+	})
+	if err != nil {
+		return fmt.Errorf("Error updating environment with ID %d in project %s. Error: %v", environmentID, projectID, err)
+	}
+
+	return resourceEnvironmentRead(d, m)
+}
+
+func resourceEnvironmentDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	projectID := d.Get("project_id").(string)
+	environmentID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return fmt.Errorf("Error parsing the environment ID %s: %v", d.Id(), err)
+	}
+
+	err = clients.EnvironmentClient.DeleteEnvironment(clients.ctx, environment.DeleteEnvironmentArgs{
+		Project:       &projectID,
+		EnvironmentId: &environmentID,
+	})
+	if err != nil && !azdoerror.IsNotFound(err) {
+		return fmt.Errorf("Error deleting environment with ID %d in project %s. Error: %v", environmentID, projectID, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// Returns the environment of the project with the given name, or nil if there is none. Environment names are
+// unique within a project regardless of their case.
+func findEnvironmentByName(clients *aggregatedClient, projectID string, name string) (*taskagent.EnvironmentInstance, error) {
+	environments, err := clients.EnvironmentClient.GetEnvironments(clients.ctx, environment.GetEnvironmentsArgs{
+		Project: &projectID,
+		Name:    &name,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error looking up environment %s in project %s. Error: %v", name, projectID, err)
+	}
+
+	if environments != nil {
+		for _, env := range *environments {
+			if env.Id != nil && strings.EqualFold(converter.ToString(env.Name, ""), name) {
+				return &env, nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+// Convert AzDO data structure to internal Terraform data structure
+func flattenEnvironment(d *schema.ResourceData, env *taskagent.EnvironmentInstance) {
+	d.SetId(strconv.Itoa(*env.Id))
+	d.Set("name", converter.ToString(env.Name, ""))
+	d.Set("description", converter.ToString(env.Description, ""))
+}
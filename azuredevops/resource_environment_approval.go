@@ -0,0 +1,128 @@
+package azuredevops
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/graph"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/pipelinechecks"
+)
+
+// The settings of a manual approval. Approvers are identified by their identity IDs.
+type approvalSettings struct {
+	Approvers            []approvalIdentity `json:"approvers"`
+	BlockedApprovers     []approvalIdentity `json:"blockedApprovers"`
+	Instructions         string             `json:"instructions"`
+	MinRequiredApprovers int                `json:"minRequiredApprovers"`
+}
+
+type approvalIdentity struct {
+	ID string `json:"id"`
+}
+
+func resourceEnvironmentApproval() *schema.Resource {
+	r := genBaseEnvironmentCheckResource(flattenEnvironmentApproval, expandEnvironmentApproval)
+	r.Importer = &schema.ResourceImporter{
+		State: genEnvironmentCheckImportFunc(pipelinechecks.ApprovalTypeID),
+	}
+
+	r.Schema["approvers"] = &schema.Schema{
+		Type:     schema.TypeSet,
+		Required: true,
+		MinItems: 1,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validation.NoZeroValues,
+		},
+		Set:         schema.HashString,
+		Description: "The descriptors of the users and groups that can approve",
+	}
+	r.Schema["instructions"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Default:  "",
+	}
+	r.Schema["min_required_approvers"] = &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Default:      0,
+		ValidateFunc: validation.IntAtLeast(0),
+		Description:  "The number of approvers that need to approve. All approvers need to approve if it is 0",
+	}
+
+	return r
+}
+
+// Convert internal Terraform data structure to an AzDO data structure. The graph descriptors of the approvers
+// are resolved to the identity IDs the service expects.
+func expandEnvironmentApproval(d *schema.ResourceData, clients *aggregatedClient) (*pipelinechecks.CheckType, interface{}, error) {
+	descriptors := make([]string, 0, d.Get("approvers").(*schema.Set).Len())
+	for _, descriptor := range d.Get("approvers").(*schema.Set).List() {
+		descriptors = append(descriptors, descriptor.(string))
+	}
+	sort.Strings(descriptors)
+
+	approvers := make([]approvalIdentity, len(descriptors))
+	for i, descriptor := range descriptors {
+		storageKey, err := clients.GraphClient.GetStorageKey(clients.ctx, graph.GetStorageKeyArgs{
+			SubjectDescriptor: converter.String(descriptor),
+		})
+		if err != nil {
+			return nil, nil, fmt.Errorf("Error resolving the identity of approver %s: %v", descriptor, err)
+		}
+		if storageKey == nil || storageKey.Value == nil {
+			return nil, nil, fmt.Errorf("Identity of approver %s was not returned by the service", descriptor)
+		}
+		approvers[i] = approvalIdentity{ID: storageKey.Value.String()}
+	}
+
+	checkType := &pipelinechecks.CheckType{
+		Id:   &pipelinechecks.ApprovalTypeID,
+		Name: converter.String("Approval"),
+	}
+	settings := approvalSettings{
+		Approvers:            approvers,
+		BlockedApprovers:     []approvalIdentity{},
+		Instructions:         d.Get("instructions").(string),
+		MinRequiredApprovers: d.Get("min_required_approvers").(int),
+	}
+	return checkType, settings, nil
+}
+
+// Convert AzDO data structure to internal Terraform data structure. The identity IDs of the approvers are
+// resolved back to their graph descriptors.
+func flattenEnvironmentApproval(d *schema.ResourceData, check *pipelinechecks.CheckConfiguration, clients *aggregatedClient) error {
+	if !isCheckOfType(check, pipelinechecks.ApprovalTypeID) {
+		return fmt.Errorf("Check with ID (%s) is not an approval", d.Id())
+	}
+
+	var settings approvalSettings
+	if err := decodeCheckSettings(check, &settings); err != nil {
+		return err
+	}
+
+	approvers := make([]interface{}, 0, len(settings.Approvers))
+	for _, approver := range settings.Approvers {
+		identityID, err := uuid.Parse(approver.ID)
+		if err != nil {
+			return fmt.Errorf("Error parsing the identity ID %s of an approver: %+v", approver.ID, err)
+		}
+		descriptor, err := clients.GraphClient.GetDescriptor(clients.ctx, graph.GetDescriptorArgs{StorageKey: &identityID})
+		if err != nil {
+			return fmt.Errorf("Error resolving the descriptor of approver %s: %v", approver.ID, err)
+		}
+		if descriptor == nil || descriptor.Value == nil {
+			return fmt.Errorf("Descriptor of approver %s was not returned by the service", approver.ID)
+		}
+		approvers = append(approvers, *descriptor.Value)
+	}
+
+	d.Set("approvers", schema.NewSet(schema.HashString, approvers))
+	d.Set("instructions", settings.Instructions)
+	d.Set("min_required_approvers", settings.MinRequiredApprovers)
+	return nil
+}
@@ -0,0 +1,332 @@
+package azuredevops
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/microsoft/azure-devops-go-api/azuredevops"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/graph"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/pipelinechecks"
+	"github.com/stretchr/testify/require"
+)
+
+var testApprovalCheckID = 12
+var testApprovalProjectID = uuid.New().String()
+var testApproverDescriptor = "vssgp.Uy0xLTktMTU1MTM3NDI0NS0xMjA0NDAwOTY5"
+var testApproverIdentityID = uuid.New()
+
+var testApprovalCheck = pipelinechecks.CheckConfiguration{
+	Id:       &testApprovalCheckID,
+	Type:     &pipelinechecks.CheckType{Id: &pipelinechecks.ApprovalTypeID, Name: converter.String("Approval")},
+	Resource: &pipelinechecks.Resource{Type: converter.String("environment"), Id: converter.String("7")},
+	Timeout:  converter.Int(60),
+	Settings: map[string]interface{}{
+		"approvers":            []interface{}{map[string]interface{}{"id": testApproverIdentityID.String(), "displayName": "Release Managers"}},
+		"instructions":         "Verify the release notes",
+		"minRequiredApprovers": 1,
+	},
+}
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that the descriptors of the approvers are resolved to the identity IDs the service expects
+func TestAzureDevOpsEnvironmentApproval_Create_ResolvesApprovers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	checksClient := azdosdkmocks.NewMockPipelineChecksClient(ctrl)
+	graphClient := azdosdkmocks.NewMockGraphClient(ctrl)
+	clients := &aggregatedClient{PipelineChecksClient: checksClient, GraphClient: graphClient, ctx: context.Background()}
+
+	resourceData := createEnvironmentApprovalResourceData(t)
+
+	graphClient.
+		EXPECT().
+		GetStorageKey(clients.ctx, graph.GetStorageKeyArgs{SubjectDescriptor: &testApproverDescriptor}).
+		Return(&graph.GraphStorageKeyResult{Value: &testApproverIdentityID}, nil).
+		Times(1)
+	checksClient.
+		EXPECT().
+		AddCheckConfiguration(clients.ctx, pipelinechecks.AddCheckConfigurationArgs{
+			Project: &testApprovalProjectID,
+			Configuration: &pipelinechecks.CheckConfiguration{
+				Type:     &pipelinechecks.CheckType{Id: &pipelinechecks.ApprovalTypeID, Name: converter.String("Approval")},
+				Resource: &pipelinechecks.Resource{Type: converter.String("environment"), Id: converter.String("7")},
+				Timeout:  converter.Int(60),
+				Settings: approvalSettings{
+					Approvers:            []approvalIdentity{{ID: testApproverIdentityID.String()}},
+					BlockedApprovers:     []approvalIdentity{},
+					Instructions:         "Verify the release notes",
+					MinRequiredApprovers: 1,
+				},
+			},
+		}).
+		Return(&pipelinechecks.CheckConfiguration{Id: &testApprovalCheckID}, nil).
+		Times(1)
+	expectGetApprovalCheck(checksClient, graphClient)
+
+	err := resourceEnvironmentApproval().Create(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, strconv.Itoa(testApprovalCheckID), resourceData.Id())
+}
+
+// verifies that the identity IDs of the approvers are resolved back to their descriptors
+func TestAzureDevOpsEnvironmentApproval_Read_ReconcilesApprovers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	checksClient := azdosdkmocks.NewMockPipelineChecksClient(ctrl)
+	graphClient := azdosdkmocks.NewMockGraphClient(ctrl)
+	clients := &aggregatedClient{PipelineChecksClient: checksClient, GraphClient: graphClient, ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceEnvironmentApproval().Schema, map[string]interface{}{
+		"project_id": testApprovalProjectID,
+	})
+	resourceData.SetId(strconv.Itoa(testApprovalCheckID))
+	expectGetApprovalCheck(checksClient, graphClient)
+
+	err := resourceEnvironmentApproval().Read(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, 7, resourceData.Get("environment_id"))
+	require.Equal(t, 60, resourceData.Get("timeout"))
+	require.Equal(t, []interface{}{testApproverDescriptor}, resourceData.Get("approvers").(*schema.Set).List())
+	require.Equal(t, "Verify the release notes", resourceData.Get("instructions"))
+	require.Equal(t, 1, resourceData.Get("min_required_approvers"))
+}
+
+// verifies that a check deleted outside of Terraform is removed from the state
+func TestAzureDevOpsEnvironmentApproval_Read_ClearsIdIfCheckWasDeleted(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	checksClient := azdosdkmocks.NewMockPipelineChecksClient(ctrl)
+	clients := &aggregatedClient{PipelineChecksClient: checksClient, ctx: context.Background()}
+
+	resourceData := createEnvironmentApprovalResourceData(t)
+	resourceData.SetId(strconv.Itoa(testApprovalCheckID))
+
+	notFound := 404
+	checksClient.
+		EXPECT().
+		GetCheckConfiguration(clients.ctx, gomock.Any()).
+		Return(nil, azuredevops.WrappedError{StatusCode: &notFound}).
+		Times(1)
+
+	err := resourceEnvironmentApproval().Read(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "", resourceData.Id())
+}
+
+// verifies that an approver that cannot be resolved fails the create before the check is added
+func TestAzureDevOpsEnvironmentApproval_Create_DoesNotSwallowApproverError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	checksClient := azdosdkmocks.NewMockPipelineChecksClient(ctrl)
+	graphClient := azdosdkmocks.NewMockGraphClient(ctrl)
+	clients := &aggregatedClient{PipelineChecksClient: checksClient, GraphClient: graphClient, ctx: context.Background()}
+
+	resourceData := createEnvironmentApprovalResourceData(t)
+
+	graphClient.
+		EXPECT().
+		GetStorageKey(clients.ctx, gomock.Any()).
+		Return(nil, errors.New("GetStorageKey() Failed")).
+		Times(1)
+	checksClient.
+		EXPECT().
+		AddCheckConfiguration(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	err := resourceEnvironmentApproval().Create(resourceData, clients)
+	require.Contains(t, err.Error(), "GetStorageKey() Failed")
+}
+
+// verifies that if an error is produced on a delete, it is not swallowed
+func TestAzureDevOpsEnvironmentApproval_Delete_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	checksClient := azdosdkmocks.NewMockPipelineChecksClient(ctrl)
+	clients := &aggregatedClient{PipelineChecksClient: checksClient, ctx: context.Background()}
+
+	resourceData := createEnvironmentApprovalResourceData(t)
+	resourceData.SetId(strconv.Itoa(testApprovalCheckID))
+
+	checksClient.
+		EXPECT().
+		DeleteCheckConfiguration(clients.ctx, pipelinechecks.DeleteCheckConfigurationArgs{Project: &testApprovalProjectID, Id: &testApprovalCheckID}).
+		Return(errors.New("DeleteCheckConfiguration() Failed")).
+		Times(1)
+
+	err := resourceEnvironmentApproval().Delete(resourceData, clients)
+	require.Contains(t, err.Error(), "DeleteCheckConfiguration() Failed")
+}
+
+// verifies that an approval can be imported by the project ID and check ID, but a check of another type cannot
+func TestAzureDevOpsEnvironmentApproval_Import_RejectsOtherCheckType(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	checksClient := azdosdkmocks.NewMockPipelineChecksClient(ctrl)
+	clients := &aggregatedClient{PipelineChecksClient: checksClient, ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceEnvironmentApproval().Schema, nil)
+	resourceData.SetId(fmt.Sprintf("%s/%d", testApprovalProjectID, testApprovalCheckID))
+
+	checksClient.
+		EXPECT().
+		GetCheckConfiguration(clients.ctx, pipelinechecks.GetCheckConfigurationArgs{Project: &testApprovalProjectID, Id: &testApprovalCheckID}).
+		Return(&testApprovalCheck, nil).
+		Times(2)
+
+	result, err := resourceEnvironmentApproval().Importer.State(resourceData, clients)
+	require.Nil(t, err)
+	require.Len(t, result, 1)
+	require.Equal(t, strconv.Itoa(testApprovalCheckID), result[0].Id())
+	require.Equal(t, testApprovalProjectID, result[0].Get("project_id"))
+
+	resourceData.SetId(fmt.Sprintf("%s/%d", testApprovalProjectID, testApprovalCheckID))
+	_, err = resourceEnvironmentCheck().Importer.State(resourceData, clients)
+	require.NotNil(t, err)
+
+	for _, id := range []string{"12", "project/", "project/check"} {
+		resourceData.SetId(id)
+		_, err = resourceEnvironmentApproval().Importer.State(resourceData, clients)
+		require.NotNil(t, err, id)
+	}
+}
+
+func createEnvironmentApprovalResourceData(t *testing.T) *schema.ResourceData {
+	return schema.TestResourceDataRaw(t, resourceEnvironmentApproval().Schema, map[string]interface{}{
+		"project_id":             testApprovalProjectID,
+		"environment_id":         7,
+		"timeout":                60,
+		"approvers":              []interface{}{testApproverDescriptor},
+		"instructions":           "Verify the release notes",
+		"min_required_approvers": 1,
+	})
+}
+
+func expectGetApprovalCheck(checksClient *azdosdkmocks.MockPipelineChecksClient, graphClient *azdosdkmocks.MockGraphClient) {
+	checksClient.
+		EXPECT().
+		GetCheckConfiguration(gomock.Any(), pipelinechecks.GetCheckConfigurationArgs{Project: &testApprovalProjectID, Id: &testApprovalCheckID}).
+		Return(&testApprovalCheck, nil).
+		Times(1)
+	graphClient.
+		EXPECT().
+		GetDescriptor(gomock.Any(), graph.GetDescriptorArgs{StorageKey: &testApproverIdentityID}).
+		Return(&graph.GraphDescriptorResult{Value: &testApproverDescriptor}, nil).
+		Times(1)
+}
+
+/**
+ * Begin acceptance tests
+ */
+
+// validates that an approval can be added to an environment, updated and imported
+func TestAccAzureDevOpsEnvironmentApproval_CreateUpdateImport(t *testing.T) {
+	projectName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	environmentName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	tfNode := "azuredevops_environment_approval.approval"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccEnvironmentCheckCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentApprovalResource(projectName, environmentName, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfNode, "id"),
+					resource.TestCheckResourceAttr(tfNode, "approvers.#", "1"),
+					resource.TestCheckResourceAttr(tfNode, "instructions", "first"),
+				),
+			}, {
+				Config: testAccEnvironmentApprovalResource(projectName, environmentName, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "instructions", "second"),
+				),
+			}, {
+				ResourceName:      tfNode,
+				ImportStateIdFunc: testAccEnvironmentCheckImportStateID(tfNode),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// HCL describing an approval of an environment by the contributors of its project
+func testAccEnvironmentApprovalResource(projectName string, environmentName string, instructions string) string {
+	approvalResource := fmt.Sprintf(`
+data "azuredevops_group" "contributors" {
+	project_id = azuredevops_project.project.id
+	name       = "Contributors"
+}
+
+resource "azuredevops_environment_approval" "approval" {
+	project_id     = azuredevops_project.project.id
+	environment_id = azuredevops_environment.environment.id
+	approvers      = [data.azuredevops_group.contributors.descriptor]
+	instructions   = "%s"
+	timeout        = 60
+}`, instructions)
+
+	environmentResource := testAccEnvironmentResource(projectName, environmentName, "")
+	return fmt.Sprintf("%s\n%s", environmentResource, approvalResource)
+}
+
+// Returns the ID an environment check is imported by
+func testAccEnvironmentCheckImportStateID(tfNode string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		res, ok := s.RootModule().Resources[tfNode]
+		if !ok {
+			return "", fmt.Errorf("Did not find a resource %s in the TF state", tfNode)
+		}
+		return fmt.Sprintf("%s/%s", res.Primary.Attributes["project_id"], res.Primary.ID), nil
+	}
+}
+
+// verifies that all checks referenced in the state are destroyed
+func testAccEnvironmentCheckCheckDestroy(s *terraform.State) error {
+	clients := testAccProvider.Meta().(*aggregatedClient)
+
+	for _, res := range s.RootModule().Resources {
+		switch res.Type {
+		case "azuredevops_environment_approval", "azuredevops_environment_check",
+			"azuredevops_check_business_hours", "azuredevops_check_required_template":
+		default:
+			continue
+		}
+
+		checkID, err := strconv.Atoi(res.Primary.ID)
+		if err != nil {
+			return err
+		}
+		projectID := res.Primary.Attributes["project_id"]
+
+		_, err = clients.PipelineChecksClient.GetCheckConfiguration(clients.ctx, pipelinechecks.GetCheckConfigurationArgs{
+			Project: &projectID,
+			Id:      &checkID,
+		})
+		if err == nil {
+			return fmt.Errorf("Check with ID %d should not exist", checkID)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,318 @@
+package azuredevops
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/pipelinechecks"
+)
+
+// The task that evaluates business hours checks. Business hours are a check of type Task Check that runs it.
+var businessHoursTaskID, _ = uuid.Parse("445fde2f-6c39-441c-807f-8a59ff2e075f")
+
+// The days of the week in the order the service lists them
+var businessDays = []string{"Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday", "Sunday"}
+
+var businessHoursTimeRegexp = regexp.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9]$`)
+
+// The types of the repositories required templates are stored in. git refers to Azure Repos.
+var requiredTemplateRepositoryTypes = []string{"git", "github", "bitbucket"}
+
+// The settings of a check that runs a task
+type taskCheckSettings struct {
+	DefinitionRef taskCheckDefinitionRef `json:"definitionRef"`
+	DisplayName   string                 `json:"displayName"`
+	Inputs        map[string]string      `json:"inputs"`
+	RetryInterval int                    `json:"retryInterval"`
+}
+
+type taskCheckDefinitionRef struct {
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+// The settings of a check that requires pipelines to extend one of the templates
+type extendsCheckSettings struct {
+	ExtendsChecks []requiredTemplate `json:"extendsChecks"`
+}
+
+type requiredTemplate struct {
+	RepositoryType string `json:"repositoryType"`
+	RepositoryName string `json:"repositoryName"`
+	RepositoryRef  string `json:"repositoryRef"`
+	TemplatePath   string `json:"templatePath"`
+}
+
+func resourceEnvironmentCheck() *schema.Resource {
+	r := genBaseEnvironmentCheckResource(flattenEnvironmentCheck, expandEnvironmentCheck)
+	r.CustomizeDiff = customizeDiffEnvironmentCheck
+	r.Importer = &schema.ResourceImporter{
+		State: genEnvironmentCheckImportFunc(pipelinechecks.TaskCheckTypeID, pipelinechecks.ExtendsCheckTypeID),
+	}
+
+	r.Schema["business_hours"] = &schema.Schema{
+		Type:          schema.TypeList,
+		Optional:      true,
+		MaxItems:      1,
+		Description:   "Only lets stages run within the business hours",
+		ConflictsWith: []string{"required_template"},
+		Elem: &schema.Resource{
+			Schema: businessHoursSchema(),
+		},
+	}
+
+	r.Schema["required_template"] = &schema.Schema{
+		Type:          schema.TypeList,
+		Optional:      true,
+		Description:   "Only lets pipelines run that extend one of the templates",
+		ConflictsWith: []string{"business_hours"},
+		Elem:          requiredTemplateResource(),
+	}
+
+	return r
+}
+
+// The schema of the settings of a business hours check
+func businessHoursSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"days": {
+			Type:     schema.TypeSet,
+			Required: true,
+			MinItems: 1,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringInSlice(businessDays, false),
+			},
+			Set: schema.HashString,
+		},
+		"start_time": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringMatch(businessHoursTimeRegexp, "the time must be formatted as HH:MM"),
+		},
+		"end_time": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringMatch(businessHoursTimeRegexp, "the time must be formatted as HH:MM"),
+		},
+		"time_zone": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.NoZeroValues,
+		},
+	}
+}
+
+// The schema of a template that pipelines are required to extend
+func requiredTemplateResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"repository_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "git",
+				ValidateFunc: validation.StringInSlice(requiredTemplateRepositoryTypes, false),
+			},
+			"repository_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"repository_ref": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"template_path": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+}
+
+// Verifies at plan time that exactly one kind of check is configured, and replaces the check if its kind
+// changes because the type of a check cannot be updated
+func customizeDiffEnvironmentCheck(d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("business_hours") || !d.NewValueKnown("required_template") {
+		return nil
+	}
+
+	isBusinessHours := len(d.Get("business_hours").([]interface{})) > 0
+	isRequiredTemplate := len(d.Get("required_template").([]interface{})) > 0
+	if isBusinessHours == isRequiredTemplate {
+		return fmt.Errorf("exactly one of business_hours or required_template must be configured")
+	}
+
+	if d.Id() != "" {
+		oldBusinessHours, _ := d.GetChange("business_hours")
+		if (len(oldBusinessHours.([]interface{})) > 0) != isBusinessHours {
+			return d.ForceNew("business_hours")
+		}
+	}
+	return nil
+}
+
+// Convert internal Terraform data structure to an AzDO data structure
+func expandEnvironmentCheck(d *schema.ResourceData, clients *aggregatedClient) (*pipelinechecks.CheckType, interface{}, error) {
+	if configuration := expandSingleItemBlock(d, "business_hours"); len(configuration) > 0 {
+		checkType := &pipelinechecks.CheckType{
+			Id:   &pipelinechecks.TaskCheckTypeID,
+			Name: converter.String("Task Check"),
+		}
+		return checkType, expandBusinessHours(configuration), nil
+	}
+
+	templates := d.Get("required_template").([]interface{})
+	if len(templates) == 0 {
+		return nil, nil, fmt.Errorf("exactly one of business_hours or required_template must be configured")
+	}
+	return expandRequiredTemplates(templates)
+}
+
+func expandRequiredTemplates(templates []interface{}) (*pipelinechecks.CheckType, interface{}, error) {
+	settings := extendsCheckSettings{ExtendsChecks: make([]requiredTemplate, len(templates))}
+	for i, template := range templates {
+		configuration := template.(map[string]interface{})
+		settings.ExtendsChecks[i] = requiredTemplate{
+			RepositoryType: configuration["repository_type"].(string),
+			RepositoryName: configuration["repository_name"].(string),
+			RepositoryRef:  configuration["repository_ref"].(string),
+			TemplatePath:   configuration["template_path"].(string),
+		}
+	}
+	checkType := &pipelinechecks.CheckType{
+		Id:   &pipelinechecks.ExtendsCheckTypeID,
+		Name: converter.String("ExtendsCheck"),
+	}
+	return checkType, settings, nil
+}
+
+func expandBusinessHours(configuration map[string]interface{}) taskCheckSettings {
+	days := configuration["days"].(*schema.Set)
+	var selectedDays []string
+	for _, day := range businessDays {
+		if days.Contains(day) {
+			selectedDays = append(selectedDays, day)
+		}
+	}
+
+	return taskCheckSettings{
+		DefinitionRef: taskCheckDefinitionRef{
+			ID:      businessHoursTaskID.String(),
+			Name:    "evaluatebusinesshours",
+			Version: "0.0.1",
+		},
+		DisplayName: "Business Hours",
+		Inputs: map[string]string{
+			"businessDays": strings.Join(selectedDays, ","),
+			"startTime":    configuration["start_time"].(string),
+			"endTime":      configuration["end_time"].(string),
+			"timeZone":     configuration["time_zone"].(string),
+		},
+		RetryInterval: 5,
+	}
+}
+
+// Convert AzDO data structure to internal Terraform data structure
+func flattenEnvironmentCheck(d *schema.ResourceData, check *pipelinechecks.CheckConfiguration, clients *aggregatedClient) error {
+	switch {
+	case isCheckOfType(check, pipelinechecks.TaskCheckTypeID):
+		businessHours, err := flattenBusinessHours(d, check)
+		if err != nil {
+			return err
+		}
+		d.Set("business_hours", []interface{}{businessHours})
+		d.Set("required_template", nil)
+	case isCheckOfType(check, pipelinechecks.ExtendsCheckTypeID):
+		templates, err := flattenRequiredTemplates(check)
+		if err != nil {
+			return err
+		}
+		d.Set("required_template", templates)
+		d.Set("business_hours", nil)
+	default:
+		return fmt.Errorf("Check with ID (%s) is neither a business hours nor a required template check", d.Id())
+	}
+	return nil
+}
+
+// Converts the settings of a business hours check into the attributes of the business hours. The service
+// does not validate the inputs of the task, so the days and times are brought into the form used by the
+// schema to keep checks that were edited in the web UI from showing a diff.
+func flattenBusinessHours(d *schema.ResourceData, check *pipelinechecks.CheckConfiguration) (map[string]interface{}, error) {
+	var settings taskCheckSettings
+	if err := decodeCheckSettings(check, &settings); err != nil {
+		return nil, err
+	}
+	if !strings.EqualFold(settings.DefinitionRef.ID, businessHoursTaskID.String()) {
+		return nil, fmt.Errorf("Check with ID (%s) runs the task %s, only business hours are supported", d.Id(), settings.DefinitionRef.ID)
+	}
+
+	var days []interface{}
+	for _, day := range strings.Split(settings.Inputs["businessDays"], ",") {
+		if day = strings.TrimSpace(day); day != "" {
+			days = append(days, normalizeBusinessDay(day))
+		}
+	}
+	return map[string]interface{}{
+		"days":       schema.NewSet(schema.HashString, days),
+		"start_time": normalizeBusinessHoursTime(settings.Inputs["startTime"]),
+		"end_time":   normalizeBusinessHoursTime(settings.Inputs["endTime"]),
+		"time_zone":  settings.Inputs["timeZone"],
+	}, nil
+}
+
+// Converts the settings of a required template check into the list of templates
+func flattenRequiredTemplates(check *pipelinechecks.CheckConfiguration) ([]interface{}, error) {
+	var settings extendsCheckSettings
+	if err := decodeCheckSettings(check, &settings); err != nil {
+		return nil, err
+	}
+
+	templates := make([]interface{}, len(settings.ExtendsChecks))
+	for i, template := range settings.ExtendsChecks {
+		templates[i] = map[string]interface{}{
+			"repository_type": strings.ToLower(template.RepositoryType),
+			"repository_name": template.RepositoryName,
+			"repository_ref":  template.RepositoryRef,
+			"template_path":   template.TemplatePath,
+		}
+	}
+	return templates, nil
+}
+
+// Returns the name of a day of the week as spelled in the schema, e.g. monday becomes Monday
+func normalizeBusinessDay(day string) string {
+	for _, businessDay := range businessDays {
+		if strings.EqualFold(day, businessDay) {
+			return businessDay
+		}
+	}
+	return day
+}
+
+// Returns a time of day formatted as HH:MM, e.g. 8:00 becomes 08:00
+func normalizeBusinessHoursTime(value string) string {
+	parts := strings.Split(strings.TrimSpace(value), ":")
+	if len(parts) < 2 {
+		return value
+	}
+	hours, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return value
+	}
+	minutes, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return value
+	}
+	return fmt.Sprintf("%02d:%02d", hours, minutes)
+}
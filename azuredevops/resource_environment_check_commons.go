@@ -0,0 +1,382 @@
+package azuredevops
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/azdoerror"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/pipelinechecks"
+)
+
+// The longest time, in minutes, a check can wait to pass. It is also the default of the service.
+const checkMaxTimeout = 43200
+
+// checkFlatFunc converts the settings of a check into the Terraform data structure of a specific check type
+type checkFlatFunc func(d *schema.ResourceData, check *pipelinechecks.CheckConfiguration, clients *aggregatedClient) error
+
+// checkExpandFunc converts the Terraform data structure of a specific check type into the type and the settings of a check
+type checkExpandFunc func(d *schema.ResourceData, clients *aggregatedClient) (*pipelinechecks.CheckType, interface{}, error)
+
+// checkTarget describes how the resource a check is configured on is referred to by a check resource
+type checkTarget struct {
+	// schema returns the schema elements that identify the resource of the check
+	schema func() map[string]*schema.Schema
+	// resourceTypes are the types of the resources the check can be configured on
+	resourceTypes []string
+	expand        func(d *schema.ResourceData) *pipelinechecks.Resource
+	flatten       func(d *schema.ResourceData, resource *pipelinechecks.Resource) error
+}
+
+// Checks that are configured on an environment given by environment_id
+var environmentCheckTarget = checkTarget{
+	schema: func() map[string]*schema.Schema {
+		return map[string]*schema.Schema{
+			"environment_id": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+		}
+	},
+	resourceTypes: []string{pipelinechecks.ResourceTypeEnvironment},
+	expand: func(d *schema.ResourceData) *pipelinechecks.Resource {
+		return &pipelinechecks.Resource{
+			Type: converter.String(pipelinechecks.ResourceTypeEnvironment),
+			Id:   converter.String(strconv.Itoa(d.Get("environment_id").(int))),
+		}
+	},
+	flatten: func(d *schema.ResourceData, resource *pipelinechecks.Resource) error {
+		environmentID, err := strconv.Atoi(*resource.Id)
+		if err != nil {
+			return fmt.Errorf("Error parsing the ID %s of the environment of check %s: %+v", *resource.Id, d.Id(), err)
+		}
+		d.Set("environment_id", environmentID)
+		return nil
+	},
+}
+
+// The types of the resources a check can be configured on through a target_resource block
+var checkTargetResourceTypes = []string{
+	pipelinechecks.ResourceTypeEnvironment,
+	pipelinechecks.ResourceTypeEndpoint,
+	pipelinechecks.ResourceTypeVariableGroup,
+}
+
+// Checks that are configured on an environment, a service connection or a variable group given by a
+// target_resource block
+var targetResourceCheckTarget = checkTarget{
+	schema: func() map[string]*schema.Schema {
+		return map[string]*schema.Schema{
+			"target_resource": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(checkTargetResourceTypes, false),
+						},
+						"id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+					},
+				},
+			},
+		}
+	},
+	resourceTypes: checkTargetResourceTypes,
+	expand: func(d *schema.ResourceData) *pipelinechecks.Resource {
+		target := expandSingleItemBlock(d, "target_resource")
+		resourceType, _ := target["type"].(string)
+		resourceID, _ := target["id"].(string)
+		return &pipelinechecks.Resource{
+			Type: converter.String(resourceType),
+			Id:   converter.String(resourceID),
+		}
+	},
+	flatten: func(d *schema.ResourceData, resource *pipelinechecks.Resource) error {
+		d.Set("target_resource", []interface{}{map[string]interface{}{
+			"type": strings.ToLower(converter.ToString(resource.Type, "")),
+			"id":   *resource.Id,
+		}})
+		return nil
+	},
+}
+
+// genBaseEnvironmentCheckResource creates a resource that shares the CRUD operations and the common schema
+// of every check of an environment. Callers add the schema elements specific to their check type.
+func genBaseEnvironmentCheckResource(f checkFlatFunc, e checkExpandFunc) *schema.Resource {
+	return genBaseCheckResource(f, e, environmentCheckTarget)
+}
+
+// genBaseCheckResource creates a resource that shares the CRUD operations and the common schema of every
+// check, which is configured on the resource described by the target
+func genBaseCheckResource(f checkFlatFunc, e checkExpandFunc, target checkTarget) *schema.Resource {
+	return &schema.Resource{
+		Create: genEnvironmentCheckCreateFunc(f, e, target),
+		Read:   genEnvironmentCheckReadFunc(f, target),
+		Update: genEnvironmentCheckUpdateFunc(f, e, target),
+		Delete: genEnvironmentCheckDeleteFunc(),
+		Schema: baseCheckSchema(target),
+	}
+}
+
+func baseCheckSchema(target checkTarget) map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"project_id": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.NoZeroValues,
+		},
+		"timeout": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      checkMaxTimeout,
+			ValidateFunc: validation.IntBetween(1, checkMaxTimeout),
+		},
+	}
+	for key, elem := range target.schema() {
+		s[key] = elem
+	}
+	return s
+}
+
+// doBaseEnvironmentCheckExpansion expands the attributes shared by every check
+func doBaseEnvironmentCheckExpansion(d *schema.ResourceData, checkType *pipelinechecks.CheckType, settings interface{}, target checkTarget) (*pipelinechecks.CheckConfiguration, *string, error) {
+	projectID := converter.String(d.Get("project_id").(string))
+	check := &pipelinechecks.CheckConfiguration{
+		Type:     checkType,
+		Resource: target.expand(d),
+		Settings: settings,
+		Timeout:  converter.Int(d.Get("timeout").(int)),
+	}
+
+	if d.Id() != "" {
+		checkID, err := strconv.Atoi(d.Id())
+		if err != nil {
+			return nil, nil, fmt.Errorf("Error parsing check ID %s: %+v", d.Id(), err)
+		}
+		check.Id = &checkID
+	}
+
+	return check, projectID, nil
+}
+
+// doBaseEnvironmentCheckFlattening flattens the attributes shared by every check
+func doBaseEnvironmentCheckFlattening(d *schema.ResourceData, check *pipelinechecks.CheckConfiguration, projectID *string, target checkTarget) error {
+	if check.Id == nil {
+		return fmt.Errorf("Check was not returned by the service")
+	}
+	d.SetId(strconv.Itoa(*check.Id))
+	d.Set("project_id", projectID)
+
+	if check.Resource != nil && check.Resource.Id != nil {
+		if err := target.flatten(d, check.Resource); err != nil {
+			return err
+		}
+	}
+	if check.Timeout != nil {
+		d.Set("timeout", *check.Timeout)
+	}
+	return nil
+}
+
+// Decodes the loosely typed settings of a check into a struct describing the settings
+func decodeCheckSettings(check *pipelinechecks.CheckConfiguration, settings interface{}) error {
+	raw, err := json.Marshal(check.Settings)
+	if err != nil {
+		return fmt.Errorf("Error reading the settings of the check: %+v", err)
+	}
+	if err := json.Unmarshal(raw, settings); err != nil {
+		return fmt.Errorf("Error reading the settings of the check: %+v", err)
+	}
+	return nil
+}
+
+func genEnvironmentCheckCreateFunc(flatFunc checkFlatFunc, expandFunc checkExpandFunc, target checkTarget) func(d *schema.ResourceData, m interface{}) error {
+	return func(d *schema.ResourceData, m interface{}) error {
+		clients := m.(*aggregatedClient)
+		check, projectID, err := doEnvironmentCheckExpansion(d, clients, expandFunc, target)
+		if err != nil {
+			return err
+		}
+
+		createdCheck, err := clients.PipelineChecksClient.AddCheckConfiguration(clients.ctx, pipelinechecks.AddCheckConfigurationArgs{
+			Configuration: check,
+			Project:       projectID,
+		})
+		if err != nil {
+			return fmt.Errorf("Error creating check of %s %s in Azure DevOps: %+v", *check.Resource.Type, *check.Resource.Id, err)
+		}
+
+		if err := doBaseEnvironmentCheckFlattening(d, createdCheck, projectID, target); err != nil {
+			return err
+		}
+		return genEnvironmentCheckReadFunc(flatFunc, target)(d, m)
+	}
+}
+
+// The settings of a check are only returned by the service if they are requested explicitly, so the check is
+// always read again after it has been created or updated
+func genEnvironmentCheckReadFunc(flatFunc checkFlatFunc, target checkTarget) func(d *schema.ResourceData, m interface{}) error {
+	return func(d *schema.ResourceData, m interface{}) error {
+		clients := m.(*aggregatedClient)
+		projectID := converter.String(d.Get("project_id").(string))
+		checkID, err := strconv.Atoi(d.Id())
+		if err != nil {
+			return fmt.Errorf("Error parsing check ID %s: %+v", d.Id(), err)
+		}
+
+		check, err := clients.PipelineChecksClient.GetCheckConfiguration(clients.ctx, pipelinechecks.GetCheckConfigurationArgs{
+			Id:      &checkID,
+			Project: projectID,
+		})
+		if err != nil {
+			// the check, or the resource it belongs to, was deleted outside of Terraform
+			if azdoerror.IsNotFound(err) {
+				d.SetId("")
+				return nil
+			}
+			return fmt.Errorf("Error looking up check with ID (%v) and project ID (%v): %v", checkID, *projectID, err)
+		}
+
+		if err := doBaseEnvironmentCheckFlattening(d, check, projectID, target); err != nil {
+			return err
+		}
+		return flatFunc(d, check, clients)
+	}
+}
+
+func genEnvironmentCheckUpdateFunc(flatFunc checkFlatFunc, expandFunc checkExpandFunc, target checkTarget) func(d *schema.ResourceData, m interface{}) error {
+	return func(d *schema.ResourceData, m interface{}) error {
+		clients := m.(*aggregatedClient)
+		check, projectID, err := doEnvironmentCheckExpansion(d, clients, expandFunc, target)
+		if err != nil {
+			return err
+		}
+
+		_, err = clients.PipelineChecksClient.UpdateCheckConfiguration(clients.ctx, pipelinechecks.UpdateCheckConfigurationArgs{
+			Id:            check.Id,
+			Configuration: check,
+			Project:       projectID,
+		})
+		if err != nil {
+			return fmt.Errorf("Error updating check in Azure DevOps: %+v", err)
+		}
+
+		return genEnvironmentCheckReadFunc(flatFunc, target)(d, m)
+	}
+}
+
+func genEnvironmentCheckDeleteFunc() func(d *schema.ResourceData, m interface{}) error {
+	return func(d *schema.ResourceData, m interface{}) error {
+		clients := m.(*aggregatedClient)
+		projectID := converter.String(d.Get("project_id").(string))
+		checkID, err := strconv.Atoi(d.Id())
+		if err != nil {
+			return fmt.Errorf("Error parsing check ID %s: %+v", d.Id(), err)
+		}
+
+		err = clients.PipelineChecksClient.DeleteCheckConfiguration(clients.ctx, pipelinechecks.DeleteCheckConfigurationArgs{
+			Id:      &checkID,
+			Project: projectID,
+		})
+		if err != nil && !azdoerror.IsNotFound(err) {
+			return fmt.Errorf("Error deleting check in Azure DevOps: %+v", err)
+		}
+
+		d.SetId("")
+		return nil
+	}
+}
+
+// genEnvironmentCheckImportFunc creates an importer for checks of specific types of an environment
+func genEnvironmentCheckImportFunc(checkTypeIDs ...uuid.UUID) func(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	return genCheckImportFunc(environmentCheckTarget, checkTypeIDs...)
+}
+
+// genCheckImportFunc creates an importer for checks of specific types. Checks are imported by the project ID
+// and the check ID, and importing a check of another type, or of another type of resource, is rejected.
+func genCheckImportFunc(target checkTarget, checkTypeIDs ...uuid.UUID) func(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	return func(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+		parts := strings.SplitN(d.Id(), "/", 2)
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return nil, fmt.Errorf("Unexpected format of ID (%s), expected projectid/checkid", d.Id())
+		}
+
+		checkID, err := strconv.Atoi(parts[1])
+		if err != nil {
+			return nil, fmt.Errorf("Check ID (%s) is not a valid integer", parts[1])
+		}
+
+		clients := m.(*aggregatedClient)
+		check, err := clients.PipelineChecksClient.GetCheckConfiguration(clients.ctx, pipelinechecks.GetCheckConfigurationArgs{
+			Id:      &checkID,
+			Project: &parts[0],
+		})
+		if err != nil {
+			return nil, fmt.Errorf("Error looking up check with ID (%v) and project ID (%v): %v", checkID, parts[0], err)
+		}
+		if !isCheckOfType(check, checkTypeIDs...) {
+			return nil, fmt.Errorf("Check with ID (%v) is not a check of the type of this resource", checkID)
+		}
+		if check.Resource == nil || !isCheckResourceOfType(check.Resource, target.resourceTypes) {
+			return nil, fmt.Errorf("Check with ID (%v) is not a check of a resource of type %s", checkID, strings.Join(target.resourceTypes, ", "))
+		}
+
+		d.Set("project_id", parts[0])
+		d.SetId(parts[1])
+		return []*schema.ResourceData{d}, nil
+	}
+}
+
+func isCheckOfType(check *pipelinechecks.CheckConfiguration, checkTypeIDs ...uuid.UUID) bool {
+	if check.Type == nil || check.Type.Id == nil {
+		return false
+	}
+	for _, checkTypeID := range checkTypeIDs {
+		if *check.Type.Id == checkTypeID {
+			return true
+		}
+	}
+	return false
+}
+
+func isCheckResourceOfType(resource *pipelinechecks.Resource, resourceTypes []string) bool {
+	for _, resourceType := range resourceTypes {
+		if strings.EqualFold(converter.ToString(resource.Type, ""), resourceType) {
+			return true
+		}
+	}
+	return false
+}
+
+// Expands a check of a specific type together with the attributes shared by every check
+func doEnvironmentCheckExpansion(d *schema.ResourceData, clients *aggregatedClient, expandFunc checkExpandFunc, target checkTarget) (*pipelinechecks.CheckConfiguration, *string, error) {
+	checkType, settings, err := expandFunc(d, clients)
+	if err != nil {
+		return nil, nil, fmt.Errorf("Error converting terraform data model to AzDO check: %+v", err)
+	}
+
+	check, projectID, err := doBaseEnvironmentCheckExpansion(d, checkType, settings, target)
+	if err != nil {
+		return nil, nil, fmt.Errorf("Error converting terraform data model to AzDO check: %+v", err)
+	}
+	return check, projectID, nil
+}
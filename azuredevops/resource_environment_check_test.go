@@ -0,0 +1,271 @@
+package azuredevops
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/pipelinechecks"
+	"github.com/stretchr/testify/require"
+)
+
+var testEnvironmentCheckID = 13
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that business hours are expanded into a check that runs the business hours task, and flattened back
+func TestAzureDevOpsEnvironmentCheck_BusinessHours_ExpandFlatten_Roundtrip(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, resourceEnvironmentCheck().Schema, map[string]interface{}{
+		"project_id":     testApprovalProjectID,
+		"environment_id": 7,
+		"business_hours": []interface{}{map[string]interface{}{
+			"days":       []interface{}{"Friday", "Monday"},
+			"start_time": "08:00",
+			"end_time":   "17:30",
+			"time_zone":  "W. Europe Standard Time",
+		}},
+	})
+
+	checkType, settings, err := expandEnvironmentCheck(resourceData, nil)
+	require.Nil(t, err)
+	require.Equal(t, pipelinechecks.TaskCheckTypeID, *checkType.Id)
+	taskSettings := settings.(taskCheckSettings)
+	require.Equal(t, businessHoursTaskID.String(), taskSettings.DefinitionRef.ID)
+	require.Equal(t, map[string]string{
+		"businessDays": "Monday,Friday",
+		"startTime":    "08:00",
+		"endTime":      "17:30",
+		"timeZone":     "W. Europe Standard Time",
+	}, taskSettings.Inputs)
+
+	flattenedData := schema.TestResourceDataRaw(t, resourceEnvironmentCheck().Schema, nil)
+	flattenedData.SetId(strconv.Itoa(testEnvironmentCheckID))
+	check := &pipelinechecks.CheckConfiguration{Id: &testEnvironmentCheckID, Type: checkType, Settings: settings}
+	err = flattenEnvironmentCheck(flattenedData, check, nil)
+	require.Nil(t, err)
+	require.Equal(t, resourceData.Get("business_hours.0.days").(*schema.Set).List(), flattenedData.Get("business_hours.0.days").(*schema.Set).List())
+	require.Equal(t, "08:00", flattenedData.Get("business_hours.0.start_time"))
+	require.Equal(t, "17:30", flattenedData.Get("business_hours.0.end_time"))
+	require.Equal(t, "W. Europe Standard Time", flattenedData.Get("business_hours.0.time_zone"))
+	require.Empty(t, flattenedData.Get("required_template"))
+}
+
+// verifies that required templates are expanded into an extends check, and flattened back
+func TestAzureDevOpsEnvironmentCheck_RequiredTemplate_ExpandFlatten_Roundtrip(t *testing.T) {
+	templates := []interface{}{map[string]interface{}{
+		"repository_type": "git",
+		"repository_name": "project/templates",
+		"repository_ref":  "refs/heads/master",
+		"template_path":   "deploy.yml",
+	}}
+	resourceData := schema.TestResourceDataRaw(t, resourceEnvironmentCheck().Schema, map[string]interface{}{
+		"project_id":        testApprovalProjectID,
+		"environment_id":    7,
+		"required_template": templates,
+	})
+
+	checkType, settings, err := expandEnvironmentCheck(resourceData, nil)
+	require.Nil(t, err)
+	require.Equal(t, pipelinechecks.ExtendsCheckTypeID, *checkType.Id)
+	require.Equal(t, extendsCheckSettings{ExtendsChecks: []requiredTemplate{{
+		RepositoryType: "git",
+		RepositoryName: "project/templates",
+		RepositoryRef:  "refs/heads/master",
+		TemplatePath:   "deploy.yml",
+	}}}, settings)
+
+	flattenedData := schema.TestResourceDataRaw(t, resourceEnvironmentCheck().Schema, nil)
+	flattenedData.SetId(strconv.Itoa(testEnvironmentCheckID))
+	check := &pipelinechecks.CheckConfiguration{Id: &testEnvironmentCheckID, Type: checkType, Settings: settings}
+	err = flattenEnvironmentCheck(flattenedData, check, nil)
+	require.Nil(t, err)
+	require.Equal(t, templates, flattenedData.Get("required_template"))
+	require.Empty(t, flattenedData.Get("business_hours"))
+}
+
+// verifies that checks that run other tasks than the business hours task are reported instead of being misread
+func TestAzureDevOpsEnvironmentCheck_Flatten_RejectsOtherTasks(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, resourceEnvironmentCheck().Schema, nil)
+	check := &pipelinechecks.CheckConfiguration{
+		Id:   &testEnvironmentCheckID,
+		Type: &pipelinechecks.CheckType{Id: &pipelinechecks.TaskCheckTypeID},
+		Settings: map[string]interface{}{
+			"definitionRef": map[string]interface{}{"id": "537fdb7a-a601-4537-aa70-92645a2b5ce4", "name": "AzureFunction"},
+		},
+	}
+
+	err := flattenEnvironmentCheck(resourceData, check, nil)
+	require.NotNil(t, err)
+}
+
+// verifies that exactly one kind of check needs to be configured
+func TestAzureDevOpsEnvironmentCheck_CustomizeDiff_RequiresExactlyOneKind(t *testing.T) {
+	base := map[string]interface{}{
+		"project_id":     testApprovalProjectID,
+		"environment_id": 7,
+	}
+	_, err := resourceEnvironmentCheck().Diff(nil, terraform.NewResourceConfigRaw(base), nil)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "exactly one of business_hours or required_template")
+
+	base["required_template"] = []interface{}{map[string]interface{}{
+		"repository_name": "project/templates",
+		"repository_ref":  "refs/heads/master",
+		"template_path":   "deploy.yml",
+	}}
+	_, err = resourceEnvironmentCheck().Diff(nil, terraform.NewResourceConfigRaw(base), nil)
+	require.Nil(t, err)
+}
+
+// verifies that the check is replaced if its kind changes, since the type of a check cannot be updated
+func TestAzureDevOpsEnvironmentCheck_CustomizeDiff_ReplacesCheckOfOtherKind(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: strconv.Itoa(testEnvironmentCheckID),
+		Attributes: map[string]string{
+			"id":                               strconv.Itoa(testEnvironmentCheckID),
+			"project_id":                       testApprovalProjectID,
+			"environment_id":                   "7",
+			"timeout":                          "43200",
+			"business_hours.#":                 "1",
+			"business_hours.0.days.#":          "1",
+			"business_hours.0.days.3632146495": "Monday",
+			"business_hours.0.start_time":      "08:00",
+			"business_hours.0.end_time":        "17:00",
+			"business_hours.0.time_zone":       "UTC",
+			"required_template.#":              "0",
+		},
+	}
+	cfg := map[string]interface{}{
+		"project_id":     testApprovalProjectID,
+		"environment_id": 7,
+		"required_template": []interface{}{map[string]interface{}{
+			"repository_name": "project/templates",
+			"repository_ref":  "refs/heads/master",
+			"template_path":   "deploy.yml",
+		}},
+	}
+
+	diff, err := resourceEnvironmentCheck().Diff(state, terraform.NewResourceConfigRaw(cfg), nil)
+	require.Nil(t, err)
+	require.True(t, diff.RequiresNew())
+}
+
+// verifies that the check is read again after an update, since the service only returns its settings on request
+func TestAzureDevOpsEnvironmentCheck_Update_ReadsSettings(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	checksClient := azdosdkmocks.NewMockPipelineChecksClient(ctrl)
+	clients := &aggregatedClient{PipelineChecksClient: checksClient, ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceEnvironmentCheck().Schema, map[string]interface{}{
+		"project_id":     testApprovalProjectID,
+		"environment_id": 7,
+		"timeout":        30,
+		"business_hours": []interface{}{map[string]interface{}{
+			"days":       []interface{}{"Monday"},
+			"start_time": "08:00",
+			"end_time":   "17:00",
+			"time_zone":  "UTC",
+		}},
+	})
+	resourceData.SetId(strconv.Itoa(testEnvironmentCheckID))
+
+	checkType, settings, _ := expandEnvironmentCheck(resourceData, clients)
+	checksClient.
+		EXPECT().
+		UpdateCheckConfiguration(clients.ctx, pipelinechecks.UpdateCheckConfigurationArgs{
+			Project: &testApprovalProjectID,
+			Id:      &testEnvironmentCheckID,
+			Configuration: &pipelinechecks.CheckConfiguration{
+				Id:       &testEnvironmentCheckID,
+				Type:     checkType,
+				Resource: &pipelinechecks.Resource{Type: converter.String("environment"), Id: converter.String("7")},
+				Settings: settings,
+				Timeout:  converter.Int(30),
+			},
+		}).
+		Return(&pipelinechecks.CheckConfiguration{Id: &testEnvironmentCheckID}, nil).
+		Times(1)
+	checksClient.
+		EXPECT().
+		GetCheckConfiguration(clients.ctx, pipelinechecks.GetCheckConfigurationArgs{Project: &testApprovalProjectID, Id: &testEnvironmentCheckID}).
+		Return(&pipelinechecks.CheckConfiguration{
+			Id:       &testEnvironmentCheckID,
+			Type:     checkType,
+			Resource: &pipelinechecks.Resource{Type: converter.String("environment"), Id: converter.String("7")},
+			Settings: settings,
+			Timeout:  converter.Int(30),
+		}, nil).
+		Times(1)
+
+	err := resourceEnvironmentCheck().Update(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, 30, resourceData.Get("timeout"))
+}
+
+/**
+ * Begin acceptance tests
+ */
+
+// validates that a business hours check can be added to an environment, updated and imported
+func TestAccAzureDevOpsEnvironmentCheck_BusinessHours_CreateUpdateImport(t *testing.T) {
+	projectName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	environmentName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	tfNode := "azuredevops_environment_check.check"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccEnvironmentCheckCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentCheckBusinessHoursResource(projectName, environmentName, "17:00"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfNode, "id"),
+					resource.TestCheckResourceAttr(tfNode, "business_hours.0.days.#", "2"),
+					resource.TestCheckResourceAttr(tfNode, "business_hours.0.end_time", "17:00"),
+				),
+			}, {
+				Config: testAccEnvironmentCheckBusinessHoursResource(projectName, environmentName, "18:00"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "business_hours.0.end_time", "18:00"),
+				),
+			}, {
+				ResourceName:      tfNode,
+				ImportStateIdFunc: testAccEnvironmentCheckImportStateID(tfNode),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// HCL describing business hours of an environment
+func testAccEnvironmentCheckBusinessHoursResource(projectName string, environmentName string, endTime string) string {
+	checkResource := fmt.Sprintf(`
+resource "azuredevops_environment_check" "check" {
+	project_id     = azuredevops_project.project.id
+	environment_id = azuredevops_environment.environment.id
+
+	business_hours {
+		days       = ["Monday", "Tuesday"]
+		start_time = "08:00"
+		end_time   = "%s"
+		time_zone  = "UTC"
+	}
+}`, endTime)
+
+	environmentResource := testAccEnvironmentResource(projectName, environmentName, "")
+	return fmt.Sprintf("%s\n%s", environmentResource, checkResource)
+}
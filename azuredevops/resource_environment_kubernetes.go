@@ -0,0 +1,190 @@
+package azuredevops
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/azdoerror"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/environment"
+)
+
+// The service has no API to update the resources of an environment, so every change replaces the resource
+func resourceEnvironmentKubernetes() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceEnvironmentKubernetesCreate,
+		Read:   resourceEnvironmentKubernetesRead,
+		Delete: resourceEnvironmentKubernetesDelete,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"environment_id": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"service_endpoint_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"namespace": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"cluster_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "",
+			},
+			"tags": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.NoZeroValues,
+				},
+				Set: schema.HashString,
+			},
+		},
+	}
+}
+
+func resourceEnvironmentKubernetesCreate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	projectID := d.Get("project_id").(string)
+	environmentID := d.Get("environment_id").(int)
+
+	parameters, err := expandEnvironmentKubernetes(d)
+	if err != nil {
+		return fmt.Errorf("Error converting terraform data model to AzDO kubernetes resource: %+v", err)
+	}
+
+	resource, err := clients.EnvironmentClient.AddKubernetesResource(clients.ctx, environment.AddKubernetesResourceArgs{
+		CreateParameters: parameters,
+		Project:          &projectID,
+		EnvironmentId:    &environmentID,
+	})
+	if err != nil {
+		return fmt.Errorf("Error adding namespace %s to environment %d. Error: %v", *parameters.Namespace, environmentID, err)
+	}
+	if resource == nil || resource.Id == nil {
+		return fmt.Errorf("Kubernetes resource of environment %d was not returned by the service", environmentID)
+	}
+
+	d.SetId(strconv.Itoa(*resource.Id))
+	return resourceEnvironmentKubernetesRead(d, m)
+}
+
+func resourceEnvironmentKubernetesRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	projectID := d.Get("project_id").(string)
+	environmentID := d.Get("environment_id").(int)
+	resourceID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return fmt.Errorf("Error parsing kubernetes resource ID %s: %+v", d.Id(), err)
+	}
+
+	resource, err := clients.EnvironmentClient.GetKubernetesResource(clients.ctx, environment.GetKubernetesResourceArgs{
+		Project:       &projectID,
+		EnvironmentId: &environmentID,
+		ResourceId:    &resourceID,
+	})
+	if err != nil {
+		// the resource, or the environment it belongs to, was deleted outside of Terraform
+		if azdoerror.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error looking up kubernetes resource with ID %d of environment %d. Error: %v", resourceID, environmentID, err)
+	}
+	if resource == nil || resource.Id == nil {
+		d.SetId("")
+		return nil
+	}
+
+	flattenEnvironmentKubernetes(d, resource)
+	return nil
+}
+
+// Only removes the namespace from the environment. Both the environment and the service endpoint are kept.
+func resourceEnvironmentKubernetesDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	projectID := d.Get("project_id").(string)
+	environmentID := d.Get("environment_id").(int)
+	resourceID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return fmt.Errorf("Error parsing kubernetes resource ID %s: %+v", d.Id(), err)
+	}
+
+	err = clients.EnvironmentClient.DeleteKubernetesResource(clients.ctx, environment.DeleteKubernetesResourceArgs{
+		Project:       &projectID,
+		EnvironmentId: &environmentID,
+		ResourceId:    &resourceID,
+	})
+	if err != nil && !azdoerror.IsNotFound(err) {
+		return fmt.Errorf("Error deleting kubernetes resource with ID %d of environment %d. Error: %v", resourceID, environmentID, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// Convert internal Terraform data structure to an AzDO data structure. The resource is named after its namespace,
+// like the resources added through the web UI.
+func expandEnvironmentKubernetes(d *schema.ResourceData) (*environment.KubernetesResourceCreateParameters, error) {
+	serviceEndpointID, err := uuid.Parse(d.Get("service_endpoint_id").(string))
+	if err != nil {
+		return nil, fmt.Errorf("Invalid service_endpoint_id UUID: %s", d.Get("service_endpoint_id"))
+	}
+
+	tags := []string{}
+	for _, tag := range d.Get("tags").(*schema.Set).List() {
+		tags = append(tags, tag.(string))
+	}
+	sort.Strings(tags)
+
+	namespace := d.Get("namespace").(string)
+	return &environment.KubernetesResourceCreateParameters{
+		Name:              converter.String(namespace),
+		Namespace:         converter.String(namespace),
+		ClusterName:       converter.String(d.Get("cluster_name").(string)),
+		ServiceEndpointId: &serviceEndpointID,
+		Tags:              &tags,
+	}, nil
+}
+
+// Convert AzDO data structure to internal Terraform data structure
+func flattenEnvironmentKubernetes(d *schema.ResourceData, resource *environment.KubernetesResource) {
+	d.SetId(strconv.Itoa(*resource.Id))
+	if resource.EnvironmentReference != nil && resource.EnvironmentReference.Id != nil {
+		d.Set("environment_id", *resource.EnvironmentReference.Id)
+	}
+	if resource.ServiceEndpointId != nil {
+		d.Set("service_endpoint_id", resource.ServiceEndpointId.String())
+	}
+	d.Set("namespace", converter.ToString(resource.Namespace, ""))
+	d.Set("cluster_name", converter.ToString(resource.ClusterName, ""))
+
+	var tags []interface{}
+	if resource.Tags != nil {
+		for _, tag := range *resource.Tags {
+			tags = append(tags, tag)
+		}
+	}
+	d.Set("tags", schema.NewSet(schema.HashString, tags))
+}
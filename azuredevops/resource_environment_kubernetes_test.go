@@ -0,0 +1,240 @@
+package azuredevops
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/environment"
+	"github.com/stretchr/testify/require"
+)
+
+var testKubernetesResourceID = 3
+var testKubernetesServiceEndpointID = uuid.New()
+
+var testKubernetesResource = environment.KubernetesResource{
+	Id:                   &testKubernetesResourceID,
+	EnvironmentReference: &taskagent.EnvironmentReference{Id: &testEnvironmentID},
+	Name:                 converter.String("web"),
+	Namespace:            converter.String("web"),
+	ClusterName:          converter.String("aks-westeurope"),
+	ServiceEndpointId:    &testKubernetesServiceEndpointID,
+	Tags:                 &[]string{"gitops", "web"},
+}
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that the namespace is added to the environment with the configured service endpoint and tags
+func TestAzureDevOpsEnvironmentKubernetes_Create_AddsResource(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	environmentClient := azdosdkmocks.NewMockEnvironmentClient(ctrl)
+	clients := &aggregatedClient{EnvironmentClient: environmentClient, ctx: context.Background()}
+
+	resourceData := createEnvironmentKubernetesResourceData(t)
+
+	environmentClient.
+		EXPECT().
+		AddKubernetesResource(clients.ctx, environment.AddKubernetesResourceArgs{
+			Project:       &testEnvironmentProjectID,
+			EnvironmentId: &testEnvironmentID,
+			CreateParameters: &environment.KubernetesResourceCreateParameters{
+				Name:              converter.String("web"),
+				Namespace:         converter.String("web"),
+				ClusterName:       converter.String("aks-westeurope"),
+				ServiceEndpointId: &testKubernetesServiceEndpointID,
+				Tags:              &[]string{"gitops", "web"},
+			},
+		}).
+		Return(&testKubernetesResource, nil).
+		Times(1)
+	expectGetKubernetesResource(environmentClient).
+		Return(&testKubernetesResource, nil).
+		Times(1)
+
+	err := resourceEnvironmentKubernetesCreate(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, strconv.Itoa(testKubernetesResourceID), resourceData.Id())
+}
+
+// verifies that a resource removed outside of Terraform is removed from the state
+func TestAzureDevOpsEnvironmentKubernetes_Read_ClearsIdIfResourceWasDeleted(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	environmentClient := azdosdkmocks.NewMockEnvironmentClient(ctrl)
+	clients := &aggregatedClient{EnvironmentClient: environmentClient, ctx: context.Background()}
+
+	resourceData := createEnvironmentKubernetesResourceData(t)
+	resourceData.SetId(strconv.Itoa(testKubernetesResourceID))
+
+	notFound := 404
+	expectGetKubernetesResource(environmentClient).
+		Return(nil, azuredevops.WrappedError{StatusCode: &notFound}).
+		Times(1)
+
+	err := resourceEnvironmentKubernetesRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "", resourceData.Id())
+}
+
+// verifies that the resource is read back into the state
+func TestAzureDevOpsEnvironmentKubernetes_Read_FlattensResource(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	environmentClient := azdosdkmocks.NewMockEnvironmentClient(ctrl)
+	clients := &aggregatedClient{EnvironmentClient: environmentClient, ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceEnvironmentKubernetes().Schema, map[string]interface{}{
+		"project_id":     testEnvironmentProjectID,
+		"environment_id": testEnvironmentID,
+	})
+	resourceData.SetId(strconv.Itoa(testKubernetesResourceID))
+
+	expectGetKubernetesResource(environmentClient).
+		Return(&testKubernetesResource, nil).
+		Times(1)
+
+	err := resourceEnvironmentKubernetesRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, testKubernetesServiceEndpointID.String(), resourceData.Get("service_endpoint_id"))
+	require.Equal(t, "web", resourceData.Get("namespace"))
+	require.Equal(t, "aks-westeurope", resourceData.Get("cluster_name"))
+	require.ElementsMatch(t, []interface{}{"gitops", "web"}, resourceData.Get("tags").(*schema.Set).List())
+}
+
+// verifies that deleting the resource only removes it from the environment, and that errors are not swallowed
+func TestAzureDevOpsEnvironmentKubernetes_Delete_OnlyRemovesResource(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	environmentClient := azdosdkmocks.NewMockEnvironmentClient(ctrl)
+	clients := &aggregatedClient{EnvironmentClient: environmentClient, ctx: context.Background()}
+
+	resourceData := createEnvironmentKubernetesResourceData(t)
+	resourceData.SetId(strconv.Itoa(testKubernetesResourceID))
+
+	environmentClient.
+		EXPECT().
+		DeleteKubernetesResource(clients.ctx, environment.DeleteKubernetesResourceArgs{
+			Project:       &testEnvironmentProjectID,
+			EnvironmentId: &testEnvironmentID,
+			ResourceId:    &testKubernetesResourceID,
+		}).
+		Return(errors.New("DeleteKubernetesResource() Failed")).
+		Times(1)
+	environmentClient.
+		EXPECT().
+		DeleteEnvironment(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	err := resourceEnvironmentKubernetesDelete(resourceData, clients)
+	require.Contains(t, err.Error(), "DeleteKubernetesResource() Failed")
+}
+
+// verifies that a service endpoint ID that is not a UUID is rejected before calling the service
+func TestAzureDevOpsEnvironmentKubernetes_Create_RejectsInvalidServiceEndpointID(t *testing.T) {
+	resourceData := createEnvironmentKubernetesResourceData(t)
+	resourceData.Set("service_endpoint_id", "not-a-uuid")
+
+	err := resourceEnvironmentKubernetesCreate(resourceData, &aggregatedClient{ctx: context.Background()})
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "service_endpoint_id")
+}
+
+func createEnvironmentKubernetesResourceData(t *testing.T) *schema.ResourceData {
+	return schema.TestResourceDataRaw(t, resourceEnvironmentKubernetes().Schema, map[string]interface{}{
+		"project_id":          testEnvironmentProjectID,
+		"environment_id":      testEnvironmentID,
+		"service_endpoint_id": testKubernetesServiceEndpointID.String(),
+		"namespace":           "web",
+		"cluster_name":        "aks-westeurope",
+		"tags":                []interface{}{"web", "gitops"},
+	})
+}
+
+func expectGetKubernetesResource(environmentClient *azdosdkmocks.MockEnvironmentClient) *gomock.Call {
+	return environmentClient.
+		EXPECT().
+		GetKubernetesResource(gomock.Any(), environment.GetKubernetesResourceArgs{
+			Project:       &testEnvironmentProjectID,
+			EnvironmentId: &testEnvironmentID,
+			ResourceId:    &testKubernetesResourceID,
+		})
+}
+
+/**
+ * Begin acceptance tests
+ */
+
+// validates that a namespace can be added to an environment and removed again, while the environment is kept
+func TestAccAzureDevOpsEnvironmentKubernetes_CreateAndDelete(t *testing.T) {
+	projectName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	serviceEndpointName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	environmentName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	tfNode := "azuredevops_environment_kubernetes.kubernetes"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccEnvironmentCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentKubernetesResource(projectName, serviceEndpointName, environmentName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfNode, "id"),
+					resource.TestCheckResourceAttr(tfNode, "namespace", "default"),
+					resource.TestCheckResourceAttr(tfNode, "tags.#", "1"),
+				),
+			}, {
+				// removing the namespace keeps the environment
+				Config: testAccEnvironmentKubernetesResourceRemoved(projectName, serviceEndpointName, environmentName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("azuredevops_environment.environment", "id"),
+				),
+			},
+		},
+	})
+}
+
+// HCL describing a namespace of a Kubernetes cluster within an environment
+func testAccEnvironmentKubernetesResource(projectName string, serviceEndpointName string, environmentName string) string {
+	kubernetesResource := `
+resource "azuredevops_environment_kubernetes" "kubernetes" {
+	project_id          = azuredevops_project.project.id
+	environment_id      = azuredevops_environment.environment.id
+	service_endpoint_id = azuredevops_serviceendpoint_kubernetes.serviceendpoint.id
+	namespace           = "default"
+	cluster_name        = "sample-kubernetes-cluster"
+	tags                = ["gitops"]
+}`
+
+	return fmt.Sprintf("%s\n%s", testAccEnvironmentKubernetesResourceRemoved(projectName, serviceEndpointName, environmentName), kubernetesResource)
+}
+
+// HCL describing an environment and a Kubernetes service endpoint without any namespace bound to the environment
+func testAccEnvironmentKubernetesResourceRemoved(projectName string, serviceEndpointName string, environmentName string) string {
+	environmentResource := fmt.Sprintf(`
+resource "azuredevops_environment" "environment" {
+	project_id = azuredevops_project.project.id
+	name       = "%s"
+}`, environmentName)
+
+	serviceEndpointResource := testAccServiceEndpointKubernetesResource(projectName, serviceEndpointName)
+	return fmt.Sprintf("%s\n%s", serviceEndpointResource, environmentResource)
+}
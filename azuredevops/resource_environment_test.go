@@ -0,0 +1,292 @@
+package azuredevops
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/microsoft/azure-devops-go-api/azuredevops"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/environment"
+	"github.com/stretchr/testify/require"
+)
+
+var testEnvironmentID = 7
+var testEnvironmentProjectID = uuid.New().String()
+
+var testEnvironment = taskagent.EnvironmentInstance{
+	Id:          &testEnvironmentID,
+	Name:        converter.String("production"),
+	Description: converter.String("Production clusters"),
+}
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that an environment is created if there is none with the configured name
+func TestAzureDevOpsEnvironment_Create_AddsEnvironment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	environmentClient := azdosdkmocks.NewMockEnvironmentClient(ctrl)
+	clients := &aggregatedClient{EnvironmentClient: environmentClient, ctx: context.Background()}
+
+	resourceData := createEnvironmentResourceData(t)
+
+	environmentClient.
+		EXPECT().
+		GetEnvironments(clients.ctx, environment.GetEnvironmentsArgs{Project: &testEnvironmentProjectID, Name: converter.String("production")}).
+		Return(&[]taskagent.EnvironmentInstance{}, nil).
+		Times(1)
+	environmentClient.
+		EXPECT().
+		AddEnvironment(clients.ctx, environment.AddEnvironmentArgs{
+			EnvironmentCreateParameter: &taskagent.EnvironmentCreateParameter{
+				Name:        converter.String("production"),
+				Description: converter.String("Production clusters"),
+			},
+			Project: &testEnvironmentProjectID,
+		}).
+		Return(&testEnvironment, nil).
+		Times(1)
+	expectGetEnvironmentByID(environmentClient, &testEnvironment)
+
+	err := resourceEnvironmentCreate(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, strconv.Itoa(testEnvironmentID), resourceData.Id())
+}
+
+// verifies that an environment created by a pipeline run is adopted, and that its description is updated
+func TestAzureDevOpsEnvironment_Create_AdoptsExistingEnvironment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	environmentClient := azdosdkmocks.NewMockEnvironmentClient(ctrl)
+	clients := &aggregatedClient{EnvironmentClient: environmentClient, ctx: context.Background()}
+
+	resourceData := createEnvironmentResourceData(t)
+
+	autoCreated := testEnvironment
+	autoCreated.Name = converter.String("Production")
+	autoCreated.Description = converter.String("")
+	environmentClient.
+		EXPECT().
+		GetEnvironments(clients.ctx, gomock.Any()).
+		Return(&[]taskagent.EnvironmentInstance{autoCreated}, nil).
+		Times(1)
+	environmentClient.
+		EXPECT().
+		AddEnvironment(gomock.Any(), gomock.Any()).
+		Times(0)
+	environmentClient.
+		EXPECT().
+		UpdateEnvironment(clients.ctx, environment.UpdateEnvironmentArgs{
+			EnvironmentUpdateParameter: &taskagent.EnvironmentUpdateParameter{
+				Name:        converter.String("production"),
+				Description: converter.String("Production clusters"),
+			},
+			Project:       &testEnvironmentProjectID,
+			EnvironmentId: &testEnvironmentID,
+		}).
+		Return(&testEnvironment, nil).
+		Times(1)
+	expectGetEnvironmentByID(environmentClient, &testEnvironment)
+
+	err := resourceEnvironmentCreate(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, strconv.Itoa(testEnvironmentID), resourceData.Id())
+	require.Equal(t, "Production clusters", resourceData.Get("description"))
+}
+
+// verifies that an environment deleted outside of Terraform is removed from the state
+func TestAzureDevOpsEnvironment_Read_ClearsIdIfEnvironmentWasDeleted(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	environmentClient := azdosdkmocks.NewMockEnvironmentClient(ctrl)
+	clients := &aggregatedClient{EnvironmentClient: environmentClient, ctx: context.Background()}
+
+	resourceData := createEnvironmentResourceData(t)
+	resourceData.SetId(strconv.Itoa(testEnvironmentID))
+
+	notFound := 404
+	environmentClient.
+		EXPECT().
+		GetEnvironmentById(clients.ctx, gomock.Any()).
+		Return(nil, azuredevops.WrappedError{StatusCode: &notFound}).
+		Times(1)
+
+	err := resourceEnvironmentRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "", resourceData.Id())
+}
+
+// verifies that changes made outside of Terraform are detected
+func TestAzureDevOpsEnvironment_Read_ReconcilesState(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	environmentClient := azdosdkmocks.NewMockEnvironmentClient(ctrl)
+	clients := &aggregatedClient{EnvironmentClient: environmentClient, ctx: context.Background()}
+
+	resourceData := createEnvironmentResourceData(t)
+	resourceData.SetId(strconv.Itoa(testEnvironmentID))
+
+	renamed := testEnvironment
+	renamed.Name = converter.String("prod")
+	renamed.Description = nil
+	expectGetEnvironmentByID(environmentClient, &renamed)
+
+	err := resourceEnvironmentRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "prod", resourceData.Get("name"))
+	require.Equal(t, "", resourceData.Get("description"))
+}
+
+// verifies that if an error is produced on create, the error is not swallowed
+func TestAzureDevOpsEnvironment_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	environmentClient := azdosdkmocks.NewMockEnvironmentClient(ctrl)
+	clients := &aggregatedClient{EnvironmentClient: environmentClient, ctx: context.Background()}
+
+	resourceData := createEnvironmentResourceData(t)
+
+	environmentClient.
+		EXPECT().
+		GetEnvironments(clients.ctx, gomock.Any()).
+		Return(nil, nil).
+		Times(1)
+	environmentClient.
+		EXPECT().
+		AddEnvironment(clients.ctx, gomock.Any()).
+		Return(nil, errors.New("AddEnvironment() Failed")).
+		Times(1)
+
+	err := resourceEnvironmentCreate(resourceData, clients)
+	require.Contains(t, err.Error(), "AddEnvironment() Failed")
+}
+
+// verifies that if an error is produced on a delete, it is not swallowed
+func TestAzureDevOpsEnvironment_Delete_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	environmentClient := azdosdkmocks.NewMockEnvironmentClient(ctrl)
+	clients := &aggregatedClient{EnvironmentClient: environmentClient, ctx: context.Background()}
+
+	resourceData := createEnvironmentResourceData(t)
+	resourceData.SetId(strconv.Itoa(testEnvironmentID))
+
+	environmentClient.
+		EXPECT().
+		DeleteEnvironment(clients.ctx, environment.DeleteEnvironmentArgs{Project: &testEnvironmentProjectID, EnvironmentId: &testEnvironmentID}).
+		Return(errors.New("DeleteEnvironment() Failed")).
+		Times(1)
+
+	err := resourceEnvironmentDelete(resourceData, clients)
+	require.Contains(t, err.Error(), "DeleteEnvironment() Failed")
+}
+
+func createEnvironmentResourceData(t *testing.T) *schema.ResourceData {
+	return schema.TestResourceDataRaw(t, resourceEnvironment().Schema, map[string]interface{}{
+		"project_id":  testEnvironmentProjectID,
+		"name":        "production",
+		"description": "Production clusters",
+	})
+}
+
+func expectGetEnvironmentByID(environmentClient *azdosdkmocks.MockEnvironmentClient, env *taskagent.EnvironmentInstance) *gomock.Call {
+	return environmentClient.
+		EXPECT().
+		GetEnvironmentById(gomock.Any(), environment.GetEnvironmentByIdArgs{
+			Project:       &testEnvironmentProjectID,
+			EnvironmentId: &testEnvironmentID,
+		}).
+		Return(env, nil).
+		Times(1)
+}
+
+/**
+ * Begin acceptance tests
+ */
+
+// validates that an apply followed by another apply (i.e., resource update) will be reflected in AzDO and the
+// underlying terraform state.
+func TestAccAzureDevOpsEnvironment_CreateAndUpdate(t *testing.T) {
+	projectName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	environmentName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	tfNode := "azuredevops_environment.environment"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccEnvironmentCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentResource(projectName, environmentName, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfNode, "id"),
+					resource.TestCheckResourceAttr(tfNode, "name", environmentName),
+					resource.TestCheckResourceAttr(tfNode, "description", "first"),
+				),
+			}, {
+				Config: testAccEnvironmentResource(projectName, environmentName, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "description", "second"),
+				),
+			},
+		},
+	})
+}
+
+// HCL describing an environment in a project
+func testAccEnvironmentResource(projectName string, environmentName string, description string) string {
+	environmentResource := fmt.Sprintf(`
+resource "azuredevops_environment" "environment" {
+	project_id  = azuredevops_project.project.id
+	name        = "%s"
+	description = "%s"
+}`, environmentName, description)
+
+	projectResource := testAccProjectResource(projectName)
+	return fmt.Sprintf("%s\n%s", projectResource, environmentResource)
+}
+
+// verifies that all environments referenced in the state are destroyed
+func testAccEnvironmentCheckDestroy(s *terraform.State) error {
+	clients := testAccProvider.Meta().(*aggregatedClient)
+
+	for _, res := range s.RootModule().Resources {
+		if res.Type != "azuredevops_environment" {
+			continue
+		}
+
+		environmentID, err := strconv.Atoi(res.Primary.ID)
+		if err != nil {
+			return err
+		}
+		projectID := res.Primary.Attributes["project_id"]
+
+		env, err := clients.EnvironmentClient.GetEnvironmentById(clients.ctx, environment.GetEnvironmentByIdArgs{
+			Project:       &projectID,
+			EnvironmentId: &environmentID,
+		})
+		if err == nil && env != nil && env.Id != nil {
+			return fmt.Errorf("Environment with ID %d should not exist", environmentID)
+		}
+	}
+	return nil
+}
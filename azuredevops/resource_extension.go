@@ -0,0 +1,171 @@
+package azuredevops
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/extensionmanagement"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/azdoerror"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+)
+
+// Extensions are installed for the whole organization. Installed extensions are upgraded by the service
+// when a new version is published, so the installed version is read back instead of being enforced.
+func resourceExtension() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceExtensionCreate,
+		Read:   resourceExtensionRead,
+		Delete: resourceExtensionDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceExtensionImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"publisher_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"extension_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"publisher_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"extension_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// An extension that is already installed is adopted instead of being installed again
+func resourceExtensionCreate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	publisherID := d.Get("publisher_id").(string)
+	extensionID := d.Get("extension_id").(string)
+
+	installedExtension, err := getInstalledExtension(clients, publisherID, extensionID)
+	if err != nil {
+		return fmt.Errorf("Error looking up extension %s.%s. Error: %v", publisherID, extensionID, err)
+	}
+
+	if installedExtension != nil {
+		log.Printf("[INFO] Extension %s.%s is already installed in version %s and is adopted", publisherID, extensionID, converter.ToString(installedExtension.Version, ""))
+	} else {
+		args := extensionmanagement.InstallExtensionByNameArgs{
+			PublisherName: converter.String(publisherID),
+			ExtensionName: converter.String(extensionID),
+		}
+		if version, ok := d.GetOk("version"); ok {
+			args.Version = converter.String(version.(string))
+		}
+
+		_, err = clients.ExtensionClient.InstallExtensionByName(clients.ctx, args)
+		if err != nil {
+			return fmt.Errorf("Error installing extension %s.%s. Error: %v", publisherID, extensionID, err)
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", publisherID, extensionID))
+	return resourceExtensionRead(d, m)
+}
+
+func resourceExtensionRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	publisherID := d.Get("publisher_id").(string)
+	extensionID := d.Get("extension_id").(string)
+
+	installedExtension, err := getInstalledExtension(clients, publisherID, extensionID)
+	if err != nil {
+		return fmt.Errorf("Error looking up extension %s.%s. Error: %v", publisherID, extensionID, err)
+	}
+
+	// the extension was uninstalled outside of Terraform
+	if installedExtension == nil {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("version", converter.ToString(installedExtension.Version, ""))
+	d.Set("publisher_name", converter.ToString(installedExtension.PublisherName, ""))
+	d.Set("extension_name", converter.ToString(installedExtension.ExtensionName, ""))
+	return nil
+}
+
+func resourceExtensionDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	publisherID := d.Get("publisher_id").(string)
+	extensionID := d.Get("extension_id").(string)
+
+	err := clients.ExtensionClient.UninstallExtensionByName(clients.ctx, extensionmanagement.UninstallExtensionByNameArgs{
+		PublisherName: converter.String(publisherID),
+		ExtensionName: converter.String(extensionID),
+	})
+	if err != nil && !azdoerror.IsNotFound(err) {
+		return fmt.Errorf("Error uninstalling extension %s.%s. Error: %v", publisherID, extensionID, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// Imports an extension given an ID of the form <publisherID>/<extensionID>
+func resourceExtensionImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%s), expected publisherid/extensionid", d.Id())
+	}
+
+	d.Set("publisher_id", parts[0])
+	d.Set("extension_id", parts[1])
+	return []*schema.ResourceData{d}, nil
+}
+
+// Returns the installed extension, or nil if the extension is not installed. Built-in extensions are
+// returned by the service even if they are not installed, in which case they are flagged as uninstalled.
+func getInstalledExtension(clients *aggregatedClient, publisherID string, extensionID string) (*extensionmanagement.InstalledExtension, error) {
+	installedExtension, err := clients.ExtensionClient.GetInstalledExtensionByName(clients.ctx, extensionmanagement.GetInstalledExtensionByNameArgs{
+		PublisherName: converter.String(publisherID),
+		ExtensionName: converter.String(extensionID),
+	})
+	if err != nil {
+		if azdoerror.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if installedExtension == nil || isExtensionUninstalled(installedExtension) {
+		return nil, nil
+	}
+	return installedExtension, nil
+}
+
+// The flags of the state of an extension are a comma separated list, e.g. "builtIn, unInstalled"
+func isExtensionUninstalled(installedExtension *extensionmanagement.InstalledExtension) bool {
+	if installedExtension.InstallState == nil || installedExtension.InstallState.Flags == nil {
+		return false
+	}
+	for _, flag := range strings.Split(string(*installedExtension.InstallState.Flags), ",") {
+		if strings.EqualFold(strings.TrimSpace(flag), string(extensionmanagement.ExtensionStateFlagsValues.UnInstalled)) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,274 @@
+package azuredevops
+
+// The tests in this file use the mock clients in mock_client.go to mock out
+// the Azure DevOps client operations.
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/microsoft/azure-devops-go-api/azuredevops"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/extensionmanagement"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var testExtensionArgs = extensionmanagement.GetInstalledExtensionByNameArgs{
+	PublisherName: converter.String("ms-devlabs"),
+	ExtensionName: converter.String("estimate"),
+}
+
+func testExtensionResourceData(t *testing.T, version string) *schema.ResourceData {
+	resourceData := schema.TestResourceDataRaw(t, resourceExtension().Schema, nil)
+	resourceData.Set("publisher_id", "ms-devlabs")
+	resourceData.Set("extension_id", "estimate")
+	resourceData.Set("version", version)
+	return resourceData
+}
+
+func testExtensionNotFoundError() error {
+	statusCode := http.StatusNotFound
+	return azuredevops.WrappedError{StatusCode: &statusCode}
+}
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that an extension that is not installed yet is installed in the configured version
+func TestAzureDevOpsExtension_Create_InstallsExtension(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	extensionClient := azdosdkmocks.NewMockExtensionManagementClient(ctrl)
+	clients := &aggregatedClient{ExtensionClient: extensionClient, ctx: context.Background()}
+
+	installedExtension := &extensionmanagement.InstalledExtension{
+		PublisherName: converter.String("Microsoft DevLabs"),
+		ExtensionName: converter.String("Estimate"),
+		Version:       converter.String("1.0.2"),
+	}
+	gomock.InOrder(
+		extensionClient.
+			EXPECT().
+			GetInstalledExtensionByName(clients.ctx, testExtensionArgs).
+			Return(nil, testExtensionNotFoundError()),
+		extensionClient.
+			EXPECT().
+			InstallExtensionByName(clients.ctx, extensionmanagement.InstallExtensionByNameArgs{
+				PublisherName: converter.String("ms-devlabs"),
+				ExtensionName: converter.String("estimate"),
+				Version:       converter.String("1.0.2"),
+			}).
+			Return(installedExtension, nil),
+		extensionClient.
+			EXPECT().
+			GetInstalledExtensionByName(clients.ctx, testExtensionArgs).
+			Return(installedExtension, nil),
+	)
+
+	resourceData := testExtensionResourceData(t, "1.0.2")
+	err := resourceExtensionCreate(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "ms-devlabs/estimate", resourceData.Id())
+	require.Equal(t, "Estimate", resourceData.Get("extension_name"))
+	require.Equal(t, "Microsoft DevLabs", resourceData.Get("publisher_name"))
+}
+
+// verifies that an extension that is already installed is adopted instead of being installed again
+func TestAzureDevOpsExtension_Create_AdoptsInstalledExtension(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	extensionClient := azdosdkmocks.NewMockExtensionManagementClient(ctrl)
+	clients := &aggregatedClient{ExtensionClient: extensionClient, ctx: context.Background()}
+
+	extensionClient.
+		EXPECT().
+		GetInstalledExtensionByName(clients.ctx, testExtensionArgs).
+		Return(&extensionmanagement.InstalledExtension{Version: converter.String("1.0.3")}, nil).
+		Times(2)
+	extensionClient.
+		EXPECT().
+		InstallExtensionByName(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	resourceData := testExtensionResourceData(t, "")
+	err := resourceExtensionCreate(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "ms-devlabs/estimate", resourceData.Id())
+	require.Equal(t, "1.0.3", resourceData.Get("version"))
+}
+
+// verifies that the create operation fails if the extension cannot be installed
+func TestAzureDevOpsExtension_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	extensionClient := azdosdkmocks.NewMockExtensionManagementClient(ctrl)
+	clients := &aggregatedClient{ExtensionClient: extensionClient, ctx: context.Background()}
+
+	extensionClient.
+		EXPECT().
+		GetInstalledExtensionByName(clients.ctx, testExtensionArgs).
+		Return(nil, testExtensionNotFoundError()).
+		Times(1)
+	extensionClient.
+		EXPECT().
+		InstallExtensionByName(clients.ctx, gomock.Any()).
+		Return(nil, errors.New("InstallExtensionByName() Failed")).
+		Times(1)
+
+	resourceData := testExtensionResourceData(t, "")
+	err := resourceExtensionCreate(resourceData, clients)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "InstallExtensionByName() Failed")
+	require.Equal(t, "", resourceData.Id())
+}
+
+// verifies that the installed version is read, and that built-in extensions that are not installed are removed
+// from the state
+func TestAzureDevOpsExtension_Read_ReconcilesInstallation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	extensionClient := azdosdkmocks.NewMockExtensionManagementClient(ctrl)
+	clients := &aggregatedClient{ExtensionClient: extensionClient, ctx: context.Background()}
+
+	uninstalledFlags := extensionmanagement.ExtensionStateFlags("builtIn, unInstalled")
+	gomock.InOrder(
+		extensionClient.
+			EXPECT().
+			GetInstalledExtensionByName(clients.ctx, testExtensionArgs).
+			Return(&extensionmanagement.InstalledExtension{Version: converter.String("2.0.0")}, nil),
+		extensionClient.
+			EXPECT().
+			GetInstalledExtensionByName(clients.ctx, testExtensionArgs).
+			Return(&extensionmanagement.InstalledExtension{
+				Version:      converter.String("2.0.0"),
+				InstallState: &extensionmanagement.InstalledExtensionState{Flags: &uninstalledFlags},
+			}, nil),
+	)
+
+	resourceData := testExtensionResourceData(t, "1.0.2")
+	resourceData.SetId("ms-devlabs/estimate")
+	require.Nil(t, resourceExtensionRead(resourceData, clients))
+	require.Equal(t, "2.0.0", resourceData.Get("version"))
+
+	require.Nil(t, resourceExtensionRead(resourceData, clients))
+	require.Equal(t, "", resourceData.Id())
+}
+
+// verifies that an extension that was uninstalled outside of Terraform does not fail the delete operation
+func TestAzureDevOpsExtension_Delete_IgnoresUninstalledExtension(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	extensionClient := azdosdkmocks.NewMockExtensionManagementClient(ctrl)
+	clients := &aggregatedClient{ExtensionClient: extensionClient, ctx: context.Background()}
+
+	extensionClient.
+		EXPECT().
+		UninstallExtensionByName(clients.ctx, extensionmanagement.UninstallExtensionByNameArgs{
+			PublisherName: converter.String("ms-devlabs"),
+			ExtensionName: converter.String("estimate"),
+		}).
+		Return(testExtensionNotFoundError()).
+		Times(1)
+
+	resourceData := testExtensionResourceData(t, "")
+	resourceData.SetId("ms-devlabs/estimate")
+	require.Nil(t, resourceExtensionDelete(resourceData, clients))
+	require.Equal(t, "", resourceData.Id())
+}
+
+// verifies that extensions are imported by the publisher ID and the extension ID
+func TestAzureDevOpsExtension_Import_ParsesID(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, resourceExtension().Schema, nil)
+	resourceData.SetId("ms-devlabs/estimate")
+	_, err := resourceExtensionImport(resourceData, nil)
+	require.Nil(t, err)
+	require.Equal(t, "ms-devlabs", resourceData.Get("publisher_id"))
+	require.Equal(t, "estimate", resourceData.Get("extension_id"))
+
+	resourceData.SetId("ms-devlabs.estimate")
+	_, err = resourceExtensionImport(resourceData, nil)
+	require.NotNil(t, err)
+}
+
+/**
+ * Begin acceptance tests
+ */
+
+// Validates that an extension can be installed and imported. Since extensions are installed for the whole
+// organization, the extension is read from the AZDO_TEST_EXTENSION environment variable in the form
+// publisherid/extensionid. The extension must not be installed before the test.
+func TestAccAzureDevOpsExtension_InstallImport(t *testing.T) {
+	extension := os.Getenv("AZDO_TEST_EXTENSION")
+	parts := strings.Split(extension, "/")
+	tfNode := "azuredevops_extension.extension"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			if len(parts) != 2 {
+				t.Skip("AZDO_TEST_EXTENSION must be set to publisherid/extensionid for this acceptance test")
+			}
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccExtensionCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccExtensionResource(parts[0], parts[len(parts)-1]),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "id", extension),
+					resource.TestCheckResourceAttrSet(tfNode, "version"),
+					resource.TestCheckResourceAttrSet(tfNode, "extension_name"),
+				),
+			}, {
+				ResourceName:      tfNode,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// HCL describing an installed extension
+func testAccExtensionResource(publisherID string, extensionID string) string {
+	return fmt.Sprintf(`
+resource "azuredevops_extension" "extension" {
+	publisher_id = "%s"
+	extension_id = "%s"
+}`, publisherID, extensionID)
+}
+
+// verifies that all extensions referenced in the state are uninstalled
+func testAccExtensionCheckDestroy(s *terraform.State) error {
+	clients := testAccProvider.Meta().(*aggregatedClient)
+
+	for _, res := range s.RootModule().Resources {
+		if res.Type != "azuredevops_extension" {
+			continue
+		}
+
+		installedExtension, err := getInstalledExtension(clients, res.Primary.Attributes["publisher_id"], res.Primary.Attributes["extension_id"])
+		if err != nil {
+			return err
+		}
+		if installedExtension != nil {
+			return fmt.Errorf("Extension %s should not be installed", res.Primary.ID)
+		}
+	}
+	return nil
+}
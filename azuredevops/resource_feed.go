@@ -0,0 +1,274 @@
+package azuredevops
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/feed"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/azdoerror"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/feedrecyclebin"
+)
+
+func resourceFeed() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceFeedCreate,
+		Read:   resourceFeedRead,
+		Update: resourceFeedUpdate,
+		Delete: resourceFeedDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceFeedImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "",
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+			"upstream_source": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"protocol": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"npm", "nuget", "maven", "pypi"}, false),
+						},
+						"location": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"upstream_source_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  string(feed.UpstreamSourceTypeValues.Public),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(feed.UpstreamSourceTypeValues.Public),
+								string(feed.UpstreamSourceTypeValues.Internal),
+							}, false),
+						},
+					},
+				},
+			},
+			"permanent_delete": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourceFeedCreate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	projectID := d.Get("project_id").(string)
+	name := d.Get("name").(string)
+
+	upstreamSources := expandFeedUpstreamSources(d)
+	created, err := clients.FeedClient.CreateFeed(clients.ctx, feed.CreateFeedArgs{
+		Feed: &feed.Feed{
+			Name:            converter.String(name),
+			Description:     converter.String(d.Get("description").(string)),
+			UpstreamEnabled: converter.Bool(true),
+			UpstreamSources: &upstreamSources,
+		},
+		Project: converter.String(projectID),
+	})
+	if err != nil {
+		return fmt.Errorf("Error creating feed %s. Error: %v", name, err)
+	}
+
+	d.SetId(created.Id.String())
+	return resourceFeedRead(d, m)
+}
+
+func resourceFeedRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	projectID := d.Get("project_id").(string)
+
+	f, foundProjectID, err := findFeed(clients, d.Id(), projectID)
+	if err != nil {
+		return fmt.Errorf("Error looking up feed with ID %s. Error: %v", d.Id(), err)
+	}
+	if f == nil {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("project_id", foundProjectID)
+	flattenFeed(d, f)
+	return nil
+}
+
+func resourceFeedUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	projectID := d.Get("project_id").(string)
+
+	upstreamSources := expandFeedUpstreamSources(d)
+	_, err := clients.FeedClient.UpdateFeed(clients.ctx, feed.UpdateFeedArgs{
+		Feed: &feed.FeedUpdate{
+			Name:            converter.String(d.Get("name").(string)),
+			Description:     converter.String(d.Get("description").(string)),
+			UpstreamEnabled: converter.Bool(true),
+			UpstreamSources: &upstreamSources,
+		},
+		FeedId:  converter.String(d.Id()),
+		Project: converter.String(projectID),
+	})
+	if err != nil {
+		return fmt.Errorf("Error updating feed with ID %s. Error: %v", d.Id(), err)
+	}
+
+	return resourceFeedRead(d, m)
+}
+
+// Deleting a feed moves it to the recycle bin, where it keeps its name reserved until it is purged. Feeds are
+// only purged if permanent_delete is set, as this also deletes all of their packages for good.
+func resourceFeedDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	projectID := d.Get("project_id").(string)
+
+	err := clients.FeedClient.DeleteFeed(clients.ctx, feed.DeleteFeedArgs{
+		FeedId:  converter.String(d.Id()),
+		Project: converter.String(projectID),
+	})
+	if err != nil && !azdoerror.IsNotFound(err) {
+		return fmt.Errorf("Error deleting feed with ID %s. Error: %v", d.Id(), err)
+	}
+
+	if d.Get("permanent_delete").(bool) {
+		err := clients.FeedRecycleBinClient.PermanentDeleteFeed(clients.ctx, feedrecyclebin.PermanentDeleteFeedArgs{
+			FeedId:  converter.String(d.Id()),
+			Project: converter.String(projectID),
+		})
+		if err != nil && !azdoerror.IsNotFound(err) {
+			return fmt.Errorf("Error purging feed with ID %s from the recycle bin. Error: %v", d.Id(), err)
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// Imports a feed given an ID of the form <projectID>/<feedID>, or only <feedID> for feeds of the organization
+func resourceFeedImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	projectID, feedID := "", d.Id()
+	if parts := strings.SplitN(d.Id(), "/", 2); len(parts) == 2 {
+		projectID, feedID = parts[0], parts[1]
+	}
+	if _, err := uuid.Parse(feedID); err != nil {
+		return nil, fmt.Errorf("Unexpected format of ID (%s), expected projectid/feedid or feedid", d.Id())
+	}
+
+	d.SetId(feedID)
+	d.Set("project_id", projectID)
+	d.Set("permanent_delete", false)
+	return []*schema.ResourceData{d}, nil
+}
+
+// Looks up a feed in its configured scope, and returns the project the feed was found in, which is empty for feeds
+// of the organization. Feeds can be moved from a project to the organization, so a feed that is not found in its
+// project is looked up in the organization. Nil is returned if the feed is found in neither.
+func findFeed(clients *aggregatedClient, feedID string, projectID string) (*feed.Feed, string, error) {
+	scopes := []string{projectID}
+	if projectID != "" {
+		scopes = append(scopes, "")
+	}
+
+	for _, scope := range scopes {
+		f, err := clients.FeedClient.GetFeed(clients.ctx, feed.GetFeedArgs{
+			FeedId:  converter.String(feedID),
+			Project: converter.String(scope),
+		})
+		if err != nil {
+			if azdoerror.IsNotFound(err) {
+				continue
+			}
+			return nil, "", err
+		}
+		if f == nil || f.Id == nil {
+			continue
+		}
+		return f, feedProjectID(f, scope), nil
+	}
+	return nil, "", nil
+}
+
+// Returns the project of a feed as it is reported by the service. A project that is configured by name is kept
+// as configured, as long as the feed did not move to another project.
+func feedProjectID(f *feed.Feed, scope string) string {
+	if f.Project == nil || f.Project.Id == nil {
+		return scope
+	}
+	if strings.EqualFold(scope, f.Project.Id.String()) || strings.EqualFold(scope, converter.ToString(f.Project.Name, "")) {
+		return scope
+	}
+	return f.Project.Id.String()
+}
+
+// Convert internal Terraform data structure to an AzDO data structure
+func expandFeedUpstreamSources(d *schema.ResourceData) []feed.UpstreamSource {
+	configured := d.Get("upstream_source").([]interface{})
+	upstreamSources := make([]feed.UpstreamSource, 0, len(configured))
+	for _, item := range configured {
+		source := item.(map[string]interface{})
+		sourceType := feed.UpstreamSourceType(source["upstream_source_type"].(string))
+		upstreamSources = append(upstreamSources, feed.UpstreamSource{
+			Name:               converter.String(source["name"].(string)),
+			Protocol:           converter.String(source["protocol"].(string)),
+			Location:           converter.String(source["location"].(string)),
+			UpstreamSourceType: &sourceType,
+		})
+	}
+	return upstreamSources
+}
+
+// Convert AzDO data structure to internal Terraform data structure. Upstream sources that have been deleted are
+// still returned by the service until they are cleaned up, so they are skipped.
+func flattenFeed(d *schema.ResourceData, f *feed.Feed) {
+	d.SetId(f.Id.String())
+	d.Set("name", converter.ToString(f.Name, ""))
+	d.Set("description", converter.ToString(f.Description, ""))
+
+	upstreamSources := []interface{}{}
+	if f.UpstreamSources != nil {
+		for _, source := range *f.UpstreamSources {
+			if source.DeletedDate != nil {
+				continue
+			}
+			sourceType := ""
+			if source.UpstreamSourceType != nil {
+				sourceType = strings.ToLower(string(*source.UpstreamSourceType))
+			}
+			upstreamSources = append(upstreamSources, map[string]interface{}{
+				"name":                 converter.ToString(source.Name, ""),
+				"protocol":             strings.ToLower(converter.ToString(source.Protocol, "")),
+				"location":             converter.ToString(source.Location, ""),
+				"upstream_source_type": sourceType,
+			})
+		}
+	}
+	d.Set("upstream_source", upstreamSources)
+}
@@ -0,0 +1,170 @@
+package azuredevops
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/feed"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/azdoerror"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+)
+
+func resourceFeedPermission() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceFeedPermissionCreateOrUpdate,
+		Read:   resourceFeedPermissionRead,
+		Update: resourceFeedPermissionCreateOrUpdate,
+		Delete: resourceFeedPermissionDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceFeedPermissionImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "",
+			},
+			"feed_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"identity_descriptor": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"role": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(feed.FeedRoleValues.Reader),
+					string(feed.FeedRoleValues.Contributor),
+					string(feed.FeedRoleValues.Collaborator),
+					string(feed.FeedRoleValues.Administrator),
+				}, false),
+			},
+		},
+	}
+}
+
+// Granting a role replaces any role the principal had been granted on the feed before
+func resourceFeedPermissionCreateOrUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	feedID := d.Get("feed_id").(string)
+	subjectDescriptor := d.Get("identity_descriptor").(string)
+	role := feed.FeedRole(d.Get("role").(string))
+
+	if err := setFeedPermission(clients, d.Get("project_id").(string), feedID, subjectDescriptor, role); err != nil {
+		return fmt.Errorf("Error granting role %s on feed %s to %s. Error: %v", role, feedID, subjectDescriptor, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", feedID, subjectDescriptor))
+	return resourceFeedPermissionRead(d, m)
+}
+
+func resourceFeedPermissionRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	projectID := d.Get("project_id").(string)
+	feedID := d.Get("feed_id").(string)
+	subjectDescriptor := d.Get("identity_descriptor").(string)
+
+	identityDescriptor, err := getIdentityDescriptor(clients, subjectDescriptor)
+	if err != nil {
+		return err
+	}
+
+	permissions, err := clients.FeedClient.GetFeedPermissions(clients.ctx, feed.GetFeedPermissionsArgs{
+		FeedId:                      converter.String(feedID),
+		Project:                     converter.String(projectID),
+		IdentityDescriptor:          converter.String(identityDescriptor),
+		ExcludeInheritedPermissions: converter.Bool(true),
+	})
+	if err != nil {
+		if azdoerror.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error looking up the permissions of %s on feed %s. Error: %v", subjectDescriptor, feedID, err)
+	}
+
+	role := findFeedRole(permissions, identityDescriptor)
+	if role == "" || role == feed.FeedRoleValues.None {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("role", string(role))
+	return nil
+}
+
+// Only removes the role granted to the principal, other grants on the feed are kept
+func resourceFeedPermissionDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	feedID := d.Get("feed_id").(string)
+	subjectDescriptor := d.Get("identity_descriptor").(string)
+
+	err := setFeedPermission(clients, d.Get("project_id").(string), feedID, subjectDescriptor, feed.FeedRoleValues.None)
+	if err != nil && !azdoerror.IsNotFound(err) {
+		return fmt.Errorf("Error removing the role of %s on feed %s. Error: %v", subjectDescriptor, feedID, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// Imports a grant given an ID of the form <projectID>/<feedID>/<identityDescriptor>, or
+// <feedID>/<identityDescriptor> for feeds of the organization
+func resourceFeedPermissionImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) == 2 {
+		parts = append([]string{""}, parts...)
+	}
+	if len(parts) != 3 || parts[1] == "" || parts[2] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%s), expected projectid/feedid/descriptor or feedid/descriptor", d.Id())
+	}
+	projectID, feedID, subjectDescriptor := parts[0], parts[1], parts[2]
+
+	d.SetId(fmt.Sprintf("%s/%s", feedID, subjectDescriptor))
+	d.Set("project_id", projectID)
+	d.Set("feed_id", feedID)
+	d.Set("identity_descriptor", subjectDescriptor)
+	return []*schema.ResourceData{d}, nil
+}
+
+// Grants a role on a feed to the subject. The feed service identifies principals by their identity descriptors, so
+// the descriptor of the user or group is resolved first.
+func setFeedPermission(clients *aggregatedClient, projectID string, feedID string, subjectDescriptor string, role feed.FeedRole) error {
+	identityDescriptor, err := getIdentityDescriptor(clients, subjectDescriptor)
+	if err != nil {
+		return err
+	}
+
+	_, err = clients.FeedClient.SetFeedPermissions(clients.ctx, feed.SetFeedPermissionsArgs{
+		FeedPermission: &[]feed.FeedPermission{{
+			IdentityDescriptor: converter.String(identityDescriptor),
+			Role:               &role,
+		}},
+		FeedId:  converter.String(feedID),
+		Project: converter.String(projectID),
+	})
+	return err
+}
+
+// Returns the role granted to the identity, or an empty role if there is none
+func findFeedRole(permissions *[]feed.FeedPermission, identityDescriptor string) feed.FeedRole {
+	if permissions == nil {
+		return ""
+	}
+	for _, permission := range *permissions {
+		if permission.Role != nil && strings.EqualFold(converter.ToString(permission.IdentityDescriptor, ""), identityDescriptor) {
+			return *permission.Role
+		}
+	}
+	return ""
+}
@@ -0,0 +1,257 @@
+package azuredevops
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/feed"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/identity"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that the role is granted to the identity of the subject
+func TestAzureDevOpsFeedPermission_Create_GrantsRoleToIdentity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	feedClient := azdosdkmocks.NewMockFeedClient(ctrl)
+	identityClient := azdosdkmocks.NewMockIdentityClient(ctrl)
+	clients := &aggregatedClient{FeedClient: feedClient, IdentityClient: identityClient, ctx: context.Background()}
+
+	resourceData := createFeedPermissionResourceData(t, "contributor")
+
+	expectReadFeedPermissionIdentity(identityClient).Times(2)
+	role := feed.FeedRoleValues.Contributor
+	feedClient.
+		EXPECT().
+		SetFeedPermissions(clients.ctx, feed.SetFeedPermissionsArgs{
+			FeedPermission: &[]feed.FeedPermission{{
+				IdentityDescriptor: converter.String("Microsoft.TeamFoundation.Identity;S-1-9-1"),
+				Role:               &role,
+			}},
+			FeedId:  converter.String(testFeedID.String()),
+			Project: converter.String(testFeedProjectID.String()),
+		}).
+		Return(nil, nil).
+		Times(1)
+	expectGetFeedPermissions(feedClient, &[]feed.FeedPermission{{
+		IdentityDescriptor: converter.String("Microsoft.TeamFoundation.Identity;S-1-9-1"),
+		Role:               &role,
+	}})
+
+	err := resourceFeedPermissionCreateOrUpdate(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, testFeedID.String()+"/vssgp.group", resourceData.Id())
+	require.Equal(t, "contributor", resourceData.Get("role"))
+}
+
+// verifies that a role changed outside of Terraform is detected
+func TestAzureDevOpsFeedPermission_Read_ReconcilesRole(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	feedClient := azdosdkmocks.NewMockFeedClient(ctrl)
+	identityClient := azdosdkmocks.NewMockIdentityClient(ctrl)
+	clients := &aggregatedClient{FeedClient: feedClient, IdentityClient: identityClient, ctx: context.Background()}
+
+	resourceData := createFeedPermissionResourceData(t, "contributor")
+	resourceData.SetId(testFeedID.String() + "/vssgp.group")
+
+	expectReadFeedPermissionIdentity(identityClient).Times(1)
+	role := feed.FeedRoleValues.Administrator
+	expectGetFeedPermissions(feedClient, &[]feed.FeedPermission{{
+		IdentityDescriptor: converter.String("microsoft.teamfoundation.identity;S-1-9-1"),
+		Role:               &role,
+	}})
+
+	err := resourceFeedPermissionRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "administrator", resourceData.Get("role"))
+}
+
+// verifies that a grant removed outside of Terraform is removed from the state
+func TestAzureDevOpsFeedPermission_Read_ClearsIdIfGrantWasRemoved(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	feedClient := azdosdkmocks.NewMockFeedClient(ctrl)
+	identityClient := azdosdkmocks.NewMockIdentityClient(ctrl)
+	clients := &aggregatedClient{FeedClient: feedClient, IdentityClient: identityClient, ctx: context.Background()}
+
+	resourceData := createFeedPermissionResourceData(t, "reader")
+	resourceData.SetId(testFeedID.String() + "/vssgp.group")
+
+	expectReadFeedPermissionIdentity(identityClient).Times(1)
+	expectGetFeedPermissions(feedClient, &[]feed.FeedPermission{})
+
+	err := resourceFeedPermissionRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "", resourceData.Id())
+}
+
+// verifies that only the managed grant is removed
+func TestAzureDevOpsFeedPermission_Delete_RemovesOnlyManagedGrant(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	feedClient := azdosdkmocks.NewMockFeedClient(ctrl)
+	identityClient := azdosdkmocks.NewMockIdentityClient(ctrl)
+	clients := &aggregatedClient{FeedClient: feedClient, IdentityClient: identityClient, ctx: context.Background()}
+
+	resourceData := createFeedPermissionResourceData(t, "reader")
+	resourceData.SetId(testFeedID.String() + "/vssgp.group")
+
+	expectReadFeedPermissionIdentity(identityClient).Times(1)
+	role := feed.FeedRoleValues.None
+	feedClient.
+		EXPECT().
+		SetFeedPermissions(clients.ctx, feed.SetFeedPermissionsArgs{
+			FeedPermission: &[]feed.FeedPermission{{
+				IdentityDescriptor: converter.String("Microsoft.TeamFoundation.Identity;S-1-9-1"),
+				Role:               &role,
+			}},
+			FeedId:  converter.String(testFeedID.String()),
+			Project: converter.String(testFeedProjectID.String()),
+		}).
+		Return(nil, nil).
+		Times(1)
+
+	err := resourceFeedPermissionDelete(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "", resourceData.Id())
+}
+
+// verifies that if an error is produced on create, the error is not swallowed
+func TestAzureDevOpsFeedPermission_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	feedClient := azdosdkmocks.NewMockFeedClient(ctrl)
+	identityClient := azdosdkmocks.NewMockIdentityClient(ctrl)
+	clients := &aggregatedClient{FeedClient: feedClient, IdentityClient: identityClient, ctx: context.Background()}
+
+	resourceData := createFeedPermissionResourceData(t, "reader")
+
+	expectReadFeedPermissionIdentity(identityClient).Times(1)
+	feedClient.
+		EXPECT().
+		SetFeedPermissions(clients.ctx, gomock.Any()).
+		Return(nil, errors.New("SetFeedPermissions() Failed")).
+		Times(1)
+
+	err := resourceFeedPermissionCreateOrUpdate(resourceData, clients)
+	require.Contains(t, err.Error(), "SetFeedPermissions() Failed")
+}
+
+// verifies that grants on feeds of projects and of the organization can be imported
+func TestAzureDevOpsFeedPermission_Import_ParsesID(t *testing.T) {
+	for id, expectedProjectID := range map[string]string{
+		testFeedID.String() + "/vssgp.group":                                    "",
+		testFeedProjectID.String() + "/" + testFeedID.String() + "/vssgp.group": testFeedProjectID.String(),
+	} {
+		resourceData := schema.TestResourceDataRaw(t, resourceFeedPermission().Schema, nil)
+		resourceData.SetId(id)
+
+		imported, err := resourceFeedPermissionImport(resourceData, nil)
+		require.Nil(t, err)
+		require.Equal(t, testFeedID.String()+"/vssgp.group", imported[0].Id())
+		require.Equal(t, expectedProjectID, imported[0].Get("project_id"))
+		require.Equal(t, testFeedID.String(), imported[0].Get("feed_id"))
+		require.Equal(t, "vssgp.group", imported[0].Get("identity_descriptor"))
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceFeedPermission().Schema, nil)
+	resourceData.SetId("vssgp.group")
+	_, err := resourceFeedPermissionImport(resourceData, nil)
+	require.NotNil(t, err)
+}
+
+func createFeedPermissionResourceData(t *testing.T, role string) *schema.ResourceData {
+	return schema.TestResourceDataRaw(t, resourceFeedPermission().Schema, map[string]interface{}{
+		"project_id":          testFeedProjectID.String(),
+		"feed_id":             testFeedID.String(),
+		"identity_descriptor": "vssgp.group",
+		"role":                role,
+	})
+}
+
+func expectReadFeedPermissionIdentity(identityClient *azdosdkmocks.MockIdentityClient) *gomock.Call {
+	return identityClient.
+		EXPECT().
+		ReadIdentities(gomock.Any(), identity.ReadIdentitiesArgs{SubjectDescriptors: converter.String("vssgp.group")}).
+		Return(&[]identity.Identity{{Descriptor: converter.String("Microsoft.TeamFoundation.Identity;S-1-9-1")}}, nil)
+}
+
+func expectGetFeedPermissions(feedClient *azdosdkmocks.MockFeedClient, permissions *[]feed.FeedPermission) *gomock.Call {
+	return feedClient.
+		EXPECT().
+		GetFeedPermissions(gomock.Any(), feed.GetFeedPermissionsArgs{
+			FeedId:                      converter.String(testFeedID.String()),
+			Project:                     converter.String(testFeedProjectID.String()),
+			IdentityDescriptor:          converter.String("Microsoft.TeamFoundation.Identity;S-1-9-1"),
+			ExcludeInheritedPermissions: converter.Bool(true),
+		}).
+		Return(permissions, nil).
+		Times(1)
+}
+
+/**
+ * Begin acceptance tests
+ */
+
+// validates that a role granted to a group can be changed
+func TestAccAzureDevOpsFeedPermission_CreateAndUpdate(t *testing.T) {
+	projectName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	feedName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	tfNode := "azuredevops_feed_permission.permission"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccFeedCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFeedPermissionResource(projectName, feedName, "reader"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfNode, "id"),
+					resource.TestCheckResourceAttr(tfNode, "role", "reader"),
+				),
+			}, {
+				Config: testAccFeedPermissionResource(projectName, feedName, "contributor"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "role", "contributor"),
+				),
+			},
+		},
+	})
+}
+
+// HCL describing a role granted to the readers of a project on a feed of the project
+func testAccFeedPermissionResource(projectName string, feedName string, role string) string {
+	permissionResource := fmt.Sprintf(`
+data "azuredevops_group" "readers" {
+	project_id = azuredevops_project.project.id
+	name       = "Readers"
+}
+
+resource "azuredevops_feed_permission" "permission" {
+	project_id          = azuredevops_project.project.id
+	feed_id             = azuredevops_feed.feed.id
+	identity_descriptor = data.azuredevops_group.readers.descriptor
+	role                = "%s"
+}`, role)
+
+	return fmt.Sprintf("%s\n%s", testAccFeedResource(projectName, feedName, "feed"), permissionResource)
+}
@@ -0,0 +1,414 @@
+package azuredevops
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/microsoft/azure-devops-go-api/azuredevops"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/feed"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/feedrecyclebin"
+	"github.com/stretchr/testify/require"
+)
+
+var testFeedID = uuid.New()
+var testFeedProjectID = uuid.New()
+
+var testFeedUpstreamSourceType = feed.UpstreamSourceTypeValues.Public
+var testFeed = feed.Feed{
+	Id:          &testFeedID,
+	Name:        converter.String("packages"),
+	Description: converter.String("Packages of the team"),
+	Project: &feed.ProjectReference{
+		Id:   &testFeedProjectID,
+		Name: converter.String("project"),
+	},
+	UpstreamSources: &[]feed.UpstreamSource{{
+		Name:               converter.String("npmjs"),
+		Protocol:           converter.String("npm"),
+		Location:           converter.String("https://registry.npmjs.org/"),
+		UpstreamSourceType: &testFeedUpstreamSourceType,
+	}},
+}
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that the configured upstream sources are sent when the feed is created
+func TestAzureDevOpsFeed_Create_SendsUpstreamSources(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	feedClient := azdosdkmocks.NewMockFeedClient(ctrl)
+	clients := &aggregatedClient{FeedClient: feedClient, ctx: context.Background()}
+
+	resourceData := createFeedResourceData(t)
+
+	feedClient.
+		EXPECT().
+		CreateFeed(clients.ctx, feed.CreateFeedArgs{
+			Feed: &feed.Feed{
+				Name:            converter.String("packages"),
+				Description:     converter.String("Packages of the team"),
+				UpstreamEnabled: converter.Bool(true),
+				UpstreamSources: testFeed.UpstreamSources,
+			},
+			Project: converter.String(testFeedProjectID.String()),
+		}).
+		Return(&testFeed, nil).
+		Times(1)
+	feedClient.
+		EXPECT().
+		GetFeed(clients.ctx, feed.GetFeedArgs{FeedId: converter.String(testFeedID.String()), Project: converter.String(testFeedProjectID.String())}).
+		Return(&testFeed, nil).
+		Times(1)
+
+	err := resourceFeedCreate(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, testFeedID.String(), resourceData.Id())
+	require.Equal(t, testFeedProjectID.String(), resourceData.Get("project_id"))
+}
+
+// verifies that a feed without upstream sources is created with an empty list, which keeps the service from
+// adding the default public upstream sources
+func TestAzureDevOpsFeed_Create_SendsEmptyUpstreamSources(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, resourceFeed().Schema, map[string]interface{}{
+		"name": "packages",
+	})
+
+	upstreamSources := expandFeedUpstreamSources(resourceData)
+	require.NotNil(t, upstreamSources)
+	require.Len(t, upstreamSources, 0)
+}
+
+// verifies that a feed deleted outside of Terraform is removed from the state
+func TestAzureDevOpsFeed_Read_ClearsIdIfFeedWasDeleted(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	feedClient := azdosdkmocks.NewMockFeedClient(ctrl)
+	clients := &aggregatedClient{FeedClient: feedClient, ctx: context.Background()}
+
+	resourceData := createFeedResourceData(t)
+	resourceData.SetId(testFeedID.String())
+
+	notFound := 404
+	feedClient.
+		EXPECT().
+		GetFeed(clients.ctx, gomock.Any()).
+		Return(nil, azuredevops.WrappedError{StatusCode: &notFound}).
+		Times(2)
+
+	err := resourceFeedRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "", resourceData.Id())
+}
+
+// verifies that a feed that was moved from its project to the organization is detected
+func TestAzureDevOpsFeed_Read_DetectsFeedMovedToOrganization(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	feedClient := azdosdkmocks.NewMockFeedClient(ctrl)
+	clients := &aggregatedClient{FeedClient: feedClient, ctx: context.Background()}
+
+	resourceData := createFeedResourceData(t)
+	resourceData.SetId(testFeedID.String())
+
+	notFound := 404
+	moved := testFeed
+	moved.Project = nil
+	feedClient.
+		EXPECT().
+		GetFeed(clients.ctx, feed.GetFeedArgs{FeedId: converter.String(testFeedID.String()), Project: converter.String(testFeedProjectID.String())}).
+		Return(nil, azuredevops.WrappedError{StatusCode: &notFound}).
+		Times(1)
+	feedClient.
+		EXPECT().
+		GetFeed(clients.ctx, feed.GetFeedArgs{FeedId: converter.String(testFeedID.String()), Project: converter.String("")}).
+		Return(&moved, nil).
+		Times(1)
+
+	err := resourceFeedRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, testFeedID.String(), resourceData.Id())
+	require.Equal(t, "", resourceData.Get("project_id"))
+}
+
+// verifies that a feed that was moved to another project is detected, while a project configured by name is kept
+func TestAzureDevOpsFeed_Read_DetectsFeedMovedToOtherProject(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	feedClient := azdosdkmocks.NewMockFeedClient(ctrl)
+	clients := &aggregatedClient{FeedClient: feedClient, ctx: context.Background()}
+
+	resourceData := createFeedResourceData(t)
+	resourceData.SetId(testFeedID.String())
+	resourceData.Set("project_id", "project")
+
+	feedClient.
+		EXPECT().
+		GetFeed(clients.ctx, gomock.Any()).
+		Return(&testFeed, nil).
+		Times(1)
+
+	err := resourceFeedRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "project", resourceData.Get("project_id"))
+
+	otherProjectID := uuid.New()
+	moved := testFeed
+	moved.Project = &feed.ProjectReference{Id: &otherProjectID, Name: converter.String("other")}
+	feedClient.
+		EXPECT().
+		GetFeed(clients.ctx, gomock.Any()).
+		Return(&moved, nil).
+		Times(1)
+
+	err = resourceFeedRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, otherProjectID.String(), resourceData.Get("project_id"))
+}
+
+// verifies that deleted upstream sources are not part of the state
+func TestAzureDevOpsFeed_Flatten_SkipsDeletedUpstreamSources(t *testing.T) {
+	resourceData := createFeedResourceData(t)
+
+	withDeletedSource := testFeed
+	withDeletedSource.UpstreamSources = &[]feed.UpstreamSource{
+		(*testFeed.UpstreamSources)[0],
+		{Name: converter.String("nuget.org"), Protocol: converter.String("nuget"), DeletedDate: &azuredevops.Time{}},
+	}
+	flattenFeed(resourceData, &withDeletedSource)
+
+	require.Equal(t, 1, resourceData.Get("upstream_source.#"))
+	require.Equal(t, "npmjs", resourceData.Get("upstream_source.0.name"))
+}
+
+// verifies that a deleted feed is only purged from the recycle bin if permanent_delete is set
+func TestAzureDevOpsFeed_Delete_PurgesFeedIfConfigured(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	feedClient := azdosdkmocks.NewMockFeedClient(ctrl)
+	recycleBinClient := azdosdkmocks.NewMockFeedRecycleBinClient(ctrl)
+	clients := &aggregatedClient{FeedClient: feedClient, FeedRecycleBinClient: recycleBinClient, ctx: context.Background()}
+
+	for _, permanentDelete := range []bool{false, true} {
+		resourceData := createFeedResourceData(t)
+		resourceData.SetId(testFeedID.String())
+		resourceData.Set("permanent_delete", permanentDelete)
+
+		feedClient.
+			EXPECT().
+			DeleteFeed(clients.ctx, feed.DeleteFeedArgs{FeedId: converter.String(testFeedID.String()), Project: converter.String(testFeedProjectID.String())}).
+			Return(nil).
+			Times(1)
+		expectedPurges := 0
+		if permanentDelete {
+			expectedPurges = 1
+		}
+		recycleBinClient.
+			EXPECT().
+			PermanentDeleteFeed(clients.ctx, feedrecyclebin.PermanentDeleteFeedArgs{FeedId: converter.String(testFeedID.String()), Project: converter.String(testFeedProjectID.String())}).
+			Return(nil).
+			Times(expectedPurges)
+
+		err := resourceFeedDelete(resourceData, clients)
+		require.Nil(t, err)
+		require.Equal(t, "", resourceData.Id())
+	}
+}
+
+// verifies that a feed that is already in the recycle bin is still purged
+func TestAzureDevOpsFeed_Delete_PurgesFeedAlreadyInRecycleBin(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	feedClient := azdosdkmocks.NewMockFeedClient(ctrl)
+	recycleBinClient := azdosdkmocks.NewMockFeedRecycleBinClient(ctrl)
+	clients := &aggregatedClient{FeedClient: feedClient, FeedRecycleBinClient: recycleBinClient, ctx: context.Background()}
+
+	resourceData := createFeedResourceData(t)
+	resourceData.SetId(testFeedID.String())
+	resourceData.Set("permanent_delete", true)
+
+	notFound := 404
+	feedClient.
+		EXPECT().
+		DeleteFeed(clients.ctx, gomock.Any()).
+		Return(azuredevops.WrappedError{StatusCode: &notFound}).
+		Times(1)
+	recycleBinClient.
+		EXPECT().
+		PermanentDeleteFeed(clients.ctx, gomock.Any()).
+		Return(nil).
+		Times(1)
+
+	err := resourceFeedDelete(resourceData, clients)
+	require.Nil(t, err)
+}
+
+// verifies that if an error is produced on create, the error is not swallowed
+func TestAzureDevOpsFeed_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	feedClient := azdosdkmocks.NewMockFeedClient(ctrl)
+	clients := &aggregatedClient{FeedClient: feedClient, ctx: context.Background()}
+
+	resourceData := createFeedResourceData(t)
+
+	feedClient.
+		EXPECT().
+		CreateFeed(clients.ctx, gomock.Any()).
+		Return(nil, errors.New("CreateFeed() Failed")).
+		Times(1)
+
+	err := resourceFeedCreate(resourceData, clients)
+	require.Contains(t, err.Error(), "CreateFeed() Failed")
+}
+
+// verifies that if an error is produced on a delete, it is not swallowed
+func TestAzureDevOpsFeed_Delete_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	feedClient := azdosdkmocks.NewMockFeedClient(ctrl)
+	clients := &aggregatedClient{FeedClient: feedClient, ctx: context.Background()}
+
+	resourceData := createFeedResourceData(t)
+	resourceData.SetId(testFeedID.String())
+
+	feedClient.
+		EXPECT().
+		DeleteFeed(clients.ctx, gomock.Any()).
+		Return(errors.New("DeleteFeed() Failed")).
+		Times(1)
+
+	err := resourceFeedDelete(resourceData, clients)
+	require.Contains(t, err.Error(), "DeleteFeed() Failed")
+}
+
+// verifies that feeds of projects and of the organization can be imported
+func TestAzureDevOpsFeed_Import_ParsesID(t *testing.T) {
+	for id, expectedProjectID := range map[string]string{
+		testFeedID.String(): "",
+		testFeedProjectID.String() + "/" + testFeedID.String(): testFeedProjectID.String(),
+	} {
+		resourceData := schema.TestResourceDataRaw(t, resourceFeed().Schema, nil)
+		resourceData.SetId(id)
+
+		imported, err := resourceFeedImport(resourceData, nil)
+		require.Nil(t, err)
+		require.Equal(t, testFeedID.String(), imported[0].Id())
+		require.Equal(t, expectedProjectID, imported[0].Get("project_id"))
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceFeed().Schema, nil)
+	resourceData.SetId("project/packages")
+	_, err := resourceFeedImport(resourceData, nil)
+	require.NotNil(t, err)
+}
+
+func createFeedResourceData(t *testing.T) *schema.ResourceData {
+	return schema.TestResourceDataRaw(t, resourceFeed().Schema, map[string]interface{}{
+		"project_id":  testFeedProjectID.String(),
+		"name":        "packages",
+		"description": "Packages of the team",
+		"upstream_source": []interface{}{
+			map[string]interface{}{
+				"name":                 "npmjs",
+				"protocol":             "npm",
+				"location":             "https://registry.npmjs.org/",
+				"upstream_source_type": "public",
+			},
+		},
+	})
+}
+
+/**
+ * Begin acceptance tests
+ */
+
+// validates that an apply followed by another apply (i.e., resource update) will be reflected in AzDO and the
+// underlying terraform state.
+func TestAccAzureDevOpsFeed_CreateAndUpdate(t *testing.T) {
+	projectName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	feedName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	tfNode := "azuredevops_feed.feed"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccFeedCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFeedResource(projectName, feedName, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfNode, "id"),
+					resource.TestCheckResourceAttr(tfNode, "name", feedName),
+					resource.TestCheckResourceAttr(tfNode, "description", "first"),
+					resource.TestCheckResourceAttr(tfNode, "upstream_source.#", "1"),
+				),
+			}, {
+				Config: testAccFeedResource(projectName, feedName, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "description", "second"),
+				),
+			},
+		},
+	})
+}
+
+// HCL describing a feed of a project that mirrors npmjs. The feed is purged on delete so that its name can be
+// reused by the next test run.
+func testAccFeedResource(projectName string, feedName string, description string) string {
+	feedResource := fmt.Sprintf(`
+resource "azuredevops_feed" "feed" {
+	project_id       = azuredevops_project.project.id
+	name             = "%s"
+	description      = "%s"
+	permanent_delete = true
+
+	upstream_source {
+		name     = "npmjs"
+		protocol = "npm"
+		location = "https://registry.npmjs.org/"
+	}
+}`, feedName, description)
+
+	projectResource := testAccProjectResource(projectName)
+	return fmt.Sprintf("%s\n%s", projectResource, feedResource)
+}
+
+// verifies that all feeds referenced in the state are destroyed
+func testAccFeedCheckDestroy(s *terraform.State) error {
+	clients := testAccProvider.Meta().(*aggregatedClient)
+
+	for _, res := range s.RootModule().Resources {
+		if res.Type != "azuredevops_feed" {
+			continue
+		}
+
+		f, _, err := findFeed(clients, res.Primary.ID, res.Primary.Attributes["project_id"])
+		if err != nil {
+			return err
+		}
+		if f != nil {
+			return fmt.Errorf("Feed with ID %s should not exist", res.Primary.ID)
+		}
+	}
+	return nil
+}
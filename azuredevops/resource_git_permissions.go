@@ -0,0 +1,117 @@
+package azuredevops
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"unicode/utf16"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+// The security namespace that controls the permissions on Git repositories and their branches
+var securityNamespaceGitRepositories, _ = uuid.Parse("2e9eb7ed-3c0a-47d4-87c1-0ffdd275fd87")
+
+func resourceGitPermissions() *schema.Resource {
+	r := &schema.Resource{
+		Create: resourceGitPermissionsCreateOrUpdate,
+		Read:   resourceGitPermissionsRead,
+		Update: resourceGitPermissionsCreateOrUpdate,
+		Delete: resourceGitPermissionsDelete,
+		Schema: baseSecurityPermissionsSchema(),
+	}
+
+	r.Schema["repository_id"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ForceNew:     true,
+		ValidateFunc: validation.NoZeroValues,
+	}
+	r.Schema["branch_name"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ForceNew:     true,
+		ValidateFunc: validation.NoZeroValues,
+	}
+	return r
+}
+
+func resourceGitPermissionsCreateOrUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	token, err := gitSecurityTokenFromResourceData(d)
+	if err != nil {
+		return err
+	}
+
+	if err := updateSecurityPermissions(clients, d, securityNamespaceGitRepositories, token); err != nil {
+		return err
+	}
+
+	d.SetId(token + "#" + d.Get("principal").(string))
+	return resourceGitPermissionsRead(d, m)
+}
+
+func resourceGitPermissionsRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	token, err := gitSecurityTokenFromResourceData(d)
+	if err != nil {
+		return err
+	}
+	return readSecurityPermissions(clients, d, securityNamespaceGitRepositories, token)
+}
+
+func resourceGitPermissionsDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	token, err := gitSecurityTokenFromResourceData(d)
+	if err != nil {
+		return err
+	}
+
+	if err := resetSecurityPermissions(clients, d, securityNamespaceGitRepositories, token); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func gitSecurityTokenFromResourceData(d *schema.ResourceData) (string, error) {
+	return gitSecurityToken(d.Get("project_id").(string), d.Get("repository_id").(string), d.Get("branch_name").(string))
+}
+
+// Builds the token that identifies all repositories of a project, a single repository, or a single branch
+// of a repository in the Git security namespace. Branches are identified by their ref, in which each
+// segment of the branch name is encoded as the hex representation of its UTF-16LE bytes, e.g. the token
+// of the branch feature/a is repoV2/<project>/<repository>/refs/heads/6600650061007400750072006500/6100
+func gitSecurityToken(projectID string, repositoryID string, branchName string) (string, error) {
+	token := "repoV2/" + projectID
+	if repositoryID == "" {
+		if branchName != "" {
+			return "", fmt.Errorf("the repository_id is required to set the permissions of branch %s", branchName)
+		}
+		return token, nil
+	}
+
+	token += "/" + repositoryID
+	if branchName == "" {
+		return token, nil
+	}
+
+	branchName = strings.TrimPrefix(branchName, "refs/heads/")
+	segments := strings.Split(branchName, "/")
+	for i, segment := range segments {
+		segments[i] = encodeUTF16LEHex(segment)
+	}
+	return token + "/refs/heads/" + strings.Join(segments, "/"), nil
+}
+
+func encodeUTF16LEHex(value string) string {
+	codeUnits := utf16.Encode([]rune(value))
+	bytes := make([]byte, 0, len(codeUnits)*2)
+	for _, codeUnit := range codeUnits {
+		bytes = append(bytes, byte(codeUnit), byte(codeUnit>>8))
+	}
+	return hex.EncodeToString(bytes)
+}
@@ -0,0 +1,126 @@
+package azuredevops
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/security"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/stretchr/testify/require"
+)
+
+/**
+ * Begin unit tests
+ */
+
+// verifies the tokens of projects, repositories and branches in the Git security namespace
+func TestAzureDevOpsGitPermissions_Token(t *testing.T) {
+	tests := []struct {
+		repositoryID string
+		branchName   string
+		token        string
+	}{
+		{"", "", "repoV2/project"},
+		{"repository", "", "repoV2/project/repository"},
+		{"repository", "master", "repoV2/project/repository/refs/heads/6d0061007300740065007200"},
+		{"repository", "refs/heads/master", "repoV2/project/repository/refs/heads/6d0061007300740065007200"},
+		{"repository", "feature/a", "repoV2/project/repository/refs/heads/6600650061007400750072006500/6100"},
+		{"repository", "fü\U0001F600", "repoV2/project/repository/refs/heads/6600fc003dd800de"},
+	}
+
+	for _, test := range tests {
+		token, err := gitSecurityToken("project", test.repositoryID, test.branchName)
+		require.Nil(t, err)
+		require.Equal(t, test.token, token, "%s %s", test.repositoryID, test.branchName)
+	}
+
+	_, err := gitSecurityToken("project", "", "master")
+	require.NotNil(t, err)
+}
+
+// verifies that the permissions are set on the token of the branch in the Git namespace
+func TestAzureDevOpsGitPermissions_Create_UsesGitNamespace(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	securityClient := azdosdkmocks.NewMockSecurityClient(ctrl)
+	clients := &aggregatedClient{SecurityClient: securityClient, ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceGitPermissions().Schema, map[string]interface{}{
+		"project_id":    "project",
+		"repository_id": "repository",
+		"branch_name":   "master",
+		"principal":     testPermissionsPrincipal,
+		"permissions":   map[string]interface{}{"ForcePush": permissionDeny},
+	})
+
+	securityClient.
+		EXPECT().
+		QuerySecurityNamespaces(clients.ctx, security.QuerySecurityNamespacesArgs{SecurityNamespaceId: &securityNamespaceGitRepositories}).
+		Return(nil, fmt.Errorf("QuerySecurityNamespaces() Failed")).
+		Times(1)
+
+	err := resourceGitPermissionsCreateOrUpdate(resourceData, clients)
+	require.Contains(t, err.Error(), "QuerySecurityNamespaces() Failed")
+}
+
+/**
+ * Begin acceptance tests
+ */
+
+// Verifies that permissions of a group on a branch of a repository can be set and changed
+func TestAccAzureDevOpsGitPermissions_CreateAndUpdate(t *testing.T) {
+	projectName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	groupName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	tfNode := "azuredevops_git_permissions.permissions"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGitPermissionsResource(projectName, groupName, permissionDeny),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "permissions.ForcePush", permissionDeny),
+					resource.TestCheckResourceAttr(tfNode, "permissions.GenericContribute", permissionAllow),
+				),
+			}, {
+				Config: testAccGitPermissionsResource(projectName, groupName, permissionAllow),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "permissions.ForcePush", permissionAllow),
+				),
+			},
+		},
+	})
+}
+
+// HCL describing permissions of a group on the master branch of a repository
+func testAccGitPermissionsResource(projectName string, groupName string, forcePushState string) string {
+	permissionsResource := fmt.Sprintf(`
+resource "azuredevops_azure_git_repository" "repository" {
+	project_id = azuredevops_project.project.id
+	name       = "repository"
+	initialization {
+		init_type = "Clean"
+	}
+}
+
+resource "azuredevops_git_permissions" "permissions" {
+	project_id    = azuredevops_project.project.id
+	repository_id = azuredevops_azure_git_repository.repository.id
+	branch_name   = "master"
+	principal     = azuredevops_group.group.descriptor
+	permissions = {
+		GenericContribute = "Allow"
+		ForcePush         = "%s"
+	}
+}`, forcePushState)
+
+	groupResource := testAccGroupResource(projectName, groupName, "Description")
+	return fmt.Sprintf("%s\n%s", groupResource, permissionsResource)
+}
@@ -0,0 +1,223 @@
+package azuredevops
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/git"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+)
+
+const (
+	refsHeadsPrefix = "refs/heads/"
+	// The object ID git uses to represent a ref that does not exist
+	zeroObjectID = "0000000000000000000000000000000000000000"
+)
+
+func resourceGitRepositoryBranch() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGitRepositoryBranchCreate,
+		Read:   resourceGitRepositoryBranchRead,
+		Delete: resourceGitRepositoryBranchDelete,
+
+		CustomizeDiff: customizeDiffGitRepositoryBranch,
+
+		Schema: map[string]*schema.Schema{
+			"repository_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"ref_branch": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ValidateFunc:  validation.NoZeroValues,
+				ConflictsWith: []string{"ref_commit_id"},
+			},
+			"ref_commit_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ValidateFunc:  validation.NoZeroValues,
+				ConflictsWith: []string{"ref_branch"},
+			},
+			"ref": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_commit_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func customizeDiffGitRepositoryBranch(d *schema.ResourceDiff, m interface{}) error {
+	if d.Get("ref_branch").(string) == "" && d.Get("ref_commit_id").(string) == "" {
+		return fmt.Errorf("exactly one of ref_branch or ref_commit_id must be configured")
+	}
+	return nil
+}
+
+func resourceGitRepositoryBranchCreate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	repoID := d.Get("repository_id").(string)
+	refName := withRefsHeadsPrefix(d.Get("name").(string))
+
+	objectID, err := resolveBranchSourceObjectID(clients, d, repoID)
+	if err != nil {
+		return err
+	}
+
+	err = updateGitRef(clients, repoID, refName, zeroObjectID, objectID)
+	if err != nil {
+		return fmt.Errorf("Error creating branch %s in repository %s: %+v", refName, repoID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", repoID, refName))
+	return resourceGitRepositoryBranchRead(d, m)
+}
+
+// Determines the commit that a new branch will point to. It is either given directly or is the tip of the source branch
+func resolveBranchSourceObjectID(clients *aggregatedClient, d *schema.ResourceData, repoID string) (string, error) {
+	if commitID, ok := d.GetOk("ref_commit_id"); ok {
+		return commitID.(string), nil
+	}
+
+	refBranch, ok := d.GetOk("ref_branch")
+	if !ok {
+		return "", fmt.Errorf("One of ref_branch or ref_commit_id must be specified")
+	}
+
+	sourceRefName := withRefsHeadsPrefix(refBranch.(string))
+	sourceRef, err := getGitRef(clients, repoID, sourceRefName)
+	if err != nil {
+		return "", fmt.Errorf("Error looking up source branch %s in repository %s: %+v", sourceRefName, repoID, err)
+	}
+	if sourceRef == nil {
+		return "", fmt.Errorf("Source branch %s does not exist in repository %s. Branches can only be created from an existing branch or commit", sourceRefName, repoID)
+	}
+
+	return *sourceRef.ObjectId, nil
+}
+
+func resourceGitRepositoryBranchRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	repoID := d.Get("repository_id").(string)
+	refName := withRefsHeadsPrefix(d.Get("name").(string))
+
+	ref, err := getGitRef(clients, repoID, refName)
+	if err != nil {
+		return fmt.Errorf("Error looking up branch %s in repository %s: %+v", refName, repoID, err)
+	}
+
+	// the branch was deleted outside of Terraform and needs to be created again
+	if ref == nil {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("ref", *ref.Name)
+	d.Set("last_commit_id", converter.ToString(ref.ObjectId, ""))
+	return nil
+}
+
+func resourceGitRepositoryBranchDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	repoID := d.Get("repository_id").(string)
+	refName := withRefsHeadsPrefix(d.Get("name").(string))
+
+	ref, err := getGitRef(clients, repoID, refName)
+	if err != nil {
+		return fmt.Errorf("Error looking up branch %s in repository %s: %+v", refName, repoID, err)
+	}
+	if ref == nil {
+		return nil
+	}
+
+	err = updateGitRef(clients, repoID, refName, *ref.ObjectId, zeroObjectID)
+	if err != nil {
+		return fmt.Errorf("Error deleting branch %s in repository %s: %+v", refName, repoID, err)
+	}
+	return nil
+}
+
+// Lookup a ref by its full name. A nil ref is returned if the ref does not exist.
+func getGitRef(clients *aggregatedClient, repoID string, refName string) (*git.GitRef, error) {
+	// the filter is a prefix match on the ref name without the leading "refs/"
+	return findGitRef(clients, git.GetRefsArgs{
+		RepositoryId: converter.String(repoID),
+		Filter:       converter.String(strings.TrimPrefix(refName, "refs/")),
+	}, refName)
+}
+
+// Lookup a ref by its full name among the refs matching the query
+func findGitRef(clients *aggregatedClient, args git.GetRefsArgs, refName string) (*git.GitRef, error) {
+	refs, err := clients.GitReposClient.GetRefs(clients.ctx, args)
+	if err != nil {
+		return nil, err
+	}
+	if refs == nil {
+		return nil, nil
+	}
+
+	for _, ref := range refs.Value {
+		if ref.Name != nil && *ref.Name == refName {
+			return &ref, nil
+		}
+	}
+	return nil, nil
+}
+
+// Moves a ref from one object to another. Refs are created by moving them from the zero object
+// and deleted by moving them to the zero object.
+func updateGitRef(clients *aggregatedClient, repoID string, refName string, oldObjectID string, newObjectID string) error {
+	results, err := clients.GitReposClient.UpdateRefs(clients.ctx, git.UpdateRefsArgs{
+		RepositoryId: converter.String(repoID),
+		RefUpdates: &[]git.GitRefUpdate{{
+			Name:        converter.String(refName),
+			OldObjectId: converter.String(oldObjectID),
+			NewObjectId: converter.String(newObjectID),
+		}},
+	})
+	if err != nil {
+		return err
+	}
+
+	if results == nil || len(*results) != 1 {
+		return fmt.Errorf("Expected the result of exactly one ref update")
+	}
+
+	result := (*results)[0]
+	if result.Success == nil || !*result.Success {
+		status := ""
+		if result.UpdateStatus != nil {
+			status = string(*result.UpdateStatus)
+		}
+		return fmt.Errorf("Ref update was not successful. Status: %s %s", status, converter.ToString(result.CustomMessage, ""))
+	}
+	return nil
+}
+
+func withRefsHeadsPrefix(branchName string) string {
+	if strings.HasPrefix(branchName, "refs/") {
+		return branchName
+	}
+	return refsHeadsPrefix + branchName
+}
+
+// Branches may be configured with or without the refs/heads/ prefix
+func suppressRefsHeadsPrefixDiff(k, old, new string, d *schema.ResourceData) bool {
+	return strings.TrimPrefix(old, refsHeadsPrefix) == strings.TrimPrefix(new, refsHeadsPrefix)
+}
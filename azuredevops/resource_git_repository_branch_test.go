@@ -0,0 +1,269 @@
+package azuredevops
+
+// The tests in this file use the mock clients in mock_client.go to mock out
+// the Azure DevOps client operations.
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/git"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var testBranchRepoID = testRepoID.String()
+var testBranchSourceCommitID = "0123456789abcdef0123456789abcdef01234567"
+
+func testBranchResourceData(t *testing.T) *schema.ResourceData {
+	resourceData := schema.TestResourceDataRaw(t, resourceGitRepositoryBranch().Schema, nil)
+	resourceData.Set("repository_id", testBranchRepoID)
+	resourceData.Set("name", "develop")
+	resourceData.Set("ref_branch", "master")
+	return resourceData
+}
+
+func testGetRefsArgs(filter string) git.GetRefsArgs {
+	return git.GetRefsArgs{
+		RepositoryId: converter.String(testBranchRepoID),
+		Filter:       converter.String(filter),
+	}
+}
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that a clear error is returned if the source branch does not exist
+func TestGitRepositoryBranch_Create_ReportsMissingSourceBranch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &aggregatedClient{GitReposClient: reposClient, ctx: context.Background()}
+
+	reposClient.
+		EXPECT().
+		GetRefs(clients.ctx, testGetRefsArgs("heads/master")).
+		Return(&git.GetRefsResponseValue{Value: []git.GitRef{
+			{Name: converter.String("refs/heads/master-old"), ObjectId: converter.String(testBranchSourceCommitID)},
+		}}, nil).
+		Times(1)
+
+	reposClient.
+		EXPECT().
+		UpdateRefs(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	err := resourceGitRepositoryBranchCreate(testBranchResourceData(t), clients)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "Source branch refs/heads/master does not exist")
+}
+
+// verifies that the branch is created from the tip of the source branch
+func TestGitRepositoryBranch_Create_CreatesRefFromSourceBranch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &aggregatedClient{GitReposClient: reposClient, ctx: context.Background()}
+
+	reposClient.
+		EXPECT().
+		GetRefs(clients.ctx, testGetRefsArgs("heads/master")).
+		Return(&git.GetRefsResponseValue{Value: []git.GitRef{
+			{Name: converter.String("refs/heads/master"), ObjectId: converter.String(testBranchSourceCommitID)},
+		}}, nil).
+		Times(1)
+
+	expectedArgs := git.UpdateRefsArgs{
+		RepositoryId: converter.String(testBranchRepoID),
+		RefUpdates: &[]git.GitRefUpdate{{
+			Name:        converter.String("refs/heads/develop"),
+			OldObjectId: converter.String(zeroObjectID),
+			NewObjectId: converter.String(testBranchSourceCommitID),
+		}},
+	}
+	reposClient.
+		EXPECT().
+		UpdateRefs(clients.ctx, expectedArgs).
+		Return(&[]git.GitRefUpdateResult{{Success: converter.Bool(true)}}, nil).
+		Times(1)
+
+	reposClient.
+		EXPECT().
+		GetRefs(clients.ctx, testGetRefsArgs("heads/develop")).
+		Return(&git.GetRefsResponseValue{Value: []git.GitRef{
+			{Name: converter.String("refs/heads/develop"), ObjectId: converter.String(testBranchSourceCommitID)},
+		}}, nil).
+		Times(1)
+
+	resourceData := testBranchResourceData(t)
+	err := resourceGitRepositoryBranchCreate(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, fmt.Sprintf("%s:refs/heads/develop", testBranchRepoID), resourceData.Id())
+	require.Equal(t, testBranchSourceCommitID, resourceData.Get("last_commit_id"))
+}
+
+// verifies that the create operation is considered failed if the service rejects the ref update
+func TestGitRepositoryBranch_Create_DoesNotSwallowRejectedRefUpdate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &aggregatedClient{GitReposClient: reposClient, ctx: context.Background()}
+
+	resourceData := testBranchResourceData(t)
+	resourceData.Set("ref_branch", "")
+	resourceData.Set("ref_commit_id", testBranchSourceCommitID)
+
+	status := git.GitRefUpdateStatusValues.InvalidRefName
+	reposClient.
+		EXPECT().
+		UpdateRefs(clients.ctx, gomock.Any()).
+		Return(&[]git.GitRefUpdateResult{{Success: converter.Bool(false), UpdateStatus: &status}}, nil).
+		Times(1)
+
+	err := resourceGitRepositoryBranchCreate(resourceData, clients)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), string(status))
+}
+
+// verifies that the create operation is considered failed if the API call fails
+func TestGitRepositoryBranch_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &aggregatedClient{GitReposClient: reposClient, ctx: context.Background()}
+
+	resourceData := testBranchResourceData(t)
+	resourceData.Set("ref_branch", "")
+	resourceData.Set("ref_commit_id", testBranchSourceCommitID)
+
+	reposClient.
+		EXPECT().
+		UpdateRefs(clients.ctx, gomock.Any()).
+		Return(nil, errors.New("UpdateRefs() Failed")).
+		Times(1)
+
+	err := resourceGitRepositoryBranchCreate(resourceData, clients)
+	require.Contains(t, err.Error(), "UpdateRefs() Failed")
+}
+
+// verifies that a branch deleted outside of Terraform is removed from the state
+func TestGitRepositoryBranch_Read_ClearsIdIfBranchWasDeleted(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &aggregatedClient{GitReposClient: reposClient, ctx: context.Background()}
+
+	resourceData := testBranchResourceData(t)
+	resourceData.SetId(fmt.Sprintf("%s:refs/heads/develop", testBranchRepoID))
+
+	reposClient.
+		EXPECT().
+		GetRefs(clients.ctx, testGetRefsArgs("heads/develop")).
+		Return(&git.GetRefsResponseValue{Value: []git.GitRef{}}, nil).
+		Times(1)
+
+	err := resourceGitRepositoryBranchRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "", resourceData.Id())
+}
+
+// verifies that the read operation is considered failed if the API call fails
+func TestGitRepositoryBranch_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &aggregatedClient{GitReposClient: reposClient, ctx: context.Background()}
+
+	reposClient.
+		EXPECT().
+		GetRefs(clients.ctx, gomock.Any()).
+		Return(nil, errors.New("GetRefs() Failed")).
+		Times(1)
+
+	err := resourceGitRepositoryBranchRead(testBranchResourceData(t), clients)
+	require.Contains(t, err.Error(), "GetRefs() Failed")
+}
+
+// verifies that the delete operation moves the ref from its current commit to the zero object
+func TestGitRepositoryBranch_Delete_DeletesRef(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &aggregatedClient{GitReposClient: reposClient, ctx: context.Background()}
+
+	reposClient.
+		EXPECT().
+		GetRefs(clients.ctx, testGetRefsArgs("heads/develop")).
+		Return(&git.GetRefsResponseValue{Value: []git.GitRef{
+			{Name: converter.String("refs/heads/develop"), ObjectId: converter.String(testBranchSourceCommitID)},
+		}}, nil).
+		Times(1)
+
+	expectedArgs := git.UpdateRefsArgs{
+		RepositoryId: converter.String(testBranchRepoID),
+		RefUpdates: &[]git.GitRefUpdate{{
+			Name:        converter.String("refs/heads/develop"),
+			OldObjectId: converter.String(testBranchSourceCommitID),
+			NewObjectId: converter.String(zeroObjectID),
+		}},
+	}
+	reposClient.
+		EXPECT().
+		UpdateRefs(clients.ctx, expectedArgs).
+		Return(nil, errors.New("UpdateRefs() Failed")).
+		Times(1)
+
+	err := resourceGitRepositoryBranchDelete(testBranchResourceData(t), clients)
+	require.Contains(t, err.Error(), "UpdateRefs() Failed")
+}
+
+/**
+ * Begin acceptance tests
+ */
+
+// Verifies that creating a branch from a source branch that does not exist fails with a clear
+// error. Newly created repositories are empty and have no branches to create a branch from.
+func TestAccGitRepositoryBranch_CreateFromMissingSourceBranch(t *testing.T) {
+	projectName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	gitRepoName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccAzureGitRepoCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccGitRepositoryBranchResource(projectName, gitRepoName),
+				ExpectError: regexp.MustCompile("Source branch refs/heads/master does not exist"),
+			},
+		},
+	})
+}
+
+func testAccGitRepositoryBranchResource(projectName string, gitRepoName string) string {
+	branchResource := `
+resource "azuredevops_git_repository_branch" "branch" {
+	repository_id = azuredevops_azure_git_repository.gitrepo.id
+	name          = "develop"
+	ref_branch    = "master"
+}`
+
+	gitRepoResource := testAccAzureGitRepoResource(projectName, gitRepoName)
+	return fmt.Sprintf("%s\n%s", gitRepoResource, branchResource)
+}
@@ -0,0 +1,246 @@
+package azuredevops
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/git"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/azdoerror"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+)
+
+func resourceGitRepositoryFile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGitRepositoryFileCreate,
+		Read:   resourceGitRepositoryFileRead,
+		Update: resourceGitRepositoryFileUpdate,
+		Delete: resourceGitRepositoryFileDelete,
+		Schema: map[string]*schema.Schema{
+			"repository_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"file": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"content": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"branch": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				ValidateFunc:     validation.NoZeroValues,
+				DiffSuppressFunc: suppressRefsHeadsPrefixDiff,
+			},
+			"commit_message": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"overwrite_on_create": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourceGitRepositoryFileCreate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	repoID := d.Get("repository_id").(string)
+	file := d.Get("file").(string)
+
+	branch, err := getGitRepositoryFileBranch(clients, d)
+	if err != nil {
+		return err
+	}
+
+	existingFile, err := getGitRepositoryFile(clients, repoID, file, branch)
+	if err != nil {
+		return fmt.Errorf("Error looking up file %s on branch %s in repository %s: %+v", file, branch, repoID, err)
+	}
+
+	changeType := git.VersionControlChangeTypeValues.Add
+	if existingFile != nil {
+		if !d.Get("overwrite_on_create").(bool) {
+			return fmt.Errorf("File %s already exists on branch %s in repository %s. Set overwrite_on_create to true to overwrite it", file, branch, repoID)
+		}
+		changeType = git.VersionControlChangeTypeValues.Edit
+	}
+
+	change := expandGitRepositoryFileChange(changeType, file, converter.String(d.Get("content").(string)))
+	message := getGitRepositoryFileCommitMessage(d, "Add")
+	if err := pushGitRepositoryFileChange(clients, repoID, branch, change, message); err != nil {
+		return fmt.Errorf("Error committing file %s to branch %s in repository %s: %+v", file, branch, repoID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", repoID, file))
+	d.Set("branch", branch)
+	return resourceGitRepositoryFileRead(d, m)
+}
+
+func resourceGitRepositoryFileRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	repoID := d.Get("repository_id").(string)
+	file := d.Get("file").(string)
+	branch := d.Get("branch").(string)
+
+	item, err := getGitRepositoryFile(clients, repoID, file, branch)
+	if err != nil {
+		return fmt.Errorf("Error looking up file %s on branch %s in repository %s: %+v", file, branch, repoID, err)
+	}
+
+	// the file was deleted outside of Terraform and needs to be committed again
+	if item == nil {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("content", converter.ToString(item.Content, ""))
+	return nil
+}
+
+func resourceGitRepositoryFileUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	repoID := d.Get("repository_id").(string)
+	file := d.Get("file").(string)
+	branch := d.Get("branch").(string)
+
+	if d.HasChange("content") {
+		change := expandGitRepositoryFileChange(git.VersionControlChangeTypeValues.Edit, file, converter.String(d.Get("content").(string)))
+		message := getGitRepositoryFileCommitMessage(d, "Update")
+		if err := pushGitRepositoryFileChange(clients, repoID, branch, change, message); err != nil {
+			return fmt.Errorf("Error committing file %s to branch %s in repository %s: %+v", file, branch, repoID, err)
+		}
+	}
+
+	return resourceGitRepositoryFileRead(d, m)
+}
+
+func resourceGitRepositoryFileDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	repoID := d.Get("repository_id").(string)
+	file := d.Get("file").(string)
+	branch := d.Get("branch").(string)
+
+	change := expandGitRepositoryFileChange(git.VersionControlChangeTypeValues.Delete, file, nil)
+	message := getGitRepositoryFileCommitMessage(d, "Delete")
+	if err := pushGitRepositoryFileChange(clients, repoID, branch, change, message); err != nil {
+		return fmt.Errorf("Error deleting file %s from branch %s in repository %s: %+v", file, branch, repoID, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// Returns the configured branch, or the default branch of the repository if no branch is configured
+func getGitRepositoryFileBranch(clients *aggregatedClient, d *schema.ResourceData) (string, error) {
+	if branch, ok := d.GetOk("branch"); ok {
+		return strings.TrimPrefix(branch.(string), refsHeadsPrefix), nil
+	}
+
+	repoID := d.Get("repository_id").(string)
+	repo, err := clients.GitReposClient.GetRepository(clients.ctx, git.GetRepositoryArgs{
+		RepositoryId: converter.String(repoID),
+	})
+	if err != nil {
+		return "", fmt.Errorf("Error looking up repository %s: %+v", repoID, err)
+	}
+	if repo == nil || repo.DefaultBranch == nil {
+		return "", fmt.Errorf("Repository %s does not have a default branch. The branch has to be configured explicitly", repoID)
+	}
+	return strings.TrimPrefix(*repo.DefaultBranch, refsHeadsPrefix), nil
+}
+
+// Lookup a file including its content. A nil item is returned if the file does not exist on the branch.
+func getGitRepositoryFile(clients *aggregatedClient, repoID string, file string, branch string) (*git.GitItem, error) {
+	item, err := clients.GitReposClient.GetItem(clients.ctx, git.GetItemArgs{
+		RepositoryId:   converter.String(repoID),
+		Path:           converter.String(file),
+		IncludeContent: converter.Bool(true),
+		VersionDescriptor: &git.GitVersionDescriptor{
+			Version:     converter.String(branch),
+			VersionType: &git.GitVersionTypeValues.Branch,
+		},
+	})
+	if err != nil {
+		if azdoerror.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return item, nil
+}
+
+// Commits a single change on top of the tip of a branch. A push is rejected if the branch was moved by someone
+// else since its tip was looked up, in which case the tip is looked up again and the push is retried once.
+func pushGitRepositoryFileChange(clients *aggregatedClient, repoID string, branch string, change *git.Change, message string) error {
+	err := tryPushGitRepositoryFileChange(clients, repoID, branch, change, message)
+	if azdoerror.IsConflict(err) {
+		log.Printf("[DEBUG] Branch %s in repository %s was updated concurrently, retrying the push: %+v", branch, repoID, err)
+		err = tryPushGitRepositoryFileChange(clients, repoID, branch, change, message)
+	}
+	return err
+}
+
+func tryPushGitRepositoryFileChange(clients *aggregatedClient, repoID string, branch string, change *git.Change, message string) error {
+	refName := withRefsHeadsPrefix(branch)
+	ref, err := getGitRef(clients, repoID, refName)
+	if err != nil {
+		return fmt.Errorf("Error looking up branch %s: %+v", refName, err)
+	}
+	if ref == nil || ref.ObjectId == nil {
+		return fmt.Errorf("Branch %s does not exist. Files can only be committed to an existing branch", refName)
+	}
+
+	_, err = clients.GitReposClient.CreatePush(clients.ctx, git.CreatePushArgs{
+		RepositoryId: converter.String(repoID),
+		Push: &git.GitPush{
+			RefUpdates: &[]git.GitRefUpdate{{
+				Name:        converter.String(refName),
+				OldObjectId: ref.ObjectId,
+			}},
+			Commits: &[]git.GitCommitRef{{
+				Comment: converter.String(message),
+				Changes: &[]interface{}{change},
+			}},
+		},
+	})
+	return err
+}
+
+// Convert internal Terraform data structure to an AzDO data structure. The content is omitted for deletions.
+func expandGitRepositoryFileChange(changeType git.VersionControlChangeType, file string, content *string) *git.Change {
+	change := &git.Change{
+		ChangeType: &changeType,
+		Item: git.GitItem{
+			Path: converter.String(file),
+		},
+	}
+	if content != nil {
+		change.NewContent = &git.ItemContent{
+			Content:     content,
+			ContentType: &git.ItemContentTypeValues.RawText,
+		}
+	}
+	return change
+}
+
+// Returns the configured commit message, or a message describing the change if none is configured
+func getGitRepositoryFileCommitMessage(d *schema.ResourceData, action string) string {
+	if message, ok := d.GetOk("commit_message"); ok {
+		return message.(string)
+	}
+	return fmt.Sprintf("%s %s", action, d.Get("file").(string))
+}
@@ -0,0 +1,296 @@
+package azuredevops
+
+// The tests in this file use the mock clients in mock_client.go to mock out
+// the Azure DevOps client operations.
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/git"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var testFileRepoID = testRepoID.String()
+var testFileCommitID = "0123456789abcdef0123456789abcdef01234567"
+
+func testGitRepositoryFileResourceData(t *testing.T, overwrite bool) *schema.ResourceData {
+	return schema.TestResourceDataRaw(t, resourceGitRepositoryFile().Schema, map[string]interface{}{
+		"repository_id":       testFileRepoID,
+		"file":                "/README.md",
+		"content":             "# Hello",
+		"branch":              "master",
+		"overwrite_on_create": overwrite,
+	})
+}
+
+func testGetGitRepositoryFileArgs() git.GetItemArgs {
+	return git.GetItemArgs{
+		RepositoryId:   converter.String(testFileRepoID),
+		Path:           converter.String("/README.md"),
+		IncludeContent: converter.Bool(true),
+		VersionDescriptor: &git.GitVersionDescriptor{
+			Version:     converter.String("master"),
+			VersionType: &git.GitVersionTypeValues.Branch,
+		},
+	}
+}
+
+func testGitRepositoryFilePushArgs(changeType git.VersionControlChangeType, content *string, message string) git.CreatePushArgs {
+	return git.CreatePushArgs{
+		RepositoryId: converter.String(testFileRepoID),
+		Push: &git.GitPush{
+			RefUpdates: &[]git.GitRefUpdate{{
+				Name:        converter.String("refs/heads/master"),
+				OldObjectId: converter.String(testFileCommitID),
+			}},
+			Commits: &[]git.GitCommitRef{{
+				Comment: converter.String(message),
+				Changes: &[]interface{}{expandGitRepositoryFileChange(changeType, "/README.md", content)},
+			}},
+		},
+	}
+}
+
+func expectGitRepositoryFileBranchTip(reposClient *azdosdkmocks.MockGitClient, times int) {
+	reposClient.
+		EXPECT().
+		GetRefs(gomock.Any(), testGetRefsArgs("heads/master")).
+		Return(&git.GetRefsResponseValue{Value: []git.GitRef{
+			{Name: converter.String("refs/heads/master"), ObjectId: converter.String(testFileCommitID)},
+		}}, nil).
+		Times(times)
+}
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that an existing file is not overwritten unless requested
+func TestGitRepositoryFile_Create_DoesNotOverwriteExistingFile(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &aggregatedClient{GitReposClient: reposClient, ctx: context.Background()}
+
+	reposClient.
+		EXPECT().
+		GetItem(clients.ctx, testGetGitRepositoryFileArgs()).
+		Return(&git.GitItem{Content: converter.String("# Old")}, nil).
+		Times(1)
+	reposClient.EXPECT().CreatePush(gomock.Any(), gomock.Any()).Times(0)
+
+	err := resourceGitRepositoryFileCreate(testGitRepositoryFileResourceData(t, false), clients)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "Set overwrite_on_create to true")
+}
+
+// verifies that a new file is added on top of the tip of the branch
+func TestGitRepositoryFile_Create_AddsFile(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &aggregatedClient{GitReposClient: reposClient, ctx: context.Background()}
+
+	notFound := http.StatusNotFound
+	gomock.InOrder(
+		reposClient.
+			EXPECT().
+			GetItem(clients.ctx, testGetGitRepositoryFileArgs()).
+			Return(nil, azuredevops.WrappedError{StatusCode: &notFound}),
+		reposClient.
+			EXPECT().
+			GetItem(clients.ctx, testGetGitRepositoryFileArgs()).
+			Return(&git.GitItem{Content: converter.String("# Hello")}, nil),
+	)
+	expectGitRepositoryFileBranchTip(reposClient, 1)
+	reposClient.
+		EXPECT().
+		CreatePush(clients.ctx, testGitRepositoryFilePushArgs(git.VersionControlChangeTypeValues.Add, converter.String("# Hello"), "Add /README.md")).
+		Return(&git.GitPush{}, nil).
+		Times(1)
+
+	resourceData := testGitRepositoryFileResourceData(t, false)
+	err := resourceGitRepositoryFileCreate(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, fmt.Sprintf("%s:/README.md", testFileRepoID), resourceData.Id())
+}
+
+// verifies that an existing file is edited if overwriting it was requested
+func TestGitRepositoryFile_Create_OverwritesExistingFile(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &aggregatedClient{GitReposClient: reposClient, ctx: context.Background()}
+
+	reposClient.
+		EXPECT().
+		GetItem(clients.ctx, testGetGitRepositoryFileArgs()).
+		Return(&git.GitItem{Content: converter.String("# Old")}, nil).
+		Times(2)
+	expectGitRepositoryFileBranchTip(reposClient, 1)
+	reposClient.
+		EXPECT().
+		CreatePush(clients.ctx, testGitRepositoryFilePushArgs(git.VersionControlChangeTypeValues.Edit, converter.String("# Hello"), "Add /README.md")).
+		Return(&git.GitPush{}, nil).
+		Times(1)
+
+	err := resourceGitRepositoryFileCreate(testGitRepositoryFileResourceData(t, true), clients)
+	require.Nil(t, err)
+}
+
+// verifies that a push that was rejected because the branch moved is retried once on top of the new tip
+func TestGitRepositoryFile_Delete_RetriesStalePushOnce(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &aggregatedClient{GitReposClient: reposClient, ctx: context.Background()}
+
+	conflict := http.StatusConflict
+	expectGitRepositoryFileBranchTip(reposClient, 2)
+	reposClient.
+		EXPECT().
+		CreatePush(clients.ctx, testGitRepositoryFilePushArgs(git.VersionControlChangeTypeValues.Delete, nil, "Delete /README.md")).
+		Return(nil, azuredevops.WrappedError{StatusCode: &conflict}).
+		Times(2)
+
+	resourceData := testGitRepositoryFileResourceData(t, false)
+	resourceData.SetId(fmt.Sprintf("%s:/README.md", testFileRepoID))
+	err := resourceGitRepositoryFileDelete(resourceData, clients)
+	require.NotNil(t, err)
+	require.Equal(t, fmt.Sprintf("%s:/README.md", testFileRepoID), resourceData.Id())
+}
+
+// verifies that other errors are not retried
+func TestGitRepositoryFile_Push_DoesNotRetryOtherErrors(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &aggregatedClient{GitReposClient: reposClient, ctx: context.Background()}
+
+	expectGitRepositoryFileBranchTip(reposClient, 1)
+	reposClient.
+		EXPECT().
+		CreatePush(clients.ctx, gomock.Any()).
+		Return(nil, errors.New("CreatePush() Failed")).
+		Times(1)
+
+	err := pushGitRepositoryFileChange(clients, testFileRepoID, "master", expandGitRepositoryFileChange(git.VersionControlChangeTypeValues.Edit, "/README.md", converter.String("# Hello")), "Update /README.md")
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "CreatePush() Failed")
+}
+
+// verifies that a file that was deleted outside of Terraform is removed from the state
+func TestGitRepositoryFile_Read_ClearsIdIfFileWasDeleted(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &aggregatedClient{GitReposClient: reposClient, ctx: context.Background()}
+
+	notFound := http.StatusNotFound
+	reposClient.
+		EXPECT().
+		GetItem(clients.ctx, testGetGitRepositoryFileArgs()).
+		Return(nil, azuredevops.WrappedError{StatusCode: &notFound}).
+		Times(1)
+
+	resourceData := testGitRepositoryFileResourceData(t, false)
+	resourceData.SetId(fmt.Sprintf("%s:/README.md", testFileRepoID))
+	err := resourceGitRepositoryFileRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "", resourceData.Id())
+}
+
+// verifies that the default branch of the repository is used if no branch is configured
+func TestGitRepositoryFile_Create_UsesDefaultBranch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &aggregatedClient{GitReposClient: reposClient, ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceGitRepositoryFile().Schema, map[string]interface{}{
+		"repository_id": testFileRepoID,
+		"file":          "/README.md",
+		"content":       "# Hello",
+	})
+
+	reposClient.
+		EXPECT().
+		GetRepository(clients.ctx, git.GetRepositoryArgs{RepositoryId: converter.String(testFileRepoID)}).
+		Return(&git.GitRepository{DefaultBranch: converter.String("refs/heads/master")}, nil).
+		Times(1)
+
+	branch, err := getGitRepositoryFileBranch(clients, resourceData)
+	require.Nil(t, err)
+	require.Equal(t, "master", branch)
+}
+
+/**
+ * Begin acceptance tests
+ */
+
+// Verifies that a file can be committed, updated and deleted
+func TestAccGitRepositoryFile_CreateAndUpdate(t *testing.T) {
+	projectName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	gitRepoName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	tfNode := "azuredevops_git_repository_file.file"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccAzureGitRepoCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGitRepositoryFileResource(projectName, gitRepoName, "# Hello"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "content", "# Hello"),
+					resource.TestCheckResourceAttr(tfNode, "branch", "master"),
+				),
+			}, {
+				Config: testAccGitRepositoryFileResource(projectName, gitRepoName, "# Hello again"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "content", "# Hello again"),
+				),
+			},
+		},
+	})
+}
+
+func testAccGitRepositoryFileResource(projectName string, gitRepoName string, content string) string {
+	fileResource := fmt.Sprintf(`
+resource "azuredevops_azure_git_repository" "gitrepo" {
+	project_id = azuredevops_project.project.id
+	name       = "%s"
+	initialization {
+		init_type = "Clean"
+	}
+}
+
+resource "azuredevops_git_repository_file" "file" {
+	repository_id       = azuredevops_azure_git_repository.gitrepo.id
+	file                = "/README.md"
+	content             = "%s"
+	branch              = "master"
+	overwrite_on_create = true
+}`, gitRepoName, content)
+
+	projectResource := testAccProjectResource(projectName)
+	return fmt.Sprintf("%s\n%s", projectResource, fileResource)
+}
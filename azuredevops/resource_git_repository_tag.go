@@ -0,0 +1,201 @@
+package azuredevops
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/git"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/azdoerror"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+)
+
+const refsTagsPrefix = "refs/tags/"
+
+// A full commit SHA. Refs of this form are tagged directly instead of being resolved as a branch.
+var commitIDRegexp = regexp.MustCompile(`^[0-9a-fA-F]{40}$`)
+
+// Tags are lightweight unless a message is configured, in which case an annotated tag is created
+func resourceGitRepositoryTag() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGitRepositoryTagCreate,
+		Read:   resourceGitRepositoryTagRead,
+		Delete: resourceGitRepositoryTagDelete,
+
+		Schema: map[string]*schema.Schema{
+			"repository_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"ref": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"message": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"commit_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceGitRepositoryTagCreate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	repoID := d.Get("repository_id").(string)
+	refName := withRefsTagsPrefix(d.Get("name").(string))
+
+	commitID, err := resolveTagCommitID(clients, repoID, d.Get("ref").(string))
+	if err != nil {
+		return err
+	}
+
+	if message, ok := d.GetOk("message"); ok {
+		err = createAnnotatedGitTag(clients, repoID, refName, commitID, message.(string))
+	} else {
+		err = updateGitRef(clients, repoID, refName, zeroObjectID, commitID)
+	}
+	if err != nil {
+		return fmt.Errorf("Error creating tag %s in repository %s: %+v", refName, repoID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", repoID, refName))
+	d.Set("commit_id", commitID)
+	return resourceGitRepositoryTagRead(d, m)
+}
+
+// Determines the commit a new tag points to. A commit SHA is verified to exist, any other ref is
+// resolved as a branch.
+func resolveTagCommitID(clients *aggregatedClient, repoID string, ref string) (string, error) {
+	if commitIDRegexp.MatchString(ref) {
+		commit, err := clients.GitReposClient.GetCommit(clients.ctx, git.GetCommitArgs{
+			CommitId:     converter.String(ref),
+			RepositoryId: converter.String(repoID),
+		})
+		if err != nil {
+			if azdoerror.IsNotFound(err) {
+				return "", fmt.Errorf("Commit %s does not exist in repository %s", ref, repoID)
+			}
+			return "", fmt.Errorf("Error looking up commit %s in repository %s: %+v", ref, repoID, err)
+		}
+		return converter.ToString(commit.CommitId, ref), nil
+	}
+
+	branchName := withRefsHeadsPrefix(ref)
+	branch, err := getGitRef(clients, repoID, branchName)
+	if err != nil {
+		return "", fmt.Errorf("Error looking up branch %s in repository %s: %+v", branchName, repoID, err)
+	}
+	if branch == nil {
+		return "", fmt.Errorf("Branch %s does not exist in repository %s. Tags can only be created for an existing branch or commit", branchName, repoID)
+	}
+	return *branch.ObjectId, nil
+}
+
+// Annotated tags are created in the project of the repository, which also creates the ref of the tag
+func createAnnotatedGitTag(clients *aggregatedClient, repoID string, refName string, commitID string, message string) error {
+	repo, err := clients.GitReposClient.GetRepository(clients.ctx, git.GetRepositoryArgs{
+		RepositoryId: converter.String(repoID),
+	})
+	if err != nil {
+		return fmt.Errorf("Error looking up repository %s: %+v", repoID, err)
+	}
+	if repo.Project == nil || repo.Project.Id == nil {
+		return fmt.Errorf("Project of repository %s was not returned by the service", repoID)
+	}
+
+	_, err = clients.GitReposClient.CreateAnnotatedTag(clients.ctx, git.CreateAnnotatedTagArgs{
+		TagObject: &git.GitAnnotatedTag{
+			Name:    converter.String(strings.TrimPrefix(refName, refsTagsPrefix)),
+			Message: converter.String(message),
+			TaggedObject: &git.GitObject{
+				ObjectId:   converter.String(commitID),
+				ObjectType: &git.GitObjectTypeValues.Commit,
+			},
+		},
+		Project:      converter.String(repo.Project.Id.String()),
+		RepositoryId: converter.String(repoID),
+	})
+	return err
+}
+
+// A tag that was deleted outside of Terraform is removed from the state. A tag that was moved to another
+// commit reports the commit it points to as its ref, so that the tag is replaced.
+func resourceGitRepositoryTagRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	repoID := d.Get("repository_id").(string)
+	refName := withRefsTagsPrefix(d.Get("name").(string))
+
+	ref, err := getGitTagRef(clients, repoID, refName)
+	if err != nil {
+		return fmt.Errorf("Error looking up tag %s in repository %s: %+v", refName, repoID, err)
+	}
+	if ref == nil {
+		d.SetId("")
+		return nil
+	}
+
+	// annotated tags point to a tag object, which in turn points to the commit
+	commitID := converter.ToString(ref.PeeledObjectId, "")
+	if commitID == "" {
+		commitID = converter.ToString(ref.ObjectId, "")
+	}
+	if previousCommitID := d.Get("commit_id").(string); previousCommitID != "" && !strings.EqualFold(previousCommitID, commitID) {
+		d.Set("ref", commitID)
+	}
+	d.Set("commit_id", commitID)
+	return nil
+}
+
+func resourceGitRepositoryTagDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	repoID := d.Get("repository_id").(string)
+	refName := withRefsTagsPrefix(d.Get("name").(string))
+
+	ref, err := getGitTagRef(clients, repoID, refName)
+	if err != nil {
+		return fmt.Errorf("Error looking up tag %s in repository %s: %+v", refName, repoID, err)
+	}
+	if ref == nil {
+		return nil
+	}
+
+	err = updateGitRef(clients, repoID, refName, *ref.ObjectId, zeroObjectID)
+	if err != nil {
+		return fmt.Errorf("Error deleting tag %s in repository %s: %+v", refName, repoID, err)
+	}
+	return nil
+}
+
+// Lookup a tag by its full ref name. Annotated tags are peeled, so that the commit they point to is returned.
+func getGitTagRef(clients *aggregatedClient, repoID string, refName string) (*git.GitRef, error) {
+	return findGitRef(clients, git.GetRefsArgs{
+		RepositoryId: converter.String(repoID),
+		Filter:       converter.String(strings.TrimPrefix(refName, "refs/")),
+		PeelTags:     converter.Bool(true),
+	}, refName)
+}
+
+func withRefsTagsPrefix(tagName string) string {
+	if strings.HasPrefix(tagName, "refs/") {
+		return tagName
+	}
+	return refsTagsPrefix + tagName
+}
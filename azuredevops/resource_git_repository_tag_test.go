@@ -0,0 +1,302 @@
+package azuredevops
+
+// The tests in this file use the mock clients in mock_client.go to mock out
+// the Azure DevOps client operations.
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/core"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/git"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var testTagObjectID = "fedcba9876543210fedcba9876543210fedcba98"
+
+func testTagResourceData(t *testing.T, ref string, message string) *schema.ResourceData {
+	resourceData := schema.TestResourceDataRaw(t, resourceGitRepositoryTag().Schema, nil)
+	resourceData.Set("repository_id", testBranchRepoID)
+	resourceData.Set("name", "v1.0.0")
+	resourceData.Set("ref", ref)
+	resourceData.Set("message", message)
+	return resourceData
+}
+
+func testGetTagRefsArgs() git.GetRefsArgs {
+	return git.GetRefsArgs{
+		RepositoryId: converter.String(testBranchRepoID),
+		Filter:       converter.String("tags/v1.0.0"),
+		PeelTags:     converter.Bool(true),
+	}
+}
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that a lightweight tag is created as a ref pointing to the tip of the branch
+func TestGitRepositoryTag_Create_CreatesLightweightTagFromBranch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &aggregatedClient{GitReposClient: reposClient, ctx: context.Background()}
+
+	reposClient.
+		EXPECT().
+		GetRefs(clients.ctx, testGetRefsArgs("heads/master")).
+		Return(&git.GetRefsResponseValue{Value: []git.GitRef{
+			{Name: converter.String("refs/heads/master"), ObjectId: converter.String(testBranchSourceCommitID)},
+		}}, nil).
+		Times(1)
+
+	reposClient.
+		EXPECT().
+		UpdateRefs(clients.ctx, git.UpdateRefsArgs{
+			RepositoryId: converter.String(testBranchRepoID),
+			RefUpdates: &[]git.GitRefUpdate{{
+				Name:        converter.String("refs/tags/v1.0.0"),
+				OldObjectId: converter.String(zeroObjectID),
+				NewObjectId: converter.String(testBranchSourceCommitID),
+			}},
+		}).
+		Return(&[]git.GitRefUpdateResult{{Success: converter.Bool(true)}}, nil).
+		Times(1)
+
+	reposClient.
+		EXPECT().
+		GetRefs(clients.ctx, testGetTagRefsArgs()).
+		Return(&git.GetRefsResponseValue{Value: []git.GitRef{
+			{Name: converter.String("refs/tags/v1.0.0"), ObjectId: converter.String(testBranchSourceCommitID)},
+		}}, nil).
+		Times(1)
+
+	resourceData := testTagResourceData(t, "master", "")
+	err := resourceGitRepositoryTagCreate(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, fmt.Sprintf("%s:refs/tags/v1.0.0", testBranchRepoID), resourceData.Id())
+	require.Equal(t, testBranchSourceCommitID, resourceData.Get("commit_id"))
+	require.Equal(t, "master", resourceData.Get("ref"))
+}
+
+// verifies that an annotated tag is created in the project of the repository once the commit is verified to exist
+func TestGitRepositoryTag_Create_CreatesAnnotatedTagForCommit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &aggregatedClient{GitReposClient: reposClient, ctx: context.Background()}
+	projectID := uuid.New()
+
+	reposClient.
+		EXPECT().
+		GetCommit(clients.ctx, git.GetCommitArgs{
+			CommitId:     converter.String(testBranchSourceCommitID),
+			RepositoryId: converter.String(testBranchRepoID),
+		}).
+		Return(&git.GitCommit{CommitId: converter.String(testBranchSourceCommitID)}, nil).
+		Times(1)
+
+	reposClient.
+		EXPECT().
+		GetRepository(clients.ctx, git.GetRepositoryArgs{RepositoryId: converter.String(testBranchRepoID)}).
+		Return(&git.GitRepository{Id: &testRepoID, Project: &core.TeamProjectReference{Id: &projectID}}, nil).
+		Times(1)
+
+	reposClient.
+		EXPECT().
+		CreateAnnotatedTag(clients.ctx, git.CreateAnnotatedTagArgs{
+			TagObject: &git.GitAnnotatedTag{
+				Name:    converter.String("v1.0.0"),
+				Message: converter.String("Release 1.0.0"),
+				TaggedObject: &git.GitObject{
+					ObjectId:   converter.String(testBranchSourceCommitID),
+					ObjectType: &git.GitObjectTypeValues.Commit,
+				},
+			},
+			Project:      converter.String(projectID.String()),
+			RepositoryId: converter.String(testBranchRepoID),
+		}).
+		Return(&git.GitAnnotatedTag{ObjectId: converter.String(testTagObjectID)}, nil).
+		Times(1)
+
+	reposClient.
+		EXPECT().
+		GetRefs(clients.ctx, testGetTagRefsArgs()).
+		Return(&git.GetRefsResponseValue{Value: []git.GitRef{{
+			Name:           converter.String("refs/tags/v1.0.0"),
+			ObjectId:       converter.String(testTagObjectID),
+			PeeledObjectId: converter.String(testBranchSourceCommitID),
+		}}}, nil).
+		Times(1)
+
+	resourceData := testTagResourceData(t, testBranchSourceCommitID, "Release 1.0.0")
+	err := resourceGitRepositoryTagCreate(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, testBranchSourceCommitID, resourceData.Get("commit_id"))
+	require.Equal(t, testBranchSourceCommitID, resourceData.Get("ref"))
+}
+
+// verifies that a clear error is returned if the commit to tag does not exist
+func TestGitRepositoryTag_Create_ReportsMissingCommit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &aggregatedClient{GitReposClient: reposClient, ctx: context.Background()}
+
+	statusCode := http.StatusNotFound
+	reposClient.
+		EXPECT().
+		GetCommit(clients.ctx, gomock.Any()).
+		Return(nil, azuredevops.WrappedError{StatusCode: &statusCode}).
+		Times(1)
+	reposClient.
+		EXPECT().
+		UpdateRefs(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	err := resourceGitRepositoryTagCreate(testTagResourceData(t, testBranchSourceCommitID, ""), clients)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), fmt.Sprintf("Commit %s does not exist", testBranchSourceCommitID))
+}
+
+// verifies that a tag deleted outside of Terraform is removed from the state
+func TestGitRepositoryTag_Read_ClearsIdIfTagWasDeleted(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &aggregatedClient{GitReposClient: reposClient, ctx: context.Background()}
+
+	reposClient.
+		EXPECT().
+		GetRefs(clients.ctx, testGetTagRefsArgs()).
+		Return(&git.GetRefsResponseValue{Value: []git.GitRef{}}, nil).
+		Times(1)
+
+	resourceData := testTagResourceData(t, "master", "")
+	resourceData.SetId("tag")
+	err := resourceGitRepositoryTagRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "", resourceData.Id())
+}
+
+// verifies that a tag moved outside of Terraform reports the commit it points to as its ref
+func TestGitRepositoryTag_Read_ReportsMovedTag(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &aggregatedClient{GitReposClient: reposClient, ctx: context.Background()}
+
+	movedCommitID := "1111111111111111111111111111111111111111"
+	reposClient.
+		EXPECT().
+		GetRefs(clients.ctx, testGetTagRefsArgs()).
+		Return(&git.GetRefsResponseValue{Value: []git.GitRef{
+			{Name: converter.String("refs/tags/v1.0.0"), ObjectId: converter.String(movedCommitID)},
+		}}, nil).
+		Times(1)
+
+	resourceData := testTagResourceData(t, "master", "")
+	resourceData.SetId("tag")
+	resourceData.Set("commit_id", testBranchSourceCommitID)
+	err := resourceGitRepositoryTagRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, movedCommitID, resourceData.Get("ref"))
+	require.Equal(t, movedCommitID, resourceData.Get("commit_id"))
+}
+
+// verifies that the ref of an annotated tag is deleted by moving it from the tag object to the zero object
+func TestGitRepositoryTag_Delete_DeletesRef(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &aggregatedClient{GitReposClient: reposClient, ctx: context.Background()}
+
+	reposClient.
+		EXPECT().
+		GetRefs(clients.ctx, testGetTagRefsArgs()).
+		Return(&git.GetRefsResponseValue{Value: []git.GitRef{{
+			Name:           converter.String("refs/tags/v1.0.0"),
+			ObjectId:       converter.String(testTagObjectID),
+			PeeledObjectId: converter.String(testBranchSourceCommitID),
+		}}}, nil).
+		Times(1)
+	reposClient.
+		EXPECT().
+		UpdateRefs(clients.ctx, git.UpdateRefsArgs{
+			RepositoryId: converter.String(testBranchRepoID),
+			RefUpdates: &[]git.GitRefUpdate{{
+				Name:        converter.String("refs/tags/v1.0.0"),
+				OldObjectId: converter.String(testTagObjectID),
+				NewObjectId: converter.String(zeroObjectID),
+			}},
+		}).
+		Return(&[]git.GitRefUpdateResult{{Success: converter.Bool(true)}}, nil).
+		Times(1)
+
+	err := resourceGitRepositoryTagDelete(testTagResourceData(t, "master", "Release 1.0.0"), clients)
+	require.Nil(t, err)
+}
+
+/**
+ * Begin acceptance tests
+ */
+
+// Verifies that the tip of the default branch of an initialized repository can be tagged
+func TestAccGitRepositoryTag_CreateAnnotatedTag(t *testing.T) {
+	projectName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	gitRepoName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	tfNode := "azuredevops_git_repository_tag.tag"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccAzureGitRepoCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGitRepositoryTagResource(projectName, gitRepoName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "ref", "master"),
+					resource.TestMatchResourceAttr(tfNode, "commit_id", regexp.MustCompile("^[0-9a-f]{40}$")),
+				),
+			},
+		},
+	})
+}
+
+func testAccGitRepositoryTagResource(projectName string, gitRepoName string) string {
+	tagResource := fmt.Sprintf(`
+resource "azuredevops_azure_git_repository" "gitrepo" {
+	project_id = azuredevops_project.project.id
+	name       = "%s"
+	initialization {
+		init_type = "Clean"
+	}
+}
+
+resource "azuredevops_git_repository_tag" "tag" {
+	repository_id = azuredevops_azure_git_repository.gitrepo.id
+	name          = "v1.0.0"
+	ref           = "master"
+	message       = "Release 1.0.0"
+}`, gitRepoName)
+
+	projectResource := testAccProjectResource(projectName)
+	return fmt.Sprintf("%s\n%s", projectResource, tagResource)
+}
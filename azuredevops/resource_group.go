@@ -0,0 +1,221 @@
+package azuredevops
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/graph"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/webapi"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/azdoerror"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/graphgroup"
+)
+
+func resourceGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGroupCreate,
+		Read:   resourceGroupRead,
+		Update: resourceGroupUpdate,
+		Delete: resourceGroupDelete,
+		Schema: map[string]*schema.Schema{
+			"scope": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"display_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"reference_existing": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"descriptor": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"origin": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"origin_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceGroupCreate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+
+	scopeDescriptor, err := getGroupScopeDescriptor(clients, d.Get("scope").(string))
+	if err != nil {
+		return err
+	}
+
+	var group *graph.GraphGroup
+	if d.Get("reference_existing").(bool) {
+		group, err = findExistingGroup(clients, scopeDescriptor, d.Get("display_name").(string))
+	} else {
+		group, err = createGroup(clients, d, scopeDescriptor)
+	}
+	if err != nil {
+		return err
+	}
+
+	// the description of a referenced group is only changed if it has been configured
+	if d.Get("reference_existing").(bool) {
+		if description, ok := d.GetOk("description"); ok && description.(string) != converter.ToString(group.Description, "") {
+			group, err = updateGroup(clients, *group.Descriptor, groupPatchOperation("/description", description.(string)))
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	flattenGroup(d, group)
+	return nil
+}
+
+func resourceGroupRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+
+	group, err := clients.GraphClient.GetGroup(clients.ctx, graph.GetGroupArgs{
+		GroupDescriptor: converter.String(d.Id()),
+	})
+	if err != nil {
+		if azdoerror.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error looking up group with descriptor %s. Error: %v", d.Id(), err)
+	}
+
+	flattenGroup(d, group)
+	return nil
+}
+
+func resourceGroupUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+
+	var operations []webapi.JsonPatchOperation
+	if d.HasChange("display_name") {
+		operations = append(operations, groupPatchOperation("/displayName", d.Get("display_name").(string)))
+	}
+	if d.HasChange("description") {
+		operations = append(operations, groupPatchOperation("/description", d.Get("description").(string)))
+	}
+	if len(operations) == 0 {
+		return resourceGroupRead(d, m)
+	}
+
+	group, err := updateGroup(clients, d.Id(), operations...)
+	if err != nil {
+		return err
+	}
+
+	flattenGroup(d, group)
+	return nil
+}
+
+func resourceGroupDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+
+	// referenced groups, e.g. the built-in groups of a project, are not managed by Terraform
+	if !d.Get("reference_existing").(bool) {
+		err := clients.GraphClient.DeleteGroup(clients.ctx, graph.DeleteGroupArgs{
+			GroupDescriptor: converter.String(d.Id()),
+		})
+		if err != nil {
+			return fmt.Errorf("Error deleting group with descriptor %s. Error: %v", d.Id(), err)
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// Returns the descriptor of the project in which the group exists, or an empty string for groups of the organization
+func getGroupScopeDescriptor(clients *aggregatedClient, projectID string) (string, error) {
+	if projectID == "" {
+		return "", nil
+	}
+
+	scopeDescriptor, err := getProjectDescriptor(clients, projectID)
+	if err != nil {
+		return "", fmt.Errorf("Error finding descriptor for project with ID %s. Error: %v", projectID, err)
+	}
+	return scopeDescriptor, nil
+}
+
+func createGroup(clients *aggregatedClient, d *schema.ResourceData, scopeDescriptor string) (*graph.GraphGroup, error) {
+	args := graphgroup.CreateGroupArgs{
+		CreationContext: &graph.GraphGroupVstsCreationContext{
+			DisplayName: converter.String(d.Get("display_name").(string)),
+			Description: converter.String(d.Get("description").(string)),
+		},
+	}
+	if scopeDescriptor != "" {
+		args.ScopeDescriptor = &scopeDescriptor
+	}
+
+	group, err := clients.GraphGroupClient.CreateGroup(clients.ctx, args)
+	if err != nil {
+		return nil, fmt.Errorf("Error creating group %s in Azure DevOps: %+v", d.Get("display_name"), err)
+	}
+	return group, nil
+}
+
+func findExistingGroup(clients *aggregatedClient, scopeDescriptor string, displayName string) (*graph.GraphGroup, error) {
+	groups, err := getGroupsForDescriptor(clients, scopeDescriptor)
+	if err != nil {
+		return nil, fmt.Errorf("Error finding groups in scope %s. Error: %v", scopeDescriptor, err)
+	}
+
+	group := selectGroup(groups, displayName)
+	if group == nil {
+		return nil, fmt.Errorf("Could not find an existing group with name %s", displayName)
+	}
+	return group, nil
+}
+
+func updateGroup(clients *aggregatedClient, descriptor string, operations ...webapi.JsonPatchOperation) (*graph.GraphGroup, error) {
+	group, err := clients.GraphClient.UpdateGroup(clients.ctx, graph.UpdateGroupArgs{
+		GroupDescriptor: &descriptor,
+		PatchDocument:   &operations,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error updating group with descriptor %s. Error: %v", descriptor, err)
+	}
+	return group, nil
+}
+
+func groupPatchOperation(path string, value string) webapi.JsonPatchOperation {
+	return webapi.JsonPatchOperation{
+		Op:    &webapi.OperationValues.Replace,
+		Path:  converter.String(path),
+		Value: value,
+	}
+}
+
+// Convert AzDO data structure to internal Terraform data structure
+func flattenGroup(d *schema.ResourceData, group *graph.GraphGroup) {
+	d.SetId(*group.Descriptor)
+	d.Set("descriptor", *group.Descriptor)
+	d.Set("display_name", converter.ToString(group.DisplayName, ""))
+	d.Set("description", converter.ToString(group.Description, ""))
+	d.Set("origin", converter.ToString(group.Origin, ""))
+	d.Set("origin_id", converter.ToString(group.OriginId, ""))
+}
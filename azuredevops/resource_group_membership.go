@@ -0,0 +1,193 @@
+package azuredevops
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/graph"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/azdoerror"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+)
+
+// The modes in which memberships are managed. In add mode only the listed members are managed, while in
+// overwrite mode the listed members are the only members of the group.
+const (
+	membershipModeAdd       = "add"
+	membershipModeOverwrite = "overwrite"
+)
+
+func resourceGroupMembership() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGroupMembershipCreate,
+		Read:   resourceGroupMembershipRead,
+		Update: resourceGroupMembershipUpdate,
+		Delete: resourceGroupMembershipDelete,
+		Schema: map[string]*schema.Schema{
+			"group": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"members": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.NoZeroValues,
+				},
+				Set: schema.HashString,
+			},
+			"mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      membershipModeAdd,
+				ValidateFunc: validation.StringInSlice([]string{membershipModeAdd, membershipModeOverwrite}, false),
+			},
+		},
+	}
+}
+
+func resourceGroupMembershipCreate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	group := d.Get("group").(string)
+
+	if err := reconcileGroupMembership(clients, d, nil); err != nil {
+		return err
+	}
+
+	d.SetId(group)
+	return resourceGroupMembershipRead(d, m)
+}
+
+func resourceGroupMembershipRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	group := d.Get("group").(string)
+
+	actualMembers, err := getGroupMembers(clients, group)
+	if err != nil {
+		if azdoerror.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error listing the members of group %s. Error: %v", group, err)
+	}
+
+	d.Set("members", managedMemberships(actualMembers, d.Get("members").(*schema.Set), d.Get("mode").(string)))
+	return nil
+}
+
+func resourceGroupMembershipUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+
+	// members that are no longer listed are removed in both modes
+	var removed *schema.Set
+	if d.HasChange("members") {
+		oldMembers, newMembers := d.GetChange("members")
+		removed = oldMembers.(*schema.Set).Difference(newMembers.(*schema.Set))
+	}
+
+	if err := reconcileGroupMembership(clients, d, removed); err != nil {
+		return err
+	}
+	return resourceGroupMembershipRead(d, m)
+}
+
+func resourceGroupMembershipDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	group := d.Get("group").(string)
+
+	// only the members listed by this resource are removed, even in overwrite mode
+	for _, member := range d.Get("members").(*schema.Set).List() {
+		if err := removeGroupMember(clients, group, member.(string)); err != nil {
+			return err
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// Adds the listed members that are missing from the group. Members in the removed set are removed from the
+// group, and so are all other members that are not listed if the memberships are managed in overwrite mode.
+func reconcileGroupMembership(clients *aggregatedClient, d *schema.ResourceData, removed *schema.Set) error {
+	group := d.Get("group").(string)
+	desired := d.Get("members").(*schema.Set)
+
+	actual, err := getGroupMembers(clients, group)
+	if err != nil {
+		return fmt.Errorf("Error listing the members of group %s. Error: %v", group, err)
+	}
+
+	toAdd, toRemove := diffMemberships(desired, actual, removed, d.Get("mode").(string))
+	for _, member := range toAdd.List() {
+		_, err := clients.GraphClient.AddMembership(clients.ctx, graph.AddMembershipArgs{
+			SubjectDescriptor:   converter.String(member.(string)),
+			ContainerDescriptor: converter.String(group),
+		})
+		if err != nil {
+			return fmt.Errorf("Error adding member %s to group %s. Error: %v", member, group, err)
+		}
+	}
+
+	for _, member := range toRemove.List() {
+		if err := removeGroupMember(clients, group, member.(string)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Returns the members that have to be added and removed so that the actual members match the desired
+// members in the given mode. Members in the removed set are removed in both modes.
+func diffMemberships(desired *schema.Set, actual *schema.Set, removed *schema.Set, mode string) (*schema.Set, *schema.Set) {
+	toRemove := schema.NewSet(schema.HashString, nil)
+	if removed != nil {
+		toRemove = removed.Intersection(actual)
+	}
+	if mode == membershipModeOverwrite {
+		toRemove = toRemove.Union(actual.Difference(desired))
+	}
+	return desired.Difference(actual), toRemove
+}
+
+// Returns the members that are managed in the given mode. In add mode, members that are not listed are
+// ignored.
+func managedMemberships(actual *schema.Set, listed *schema.Set, mode string) *schema.Set {
+	if mode == membershipModeAdd {
+		return actual.Intersection(listed)
+	}
+	return actual
+}
+
+func removeGroupMember(clients *aggregatedClient, group string, member string) error {
+	err := clients.GraphClient.RemoveMembership(clients.ctx, graph.RemoveMembershipArgs{
+		SubjectDescriptor:   converter.String(member),
+		ContainerDescriptor: converter.String(group),
+	})
+	if err != nil && !azdoerror.IsNotFound(err) {
+		return fmt.Errorf("Error removing member %s from group %s. Error: %v", member, group, err)
+	}
+	return nil
+}
+
+// Returns the descriptors of the direct members of a group
+func getGroupMembers(clients *aggregatedClient, group string) (*schema.Set, error) {
+	memberships, err := clients.GraphClient.ListMemberships(clients.ctx, graph.ListMembershipsArgs{
+		SubjectDescriptor: converter.String(group),
+		Direction:         &graph.GraphTraversalDirectionValues.Down,
+		Depth:             converter.Int(1),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	members := schema.NewSet(schema.HashString, nil)
+	if memberships != nil {
+		for _, membership := range *memberships {
+			members.Add(converter.ToString(membership.MemberDescriptor, ""))
+		}
+	}
+	return members, nil
+}
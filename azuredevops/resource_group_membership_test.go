@@ -0,0 +1,269 @@
+package azuredevops
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/microsoft/azure-devops-go-api/azuredevops"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/graph"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var testMembershipGroup = "vssgp.group"
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that only the missing members are added in add mode and that other members are ignored
+func TestAzureDevOpsGroupMembership_Create_AddModeOnlyAddsMissingMembers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	graphClient := azdosdkmocks.NewMockGraphClient(ctrl)
+	clients := &aggregatedClient{GraphClient: graphClient, ctx: context.Background()}
+
+	resourceData := createGroupMembershipResourceData(t, membershipModeAdd, "aad.a", "aad.b")
+
+	first := expectListMemberships(graphClient, "aad.a", "aad.c")
+	expectAddMembership(graphClient, "aad.b").After(first)
+	expectListMemberships(graphClient, "aad.a", "aad.b", "aad.c")
+
+	err := resourceGroupMembershipCreate(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, testMembershipGroup, resourceData.Id())
+	require.ElementsMatch(t, []interface{}{"aad.a", "aad.b"}, resourceData.Get("members").(*schema.Set).List())
+}
+
+// verifies that members that are not listed are removed in overwrite mode
+func TestAzureDevOpsGroupMembership_Create_OverwriteModeRemovesOtherMembers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	graphClient := azdosdkmocks.NewMockGraphClient(ctrl)
+	clients := &aggregatedClient{GraphClient: graphClient, ctx: context.Background()}
+
+	resourceData := createGroupMembershipResourceData(t, membershipModeOverwrite, "aad.a", "aad.b")
+
+	first := expectListMemberships(graphClient, "aad.a", "aad.c")
+	expectAddMembership(graphClient, "aad.b").After(first)
+	expectRemoveMembership(graphClient, "aad.c").After(first)
+	expectListMemberships(graphClient, "aad.a", "aad.b", "aad.d")
+
+	err := resourceGroupMembershipCreate(resourceData, clients)
+	require.Nil(t, err)
+
+	// members that were added out of band are detected in overwrite mode
+	require.ElementsMatch(t, []interface{}{"aad.a", "aad.b", "aad.d"}, resourceData.Get("members").(*schema.Set).List())
+}
+
+// verifies that members which are no longer listed are removed in add mode
+func TestAzureDevOpsGroupMembership_Reconcile_RemovesUnlistedMembersInAddMode(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	graphClient := azdosdkmocks.NewMockGraphClient(ctrl)
+	clients := &aggregatedClient{GraphClient: graphClient, ctx: context.Background()}
+
+	resourceData := createGroupMembershipResourceData(t, membershipModeAdd, "aad.a")
+	removed := schema.NewSet(schema.HashString, []interface{}{"aad.b", "aad.gone"})
+
+	expectListMemberships(graphClient, "aad.a", "aad.b", "aad.c")
+	expectRemoveMembership(graphClient, "aad.b")
+
+	err := reconcileGroupMembership(clients, resourceData, removed)
+	require.Nil(t, err)
+}
+
+// verifies that if an error is produced while adding a member, the error is not swallowed
+func TestAzureDevOpsGroupMembership_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	graphClient := azdosdkmocks.NewMockGraphClient(ctrl)
+	clients := &aggregatedClient{GraphClient: graphClient, ctx: context.Background()}
+
+	resourceData := createGroupMembershipResourceData(t, membershipModeAdd, "aad.a")
+
+	expectListMemberships(graphClient)
+	graphClient.
+		EXPECT().
+		AddMembership(clients.ctx, gomock.Any()).
+		Return(nil, errors.New("AddMembership() Failed")).
+		Times(1)
+
+	err := resourceGroupMembershipCreate(resourceData, clients)
+	require.Contains(t, err.Error(), "AddMembership() Failed")
+}
+
+// verifies that the memberships of a group that no longer exists are removed from the state
+func TestAzureDevOpsGroupMembership_Read_ClearsIDOfMissingGroup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	graphClient := azdosdkmocks.NewMockGraphClient(ctrl)
+	clients := &aggregatedClient{GraphClient: graphClient, ctx: context.Background()}
+
+	resourceData := createGroupMembershipResourceData(t, membershipModeAdd, "aad.a")
+	resourceData.SetId(testMembershipGroup)
+
+	statusCode := http.StatusNotFound
+	graphClient.
+		EXPECT().
+		ListMemberships(clients.ctx, gomock.Any()).
+		Return(nil, azuredevops.WrappedError{StatusCode: &statusCode}).
+		Times(1)
+
+	err := resourceGroupMembershipRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "", resourceData.Id())
+}
+
+// verifies that only the listed members are removed on delete
+func TestAzureDevOpsGroupMembership_Delete_RemovesListedMembers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	graphClient := azdosdkmocks.NewMockGraphClient(ctrl)
+	clients := &aggregatedClient{GraphClient: graphClient, ctx: context.Background()}
+
+	resourceData := createGroupMembershipResourceData(t, membershipModeAdd, "aad.a", "aad.b")
+	resourceData.SetId(testMembershipGroup)
+
+	expectRemoveMembership(graphClient, "aad.a")
+
+	// members that have already been removed out of band are ignored
+	statusCode := http.StatusNotFound
+	graphClient.
+		EXPECT().
+		RemoveMembership(clients.ctx, graph.RemoveMembershipArgs{
+			SubjectDescriptor:   converter.String("aad.b"),
+			ContainerDescriptor: &testMembershipGroup,
+		}).
+		Return(azuredevops.WrappedError{StatusCode: &statusCode}).
+		Times(1)
+
+	err := resourceGroupMembershipDelete(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "", resourceData.Id())
+}
+
+func createGroupMembershipResourceData(t *testing.T, mode string, members ...interface{}) *schema.ResourceData {
+	return schema.TestResourceDataRaw(t, resourceGroupMembership().Schema, map[string]interface{}{
+		"group":   testMembershipGroup,
+		"members": members,
+		"mode":    mode,
+	})
+}
+
+func expectListMemberships(graphClient *azdosdkmocks.MockGraphClient, members ...string) *gomock.Call {
+	var memberships []graph.GraphMembership
+	for _, member := range members {
+		memberships = append(memberships, graph.GraphMembership{
+			ContainerDescriptor: &testMembershipGroup,
+			MemberDescriptor:    converter.String(member),
+		})
+	}
+
+	return graphClient.
+		EXPECT().
+		ListMemberships(gomock.Any(), graph.ListMembershipsArgs{
+			SubjectDescriptor: &testMembershipGroup,
+			Direction:         &graph.GraphTraversalDirectionValues.Down,
+			Depth:             converter.Int(1),
+		}).
+		Return(&memberships, nil).
+		Times(1)
+}
+
+func expectAddMembership(graphClient *azdosdkmocks.MockGraphClient, member string) *gomock.Call {
+	return graphClient.
+		EXPECT().
+		AddMembership(gomock.Any(), graph.AddMembershipArgs{
+			SubjectDescriptor:   converter.String(member),
+			ContainerDescriptor: &testMembershipGroup,
+		}).
+		Return(&graph.GraphMembership{}, nil).
+		Times(1)
+}
+
+func expectRemoveMembership(graphClient *azdosdkmocks.MockGraphClient, member string) *gomock.Call {
+	return graphClient.
+		EXPECT().
+		RemoveMembership(gomock.Any(), graph.RemoveMembershipArgs{
+			SubjectDescriptor:   converter.String(member),
+			ContainerDescriptor: &testMembershipGroup,
+		}).
+		Return(nil).
+		Times(1)
+}
+
+/**
+ * Begin acceptance tests
+ */
+
+// Verifies that a group can be added to and removed from the members of a built-in group
+func TestAccAzureDevOpsGroupMembership_CreateAndUpdate(t *testing.T) {
+	projectName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	groupName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	tfMembershipNode := "azuredevops_group_membership.membership"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupMembershipResource(projectName, groupName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfMembershipNode, "members.#", "1"),
+					testAccCheckGroupMembershipExists(true),
+				),
+			}, {
+				// removing the membership resource must remove the member from the group
+				Config: testAccGroupResource(projectName, groupName, "Description"),
+				Check:  testAccCheckGroupMembershipExists(false),
+			},
+		},
+	})
+}
+
+// HCL describing a membership of a group in a built-in group of a project
+func testAccGroupMembershipResource(projectName string, groupName string) string {
+	membershipResource := `
+resource "azuredevops_group_membership" "membership" {
+	group   = azuredevops_group.admins.descriptor
+	members = [azuredevops_group.group.descriptor]
+}`
+
+	groupResource := testAccGroupResource(projectName, groupName, "Description")
+	return fmt.Sprintf("%s\n%s", groupResource, membershipResource)
+}
+
+// verifies whether the group created by the test is a member of the built-in group
+func testAccCheckGroupMembershipExists(expectMembership bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		group := s.RootModule().Resources["azuredevops_group.group"].Primary.ID
+		admins := s.RootModule().Resources["azuredevops_group.admins"].Primary.ID
+
+		clients := testAccProvider.Meta().(*aggregatedClient)
+		members, err := getGroupMembers(clients, admins)
+		if err != nil {
+			return err
+		}
+
+		if members.Contains(group) != expectMembership {
+			return fmt.Errorf("Expected membership of group %s in group %s to be %t", group, admins, expectMembership)
+		}
+		return nil
+	}
+}
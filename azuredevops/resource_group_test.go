@@ -0,0 +1,317 @@
+package azuredevops
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/microsoft/azure-devops-go-api/azuredevops"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/graph"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/webapi"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/graphgroup"
+	"github.com/stretchr/testify/require"
+)
+
+var testGroup = graph.GraphGroup{
+	Descriptor:  converter.String("vssgp.descriptor"),
+	DisplayName: converter.String("Group"),
+	Description: converter.String("Description"),
+	Origin:      converter.String("vsts"),
+	OriginId:    converter.String("origin-id"),
+}
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that a group is created in the scope of the project
+func TestAzureDevOpsGroup_Create_UsesProjectScope(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	graphClient := azdosdkmocks.NewMockGraphClient(ctrl)
+	graphGroupClient := azdosdkmocks.NewMockGraphgroupClient(ctrl)
+	clients := &aggregatedClient{GraphClient: graphClient, GraphGroupClient: graphGroupClient, ctx: context.Background()}
+
+	projectID := uuid.New()
+	resourceData := schema.TestResourceDataRaw(t, resourceGroup().Schema, map[string]interface{}{
+		"scope":        projectID.String(),
+		"display_name": "Group",
+		"description":  "Description",
+	})
+
+	graphClient.
+		EXPECT().
+		GetDescriptor(clients.ctx, graph.GetDescriptorArgs{StorageKey: &projectID}).
+		Return(&graph.GraphDescriptorResult{Value: converter.String("scp.descriptor")}, nil).
+		Times(1)
+
+	graphGroupClient.
+		EXPECT().
+		CreateGroup(clients.ctx, graphgroup.CreateGroupArgs{
+			CreationContext: &graph.GraphGroupVstsCreationContext{
+				DisplayName: converter.String("Group"),
+				Description: converter.String("Description"),
+			},
+			ScopeDescriptor: converter.String("scp.descriptor"),
+		}).
+		Return(&testGroup, nil).
+		Times(1)
+
+	err := resourceGroupCreate(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "vssgp.descriptor", resourceData.Id())
+	require.Equal(t, "vsts", resourceData.Get("origin"))
+	require.Equal(t, "origin-id", resourceData.Get("origin_id"))
+}
+
+// verifies that if an error is produced on create, the error is not swallowed
+func TestAzureDevOpsGroup_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	graphGroupClient := azdosdkmocks.NewMockGraphgroupClient(ctrl)
+	clients := &aggregatedClient{GraphGroupClient: graphGroupClient, ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceGroup().Schema, map[string]interface{}{
+		"display_name": "Group",
+	})
+
+	graphGroupClient.
+		EXPECT().
+		CreateGroup(clients.ctx, gomock.Any()).
+		Return(nil, errors.New("CreateGroup() Failed")).
+		Times(1)
+
+	err := resourceGroupCreate(resourceData, clients)
+	require.Contains(t, err.Error(), "CreateGroup() Failed")
+}
+
+// verifies that an existing group is referenced instead of being created
+func TestAzureDevOpsGroup_Create_ReferencesExistingGroup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	graphClient := azdosdkmocks.NewMockGraphClient(ctrl)
+	graphGroupClient := azdosdkmocks.NewMockGraphgroupClient(ctrl)
+	clients := &aggregatedClient{GraphClient: graphClient, GraphGroupClient: graphGroupClient, ctx: context.Background()}
+
+	projectID := uuid.New()
+	resourceData := schema.TestResourceDataRaw(t, resourceGroup().Schema, map[string]interface{}{
+		"scope":              projectID.String(),
+		"display_name":       "Project Administrators",
+		"reference_existing": true,
+	})
+
+	graphClient.
+		EXPECT().
+		GetDescriptor(clients.ctx, gomock.Any()).
+		Return(&graph.GraphDescriptorResult{Value: converter.String("scp.descriptor")}, nil).
+		Times(1)
+
+	graphClient.
+		EXPECT().
+		ListGroups(clients.ctx, graph.ListGroupsArgs{ScopeDescriptor: converter.String("scp.descriptor")}).
+		Return(&graph.PagedGraphGroups{
+			GraphGroups: createGroupsWithDescriptors(
+				groupMeta{name: "Contributors", descriptor: "vssgp.contributors"},
+				groupMeta{name: "Project Administrators", descriptor: "vssgp.administrators"},
+			),
+		}, nil).
+		Times(1)
+
+	graphGroupClient.
+		EXPECT().
+		CreateGroup(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	err := resourceGroupCreate(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "vssgp.administrators", resourceData.Id())
+}
+
+// verifies that a missing group is removed from the state
+func TestAzureDevOpsGroup_Read_ClearsIDOfMissingGroup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	graphClient := azdosdkmocks.NewMockGraphClient(ctrl)
+	clients := &aggregatedClient{GraphClient: graphClient, ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceGroup().Schema, nil)
+	resourceData.SetId("vssgp.descriptor")
+
+	statusCode := http.StatusNotFound
+	graphClient.
+		EXPECT().
+		GetGroup(clients.ctx, graph.GetGroupArgs{GroupDescriptor: converter.String("vssgp.descriptor")}).
+		Return(nil, azuredevops.WrappedError{StatusCode: &statusCode}).
+		Times(1)
+
+	err := resourceGroupRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "", resourceData.Id())
+}
+
+// verifies that the display name and description are updated in place
+func TestAzureDevOpsGroup_Update_PatchesChangedFields(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	graphClient := azdosdkmocks.NewMockGraphClient(ctrl)
+	clients := &aggregatedClient{GraphClient: graphClient, ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceGroup().Schema, map[string]interface{}{
+		"display_name": "Group",
+		"description":  "New Description",
+	})
+	resourceData.SetId("vssgp.descriptor")
+
+	graphClient.
+		EXPECT().
+		UpdateGroup(clients.ctx, graph.UpdateGroupArgs{
+			GroupDescriptor: converter.String("vssgp.descriptor"),
+			PatchDocument: &[]webapi.JsonPatchOperation{
+				{Op: &webapi.OperationValues.Replace, Path: converter.String("/displayName"), Value: "Group"},
+				{Op: &webapi.OperationValues.Replace, Path: converter.String("/description"), Value: "New Description"},
+			},
+		}).
+		Return(&testGroup, nil).
+		Times(1)
+
+	err := resourceGroupUpdate(resourceData, clients)
+	require.Nil(t, err)
+}
+
+// verifies that a group created by Terraform is deleted, but a referenced group is not
+func TestAzureDevOpsGroup_Delete_OnlyDeletesManagedGroups(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	graphClient := azdosdkmocks.NewMockGraphClient(ctrl)
+	clients := &aggregatedClient{GraphClient: graphClient, ctx: context.Background()}
+
+	graphClient.
+		EXPECT().
+		DeleteGroup(clients.ctx, graph.DeleteGroupArgs{GroupDescriptor: converter.String("vssgp.managed")}).
+		Return(nil).
+		Times(1)
+
+	managed := schema.TestResourceDataRaw(t, resourceGroup().Schema, map[string]interface{}{"display_name": "Managed"})
+	managed.SetId("vssgp.managed")
+	require.Nil(t, resourceGroupDelete(managed, clients))
+
+	referenced := schema.TestResourceDataRaw(t, resourceGroup().Schema, map[string]interface{}{
+		"display_name":       "Project Administrators",
+		"reference_existing": true,
+	})
+	referenced.SetId("vssgp.administrators")
+	require.Nil(t, resourceGroupDelete(referenced, clients))
+	require.Equal(t, "", referenced.Id())
+}
+
+// verifies that if an error is produced on delete, the error is not swallowed
+func TestAzureDevOpsGroup_Delete_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	graphClient := azdosdkmocks.NewMockGraphClient(ctrl)
+	clients := &aggregatedClient{GraphClient: graphClient, ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceGroup().Schema, map[string]interface{}{"display_name": "Group"})
+	resourceData.SetId("vssgp.descriptor")
+
+	graphClient.
+		EXPECT().
+		DeleteGroup(clients.ctx, gomock.Any()).
+		Return(errors.New("DeleteGroup() Failed")).
+		Times(1)
+
+	err := resourceGroupDelete(resourceData, clients)
+	require.Contains(t, err.Error(), "DeleteGroup() Failed")
+}
+
+/**
+ * Begin acceptance tests
+ */
+
+// Verifies that a group can be created in a project, updated and destroyed, and that
+// a built-in group can be referenced without being destroyed
+func TestAccAzureDevOpsGroup_CreateAndUpdate(t *testing.T) {
+	projectName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	groupName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	tfGroupNode := "azuredevops_group.group"
+	tfAdminsNode := "azuredevops_group.admins"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccGroupCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupResource(projectName, groupName, "First description"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfGroupNode, "display_name", groupName),
+					resource.TestCheckResourceAttr(tfGroupNode, "description", "First description"),
+					resource.TestCheckResourceAttrSet(tfGroupNode, "descriptor"),
+					resource.TestCheckResourceAttrSet(tfGroupNode, "origin"),
+					resource.TestCheckResourceAttrSet(tfGroupNode, "origin_id"),
+					resource.TestCheckResourceAttrSet(tfAdminsNode, "descriptor"),
+				),
+			}, {
+				Config: testAccGroupResource(projectName, groupName, "Second description"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfGroupNode, "description", "Second description"),
+				),
+			},
+		},
+	})
+}
+
+// HCL describing a group of a project and a reference to a built-in group of the project
+func testAccGroupResource(projectName string, groupName string, description string) string {
+	groupResource := fmt.Sprintf(`
+resource "azuredevops_group" "group" {
+	scope        = azuredevops_project.project.id
+	display_name = "%s"
+	description  = "%s"
+}
+
+resource "azuredevops_group" "admins" {
+	scope              = azuredevops_project.project.id
+	display_name       = "Project Administrators"
+	reference_existing = true
+}`, groupName, description)
+
+	projectResource := testAccProjectResource(projectName)
+	return fmt.Sprintf("%s\n%s", projectResource, groupResource)
+}
+
+// verifies that all groups created by Terraform are destroyed
+func testAccGroupCheckDestroy(s *terraform.State) error {
+	clients := testAccProvider.Meta().(*aggregatedClient)
+
+	for _, resource := range s.RootModule().Resources {
+		if resource.Type != "azuredevops_group" || resource.Primary.Attributes["reference_existing"] == "true" {
+			continue
+		}
+
+		group, err := clients.GraphClient.GetGroup(clients.ctx, graph.GetGroupArgs{
+			GroupDescriptor: converter.String(resource.Primary.ID),
+		})
+		if err == nil && group != nil {
+			return fmt.Errorf("Group with descriptor %s should not exist", resource.Primary.ID)
+		}
+	}
+	return nil
+}
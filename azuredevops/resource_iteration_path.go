@@ -0,0 +1,99 @@
+package azuredevops
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/workitemtracking"
+)
+
+// The format of the start and finish dates of an iteration
+const iterationDateFormat = "2006-01-02"
+
+func resourceIterationPath() *schema.Resource {
+	r := genBaseClassificationNodeResource(workitemtracking.TreeStructureGroupValues.Iterations, flattenIterationPath, expandIterationPath)
+	r.CustomizeDiff = customizeDiffIterationPath
+
+	r.Schema["start_date"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Description:  "The first day of the iteration, in the format YYYY-MM-DD.",
+		ValidateFunc: validateIterationDate,
+	}
+	r.Schema["finish_date"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Description:  "The last day of the iteration, in the format YYYY-MM-DD.",
+		ValidateFunc: validateIterationDate,
+	}
+	return r
+}
+
+// Verifies at plan time that either both or none of the dates are set, and that the iteration does not end
+// before it starts
+func customizeDiffIterationPath(d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("start_date") || !d.NewValueKnown("finish_date") {
+		return nil
+	}
+
+	startDate := d.Get("start_date").(string)
+	finishDate := d.Get("finish_date").(string)
+	if (startDate == "") != (finishDate == "") {
+		return fmt.Errorf("start_date and finish_date must either both be set or both be omitted")
+	}
+	if startDate != "" && finishDate < startDate {
+		return fmt.Errorf("finish_date %s must not be before start_date %s", finishDate, startDate)
+	}
+	return nil
+}
+
+func validateIterationDate(i interface{}, k string) ([]string, []error) {
+	date, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %q to be string", k)}
+	}
+	if _, err := time.Parse(iterationDateFormat, date); err != nil {
+		return nil, []error{fmt.Errorf("%q must be a date in the format YYYY-MM-DD, got %q", k, date)}
+	}
+	return nil, nil
+}
+
+// Convert internal Terraform data structure to the attributes of an AzDO classification node. Dates that are not
+// set are sent as null, which removes them from the iteration.
+func expandIterationPath(d *schema.ResourceData) *map[string]interface{} {
+	attributes := map[string]interface{}{
+		"startDate":  nil,
+		"finishDate": nil,
+	}
+	if startDate := d.Get("start_date").(string); startDate != "" {
+		attributes["startDate"] = startDate + "T00:00:00Z"
+	}
+	if finishDate := d.Get("finish_date").(string); finishDate != "" {
+		attributes["finishDate"] = finishDate + "T00:00:00Z"
+	}
+	return &attributes
+}
+
+// Convert the attributes of an AzDO classification node to internal Terraform data structure
+func flattenIterationPath(d *schema.ResourceData, node *workitemtracking.WorkItemClassificationNode) {
+	var attributes map[string]interface{}
+	if node.Attributes != nil {
+		attributes = *node.Attributes
+	}
+	d.Set("start_date", flattenIterationDate(attributes["startDate"]))
+	d.Set("finish_date", flattenIterationDate(attributes["finishDate"]))
+}
+
+// The service returns dates as timestamps, e.g. 2020-01-06T00:00:00Z
+func flattenIterationDate(value interface{}) string {
+	timestamp, ok := value.(string)
+	if !ok {
+		return ""
+	}
+	date, err := time.Parse(time.RFC3339, timestamp)
+	if err != nil {
+		return timestamp
+	}
+	return date.Format(iterationDateFormat)
+}
@@ -0,0 +1,168 @@
+package azuredevops
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/workitemtracking"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that either both or none of the dates have to be set, and that they have to be in order
+func TestAzureDevOpsIterationPath_ValidatesDates(t *testing.T) {
+	diffWithDates := func(dates map[string]interface{}) error {
+		config := map[string]interface{}{
+			"project_id": "project",
+			"name":       "Sprint 1",
+		}
+		for key, value := range dates {
+			config[key] = value
+		}
+		_, err := resourceIterationPath().Diff(nil, terraform.NewResourceConfigRaw(config), nil)
+		return err
+	}
+
+	require.Nil(t, diffWithDates(map[string]interface{}{}))
+	require.Nil(t, diffWithDates(map[string]interface{}{"start_date": "2020-01-06", "finish_date": "2020-01-17"}))
+	require.Nil(t, diffWithDates(map[string]interface{}{"start_date": "2020-01-06", "finish_date": "2020-01-06"}))
+	require.NotNil(t, diffWithDates(map[string]interface{}{"start_date": "2020-01-06"}))
+	require.NotNil(t, diffWithDates(map[string]interface{}{"finish_date": "2020-01-17"}))
+	require.NotNil(t, diffWithDates(map[string]interface{}{"start_date": "2020-01-17", "finish_date": "2020-01-06"}))
+
+	_, errs := validateIterationDate("06.01.2020", "start_date")
+	require.Len(t, errs, 1)
+}
+
+// verifies that the dates of an iteration are sent as timestamps and read back as dates
+func TestAzureDevOpsIterationPath_Create_RoundTripsDates(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	witClient := azdosdkmocks.NewMockWorkitemtrackingClient(ctrl)
+	clients := &aggregatedClient{WorkItemTrackingClient: witClient, ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceIterationPath().Schema, map[string]interface{}{
+		"project_id":  testAreaPathProjectID,
+		"name":        "Sprint 1",
+		"start_date":  "2020-01-06",
+		"finish_date": "2020-01-17",
+	})
+
+	iterations := workitemtracking.TreeStructureGroupValues.Iterations
+	rootID := 1
+	witClient.
+		EXPECT().
+		GetClassificationNode(clients.ctx, workitemtracking.GetClassificationNodeArgs{
+			Project:        &testAreaPathProjectID,
+			StructureGroup: &iterations,
+			Path:           converter.String(""),
+		}).
+		Return(&workitemtracking.WorkItemClassificationNode{Id: &rootID}, nil).
+		Times(1)
+
+	nodeID := 2
+	witClient.
+		EXPECT().
+		CreateOrUpdateClassificationNode(clients.ctx, workitemtracking.CreateOrUpdateClassificationNodeArgs{
+			PostedNode: &workitemtracking.WorkItemClassificationNode{
+				Name: converter.String("Sprint 1"),
+				Attributes: &map[string]interface{}{
+					"startDate":  "2020-01-06T00:00:00Z",
+					"finishDate": "2020-01-17T00:00:00Z",
+				},
+			},
+			Project:        &testAreaPathProjectID,
+			StructureGroup: &iterations,
+			Path:           converter.String(""),
+		}).
+		Return(&workitemtracking.WorkItemClassificationNode{
+			Id:   &nodeID,
+			Name: converter.String("Sprint 1"),
+			Path: converter.String(`\Project\Iteration\Sprint 1`),
+			Attributes: &map[string]interface{}{
+				"startDate":  "2020-01-06T00:00:00Z",
+				"finishDate": "2020-01-17T00:00:00Z",
+			},
+		}, nil).
+		Times(1)
+
+	err := resourceIterationPath().Create(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "2", resourceData.Id())
+	require.Equal(t, `\`, resourceData.Get("path"))
+	require.Equal(t, "2020-01-06", resourceData.Get("start_date"))
+	require.Equal(t, "2020-01-17", resourceData.Get("finish_date"))
+}
+
+// verifies that dates that are not set are removed from the iteration
+func TestAzureDevOpsIterationPath_Expand_ClearsUnsetDates(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, resourceIterationPath().Schema, map[string]interface{}{
+		"project_id": testAreaPathProjectID,
+		"name":       "Sprint 1",
+	})
+
+	attributes := expandIterationPath(resourceData)
+	require.Equal(t, map[string]interface{}{"startDate": nil, "finishDate": nil}, *attributes)
+
+	flattenIterationPath(resourceData, &workitemtracking.WorkItemClassificationNode{})
+	require.Equal(t, "", resourceData.Get("start_date"))
+	require.Equal(t, "", resourceData.Get("finish_date"))
+}
+
+/**
+ * Begin acceptance tests
+ */
+
+// Verifies that the dates of an iteration can be set and updated
+func TestAccAzureDevOpsIterationPath_CreateAndUpdate(t *testing.T) {
+	projectName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	tfNode := "azuredevops_iteration_path.iteration"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccClassificationNodeCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIterationPathResource(projectName, "2020-01-06", "2020-01-17"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfNode, "id"),
+					resource.TestCheckResourceAttr(tfNode, "start_date", "2020-01-06"),
+					resource.TestCheckResourceAttr(tfNode, "finish_date", "2020-01-17"),
+				),
+			}, {
+				Config: testAccIterationPathResource(projectName, "2020-01-20", "2020-01-31"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "start_date", "2020-01-20"),
+					resource.TestCheckResourceAttr(tfNode, "finish_date", "2020-01-31"),
+				),
+			},
+		},
+	})
+}
+
+// HCL describing an iteration path
+func testAccIterationPathResource(projectName string, startDate string, finishDate string) string {
+	iterationPathResource := fmt.Sprintf(`
+resource "azuredevops_iteration_path" "iteration" {
+	project_id  = azuredevops_project.project.id
+	name        = "Sprint 1"
+	start_date  = "%s"
+	finish_date = "%s"
+}`, startDate, finishDate)
+
+	projectResource := testAccProjectResource(projectName)
+	return fmt.Sprintf("%s\n%s", projectResource, iterationPathResource)
+}
@@ -0,0 +1,247 @@
+package azuredevops
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/security"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+)
+
+// The states that a permission of a principal can be set to. Permissions that are not set are inherited.
+const (
+	permissionAllow  = "Allow"
+	permissionDeny   = "Deny"
+	permissionNotSet = "NotSet"
+)
+
+// Returns the schema shared by all resources that manage the permissions of a principal on a securable
+// object. The permissions are a map of the names of the actions of the security namespace to their state.
+func baseSecurityPermissionsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"project_id": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.NoZeroValues,
+		},
+		"principal": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.NoZeroValues,
+		},
+		"permissions": {
+			Type:         schema.TypeMap,
+			Required:     true,
+			ValidateFunc: validatePermissionStates,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+	}
+}
+
+// Sets the configured permissions of the principal on the object identified by the token. Only the bits of
+// the configured permissions are changed, and permissions that are no longer configured are reset.
+func updateSecurityPermissions(clients *aggregatedClient, d *schema.ResourceData, namespaceID uuid.UUID, token string) error {
+	oldPermissions, newPermissions := d.GetChange("permissions")
+	permissions := map[string]string{}
+	for name := range oldPermissions.(map[string]interface{}) {
+		permissions[name] = permissionNotSet
+	}
+	for name, state := range newPermissions.(map[string]interface{}) {
+		permissions[name] = state.(string)
+	}
+
+	return setSecurityPermissions(clients, namespaceID, token, d.Get("principal").(string), permissions)
+}
+
+// Reads the state of the configured permissions of the principal on the object identified by the token
+func readSecurityPermissions(clients *aggregatedClient, d *schema.ResourceData, namespaceID uuid.UUID, token string) error {
+	principal := d.Get("principal").(string)
+	actions, err := getSecurityNamespaceActions(clients, namespaceID)
+	if err != nil {
+		return err
+	}
+	identityDescriptor, err := getIdentityDescriptor(clients, principal)
+	if err != nil {
+		return err
+	}
+	allow, deny, err := getAccessControlEntry(clients, namespaceID, token, identityDescriptor)
+	if err != nil {
+		return err
+	}
+
+	permissions := map[string]interface{}{}
+	for name := range d.Get("permissions").(map[string]interface{}) {
+		bit, ok := actions[name]
+		if !ok {
+			continue
+		}
+		switch {
+		case allow&bit != 0:
+			permissions[name] = permissionAllow
+		case deny&bit != 0:
+			permissions[name] = permissionDeny
+		default:
+			permissions[name] = permissionNotSet
+		}
+	}
+
+	d.Set("permissions", permissions)
+	return nil
+}
+
+// Resets the configured permissions of the principal, so that they are inherited again. Permissions that are
+// not managed by the resource are left untouched.
+func resetSecurityPermissions(clients *aggregatedClient, d *schema.ResourceData, namespaceID uuid.UUID, token string) error {
+	permissions := map[string]string{}
+	for name := range d.Get("permissions").(map[string]interface{}) {
+		permissions[name] = permissionNotSet
+	}
+
+	return setSecurityPermissions(clients, namespaceID, token, d.Get("principal").(string), permissions)
+}
+
+func setSecurityPermissions(clients *aggregatedClient, namespaceID uuid.UUID, token string, principal string, permissions map[string]string) error {
+	actions, err := getSecurityNamespaceActions(clients, namespaceID)
+	if err != nil {
+		return err
+	}
+	identityDescriptor, err := getIdentityDescriptor(clients, principal)
+	if err != nil {
+		return err
+	}
+	allow, deny, err := getAccessControlEntry(clients, namespaceID, token, identityDescriptor)
+	if err != nil {
+		return err
+	}
+
+	for name, state := range permissions {
+		bit, ok := actions[name]
+		if !ok {
+			var names []string
+			for action := range actions {
+				names = append(names, action)
+			}
+			sort.Strings(names)
+			return fmt.Errorf("Unknown permission %s, expected one of %s", name, strings.Join(names, ", "))
+		}
+
+		allow &^= bit
+		deny &^= bit
+		switch state {
+		case permissionAllow:
+			allow |= bit
+		case permissionDeny:
+			deny |= bit
+		}
+	}
+
+	// the entry of the principal is replaced, while the entries of all other principals are kept
+	_, err = clients.SecurityClient.SetAccessControlEntries(clients.ctx, security.SetAccessControlEntriesArgs{
+		SecurityNamespaceId: &namespaceID,
+		Container: map[string]interface{}{
+			"token": token,
+			"merge": false,
+			"accessControlEntries": []security.AccessControlEntry{{
+				Descriptor: &identityDescriptor,
+				Allow:      &allow,
+				Deny:       &deny,
+			}},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("Error setting the permissions of %s on %s. Error: %v", principal, token, err)
+	}
+	return nil
+}
+
+// Returns the bits of the actions of a security namespace by their name
+func getSecurityNamespaceActions(clients *aggregatedClient, namespaceID uuid.UUID) (map[string]int, error) {
+	namespaces, err := clients.SecurityClient.QuerySecurityNamespaces(clients.ctx, security.QuerySecurityNamespacesArgs{
+		SecurityNamespaceId: &namespaceID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error reading security namespace %s. Error: %v", namespaceID, err)
+	}
+	if namespaces == nil || len(*namespaces) == 0 {
+		return nil, fmt.Errorf("Security namespace %s does not exist", namespaceID)
+	}
+
+	actions := map[string]int{}
+	for _, namespace := range *namespaces {
+		if namespace.Actions == nil {
+			continue
+		}
+		for _, action := range *namespace.Actions {
+			if action.Name != nil && action.Bit != nil {
+				actions[*action.Name] = *action.Bit
+			}
+		}
+	}
+	return actions, nil
+}
+
+// Returns the allowed and denied permission bits of the identity on the object identified by the token
+func getAccessControlEntry(clients *aggregatedClient, namespaceID uuid.UUID, token string, identityDescriptor string) (int, int, error) {
+	acls, err := clients.SecurityClient.QueryAccessControlLists(clients.ctx, security.QueryAccessControlListsArgs{
+		SecurityNamespaceId: &namespaceID,
+		Token:               &token,
+		Descriptors:         &identityDescriptor,
+	})
+	if err != nil {
+		return 0, 0, fmt.Errorf("Error reading the permissions on %s. Error: %v", token, err)
+	}
+
+	if acls != nil {
+		for _, acl := range *acls {
+			if acl.AcesDictionary == nil {
+				continue
+			}
+			for _, ace := range *acl.AcesDictionary {
+				if strings.EqualFold(converter.ToString(ace.Descriptor, ""), identityDescriptor) {
+					allow, deny := 0, 0
+					if ace.Allow != nil {
+						allow = *ace.Allow
+					}
+					if ace.Deny != nil {
+						deny = *ace.Deny
+					}
+					return allow, deny, nil
+				}
+			}
+		}
+	}
+	return 0, 0, nil
+}
+
+// Resolves the identity descriptor of a single subject descriptor
+func getIdentityDescriptor(clients *aggregatedClient, principal string) (string, error) {
+	identityDescriptors, err := getIdentityDescriptors(clients, schema.NewSet(schema.HashString, []interface{}{principal}))
+	if err != nil {
+		return "", err
+	}
+	return identityDescriptors[0], nil
+}
+
+func validatePermissionStates(i interface{}, k string) ([]string, []error) {
+	permissions, ok := i.(map[string]interface{})
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %q to be map", k)}
+	}
+
+	var errors []error
+	for name, state := range permissions {
+		value, _ := state.(string)
+		if value != permissionAllow && value != permissionDeny && value != permissionNotSet {
+			errors = append(errors, fmt.Errorf("the state of permission %q in %q must be one of %s, %s or %s, got %q", name, k, permissionAllow, permissionDeny, permissionNotSet, value))
+		}
+	}
+	return nil, errors
+}
@@ -0,0 +1,227 @@
+package azuredevops
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/identity"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/security"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var testPermissionsNamespaceID = uuid.New()
+var testPermissionsToken = "token"
+var testPermissionsPrincipal = "vssgp.principal"
+var testPermissionsIdentity = "Microsoft.TeamFoundation.Identity;principal"
+
+var testPermissionsActions = []security.ActionDefinition{
+	{Name: converter.String("Read"), Bit: converter.Int(1)},
+	{Name: converter.String("Write"), Bit: converter.Int(2)},
+	{Name: converter.String("Delete"), Bit: converter.Int(4)},
+	{Name: converter.String("Manage"), Bit: converter.Int(8)},
+}
+
+type permissionsMocks struct {
+	security *azdosdkmocks.MockSecurityClient
+	identity *azdosdkmocks.MockIdentityClient
+}
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that only the bits of the configured permissions are changed
+func TestSecurityPermissions_Update_MergesManagedBits(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mocks, clients := createPermissionsMocks(ctrl)
+	resourceData := createPermissionsResourceData(t, map[string]interface{}{
+		"Read":   permissionAllow,
+		"Delete": permissionDeny,
+		"Manage": permissionNotSet,
+	})
+
+	expectSecurityNamespaceActions(mocks)
+	expectPermissionsIdentity(mocks)
+	// Write (2) and Manage (8) are allowed, Delete (4) is not set
+	expectAccessControlEntry(mocks, 2|8, 0)
+	expectSetAccessControlEntry(mocks, 1|2, 4)
+
+	err := updateSecurityPermissions(clients, resourceData, testPermissionsNamespaceID, testPermissionsToken)
+	require.Nil(t, err)
+}
+
+// verifies that the state of the configured permissions is read from the entry of the principal
+func TestSecurityPermissions_Read_ReadsManagedPermissions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mocks, clients := createPermissionsMocks(ctrl)
+	resourceData := createPermissionsResourceData(t, map[string]interface{}{
+		"Read":   permissionAllow,
+		"Delete": permissionAllow,
+		"Manage": permissionAllow,
+	})
+
+	expectSecurityNamespaceActions(mocks)
+	expectPermissionsIdentity(mocks)
+	expectAccessControlEntry(mocks, 1|2, 4)
+
+	err := readSecurityPermissions(clients, resourceData, testPermissionsNamespaceID, testPermissionsToken)
+	require.Nil(t, err)
+	require.Equal(t, map[string]interface{}{
+		"Read":   permissionAllow,
+		"Delete": permissionDeny,
+		"Manage": permissionNotSet,
+	}, resourceData.Get("permissions"))
+}
+
+// verifies that only the configured permissions are reset and that other permissions are kept
+func TestSecurityPermissions_Reset_OnlyResetsManagedBits(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mocks, clients := createPermissionsMocks(ctrl)
+	resourceData := createPermissionsResourceData(t, map[string]interface{}{
+		"Read":   permissionAllow,
+		"Delete": permissionDeny,
+	})
+
+	expectSecurityNamespaceActions(mocks)
+	expectPermissionsIdentity(mocks)
+	expectAccessControlEntry(mocks, 1|2, 4|8)
+	expectSetAccessControlEntry(mocks, 2, 8)
+
+	err := resetSecurityPermissions(clients, resourceData, testPermissionsNamespaceID, testPermissionsToken)
+	require.Nil(t, err)
+}
+
+// verifies that unknown permissions are reported with the permissions of the namespace
+func TestSecurityPermissions_Update_RejectsUnknownPermission(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mocks, clients := createPermissionsMocks(ctrl)
+	resourceData := createPermissionsResourceData(t, map[string]interface{}{"Reed": permissionAllow})
+
+	expectSecurityNamespaceActions(mocks)
+	expectPermissionsIdentity(mocks)
+	expectAccessControlEntry(mocks, 0, 0)
+
+	err := updateSecurityPermissions(clients, resourceData, testPermissionsNamespaceID, testPermissionsToken)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "Unknown permission Reed, expected one of Delete, Manage, Read, Write")
+}
+
+// verifies that if an error is produced while setting the permissions, the error is not swallowed
+func TestSecurityPermissions_Update_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mocks, clients := createPermissionsMocks(ctrl)
+	resourceData := createPermissionsResourceData(t, map[string]interface{}{"Read": permissionAllow})
+
+	expectSecurityNamespaceActions(mocks)
+	expectPermissionsIdentity(mocks)
+	expectAccessControlEntry(mocks, 0, 0)
+	mocks.security.
+		EXPECT().
+		SetAccessControlEntries(clients.ctx, gomock.Any()).
+		Return(nil, errors.New("SetAccessControlEntries() Failed")).
+		Times(1)
+
+	err := updateSecurityPermissions(clients, resourceData, testPermissionsNamespaceID, testPermissionsToken)
+	require.Contains(t, err.Error(), "SetAccessControlEntries() Failed")
+}
+
+// verifies that only the known permission states are accepted
+func TestSecurityPermissions_Validate_RejectsUnknownStates(t *testing.T) {
+	_, errs := validatePermissionStates(map[string]interface{}{"Read": permissionAllow, "Write": permissionNotSet}, "permissions")
+	require.Empty(t, errs)
+
+	_, errs = validatePermissionStates(map[string]interface{}{"Read": "allow"}, "permissions")
+	require.Len(t, errs, 1)
+}
+
+func createPermissionsMocks(ctrl *gomock.Controller) (*permissionsMocks, *aggregatedClient) {
+	mocks := &permissionsMocks{
+		security: azdosdkmocks.NewMockSecurityClient(ctrl),
+		identity: azdosdkmocks.NewMockIdentityClient(ctrl),
+	}
+	clients := &aggregatedClient{
+		SecurityClient: mocks.security,
+		IdentityClient: mocks.identity,
+		ctx:            context.Background(),
+	}
+	return mocks, clients
+}
+
+func createPermissionsResourceData(t *testing.T, permissions map[string]interface{}) *schema.ResourceData {
+	return schema.TestResourceDataRaw(t, baseSecurityPermissionsSchema(), map[string]interface{}{
+		"project_id":  "project",
+		"principal":   testPermissionsPrincipal,
+		"permissions": permissions,
+	})
+}
+
+func expectSecurityNamespaceActions(mocks *permissionsMocks) *gomock.Call {
+	return mocks.security.
+		EXPECT().
+		QuerySecurityNamespaces(gomock.Any(), security.QuerySecurityNamespacesArgs{SecurityNamespaceId: &testPermissionsNamespaceID}).
+		Return(&[]security.SecurityNamespaceDescription{{Actions: &testPermissionsActions}}, nil).
+		Times(1)
+}
+
+func expectPermissionsIdentity(mocks *permissionsMocks) *gomock.Call {
+	return mocks.identity.
+		EXPECT().
+		ReadIdentities(gomock.Any(), identity.ReadIdentitiesArgs{SubjectDescriptors: &testPermissionsPrincipal}).
+		Return(&[]identity.Identity{{Descriptor: &testPermissionsIdentity}}, nil).
+		Times(1)
+}
+
+func expectAccessControlEntry(mocks *permissionsMocks, allow int, deny int) *gomock.Call {
+	aces := map[string]security.AccessControlEntry{
+		"Microsoft.TeamFoundation.Identity;other": {Allow: converter.Int(15)},
+		testPermissionsIdentity: {
+			Descriptor: &testPermissionsIdentity,
+			Allow:      &allow,
+			Deny:       &deny,
+		},
+	}
+	return mocks.security.
+		EXPECT().
+		QueryAccessControlLists(gomock.Any(), security.QueryAccessControlListsArgs{
+			SecurityNamespaceId: &testPermissionsNamespaceID,
+			Token:               &testPermissionsToken,
+			Descriptors:         &testPermissionsIdentity,
+		}).
+		Return(&[]security.AccessControlList{{AcesDictionary: &aces}}, nil).
+		Times(1)
+}
+
+func expectSetAccessControlEntry(mocks *permissionsMocks, allow int, deny int) *gomock.Call {
+	return mocks.security.
+		EXPECT().
+		SetAccessControlEntries(gomock.Any(), security.SetAccessControlEntriesArgs{
+			SecurityNamespaceId: &testPermissionsNamespaceID,
+			Container: map[string]interface{}{
+				"token": testPermissionsToken,
+				"merge": false,
+				"accessControlEntries": []security.AccessControlEntry{{
+					Descriptor: &testPermissionsIdentity,
+					Allow:      &allow,
+					Deny:       &deny,
+				}},
+			},
+		}).
+		Return(nil, nil).
+		Times(1)
+}
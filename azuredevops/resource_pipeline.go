@@ -0,0 +1,288 @@
+package azuredevops
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/build"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/azdoerror"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/yamlpipeline"
+)
+
+// The folder pipelines are created in if no folder is configured
+const pipelineRootFolder = `\`
+
+func resourcePipeline() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePipelineCreate,
+		Read:   resourcePipelineRead,
+		Update: resourcePipelineUpdate,
+		Delete: resourcePipelineDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourcePipelineImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"folder": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      pipelineRootFolder,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"repository": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"repo_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+							Default:  yamlpipeline.RepositoryTypeAzureReposGit,
+							ValidateFunc: validation.StringInSlice([]string{
+								yamlpipeline.RepositoryTypeAzureReposGit,
+								yamlpipeline.RepositoryTypeGitHub,
+							}, false),
+						},
+						"repo_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"service_connection_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+							Default:  "",
+						},
+					},
+				},
+			},
+			"yaml_path": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"configuration": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"revision": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourcePipelineCreate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	projectID := d.Get("project_id").(string)
+
+	parameters, err := expandPipeline(d)
+	if err != nil {
+		return err
+	}
+
+	pipeline, err := clients.YamlPipelineClient.CreatePipeline(clients.ctx, yamlpipeline.CreatePipelineArgs{
+		InputParameters: parameters,
+		Project:         &projectID,
+	})
+	if err != nil {
+		return fmt.Errorf("Error creating pipeline %s in project %s. Error: %v", d.Get("name"), projectID, err)
+	}
+
+	d.SetId(strconv.Itoa(*pipeline.Id))
+	return resourcePipelineRead(d, m)
+}
+
+func resourcePipelineRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	projectID, pipelineID, err := parsePipelineIdentifiers(d)
+	if err != nil {
+		return err
+	}
+
+	pipeline, err := clients.YamlPipelineClient.GetPipeline(clients.ctx, yamlpipeline.GetPipelineArgs{
+		Project:    &projectID,
+		PipelineId: &pipelineID,
+	})
+	if err != nil {
+		if azdoerror.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error looking up pipeline with ID %d in project %s. Error: %v", pipelineID, projectID, err)
+	}
+
+	flattenPipeline(d, pipeline)
+	return nil
+}
+
+// The pipelines API cannot change pipelines, so pipelines are updated through the build definition that backs
+// them. Only the attributes the pipeline shares with its definition are changed.
+func resourcePipelineUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	projectID, pipelineID, err := parsePipelineIdentifiers(d)
+	if err != nil {
+		return err
+	}
+
+	definition, err := clients.BuildClient.GetDefinition(clients.ctx, build.GetDefinitionArgs{
+		Project:      &projectID,
+		DefinitionId: &pipelineID,
+	})
+	if err != nil {
+		return fmt.Errorf("Error looking up the definition of pipeline with ID %d in project %s. Error: %v", pipelineID, projectID, err)
+	}
+
+	definition.Name = converter.String(d.Get("name").(string))
+	definition.Path = converter.String(d.Get("folder").(string))
+	setYamlFilePath(definition, d.Get("yaml_path").(string))
+
+	_, err = clients.BuildClient.UpdateDefinition(clients.ctx, build.UpdateDefinitionArgs{
+		Definition:   definition,
+		Project:      &projectID,
+		DefinitionId: &pipelineID,
+	})
+	if err != nil {
+		return fmt.Errorf("Error updating pipeline with ID %d in project %s. Error: %v", pipelineID, projectID, err)
+	}
+
+	return resourcePipelineRead(d, m)
+}
+
+// Pipelines are deleted along with the build definition that backs them
+func resourcePipelineDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	projectID, pipelineID, err := parsePipelineIdentifiers(d)
+	if err != nil {
+		return err
+	}
+
+	err = clients.BuildClient.DeleteDefinition(clients.ctx, build.DeleteDefinitionArgs{
+		Project:      &projectID,
+		DefinitionId: &pipelineID,
+	})
+	if err != nil && !azdoerror.IsNotFound(err) {
+		return fmt.Errorf("Error deleting pipeline with ID %d in project %s. Error: %v", pipelineID, projectID, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// Imports a pipeline given an ID of the form <projectID>/<pipelineID>
+func resourcePipelineImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%s), expected projectid/pipelineid", d.Id())
+	}
+
+	if _, err := strconv.Atoi(parts[1]); err != nil {
+		return nil, fmt.Errorf("Pipeline ID (%s) is not a valid integer", parts[1])
+	}
+
+	d.Set("project_id", parts[0])
+	d.SetId(parts[1])
+	return []*schema.ResourceData{d}, nil
+}
+
+func parsePipelineIdentifiers(d *schema.ResourceData) (string, int, error) {
+	projectID := d.Get("project_id").(string)
+	pipelineID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return "", 0, fmt.Errorf("Error parsing the pipeline ID %s: %v", d.Id(), err)
+	}
+	return projectID, pipelineID, nil
+}
+
+// The process of a definition is untyped in the API model. Definitions read from the service hold their process
+// as a plain map, which also carries the type of the process, so the YAML file is updated in place.
+func setYamlFilePath(definition *build.BuildDefinition, yamlFilePath string) {
+	if processMap, ok := definition.Process.(map[string]interface{}); ok {
+		processMap["yamlFilename"] = yamlFilePath
+		return
+	}
+	definition.Process = &build.YamlProcess{YamlFilename: &yamlFilePath}
+}
+
+// Convert internal Terraform data structure to an AzDO data structure. Repositories in Azure DevOps are
+// referenced by their ID, repositories on GitHub by their full name and the service connection used to access them.
+func expandPipeline(d *schema.ResourceData) (*yamlpipeline.CreatePipelineParameters, error) {
+	repository := d.Get("repository").([]interface{})[0].(map[string]interface{})
+	repoType := repository["repo_type"].(string)
+	repoID := repository["repo_id"].(string)
+	serviceConnectionID := repository["service_connection_id"].(string)
+
+	configurationRepository := &yamlpipeline.Repository{Type: &repoType}
+	if repoType == yamlpipeline.RepositoryTypeGitHub {
+		if serviceConnectionID == "" {
+			return nil, fmt.Errorf("service_connection_id must be set for repositories of type %s", repoType)
+		}
+		configurationRepository.FullName = &repoID
+		configurationRepository.Connection = &yamlpipeline.ServiceConnection{Id: &serviceConnectionID}
+	} else {
+		configurationRepository.Id = &repoID
+	}
+
+	return &yamlpipeline.CreatePipelineParameters{
+		Name:   converter.String(d.Get("name").(string)),
+		Folder: converter.String(d.Get("folder").(string)),
+		Configuration: &yamlpipeline.Configuration{
+			Type:       converter.String(yamlpipeline.ConfigurationTypeYaml),
+			Path:       converter.String(d.Get("yaml_path").(string)),
+			Repository: configurationRepository,
+		},
+	}, nil
+}
+
+// Convert AzDO data structure to internal Terraform data structure
+func flattenPipeline(d *schema.ResourceData, pipeline *yamlpipeline.Pipeline) {
+	d.SetId(strconv.Itoa(*pipeline.Id))
+	d.Set("name", converter.ToString(pipeline.Name, ""))
+	d.Set("folder", converter.ToString(pipeline.Folder, pipelineRootFolder))
+	d.Set("revision", converter.ToInt(pipeline.Revision, 0))
+
+	configuration := pipeline.Configuration
+	if configuration == nil {
+		d.Set("configuration", "")
+		return
+	}
+	d.Set("configuration", converter.ToString(configuration.Type, ""))
+	d.Set("yaml_path", converter.ToString(configuration.Path, ""))
+
+	if configuration.Repository != nil && configuration.Repository.Type != nil {
+		repository := configuration.Repository
+		repoID := converter.ToString(repository.Id, "")
+		serviceConnectionID := ""
+		if *repository.Type == yamlpipeline.RepositoryTypeGitHub {
+			repoID = converter.ToString(repository.FullName, "")
+			if repository.Connection != nil {
+				serviceConnectionID = converter.ToString(repository.Connection.Id, "")
+			}
+		}
+		d.Set("repository", []interface{}{map[string]interface{}{
+			"repo_type":             *repository.Type,
+			"repo_id":               repoID,
+			"service_connection_id": serviceConnectionID,
+		}})
+	}
+}
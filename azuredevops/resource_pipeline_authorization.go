@@ -0,0 +1,155 @@
+package azuredevops
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/build"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+)
+
+// The types of resources that can be authorized for use by pipelines
+const (
+	pipelineResourceTypeEndpoint      = "endpoint"
+	pipelineResourceTypeVariableGroup = "variablegroup"
+	pipelineResourceTypeQueue         = "queue"
+)
+
+func resourcePipelineAuthorization() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePipelineAuthorizationCreate,
+		Read:   resourcePipelineAuthorizationRead,
+		Delete: resourcePipelineAuthorizationDelete,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"resource_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"resource_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					pipelineResourceTypeEndpoint,
+					pipelineResourceTypeVariableGroup,
+					pipelineResourceTypeQueue,
+				}, false),
+			},
+			"definition_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+		},
+	}
+}
+
+func resourcePipelineAuthorizationCreate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	if err := authorizePipelineResource(clients, d, true); err != nil {
+		return fmt.Errorf("Error authorizing %s %s. Error: %v", d.Get("resource_type").(string), d.Get("resource_id").(string), err)
+	}
+
+	d.SetId(pipelineAuthorizationID(d))
+	return resourcePipelineAuthorizationRead(d, m)
+}
+
+// The authorization is removed from the state once the resource is no longer authorized
+func resourcePipelineAuthorizationRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	projectID := d.Get("project_id").(string)
+	resourceID := d.Get("resource_id").(string)
+	resourceType := d.Get("resource_type").(string)
+
+	var references *[]build.DefinitionResourceReference
+	var err error
+	if definitionID, ok := d.GetOk("definition_id"); ok {
+		references, err = clients.BuildClient.GetDefinitionResources(clients.ctx, build.GetDefinitionResourcesArgs{
+			Project:      &projectID,
+			DefinitionId: converter.Int(definitionID.(int)),
+		})
+	} else {
+		references, err = clients.BuildClient.GetProjectResources(clients.ctx, build.GetProjectResourcesArgs{
+			Project: &projectID,
+			Type:    &resourceType,
+			Id:      &resourceID,
+		})
+	}
+	if err != nil {
+		return fmt.Errorf("Error looking up the authorization of %s %s. Error: %v", resourceType, resourceID, err)
+	}
+
+	if !isPipelineResourceAuthorized(references, resourceType, resourceID) {
+		d.SetId("")
+	}
+	return nil
+}
+
+func resourcePipelineAuthorizationDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	if err := authorizePipelineResource(clients, d, false); err != nil {
+		return fmt.Errorf("Error removing the authorization of %s %s. Error: %v", d.Get("resource_type").(string), d.Get("resource_id").(string), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// Grants or revokes the authorization of the resource, either for a single pipeline or for all
+// pipelines of the project
+func authorizePipelineResource(clients *aggregatedClient, d *schema.ResourceData, authorized bool) error {
+	projectID := d.Get("project_id").(string)
+	references := []build.DefinitionResourceReference{{
+		Id:         converter.String(d.Get("resource_id").(string)),
+		Type:       converter.String(d.Get("resource_type").(string)),
+		Authorized: converter.Bool(authorized),
+	}}
+
+	var err error
+	if definitionID, ok := d.GetOk("definition_id"); ok {
+		_, err = clients.BuildClient.AuthorizeDefinitionResources(clients.ctx, build.AuthorizeDefinitionResourcesArgs{
+			Resources:    &references,
+			Project:      &projectID,
+			DefinitionId: converter.Int(definitionID.(int)),
+		})
+	} else {
+		_, err = clients.BuildClient.AuthorizeProjectResources(clients.ctx, build.AuthorizeProjectResourcesArgs{
+			Resources: &references,
+			Project:   &projectID,
+		})
+	}
+	return err
+}
+
+func isPipelineResourceAuthorized(references *[]build.DefinitionResourceReference, resourceType string, resourceID string) bool {
+	if references == nil {
+		return false
+	}
+	for _, reference := range *references {
+		if strings.EqualFold(converter.ToString(reference.Type, ""), resourceType) &&
+			strings.EqualFold(converter.ToString(reference.Id, ""), resourceID) {
+			return converter.ToBool(reference.Authorized, false)
+		}
+	}
+	return false
+}
+
+func pipelineAuthorizationID(d *schema.ResourceData) string {
+	id := fmt.Sprintf("%s/%s/%s", d.Get("project_id").(string), d.Get("resource_type").(string), d.Get("resource_id").(string))
+	if definitionID, ok := d.GetOk("definition_id"); ok {
+		id += "/" + strconv.Itoa(definitionID.(int))
+	}
+	return id
+}
@@ -0,0 +1,208 @@
+package azuredevops
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/build"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var testPipelineAuthorizationProjectID = "project-id"
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that a resource without a definition is authorized for all pipelines of the project
+func TestAzureDevOpsPipelineAuthorization_Create_AuthorizesAllPipelines(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	buildClient := azdosdkmocks.NewMockBuildClient(ctrl)
+	clients := &aggregatedClient{BuildClient: buildClient, ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, resourcePipelineAuthorization().Schema, map[string]interface{}{
+		"project_id":    testPipelineAuthorizationProjectID,
+		"resource_id":   "endpoint-id",
+		"resource_type": pipelineResourceTypeEndpoint,
+	})
+
+	references := []build.DefinitionResourceReference{{
+		Id:         converter.String("endpoint-id"),
+		Type:       converter.String(pipelineResourceTypeEndpoint),
+		Authorized: converter.Bool(true),
+	}}
+	buildClient.
+		EXPECT().
+		AuthorizeProjectResources(clients.ctx, build.AuthorizeProjectResourcesArgs{
+			Resources: &references,
+			Project:   &testPipelineAuthorizationProjectID,
+		}).
+		Return(&references, nil).
+		Times(1)
+	buildClient.
+		EXPECT().
+		GetProjectResources(clients.ctx, build.GetProjectResourcesArgs{
+			Project: &testPipelineAuthorizationProjectID,
+			Type:    converter.String(pipelineResourceTypeEndpoint),
+			Id:      converter.String("endpoint-id"),
+		}).
+		Return(&references, nil).
+		Times(1)
+
+	err := resourcePipelineAuthorizationCreate(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "project-id/endpoint/endpoint-id", resourceData.Id())
+}
+
+// verifies that a resource with a definition is only authorized for that pipeline
+func TestAzureDevOpsPipelineAuthorization_Create_AuthorizesSinglePipeline(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	buildClient := azdosdkmocks.NewMockBuildClient(ctrl)
+	clients := &aggregatedClient{BuildClient: buildClient, ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, resourcePipelineAuthorization().Schema, map[string]interface{}{
+		"project_id":    testPipelineAuthorizationProjectID,
+		"resource_id":   "7",
+		"resource_type": pipelineResourceTypeVariableGroup,
+		"definition_id": 42,
+	})
+
+	buildClient.
+		EXPECT().
+		AuthorizeDefinitionResources(clients.ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, args build.AuthorizeDefinitionResourcesArgs) (*[]build.DefinitionResourceReference, error) {
+			require.Equal(t, 42, *args.DefinitionId)
+			require.True(t, *(*args.Resources)[0].Authorized)
+			return args.Resources, nil
+		}).
+		Times(1)
+	buildClient.
+		EXPECT().
+		GetDefinitionResources(clients.ctx, build.GetDefinitionResourcesArgs{
+			Project:      &testPipelineAuthorizationProjectID,
+			DefinitionId: converter.Int(42),
+		}).
+		Return(&[]build.DefinitionResourceReference{{
+			Id:         converter.String("7"),
+			Type:       converter.String(pipelineResourceTypeVariableGroup),
+			Authorized: converter.Bool(true),
+		}}, nil).
+		Times(1)
+
+	err := resourcePipelineAuthorizationCreate(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "project-id/variablegroup/7/42", resourceData.Id())
+}
+
+// verifies that a resource which is no longer authorized is removed from the state
+func TestAzureDevOpsPipelineAuthorization_Read_ClearsIDIfNotAuthorized(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	buildClient := azdosdkmocks.NewMockBuildClient(ctrl)
+	clients := &aggregatedClient{BuildClient: buildClient, ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, resourcePipelineAuthorization().Schema, map[string]interface{}{
+		"project_id":    testPipelineAuthorizationProjectID,
+		"resource_id":   "1",
+		"resource_type": pipelineResourceTypeQueue,
+	})
+	resourceData.SetId("project-id/queue/1")
+
+	buildClient.
+		EXPECT().
+		GetProjectResources(clients.ctx, gomock.Any()).
+		Return(&[]build.DefinitionResourceReference{{
+			Id:         converter.String("1"),
+			Type:       converter.String(pipelineResourceTypeQueue),
+			Authorized: converter.Bool(false),
+		}}, nil).
+		Times(1)
+
+	err := resourcePipelineAuthorizationRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "", resourceData.Id())
+}
+
+// verifies that the authorization is revoked on delete, and that errors are not swallowed
+func TestAzureDevOpsPipelineAuthorization_Delete_RevokesAuthorization(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	buildClient := azdosdkmocks.NewMockBuildClient(ctrl)
+	clients := &aggregatedClient{BuildClient: buildClient, ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, resourcePipelineAuthorization().Schema, map[string]interface{}{
+		"project_id":    testPipelineAuthorizationProjectID,
+		"resource_id":   "endpoint-id",
+		"resource_type": pipelineResourceTypeEndpoint,
+	})
+	resourceData.SetId("project-id/endpoint/endpoint-id")
+
+	buildClient.
+		EXPECT().
+		AuthorizeProjectResources(clients.ctx, build.AuthorizeProjectResourcesArgs{
+			Resources: &[]build.DefinitionResourceReference{{
+				Id:         converter.String("endpoint-id"),
+				Type:       converter.String(pipelineResourceTypeEndpoint),
+				Authorized: converter.Bool(false),
+			}},
+			Project: &testPipelineAuthorizationProjectID,
+		}).
+		Return(nil, errors.New("AuthorizeProjectResources() Failed")).
+		Times(1)
+
+	err := resourcePipelineAuthorizationDelete(resourceData, clients)
+	require.Contains(t, err.Error(), "AuthorizeProjectResources() Failed")
+}
+
+/**
+ * Begin acceptance tests
+ */
+
+// Verifies that a service endpoint can be authorized for a pipeline
+func TestAccAzureDevOpsPipelineAuthorization_Endpoint(t *testing.T) {
+	projectName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	buildDefinitionName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	tfNode := "azuredevops_pipeline_authorization.authorization"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPipelineAuthorizationResource(projectName, buildDefinitionName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(tfNode, "resource_id", "azuredevops_serviceendpoint_github.serviceendpoint", "id"),
+					resource.TestCheckResourceAttrPair(tfNode, "definition_id", "azuredevops_build_definition.build", "id"),
+				),
+			},
+		},
+	})
+}
+
+// HCL describing the authorization of the GitHub service endpoint for a build definition
+func testAccPipelineAuthorizationResource(projectName string, buildDefinitionName string) string {
+	authorizationResource := `
+resource "azuredevops_pipeline_authorization" "authorization" {
+	project_id    = azuredevops_project.project.id
+	resource_id   = azuredevops_serviceendpoint_github.serviceendpoint.id
+	resource_type = "endpoint"
+	definition_id = azuredevops_build_definition.build.id
+}`
+
+	buildDefinitionResource := testAccBuildDefinitionResource(projectName, buildDefinitionName)
+	return fmt.Sprintf("%s\n%s", buildDefinitionResource, authorizationResource)
+}
@@ -0,0 +1,372 @@
+package azuredevops
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/microsoft/azure-devops-go-api/azuredevops"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/build"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/yamlpipeline"
+	"github.com/stretchr/testify/require"
+)
+
+var testPipelineProjectID = uuid.New().String()
+var testPipelineRepositoryID = uuid.New().String()
+var testPipelineID = 42
+
+var testPipeline = yamlpipeline.Pipeline{
+	Id:       &testPipelineID,
+	Name:     converter.String("pipeline"),
+	Folder:   converter.String(`\ci`),
+	Revision: converter.Int(1),
+	Configuration: &yamlpipeline.Configuration{
+		Type: converter.String(yamlpipeline.ConfigurationTypeYaml),
+		Path: converter.String("azure-pipelines.yml"),
+		Repository: &yamlpipeline.Repository{
+			Id:   &testPipelineRepositoryID,
+			Type: converter.String(yamlpipeline.RepositoryTypeAzureReposGit),
+		},
+	},
+}
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that a pipeline is created from the YAML file in its repository
+func TestAzureDevOpsPipeline_Create_SendsYamlConfiguration(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	pipelineClient := azdosdkmocks.NewMockYamlPipelineClient(ctrl)
+	clients := &aggregatedClient{YamlPipelineClient: pipelineClient, ctx: context.Background()}
+
+	resourceData := createPipelineResourceData(t, yamlpipeline.RepositoryTypeAzureReposGit, testPipelineRepositoryID, "")
+
+	pipelineClient.
+		EXPECT().
+		CreatePipeline(clients.ctx, yamlpipeline.CreatePipelineArgs{
+			InputParameters: &yamlpipeline.CreatePipelineParameters{
+				Name:          testPipeline.Name,
+				Folder:        testPipeline.Folder,
+				Configuration: testPipeline.Configuration,
+			},
+			Project: &testPipelineProjectID,
+		}).
+		Return(&testPipeline, nil).
+		Times(1)
+	pipelineClient.
+		EXPECT().
+		GetPipeline(clients.ctx, yamlpipeline.GetPipelineArgs{Project: &testPipelineProjectID, PipelineId: &testPipelineID}).
+		Return(&testPipeline, nil).
+		Times(1)
+
+	err := resourcePipelineCreate(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, strconv.Itoa(testPipelineID), resourceData.Id())
+	require.Equal(t, "yaml", resourceData.Get("configuration"))
+	require.Equal(t, 1, resourceData.Get("revision"))
+}
+
+// verifies that repositories on GitHub are referenced by their full name and their service connection
+func TestAzureDevOpsPipeline_Expand_GitHubRepository(t *testing.T) {
+	resourceData := createPipelineResourceData(t, yamlpipeline.RepositoryTypeGitHub, "owner/repo", "connection")
+
+	parameters, err := expandPipeline(resourceData)
+	require.Nil(t, err)
+	require.Nil(t, parameters.Configuration.Repository.Id)
+	require.Equal(t, "owner/repo", *parameters.Configuration.Repository.FullName)
+	require.Equal(t, "connection", *parameters.Configuration.Repository.Connection.Id)
+
+	resourceData = createPipelineResourceData(t, yamlpipeline.RepositoryTypeGitHub, "owner/repo", "")
+	_, err = expandPipeline(resourceData)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "service_connection_id must be set")
+}
+
+// verifies that the flatten/expand round trip yields the same pipeline
+func TestAzureDevOpsPipeline_ExpandFlatten_Roundtrip(t *testing.T) {
+	for _, repository := range []*yamlpipeline.Repository{
+		testPipeline.Configuration.Repository,
+		{
+			FullName:   converter.String("owner/repo"),
+			Type:       converter.String(yamlpipeline.RepositoryTypeGitHub),
+			Connection: &yamlpipeline.ServiceConnection{Id: converter.String("connection")},
+		},
+	} {
+		pipeline := testPipeline
+		configuration := *testPipeline.Configuration
+		configuration.Repository = repository
+		pipeline.Configuration = &configuration
+
+		resourceData := schema.TestResourceDataRaw(t, resourcePipeline().Schema, nil)
+		flattenPipeline(resourceData, &pipeline)
+
+		parameters, err := expandPipeline(resourceData)
+		require.Nil(t, err)
+		require.Equal(t, pipeline.Name, parameters.Name)
+		require.Equal(t, pipeline.Folder, parameters.Folder)
+		require.Equal(t, pipeline.Configuration, parameters.Configuration)
+	}
+}
+
+// verifies that a pipeline is renamed and moved through its build definition, keeping the other settings
+func TestAzureDevOpsPipeline_Update_UpdatesBuildDefinition(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	pipelineClient := azdosdkmocks.NewMockYamlPipelineClient(ctrl)
+	buildClient := azdosdkmocks.NewMockBuildClient(ctrl)
+	clients := &aggregatedClient{YamlPipelineClient: pipelineClient, BuildClient: buildClient, ctx: context.Background()}
+
+	resourceData := createPipelineResourceData(t, yamlpipeline.RepositoryTypeAzureReposGit, testPipelineRepositoryID, "")
+	resourceData.SetId(strconv.Itoa(testPipelineID))
+	resourceData.Set("name", "renamed")
+	resourceData.Set("yaml_path", "ci/build.yml")
+
+	buildClient.
+		EXPECT().
+		GetDefinition(clients.ctx, build.GetDefinitionArgs{Project: &testPipelineProjectID, DefinitionId: &testPipelineID}).
+		Return(&build.BuildDefinition{
+			Id:      &testPipelineID,
+			Name:    converter.String("pipeline"),
+			Path:    converter.String(`\`),
+			Process: map[string]interface{}{"type": 2, "yamlFilename": "azure-pipelines.yml"},
+		}, nil).
+		Times(1)
+	buildClient.
+		EXPECT().
+		UpdateDefinition(clients.ctx, build.UpdateDefinitionArgs{
+			Definition: &build.BuildDefinition{
+				Id:      &testPipelineID,
+				Name:    converter.String("renamed"),
+				Path:    converter.String(`\ci`),
+				Process: map[string]interface{}{"type": 2, "yamlFilename": "ci/build.yml"},
+			},
+			Project:      &testPipelineProjectID,
+			DefinitionId: &testPipelineID,
+		}).
+		Return(&build.BuildDefinition{}, nil).
+		Times(1)
+	pipelineClient.
+		EXPECT().
+		GetPipeline(clients.ctx, gomock.Any()).
+		Return(&testPipeline, nil).
+		Times(1)
+
+	err := resourcePipelineUpdate(resourceData, clients)
+	require.Nil(t, err)
+}
+
+// verifies that a pipeline that no longer exists is removed from the state
+func TestAzureDevOpsPipeline_Read_ClearsIDOfMissingPipeline(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	pipelineClient := azdosdkmocks.NewMockYamlPipelineClient(ctrl)
+	clients := &aggregatedClient{YamlPipelineClient: pipelineClient, ctx: context.Background()}
+
+	resourceData := createPipelineResourceData(t, yamlpipeline.RepositoryTypeAzureReposGit, testPipelineRepositoryID, "")
+	resourceData.SetId(strconv.Itoa(testPipelineID))
+
+	statusCode := http.StatusNotFound
+	pipelineClient.
+		EXPECT().
+		GetPipeline(clients.ctx, gomock.Any()).
+		Return(nil, azuredevops.WrappedError{StatusCode: &statusCode}).
+		Times(1)
+
+	err := resourcePipelineRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "", resourceData.Id())
+}
+
+// verifies that a pipeline is deleted along with its build definition
+func TestAzureDevOpsPipeline_Delete_DeletesBuildDefinition(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	buildClient := azdosdkmocks.NewMockBuildClient(ctrl)
+	clients := &aggregatedClient{BuildClient: buildClient, ctx: context.Background()}
+
+	resourceData := createPipelineResourceData(t, yamlpipeline.RepositoryTypeAzureReposGit, testPipelineRepositoryID, "")
+	resourceData.SetId(strconv.Itoa(testPipelineID))
+
+	buildClient.
+		EXPECT().
+		DeleteDefinition(clients.ctx, build.DeleteDefinitionArgs{Project: &testPipelineProjectID, DefinitionId: &testPipelineID}).
+		Return(nil).
+		Times(1)
+
+	err := resourcePipelineDelete(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "", resourceData.Id())
+}
+
+// verifies that if an error is produced on create, the error is not swallowed
+func TestAzureDevOpsPipeline_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	pipelineClient := azdosdkmocks.NewMockYamlPipelineClient(ctrl)
+	clients := &aggregatedClient{YamlPipelineClient: pipelineClient, ctx: context.Background()}
+
+	resourceData := createPipelineResourceData(t, yamlpipeline.RepositoryTypeAzureReposGit, testPipelineRepositoryID, "")
+
+	pipelineClient.
+		EXPECT().
+		CreatePipeline(clients.ctx, gomock.Any()).
+		Return(nil, errors.New("CreatePipeline() Failed")).
+		Times(1)
+
+	err := resourcePipelineCreate(resourceData, clients)
+	require.Contains(t, err.Error(), "CreatePipeline() Failed")
+}
+
+// verifies that pipelines are imported given their project and their ID
+func TestAzureDevOpsPipeline_Import_ParsesID(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, resourcePipeline().Schema, nil)
+	resourceData.SetId(testPipelineProjectID + "/42")
+
+	imported, err := resourcePipelineImport(resourceData, nil)
+	require.Nil(t, err)
+	require.Equal(t, "42", imported[0].Id())
+	require.Equal(t, testPipelineProjectID, imported[0].Get("project_id"))
+
+	for _, id := range []string{"42", testPipelineProjectID + "/pipeline"} {
+		resourceData.SetId(id)
+		_, err := resourcePipelineImport(resourceData, nil)
+		require.NotNil(t, err)
+	}
+}
+
+func createPipelineResourceData(t *testing.T, repoType string, repoID string, serviceConnectionID string) *schema.ResourceData {
+	return schema.TestResourceDataRaw(t, resourcePipeline().Schema, map[string]interface{}{
+		"project_id": testPipelineProjectID,
+		"name":       *testPipeline.Name,
+		"folder":     *testPipeline.Folder,
+		"repository": []interface{}{map[string]interface{}{
+			"repo_type":             repoType,
+			"repo_id":               repoID,
+			"service_connection_id": serviceConnectionID,
+		}},
+		"yaml_path": *testPipeline.Configuration.Path,
+	})
+}
+
+/**
+ * Begin acceptance tests
+ */
+
+// validates that a YAML pipeline can be created from a file in a repository, and that it can be renamed
+func TestAccAzureDevOpsPipeline_CreateAndUpdate(t *testing.T) {
+	projectName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	pipelineName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	pipelineNameUpdated := pipelineName + "-updated"
+	tfNode := "azuredevops_pipeline.pipeline"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccPipelineCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPipelineResource(projectName, pipelineName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "name", pipelineName),
+					resource.TestCheckResourceAttr(tfNode, "folder", `\`),
+					resource.TestCheckResourceAttr(tfNode, "configuration", "yaml"),
+					resource.TestCheckResourceAttrSet(tfNode, "revision"),
+				),
+			}, {
+				Config: testAccPipelineResource(projectName, pipelineNameUpdated),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "name", pipelineNameUpdated),
+				),
+			}, {
+				ResourceName:      tfNode,
+				ImportStateIdFunc: testAccPipelineImportStateID(tfNode),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// HCL describing a YAML pipeline that is defined in a repository of the project
+func testAccPipelineResource(projectName string, pipelineName string) string {
+	pipelineResource := fmt.Sprintf(`
+resource "azuredevops_azure_git_repository" "gitrepo" {
+	project_id = azuredevops_project.project.id
+	name       = "%s-repo"
+	initialization {
+		init_type = "Clean"
+	}
+}
+
+resource "azuredevops_git_repository_file" "pipeline" {
+	repository_id       = azuredevops_azure_git_repository.gitrepo.id
+	file                = "/azure-pipelines.yml"
+	content             = "steps:\n- script: echo hello"
+	branch              = "master"
+	overwrite_on_create = true
+}
+
+resource "azuredevops_pipeline" "pipeline" {
+	project_id = azuredevops_project.project.id
+	name       = "%s"
+	yaml_path  = azuredevops_git_repository_file.pipeline.file
+
+	repository {
+		repo_id = azuredevops_azure_git_repository.gitrepo.id
+	}
+}`, projectName, pipelineName)
+
+	projectResource := testAccProjectResource(projectName)
+	return fmt.Sprintf("%s\n%s", projectResource, pipelineResource)
+}
+
+// Builds the <projectID>/<pipelineID> identifier needed to import a pipeline
+func testAccPipelineImportStateID(tfNode string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		res := s.RootModule().Resources[tfNode]
+		return fmt.Sprintf("%s/%s", res.Primary.Attributes["project_id"], res.Primary.ID), nil
+	}
+}
+
+// verifies that all pipelines referenced in the state are destroyed
+func testAccPipelineCheckDestroy(s *terraform.State) error {
+	clients := testAccProvider.Meta().(*aggregatedClient)
+
+	for _, res := range s.RootModule().Resources {
+		if res.Type != "azuredevops_pipeline" {
+			continue
+		}
+
+		projectID := res.Primary.Attributes["project_id"]
+		pipelineID, err := strconv.Atoi(res.Primary.ID)
+		if err != nil {
+			return err
+		}
+		pipeline, err := clients.YamlPipelineClient.GetPipeline(clients.ctx, yamlpipeline.GetPipelineArgs{
+			Project:    &projectID,
+			PipelineId: &pipelineID,
+		})
+		if err == nil && pipeline != nil {
+			return fmt.Errorf("Pipeline with ID %d should not exist", pipelineID)
+		}
+	}
+	return nil
+}
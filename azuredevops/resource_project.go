@@ -7,7 +7,9 @@ import (
 	"strings"
 	"time"
 
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/azdoerror"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	azdooperations "github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/operations"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/tfhelper"
 
 	"github.com/google/uuid"
@@ -18,7 +20,7 @@ import (
 )
 
 var projectCreateTimeoutSeconds int = 30
-var projectDeleteTimeoutSeconds int = 30
+var projectDeleteTimeoutSeconds int = 600
 
 func resourceProject() *schema.Resource {
 	return &schema.Resource{
@@ -27,6 +29,12 @@ func resourceProject() *schema.Resource {
 		Update: resourceProjectUpdate,
 		Delete: resourceProjectDelete,
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(time.Duration(projectCreateTimeoutSeconds) * time.Second),
+			Update: schema.DefaultTimeout(time.Duration(projectCreateTimeoutSeconds) * time.Second),
+			Delete: schema.DefaultTimeout(time.Duration(projectDeleteTimeoutSeconds) * time.Second),
+		},
+
 		//https://godoc.org/github.com/hashicorp/terraform/helper/schema#Schema
 		Schema: map[string]*schema.Schema{
 			"project_name": {
@@ -46,11 +54,12 @@ func resourceProject() *schema.Resource {
 				ValidateFunc: validation.StringInSlice([]string{"private", "public"}, false),
 			},
 			"version_control": {
-				Type:         schema.TypeString,
-				ForceNew:     true,
-				Optional:     true,
-				Default:      "Git",
-				ValidateFunc: validation.StringInSlice([]string{"Git", "Tfvc"}, true),
+				Type:             schema.TypeString,
+				ForceNew:         true,
+				Optional:         true,
+				Default:          "Git",
+				ValidateFunc:     validation.StringInSlice([]string{"Git", "Tfvc"}, true),
+				DiffSuppressFunc: tfhelper.DiffFuncSupressCaseSensitivity,
 			},
 			"work_item_template": {
 				Type:     schema.TypeString,
@@ -68,13 +77,16 @@ func resourceProject() *schema.Resource {
 }
 
 func resourceProjectCreate(d *schema.ResourceData, m interface{}) error {
-	clients := m.(*aggregatedClient)
-	project, err := expandProject(clients, d, true)
+	timeout := d.Timeout(schema.TimeoutCreate)
+	clients, cancel := m.(*aggregatedClient).withTimeout(timeout)
+	defer cancel()
+
+	project, err := expandProject(clients, d)
 	if err != nil {
 		return fmt.Errorf("Error converting terraform data model to AzDO project reference: %+v", err)
 	}
 
-	err = createProject(clients, project, projectCreateTimeoutSeconds)
+	err = createProject(clients, project, int(timeout.Seconds()))
 	if err != nil {
 		return fmt.Errorf("Error creating project in Azure DevOps: %+v", err)
 	}
@@ -90,42 +102,27 @@ func createProject(clients *aggregatedClient, project *core.TeamProject, timeout
 		return err
 	}
 
-	return waitForAsyncOperationSuccess(clients, operationRef, timeoutSeconds)
-}
-
-func waitForAsyncOperationSuccess(clients *aggregatedClient, operationRef *operations.OperationReference, timeoutSeconds int) error {
-	timeout := time.After(time.Duration(timeoutSeconds) * time.Second)
-	ticker := time.NewTicker(1 * time.Second)
-	defer ticker.Stop()
+	err = waitForProjectOperation(clients, operationRef, timeoutSeconds)
+	if err != nil {
+		return err
+	}
 
-	for {
-		select {
-		case <-ticker.C:
-			result, err := clients.OperationsClient.GetOperation(clients.ctx, operations.GetOperationArgs{
-				OperationId: operationRef.Id,
-				PluginId:    operationRef.PluginId,
-			})
-
-			if err != nil {
-				return err
-			}
-
-			if *result.Status == operations.OperationStatusValues.Succeeded {
-				// Sometimes without the sleep, the subsequent operations won't find the project...
-				delay := os.Getenv("AZDO_PRJ_CREATE_DELAY")
-				settleDelay := time.Duration(0)
-				i, err := strconv.ParseInt(delay, 10, 64)
-				if err == nil {
-					settleDelay = time.Duration(i) * time.Second
-				}
-				fmt.Printf("Inserting artificial delay after project creation: %s\n", settleDelay.String())
-				time.Sleep(settleDelay)
-				return nil
-			}
-		case <-timeout:
-			return fmt.Errorf("Operation was not successful after %d seconds", timeoutSeconds)
-		}
+	// Sometimes without the sleep, the subsequent operations won't find the project...
+	delay := os.Getenv("AZDO_PRJ_CREATE_DELAY")
+	settleDelay := time.Duration(0)
+	i, err := strconv.ParseInt(delay, 10, 64)
+	if err == nil {
+		settleDelay = time.Duration(i) * time.Second
 	}
+	fmt.Printf("Inserting artificial delay after project creation: %s\n", settleDelay.String())
+	time.Sleep(settleDelay)
+	return nil
+}
+
+// Waits for an asynchronous operation of the core service, like the creation of a project, to succeed
+func waitForProjectOperation(clients *aggregatedClient, operationRef *operations.OperationReference, timeoutSeconds int) error {
+	_, err := azdooperations.WaitForCompletion(clients.ctx, clients.OperationsClient, operationRef, time.Duration(timeoutSeconds)*time.Second, azdooperations.DefaultPollInterval)
+	return err
 }
 
 func resourceProjectRead(d *schema.ResourceData, m interface{}) error {
@@ -135,7 +132,10 @@ func resourceProjectRead(d *schema.ResourceData, m interface{}) error {
 	name := d.Get("project_name").(string)
 	project, err := projectRead(clients, id, name)
 	if err != nil {
-		return fmt.Errorf("Error looking up project with ID %s and Name %s", id, name)
+		if tfhelper.ClearIDIfNotFound(d, err) {
+			return nil
+		}
+		return fmt.Errorf("Error looking up project with ID %s and Name %s. Error: %v", id, name, err)
 	}
 
 	err = flattenProject(clients, d, project)
@@ -161,22 +161,53 @@ func projectRead(clients *aggregatedClient, projectID string, projectName string
 	})
 }
 
+// Changes the name, the description and the visibility of a project in place. The version control and the
+// process of a project cannot be changed after its creation, which is why changing them forces a new project.
 func resourceProjectUpdate(d *schema.ResourceData, m interface{}) error {
-	clients := m.(*aggregatedClient)
-	project, err := expandProject(clients, d, false)
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	clients, cancel := m.(*aggregatedClient).withTimeout(timeout)
+	defer cancel()
+
+	projectID, err := uuid.Parse(d.Id())
 	if err != nil {
-		return fmt.Errorf("Error converting terraform data model to AzDO project reference: %+v", err)
+		return fmt.Errorf("Invalid project UUID: %s", d.Id())
 	}
 
-	err = updateProject(clients, project, projectCreateTimeoutSeconds)
-	if err != nil {
-		return fmt.Errorf("Error updating project in Azure DevOps: %+v", err)
+	for _, project := range expandProjectUpdates(d, projectID) {
+		err = updateProject(clients, project, int(timeout.Seconds()))
+		if err != nil {
+			return fmt.Errorf("Error updating project in Azure DevOps: %+v", err)
+		}
 	}
 	return resourceProjectRead(d, m)
 }
 
-func updateProject(clients *aggregatedClient, project *core.TeamProject, timeoutSeconds int) error {
+// Returns the updates that apply the changed attributes to the project. Only changed attributes are sent, and a
+// rename is sent on its own because the service does not rename a project and change other attributes at once.
+func expandProjectUpdates(d *schema.ResourceData, projectID uuid.UUID) []*core.TeamProject {
+	var updates []*core.TeamProject
+	if d.HasChange("project_name") {
+		updates = append(updates, &core.TeamProject{
+			Id:   &projectID,
+			Name: converter.String(d.Get("project_name").(string)),
+		})
+	}
 
+	if d.HasChange("description") || d.HasChange("visibility") {
+		project := &core.TeamProject{Id: &projectID}
+		if d.HasChange("description") {
+			project.Description = converter.String(d.Get("description").(string))
+		}
+		if d.HasChange("visibility") {
+			project.Visibility = convertVisibilty(d.Get("visibility").(string))
+		}
+		updates = append(updates, project)
+	}
+	return updates
+}
+
+// Make API call to update the project and wait for an async success/fail response from the service
+func updateProject(clients *aggregatedClient, project *core.TeamProject, timeoutSeconds int) error {
 	operationRef, err := clients.CoreClient.UpdateProject(
 		clients.ctx,
 		core.UpdateProjectArgs{
@@ -188,17 +219,30 @@ func updateProject(clients *aggregatedClient, project *core.TeamProject, timeout
 		return err
 	}
 
-	return waitForAsyncOperationSuccess(clients, operationRef, timeoutSeconds)
+	return waitForProjectOperation(clients, operationRef, timeoutSeconds)
 }
 
 func resourceProjectDelete(d *schema.ResourceData, m interface{}) error {
-	clients := m.(*aggregatedClient)
+	timeout := d.Timeout(schema.TimeoutDelete)
+	clients, cancel := m.(*aggregatedClient).withTimeout(timeout)
+	defer cancel()
 	id := d.Id()
 
-	return deleteProject(clients, id, projectDeleteTimeoutSeconds)
+	err := deleteProject(clients, id, int(timeout.Seconds()))
+	if err != nil {
+		return fmt.Errorf("Error deleting project in Azure DevOps: %+v", err)
+	}
+
+	d.SetId("")
+	return nil
 }
 
+// Make API call to delete the project and wait until the service no longer knows the project. The delete
+// operation completes before the project is purged, and a project with the same name can only be created
+// once it is gone.
 func deleteProject(clients *aggregatedClient, id string, timeoutSeconds int) error {
+	deadline := time.Now().Add(time.Duration(timeoutSeconds) * time.Second)
+
 	uuid, err := uuid.Parse(id)
 	if err != nil {
 		return fmt.Errorf("Invalid project UUID: %s", id)
@@ -212,11 +256,48 @@ func deleteProject(clients *aggregatedClient, id string, timeoutSeconds int) err
 		return err
 	}
 
-	return waitForAsyncOperationSuccess(clients, operationRef, timeoutSeconds)
+	err = waitForProjectOperation(clients, operationRef, timeoutSeconds)
+	if err != nil {
+		return fmt.Errorf("Deletion of project %s did not complete: %v", id, err)
+	}
+
+	return waitForProjectRemoval(clients, id, time.Until(deadline))
+}
+
+// Polls the project until the service reports that it does not exist anymore, or that it is deleted
+func waitForProjectRemoval(clients *aggregatedClient, id string, timeout time.Duration) error {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	ticker := time.NewTicker(azdooperations.DefaultPollInterval)
+	defer ticker.Stop()
+
+	for {
+		project, err := clients.CoreClient.GetProject(clients.ctx, core.GetProjectArgs{
+			ProjectId:      &id,
+			IncludeHistory: converter.Bool(false),
+		})
+		if azdoerror.IsNotFound(err) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("Error looking up project %s after its deletion: %v", id, err)
+		}
+		if project.State != nil && *project.State == core.ProjectStateValues.Deleted {
+			return nil
+		}
+
+		select {
+		case <-ticker.C:
+		case <-timer.C:
+			return fmt.Errorf("Project %s was not removed after %d seconds", id, int(timeout.Seconds()))
+		case <-clients.ctx.Done():
+			return fmt.Errorf("Operation was cancelled before project %s was removed: %v", id, clients.ctx.Err())
+		}
+	}
 }
 
 // Convert internal Terraform data structure to an AzDO data structure
-func expandProject(clients *aggregatedClient, d *schema.ResourceData, forCreate bool) (*core.TeamProject, error) {
+func expandProject(clients *aggregatedClient, d *schema.ResourceData) (*core.TeamProject, error) {
 	workItemTemplate := d.Get("work_item_template").(string)
 	processTemplateID, err := lookupProcessTemplateID(clients, workItemTemplate)
 	if err != nil {
@@ -232,16 +313,13 @@ func expandProject(clients *aggregatedClient, d *schema.ResourceData, forCreate
 
 	visibility := d.Get("visibility").(string)
 
-	var capabilities *map[string]map[string]string
-	if forCreate {
-		capabilities = &map[string]map[string]string{
-			"versioncontrol": {
-				"sourceControlType": d.Get("version_control").(string),
-			},
-			"processTemplate": {
-				"templateTypeId": processTemplateID,
-			},
-		}
+	capabilities := &map[string]map[string]string{
+		"versioncontrol": {
+			"sourceControlType": d.Get("version_control").(string),
+		},
+		"processTemplate": {
+			"templateTypeId": processTemplateID,
+		},
 	}
 
 	project := &core.TeamProject{
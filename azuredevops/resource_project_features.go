@@ -0,0 +1,176 @@
+package azuredevops
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/featuremanagement"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+)
+
+// The contribution IDs of the features that can be toggled for a project
+var projectFeatureIDs = map[string]string{
+	"boards":       "ms.vss-work.agile",
+	"repositories": "ms.vss-code.version-control",
+	"pipelines":    "ms.vss-build.pipelines",
+	"testplans":    "ms.vss-test-web.test",
+	"artifacts":    "ms.feed.feed",
+}
+
+var projectFeatureStates = []string{
+	string(featuremanagement.ContributedFeatureEnabledValueValues.Enabled),
+	string(featuremanagement.ContributedFeatureEnabledValueValues.Disabled),
+}
+
+func resourceProjectFeatures() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceProjectFeaturesCreateOrUpdate,
+		Read:   resourceProjectFeaturesRead,
+		Update: resourceProjectFeaturesCreateOrUpdate,
+		Delete: resourceProjectFeaturesDelete,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"features": {
+				Type:         schema.TypeMap,
+				Required:     true,
+				ValidateFunc: validateProjectFeatures,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceProjectFeaturesCreateOrUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	projectID := d.Get("project_id").(string)
+
+	oldFeatures, newFeatures := d.GetChange("features")
+	for feature, state := range newFeatures.(map[string]interface{}) {
+		if err := setProjectFeatureState(clients, projectID, feature, state.(string)); err != nil {
+			return err
+		}
+	}
+
+	// features that are no longer managed are enabled again
+	for feature := range oldFeatures.(map[string]interface{}) {
+		if _, ok := newFeatures.(map[string]interface{})[feature]; ok {
+			continue
+		}
+		err := setProjectFeatureState(clients, projectID, feature, string(featuremanagement.ContributedFeatureEnabledValueValues.Enabled))
+		if err != nil {
+			return err
+		}
+	}
+
+	d.SetId(projectID)
+	return resourceProjectFeaturesRead(d, m)
+}
+
+func resourceProjectFeaturesRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	projectID := d.Get("project_id").(string)
+
+	// only the configured features are managed by this resource
+	features := map[string]interface{}{}
+	for feature := range d.Get("features").(map[string]interface{}) {
+		state, err := clients.FeatureManagementClient.GetFeatureStateForScope(clients.ctx, featuremanagement.GetFeatureStateForScopeArgs{
+			FeatureId:  converter.String(projectFeatureIDs[feature]),
+			UserScope:  converter.String("host"),
+			ScopeName:  converter.String("project"),
+			ScopeValue: &projectID,
+		})
+		if err != nil {
+			return fmt.Errorf("Error reading the state of feature %s of project %s. Error: %v", feature, projectID, err)
+		}
+		if state.State != nil {
+			features[feature] = string(*state.State)
+		}
+	}
+
+	d.Set("features", features)
+	return nil
+}
+
+// Features are enabled for new projects, so they are enabled again when they are no longer managed
+func resourceProjectFeaturesDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	projectID := d.Get("project_id").(string)
+
+	for feature := range d.Get("features").(map[string]interface{}) {
+		err := setProjectFeatureState(clients, projectID, feature, string(featuremanagement.ContributedFeatureEnabledValueValues.Enabled))
+		if err != nil {
+			return err
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func setProjectFeatureState(clients *aggregatedClient, projectID string, feature string, state string) error {
+	featureID := projectFeatureIDs[feature]
+	featureState := featuremanagement.ContributedFeatureEnabledValue(state)
+
+	_, err := clients.FeatureManagementClient.SetFeatureStateForScope(clients.ctx, featuremanagement.SetFeatureStateForScopeArgs{
+		Feature: &featuremanagement.ContributedFeatureState{
+			FeatureId: &featureID,
+			Scope: &featuremanagement.ContributedFeatureSettingScope{
+				SettingScope: converter.String("project"),
+				UserScoped:   converter.Bool(false),
+			},
+			State: &featureState,
+		},
+		FeatureId:  &featureID,
+		UserScope:  converter.String("host"),
+		ScopeName:  converter.String("project"),
+		ScopeValue: &projectID,
+	})
+	if err != nil {
+		return fmt.Errorf("Error setting the state of feature %s of project %s to %s. Error: %v", feature, projectID, state, err)
+	}
+	return nil
+}
+
+// Validates that only known features are toggled and that they are either enabled or disabled
+func validateProjectFeatures(i interface{}, k string) ([]string, []error) {
+	features, ok := i.(map[string]interface{})
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %q to be map", k)}
+	}
+
+	var knownFeatures []string
+	for feature := range projectFeatureIDs {
+		knownFeatures = append(knownFeatures, feature)
+	}
+	sort.Strings(knownFeatures)
+
+	var errors []error
+	for feature, state := range features {
+		if _, ok := projectFeatureIDs[feature]; !ok {
+			errors = append(errors, fmt.Errorf("%q contains the unknown feature %q, expected one of %s", k, feature, strings.Join(knownFeatures, ", ")))
+			continue
+		}
+
+		value, _ := state.(string)
+		valid := false
+		for _, known := range projectFeatureStates {
+			if value == known {
+				valid = true
+			}
+		}
+		if !valid {
+			errors = append(errors, fmt.Errorf("the state of feature %q in %q must be one of %s, got %q", feature, k, strings.Join(projectFeatureStates, ", "), value))
+		}
+	}
+	return nil, errors
+}
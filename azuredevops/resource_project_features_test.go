@@ -0,0 +1,228 @@
+package azuredevops
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/featuremanagement"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var testFeaturesProjectID = "project-id"
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that the configured features are toggled and that their actual state is read back
+func TestAzureDevOpsProjectFeatures_Create_SetsFeatureStates(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	featureClient := azdosdkmocks.NewMockFeaturemanagementClient(ctrl)
+	clients := &aggregatedClient{FeatureManagementClient: featureClient, ctx: context.Background()}
+
+	resourceData := createProjectFeaturesResourceData(t, map[string]interface{}{"boards": "disabled"})
+
+	disabled := featuremanagement.ContributedFeatureEnabledValueValues.Disabled
+	featureClient.
+		EXPECT().
+		SetFeatureStateForScope(clients.ctx, featuremanagement.SetFeatureStateForScopeArgs{
+			Feature: &featuremanagement.ContributedFeatureState{
+				FeatureId: converter.String("ms.vss-work.agile"),
+				Scope: &featuremanagement.ContributedFeatureSettingScope{
+					SettingScope: converter.String("project"),
+					UserScoped:   converter.Bool(false),
+				},
+				State: &disabled,
+			},
+			FeatureId:  converter.String("ms.vss-work.agile"),
+			UserScope:  converter.String("host"),
+			ScopeName:  converter.String("project"),
+			ScopeValue: &testFeaturesProjectID,
+		}).
+		Return(&featuremanagement.ContributedFeatureState{}, nil).
+		Times(1)
+	expectGetProjectFeatureState(featureClient, "ms.vss-work.agile", disabled)
+
+	err := resourceProjectFeaturesCreateOrUpdate(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, testFeaturesProjectID, resourceData.Id())
+	require.Equal(t, map[string]interface{}{"boards": "disabled"}, resourceData.Get("features"))
+}
+
+// verifies that a feature that has been enabled out of band is detected on read
+func TestAzureDevOpsProjectFeatures_Read_ReconcilesState(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	featureClient := azdosdkmocks.NewMockFeaturemanagementClient(ctrl)
+	clients := &aggregatedClient{FeatureManagementClient: featureClient, ctx: context.Background()}
+
+	resourceData := createProjectFeaturesResourceData(t, map[string]interface{}{"artifacts": "disabled"})
+	resourceData.SetId(testFeaturesProjectID)
+
+	expectGetProjectFeatureState(featureClient, "ms.feed.feed", featuremanagement.ContributedFeatureEnabledValueValues.Enabled)
+
+	err := resourceProjectFeaturesRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, map[string]interface{}{"artifacts": "enabled"}, resourceData.Get("features"))
+}
+
+// verifies that the features are enabled again when the resource is destroyed
+func TestAzureDevOpsProjectFeatures_Delete_EnablesFeatures(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	featureClient := azdosdkmocks.NewMockFeaturemanagementClient(ctrl)
+	clients := &aggregatedClient{FeatureManagementClient: featureClient, ctx: context.Background()}
+
+	resourceData := createProjectFeaturesResourceData(t, map[string]interface{}{"testplans": "disabled"})
+	resourceData.SetId(testFeaturesProjectID)
+
+	featureClient.
+		EXPECT().
+		SetFeatureStateForScope(clients.ctx, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, args featuremanagement.SetFeatureStateForScopeArgs) (*featuremanagement.ContributedFeatureState, error) {
+			require.Equal(t, "ms.vss-test-web.test", *args.FeatureId)
+			require.Equal(t, featuremanagement.ContributedFeatureEnabledValueValues.Enabled, *args.Feature.State)
+			return args.Feature, nil
+		}).
+		Times(1)
+
+	err := resourceProjectFeaturesDelete(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "", resourceData.Id())
+}
+
+// verifies that unknown features and states are rejected
+func TestAzureDevOpsProjectFeatures_Validate_RejectsTypos(t *testing.T) {
+	_, errs := validateProjectFeatures(map[string]interface{}{"boards": "disabled", "pipelines": "enabled"}, "features")
+	require.Empty(t, errs)
+
+	_, errs = validateProjectFeatures(map[string]interface{}{"board": "disabled"}, "features")
+	require.Len(t, errs, 1)
+	require.Contains(t, errs[0].Error(), "unknown feature \"board\"")
+
+	_, errs = validateProjectFeatures(map[string]interface{}{"boards": "off"}, "features")
+	require.Len(t, errs, 1)
+	require.Contains(t, errs[0].Error(), "must be one of enabled, disabled")
+}
+
+// verifies that if an error is produced while toggling a feature, the error is not swallowed
+func TestAzureDevOpsProjectFeatures_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	featureClient := azdosdkmocks.NewMockFeaturemanagementClient(ctrl)
+	clients := &aggregatedClient{FeatureManagementClient: featureClient, ctx: context.Background()}
+
+	resourceData := createProjectFeaturesResourceData(t, map[string]interface{}{"boards": "disabled"})
+
+	featureClient.
+		EXPECT().
+		SetFeatureStateForScope(clients.ctx, gomock.Any()).
+		Return(nil, errors.New("SetFeatureStateForScope() Failed")).
+		Times(1)
+
+	err := resourceProjectFeaturesCreateOrUpdate(resourceData, clients)
+	require.Contains(t, err.Error(), "SetFeatureStateForScope() Failed")
+}
+
+func createProjectFeaturesResourceData(t *testing.T, features map[string]interface{}) *schema.ResourceData {
+	return schema.TestResourceDataRaw(t, resourceProjectFeatures().Schema, map[string]interface{}{
+		"project_id": testFeaturesProjectID,
+		"features":   features,
+	})
+}
+
+func expectGetProjectFeatureState(featureClient *azdosdkmocks.MockFeaturemanagementClient, featureID string, state featuremanagement.ContributedFeatureEnabledValue) *gomock.Call {
+	return featureClient.
+		EXPECT().
+		GetFeatureStateForScope(gomock.Any(), featuremanagement.GetFeatureStateForScopeArgs{
+			FeatureId:  converter.String(featureID),
+			UserScope:  converter.String("host"),
+			ScopeName:  converter.String("project"),
+			ScopeValue: &testFeaturesProjectID,
+		}).
+		Return(&featuremanagement.ContributedFeatureState{FeatureId: &featureID, State: &state}, nil).
+		Times(1)
+}
+
+/**
+ * Begin acceptance tests
+ */
+
+// Verifies that features of a project can be disabled and enabled again
+func TestAccAzureDevOpsProjectFeatures_CreateAndUpdate(t *testing.T) {
+	projectName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	tfNode := "azuredevops_project_features.features"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectFeaturesResource(projectName, "disabled"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "features.boards", "disabled"),
+					resource.TestCheckResourceAttr(tfNode, "features.artifacts", "disabled"),
+					testAccCheckProjectFeatureState("artifacts", "disabled"),
+				),
+			}, {
+				Config: testAccProjectFeaturesResource(projectName, "enabled"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "features.boards", "enabled"),
+					testAccCheckProjectFeatureState("artifacts", "enabled"),
+				),
+			},
+		},
+	})
+}
+
+// HCL describing the features of a project
+func testAccProjectFeaturesResource(projectName string, state string) string {
+	featuresResource := fmt.Sprintf(`
+resource "azuredevops_project_features" "features" {
+	project_id = azuredevops_project.project.id
+	features = {
+		boards    = "%s"
+		artifacts = "%s"
+	}
+}`, state, state)
+
+	projectResource := testAccProjectResource(projectName)
+	return fmt.Sprintf("%s\n%s", projectResource, featuresResource)
+}
+
+// verifies the state of a feature of the project created by the test
+func testAccCheckProjectFeatureState(feature string, expectedState string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		projectID := s.RootModule().Resources["azuredevops_project.project"].Primary.ID
+
+		clients := testAccProvider.Meta().(*aggregatedClient)
+		state, err := clients.FeatureManagementClient.GetFeatureStateForScope(clients.ctx, featuremanagement.GetFeatureStateForScopeArgs{
+			FeatureId:  converter.String(projectFeatureIDs[feature]),
+			UserScope:  converter.String("host"),
+			ScopeName:  converter.String("project"),
+			ScopeValue: &projectID,
+		})
+		if err != nil {
+			return err
+		}
+
+		if state.State == nil || string(*state.State) != expectedState {
+			return fmt.Errorf("Expected feature %s of project %s to be %s", feature, projectID, expectedState)
+		}
+		return nil
+	}
+}
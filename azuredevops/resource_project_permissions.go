@@ -0,0 +1,53 @@
+package azuredevops
+
+import (
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// The security namespace that controls the permissions on projects
+var securityNamespaceProject, _ = uuid.Parse("52d39943-cb85-4d7f-8fa8-c6baac873819")
+
+func resourceProjectPermissions() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceProjectPermissionsCreateOrUpdate,
+		Read:   resourceProjectPermissionsRead,
+		Update: resourceProjectPermissionsCreateOrUpdate,
+		Delete: resourceProjectPermissionsDelete,
+		Schema: baseSecurityPermissionsSchema(),
+	}
+}
+
+func resourceProjectPermissionsCreateOrUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	token := projectSecurityToken(d.Get("project_id").(string))
+
+	if err := updateSecurityPermissions(clients, d, securityNamespaceProject, token); err != nil {
+		return err
+	}
+
+	d.SetId(token + "#" + d.Get("principal").(string))
+	return resourceProjectPermissionsRead(d, m)
+}
+
+func resourceProjectPermissionsRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	token := projectSecurityToken(d.Get("project_id").(string))
+	return readSecurityPermissions(clients, d, securityNamespaceProject, token)
+}
+
+func resourceProjectPermissionsDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	token := projectSecurityToken(d.Get("project_id").(string))
+
+	if err := resetSecurityPermissions(clients, d, securityNamespaceProject, token); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func projectSecurityToken(projectID string) string {
+	return "$PROJECT:vstfs:///Classification/TeamProject/" + projectID
+}
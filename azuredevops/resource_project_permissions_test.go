@@ -0,0 +1,90 @@
+package azuredevops
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/security"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/stretchr/testify/require"
+)
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that the permissions are set on the token of the project in the project namespace
+func TestAzureDevOpsProjectPermissions_Create_UsesProjectToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	securityClient := azdosdkmocks.NewMockSecurityClient(ctrl)
+	clients := &aggregatedClient{SecurityClient: securityClient, ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceProjectPermissions().Schema, map[string]interface{}{
+		"project_id":  "project-id",
+		"principal":   testPermissionsPrincipal,
+		"permissions": map[string]interface{}{"GENERIC_READ": permissionAllow},
+	})
+
+	securityClient.
+		EXPECT().
+		QuerySecurityNamespaces(clients.ctx, security.QuerySecurityNamespacesArgs{SecurityNamespaceId: &securityNamespaceProject}).
+		Return(nil, fmt.Errorf("QuerySecurityNamespaces() Failed")).
+		Times(1)
+
+	err := resourceProjectPermissionsCreateOrUpdate(resourceData, clients)
+	require.Contains(t, err.Error(), "QuerySecurityNamespaces() Failed")
+	require.Equal(t, "$PROJECT:vstfs:///Classification/TeamProject/project-id", projectSecurityToken("project-id"))
+}
+
+/**
+ * Begin acceptance tests
+ */
+
+// Verifies that permissions of a group on a project can be set, changed and reset
+func TestAccAzureDevOpsProjectPermissions_CreateAndUpdate(t *testing.T) {
+	projectName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	groupName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	tfNode := "azuredevops_project_permissions.permissions"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectPermissionsResource(projectName, groupName, permissionDeny),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "permissions.DELETE", permissionDeny),
+					resource.TestCheckResourceAttr(tfNode, "permissions.GENERIC_READ", permissionAllow),
+				),
+			}, {
+				Config: testAccProjectPermissionsResource(projectName, groupName, permissionNotSet),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "permissions.DELETE", permissionNotSet),
+				),
+			},
+		},
+	})
+}
+
+// HCL describing permissions of a group on a project
+func testAccProjectPermissionsResource(projectName string, groupName string, deleteState string) string {
+	permissionsResource := fmt.Sprintf(`
+resource "azuredevops_project_permissions" "permissions" {
+	project_id = azuredevops_project.project.id
+	principal  = azuredevops_group.group.descriptor
+	permissions = {
+		GENERIC_READ = "Allow"
+		DELETE       = "%s"
+	}
+}`, deleteState)
+
+	groupResource := testAccGroupResource(projectName, groupName, "Description")
+	return fmt.Sprintf("%s\n%s", groupResource, permissionsResource)
+}
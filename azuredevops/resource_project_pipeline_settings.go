@@ -0,0 +1,243 @@
+package azuredevops
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/build"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/azdoerror"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/pipelinesettings"
+)
+
+// The pipeline settings of a project always exist, so the resource only changes the settings that are
+// configured. Settings that are not configured are read, but left as they are.
+func resourceProjectPipelineSettings() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceProjectPipelineSettingsCreateOrUpdate,
+		Read:          resourceProjectPipelineSettingsRead,
+		Update:        resourceProjectPipelineSettingsCreateOrUpdate,
+		Delete:        resourceProjectPipelineSettingsDelete,
+		CustomizeDiff: customizeDiffProjectPipelineSettings,
+		Importer: &schema.ResourceImporter{
+			State: resourceProjectPipelineSettingsImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"enforce_job_authorization_scope": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"enforce_settable_variables": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"days_to_keep_deleted_builds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"default_retention_policy": projectRetentionPolicySchema(),
+			"maximum_retention_policy": projectRetentionPolicySchema(),
+		},
+	}
+}
+
+func projectRetentionPolicySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"days_to_keep": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				"minimum_to_keep": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+// The service rejects a default retention policy that keeps builds longer than the maximum retention policy
+func customizeDiffProjectPipelineSettings(d *schema.ResourceDiff, m interface{}) error {
+	defaultPolicies := d.Get("default_retention_policy").([]interface{})
+	maximumPolicies := d.Get("maximum_retention_policy").([]interface{})
+	if len(defaultPolicies) != 1 || len(maximumPolicies) != 1 || defaultPolicies[0] == nil || maximumPolicies[0] == nil {
+		return nil
+	}
+
+	defaultPolicy := defaultPolicies[0].(map[string]interface{})
+	maximumPolicy := maximumPolicies[0].(map[string]interface{})
+	for _, key := range []string{"days_to_keep", "minimum_to_keep"} {
+		if defaultPolicy[key].(int) > maximumPolicy[key].(int) {
+			return fmt.Errorf("default_retention_policy %s %d exceeds the maximum_retention_policy %s of %d",
+				key, defaultPolicy[key].(int), key, maximumPolicy[key].(int))
+		}
+	}
+	return nil
+}
+
+func resourceProjectPipelineSettingsCreateOrUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	projectID := d.Get("project_id").(string)
+
+	if generalSettings := expandProjectGeneralPipelineSettings(d); generalSettings != nil {
+		_, err := clients.PipelineSettingsClient.UpdateGeneralSettings(clients.ctx, pipelinesettings.UpdateGeneralSettingsArgs{
+			Settings: generalSettings,
+			Project:  &projectID,
+		})
+		if err != nil {
+			return fmt.Errorf("Error updating the general pipeline settings of project %s. Error: %v", projectID, err)
+		}
+	}
+
+	if hasProjectRetentionSettings(d) {
+		settings, err := clients.BuildClient.GetBuildSettings(clients.ctx, build.GetBuildSettingsArgs{
+			Project: &projectID,
+		})
+		if err != nil {
+			return fmt.Errorf("Error looking up the retention settings of project %s. Error: %v", projectID, err)
+		}
+
+		_, err = clients.BuildClient.UpdateBuildSettings(clients.ctx, build.UpdateBuildSettingsArgs{
+			Settings: expandProjectRetentionSettings(d, settings),
+			Project:  &projectID,
+		})
+		if err != nil {
+			return fmt.Errorf("Error updating the retention settings of project %s. Error: %v", projectID, err)
+		}
+	}
+
+	d.SetId(projectID)
+	return resourceProjectPipelineSettingsRead(d, m)
+}
+
+func resourceProjectPipelineSettingsRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	projectID := d.Get("project_id").(string)
+
+	generalSettings, err := clients.PipelineSettingsClient.GetGeneralSettings(clients.ctx, pipelinesettings.GetGeneralSettingsArgs{
+		Project: &projectID,
+	})
+	if err != nil {
+		if azdoerror.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error looking up the general pipeline settings of project %s. Error: %v", projectID, err)
+	}
+
+	settings, err := clients.BuildClient.GetBuildSettings(clients.ctx, build.GetBuildSettingsArgs{
+		Project: &projectID,
+	})
+	if err != nil {
+		if azdoerror.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error looking up the retention settings of project %s. Error: %v", projectID, err)
+	}
+
+	// settings that are missing from the response are disabled
+	d.Set("enforce_job_authorization_scope", converter.ToBool(generalSettings.EnforceJobAuthScope, false))
+	d.Set("enforce_settable_variables", converter.ToBool(generalSettings.EnforceSettableVar, false))
+	d.Set("days_to_keep_deleted_builds", converter.ToInt(settings.DaysToKeepDeletedBuildsBeforeDestroy, 0))
+	d.Set("default_retention_policy", flattenProjectRetentionPolicy(settings.DefaultRetentionPolicy))
+	d.Set("maximum_retention_policy", flattenProjectRetentionPolicy(settings.MaximumRetentionPolicy))
+	return nil
+}
+
+// The settings of a project cannot be deleted, so they are left as they are when the resource is destroyed
+func resourceProjectPipelineSettingsDelete(d *schema.ResourceData, m interface{}) error {
+	d.SetId("")
+	return nil
+}
+
+// Imports the pipeline settings of a project given the ID of the project
+func resourceProjectPipelineSettingsImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	d.Set("project_id", d.Id())
+	return []*schema.ResourceData{d}, nil
+}
+
+// Returns the configured general settings, or nil if none of them is configured
+func expandProjectGeneralPipelineSettings(d *schema.ResourceData) *pipelinesettings.GeneralSettings {
+	settings := pipelinesettings.GeneralSettings{}
+	configured := false
+	// unlike GetOk, GetOkExists tells a setting that is configured to be disabled apart from an unconfigured one
+	if value, ok := d.GetOkExists("enforce_job_authorization_scope"); ok {
+		settings.EnforceJobAuthScope = converter.Bool(value.(bool))
+		configured = true
+	}
+	if value, ok := d.GetOkExists("enforce_settable_variables"); ok {
+		settings.EnforceSettableVar = converter.Bool(value.(bool))
+		configured = true
+	}
+
+	if !configured {
+		return nil
+	}
+	return &settings
+}
+
+func hasProjectRetentionSettings(d *schema.ResourceData) bool {
+	for _, key := range []string{"days_to_keep_deleted_builds", "default_retention_policy", "maximum_retention_policy"} {
+		if _, ok := d.GetOk(key); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// Applies the configured retention settings to the current settings of the project. The parts of the retention
+// policies that are not managed by the resource, like the branches they apply to, are kept.
+func expandProjectRetentionSettings(d *schema.ResourceData, settings *build.BuildSettings) *build.BuildSettings {
+	if settings == nil {
+		settings = &build.BuildSettings{}
+	}
+	if days, ok := d.GetOk("days_to_keep_deleted_builds"); ok {
+		settings.DaysToKeepDeletedBuildsBeforeDestroy = converter.Int(days.(int))
+	}
+	settings.DefaultRetentionPolicy = expandProjectRetentionPolicy(d, "default_retention_policy", settings.DefaultRetentionPolicy)
+	settings.MaximumRetentionPolicy = expandProjectRetentionPolicy(d, "maximum_retention_policy", settings.MaximumRetentionPolicy)
+	return settings
+}
+
+func expandProjectRetentionPolicy(d *schema.ResourceData, key string, policy *build.RetentionPolicy) *build.RetentionPolicy {
+	configured := expandSingleItemBlock(d, key)
+	if len(configured) == 0 {
+		return policy
+	}
+	if policy == nil {
+		policy = &build.RetentionPolicy{}
+	}
+	policy.DaysToKeep = converter.Int(configured["days_to_keep"].(int))
+	policy.MinimumToKeep = converter.Int(configured["minimum_to_keep"].(int))
+	return policy
+}
+
+func flattenProjectRetentionPolicy(policy *build.RetentionPolicy) []interface{} {
+	if policy == nil {
+		return nil
+	}
+	return []interface{}{map[string]interface{}{
+		"days_to_keep":    converter.ToInt(policy.DaysToKeep, 0),
+		"minimum_to_keep": converter.ToInt(policy.MinimumToKeep, 0),
+	}}
+}
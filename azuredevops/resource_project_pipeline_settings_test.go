@@ -0,0 +1,235 @@
+package azuredevops
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/build"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/pipelinesettings"
+	"github.com/stretchr/testify/require"
+)
+
+var testPipelineSettingsProjectID = "project"
+
+var testProjectBuildSettings = build.BuildSettings{
+	DaysToKeepDeletedBuildsBeforeDestroy: converter.Int(30),
+	DefaultRetentionPolicy: &build.RetentionPolicy{
+		Branches:      &[]string{"+refs/heads/*"},
+		DaysToKeep:    converter.Int(10),
+		MinimumToKeep: converter.Int(1),
+	},
+	MaximumRetentionPolicy: &build.RetentionPolicy{
+		DaysToKeep:    converter.Int(30),
+		MinimumToKeep: converter.Int(50),
+	},
+}
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that only the configured general settings are changed, including settings that are disabled, and
+// that all settings are read back
+func TestAzureDevOpsProjectPipelineSettings_Create_OnlyUpdatesConfiguredGeneralSettings(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	settingsClient := azdosdkmocks.NewMockPipelineSettingsClient(ctrl)
+	buildClient := azdosdkmocks.NewMockBuildClient(ctrl)
+	clients := &aggregatedClient{PipelineSettingsClient: settingsClient, BuildClient: buildClient, ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceProjectPipelineSettings().Schema, map[string]interface{}{
+		"project_id":                 testPipelineSettingsProjectID,
+		"enforce_settable_variables": false,
+	})
+
+	settingsClient.
+		EXPECT().
+		UpdateGeneralSettings(clients.ctx, pipelinesettings.UpdateGeneralSettingsArgs{
+			Settings: &pipelinesettings.GeneralSettings{EnforceSettableVar: converter.Bool(false)},
+			Project:  &testPipelineSettingsProjectID,
+		}).
+		Return(&pipelinesettings.GeneralSettings{}, nil).
+		Times(1)
+	buildClient.
+		EXPECT().
+		UpdateBuildSettings(gomock.Any(), gomock.Any()).
+		Times(0)
+	expectReadProjectPipelineSettings(settingsClient, buildClient, &pipelinesettings.GeneralSettings{EnforceJobAuthScope: converter.Bool(true)})
+
+	err := resourceProjectPipelineSettingsCreateOrUpdate(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, testPipelineSettingsProjectID, resourceData.Id())
+	require.True(t, resourceData.Get("enforce_job_authorization_scope").(bool))
+	require.False(t, resourceData.Get("enforce_settable_variables").(bool))
+	require.Equal(t, 30, resourceData.Get("days_to_keep_deleted_builds"))
+	require.Equal(t, 10, resourceData.Get("default_retention_policy.0.days_to_keep"))
+	require.Equal(t, 50, resourceData.Get("maximum_retention_policy.0.minimum_to_keep"))
+}
+
+// verifies that the configured retention policy is applied to the current settings, which keeps the parts of
+// the policy that are not managed by the resource
+func TestAzureDevOpsProjectPipelineSettings_Create_KeepsUnmanagedRetentionSettings(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	settingsClient := azdosdkmocks.NewMockPipelineSettingsClient(ctrl)
+	buildClient := azdosdkmocks.NewMockBuildClient(ctrl)
+	clients := &aggregatedClient{PipelineSettingsClient: settingsClient, BuildClient: buildClient, ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceProjectPipelineSettings().Schema, map[string]interface{}{
+		"project_id": testPipelineSettingsProjectID,
+		"default_retention_policy": []interface{}{map[string]interface{}{
+			"days_to_keep":    20,
+			"minimum_to_keep": 5,
+		}},
+	})
+
+	current := testProjectBuildSettings
+	currentDefault := *testProjectBuildSettings.DefaultRetentionPolicy
+	current.DefaultRetentionPolicy = &currentDefault
+
+	expected := testProjectBuildSettings
+	expected.DefaultRetentionPolicy = &build.RetentionPolicy{
+		Branches:      &[]string{"+refs/heads/*"},
+		DaysToKeep:    converter.Int(20),
+		MinimumToKeep: converter.Int(5),
+	}
+
+	settingsClient.
+		EXPECT().
+		UpdateGeneralSettings(gomock.Any(), gomock.Any()).
+		Times(0)
+	first := buildClient.
+		EXPECT().
+		GetBuildSettings(clients.ctx, build.GetBuildSettingsArgs{Project: &testPipelineSettingsProjectID}).
+		Return(&current, nil).
+		Times(1)
+	buildClient.
+		EXPECT().
+		UpdateBuildSettings(clients.ctx, build.UpdateBuildSettingsArgs{Settings: &expected, Project: &testPipelineSettingsProjectID}).
+		Return(&expected, nil).
+		After(first).
+		Times(1)
+	expectReadProjectPipelineSettings(settingsClient, buildClient, &pipelinesettings.GeneralSettings{})
+
+	err := resourceProjectPipelineSettingsCreateOrUpdate(resourceData, clients)
+	require.Nil(t, err)
+}
+
+// verifies that a default retention policy that exceeds the maximum retention policy is rejected during planning
+func TestAzureDevOpsProjectPipelineSettings_CustomizeDiff_RejectsDefaultAboveMaximum(t *testing.T) {
+	diff := func(defaultDays int, maximumDays int) error {
+		config := map[string]interface{}{
+			"project_id": testPipelineSettingsProjectID,
+			"default_retention_policy": []interface{}{map[string]interface{}{
+				"days_to_keep":    defaultDays,
+				"minimum_to_keep": 1,
+			}},
+			"maximum_retention_policy": []interface{}{map[string]interface{}{
+				"days_to_keep":    maximumDays,
+				"minimum_to_keep": 1,
+			}},
+		}
+		_, err := resourceProjectPipelineSettings().Diff(nil, terraform.NewResourceConfigRaw(config), nil)
+		return err
+	}
+
+	require.Nil(t, diff(10, 10))
+	require.NotNil(t, diff(11, 10))
+}
+
+// verifies that if an error is produced on a read, it is not swallowed
+func TestAzureDevOpsProjectPipelineSettings_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	settingsClient := azdosdkmocks.NewMockPipelineSettingsClient(ctrl)
+	clients := &aggregatedClient{PipelineSettingsClient: settingsClient, ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceProjectPipelineSettings().Schema, map[string]interface{}{
+		"project_id": testPipelineSettingsProjectID,
+	})
+	resourceData.SetId(testPipelineSettingsProjectID)
+
+	settingsClient.
+		EXPECT().
+		GetGeneralSettings(clients.ctx, gomock.Any()).
+		Return(nil, errors.New("GetGeneralSettings() Failed")).
+		Times(1)
+
+	err := resourceProjectPipelineSettingsRead(resourceData, clients)
+	require.Contains(t, err.Error(), "GetGeneralSettings() Failed")
+}
+
+func expectReadProjectPipelineSettings(settingsClient *azdosdkmocks.MockPipelineSettingsClient, buildClient *azdosdkmocks.MockBuildClient, generalSettings *pipelinesettings.GeneralSettings) {
+	settingsClient.
+		EXPECT().
+		GetGeneralSettings(gomock.Any(), pipelinesettings.GetGeneralSettingsArgs{Project: &testPipelineSettingsProjectID}).
+		Return(generalSettings, nil).
+		Times(1)
+	buildClient.
+		EXPECT().
+		GetBuildSettings(gomock.Any(), build.GetBuildSettingsArgs{Project: &testPipelineSettingsProjectID}).
+		Return(&testProjectBuildSettings, nil).
+		Times(1)
+}
+
+/**
+ * Begin acceptance tests
+ */
+
+// Verifies that the general pipeline settings and the retention settings of a project can be changed
+func TestAccAzureDevOpsProjectPipelineSettings_CreateAndUpdate(t *testing.T) {
+	projectName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	tfSettingsNode := "azuredevops_project_pipeline_settings.settings"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectPipelineSettingsResource(projectName, true, 10),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfSettingsNode, "enforce_job_authorization_scope", "true"),
+					resource.TestCheckResourceAttr(tfSettingsNode, "enforce_settable_variables", "true"),
+					resource.TestCheckResourceAttr(tfSettingsNode, "default_retention_policy.0.days_to_keep", "10"),
+				),
+			}, {
+				Config: testAccProjectPipelineSettingsResource(projectName, false, 20),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfSettingsNode, "enforce_job_authorization_scope", "false"),
+					resource.TestCheckResourceAttr(tfSettingsNode, "enforce_settable_variables", "false"),
+					resource.TestCheckResourceAttr(tfSettingsNode, "default_retention_policy.0.days_to_keep", "20"),
+				),
+			},
+		},
+	})
+}
+
+// HCL describing the pipeline settings of a project
+func testAccProjectPipelineSettingsResource(projectName string, enforce bool, daysToKeep int) string {
+	settingsResource := fmt.Sprintf(`
+resource "azuredevops_project_pipeline_settings" "settings" {
+	project_id                      = azuredevops_project.project.id
+	enforce_job_authorization_scope = %t
+	enforce_settable_variables      = %t
+
+	default_retention_policy {
+		days_to_keep    = %d
+		minimum_to_keep = 1
+	}
+}`, enforce, enforce, daysToKeep)
+
+	projectResource := testAccProjectResource(projectName)
+	return fmt.Sprintf("%s\n%s", projectResource, settingsResource)
+}
@@ -0,0 +1,196 @@
+package azuredevops
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/core"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/webapi"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/azdoerror"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+)
+
+func resourceProjectProperties() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceProjectPropertiesCreateOrUpdate,
+		Read:   resourceProjectPropertiesRead,
+		Update: resourceProjectPropertiesCreateOrUpdate,
+		Delete: resourceProjectPropertiesDelete,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"properties": {
+				Type:     schema.TypeMap,
+				Required: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceProjectPropertiesCreateOrUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	projectID := d.Get("project_id").(string)
+
+	oldProperties, newProperties := d.GetChange("properties")
+	operations := expandProjectPropertyOperations(newProperties.(map[string]interface{}))
+
+	// properties that are no longer managed are removed, all others are left untouched
+	var removedKeys []string
+	for key := range oldProperties.(map[string]interface{}) {
+		if _, ok := newProperties.(map[string]interface{})[key]; !ok {
+			removedKeys = append(removedKeys, key)
+		}
+	}
+	operations = append(operations, expandProjectPropertyRemovals(removedKeys)...)
+
+	if err := setProjectProperties(clients, projectID, operations); err != nil {
+		return err
+	}
+
+	d.SetId(projectID)
+	return resourceProjectPropertiesRead(d, m)
+}
+
+func resourceProjectPropertiesRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	projectID := d.Get("project_id").(string)
+
+	// only the configured properties are managed by this resource, so only they are read
+	var keys []string
+	for key := range d.Get("properties").(map[string]interface{}) {
+		keys = append(keys, key)
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+	sort.Strings(keys)
+
+	projectUUID, err := uuid.Parse(projectID)
+	if err != nil {
+		return fmt.Errorf("Error parsing project ID %s: %+v", projectID, err)
+	}
+
+	properties, err := clients.CoreClient.GetProjectProperties(clients.ctx, core.GetProjectPropertiesArgs{
+		ProjectId: &projectUUID,
+		Keys:      &keys,
+	})
+	if err != nil {
+		if azdoerror.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading the properties of project %s. Error: %v", projectID, err)
+	}
+
+	d.Set("properties", flattenProjectProperties(properties, keys))
+	return nil
+}
+
+// Only the managed properties are removed, properties set outside of Terraform are kept
+func resourceProjectPropertiesDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	projectID := d.Get("project_id").(string)
+
+	var keys []string
+	for key := range d.Get("properties").(map[string]interface{}) {
+		keys = append(keys, key)
+	}
+
+	err := setProjectProperties(clients, projectID, expandProjectPropertyRemovals(keys))
+	if err != nil && !azdoerror.IsNotFound(err) {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func setProjectProperties(clients *aggregatedClient, projectID string, operations []webapi.JsonPatchOperation) error {
+	if len(operations) == 0 {
+		return nil
+	}
+
+	projectUUID, err := uuid.Parse(projectID)
+	if err != nil {
+		return fmt.Errorf("Error parsing project ID %s: %+v", projectID, err)
+	}
+
+	err = clients.CoreClient.SetProjectProperties(clients.ctx, core.SetProjectPropertiesArgs{
+		ProjectId:     &projectUUID,
+		PatchDocument: &operations,
+	})
+	if err != nil {
+		return fmt.Errorf("Error setting the properties of project %s. Error: %v", projectID, err)
+	}
+	return nil
+}
+
+// Convert internal Terraform data structure to an AzDO data structure. The service uses add operations
+// to create and to update properties.
+func expandProjectPropertyOperations(properties map[string]interface{}) []webapi.JsonPatchOperation {
+	var keys []string
+	for key := range properties {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	operations := make([]webapi.JsonPatchOperation, len(keys))
+	for i, key := range keys {
+		operations[i] = webapi.JsonPatchOperation{
+			Op:    &webapi.OperationValues.Add,
+			Path:  converter.String(projectPropertyPath(key)),
+			Value: properties[key].(string),
+		}
+	}
+	return operations
+}
+
+func expandProjectPropertyRemovals(keys []string) []webapi.JsonPatchOperation {
+	sort.Strings(keys)
+
+	operations := make([]webapi.JsonPatchOperation, len(keys))
+	for i, key := range keys {
+		operations[i] = webapi.JsonPatchOperation{
+			Op:   &webapi.OperationValues.Remove,
+			Path: converter.String(projectPropertyPath(key)),
+		}
+	}
+	return operations
+}
+
+// Returns the JSON Patch path of a property, escaping the characters that have a special meaning in a path
+func projectPropertyPath(key string) string {
+	return "/" + strings.NewReplacer("~", "~0", "/", "~1").Replace(key)
+}
+
+// Convert AzDO data structure to internal Terraform data structure. The service supports wildcards in the
+// requested keys, so properties that do not exactly match a managed key are ignored.
+func flattenProjectProperties(properties *[]core.ProjectProperty, keys []string) map[string]interface{} {
+	managed := map[string]bool{}
+	for _, key := range keys {
+		managed[key] = true
+	}
+
+	result := map[string]interface{}{}
+	if properties == nil {
+		return result
+	}
+	for _, property := range *properties {
+		name := converter.ToString(property.Name, "")
+		if managed[name] && property.Value != nil {
+			result[name] = fmt.Sprintf("%v", property.Value)
+		}
+	}
+	return result
+}
@@ -0,0 +1,260 @@
+package azuredevops
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/core"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/webapi"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var testPropertiesProjectID = uuid.New()
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that the configured properties are added and that their actual values are read back
+func TestAzureDevOpsProjectProperties_Create_SetsProperties(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreClient := azdosdkmocks.NewMockCoreClient(ctrl)
+	clients := &aggregatedClient{CoreClient: coreClient, ctx: context.Background()}
+
+	resourceData := createProjectPropertiesResourceData(t, map[string]interface{}{"CostCenter": "1234", "Owner": "team-a"})
+
+	coreClient.
+		EXPECT().
+		SetProjectProperties(clients.ctx, core.SetProjectPropertiesArgs{
+			ProjectId: &testPropertiesProjectID,
+			PatchDocument: &[]webapi.JsonPatchOperation{
+				{Op: &webapi.OperationValues.Add, Path: converter.String("/CostCenter"), Value: "1234"},
+				{Op: &webapi.OperationValues.Add, Path: converter.String("/Owner"), Value: "team-a"},
+			},
+		}).
+		Return(nil).
+		Times(1)
+	expectGetProjectProperties(coreClient, []string{"CostCenter", "Owner"}, []core.ProjectProperty{
+		{Name: converter.String("CostCenter"), Value: "1234"},
+		{Name: converter.String("Owner"), Value: "team-a"},
+	})
+
+	err := resourceProjectPropertiesCreateOrUpdate(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, testPropertiesProjectID.String(), resourceData.Id())
+	require.Equal(t, map[string]interface{}{"CostCenter": "1234", "Owner": "team-a"}, resourceData.Get("properties"))
+}
+
+// verifies that properties that are no longer configured are removed while the others are updated
+func TestAzureDevOpsProjectProperties_Update_RemovesUnmanagedProperties(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreClient := azdosdkmocks.NewMockCoreClient(ctrl)
+	clients := &aggregatedClient{CoreClient: coreClient, ctx: context.Background()}
+
+	propertiesSchema := schema.InternalMap(resourceProjectProperties().Schema)
+	state := &terraform.InstanceState{
+		ID: testPropertiesProjectID.String(),
+		Attributes: map[string]string{
+			"project_id":            testPropertiesProjectID.String(),
+			"properties.%":          "2",
+			"properties.CostCenter": "1234",
+			"properties.Owner":      "team-a",
+		},
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"project_id": testPropertiesProjectID.String(),
+		"properties": map[string]interface{}{"CostCenter": "5678"},
+	})
+	diff, err := propertiesSchema.Diff(state, config, nil, nil, true)
+	require.Nil(t, err)
+	resourceData, err := propertiesSchema.Data(state, diff)
+	require.Nil(t, err)
+
+	coreClient.
+		EXPECT().
+		SetProjectProperties(clients.ctx, core.SetProjectPropertiesArgs{
+			ProjectId: &testPropertiesProjectID,
+			PatchDocument: &[]webapi.JsonPatchOperation{
+				{Op: &webapi.OperationValues.Add, Path: converter.String("/CostCenter"), Value: "5678"},
+				{Op: &webapi.OperationValues.Remove, Path: converter.String("/Owner")},
+			},
+		}).
+		Return(nil).
+		Times(1)
+	expectGetProjectProperties(coreClient, []string{"CostCenter"}, []core.ProjectProperty{
+		{Name: converter.String("CostCenter"), Value: "5678"},
+	})
+
+	err = resourceProjectPropertiesCreateOrUpdate(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, map[string]interface{}{"CostCenter": "5678"}, resourceData.Get("properties"))
+}
+
+// verifies that changed and removed managed properties are detected on read, while properties that
+// are not managed are ignored
+func TestAzureDevOpsProjectProperties_Read_ReconcilesManagedProperties(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreClient := azdosdkmocks.NewMockCoreClient(ctrl)
+	clients := &aggregatedClient{CoreClient: coreClient, ctx: context.Background()}
+
+	resourceData := createProjectPropertiesResourceData(t, map[string]interface{}{"CostCenter": "1234", "Owner": "team-a"})
+	resourceData.SetId(testPropertiesProjectID.String())
+
+	expectGetProjectProperties(coreClient, []string{"CostCenter", "Owner"}, []core.ProjectProperty{
+		{Name: converter.String("CostCenter"), Value: float64(5678)},
+		{Name: converter.String("Environment"), Value: "production"},
+	})
+
+	err := resourceProjectPropertiesRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, map[string]interface{}{"CostCenter": "5678"}, resourceData.Get("properties"))
+}
+
+// verifies that only the managed properties are removed when the resource is destroyed
+func TestAzureDevOpsProjectProperties_Delete_RemovesManagedProperties(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreClient := azdosdkmocks.NewMockCoreClient(ctrl)
+	clients := &aggregatedClient{CoreClient: coreClient, ctx: context.Background()}
+
+	resourceData := createProjectPropertiesResourceData(t, map[string]interface{}{"Cost/Center": "1234"})
+	resourceData.SetId(testPropertiesProjectID.String())
+
+	coreClient.
+		EXPECT().
+		SetProjectProperties(clients.ctx, core.SetProjectPropertiesArgs{
+			ProjectId: &testPropertiesProjectID,
+			PatchDocument: &[]webapi.JsonPatchOperation{
+				{Op: &webapi.OperationValues.Remove, Path: converter.String("/Cost~1Center")},
+			},
+		}).
+		Return(nil).
+		Times(1)
+
+	err := resourceProjectPropertiesDelete(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "", resourceData.Id())
+}
+
+// verifies that if an error is produced while setting the properties, the error is not swallowed
+func TestAzureDevOpsProjectProperties_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreClient := azdosdkmocks.NewMockCoreClient(ctrl)
+	clients := &aggregatedClient{CoreClient: coreClient, ctx: context.Background()}
+
+	resourceData := createProjectPropertiesResourceData(t, map[string]interface{}{"CostCenter": "1234"})
+
+	coreClient.
+		EXPECT().
+		SetProjectProperties(clients.ctx, gomock.Any()).
+		Return(errors.New("SetProjectProperties() Failed")).
+		Times(1)
+
+	err := resourceProjectPropertiesCreateOrUpdate(resourceData, clients)
+	require.Contains(t, err.Error(), "SetProjectProperties() Failed")
+}
+
+// verifies that if an error is produced on a read, it is not swallowed
+func TestAzureDevOpsProjectProperties_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreClient := azdosdkmocks.NewMockCoreClient(ctrl)
+	clients := &aggregatedClient{CoreClient: coreClient, ctx: context.Background()}
+
+	resourceData := createProjectPropertiesResourceData(t, map[string]interface{}{"CostCenter": "1234"})
+	resourceData.SetId(testPropertiesProjectID.String())
+
+	coreClient.
+		EXPECT().
+		GetProjectProperties(clients.ctx, gomock.Any()).
+		Return(nil, errors.New("GetProjectProperties() Failed")).
+		Times(1)
+
+	err := resourceProjectPropertiesRead(resourceData, clients)
+	require.Contains(t, err.Error(), "GetProjectProperties() Failed")
+}
+
+func createProjectPropertiesResourceData(t *testing.T, properties map[string]interface{}) *schema.ResourceData {
+	return schema.TestResourceDataRaw(t, resourceProjectProperties().Schema, map[string]interface{}{
+		"project_id": testPropertiesProjectID.String(),
+		"properties": properties,
+	})
+}
+
+func expectGetProjectProperties(coreClient *azdosdkmocks.MockCoreClient, keys []string, properties []core.ProjectProperty) *gomock.Call {
+	return coreClient.
+		EXPECT().
+		GetProjectProperties(gomock.Any(), core.GetProjectPropertiesArgs{
+			ProjectId: &testPropertiesProjectID,
+			Keys:      &keys,
+		}).
+		Return(&properties, nil).
+		Times(1)
+}
+
+/**
+ * Begin acceptance tests
+ */
+
+// Verifies that properties of a project can be set, updated and removed
+func TestAccAzureDevOpsProjectProperties_CreateAndUpdate(t *testing.T) {
+	projectName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	tfNode := "azuredevops_project_properties.properties"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectPropertiesResource(projectName, `
+		CostCenter = "1234"
+		Owner      = "team-a"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "properties.%", "2"),
+					resource.TestCheckResourceAttr(tfNode, "properties.CostCenter", "1234"),
+					resource.TestCheckResourceAttr(tfNode, "properties.Owner", "team-a"),
+				),
+			}, {
+				Config: testAccProjectPropertiesResource(projectName, `
+		CostCenter = "5678"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "properties.%", "1"),
+					resource.TestCheckResourceAttr(tfNode, "properties.CostCenter", "5678"),
+				),
+			},
+		},
+	})
+}
+
+// HCL describing the properties of a project
+func testAccProjectPropertiesResource(projectName string, properties string) string {
+	propertiesResource := fmt.Sprintf(`
+resource "azuredevops_project_properties" "properties" {
+	project_id = azuredevops_project.project.id
+	properties = {%s
+	}
+}`, properties)
+
+	projectResource := testAccProjectResource(projectName)
+	return fmt.Sprintf("%s\n%s", projectResource, propertiesResource)
+}
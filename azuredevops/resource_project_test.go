@@ -7,7 +7,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
 	"testing"
+	"time"
 
 	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
@@ -18,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/microsoft/azure-devops-go-api/azuredevops"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/core"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/operations"
 	"github.com/stretchr/testify/require"
@@ -139,6 +142,42 @@ func TestAzureDevOpsProject_CreateProject_PollsUntilOperationIsSuccessful(t *tes
 	require.Equal(t, nil, err)
 }
 
+// verifies that a failed asynchronous create operation is reported together with the message of the service
+func TestAzureDevOpsProject_CreateProject_ReportsFailedOperation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreClient := azdosdkmocks.NewMockCoreClient(ctrl)
+	operationsClient := azdosdkmocks.NewMockOperationsClient(ctrl)
+	clients := &aggregatedClient{
+		CoreClient:       coreClient,
+		OperationsClient: operationsClient,
+		ctx:              context.Background(),
+	}
+
+	expectedProjectCreateArgs := core.QueueCreateProjectArgs{ProjectToCreate: &testProject}
+	mockedOperationReference := operations.OperationReference{Id: &testID}
+	expectedOperationArgs := operations.GetOperationArgs{OperationId: &testID}
+
+	coreClient.
+		EXPECT().
+		QueueCreateProject(clients.ctx, expectedProjectCreateArgs).
+		Return(&mockedOperationReference, nil).
+		Times(1)
+
+	status := operationWithStatus(operations.OperationStatusValues.Failed)
+	status.ResultMessage = converter.String("Project name is already in use")
+	operationsClient.
+		EXPECT().
+		GetOperation(clients.ctx, expectedOperationArgs).
+		Return(&status, nil).
+		Times(1)
+
+	err := createProject(clients, &testProject, 5)
+	require.NotNil(t, err, "Expected error indicating the failed operation")
+	require.Contains(t, err.Error(), "Project name is already in use")
+}
+
 // verifies that if a project takes too long to create, an error is returned
 func TestAzureDevOpsProject_CreateProject_ReportsErrorIfNoSuccessForLongTime(t *testing.T) {
 	ctrl := gomock.NewController(t)
@@ -174,6 +213,167 @@ func TestAzureDevOpsProject_CreateProject_ReportsErrorIfNoSuccessForLongTime(t *
 	require.NotNil(t, err, "Expected error indicating timeout")
 }
 
+// verifies that polling for the operation status stops once the client context is cancelled
+func TestAzureDevOpsProject_CreateProject_StopsPollingWhenContextIsCancelled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreClient := azdosdkmocks.NewMockCoreClient(ctrl)
+	operationsClient := azdosdkmocks.NewMockOperationsClient(ctrl)
+	clients, cancel := (&aggregatedClient{
+		CoreClient:       coreClient,
+		OperationsClient: operationsClient,
+		ctx:              context.Background(),
+	}).withTimeout(time.Minute)
+	defer cancel()
+
+	expectedProjectCreateArgs := core.QueueCreateProjectArgs{ProjectToCreate: &testProject}
+	mockedOperationReference := operations.OperationReference{Id: &testID}
+
+	coreClient.
+		EXPECT().
+		QueueCreateProject(clients.ctx, expectedProjectCreateArgs).
+		DoAndReturn(func(ctx context.Context, args core.QueueCreateProjectArgs) (*operations.OperationReference, error) {
+			cancel()
+			return &mockedOperationReference, nil
+		}).
+		Times(1)
+
+	operationsClient.
+		EXPECT().
+		GetOperation(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	err := createProject(clients, &testProject, 60)
+	require.NotNil(t, err, "Expected error indicating cancellation")
+	require.Contains(t, err.Error(), "cancelled")
+}
+
+// verifies that the delete operation waits until the project is no longer known to the service
+func TestAzureDevOpsProject_DeleteProject_WaitsUntilProjectIsRemoved(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreClient := azdosdkmocks.NewMockCoreClient(ctrl)
+	operationsClient := azdosdkmocks.NewMockOperationsClient(ctrl)
+	clients := &aggregatedClient{
+		CoreClient:       coreClient,
+		OperationsClient: operationsClient,
+		ctx:              context.Background(),
+	}
+
+	mockedOperationReference := operations.OperationReference{Id: &testID}
+	coreClient.
+		EXPECT().
+		QueueDeleteProject(clients.ctx, core.QueueDeleteProjectArgs{ProjectId: &testID}).
+		Return(&mockedOperationReference, nil).
+		Times(1)
+
+	status := operationWithStatus(operations.OperationStatusValues.Succeeded)
+	operationsClient.
+		EXPECT().
+		GetOperation(clients.ctx, operations.GetOperationArgs{OperationId: &testID}).
+		Return(&status, nil).
+		Times(1)
+
+	deletingProject := testProject
+	deletingProject.State = &core.ProjectStateValues.Deleting
+	notFound := http.StatusNotFound
+	expectedProjectArgs := core.GetProjectArgs{ProjectId: converter.String(testID.String()), IncludeHistory: converter.Bool(false)}
+	gomock.InOrder(
+		coreClient.
+			EXPECT().
+			GetProject(clients.ctx, expectedProjectArgs).
+			Return(&deletingProject, nil),
+		coreClient.
+			EXPECT().
+			GetProject(clients.ctx, expectedProjectArgs).
+			Return(nil, azuredevops.WrappedError{StatusCode: &notFound}),
+	)
+
+	err := deleteProject(clients, testID.String(), 5)
+	require.Nil(t, err)
+}
+
+// verifies that a failed asynchronous delete operation is reported instead of being treated as success
+func TestAzureDevOpsProject_DeleteProject_ReportsFailedOperation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreClient := azdosdkmocks.NewMockCoreClient(ctrl)
+	operationsClient := azdosdkmocks.NewMockOperationsClient(ctrl)
+	clients := &aggregatedClient{
+		CoreClient:       coreClient,
+		OperationsClient: operationsClient,
+		ctx:              context.Background(),
+	}
+
+	mockedOperationReference := operations.OperationReference{Id: &testID}
+	coreClient.
+		EXPECT().
+		QueueDeleteProject(clients.ctx, core.QueueDeleteProjectArgs{ProjectId: &testID}).
+		Return(&mockedOperationReference, nil).
+		Times(1)
+
+	status := operationWithStatus(operations.OperationStatusValues.Failed)
+	status.ResultMessage = converter.String("Some repositories could not be deleted")
+	operationsClient.
+		EXPECT().
+		GetOperation(clients.ctx, operations.GetOperationArgs{OperationId: &testID}).
+		Return(&status, nil).
+		Times(1)
+
+	coreClient.
+		EXPECT().
+		GetProject(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	err := deleteProject(clients, testID.String(), 5)
+	require.NotNil(t, err, "Expected error indicating the failed operation")
+	require.Contains(t, err.Error(), "Some repositories could not be deleted")
+}
+
+// verifies that an error is returned if the project is not removed within the timeout
+func TestAzureDevOpsProject_DeleteProject_ReportsErrorIfProjectIsNotRemoved(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreClient := azdosdkmocks.NewMockCoreClient(ctrl)
+	operationsClient := azdosdkmocks.NewMockOperationsClient(ctrl)
+	clients := &aggregatedClient{
+		CoreClient:       coreClient,
+		OperationsClient: operationsClient,
+		ctx:              context.Background(),
+	}
+
+	mockedOperationReference := operations.OperationReference{Id: &testID}
+	coreClient.
+		EXPECT().
+		QueueDeleteProject(clients.ctx, core.QueueDeleteProjectArgs{ProjectId: &testID}).
+		Return(&mockedOperationReference, nil).
+		Times(1)
+
+	status := operationWithStatus(operations.OperationStatusValues.Succeeded)
+	operationsClient.
+		EXPECT().
+		GetOperation(clients.ctx, operations.GetOperationArgs{OperationId: &testID}).
+		Return(&status, nil).
+		Times(1)
+
+	// the project will forever be "deleting"
+	deletingProject := testProject
+	deletingProject.State = &core.ProjectStateValues.Deleting
+	coreClient.
+		EXPECT().
+		GetProject(clients.ctx, gomock.Any()).
+		Return(&deletingProject, nil).
+		MinTimes(1)
+
+	err := deleteProject(clients, testID.String(), 3)
+	require.NotNil(t, err, "Expected error indicating timeout")
+	require.Contains(t, err.Error(), "was not removed")
+}
+
 func TestAzureDevOpsProject_FlattenExpand_RoundTrip(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -209,11 +409,40 @@ func TestAzureDevOpsProject_FlattenExpand_RoundTrip(t *testing.T) {
 	err := flattenProject(clients, resourceData, &testProject)
 	require.Nil(t, err)
 
-	projectAfterRoundTrip, err := expandProject(clients, resourceData, true)
+	projectAfterRoundTrip, err := expandProject(clients, resourceData)
 	require.Nil(t, err)
 	require.Equal(t, testProject, *projectAfterRoundTrip)
 }
 
+// verifies that a project that was deleted outside of Terraform is removed from the state on read
+func TestAzureDevOpsProject_Read_ClearsIDIfProjectDoesNotExist(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreClient := azdosdkmocks.NewMockCoreClient(ctrl)
+	clients := &aggregatedClient{
+		CoreClient: coreClient,
+		ctx:        context.Background(),
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceProject().Schema, nil)
+	resourceData.SetId(testID.String())
+
+	statusCode := http.StatusNotFound
+	coreClient.
+		EXPECT().
+		GetProject(clients.ctx, gomock.Any()).
+		Return(nil, azuredevops.WrappedError{
+			StatusCode: &statusCode,
+			TypeKey:    converter.String("ProjectDoesNotExistException"),
+		}).
+		Times(1)
+
+	err := resourceProjectRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "", resourceData.Id())
+}
+
 // verifies that the project ID is used for reads if the ID is set
 func TestAzureDevOpsProject_ProjectRead_UsesIdIfSet(t *testing.T) {
 	ctrl := gomock.NewController(t)
@@ -266,6 +495,123 @@ func TestAzureDevOpsProject_ProjectRead_UsesNameIfIdNotSet(t *testing.T) {
 	projectRead(clients, id, name)
 }
 
+// Creates the resource data of a project that is changed from the state to the configuration
+func createProjectUpdateResourceData(t *testing.T, config map[string]interface{}) (*schema.ResourceData, *terraform.InstanceDiff) {
+	projectSchema := schema.InternalMap(resourceProject().Schema)
+	state := &terraform.InstanceState{
+		ID: testID.String(),
+		Attributes: map[string]string{
+			"project_name":        "Name",
+			"description":         "Description",
+			"visibility":          "private",
+			"version_control":     "Git",
+			"work_item_template":  "Agile",
+			"process_template_id": testID.String(),
+		},
+	}
+	diff, err := projectSchema.Diff(state, terraform.NewResourceConfigRaw(config), nil, nil, true)
+	require.Nil(t, err)
+	resourceData, err := projectSchema.Data(state, diff)
+	require.Nil(t, err)
+	return resourceData, diff
+}
+
+// verifies that only the changed attributes are updated, and that a rename is sent on its own
+func TestAzureDevOpsProject_ExpandProjectUpdates_SendsOnlyChangedAttributes(t *testing.T) {
+	resourceData, _ := createProjectUpdateResourceData(t, map[string]interface{}{
+		"project_name": "Renamed",
+		"description":  "Description",
+		"visibility":   "public",
+	})
+
+	updates := expandProjectUpdates(resourceData, testID)
+	require.Equal(t, []*core.TeamProject{
+		{Id: &testID, Name: converter.String("Renamed")},
+		{Id: &testID, Visibility: &core.ProjectVisibilityValues.Public},
+	}, updates)
+
+	resourceData, _ = createProjectUpdateResourceData(t, map[string]interface{}{
+		"project_name": "Name",
+		"description":  "Changed",
+	})
+
+	updates = expandProjectUpdates(resourceData, testID)
+	require.Equal(t, []*core.TeamProject{
+		{Id: &testID, Description: converter.String("Changed")},
+	}, updates)
+}
+
+// verifies that the visibility of a project is changed in place and that the update operation is awaited
+func TestAzureDevOpsProject_Update_ChangesVisibilityInPlace(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreClient := azdosdkmocks.NewMockCoreClient(ctrl)
+	operationsClient := azdosdkmocks.NewMockOperationsClient(ctrl)
+	clients := &aggregatedClient{
+		CoreClient:       coreClient,
+		OperationsClient: operationsClient,
+		ctx:              context.Background(),
+	}
+
+	resourceData, diff := createProjectUpdateResourceData(t, map[string]interface{}{
+		"project_name": "Name",
+		"description":  "Description",
+		"visibility":   "public",
+	})
+	require.False(t, diff.RequiresNew())
+
+	operationRef := operations.OperationReference{Id: &testID}
+	coreClient.
+		EXPECT().
+		UpdateProject(gomock.Any(), core.UpdateProjectArgs{
+			ProjectUpdate: &core.TeamProject{Id: &testID, Visibility: &core.ProjectVisibilityValues.Public},
+			ProjectId:     &testID,
+		}).
+		Return(&operationRef, nil).
+		Times(1)
+
+	firstStatus := operationWithStatus(operations.OperationStatusValues.InProgress)
+	secondStatus := operationWithStatus(operations.OperationStatusValues.Succeeded)
+	gomock.InOrder(
+		operationsClient.EXPECT().GetOperation(gomock.Any(), gomock.Any()).Return(&firstStatus, nil).Times(1),
+		operationsClient.EXPECT().GetOperation(gomock.Any(), gomock.Any()).Return(&secondStatus, nil).Times(1),
+	)
+
+	coreClient.
+		EXPECT().
+		GetProject(gomock.Any(), gomock.Any()).
+		Return(&testProject, nil).
+		Times(1)
+	coreClient.
+		EXPECT().
+		GetProcessById(gomock.Any(), core.GetProcessByIdArgs{ProcessId: &testID}).
+		Return(&core.Process{Name: converter.String("Agile"), Id: &testID}, nil).
+		Times(1)
+
+	err := resourceProjectUpdate(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "public", resourceData.Get("visibility"))
+}
+
+// verifies that the version control of a project cannot be changed in place, but only by creating a new project
+func TestAzureDevOpsProject_Diff_ChangingVersionControlForcesNewProject(t *testing.T) {
+	_, diff := createProjectUpdateResourceData(t, map[string]interface{}{
+		"project_name":    "Name",
+		"description":     "Description",
+		"version_control": "Tfvc",
+	})
+	require.True(t, diff.RequiresNew())
+
+	// the version control is not case sensitive
+	_, diff = createProjectUpdateResourceData(t, map[string]interface{}{
+		"project_name":    "Name",
+		"description":     "Description",
+		"version_control": "git",
+	})
+	require.False(t, diff.RequiresNew())
+}
+
 // creates an operation given a status
 func operationWithStatus(status operations.OperationStatus) operations.Operation {
 	return operations.Operation{Status: &status}
@@ -0,0 +1,120 @@
+package azuredevops
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/build"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/azdoerror"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+)
+
+// Unlike azuredevops_pipeline_authorization, which only exists while the resource is authorized, the
+// authorization of a resource for a classic build definition is kept in the state either way, so that it
+// can be revoked by setting authorized to false.
+func resourceResourceAuthorization() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceResourceAuthorizationCreateOrUpdate,
+		Read:   resourceResourceAuthorizationRead,
+		Update: resourceResourceAuthorizationCreateOrUpdate,
+		Delete: resourceResourceAuthorizationDelete,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"resource_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  pipelineResourceTypeEndpoint,
+				ValidateFunc: validation.StringInSlice([]string{
+					pipelineResourceTypeEndpoint,
+					pipelineResourceTypeVariableGroup,
+					pipelineResourceTypeQueue,
+				}, false),
+			},
+			"definition_id": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"authorized": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceResourceAuthorizationCreateOrUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	if err := authorizeDefinitionResource(clients, d, d.Get("authorized").(bool)); err != nil {
+		return fmt.Errorf("Error updating the authorization of %s %s for definition %d. Error: %v",
+			d.Get("type").(string), d.Get("resource_id").(string), d.Get("definition_id").(int), err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s/%d",
+		d.Get("project_id").(string), d.Get("type").(string), d.Get("resource_id").(string), d.Get("definition_id").(int)))
+	return resourceResourceAuthorizationRead(d, m)
+}
+
+func resourceResourceAuthorizationRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	projectID := d.Get("project_id").(string)
+	definitionID := d.Get("definition_id").(int)
+	resourceType := d.Get("type").(string)
+	resourceID := d.Get("resource_id").(string)
+
+	references, err := clients.BuildClient.GetDefinitionResources(clients.ctx, build.GetDefinitionResourcesArgs{
+		Project:      &projectID,
+		DefinitionId: &definitionID,
+	})
+	if err != nil {
+		if azdoerror.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error looking up the resources of definition %d. Error: %v", definitionID, err)
+	}
+
+	d.Set("authorized", isPipelineResourceAuthorized(references, resourceType, resourceID))
+	return nil
+}
+
+// The authorization is revoked when the resource is destroyed
+func resourceResourceAuthorizationDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	err := authorizeDefinitionResource(clients, d, false)
+	if err != nil && !azdoerror.IsNotFound(err) {
+		return fmt.Errorf("Error revoking the authorization of %s %s for definition %d. Error: %v",
+			d.Get("type").(string), d.Get("resource_id").(string), d.Get("definition_id").(int), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func authorizeDefinitionResource(clients *aggregatedClient, d *schema.ResourceData, authorized bool) error {
+	projectID := d.Get("project_id").(string)
+	_, err := clients.BuildClient.AuthorizeDefinitionResources(clients.ctx, build.AuthorizeDefinitionResourcesArgs{
+		Resources: &[]build.DefinitionResourceReference{{
+			Id:         converter.String(d.Get("resource_id").(string)),
+			Type:       converter.String(d.Get("type").(string)),
+			Authorized: converter.Bool(authorized),
+		}},
+		Project:      &projectID,
+		DefinitionId: converter.Int(d.Get("definition_id").(int)),
+	})
+	return err
+}
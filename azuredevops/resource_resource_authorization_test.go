@@ -0,0 +1,190 @@
+package azuredevops
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/build"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var testResourceAuthorizationProjectID = "project-id"
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that the resource is authorized for the definition
+func TestAzureDevOpsResourceAuthorization_Create_AuthorizesDefinition(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	buildClient := azdosdkmocks.NewMockBuildClient(ctrl)
+	clients := &aggregatedClient{BuildClient: buildClient, ctx: context.Background()}
+
+	resourceData := createResourceAuthorizationResourceData(t, true)
+
+	expectAuthorizeDefinitionResources(buildClient, true).Return(nil, nil).Times(1)
+	expectGetDefinitionResources(buildClient, converter.Bool(true)).Times(1)
+
+	err := resourceResourceAuthorizationCreateOrUpdate(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "project-id/endpoint/endpoint-id/42", resourceData.Id())
+	require.True(t, resourceData.Get("authorized").(bool))
+}
+
+// verifies that setting authorized to false revokes the authorization without removing the resource
+func TestAzureDevOpsResourceAuthorization_Update_RevokesAuthorization(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	buildClient := azdosdkmocks.NewMockBuildClient(ctrl)
+	clients := &aggregatedClient{BuildClient: buildClient, ctx: context.Background()}
+
+	resourceData := createResourceAuthorizationResourceData(t, false)
+	resourceData.SetId("project-id/endpoint/endpoint-id/42")
+
+	expectAuthorizeDefinitionResources(buildClient, false).Return(nil, nil).Times(1)
+	expectGetDefinitionResources(buildClient, converter.Bool(false)).Times(1)
+
+	err := resourceResourceAuthorizationCreateOrUpdate(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "project-id/endpoint/endpoint-id/42", resourceData.Id())
+	require.False(t, resourceData.Get("authorized").(bool))
+}
+
+// verifies that an authorization changed outside of Terraform is detected
+func TestAzureDevOpsResourceAuthorization_Read_ReconcilesAuthorization(t *testing.T) {
+	for _, authorized := range []*bool{converter.Bool(false), nil} {
+		ctrl := gomock.NewController(t)
+
+		buildClient := azdosdkmocks.NewMockBuildClient(ctrl)
+		clients := &aggregatedClient{BuildClient: buildClient, ctx: context.Background()}
+
+		resourceData := createResourceAuthorizationResourceData(t, true)
+		resourceData.SetId("project-id/endpoint/endpoint-id/42")
+
+		expectGetDefinitionResources(buildClient, authorized).Times(1)
+
+		err := resourceResourceAuthorizationRead(resourceData, clients)
+		require.Nil(t, err)
+		require.Equal(t, "project-id/endpoint/endpoint-id/42", resourceData.Id())
+		require.False(t, resourceData.Get("authorized").(bool))
+		ctrl.Finish()
+	}
+}
+
+// verifies that the authorization is revoked on delete, and that errors are not swallowed
+func TestAzureDevOpsResourceAuthorization_Delete_RevokesAuthorization(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	buildClient := azdosdkmocks.NewMockBuildClient(ctrl)
+	clients := &aggregatedClient{BuildClient: buildClient, ctx: context.Background()}
+
+	resourceData := createResourceAuthorizationResourceData(t, true)
+	resourceData.SetId("project-id/endpoint/endpoint-id/42")
+
+	expectAuthorizeDefinitionResources(buildClient, false).
+		Return(nil, errors.New("AuthorizeDefinitionResources() Failed")).
+		Times(1)
+
+	err := resourceResourceAuthorizationDelete(resourceData, clients)
+	require.Contains(t, err.Error(), "AuthorizeDefinitionResources() Failed")
+}
+
+func createResourceAuthorizationResourceData(t *testing.T, authorized bool) *schema.ResourceData {
+	return schema.TestResourceDataRaw(t, resourceResourceAuthorization().Schema, map[string]interface{}{
+		"project_id":    testResourceAuthorizationProjectID,
+		"resource_id":   "endpoint-id",
+		"definition_id": 42,
+		"authorized":    authorized,
+	})
+}
+
+func expectAuthorizeDefinitionResources(buildClient *azdosdkmocks.MockBuildClient, authorized bool) *gomock.Call {
+	return buildClient.
+		EXPECT().
+		AuthorizeDefinitionResources(gomock.Any(), build.AuthorizeDefinitionResourcesArgs{
+			Resources: &[]build.DefinitionResourceReference{{
+				Id:         converter.String("endpoint-id"),
+				Type:       converter.String(pipelineResourceTypeEndpoint),
+				Authorized: converter.Bool(authorized),
+			}},
+			Project:      &testResourceAuthorizationProjectID,
+			DefinitionId: converter.Int(42),
+		})
+}
+
+// Expects a lookup of the resources of the definition, which only lists the endpoint if authorized is set
+func expectGetDefinitionResources(buildClient *azdosdkmocks.MockBuildClient, authorized *bool) *gomock.Call {
+	references := []build.DefinitionResourceReference{}
+	if authorized != nil {
+		references = append(references, build.DefinitionResourceReference{
+			Id:         converter.String("endpoint-id"),
+			Type:       converter.String(pipelineResourceTypeEndpoint),
+			Authorized: authorized,
+		})
+	}
+	return buildClient.
+		EXPECT().
+		GetDefinitionResources(gomock.Any(), build.GetDefinitionResourcesArgs{
+			Project:      &testResourceAuthorizationProjectID,
+			DefinitionId: converter.Int(42),
+		}).
+		Return(&references, nil)
+}
+
+/**
+ * Begin acceptance tests
+ */
+
+// Verifies that a service endpoint can be authorized for a build definition, and that the authorization can be revoked
+func TestAccAzureDevOpsResourceAuthorization_CreateAndUpdate(t *testing.T) {
+	projectName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	buildDefinitionName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	tfNode := "azuredevops_resource_authorization.authorization"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceAuthorizationResource(projectName, buildDefinitionName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(tfNode, "resource_id", "azuredevops_serviceendpoint_github.serviceendpoint", "id"),
+					resource.TestCheckResourceAttrPair(tfNode, "definition_id", "azuredevops_build_definition.build", "id"),
+					resource.TestCheckResourceAttr(tfNode, "authorized", "true"),
+				),
+			}, {
+				Config: testAccResourceAuthorizationResource(projectName, buildDefinitionName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "authorized", "false"),
+				),
+			},
+		},
+	})
+}
+
+// HCL describing the authorization of the GitHub service endpoint for a build definition
+func testAccResourceAuthorizationResource(projectName string, buildDefinitionName string, authorized bool) string {
+	authorizationResource := fmt.Sprintf(`
+resource "azuredevops_resource_authorization" "authorization" {
+	project_id    = azuredevops_project.project.id
+	resource_id   = azuredevops_serviceendpoint_github.serviceendpoint.id
+	type          = "endpoint"
+	definition_id = azuredevops_build_definition.build.id
+	authorized    = %t
+}`, authorized)
+
+	buildDefinitionResource := testAccBuildDefinitionResource(projectName, buildDefinitionName)
+	return fmt.Sprintf("%s\n%s", buildDefinitionResource, authorizationResource)
+}
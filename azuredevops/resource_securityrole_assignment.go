@@ -0,0 +1,163 @@
+package azuredevops
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/azdoerror"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/securityroles"
+)
+
+func resourceSecurityRoleAssignment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSecurityRoleAssignmentCreateOrUpdate,
+		Read:   resourceSecurityRoleAssignmentRead,
+		Update: resourceSecurityRoleAssignmentCreateOrUpdate,
+		Delete: resourceSecurityRoleAssignmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceSecurityRoleAssignmentImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"scope": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"resource_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"identity_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"role_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+}
+
+// Assigning a role replaces the role the identity held on the object before
+func resourceSecurityRoleAssignmentCreateOrUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	scope, resourceID, identityID, err := parseSecurityRoleAssignment(d)
+	if err != nil {
+		return err
+	}
+
+	_, err = clients.SecurityRolesClient.SetRoleAssignments(clients.ctx, securityroles.SetRoleAssignmentsArgs{
+		RoleAssignments: &[]securityroles.UserRoleAssignmentRef{{
+			UserId:   &identityID,
+			RoleName: converter.String(d.Get("role_name").(string)),
+		}},
+		Scope:      &scope,
+		ResourceId: &resourceID,
+	})
+	if err != nil {
+		return fmt.Errorf("Error assigning role %s to identity %s on %s %s. Error: %v", d.Get("role_name").(string), identityID, scope, resourceID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", scope, resourceID, identityID))
+	return resourceSecurityRoleAssignmentRead(d, m)
+}
+
+// The assignment is removed from the state once the identity only holds an inherited role, or no role at all
+func resourceSecurityRoleAssignmentRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	scope, resourceID, identityID, err := parseSecurityRoleAssignment(d)
+	if err != nil {
+		return err
+	}
+
+	assignments, err := clients.SecurityRolesClient.ListRoleAssignments(clients.ctx, securityroles.ListRoleAssignmentsArgs{
+		Scope:      &scope,
+		ResourceId: &resourceID,
+	})
+	if err != nil {
+		if azdoerror.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error looking up the roles assigned on %s %s. Error: %v", scope, resourceID, err)
+	}
+
+	assignment := findSecurityRoleAssignment(assignments, identityID)
+	if assignment == nil || !strings.EqualFold(converter.ToString(assignment.Access, ""), securityroles.AccessAssigned) {
+		d.SetId("")
+		return nil
+	}
+
+	if assignment.Role != nil {
+		d.Set("role_name", converter.ToString(assignment.Role.Name, ""))
+	}
+	return nil
+}
+
+// Removing the assignment leaves the identity with the role it inherits from the parent of the object, if any
+func resourceSecurityRoleAssignmentDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	scope, resourceID, identityID, err := parseSecurityRoleAssignment(d)
+	if err != nil {
+		return err
+	}
+
+	err = clients.SecurityRolesClient.RemoveRoleAssignments(clients.ctx, securityroles.RemoveRoleAssignmentsArgs{
+		IdentityIds: &[]uuid.UUID{identityID},
+		Scope:       &scope,
+		ResourceId:  &resourceID,
+	})
+	if err != nil && !azdoerror.IsNotFound(err) {
+		return fmt.Errorf("Error removing the role of identity %s on %s %s. Error: %v", identityID, scope, resourceID, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// Imports a role assignment given an ID of the form <scope>/<resourceID>/<identityID>
+func resourceSecurityRoleAssignmentImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%s), expected scope/resourceid/identityid", d.Id())
+	}
+	if _, err := uuid.Parse(parts[2]); err != nil {
+		return nil, fmt.Errorf("Identity ID (%s) is not a valid UUID", parts[2])
+	}
+
+	d.Set("scope", parts[0])
+	d.Set("resource_id", parts[1])
+	d.Set("identity_id", parts[2])
+	return []*schema.ResourceData{d}, nil
+}
+
+func parseSecurityRoleAssignment(d *schema.ResourceData) (string, string, uuid.UUID, error) {
+	identityID, err := uuid.Parse(d.Get("identity_id").(string))
+	if err != nil {
+		return "", "", uuid.Nil, fmt.Errorf("Error parsing the identity ID %s: %v", d.Get("identity_id").(string), err)
+	}
+	return d.Get("scope").(string), d.Get("resource_id").(string), identityID, nil
+}
+
+func findSecurityRoleAssignment(assignments *[]securityroles.RoleAssignment, identityID uuid.UUID) *securityroles.RoleAssignment {
+	if assignments == nil {
+		return nil
+	}
+	for _, assignment := range *assignments {
+		if assignment.Identity != nil && strings.EqualFold(converter.ToString(assignment.Identity.Id, ""), identityID.String()) {
+			return &assignment
+		}
+	}
+	return nil
+}
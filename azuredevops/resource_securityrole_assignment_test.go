@@ -0,0 +1,213 @@
+package azuredevops
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/securityroles"
+	"github.com/stretchr/testify/require"
+)
+
+var testSecurityRoleScope = "distributedtask.agentpoolrole"
+var testSecurityRoleResourceID = "12"
+var testSecurityRoleIdentityID = uuid.New()
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that the role is assigned to the identity on the object
+func TestAzureDevOpsSecurityRoleAssignment_Create_AssignsRole(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	rolesClient := azdosdkmocks.NewMockSecurityRolesClient(ctrl)
+	clients := &aggregatedClient{SecurityRolesClient: rolesClient, ctx: context.Background()}
+
+	resourceData := createSecurityRoleAssignmentResourceData(t, "Administrator")
+
+	rolesClient.
+		EXPECT().
+		SetRoleAssignments(clients.ctx, securityroles.SetRoleAssignmentsArgs{
+			RoleAssignments: &[]securityroles.UserRoleAssignmentRef{{
+				UserId:   &testSecurityRoleIdentityID,
+				RoleName: converter.String("Administrator"),
+			}},
+			Scope:      &testSecurityRoleScope,
+			ResourceId: &testSecurityRoleResourceID,
+		}).
+		Return(nil, nil).
+		Times(1)
+	expectListRoleAssignments(rolesClient, securityroles.AccessAssigned, "Administrator")
+
+	err := resourceSecurityRoleAssignmentCreateOrUpdate(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, testSecurityRoleScope+"/12/"+testSecurityRoleIdentityID.String(), resourceData.Id())
+}
+
+// verifies that a role changed outside of Terraform is detected
+func TestAzureDevOpsSecurityRoleAssignment_Read_ReconcilesRole(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	rolesClient := azdosdkmocks.NewMockSecurityRolesClient(ctrl)
+	clients := &aggregatedClient{SecurityRolesClient: rolesClient, ctx: context.Background()}
+
+	resourceData := createSecurityRoleAssignmentResourceData(t, "Administrator")
+	resourceData.SetId("assignment")
+
+	expectListRoleAssignments(rolesClient, securityroles.AccessAssigned, "User")
+
+	err := resourceSecurityRoleAssignmentRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "User", resourceData.Get("role_name"))
+}
+
+// verifies that an assignment which was removed, and only leaves an inherited role, is removed from the state
+func TestAzureDevOpsSecurityRoleAssignment_Read_ClearsIdIfRoleIsInherited(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	rolesClient := azdosdkmocks.NewMockSecurityRolesClient(ctrl)
+	clients := &aggregatedClient{SecurityRolesClient: rolesClient, ctx: context.Background()}
+
+	resourceData := createSecurityRoleAssignmentResourceData(t, "Administrator")
+	resourceData.SetId("assignment")
+
+	expectListRoleAssignments(rolesClient, securityroles.AccessInherited, "Administrator")
+
+	err := resourceSecurityRoleAssignmentRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "", resourceData.Id())
+}
+
+// verifies that the assignment is removed on delete, and that errors are not swallowed
+func TestAzureDevOpsSecurityRoleAssignment_Delete_RemovesAssignment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	rolesClient := azdosdkmocks.NewMockSecurityRolesClient(ctrl)
+	clients := &aggregatedClient{SecurityRolesClient: rolesClient, ctx: context.Background()}
+
+	resourceData := createSecurityRoleAssignmentResourceData(t, "Administrator")
+	resourceData.SetId("assignment")
+
+	rolesClient.
+		EXPECT().
+		RemoveRoleAssignments(clients.ctx, securityroles.RemoveRoleAssignmentsArgs{
+			IdentityIds: &[]uuid.UUID{testSecurityRoleIdentityID},
+			Scope:       &testSecurityRoleScope,
+			ResourceId:  &testSecurityRoleResourceID,
+		}).
+		Return(errors.New("RemoveRoleAssignments() Failed")).
+		Times(1)
+
+	err := resourceSecurityRoleAssignmentDelete(resourceData, clients)
+	require.Contains(t, err.Error(), "RemoveRoleAssignments() Failed")
+}
+
+// verifies that an assignment can be imported by its scope, object and identity
+func TestAzureDevOpsSecurityRoleAssignment_Import_ParsesID(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, resourceSecurityRoleAssignment().Schema, nil)
+	resourceData.SetId(testSecurityRoleScope + "/12/" + testSecurityRoleIdentityID.String())
+
+	imported, err := resourceSecurityRoleAssignmentImport(resourceData, nil)
+	require.Nil(t, err)
+	require.Equal(t, testSecurityRoleScope, imported[0].Get("scope"))
+	require.Equal(t, "12", imported[0].Get("resource_id"))
+	require.Equal(t, testSecurityRoleIdentityID.String(), imported[0].Get("identity_id"))
+
+	for _, id := range []string{testSecurityRoleScope + "/12", testSecurityRoleScope + "/12/not-a-uuid"} {
+		resourceData.SetId(id)
+		_, err = resourceSecurityRoleAssignmentImport(resourceData, nil)
+		require.NotNil(t, err, id)
+	}
+}
+
+func createSecurityRoleAssignmentResourceData(t *testing.T, roleName string) *schema.ResourceData {
+	return schema.TestResourceDataRaw(t, resourceSecurityRoleAssignment().Schema, map[string]interface{}{
+		"scope":       testSecurityRoleScope,
+		"resource_id": testSecurityRoleResourceID,
+		"identity_id": testSecurityRoleIdentityID.String(),
+		"role_name":   roleName,
+	})
+}
+
+func expectListRoleAssignments(rolesClient *azdosdkmocks.MockSecurityRolesClient, access string, roleName string) *gomock.Call {
+	return rolesClient.
+		EXPECT().
+		ListRoleAssignments(gomock.Any(), securityroles.ListRoleAssignmentsArgs{
+			Scope:      &testSecurityRoleScope,
+			ResourceId: &testSecurityRoleResourceID,
+		}).
+		Return(&[]securityroles.RoleAssignment{{
+			Access:   converter.String(access),
+			Identity: &securityroles.IdentityRef{Id: converter.String(testSecurityRoleIdentityID.String())},
+			Role:     &securityroles.SecurityRole{Name: converter.String(roleName)},
+		}}, nil).
+		Times(1)
+}
+
+/**
+ * Begin acceptance tests
+ */
+
+// Validates that a role on an agent pool can be assigned and changed. The ID of an existing identity of the
+// organization is read from the AZDO_TEST_IDENTITY_ID environment variable.
+func TestAccAzureDevOpsSecurityRoleAssignment_AgentPool(t *testing.T) {
+	identityID := os.Getenv("AZDO_TEST_IDENTITY_ID")
+	poolName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	tfNode := "azuredevops_securityrole_assignment.assignment"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			if identityID == "" {
+				t.Skip("AZDO_TEST_IDENTITY_ID must be set for this acceptance test")
+			}
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccAgentPoolCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecurityRoleAssignmentResource(poolName, identityID, "Reader"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(tfNode, "resource_id", "azuredevops_agent_pool.pool", "id"),
+					resource.TestCheckResourceAttr(tfNode, "role_name", "Reader"),
+				),
+			}, {
+				Config: testAccSecurityRoleAssignmentResource(poolName, identityID, "Administrator"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "role_name", "Administrator"),
+				),
+			}, {
+				ResourceName:      tfNode,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// HCL describing a role assigned to an identity on an agent pool
+func testAccSecurityRoleAssignmentResource(poolName string, identityID string, roleName string) string {
+	assignmentResource := fmt.Sprintf(`
+resource "azuredevops_securityrole_assignment" "assignment" {
+	scope       = "distributedtask.agentpoolrole"
+	resource_id = azuredevops_agent_pool.pool.id
+	identity_id = "%s"
+	role_name   = "%s"
+}`, identityID, roleName)
+
+	return fmt.Sprintf("%s\n%s", testAccAgentPoolResource(poolName, false), assignmentResource)
+}
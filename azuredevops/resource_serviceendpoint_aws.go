@@ -0,0 +1,90 @@
+package azuredevops
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/secretmemo"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/tfhelper"
+)
+
+func resourceServiceEndpointAws() *schema.Resource {
+	r := genServiceEndpointResourceFromArgs(serviceEndpointAwsArgs)
+
+	r.Schema["access_key_id"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		DefaultFunc:  schema.EnvDefaultFunc("AZDO_AWS_SERVICE_CONNECTION_ACCESS_KEY_ID", nil),
+		Description:  "The AWS access key ID for signing programmatic requests.",
+		ValidateFunc: validation.NoZeroValues,
+	}
+
+	secretHashKey, secretHashSchema := tfhelper.GenerateSecreteMemoSchema("secret_access_key")
+	r.Schema["secret_access_key"] = &schema.Schema{
+		Type:             schema.TypeString,
+		Required:         true,
+		DefaultFunc:      schema.EnvDefaultFunc("AZDO_AWS_SERVICE_CONNECTION_SECRET_ACCESS_KEY", nil),
+		Description:      "The AWS secret access key for signing programmatic requests.",
+		Sensitive:        true,
+		DiffSuppressFunc: tfhelper.DiffFuncSupressSecretChanged,
+	}
+	r.Schema[secretHashKey] = secretHashSchema
+
+	sessionHashKey, sessionHashSchema := tfhelper.GenerateSecreteMemoSchema("session_token")
+	r.Schema["session_token"] = &schema.Schema{
+		Type:             schema.TypeString,
+		Optional:         true,
+		DefaultFunc:      schema.EnvDefaultFunc("AZDO_AWS_SERVICE_CONNECTION_SESSION_TOKEN", nil),
+		Description:      "The AWS session token for signing programmatic requests.",
+		Sensitive:        true,
+		DiffSuppressFunc: tfhelper.DiffFuncSupressSecretChanged,
+	}
+	r.Schema[sessionHashKey] = sessionHashSchema
+
+	r.Schema["role_to_assume"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Default:     "",
+		Description: "The Amazon Resource Name (ARN) of the role to assume.",
+	}
+	r.Schema["role_session_name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Default:     "",
+		Description: "Optional identifier for the assumed role session.",
+	}
+	r.Schema["external_id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Default:     "",
+		Description: "A unique identifier that is used by third parties when assuming roles in their customers' accounts, aka cross-account role access.",
+	}
+
+	return r
+}
+
+var serviceEndpointAwsArgs = &serviceEndpointCRUDArgs{
+	endpointType: "aws",
+	authScheme:   "UsernamePassword",
+	url:          "https://aws.amazon.com/",
+	expandParameters: func(d *schema.ResourceData) (map[string]string, map[string]string) {
+		return map[string]string{
+			"username":        d.Get("access_key_id").(string),
+			"password":        d.Get("secret_access_key").(string),
+			"sessionToken":    d.Get("session_token").(string),
+			"assumeRoleArn":   d.Get("role_to_assume").(string),
+			"roleSessionName": d.Get("role_session_name").(string),
+			"externalId":      d.Get("external_id").(string),
+		}, nil
+	},
+	flattenParameters: func(d *schema.ResourceData, parameters map[string]string, data map[string]string, secretSettings *secretmemo.Settings) {
+		d.Set("access_key_id", parameters["username"])
+		d.Set("role_to_assume", parameters["assumeRoleArn"])
+		d.Set("role_session_name", parameters["roleSessionName"])
+		d.Set("external_id", parameters["externalId"])
+
+		tfhelper.HelpFlattenSecret(d, "secret_access_key", secretSettings)
+		tfhelper.HelpFlattenSecret(d, "session_token", secretSettings)
+		d.Set("secret_access_key", parameters["password"])
+		d.Set("session_token", parameters["sessionToken"])
+	},
+}
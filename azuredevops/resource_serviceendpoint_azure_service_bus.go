@@ -0,0 +1,52 @@
+package azuredevops
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/secretmemo"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/tfhelper"
+)
+
+func resourceServiceEndpointAzureServiceBus() *schema.Resource {
+	r := genServiceEndpointResourceFromArgs(serviceEndpointAzureServiceBusArgs)
+
+	connectionStringHashKey, connectionStringHashSchema := tfhelper.GenerateSecreteMemoSchema("connection_string")
+	r.Schema["connection_string"] = &schema.Schema{
+		Type:             schema.TypeString,
+		Required:         true,
+		Description:      "The connection string of the Service Bus namespace or queue, including its shared access key.",
+		Sensitive:        true,
+		DiffSuppressFunc: tfhelper.DiffFuncSupressSecretChanged,
+		ValidateFunc:     validation.NoZeroValues,
+	}
+	r.Schema[connectionStringHashKey] = connectionStringHashSchema
+
+	r.Schema["queue_name"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		Description:  "The name of the queue messages are published to.",
+		ValidateFunc: validation.NoZeroValues,
+	}
+
+	return r
+}
+
+// Azure Service Bus endpoints hold the connection string and the queue as the parameters of an authorization
+// without a scheme. The service never returns the connection string, so only the queue is read back.
+var serviceEndpointAzureServiceBusArgs = &serviceEndpointCRUDArgs{
+	endpointType: "AzureServiceBus",
+	authScheme:   "None",
+	url:          "https://servicebus.windows.net/",
+	expandParameters: func(d *schema.ResourceData) (map[string]string, map[string]string) {
+		return map[string]string{
+			"serviceBusConnectionString": d.Get("connection_string").(string),
+			"serviceBusQueueName":        d.Get("queue_name").(string),
+		}, nil
+	},
+	flattenParameters: func(d *schema.ResourceData, parameters map[string]string, data map[string]string, secretSettings *secretmemo.Settings) {
+		d.Set("queue_name", parameters["serviceBusQueueName"])
+
+		tfhelper.HelpFlattenSecret(d, "connection_string", secretSettings)
+		d.Set("connection_string", parameters["serviceBusConnectionString"])
+	},
+}
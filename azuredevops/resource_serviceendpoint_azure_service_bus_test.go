@@ -0,0 +1,191 @@
+package azuredevops
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/serviceendpoint"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/tfhelper"
+	"github.com/stretchr/testify/require"
+)
+
+var azureServiceBusTestServiceEndpointID = uuid.New()
+var azureServiceBusRandomServiceEndpointProjectID = uuid.New().String()
+var azureServiceBusTestServiceEndpointProjectID = &azureServiceBusRandomServiceEndpointProjectID
+
+const azureServiceBusTestConnectionString = "Endpoint=sb://namespace.servicebus.windows.net/;SharedAccessKeyName=send;SharedAccessKey=c2VjcmV0"
+
+var azureServiceBusTestServiceEndpoint = serviceendpoint.ServiceEndpoint{
+	Authorization: &serviceendpoint.EndpointAuthorization{
+		Parameters: &map[string]string{
+			"serviceBusConnectionString": azureServiceBusTestConnectionString,
+			"serviceBusQueueName":        "notifications",
+		},
+		Scheme: converter.String("None"),
+	},
+	Id:    &azureServiceBusTestServiceEndpointID,
+	Name:  converter.String("UNIT_TEST_NAME"),
+	Owner: converter.String("library"),
+	Type:  converter.String("AzureServiceBus"),
+	Url:   converter.String("https://servicebus.windows.net/"),
+}
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that the flatten/expand round trip yields the same service endpoint
+func TestAzureDevOpsServiceEndpointAzureServiceBus_ExpandFlatten_Roundtrip(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, resourceServiceEndpointAzureServiceBus().Schema, nil)
+	serviceEndpointAzureServiceBusArgs.flatten(resourceData, &azureServiceBusTestServiceEndpoint, azureServiceBusTestServiceEndpointProjectID, nil)
+
+	serviceEndpointAfterRoundTrip, projectID := serviceEndpointAzureServiceBusArgs.expand(resourceData)
+
+	require.Equal(t, azureServiceBusTestServiceEndpoint, *serviceEndpointAfterRoundTrip)
+	require.Equal(t, azureServiceBusTestServiceEndpointProjectID, projectID)
+}
+
+// verifies that a queue changed outside of Terraform is detected, while the connection string, which the
+// service does not return, does not produce a diff
+func TestAzureDevOpsServiceEndpointAzureServiceBus_Read_ReconcilesQueueName(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := resourceServiceEndpointAzureServiceBus()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"project_id":            azureServiceBusRandomServiceEndpointProjectID,
+		"service_endpoint_name": "UNIT_TEST_NAME",
+		"connection_string":     azureServiceBusTestConnectionString,
+		"queue_name":            "notifications",
+	})
+	resourceData.SetId(azureServiceBusTestServiceEndpointID.String())
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &aggregatedClient{ServiceEndpointClient: buildClient, ctx: context.Background()}
+
+	changedEndpoint := azureServiceBusTestServiceEndpoint
+	changedEndpoint.Authorization = &serviceendpoint.EndpointAuthorization{
+		Parameters: &map[string]string{"serviceBusQueueName": "alerts"},
+		Scheme:     converter.String("None"),
+	}
+	buildClient.
+		EXPECT().
+		GetServiceEndpointDetails(clients.ctx, gomock.Any()).
+		Return(&changedEndpoint, nil).
+		Times(1)
+
+	err := r.Read(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "alerts", resourceData.Get("queue_name"))
+	require.NotEmpty(t, resourceData.Get("connection_string_hash"))
+	require.True(t, tfhelper.DiffFuncSupressSecretChanged("connection_string", "", azureServiceBusTestConnectionString, resourceData))
+	require.False(t, tfhelper.DiffFuncSupressSecretChanged("connection_string", "", "Endpoint=sb://other.servicebus.windows.net/", resourceData))
+}
+
+// verifies that if an error is produced on create, the error is not swallowed
+func TestAzureDevOpsServiceEndpointAzureServiceBus_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := resourceServiceEndpointAzureServiceBus()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	serviceEndpointAzureServiceBusArgs.flatten(resourceData, &azureServiceBusTestServiceEndpoint, azureServiceBusTestServiceEndpointProjectID, nil)
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &aggregatedClient{ServiceEndpointClient: buildClient, ctx: context.Background()}
+
+	expectedArgs := serviceendpoint.CreateServiceEndpointArgs{Endpoint: &azureServiceBusTestServiceEndpoint, Project: azureServiceBusTestServiceEndpointProjectID}
+	buildClient.
+		EXPECT().
+		CreateServiceEndpoint(clients.ctx, expectedArgs).
+		Return(nil, errors.New("CreateServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Create(resourceData, clients)
+	require.Contains(t, err.Error(), "CreateServiceEndpoint() Failed")
+}
+
+// verifies that if an error is produced on an update, it is not swallowed
+func TestAzureDevOpsServiceEndpointAzureServiceBus_Update_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := resourceServiceEndpointAzureServiceBus()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	serviceEndpointAzureServiceBusArgs.flatten(resourceData, &azureServiceBusTestServiceEndpoint, azureServiceBusTestServiceEndpointProjectID, nil)
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &aggregatedClient{ServiceEndpointClient: buildClient, ctx: context.Background()}
+
+	expectedArgs := serviceendpoint.UpdateServiceEndpointArgs{
+		Endpoint:   &azureServiceBusTestServiceEndpoint,
+		EndpointId: azureServiceBusTestServiceEndpoint.Id,
+		Project:    azureServiceBusTestServiceEndpointProjectID,
+	}
+	buildClient.
+		EXPECT().
+		UpdateServiceEndpoint(clients.ctx, expectedArgs).
+		Return(nil, errors.New("UpdateServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Update(resourceData, clients)
+	require.Contains(t, err.Error(), "UpdateServiceEndpoint() Failed")
+}
+
+/**
+ * Begin acceptance tests
+ */
+
+// validates that an apply followed by another apply (i.e., resource update) will be reflected in AzDO and the
+// underlying terraform state.
+func TestAccAzureDevOpsServiceEndpointAzureServiceBus_CreateAndUpdate(t *testing.T) {
+	projectName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	serviceEndpointName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	tfSvcEpNode := "azuredevops_serviceendpoint_azure_service_bus.serviceendpoint"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccServiceEndpointCheckDestroyByType("azuredevops_serviceendpoint_azure_service_bus"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceEndpointAzureServiceBusResource(projectName, serviceEndpointName, "notifications"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfSvcEpNode, "queue_name", "notifications"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "connection_string", ""),
+					resource.TestCheckResourceAttrSet(tfSvcEpNode, "connection_string_hash"),
+					testAccCheckServiceEndpointResourceExistsByNode(tfSvcEpNode, serviceEndpointName),
+				),
+			}, {
+				Config: testAccServiceEndpointAzureServiceBusResource(projectName, serviceEndpointName, "alerts"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfSvcEpNode, "queue_name", "alerts"),
+					testAccCheckServiceEndpointResourceExistsByNode(tfSvcEpNode, serviceEndpointName),
+				),
+			},
+		},
+	})
+}
+
+// HCL describing an AzDO Azure Service Bus service endpoint
+func testAccServiceEndpointAzureServiceBusResource(projectName string, serviceEndpointName string, queueName string) string {
+	serviceEndpointResource := fmt.Sprintf(`
+resource "azuredevops_serviceendpoint_azure_service_bus" "serviceendpoint" {
+	project_id            = azuredevops_project.project.id
+	service_endpoint_name = "%s"
+	connection_string     = "Endpoint=sb://namespace.servicebus.windows.net/;SharedAccessKeyName=send;SharedAccessKey=c2VjcmV0"
+	queue_name            = "%s"
+}`, serviceEndpointName, queueName)
+
+	projectResource := testAccProjectResource(projectName)
+	return fmt.Sprintf("%s\n%s", projectResource, serviceEndpointResource)
+}
@@ -0,0 +1,153 @@
+package azuredevops
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/secretmemo"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/tfhelper"
+)
+
+// The role the service principal is granted on the registry, which allows pipelines to pull and push images
+const azureCRAcrPushRoleID = "8311e382-0749-4cb8-b61a-304f252e45ec"
+
+func resourceServiceEndpointAzureCR() *schema.Resource {
+	r := genServiceEndpointResourceFromArgs(serviceEndpointAzureCRArgs)
+
+	r.Schema["azurecr_spn_tenantid"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		Description:  "The tenant ID of the service principal.",
+		ValidateFunc: validation.NoZeroValues,
+	}
+	r.Schema["azurecr_subscription_id"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		Description:  "The ID of the Azure subscription of the registry.",
+		ValidateFunc: validation.NoZeroValues,
+	}
+	r.Schema["azurecr_subscription_name"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		Description:  "The name of the Azure subscription of the registry.",
+		ValidateFunc: validation.NoZeroValues,
+	}
+	r.Schema["resource_group"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		Description:  "The resource group of the registry.",
+		ValidateFunc: validation.NoZeroValues,
+	}
+	r.Schema["azurecr_name"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		Description:  "The name of the registry.",
+		ValidateFunc: validation.NoZeroValues,
+	}
+
+	secretHashKey, secretHashSchema := tfhelper.GenerateSecreteMemoSchema("serviceprincipalkey")
+	r.Schema["service_principal"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Required:    true,
+		MinItems:    1,
+		MaxItems:    1,
+		Description: "The service principal used to authenticate against the registry.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"serviceprincipalid": {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "The application ID of the service principal.",
+					ValidateFunc: validation.NoZeroValues,
+				},
+				"serviceprincipalkey": {
+					Type:             schema.TypeString,
+					Required:         true,
+					Description:      "The secret key of the service principal.",
+					Sensitive:        true,
+					DiffSuppressFunc: tfhelper.DiffFuncSupressSecretChanged,
+					ValidateFunc:     validation.NoZeroValues,
+				},
+				secretHashKey: secretHashSchema,
+			},
+		},
+	}
+
+	return r
+}
+
+// Azure Container Registries are docker registry endpoints of the ACR registry type, which authenticate as a
+// service principal instead of a user. The resource group and the name of the registry are read from the ID of
+// the registry. The service never returns the key of the service principal, so the key in the state is kept as is.
+var serviceEndpointAzureCRArgs = &serviceEndpointCRUDArgs{
+	endpointType: "dockerregistry",
+	authScheme:   "ServicePrincipal",
+	expandURL: func(d *schema.ResourceData) string {
+		return "https://" + azureCRLoginServer(d)
+	},
+	expandParameters: func(d *schema.ResourceData) (map[string]string, map[string]string) {
+		servicePrincipal := expandSingleItemBlock(d, "service_principal")
+		servicePrincipalID, _ := servicePrincipal["serviceprincipalid"].(string)
+		servicePrincipalKey, _ := servicePrincipal["serviceprincipalkey"].(string)
+
+		registryID := fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ContainerRegistry/registries/%s",
+			d.Get("azurecr_subscription_id").(string),
+			d.Get("resource_group").(string),
+			d.Get("azurecr_name").(string))
+
+		return map[string]string{
+			"authenticationType":  "spnKey",
+			"loginServer":         azureCRLoginServer(d),
+			"role":                azureCRAcrPushRoleID,
+			"scope":               registryID,
+			"serviceprincipalid":  servicePrincipalID,
+			"serviceprincipalkey": servicePrincipalKey,
+			"tenantId":            d.Get("azurecr_spn_tenantid").(string),
+		}, map[string]string{
+			"registryId":       registryID,
+			"registrytype":     "ACR",
+			"subscriptionId":   d.Get("azurecr_subscription_id").(string),
+			"subscriptionName": d.Get("azurecr_subscription_name").(string),
+		}
+	},
+	flattenParameters: func(d *schema.ResourceData, parameters map[string]string, data map[string]string, secretSettings *secretmemo.Settings) {
+		d.Set("azurecr_spn_tenantid", parameters["tenantId"])
+		d.Set("azurecr_subscription_id", data["subscriptionId"])
+		d.Set("azurecr_subscription_name", data["subscriptionName"])
+
+		registryID := data["registryId"]
+		if registryID == "" {
+			registryID = parameters["scope"]
+		}
+		if resourceGroup, registryName, ok := parseAzureCRRegistryID(registryID); ok {
+			d.Set("resource_group", resourceGroup)
+			d.Set("azurecr_name", registryName)
+		}
+
+		servicePrincipalKey, _ := expandSingleItemBlock(d, "service_principal")["serviceprincipalkey"].(string)
+		servicePrincipal := map[string]interface{}{
+			"serviceprincipalid":  parameters["serviceprincipalid"],
+			"serviceprincipalkey": servicePrincipalKey,
+		}
+		tfhelper.HelpFlattenSecretNested(d, "service_principal", servicePrincipal, "serviceprincipalkey", secretSettings)
+		d.Set("service_principal", []interface{}{servicePrincipal})
+	},
+}
+
+func azureCRLoginServer(d *schema.ResourceData) string {
+	return strings.ToLower(d.Get("azurecr_name").(string)) + ".azurecr.io"
+}
+
+// Returns the resource group and the name of a registry given its Azure resource ID of the form
+// /subscriptions/<id>/resourceGroups/<group>/providers/Microsoft.ContainerRegistry/registries/<name>
+func parseAzureCRRegistryID(registryID string) (string, string, bool) {
+	segments := strings.Split(strings.Trim(registryID, "/"), "/")
+	if len(segments) != 8 ||
+		!strings.EqualFold(segments[2], "resourceGroups") ||
+		!strings.EqualFold(segments[6], "registries") {
+		return "", "", false
+	}
+	return segments[3], segments[7], true
+}
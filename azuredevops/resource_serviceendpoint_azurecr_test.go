@@ -0,0 +1,276 @@
+package azuredevops
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/serviceendpoint"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var azurecrTestServiceEndpointID = uuid.New()
+var azurecrRandomServiceEndpointProjectID = uuid.New().String()
+var azurecrTestServiceEndpointProjectID = &azurecrRandomServiceEndpointProjectID
+
+const azurecrTestRegistryID = "/subscriptions/42125daf-72fd-417c-9ea7-080690625ad3/resourceGroups/RG_TEST/providers/Microsoft.ContainerRegistry/registries/acrtest"
+
+var azurecrTestServiceEndpoint = serviceendpoint.ServiceEndpoint{
+	Authorization: &serviceendpoint.EndpointAuthorization{
+		Parameters: &map[string]string{
+			"authenticationType":  "spnKey",
+			"loginServer":         "acrtest.azurecr.io",
+			"role":                azureCRAcrPushRoleID,
+			"scope":               azurecrTestRegistryID,
+			"serviceprincipalid":  "e31eaaac-47da-4156-b433-9b0538c94b7e",
+			"serviceprincipalkey": "d96d8515-20b2-4413-8879-27c5d040cbc2",
+			"tenantId":            "aba07645-051c-44b4-b806-c34d33f3dcd1",
+		},
+		Scheme: converter.String("ServicePrincipal"),
+	},
+	Data: &map[string]string{
+		"registryId":       azurecrTestRegistryID,
+		"registrytype":     "ACR",
+		"subscriptionId":   "42125daf-72fd-417c-9ea7-080690625ad3",
+		"subscriptionName": "SUBSCRIPTION_TEST",
+	},
+	Id:    &azurecrTestServiceEndpointID,
+	Name:  converter.String("UNIT_TEST_NAME"),
+	Owner: converter.String("library"),
+	Type:  converter.String("dockerregistry"),
+	Url:   converter.String("https://acrtest.azurecr.io"),
+}
+
+/**
+ * Begin unit tests
+ */
+
+func createAzureCRServiceEndpointResourceData(t *testing.T) *schema.ResourceData {
+	parameters := *azurecrTestServiceEndpoint.Authorization.Parameters
+	return schema.TestResourceDataRaw(t, resourceServiceEndpointAzureCR().Schema, map[string]interface{}{
+		"service_principal": []interface{}{map[string]interface{}{
+			"serviceprincipalid":  parameters["serviceprincipalid"],
+			"serviceprincipalkey": parameters["serviceprincipalkey"],
+		}},
+	})
+}
+
+// verifies that the flatten/expand round trip yields the same service endpoint
+func TestAzureDevOpsServiceEndpointAzureCR_ExpandFlatten_Roundtrip(t *testing.T) {
+	resourceData := createAzureCRServiceEndpointResourceData(t)
+	serviceEndpointAzureCRArgs.flatten(resourceData, &azurecrTestServiceEndpoint, azurecrTestServiceEndpointProjectID, nil)
+
+	serviceEndpointAfterRoundTrip, projectID := serviceEndpointAzureCRArgs.expand(resourceData)
+
+	require.Equal(t, azurecrTestServiceEndpoint, *serviceEndpointAfterRoundTrip)
+	require.Equal(t, azurecrTestServiceEndpointProjectID, projectID)
+	require.NotEmpty(t, resourceData.Get("service_principal.0.serviceprincipalkey_hash"))
+}
+
+// verifies that a read reconciles the coordinates of the registry but does not overwrite the key, which the
+// service does not return
+func TestAzureDevOpsServiceEndpointAzureCR_Flatten_ReconcilesRegistryAndKeepsKey(t *testing.T) {
+	resourceData := createAzureCRServiceEndpointResourceData(t)
+
+	movedRegistryID := "/subscriptions/42125daf-72fd-417c-9ea7-080690625ad3/resourceGroups/RG_MOVED/providers/Microsoft.ContainerRegistry/registries/acrmoved"
+	serviceEndpoint := azurecrTestServiceEndpoint
+	serviceEndpoint.Authorization = &serviceendpoint.EndpointAuthorization{
+		Parameters: &map[string]string{
+			"serviceprincipalid": "e31eaaac-47da-4156-b433-9b0538c94b7e",
+			"tenantId":           "aba07645-051c-44b4-b806-c34d33f3dcd1",
+		},
+		Scheme: converter.String("ServicePrincipal"),
+	}
+	serviceEndpoint.Data = &map[string]string{
+		"registryId":       movedRegistryID,
+		"registrytype":     "ACR",
+		"subscriptionId":   "42125daf-72fd-417c-9ea7-080690625ad3",
+		"subscriptionName": "RENAMED_SUBSCRIPTION",
+	}
+	serviceEndpointAzureCRArgs.flatten(resourceData, &serviceEndpoint, azurecrTestServiceEndpointProjectID, nil)
+
+	require.Equal(t, "RENAMED_SUBSCRIPTION", resourceData.Get("azurecr_subscription_name"))
+	require.Equal(t, "RG_MOVED", resourceData.Get("resource_group"))
+	require.Equal(t, "acrmoved", resourceData.Get("azurecr_name"))
+	require.Equal(t, "d96d8515-20b2-4413-8879-27c5d040cbc2", resourceData.Get("service_principal.0.serviceprincipalkey"))
+}
+
+// verifies that only IDs of container registries are parsed
+func TestAzureDevOpsServiceEndpointAzureCR_ParseRegistryID(t *testing.T) {
+	resourceGroup, registryName, ok := parseAzureCRRegistryID(azurecrTestRegistryID)
+	require.True(t, ok)
+	require.Equal(t, "RG_TEST", resourceGroup)
+	require.Equal(t, "acrtest", registryName)
+
+	_, _, ok = parseAzureCRRegistryID("/subscriptions/42125daf-72fd-417c-9ea7-080690625ad3/resourceGroups/RG_TEST")
+	require.False(t, ok)
+	_, _, ok = parseAzureCRRegistryID("")
+	require.False(t, ok)
+}
+
+// verifies that if an error is produced on create, the error is not swallowed
+func TestAzureDevOpsServiceEndpointAzureCR_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := resourceServiceEndpointAzureCR()
+	resourceData := createAzureCRServiceEndpointResourceData(t)
+	serviceEndpointAzureCRArgs.flatten(resourceData, &azurecrTestServiceEndpoint, azurecrTestServiceEndpointProjectID, nil)
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &aggregatedClient{ServiceEndpointClient: buildClient, ctx: context.Background()}
+
+	expectedArgs := serviceendpoint.CreateServiceEndpointArgs{Endpoint: &azurecrTestServiceEndpoint, Project: azurecrTestServiceEndpointProjectID}
+	buildClient.
+		EXPECT().
+		CreateServiceEndpoint(clients.ctx, expectedArgs).
+		Return(nil, errors.New("CreateServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Create(resourceData, clients)
+	require.Contains(t, err.Error(), "CreateServiceEndpoint() Failed")
+}
+
+// verifies that if an error is produced on a read, it is not swallowed
+func TestAzureDevOpsServiceEndpointAzureCR_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := resourceServiceEndpointAzureCR()
+	resourceData := createAzureCRServiceEndpointResourceData(t)
+	serviceEndpointAzureCRArgs.flatten(resourceData, &azurecrTestServiceEndpoint, azurecrTestServiceEndpointProjectID, nil)
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &aggregatedClient{ServiceEndpointClient: buildClient, ctx: context.Background()}
+
+	expectedArgs := serviceendpoint.GetServiceEndpointDetailsArgs{EndpointId: azurecrTestServiceEndpoint.Id, Project: azurecrTestServiceEndpointProjectID}
+	buildClient.
+		EXPECT().
+		GetServiceEndpointDetails(clients.ctx, expectedArgs).
+		Return(nil, errors.New("GetServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Read(resourceData, clients)
+	require.Contains(t, err.Error(), "GetServiceEndpoint() Failed")
+}
+
+// verifies that if an error is produced on a delete, it is not swallowed
+func TestAzureDevOpsServiceEndpointAzureCR_Delete_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := resourceServiceEndpointAzureCR()
+	resourceData := createAzureCRServiceEndpointResourceData(t)
+	serviceEndpointAzureCRArgs.flatten(resourceData, &azurecrTestServiceEndpoint, azurecrTestServiceEndpointProjectID, nil)
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &aggregatedClient{ServiceEndpointClient: buildClient, ctx: context.Background()}
+
+	expectedArgs := serviceendpoint.DeleteServiceEndpointArgs{EndpointId: azurecrTestServiceEndpoint.Id, Project: azurecrTestServiceEndpointProjectID}
+	buildClient.
+		EXPECT().
+		DeleteServiceEndpoint(clients.ctx, expectedArgs).
+		Return(errors.New("DeleteServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Delete(resourceData, clients)
+	require.Contains(t, err.Error(), "DeleteServiceEndpoint() Failed")
+}
+
+// verifies that if an error is produced on an update, it is not swallowed
+func TestAzureDevOpsServiceEndpointAzureCR_Update_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := resourceServiceEndpointAzureCR()
+	resourceData := createAzureCRServiceEndpointResourceData(t)
+	serviceEndpointAzureCRArgs.flatten(resourceData, &azurecrTestServiceEndpoint, azurecrTestServiceEndpointProjectID, nil)
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &aggregatedClient{ServiceEndpointClient: buildClient, ctx: context.Background()}
+
+	expectedArgs := serviceendpoint.UpdateServiceEndpointArgs{
+		Endpoint:   &azurecrTestServiceEndpoint,
+		EndpointId: azurecrTestServiceEndpoint.Id,
+		Project:    azurecrTestServiceEndpointProjectID,
+	}
+
+	buildClient.
+		EXPECT().
+		UpdateServiceEndpoint(clients.ctx, expectedArgs).
+		Return(nil, errors.New("UpdateServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Update(resourceData, clients)
+	require.Contains(t, err.Error(), "UpdateServiceEndpoint() Failed")
+}
+
+/**
+ * Begin acceptance tests
+ */
+
+// validates that an apply followed by another apply (i.e., resource update) will be reflected in AzDO and the
+// underlying terraform state.
+func TestAccAzureDevOpsServiceEndpointAzureCR_CreateAndUpdate(t *testing.T) {
+	projectName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	serviceEndpointNameFirst := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	serviceEndpointNameSecond := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	tfSvcEpNode := "azuredevops_serviceendpoint_azurecr.serviceendpoint"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccServiceEndpointCheckDestroyByType("azuredevops_serviceendpoint_azurecr"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceEndpointAzureCRResource(projectName, serviceEndpointNameFirst),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "resource_group", "acc-test-rg"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "azurecr_name", "acctestregistry"),
+					resource.TestCheckResourceAttrSet(tfSvcEpNode, "service_principal.0.serviceprincipalkey_hash"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "service_endpoint_name", serviceEndpointNameFirst),
+					testAccCheckServiceEndpointResourceExistsByNode(tfSvcEpNode, serviceEndpointNameFirst),
+				),
+			}, {
+				Config: testAccServiceEndpointAzureCRResource(projectName, serviceEndpointNameSecond),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
+					resource.TestCheckResourceAttrSet(tfSvcEpNode, "service_principal.0.serviceprincipalkey_hash"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "service_endpoint_name", serviceEndpointNameSecond),
+					testAccCheckServiceEndpointResourceExistsByNode(tfSvcEpNode, serviceEndpointNameSecond),
+				),
+			},
+		},
+	})
+}
+
+// HCL describing an AzDO Azure Container Registry service endpoint
+func testAccServiceEndpointAzureCRResource(projectName string, serviceEndpointName string) string {
+	serviceEndpointResource := fmt.Sprintf(`
+resource "azuredevops_serviceendpoint_azurecr" "serviceendpoint" {
+	project_id                = azuredevops_project.project.id
+	service_endpoint_name     = "%s"
+	azurecr_spn_tenantid      = "9c59cbe5-2ca1-4516-b303-8968a070edd2"
+	azurecr_subscription_id   = "3b0fee91-c36d-4d70-b1e9-fc4b9d608c3d"
+	azurecr_subscription_name = "Microsoft Azure DEMO"
+	resource_group            = "acc-test-rg"
+	azurecr_name              = "acctestregistry"
+
+	service_principal {
+		serviceprincipalid  = "e318e66b-ec4b-4dff-9124-41129b9d7150"
+		serviceprincipalkey = "d9d210dd-f9f0-4176-afb8-a4df60e1ae72"
+	}
+}`, serviceEndpointName)
+
+	projectResource := testAccProjectResource(projectName)
+	return fmt.Sprintf("%s\n%s", projectResource, serviceEndpointResource)
+}
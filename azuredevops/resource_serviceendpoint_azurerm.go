@@ -0,0 +1,191 @@
+package azuredevops
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/secretmemo"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/tfhelper"
+)
+
+// The ways in which the service endpoint authenticates as the service principal
+const (
+	azureRMCredentialsModeServicePrincipalKey        = "service_principal_key"
+	azureRMCredentialsModeWorkloadIdentityFederation = "workload_identity_federation"
+)
+
+// maps each credentials mode to the authorization scheme of the service endpoint
+var azureRMCredentialsModeSchemes = map[string]string{
+	azureRMCredentialsModeServicePrincipalKey:        "ServicePrincipal",
+	azureRMCredentialsModeWorkloadIdentityFederation: "WorkloadIdentityFederation",
+}
+
+func resourceServiceEndpointAzureRM() *schema.Resource {
+	r := genServiceEndpointResourceFromArgs(serviceEndpointAzureRMArgs)
+	r.CustomizeDiff = customizeDiffServiceEndpointAzureRM
+
+	r.Schema["azurerm_spn_tenantid"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		Description:  "The tenant ID of the service principal.",
+		ValidateFunc: validation.NoZeroValues,
+	}
+	r.Schema["azurerm_subscription_id"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		Description:  "The ID of the Azure subscription.",
+		ValidateFunc: validation.NoZeroValues,
+	}
+	r.Schema["azurerm_subscription_name"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		Description:  "The name of the Azure subscription.",
+		ValidateFunc: validation.NoZeroValues,
+	}
+
+	r.Schema["credentials_mode"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+		Default:     azureRMCredentialsModeServicePrincipalKey,
+		Description: "Whether the service principal authenticates with a key or through a federated credential.",
+		ValidateFunc: validation.StringInSlice([]string{
+			azureRMCredentialsModeServicePrincipalKey,
+			azureRMCredentialsModeWorkloadIdentityFederation,
+		}, false),
+	}
+	r.Schema["workload_identity_federation_issuer"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The issuer of the federated credential that has to be configured for the service principal.",
+	}
+	r.Schema["workload_identity_federation_subject"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The subject of the federated credential that has to be configured for the service principal.",
+	}
+
+	secretHashKey, secretHashSchema := tfhelper.GenerateSecreteMemoSchema("serviceprincipalkey")
+	r.Schema["credentials"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Required:    true,
+		MinItems:    1,
+		MaxItems:    1,
+		Description: "The service principal used to authenticate against the Azure subscription.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"serviceprincipalid": {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "The application ID of the service principal.",
+					ValidateFunc: validation.NoZeroValues,
+				},
+				"serviceprincipalkey": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					Description:      "The secret key of the service principal. Required if the credentials_mode is service_principal_key.",
+					Sensitive:        true,
+					DiffSuppressFunc: tfhelper.DiffFuncSupressSecretChanged,
+					ValidateFunc:     validation.NoZeroValues,
+				},
+				secretHashKey: secretHashSchema,
+			},
+		},
+	}
+
+	return r
+}
+
+// Verifies at plan time that a key is configured if and only if the service principal authenticates with a key
+func customizeDiffServiceEndpointAzureRM(d *schema.ResourceDiff, m interface{}) error {
+	keyPath := "credentials.0.serviceprincipalkey"
+	if !d.NewValueKnown(keyPath) {
+		return nil
+	}
+
+	hasKey := d.Get(keyPath).(string) != ""
+	switch d.Get("credentials_mode").(string) {
+	case azureRMCredentialsModeServicePrincipalKey:
+		if !hasKey {
+			return fmt.Errorf("credentials_mode %s requires credentials.serviceprincipalkey to be set", azureRMCredentialsModeServicePrincipalKey)
+		}
+	case azureRMCredentialsModeWorkloadIdentityFederation:
+		if hasKey {
+			return fmt.Errorf("credentials.serviceprincipalkey must not be set with credentials_mode %s", azureRMCredentialsModeWorkloadIdentityFederation)
+		}
+	}
+	return nil
+}
+
+// The service never returns the key of the service principal, so the key in the state is kept as is.
+var serviceEndpointAzureRMArgs = &serviceEndpointCRUDArgs{
+	endpointType: "azurerm",
+	url:          "https://management.azure.com/",
+	expandParameters: func(d *schema.ResourceData) (map[string]string, map[string]string) {
+		servicePrincipalID, _ := expandSingleItemBlock(d, "credentials")["serviceprincipalid"].(string)
+		return map[string]string{
+			"serviceprincipalid": servicePrincipalID,
+			"tenantid":           d.Get("azurerm_spn_tenantid").(string),
+		}, map[string]string{
+			"creationMode":     "Manual",
+			"environment":      "AzureCloud",
+			"scopeLevel":       "Subscription",
+			"subscriptionId":   d.Get("azurerm_subscription_id").(string),
+			"subscriptionName": d.Get("azurerm_subscription_name").(string),
+		}
+	},
+	flattenParameters: func(d *schema.ResourceData, parameters map[string]string, data map[string]string, secretSettings *secretmemo.Settings) {
+		d.Set("workload_identity_federation_issuer", parameters["workloadIdentityFederationIssuer"])
+		d.Set("workload_identity_federation_subject", parameters["workloadIdentityFederationSubject"])
+
+		d.Set("azurerm_spn_tenantid", parameters["tenantid"])
+		d.Set("azurerm_subscription_id", data["subscriptionId"])
+		d.Set("azurerm_subscription_name", data["subscriptionName"])
+
+		servicePrincipalKey, _ := expandSingleItemBlock(d, "credentials")["serviceprincipalkey"].(string)
+		credentials := map[string]interface{}{
+			"serviceprincipalid":  parameters["serviceprincipalid"],
+			"serviceprincipalkey": servicePrincipalKey,
+		}
+		tfhelper.HelpFlattenSecretNested(d, "credentials", credentials, "serviceprincipalkey", secretSettings)
+		d.Set("credentials", []interface{}{credentials})
+	},
+	authSchemes: []serviceEndpointAuthScheme{
+		{
+			name:       azureRMCredentialsModeServicePrincipalKey,
+			authScheme: azureRMCredentialsModeSchemes[azureRMCredentialsModeServicePrincipalKey],
+			expandParameters: func(d *schema.ResourceData) (map[string]string, map[string]string) {
+				servicePrincipalKey, _ := expandSingleItemBlock(d, "credentials")["serviceprincipalkey"].(string)
+				return map[string]string{
+					"authenticationType":  "spnKey",
+					"serviceprincipalkey": servicePrincipalKey,
+				}, nil
+			},
+			flattenParameters: func(d *schema.ResourceData, parameters map[string]string, data map[string]string, secretSettings *secretmemo.Settings) {
+				d.Set("credentials_mode", azureRMCredentialsModeServicePrincipalKey)
+			},
+		},
+		{
+			// Federated credentials do not have a secret, the service principal trusts tokens issued by Azure DevOps instead
+			name:       azureRMCredentialsModeWorkloadIdentityFederation,
+			authScheme: azureRMCredentialsModeSchemes[azureRMCredentialsModeWorkloadIdentityFederation],
+			expandParameters: func(d *schema.ResourceData) (map[string]string, map[string]string) {
+				return map[string]string{}, nil
+			},
+			flattenParameters: func(d *schema.ResourceData, parameters map[string]string, data map[string]string, secretSettings *secretmemo.Settings) {
+				d.Set("credentials_mode", azureRMCredentialsModeWorkloadIdentityFederation)
+			},
+		},
+	},
+	expandAuthScheme: func(d *schema.ResourceData) string {
+		return d.Get("credentials_mode").(string)
+	},
+	// endpoints that do not use a federated credential authenticate with the key of the service principal
+	flattenAuthScheme: func(authScheme string, parameters map[string]string, data map[string]string) string {
+		if authScheme == azureRMCredentialsModeSchemes[azureRMCredentialsModeWorkloadIdentityFederation] {
+			return azureRMCredentialsModeWorkloadIdentityFederation
+		}
+		return azureRMCredentialsModeServicePrincipalKey
+	},
+}
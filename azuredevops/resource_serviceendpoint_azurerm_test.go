@@ -0,0 +1,306 @@
+package azuredevops
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/serviceendpoint"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var azurermTestServiceEndpointID = uuid.New()
+var azurermRandomServiceEndpointProjectID = uuid.New().String()
+var azurermTestServiceEndpointProjectID = &azurermRandomServiceEndpointProjectID
+
+var azurermTestServiceEndpoint = serviceendpoint.ServiceEndpoint{
+	Authorization: &serviceendpoint.EndpointAuthorization{
+		Parameters: &map[string]string{
+			"authenticationType":  "spnKey",
+			"serviceprincipalid":  "e31eaaac-47da-4156-b433-9b0538c94b7e",
+			"serviceprincipalkey": "d96d8515-20b2-4413-8879-27c5d040cbc2",
+			"tenantid":            "aba07645-051c-44b4-b806-c34d33f3dcd1",
+		},
+		Scheme: converter.String("ServicePrincipal"),
+	},
+	Data: &map[string]string{
+		"creationMode":     "Manual",
+		"environment":      "AzureCloud",
+		"scopeLevel":       "Subscription",
+		"subscriptionId":   "42125daf-72fd-417c-9ea7-080690625ad3",
+		"subscriptionName": "SUBSCRIPTION_TEST",
+	},
+	Id:    &azurermTestServiceEndpointID,
+	Name:  converter.String("UNIT_TEST_NAME"),
+	Owner: converter.String("library"),
+	Type:  converter.String("azurerm"),
+	Url:   converter.String("https://management.azure.com/"),
+}
+
+/**
+ * Begin unit tests
+ */
+
+func createAzureRMServiceEndpointResourceData(t *testing.T) *schema.ResourceData {
+	parameters := *azurermTestServiceEndpoint.Authorization.Parameters
+	return schema.TestResourceDataRaw(t, resourceServiceEndpointAzureRM().Schema, map[string]interface{}{
+		"credentials": []interface{}{map[string]interface{}{
+			"serviceprincipalid":  parameters["serviceprincipalid"],
+			"serviceprincipalkey": parameters["serviceprincipalkey"],
+		}},
+	})
+}
+
+// verifies that the flatten/expand round trip yields the same service endpoint
+func TestAzureDevOpsServiceEndpointAzureRM_ExpandFlatten_Roundtrip(t *testing.T) {
+	resourceData := createAzureRMServiceEndpointResourceData(t)
+	serviceEndpointAzureRMArgs.flatten(resourceData, &azurermTestServiceEndpoint, azurermTestServiceEndpointProjectID, nil)
+
+	serviceEndpointAfterRoundTrip, projectID := serviceEndpointAzureRMArgs.expand(resourceData)
+
+	require.Equal(t, azurermTestServiceEndpoint, *serviceEndpointAfterRoundTrip)
+	require.Equal(t, azurermTestServiceEndpointProjectID, projectID)
+	require.NotEmpty(t, resourceData.Get("credentials.0.serviceprincipalkey_hash"))
+}
+
+// verifies that a read reconciles the subscription but does not overwrite the key, which the service does not return
+func TestAzureDevOpsServiceEndpointAzureRM_Flatten_KeepsServicePrincipalKey(t *testing.T) {
+	resourceData := createAzureRMServiceEndpointResourceData(t)
+
+	serviceEndpoint := azurermTestServiceEndpoint
+	serviceEndpoint.Authorization = &serviceendpoint.EndpointAuthorization{
+		Parameters: &map[string]string{
+			"authenticationType": "spnKey",
+			"serviceprincipalid": "e31eaaac-47da-4156-b433-9b0538c94b7e",
+			"tenantid":           "aba07645-051c-44b4-b806-c34d33f3dcd1",
+		},
+		Scheme: converter.String("ServicePrincipal"),
+	}
+	serviceEndpoint.Data = &map[string]string{
+		"subscriptionId":   "42125daf-72fd-417c-9ea7-080690625ad3",
+		"subscriptionName": "RENAMED_SUBSCRIPTION",
+	}
+	serviceEndpointAzureRMArgs.flatten(resourceData, &serviceEndpoint, azurermTestServiceEndpointProjectID, nil)
+
+	require.Equal(t, "RENAMED_SUBSCRIPTION", resourceData.Get("azurerm_subscription_name"))
+	require.Equal(t, "d96d8515-20b2-4413-8879-27c5d040cbc2", resourceData.Get("credentials.0.serviceprincipalkey"))
+}
+
+// verifies that an endpoint with a federated credential is created without a key, and that the
+// issuer and subject of the federated credential are exported
+func TestAzureDevOpsServiceEndpointAzureRM_WorkloadIdentityFederation(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, resourceServiceEndpointAzureRM().Schema, map[string]interface{}{
+		"credentials_mode":          azureRMCredentialsModeWorkloadIdentityFederation,
+		"azurerm_spn_tenantid":      "aba07645-051c-44b4-b806-c34d33f3dcd1",
+		"azurerm_subscription_id":   "42125daf-72fd-417c-9ea7-080690625ad3",
+		"azurerm_subscription_name": "SUBSCRIPTION_TEST",
+		"credentials": []interface{}{map[string]interface{}{
+			"serviceprincipalid": "e31eaaac-47da-4156-b433-9b0538c94b7e",
+		}},
+	})
+
+	serviceEndpoint, _ := serviceEndpointAzureRMArgs.expand(resourceData)
+	require.Equal(t, "WorkloadIdentityFederation", *serviceEndpoint.Authorization.Scheme)
+	require.Equal(t, map[string]string{
+		"serviceprincipalid": "e31eaaac-47da-4156-b433-9b0538c94b7e",
+		"tenantid":           "aba07645-051c-44b4-b806-c34d33f3dcd1",
+	}, *serviceEndpoint.Authorization.Parameters)
+
+	serviceEndpoint.Id = &azurermTestServiceEndpointID
+	(*serviceEndpoint.Authorization.Parameters)["workloadIdentityFederationIssuer"] = "https://vstoken.dev.azure.com/org-id"
+	(*serviceEndpoint.Authorization.Parameters)["workloadIdentityFederationSubject"] = "sc://org/project/UNIT_TEST_NAME"
+	serviceEndpointAzureRMArgs.flatten(resourceData, serviceEndpoint, azurermTestServiceEndpointProjectID, nil)
+
+	require.Equal(t, azureRMCredentialsModeWorkloadIdentityFederation, resourceData.Get("credentials_mode"))
+	require.Equal(t, "https://vstoken.dev.azure.com/org-id", resourceData.Get("workload_identity_federation_issuer"))
+	require.Equal(t, "sc://org/project/UNIT_TEST_NAME", resourceData.Get("workload_identity_federation_subject"))
+}
+
+// verifies that a key has to be configured if and only if the service principal authenticates with a key
+func TestAzureDevOpsServiceEndpointAzureRM_CustomizeDiff_KeyMatchesCredentialsMode(t *testing.T) {
+	diffWithCredentials := func(credentialsMode string, credentials map[string]interface{}) error {
+		_, err := resourceServiceEndpointAzureRM().Diff(nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+			"project_id":                "project",
+			"service_endpoint_name":     "name",
+			"credentials_mode":          credentialsMode,
+			"azurerm_spn_tenantid":      "tenant",
+			"azurerm_subscription_id":   "subscription",
+			"azurerm_subscription_name": "subscription name",
+			"credentials":               []interface{}{credentials},
+		}), nil)
+		return err
+	}
+
+	withKey := map[string]interface{}{"serviceprincipalid": "id", "serviceprincipalkey": "key"}
+	withoutKey := map[string]interface{}{"serviceprincipalid": "id"}
+
+	require.Nil(t, diffWithCredentials(azureRMCredentialsModeServicePrincipalKey, withKey))
+	require.NotNil(t, diffWithCredentials(azureRMCredentialsModeServicePrincipalKey, withoutKey))
+	require.Nil(t, diffWithCredentials(azureRMCredentialsModeWorkloadIdentityFederation, withoutKey))
+	require.NotNil(t, diffWithCredentials(azureRMCredentialsModeWorkloadIdentityFederation, withKey))
+}
+
+// verifies that if an error is produced on create, the error is not swallowed
+func TestAzureDevOpsServiceEndpointAzureRM_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := resourceServiceEndpointAzureRM()
+	resourceData := createAzureRMServiceEndpointResourceData(t)
+	serviceEndpointAzureRMArgs.flatten(resourceData, &azurermTestServiceEndpoint, azurermTestServiceEndpointProjectID, nil)
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &aggregatedClient{ServiceEndpointClient: buildClient, ctx: context.Background()}
+
+	expectedArgs := serviceendpoint.CreateServiceEndpointArgs{Endpoint: &azurermTestServiceEndpoint, Project: azurermTestServiceEndpointProjectID}
+	buildClient.
+		EXPECT().
+		CreateServiceEndpoint(clients.ctx, expectedArgs).
+		Return(nil, errors.New("CreateServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Create(resourceData, clients)
+	require.Contains(t, err.Error(), "CreateServiceEndpoint() Failed")
+}
+
+// verifies that if an error is produced on a read, it is not swallowed
+func TestAzureDevOpsServiceEndpointAzureRM_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := resourceServiceEndpointAzureRM()
+	resourceData := createAzureRMServiceEndpointResourceData(t)
+	serviceEndpointAzureRMArgs.flatten(resourceData, &azurermTestServiceEndpoint, azurermTestServiceEndpointProjectID, nil)
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &aggregatedClient{ServiceEndpointClient: buildClient, ctx: context.Background()}
+
+	expectedArgs := serviceendpoint.GetServiceEndpointDetailsArgs{EndpointId: azurermTestServiceEndpoint.Id, Project: azurermTestServiceEndpointProjectID}
+	buildClient.
+		EXPECT().
+		GetServiceEndpointDetails(clients.ctx, expectedArgs).
+		Return(nil, errors.New("GetServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Read(resourceData, clients)
+	require.Contains(t, err.Error(), "GetServiceEndpoint() Failed")
+}
+
+// verifies that if an error is produced on a delete, it is not swallowed
+func TestAzureDevOpsServiceEndpointAzureRM_Delete_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := resourceServiceEndpointAzureRM()
+	resourceData := createAzureRMServiceEndpointResourceData(t)
+	serviceEndpointAzureRMArgs.flatten(resourceData, &azurermTestServiceEndpoint, azurermTestServiceEndpointProjectID, nil)
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &aggregatedClient{ServiceEndpointClient: buildClient, ctx: context.Background()}
+
+	expectedArgs := serviceendpoint.DeleteServiceEndpointArgs{EndpointId: azurermTestServiceEndpoint.Id, Project: azurermTestServiceEndpointProjectID}
+	buildClient.
+		EXPECT().
+		DeleteServiceEndpoint(clients.ctx, expectedArgs).
+		Return(errors.New("DeleteServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Delete(resourceData, clients)
+	require.Contains(t, err.Error(), "DeleteServiceEndpoint() Failed")
+}
+
+// verifies that if an error is produced on an update, it is not swallowed
+func TestAzureDevOpsServiceEndpointAzureRM_Update_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := resourceServiceEndpointAzureRM()
+	resourceData := createAzureRMServiceEndpointResourceData(t)
+	serviceEndpointAzureRMArgs.flatten(resourceData, &azurermTestServiceEndpoint, azurermTestServiceEndpointProjectID, nil)
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &aggregatedClient{ServiceEndpointClient: buildClient, ctx: context.Background()}
+
+	expectedArgs := serviceendpoint.UpdateServiceEndpointArgs{
+		Endpoint:   &azurermTestServiceEndpoint,
+		EndpointId: azurermTestServiceEndpoint.Id,
+		Project:    azurermTestServiceEndpointProjectID,
+	}
+
+	buildClient.
+		EXPECT().
+		UpdateServiceEndpoint(clients.ctx, expectedArgs).
+		Return(nil, errors.New("UpdateServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Update(resourceData, clients)
+	require.Contains(t, err.Error(), "UpdateServiceEndpoint() Failed")
+}
+
+/**
+ * Begin acceptance tests
+ */
+
+// validates that an apply followed by another apply (i.e., resource update) will be reflected in AzDO and the
+// underlying terraform state.
+func TestAccAzureDevOpsServiceEndpointAzureRM_CreateAndUpdate(t *testing.T) {
+	projectName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	serviceEndpointNameFirst := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	serviceEndpointNameSecond := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	tfSvcEpNode := "azuredevops_serviceendpoint_azurerm.serviceendpoint"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccServiceEndpointCheckDestroyByType("azuredevops_serviceendpoint_azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceEndpointAzureRMResource(projectName, serviceEndpointNameFirst),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "azurerm_subscription_name", "Microsoft Azure DEMO"),
+					resource.TestCheckResourceAttrSet(tfSvcEpNode, "credentials.0.serviceprincipalkey_hash"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "service_endpoint_name", serviceEndpointNameFirst),
+					testAccCheckServiceEndpointResourceExistsByNode(tfSvcEpNode, serviceEndpointNameFirst),
+				),
+			}, {
+				Config: testAccServiceEndpointAzureRMResource(projectName, serviceEndpointNameSecond),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
+					resource.TestCheckResourceAttrSet(tfSvcEpNode, "credentials.0.serviceprincipalkey_hash"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "service_endpoint_name", serviceEndpointNameSecond),
+					testAccCheckServiceEndpointResourceExistsByNode(tfSvcEpNode, serviceEndpointNameSecond),
+				),
+			},
+		},
+	})
+}
+
+// HCL describing an AzDO Azure Resource Manager service endpoint
+func testAccServiceEndpointAzureRMResource(projectName string, serviceEndpointName string) string {
+	serviceEndpointResource := fmt.Sprintf(`
+resource "azuredevops_serviceendpoint_azurerm" "serviceendpoint" {
+	project_id                = azuredevops_project.project.id
+	service_endpoint_name     = "%s"
+	azurerm_spn_tenantid      = "9c59cbe5-2ca1-4516-b303-8968a070edd2"
+	azurerm_subscription_id   = "3b0fee91-c36d-4d70-b1e9-fc4b9d608c3d"
+	azurerm_subscription_name = "Microsoft Azure DEMO"
+
+	credentials {
+		serviceprincipalid  = "e318e66b-ec4b-4dff-9124-41129b9d7150"
+		serviceprincipalkey = "d9d210dd-f9f0-4176-afb8-a4df60e1ae72"
+	}
+}`, serviceEndpointName)
+
+	projectResource := testAccProjectResource(projectName)
+	return fmt.Sprintf("%s\n%s", projectResource, serviceEndpointResource)
+}
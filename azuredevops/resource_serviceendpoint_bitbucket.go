@@ -0,0 +1,53 @@
+package azuredevops
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/secretmemo"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/tfhelper"
+)
+
+func resourceServiceEndpointBitbucket() *schema.Resource {
+	r := genServiceEndpointResourceFromArgs(serviceEndpointBitbucketArgs)
+
+	// Bitbucket Cloud service connections always authenticate with a username and an app password
+	r.Schema["username"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		DefaultFunc:  schema.EnvDefaultFunc("AZDO_BITBUCKET_SERVICE_CONNECTION_USERNAME", nil),
+		Description:  "The Bitbucket username.",
+		ValidateFunc: validation.NoZeroValues,
+	}
+
+	secretHashKey, secretHashSchema := tfhelper.GenerateSecreteMemoSchema("password")
+	r.Schema["password"] = &schema.Schema{
+		Type:             schema.TypeString,
+		Required:         true,
+		DefaultFunc:      schema.EnvDefaultFunc("AZDO_BITBUCKET_SERVICE_CONNECTION_PASSWORD", nil),
+		Description:      "The Bitbucket app password.",
+		Sensitive:        true,
+		DiffSuppressFunc: tfhelper.DiffFuncSupressSecretChanged,
+		ValidateFunc:     validation.NoZeroValues,
+	}
+	r.Schema[secretHashKey] = secretHashSchema
+
+	return r
+}
+
+var serviceEndpointBitbucketArgs = &serviceEndpointCRUDArgs{
+	endpointType: "bitbucket",
+	authScheme:   "UsernamePassword",
+	url:          "https://api.bitbucket.org",
+	expandParameters: func(d *schema.ResourceData) (map[string]string, map[string]string) {
+		return map[string]string{
+			"username": d.Get("username").(string),
+			"password": d.Get("password").(string),
+		}, nil
+	},
+	flattenParameters: func(d *schema.ResourceData, parameters map[string]string, data map[string]string, secretSettings *secretmemo.Settings) {
+		d.Set("username", parameters["username"])
+
+		tfhelper.HelpFlattenSecret(d, "password", secretSettings)
+		d.Set("password", parameters["password"])
+	},
+}
@@ -0,0 +1,215 @@
+package azuredevops
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/serviceendpoint"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var bitbucketTestServiceEndpointID = uuid.New()
+var bitbucketRandomServiceEndpointProjectID = uuid.New().String()
+var bitbucketTestServiceEndpointProjectID = &bitbucketRandomServiceEndpointProjectID
+
+var bitbucketTestServiceEndpoint = serviceendpoint.ServiceEndpoint{
+	Authorization: &serviceendpoint.EndpointAuthorization{
+		Parameters: &map[string]string{
+			"username": "BITBUCKET_TEST_username",
+			"password": "BITBUCKET_TEST_password",
+		},
+		Scheme: converter.String("UsernamePassword"),
+	},
+	Id:    &bitbucketTestServiceEndpointID,
+	Name:  converter.String("UNIT_TEST_NAME"),
+	Owner: converter.String("library"),
+	Type:  converter.String("bitbucket"),
+	Url:   converter.String("https://api.bitbucket.org"),
+}
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that the flatten/expand round trip yields the same service endpoint
+func TestAzureDevOpsServiceEndpointBitbucket_ExpandFlatten_Roundtrip(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, resourceServiceEndpointBitbucket().Schema, nil)
+	serviceEndpointBitbucketArgs.flatten(resourceData, &bitbucketTestServiceEndpoint, bitbucketTestServiceEndpointProjectID, nil)
+
+	serviceEndpointAfterRoundTrip, projectID := serviceEndpointBitbucketArgs.expand(resourceData)
+
+	require.Equal(t, bitbucketTestServiceEndpoint, *serviceEndpointAfterRoundTrip)
+	require.Equal(t, bitbucketTestServiceEndpointProjectID, projectID)
+}
+
+// verifies that both the username and the password are required, as there is no token-only mode
+func TestAzureDevOpsServiceEndpointBitbucket_RequiresUsernameAndPassword(t *testing.T) {
+	r := resourceServiceEndpointBitbucket()
+	for _, attribute := range []string{"username", "password"} {
+		require.True(t, r.Schema[attribute].Required, attribute)
+		_, errs := r.Schema[attribute].ValidateFunc("", attribute)
+		require.NotEmpty(t, errs, attribute)
+	}
+}
+
+// verifies that if an error is produced on create, the error is not swallowed
+func TestAzureDevOpsServiceEndpointBitbucket_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := resourceServiceEndpointBitbucket()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	serviceEndpointBitbucketArgs.flatten(resourceData, &bitbucketTestServiceEndpoint, bitbucketTestServiceEndpointProjectID, nil)
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &aggregatedClient{ServiceEndpointClient: buildClient, ctx: context.Background()}
+
+	expectedArgs := serviceendpoint.CreateServiceEndpointArgs{Endpoint: &bitbucketTestServiceEndpoint, Project: bitbucketTestServiceEndpointProjectID}
+	buildClient.
+		EXPECT().
+		CreateServiceEndpoint(clients.ctx, expectedArgs).
+		Return(nil, errors.New("CreateServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Create(resourceData, clients)
+	require.Contains(t, err.Error(), "CreateServiceEndpoint() Failed")
+}
+
+// verifies that if an error is produced on a read, it is not swallowed
+func TestAzureDevOpsServiceEndpointBitbucket_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := resourceServiceEndpointBitbucket()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	serviceEndpointBitbucketArgs.flatten(resourceData, &bitbucketTestServiceEndpoint, bitbucketTestServiceEndpointProjectID, nil)
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &aggregatedClient{ServiceEndpointClient: buildClient, ctx: context.Background()}
+
+	expectedArgs := serviceendpoint.GetServiceEndpointDetailsArgs{EndpointId: bitbucketTestServiceEndpoint.Id, Project: bitbucketTestServiceEndpointProjectID}
+	buildClient.
+		EXPECT().
+		GetServiceEndpointDetails(clients.ctx, expectedArgs).
+		Return(nil, errors.New("GetServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Read(resourceData, clients)
+	require.Contains(t, err.Error(), "GetServiceEndpoint() Failed")
+}
+
+// verifies that if an error is produced on a delete, it is not swallowed
+func TestAzureDevOpsServiceEndpointBitbucket_Delete_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := resourceServiceEndpointBitbucket()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	serviceEndpointBitbucketArgs.flatten(resourceData, &bitbucketTestServiceEndpoint, bitbucketTestServiceEndpointProjectID, nil)
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &aggregatedClient{ServiceEndpointClient: buildClient, ctx: context.Background()}
+
+	expectedArgs := serviceendpoint.DeleteServiceEndpointArgs{EndpointId: bitbucketTestServiceEndpoint.Id, Project: bitbucketTestServiceEndpointProjectID}
+	buildClient.
+		EXPECT().
+		DeleteServiceEndpoint(clients.ctx, expectedArgs).
+		Return(errors.New("DeleteServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Delete(resourceData, clients)
+	require.Contains(t, err.Error(), "DeleteServiceEndpoint() Failed")
+}
+
+// verifies that if an error is produced on an update, it is not swallowed
+func TestAzureDevOpsServiceEndpointBitbucket_Update_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := resourceServiceEndpointBitbucket()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	serviceEndpointBitbucketArgs.flatten(resourceData, &bitbucketTestServiceEndpoint, bitbucketTestServiceEndpointProjectID, nil)
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &aggregatedClient{ServiceEndpointClient: buildClient, ctx: context.Background()}
+
+	expectedArgs := serviceendpoint.UpdateServiceEndpointArgs{
+		Endpoint:   &bitbucketTestServiceEndpoint,
+		EndpointId: bitbucketTestServiceEndpoint.Id,
+		Project:    bitbucketTestServiceEndpointProjectID,
+	}
+
+	buildClient.
+		EXPECT().
+		UpdateServiceEndpoint(clients.ctx, expectedArgs).
+		Return(nil, errors.New("UpdateServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Update(resourceData, clients)
+	require.Contains(t, err.Error(), "UpdateServiceEndpoint() Failed")
+}
+
+/**
+ * Begin acceptance tests
+ */
+
+// validates that an apply followed by another apply (i.e., resource update) will be reflected in AzDO and the
+// underlying terraform state.
+func TestAccAzureDevOpsServiceEndpointBitbucket_CreateAndUpdate(t *testing.T) {
+	projectName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	serviceEndpointNameFirst := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	serviceEndpointNameSecond := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	tfSvcEpNode := "azuredevops_serviceendpoint_bitbucket.serviceendpoint"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccServiceEndpointCheckDestroyByType("azuredevops_serviceendpoint_bitbucket"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceEndpointBitbucketResource(projectName, serviceEndpointNameFirst),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "username", "username"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "password", ""),
+					resource.TestCheckResourceAttrSet(tfSvcEpNode, "password_hash"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "service_endpoint_name", serviceEndpointNameFirst),
+					testAccCheckServiceEndpointResourceExistsByNode(tfSvcEpNode, serviceEndpointNameFirst),
+				),
+			}, {
+				Config: testAccServiceEndpointBitbucketResource(projectName, serviceEndpointNameSecond),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "username", "username"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "password", ""),
+					resource.TestCheckResourceAttrSet(tfSvcEpNode, "password_hash"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "service_endpoint_name", serviceEndpointNameSecond),
+					testAccCheckServiceEndpointResourceExistsByNode(tfSvcEpNode, serviceEndpointNameSecond),
+				),
+			},
+		},
+	})
+}
+
+// HCL describing an AzDO bitbucket service endpoint
+func testAccServiceEndpointBitbucketResource(projectName string, serviceEndpointName string) string {
+	serviceEndpointResource := fmt.Sprintf(`
+resource "azuredevops_serviceendpoint_bitbucket" "serviceendpoint" {
+	project_id            = azuredevops_project.project.id
+	service_endpoint_name = "%s"
+	username              = "username"
+	password              = "password"
+}`, serviceEndpointName)
+
+	projectResource := testAccProjectResource(projectName)
+	return fmt.Sprintf("%s\n%s", projectResource, serviceEndpointResource)
+}
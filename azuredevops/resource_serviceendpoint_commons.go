@@ -0,0 +1,422 @@
+package azuredevops
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/serviceendpoint"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/secretmemo"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/tfhelper"
+)
+
+var serviceEndpointReadyTimeoutSeconds int = 30
+
+// flatFunc converts an AzDO service endpoint into the Terraform data structure of a specific endpoint type. The
+// hashes of changed secrets are calculated with the secret hashing settings of the provider.
+type flatFunc func(d *schema.ResourceData, serviceEndpoint *serviceendpoint.ServiceEndpoint, projectID *string, secretSettings *secretmemo.Settings)
+
+// expandFunc converts the Terraform data structure of a specific endpoint type into an AzDO service endpoint
+type expandFunc func(d *schema.ResourceData) (*serviceendpoint.ServiceEndpoint, *string)
+
+// genBaseServiceEndpointResource creates a resource that shares the CRUD operations and the common schema
+// of every service endpoint type. Callers add the schema elements specific to their endpoint type.
+func genBaseServiceEndpointResource(f flatFunc, e expandFunc) *schema.Resource {
+	return &schema.Resource{
+		Create: genServiceEndpointCreateFunc(f, e),
+		Read:   genServiceEndpointReadFunc(f),
+		Update: genServiceEndpointUpdateFunc(f, e),
+		Delete: genServiceEndpointDeleteFunc(e),
+		Schema: baseServiceEndpointSchema(),
+	}
+}
+
+func baseServiceEndpointSchema() map[string]*schema.Schema {
+	skipSecretHashKey, skipSecretHashSchema := tfhelper.GenerateSkipSecretHashSchema()
+	return map[string]*schema.Schema{
+		"project_id": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		"service_endpoint_name": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.NoZeroValues,
+		},
+		skipSecretHashKey: skipSecretHashSchema,
+	}
+}
+
+// doBaseExpansion expands the attributes shared by every service endpoint type
+func doBaseExpansion(d *schema.ResourceData) (*serviceendpoint.ServiceEndpoint, *string) {
+	// an "error" is OK here as it is expected in the case that the ID is not set in the resource data
+	var serviceEndpointID *uuid.UUID
+	parsedID, err := uuid.Parse(d.Id())
+	if err == nil {
+		serviceEndpointID = &parsedID
+	}
+	projectID := converter.String(d.Get("project_id").(string))
+	serviceEndpoint := &serviceendpoint.ServiceEndpoint{
+		Id:    serviceEndpointID,
+		Name:  converter.String(d.Get("service_endpoint_name").(string)),
+		Owner: converter.String("library"),
+	}
+
+	return serviceEndpoint, projectID
+}
+
+// doBaseFlattening flattens the attributes shared by every service endpoint type
+func doBaseFlattening(d *schema.ResourceData, serviceEndpoint *serviceendpoint.ServiceEndpoint, projectID *string) {
+	d.SetId(serviceEndpoint.Id.String())
+	d.Set("service_endpoint_name", *serviceEndpoint.Name)
+	d.Set("project_id", projectID)
+}
+
+// serviceEndpointCRUDArgs describes a service endpoint type. Resources built from it only declare the schema of
+// their attributes and how these map onto the authorization parameters and data of the endpoint.
+type serviceEndpointCRUDArgs struct {
+	// the type of the endpoint in Azure DevOps, e.g. generic
+	endpointType string
+	// the attributes that hold the type and the owner of the endpoint, for resources that leave them to the
+	// configuration instead of using endpointType and the library owner
+	typeKey  string
+	ownerKey string
+	// the authorization scheme of the endpoint, e.g. UsernamePassword
+	authScheme string
+	// the attribute that holds the URL of the endpoint. Endpoint types without such an attribute either derive
+	// the URL from their configuration through expandURL, or always use the same url.
+	urlKey    string
+	url       string
+	expandURL func(d *schema.ResourceData) string
+	// converts the attributes of the endpoint type into the authorization parameters and the data of the
+	// endpoint. The data is omitted from the endpoint if it is nil.
+	expandParameters func(d *schema.ResourceData) (parameters map[string]string, data map[string]string)
+	// restores the attributes of the endpoint type from the authorization parameters and the data
+	flattenParameters func(d *schema.ResourceData, parameters map[string]string, data map[string]string, secretSettings *secretmemo.Settings)
+	// endpoint types that support several authorization schemes describe each of them in authSchemes instead of
+	// setting authScheme. expandParameters and flattenParameters are optional then, and only handle the
+	// attributes that do not belong to a scheme.
+	authSchemes []serviceEndpointAuthScheme
+	// returns the name of the configured scheme. Defaults to the first scheme whose attributes are set.
+	expandAuthScheme func(d *schema.ResourceData) string
+	// returns the name of the scheme the endpoint authenticates with. Defaults to the scheme whose authorization
+	// scheme matches the one of the endpoint.
+	flattenAuthScheme func(authScheme string, parameters map[string]string, data map[string]string) string
+}
+
+// genServiceEndpointResourceFromArgs creates a resource for the described endpoint type. Callers add the
+// schema elements specific to their endpoint type, including the URL attribute if the type has one.
+func genServiceEndpointResourceFromArgs(args *serviceEndpointCRUDArgs) *schema.Resource {
+	return genBaseServiceEndpointResource(args.flatten, args.expand)
+}
+
+// Convert internal Terraform data structure to an AzDO data structure
+func (args *serviceEndpointCRUDArgs) expand(d *schema.ResourceData) (*serviceendpoint.ServiceEndpoint, *string) {
+	serviceEndpoint, projectID := doBaseExpansion(d)
+	parameters := map[string]string{}
+	var data map[string]string
+	if args.expandParameters != nil {
+		parameters, data = args.expandParameters(d)
+	}
+
+	authScheme := args.authScheme
+	if len(args.authSchemes) > 0 {
+		scheme := args.findAuthScheme(args.expandConfiguredAuthScheme(d))
+		// the authorization is omitted if no scheme is configured, which is rejected at plan time
+		if scheme == nil {
+			return args.expandEndpoint(d, serviceEndpoint, data), projectID
+		}
+		authScheme = scheme.getAuthScheme()
+		schemeParameters, schemeData := scheme.expandParameters(d)
+		for key, value := range schemeParameters {
+			parameters[key] = value
+		}
+		if schemeData != nil && data == nil {
+			data = map[string]string{}
+		}
+		for key, value := range schemeData {
+			data[key] = value
+		}
+	}
+
+	serviceEndpoint.Authorization = &serviceendpoint.EndpointAuthorization{
+		Parameters: &parameters,
+		Scheme:     converter.String(authScheme),
+	}
+	return args.expandEndpoint(d, serviceEndpoint, data), projectID
+}
+
+// expandEndpoint sets the data, the type, the owner and the URL of the endpoint
+func (args *serviceEndpointCRUDArgs) expandEndpoint(d *schema.ResourceData, serviceEndpoint *serviceendpoint.ServiceEndpoint, data map[string]string) *serviceendpoint.ServiceEndpoint {
+	if data != nil {
+		serviceEndpoint.Data = &data
+	}
+	serviceEndpoint.Type = converter.String(args.endpointType)
+	if args.typeKey != "" {
+		serviceEndpoint.Type = converter.String(d.Get(args.typeKey).(string))
+	}
+	if args.ownerKey != "" {
+		serviceEndpoint.Owner = converter.String(d.Get(args.ownerKey).(string))
+	}
+
+	switch {
+	case args.urlKey != "":
+		serviceEndpoint.Url = converter.String(d.Get(args.urlKey).(string))
+	case args.expandURL != nil:
+		serviceEndpoint.Url = converter.String(args.expandURL(d))
+	default:
+		serviceEndpoint.Url = converter.String(args.url)
+	}
+	return serviceEndpoint
+}
+
+// Convert AzDO data structure to internal Terraform data structure
+func (args *serviceEndpointCRUDArgs) flatten(d *schema.ResourceData, serviceEndpoint *serviceendpoint.ServiceEndpoint, projectID *string, secretSettings *secretmemo.Settings) {
+	doBaseFlattening(d, serviceEndpoint, projectID)
+	if args.typeKey != "" {
+		d.Set(args.typeKey, converter.ToString(serviceEndpoint.Type, ""))
+	}
+	if args.ownerKey != "" {
+		d.Set(args.ownerKey, converter.ToString(serviceEndpoint.Owner, ""))
+	}
+	if args.urlKey != "" {
+		d.Set(args.urlKey, converter.ToString(serviceEndpoint.Url, ""))
+	}
+
+	parameters := map[string]string{}
+	if serviceEndpoint.Authorization != nil && serviceEndpoint.Authorization.Parameters != nil {
+		parameters = *serviceEndpoint.Authorization.Parameters
+	}
+	data := map[string]string{}
+	if serviceEndpoint.Data != nil {
+		data = *serviceEndpoint.Data
+	}
+	if args.flattenParameters != nil {
+		args.flattenParameters(d, parameters, data, secretSettings)
+	}
+	if len(args.authSchemes) == 0 || serviceEndpoint.Authorization == nil {
+		return
+	}
+
+	authScheme := converter.ToString(serviceEndpoint.Authorization.Scheme, "")
+	scheme := args.findAuthScheme(args.flattenConfiguredAuthScheme(authScheme, parameters, data))
+	if scheme == nil {
+		return
+	}
+	// the attributes of the other schemes are cleared, so that a scheme that was changed outside of Terraform
+	// shows up as a diff
+	for _, other := range args.authSchemes {
+		if other.name == scheme.name {
+			continue
+		}
+		for _, attribute := range other.attributes {
+			d.Set(attribute, nil)
+		}
+	}
+	scheme.flattenParameters(d, parameters, data, secretSettings)
+}
+
+func (args *serviceEndpointCRUDArgs) expandConfiguredAuthScheme(d *schema.ResourceData) string {
+	if args.expandAuthScheme != nil {
+		return args.expandAuthScheme(d)
+	}
+	return getServiceEndpointAuthScheme(d, args.authSchemes)
+}
+
+func (args *serviceEndpointCRUDArgs) flattenConfiguredAuthScheme(authScheme string, parameters map[string]string, data map[string]string) string {
+	if args.flattenAuthScheme != nil {
+		return args.flattenAuthScheme(authScheme, parameters, data)
+	}
+	for _, scheme := range args.authSchemes {
+		if scheme.getAuthScheme() == authScheme {
+			return scheme.name
+		}
+	}
+	return ""
+}
+
+// Returns the scheme of the given name, or nil if the endpoint type has no such scheme
+func (args *serviceEndpointCRUDArgs) findAuthScheme(name string) *serviceEndpointAuthScheme {
+	for i := range args.authSchemes {
+		if args.authSchemes[i].name == name {
+			return &args.authSchemes[i]
+		}
+	}
+	return nil
+}
+
+func genServiceEndpointCreateFunc(flatFunc flatFunc, expandFunc expandFunc) func(d *schema.ResourceData, m interface{}) error {
+	return func(d *schema.ResourceData, m interface{}) error {
+		clients := m.(*aggregatedClient)
+		serviceEndpoint, projectID := expandFunc(d)
+
+		createdServiceEndpoint, err := createServiceEndpoint(clients, serviceEndpoint, projectID)
+		if err != nil {
+			return fmt.Errorf("Error creating service endpoint in Azure DevOps: %+v", err)
+		}
+
+		createdServiceEndpoint, err = waitForServiceEndpointReady(clients, createdServiceEndpoint, projectID, serviceEndpointReadyTimeoutSeconds)
+		if err != nil {
+			return fmt.Errorf("Error waiting for service endpoint to become ready in Azure DevOps: %+v", err)
+		}
+
+		flatFunc(d, createdServiceEndpoint, projectID, clients.secretSettings)
+		return nil
+	}
+}
+
+func genServiceEndpointReadFunc(flatFunc flatFunc) func(d *schema.ResourceData, m interface{}) error {
+	return func(d *schema.ResourceData, m interface{}) error {
+		clients := m.(*aggregatedClient)
+
+		serviceEndpointID, err := uuid.Parse(d.Id())
+		if err != nil {
+			return fmt.Errorf("Error parsing the service endpoint ID from the Terraform resource data: %v", err)
+		}
+		projectID := converter.String(d.Get("project_id").(string))
+
+		serviceEndpoint, err := clients.ServiceEndpointClient.GetServiceEndpointDetails(
+			clients.ctx,
+			serviceendpoint.GetServiceEndpointDetailsArgs{
+				EndpointId: &serviceEndpointID,
+				Project:    projectID,
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("Error looking up service endpoint given ID (%v) and project ID (%v): %v", serviceEndpointID, projectID, err)
+		}
+
+		flatFunc(d, serviceEndpoint, projectID, clients.secretSettings)
+		return nil
+	}
+}
+
+func genServiceEndpointUpdateFunc(flatFunc flatFunc, expandFunc expandFunc) func(d *schema.ResourceData, m interface{}) error {
+	return func(d *schema.ResourceData, m interface{}) error {
+		clients := m.(*aggregatedClient)
+		serviceEndpoint, projectID := expandFunc(d)
+
+		updatedServiceEndpoint, err := updateServiceEndpoint(clients, serviceEndpoint, projectID)
+		if err != nil {
+			return fmt.Errorf("Error updating service endpoint in Azure DevOps: %+v", err)
+		}
+
+		flatFunc(d, updatedServiceEndpoint, projectID, clients.secretSettings)
+		return nil
+	}
+}
+
+func genServiceEndpointDeleteFunc(expandFunc expandFunc) func(d *schema.ResourceData, m interface{}) error {
+	return func(d *schema.ResourceData, m interface{}) error {
+		clients := m.(*aggregatedClient)
+		serviceEndpoint, projectID := expandFunc(d)
+
+		return deleteServiceEndpoint(clients, projectID, serviceEndpoint.Id)
+	}
+}
+
+// Poll the service endpoint until the service reports that it is ready to be used. Some endpoint
+// types are provisioned asynchronously and cannot be consumed by pipelines until this completes.
+func waitForServiceEndpointReady(clients *aggregatedClient, endpoint *serviceendpoint.ServiceEndpoint, projectID *string, timeoutSeconds int) (*serviceendpoint.ServiceEndpoint, error) {
+	if endpoint.IsReady == nil || *endpoint.IsReady {
+		return endpoint, nil
+	}
+
+	timeout := time.After(time.Duration(timeoutSeconds) * time.Second)
+	ticker := time.NewTicker(1 * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			current, err := clients.ServiceEndpointClient.GetServiceEndpointDetails(
+				clients.ctx,
+				serviceendpoint.GetServiceEndpointDetailsArgs{
+					EndpointId: endpoint.Id,
+					Project:    projectID,
+				},
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			if current.IsReady != nil && *current.IsReady {
+				return current, nil
+			}
+		case <-timeout:
+			return nil, fmt.Errorf("Service endpoint was not ready after %d seconds", timeoutSeconds)
+		}
+	}
+}
+
+// serviceEndpointAuthScheme describes one of the authentication schemes of a service endpoint type that supports
+// several of them
+type serviceEndpointAuthScheme struct {
+	// identifies the scheme within its endpoint type
+	name string
+	// the attributes that configure the scheme. All of them have to be set when the scheme is used. Schemes that
+	// are configured through a block name the block instead, and are selected through expandAuthScheme.
+	attributes []string
+	// the authorization scheme of the endpoint in Azure DevOps, e.g. Token. Defaults to the name.
+	authScheme string
+	// converts the attributes of the scheme into its authorization parameters and the data of the endpoint
+	expandParameters func(d *schema.ResourceData) (parameters map[string]string, data map[string]string)
+	// restores the attributes of the scheme from the authorization parameters and the data
+	flattenParameters func(d *schema.ResourceData, parameters map[string]string, data map[string]string, secretSettings *secretmemo.Settings)
+}
+
+func (scheme *serviceEndpointAuthScheme) getAuthScheme() string {
+	if scheme.authScheme != "" {
+		return scheme.authScheme
+	}
+	return scheme.name
+}
+
+// Verifies at plan time that exactly one of the given authentication schemes is configured
+func validateServiceEndpointAuthSchemes(d *schema.ResourceDiff, schemes []serviceEndpointAuthScheme) error {
+	var configured []serviceEndpointAuthScheme
+	var names []string
+	for _, scheme := range schemes {
+		names = append(names, scheme.name)
+		for _, attribute := range scheme.attributes {
+			if !d.NewValueKnown(attribute) {
+				return nil
+			}
+			if d.Get(attribute).(string) != "" {
+				configured = append(configured, scheme)
+				break
+			}
+		}
+	}
+
+	if len(configured) != 1 {
+		return fmt.Errorf("exactly one authentication scheme must be configured, valid schemes are: %s", strings.Join(names, ", "))
+	}
+	for _, attribute := range configured[0].attributes {
+		if d.Get(attribute).(string) == "" {
+			return fmt.Errorf("%s authentication requires %s to be set", configured[0].name, strings.Join(configured[0].attributes, " and "))
+		}
+	}
+	return nil
+}
+
+// Returns the name of the first of the given authentication schemes that is configured. The service does not
+// return secrets, so a secret that is unchanged since the last apply may only be known by its hash in the state,
+// which is considered if none of the schemes has a value.
+func getServiceEndpointAuthScheme(d *schema.ResourceData, schemes []serviceEndpointAuthScheme) string {
+	for _, suffix := range []string{"", "_hash"} {
+		for _, scheme := range schemes {
+			for _, attribute := range scheme.attributes {
+				if value, _ := d.Get(attribute + suffix).(string); value != "" {
+					return scheme.name
+				}
+			}
+		}
+	}
+	return ""
+}
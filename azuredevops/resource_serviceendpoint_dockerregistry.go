@@ -0,0 +1,92 @@
+package azuredevops
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/secretmemo"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/tfhelper"
+)
+
+const dockerHubRegistryURL = "https://index.docker.io/v1/"
+
+func resourceServiceEndpointDockerRegistry() *schema.Resource {
+	r := genServiceEndpointResourceFromArgs(serviceEndpointDockerRegistryArgs)
+
+	r.Schema["docker_registry"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Computed:    true,
+		Description: "The DockerRegistry registry which should be used. Defaults to Docker Hub when registry_type is DockerHub.",
+	}
+	r.Schema["docker_username"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		DefaultFunc: schema.EnvDefaultFunc("AZDO_DOCKERREGISTRY_SERVICE_CONNECTION_USERNAME", nil),
+		Description: "The DockerRegistry username which should be used.",
+	}
+	r.Schema["docker_email"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		DefaultFunc: schema.EnvDefaultFunc("AZDO_DOCKERREGISTRY_SERVICE_CONNECTION_EMAIL", nil),
+		Description: "The DockerRegistry email address which should be used.",
+	}
+
+	secretHashKey, secretHashSchema := tfhelper.GenerateSecreteMemoSchema("docker_password")
+	r.Schema["docker_password"] = &schema.Schema{
+		Type:             schema.TypeString,
+		Optional:         true,
+		DefaultFunc:      schema.EnvDefaultFunc("AZDO_DOCKERREGISTRY_SERVICE_CONNECTION_PASSWORD", nil),
+		Description:      "The DockerRegistry password which should be used.",
+		Sensitive:        true,
+		DiffSuppressFunc: tfhelper.DiffFuncSupressSecretChanged,
+	}
+	r.Schema[secretHashKey] = secretHashSchema
+
+	r.Schema["registry_type"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Default:      "DockerHub",
+		Description:  "Can be DockerHub or Others (Default DockerHub)",
+		ValidateFunc: validation.StringInSlice([]string{"DockerHub", "Others"}, false),
+	}
+
+	return r
+}
+
+var serviceEndpointDockerRegistryArgs = &serviceEndpointCRUDArgs{
+	endpointType: "dockerregistry",
+	authScheme:   "UsernamePassword",
+	expandURL:    expandDockerRegistry,
+	expandParameters: func(d *schema.ResourceData) (map[string]string, map[string]string) {
+		parameters := map[string]string{
+			"registry": expandDockerRegistry(d),
+			"username": d.Get("docker_username").(string),
+			"email":    d.Get("docker_email").(string),
+			"password": d.Get("docker_password").(string),
+		}
+		data := map[string]string{
+			"registrytype": d.Get("registry_type").(string),
+		}
+		return parameters, data
+	},
+	flattenParameters: func(d *schema.ResourceData, parameters map[string]string, data map[string]string, secretSettings *secretmemo.Settings) {
+		d.Set("docker_registry", parameters["registry"])
+		d.Set("docker_username", parameters["username"])
+		d.Set("docker_email", parameters["email"])
+		if registryType, ok := data["registrytype"]; ok {
+			d.Set("registry_type", registryType)
+		}
+
+		tfhelper.HelpFlattenSecret(d, "docker_password", secretSettings)
+		d.Set("docker_password", parameters["password"])
+	},
+}
+
+// Returns the configured registry, which defaults to Docker Hub for registries of type DockerHub
+func expandDockerRegistry(d *schema.ResourceData) string {
+	registry := d.Get("docker_registry").(string)
+	if registry == "" && d.Get("registry_type").(string) == "DockerHub" {
+		registry = dockerHubRegistryURL
+	}
+	return registry
+}
@@ -0,0 +1,226 @@
+package azuredevops
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/serviceendpoint"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var dockerRegistryTestServiceEndpointID = uuid.New()
+var dockerRegistryRandomServiceEndpointProjectID = uuid.New().String()
+var dockerRegistryTestServiceEndpointProjectID = &dockerRegistryRandomServiceEndpointProjectID
+
+var dockerRegistryTestServiceEndpoint = serviceendpoint.ServiceEndpoint{
+	Authorization: &serviceendpoint.EndpointAuthorization{
+		Parameters: &map[string]string{
+			"registry": "https://index.docker.io/v1/",
+			"username": "DH_TEST_username",
+			"email":    "DH_TEST_email",
+			"password": "DH_TEST_password",
+		},
+		Scheme: converter.String("UsernamePassword"),
+	},
+	Data: &map[string]string{
+		"registrytype": "DockerHub",
+	},
+	Id:    &dockerRegistryTestServiceEndpointID,
+	Name:  converter.String("UNIT_TEST_NAME"),
+	Owner: converter.String("library"),
+	Type:  converter.String("dockerregistry"),
+	Url:   converter.String("https://index.docker.io/v1/"),
+}
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that the flatten/expand round trip yields the same service endpoint
+func TestAzureDevOpsServiceEndpointDockerRegistry_ExpandFlatten_Roundtrip(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, resourceServiceEndpointDockerRegistry().Schema, nil)
+	serviceEndpointDockerRegistryArgs.flatten(resourceData, &dockerRegistryTestServiceEndpoint, dockerRegistryTestServiceEndpointProjectID, nil)
+
+	serviceEndpointAfterRoundTrip, projectID := serviceEndpointDockerRegistryArgs.expand(resourceData)
+
+	require.Equal(t, dockerRegistryTestServiceEndpoint, *serviceEndpointAfterRoundTrip)
+	require.Equal(t, dockerRegistryTestServiceEndpointProjectID, projectID)
+}
+
+// verifies that the registry URL defaults to Docker Hub when it is not configured
+func TestAzureDevOpsServiceEndpointDockerRegistry_Expand_DefaultsDockerHubURL(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, resourceServiceEndpointDockerRegistry().Schema, map[string]interface{}{
+		"project_id":            dockerRegistryRandomServiceEndpointProjectID,
+		"service_endpoint_name": "UNIT_TEST_NAME",
+		"registry_type":         "DockerHub",
+	})
+
+	serviceEndpoint, _ := serviceEndpointDockerRegistryArgs.expand(resourceData)
+
+	require.Equal(t, dockerHubRegistryURL, *serviceEndpoint.Url)
+	require.Equal(t, dockerHubRegistryURL, (*serviceEndpoint.Authorization.Parameters)["registry"])
+}
+
+// verifies that if an error is produced on create, the error is not swallowed
+func TestAzureDevOpsServiceEndpointDockerRegistry_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := resourceServiceEndpointDockerRegistry()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	serviceEndpointDockerRegistryArgs.flatten(resourceData, &dockerRegistryTestServiceEndpoint, dockerRegistryTestServiceEndpointProjectID, nil)
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &aggregatedClient{ServiceEndpointClient: buildClient, ctx: context.Background()}
+
+	expectedArgs := serviceendpoint.CreateServiceEndpointArgs{Endpoint: &dockerRegistryTestServiceEndpoint, Project: dockerRegistryTestServiceEndpointProjectID}
+	buildClient.
+		EXPECT().
+		CreateServiceEndpoint(clients.ctx, expectedArgs).
+		Return(nil, errors.New("CreateServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Create(resourceData, clients)
+	require.Contains(t, err.Error(), "CreateServiceEndpoint() Failed")
+}
+
+// verifies that if an error is produced on a read, it is not swallowed
+func TestAzureDevOpsServiceEndpointDockerRegistry_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := resourceServiceEndpointDockerRegistry()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	serviceEndpointDockerRegistryArgs.flatten(resourceData, &dockerRegistryTestServiceEndpoint, dockerRegistryTestServiceEndpointProjectID, nil)
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &aggregatedClient{ServiceEndpointClient: buildClient, ctx: context.Background()}
+
+	expectedArgs := serviceendpoint.GetServiceEndpointDetailsArgs{EndpointId: dockerRegistryTestServiceEndpoint.Id, Project: dockerRegistryTestServiceEndpointProjectID}
+	buildClient.
+		EXPECT().
+		GetServiceEndpointDetails(clients.ctx, expectedArgs).
+		Return(nil, errors.New("GetServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Read(resourceData, clients)
+	require.Contains(t, err.Error(), "GetServiceEndpoint() Failed")
+}
+
+// verifies that if an error is produced on a delete, it is not swallowed
+func TestAzureDevOpsServiceEndpointDockerRegistry_Delete_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := resourceServiceEndpointDockerRegistry()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	serviceEndpointDockerRegistryArgs.flatten(resourceData, &dockerRegistryTestServiceEndpoint, dockerRegistryTestServiceEndpointProjectID, nil)
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &aggregatedClient{ServiceEndpointClient: buildClient, ctx: context.Background()}
+
+	expectedArgs := serviceendpoint.DeleteServiceEndpointArgs{EndpointId: dockerRegistryTestServiceEndpoint.Id, Project: dockerRegistryTestServiceEndpointProjectID}
+	buildClient.
+		EXPECT().
+		DeleteServiceEndpoint(clients.ctx, expectedArgs).
+		Return(errors.New("DeleteServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Delete(resourceData, clients)
+	require.Contains(t, err.Error(), "DeleteServiceEndpoint() Failed")
+}
+
+// verifies that if an error is produced on an update, it is not swallowed
+func TestAzureDevOpsServiceEndpointDockerRegistry_Update_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := resourceServiceEndpointDockerRegistry()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	serviceEndpointDockerRegistryArgs.flatten(resourceData, &dockerRegistryTestServiceEndpoint, dockerRegistryTestServiceEndpointProjectID, nil)
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &aggregatedClient{ServiceEndpointClient: buildClient, ctx: context.Background()}
+
+	expectedArgs := serviceendpoint.UpdateServiceEndpointArgs{
+		Endpoint:   &dockerRegistryTestServiceEndpoint,
+		EndpointId: dockerRegistryTestServiceEndpoint.Id,
+		Project:    dockerRegistryTestServiceEndpointProjectID,
+	}
+
+	buildClient.
+		EXPECT().
+		UpdateServiceEndpoint(clients.ctx, expectedArgs).
+		Return(nil, errors.New("UpdateServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Update(resourceData, clients)
+	require.Contains(t, err.Error(), "UpdateServiceEndpoint() Failed")
+}
+
+/**
+ * Begin acceptance tests
+ */
+
+// validates that an apply followed by another apply (i.e., resource update) will be reflected in AzDO and the
+// underlying terraform state.
+func TestAccAzureDevOpsServiceEndpointDockerRegistry_CreateAndUpdate(t *testing.T) {
+	projectName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	serviceEndpointNameFirst := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	serviceEndpointNameSecond := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	tfSvcEpNode := "azuredevops_serviceendpoint_dockerregistry.serviceendpoint"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccServiceEndpointCheckDestroyByType("azuredevops_serviceendpoint_dockerregistry"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceEndpointDockerRegistryResource(projectName, serviceEndpointNameFirst),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "docker_registry", "https://index.docker.io/v1/"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "docker_password", ""),
+					resource.TestCheckResourceAttrSet(tfSvcEpNode, "docker_password_hash"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "service_endpoint_name", serviceEndpointNameFirst),
+					testAccCheckServiceEndpointResourceExistsByNode(tfSvcEpNode, serviceEndpointNameFirst),
+				),
+			}, {
+				Config: testAccServiceEndpointDockerRegistryResource(projectName, serviceEndpointNameSecond),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "docker_registry", "https://index.docker.io/v1/"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "docker_password", ""),
+					resource.TestCheckResourceAttrSet(tfSvcEpNode, "docker_password_hash"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "service_endpoint_name", serviceEndpointNameSecond),
+					testAccCheckServiceEndpointResourceExistsByNode(tfSvcEpNode, serviceEndpointNameSecond),
+				),
+			},
+		},
+	})
+}
+
+// HCL describing an AzDO Docker registry service endpoint
+func testAccServiceEndpointDockerRegistryResource(projectName string, serviceEndpointName string) string {
+	serviceEndpointResource := fmt.Sprintf(`
+resource "azuredevops_serviceendpoint_dockerregistry" "serviceendpoint" {
+	project_id            = azuredevops_project.project.id
+	service_endpoint_name = "%s"
+	docker_username       = "test-user"
+	docker_email          = "test@example.com"
+	docker_password       = "test-password"
+	registry_type         = "DockerHub"
+}`, serviceEndpointName)
+
+	projectResource := testAccProjectResource(projectName)
+	return fmt.Sprintf("%s\n%s", projectResource, serviceEndpointResource)
+}
@@ -0,0 +1,92 @@
+package azuredevops
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/secretmemo"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/tfhelper"
+)
+
+func resourceServiceEndpointExternalTFS() *schema.Resource {
+	r := genServiceEndpointResourceFromArgs(serviceEndpointExternalTFSArgs)
+
+	r.Schema["connection_url"] = &schema.Schema{
+		Type:             schema.TypeString,
+		Required:         true,
+		ValidateFunc:     validateExternalTFSConnectionURL,
+		DiffSuppressFunc: tfhelper.DiffFuncSuppressURLEquivalence,
+		Description:      "The URL of the Azure DevOps organization or the TFS collection.",
+	}
+
+	tokenHashKey, tokenHashSchema := tfhelper.GenerateSecreteMemoSchema("personal_access_token")
+	r.Schema["personal_access_token"] = &schema.Schema{
+		Type:             schema.TypeString,
+		Required:         true,
+		Description:      "The personal access token used to authenticate with the organization or the collection.",
+		Sensitive:        true,
+		DiffSuppressFunc: tfhelper.DiffFuncSupressSecretChanged,
+		ValidateFunc:     validation.NoZeroValues,
+	}
+	r.Schema[tokenHashKey] = tokenHashSchema
+
+	return r
+}
+
+// External TFS endpoints connect to another Azure DevOps organization or TFS collection with a personal access token
+var serviceEndpointExternalTFSArgs = &serviceEndpointCRUDArgs{
+	endpointType: "externaltfs",
+	authScheme:   "Token",
+	urlKey:       "connection_url",
+	expandParameters: func(d *schema.ResourceData) (map[string]string, map[string]string) {
+		return map[string]string{
+			"apitoken": d.Get("personal_access_token").(string),
+		}, nil
+	},
+	flattenParameters: func(d *schema.ResourceData, parameters map[string]string, data map[string]string, secretSettings *secretmemo.Settings) {
+		tfhelper.HelpFlattenSecret(d, "personal_access_token", secretSettings)
+		d.Set("personal_access_token", parameters["apitoken"])
+	},
+}
+
+// Verifies that a connection URL points at an Azure DevOps organization, i.e. https://dev.azure.com/{organization}
+// or https://{organization}.visualstudio.com, or at the collection of a TFS server, e.g.
+// https://{server}/tfs/{collection}
+func validateExternalTFSConnectionURL(i interface{}, k string) ([]string, []error) {
+	connectionURL, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %q to be string", k)}
+	}
+
+	u, err := url.Parse(connectionURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Hostname() == "" {
+		return nil, []error{fmt.Errorf("%q must be an absolute http or https URL, got %q", k, connectionURL)}
+	}
+
+	var segments []string
+	for _, segment := range strings.Split(u.Path, "/") {
+		if segment != "" {
+			segments = append(segments, segment)
+		}
+	}
+
+	host := strings.ToLower(u.Hostname())
+	switch {
+	case host == "dev.azure.com":
+		if len(segments) != 1 {
+			return nil, []error{fmt.Errorf("%q must be of the form https://dev.azure.com/{organization}, got %q", k, connectionURL)}
+		}
+	case strings.HasSuffix(host, ".visualstudio.com"):
+		if len(segments) > 1 || (len(segments) == 1 && !strings.EqualFold(segments[0], "DefaultCollection")) {
+			return nil, []error{fmt.Errorf("%q must be of the form https://{organization}.visualstudio.com, got %q", k, connectionURL)}
+		}
+	default:
+		if len(segments) == 0 {
+			return nil, []error{fmt.Errorf("%q must point at a TFS collection, e.g. https://{server}/tfs/{collection}, got %q", k, connectionURL)}
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,235 @@
+package azuredevops
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/serviceendpoint"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var externalTFSTestServiceEndpointID = uuid.New()
+var externalTFSRandomServiceEndpointProjectID = uuid.New().String()
+var externalTFSTestServiceEndpointProjectID = &externalTFSRandomServiceEndpointProjectID
+
+var externalTFSTestServiceEndpoint = serviceendpoint.ServiceEndpoint{
+	Authorization: &serviceendpoint.EndpointAuthorization{
+		Parameters: &map[string]string{
+			"apitoken": "EXTERNALTFS_TEST_TOKEN",
+		},
+		Scheme: converter.String("Token"),
+	},
+	Id:    &externalTFSTestServiceEndpointID,
+	Name:  converter.String("UNIT_TEST_NAME"),
+	Owner: converter.String("library"),
+	Type:  converter.String("externaltfs"),
+	Url:   converter.String("https://dev.azure.com/example"),
+}
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that the flatten/expand round trip yields the same service endpoint
+func TestAzureDevOpsServiceEndpointExternalTFS_ExpandFlatten_Roundtrip(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, resourceServiceEndpointExternalTFS().Schema, nil)
+	serviceEndpointExternalTFSArgs.flatten(resourceData, &externalTFSTestServiceEndpoint, externalTFSTestServiceEndpointProjectID, nil)
+
+	serviceEndpointAfterRoundTrip, projectID := serviceEndpointExternalTFSArgs.expand(resourceData)
+
+	require.Equal(t, externalTFSTestServiceEndpoint, *serviceEndpointAfterRoundTrip)
+	require.Equal(t, externalTFSTestServiceEndpointProjectID, projectID)
+}
+
+// verifies that only URLs of Azure DevOps organizations and TFS collections are accepted as connection URL
+func TestAzureDevOpsServiceEndpointExternalTFS_ValidateConnectionURL(t *testing.T) {
+	validURLs := []string{
+		"https://dev.azure.com/example",
+		"https://dev.azure.com/example/",
+		"https://example.visualstudio.com",
+		"https://example.visualstudio.com/DefaultCollection",
+		"https://tfs.example.com/tfs/DefaultCollection",
+		"http://tfs.example.com:8080/tfs/DefaultCollection/",
+	}
+	for _, connectionURL := range validURLs {
+		_, errs := validateExternalTFSConnectionURL(connectionURL, "connection_url")
+		require.Empty(t, errs, connectionURL)
+	}
+
+	invalidURLs := []string{
+		"",
+		"dev.azure.com/example",
+		"ftp://tfs.example.com/tfs/DefaultCollection",
+		"https://dev.azure.com",
+		"https://dev.azure.com/example/project",
+		"https://example.visualstudio.com/project",
+		"https://tfs.example.com",
+		"https://tfs.example.com/",
+	}
+	for _, connectionURL := range invalidURLs {
+		_, errs := validateExternalTFSConnectionURL(connectionURL, "connection_url")
+		require.NotEmpty(t, errs, connectionURL)
+	}
+}
+
+// verifies that if an error is produced on create, the error is not swallowed
+func TestAzureDevOpsServiceEndpointExternalTFS_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := resourceServiceEndpointExternalTFS()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	serviceEndpointExternalTFSArgs.flatten(resourceData, &externalTFSTestServiceEndpoint, externalTFSTestServiceEndpointProjectID, nil)
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &aggregatedClient{ServiceEndpointClient: buildClient, ctx: context.Background()}
+
+	expectedArgs := serviceendpoint.CreateServiceEndpointArgs{Endpoint: &externalTFSTestServiceEndpoint, Project: externalTFSTestServiceEndpointProjectID}
+	buildClient.
+		EXPECT().
+		CreateServiceEndpoint(clients.ctx, expectedArgs).
+		Return(nil, errors.New("CreateServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Create(resourceData, clients)
+	require.Contains(t, err.Error(), "CreateServiceEndpoint() Failed")
+}
+
+// verifies that if an error is produced on a read, it is not swallowed
+func TestAzureDevOpsServiceEndpointExternalTFS_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := resourceServiceEndpointExternalTFS()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	serviceEndpointExternalTFSArgs.flatten(resourceData, &externalTFSTestServiceEndpoint, externalTFSTestServiceEndpointProjectID, nil)
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &aggregatedClient{ServiceEndpointClient: buildClient, ctx: context.Background()}
+
+	expectedArgs := serviceendpoint.GetServiceEndpointDetailsArgs{EndpointId: externalTFSTestServiceEndpoint.Id, Project: externalTFSTestServiceEndpointProjectID}
+	buildClient.
+		EXPECT().
+		GetServiceEndpointDetails(clients.ctx, expectedArgs).
+		Return(nil, errors.New("GetServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Read(resourceData, clients)
+	require.Contains(t, err.Error(), "GetServiceEndpoint() Failed")
+}
+
+// verifies that if an error is produced on a delete, it is not swallowed
+func TestAzureDevOpsServiceEndpointExternalTFS_Delete_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := resourceServiceEndpointExternalTFS()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	serviceEndpointExternalTFSArgs.flatten(resourceData, &externalTFSTestServiceEndpoint, externalTFSTestServiceEndpointProjectID, nil)
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &aggregatedClient{ServiceEndpointClient: buildClient, ctx: context.Background()}
+
+	expectedArgs := serviceendpoint.DeleteServiceEndpointArgs{EndpointId: externalTFSTestServiceEndpoint.Id, Project: externalTFSTestServiceEndpointProjectID}
+	buildClient.
+		EXPECT().
+		DeleteServiceEndpoint(clients.ctx, expectedArgs).
+		Return(errors.New("DeleteServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Delete(resourceData, clients)
+	require.Contains(t, err.Error(), "DeleteServiceEndpoint() Failed")
+}
+
+// verifies that if an error is produced on an update, it is not swallowed
+func TestAzureDevOpsServiceEndpointExternalTFS_Update_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := resourceServiceEndpointExternalTFS()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	serviceEndpointExternalTFSArgs.flatten(resourceData, &externalTFSTestServiceEndpoint, externalTFSTestServiceEndpointProjectID, nil)
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &aggregatedClient{ServiceEndpointClient: buildClient, ctx: context.Background()}
+
+	expectedArgs := serviceendpoint.UpdateServiceEndpointArgs{
+		Endpoint:   &externalTFSTestServiceEndpoint,
+		EndpointId: externalTFSTestServiceEndpoint.Id,
+		Project:    externalTFSTestServiceEndpointProjectID,
+	}
+
+	buildClient.
+		EXPECT().
+		UpdateServiceEndpoint(clients.ctx, expectedArgs).
+		Return(nil, errors.New("UpdateServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Update(resourceData, clients)
+	require.Contains(t, err.Error(), "UpdateServiceEndpoint() Failed")
+}
+
+/**
+ * Begin acceptance tests
+ */
+
+// validates that an apply followed by another apply (i.e., resource update) will be reflected in AzDO and the
+// underlying terraform state.
+func TestAccAzureDevOpsServiceEndpointExternalTFS_CreateAndUpdate(t *testing.T) {
+	projectName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	serviceEndpointNameFirst := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	serviceEndpointNameSecond := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	tfSvcEpNode := "azuredevops_serviceendpoint_externaltfs.serviceendpoint"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccServiceEndpointCheckDestroyByType("azuredevops_serviceendpoint_externaltfs"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceEndpointExternalTFSResource(projectName, serviceEndpointNameFirst),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "connection_url", "https://dev.azure.com/example"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "personal_access_token", ""),
+					resource.TestCheckResourceAttrSet(tfSvcEpNode, "personal_access_token_hash"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "service_endpoint_name", serviceEndpointNameFirst),
+					testAccCheckServiceEndpointResourceExistsByNode(tfSvcEpNode, serviceEndpointNameFirst),
+				),
+			}, {
+				Config: testAccServiceEndpointExternalTFSResource(projectName, serviceEndpointNameSecond),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "connection_url", "https://dev.azure.com/example"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "personal_access_token", ""),
+					resource.TestCheckResourceAttrSet(tfSvcEpNode, "personal_access_token_hash"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "service_endpoint_name", serviceEndpointNameSecond),
+					testAccCheckServiceEndpointResourceExistsByNode(tfSvcEpNode, serviceEndpointNameSecond),
+				),
+			},
+		},
+	})
+}
+
+// HCL describing an AzDO external TFS service endpoint
+func testAccServiceEndpointExternalTFSResource(projectName string, serviceEndpointName string) string {
+	serviceEndpointResource := fmt.Sprintf(`
+resource "azuredevops_serviceendpoint_externaltfs" "serviceendpoint" {
+	project_id            = azuredevops_project.project.id
+	service_endpoint_name = "%s"
+	connection_url        = "https://dev.azure.com/example"
+	personal_access_token = "0000000000000000000000000000000000000000"
+}`, serviceEndpointName)
+
+	projectResource := testAccProjectResource(projectName)
+	return fmt.Sprintf("%s\n%s", projectResource, serviceEndpointResource)
+}
@@ -0,0 +1,105 @@
+package azuredevops
+
+import (
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/secretmemo"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/tfhelper"
+)
+
+// The attributes of a GCP service account key that the endpoint is signed with
+type gcpServiceAccountKey struct {
+	ClientEmail string `json:"client_email"`
+	PrivateKey  string `json:"private_key"`
+}
+
+func resourceServiceEndpointGcp() *schema.Resource {
+	r := genServiceEndpointResourceFromArgs(serviceEndpointGcpArgs)
+
+	tokenHashKey, tokenHashSchema := tfhelper.GenerateSecreteMemoSchema("token")
+	r.Schema["token"] = &schema.Schema{
+		Type:             schema.TypeString,
+		Required:         true,
+		DefaultFunc:      schema.EnvDefaultFunc("AZDO_GCP_SERVICE_CONNECTION_TOKEN", nil),
+		Description:      "The JSON key of the GCP service account.",
+		Sensitive:        true,
+		DiffSuppressFunc: tfhelper.DiffFuncSupressSecretChanged,
+		ValidateFunc:     validation.ValidateJsonString,
+	}
+	r.Schema[tokenHashKey] = tokenHashSchema
+
+	// project_id names the Azure DevOps project of every service endpoint
+	r.Schema["gcp_project_id"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		Description:  "The ID of the GCP project.",
+		ValidateFunc: validation.NoZeroValues,
+	}
+	r.Schema["scope"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Default:     "https://www.googleapis.com/auth/cloud-platform",
+		Description: "The scope of the access tokens requested for the service account.",
+	}
+	r.Schema["audience"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Default:     "https://oauth2.googleapis.com/token",
+		Description: "The audience of the JWT, i.e. the URL that issues the access tokens.",
+	}
+
+	privateKeyHashKey, privateKeyHashSchema := tfhelper.GenerateSecreteMemoSchema("private_key")
+	r.Schema["private_key"] = &schema.Schema{
+		Type:             schema.TypeString,
+		Optional:         true,
+		Description:      "The private key the JWT is signed with. Defaults to the private key of the JSON key.",
+		Sensitive:        true,
+		DiffSuppressFunc: tfhelper.DiffFuncSupressSecretChanged,
+	}
+	r.Schema[privateKeyHashKey] = privateKeyHashSchema
+
+	return r
+}
+
+var serviceEndpointGcpArgs = &serviceEndpointCRUDArgs{
+	endpointType: "google-cloud",
+	authScheme:   "JWT",
+	url:          "https://www.googleapis.com/",
+	expandParameters: func(d *schema.ResourceData) (map[string]string, map[string]string) {
+		token := d.Get("token").(string)
+		// the key is validated to be JSON at plan time
+		var key gcpServiceAccountKey
+		_ = json.Unmarshal([]byte(token), &key)
+
+		privateKey := d.Get("private_key").(string)
+		if privateKey == "" {
+			privateKey = key.PrivateKey
+		}
+
+		return map[string]string{
+			"certificate": token,
+			"Issuer":      key.ClientEmail,
+			"Audience":    d.Get("audience").(string),
+			"Scope":       d.Get("scope").(string),
+			"PrivateKey":  privateKey,
+		}, map[string]string{
+			"project": d.Get("gcp_project_id").(string),
+		}
+	},
+	flattenParameters: func(d *schema.ResourceData, parameters map[string]string, data map[string]string, secretSettings *secretmemo.Settings) {
+		d.Set("gcp_project_id", data["project"])
+		if audience := parameters["Audience"]; audience != "" {
+			d.Set("audience", audience)
+		}
+		if scope := parameters["Scope"]; scope != "" {
+			d.Set("scope", scope)
+		}
+
+		tfhelper.HelpFlattenSecret(d, "token", secretSettings)
+		tfhelper.HelpFlattenSecret(d, "private_key", secretSettings)
+		d.Set("token", parameters["certificate"])
+		d.Set("private_key", parameters["PrivateKey"])
+	},
+}
@@ -0,0 +1,241 @@
+package azuredevops
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/serviceendpoint"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var gcpTestServiceEndpointID = uuid.New()
+var gcpRandomServiceEndpointProjectID = uuid.New().String()
+var gcpTestServiceEndpointProjectID = &gcpRandomServiceEndpointProjectID
+
+var gcpTestServiceAccountKey = `{"type": "service_account", "client_email": "GCP_TEST_client_email", "private_key": "GCP_TEST_private_key"}`
+
+var gcpTestServiceEndpoint = serviceendpoint.ServiceEndpoint{
+	Authorization: &serviceendpoint.EndpointAuthorization{
+		Parameters: &map[string]string{
+			"certificate": gcpTestServiceAccountKey,
+			"Issuer":      "GCP_TEST_client_email",
+			"Audience":    "https://oauth2.googleapis.com/token",
+			"Scope":       "https://www.googleapis.com/auth/cloud-platform",
+			"PrivateKey":  "GCP_TEST_private_key",
+		},
+		Scheme: converter.String("JWT"),
+	},
+	Data: &map[string]string{
+		"project": "GCP_TEST_project",
+	},
+	Id:    &gcpTestServiceEndpointID,
+	Name:  converter.String("UNIT_TEST_NAME"),
+	Owner: converter.String("library"),
+	Type:  converter.String("google-cloud"),
+	Url:   converter.String("https://www.googleapis.com/"),
+}
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that the flatten/expand round trip yields the same service endpoint
+func TestAzureDevOpsServiceEndpointGcp_ExpandFlatten_Roundtrip(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, resourceServiceEndpointGcp().Schema, nil)
+	serviceEndpointGcpArgs.flatten(resourceData, &gcpTestServiceEndpoint, gcpTestServiceEndpointProjectID, nil)
+
+	serviceEndpointAfterRoundTrip, projectID := serviceEndpointGcpArgs.expand(resourceData)
+
+	require.Equal(t, gcpTestServiceEndpoint, *serviceEndpointAfterRoundTrip)
+	require.Equal(t, gcpTestServiceEndpointProjectID, projectID)
+}
+
+// verifies that the private key of the service account key is used unless a private key is configured
+func TestAzureDevOpsServiceEndpointGcp_Expand_DefaultsPrivateKeyToServiceAccountKey(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, resourceServiceEndpointGcp().Schema, nil)
+	resourceData.Set("token", gcpTestServiceAccountKey)
+
+	serviceEndpoint, _ := serviceEndpointGcpArgs.expand(resourceData)
+	require.Equal(t, "GCP_TEST_private_key", (*serviceEndpoint.Authorization.Parameters)["PrivateKey"])
+	require.Equal(t, "GCP_TEST_client_email", (*serviceEndpoint.Authorization.Parameters)["Issuer"])
+
+	resourceData.Set("private_key", "GCP_TEST_configured_private_key")
+	serviceEndpoint, _ = serviceEndpointGcpArgs.expand(resourceData)
+	require.Equal(t, "GCP_TEST_configured_private_key", (*serviceEndpoint.Authorization.Parameters)["PrivateKey"])
+}
+
+// verifies that a service account key that is not JSON is rejected at plan time
+func TestAzureDevOpsServiceEndpointGcp_ValidatesServiceAccountKey(t *testing.T) {
+	validate := resourceServiceEndpointGcp().Schema["token"].ValidateFunc
+
+	_, errs := validate(gcpTestServiceAccountKey, "token")
+	require.Empty(t, errs)
+	_, errs = validate("not a json key", "token")
+	require.NotEmpty(t, errs)
+}
+
+// verifies that if an error is produced on create, the error is not swallowed
+func TestAzureDevOpsServiceEndpointGcp_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := resourceServiceEndpointGcp()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	serviceEndpointGcpArgs.flatten(resourceData, &gcpTestServiceEndpoint, gcpTestServiceEndpointProjectID, nil)
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &aggregatedClient{ServiceEndpointClient: buildClient, ctx: context.Background()}
+
+	expectedArgs := serviceendpoint.CreateServiceEndpointArgs{Endpoint: &gcpTestServiceEndpoint, Project: gcpTestServiceEndpointProjectID}
+	buildClient.
+		EXPECT().
+		CreateServiceEndpoint(clients.ctx, expectedArgs).
+		Return(nil, errors.New("CreateServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Create(resourceData, clients)
+	require.Contains(t, err.Error(), "CreateServiceEndpoint() Failed")
+}
+
+// verifies that if an error is produced on a read, it is not swallowed
+func TestAzureDevOpsServiceEndpointGcp_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := resourceServiceEndpointGcp()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	serviceEndpointGcpArgs.flatten(resourceData, &gcpTestServiceEndpoint, gcpTestServiceEndpointProjectID, nil)
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &aggregatedClient{ServiceEndpointClient: buildClient, ctx: context.Background()}
+
+	expectedArgs := serviceendpoint.GetServiceEndpointDetailsArgs{EndpointId: gcpTestServiceEndpoint.Id, Project: gcpTestServiceEndpointProjectID}
+	buildClient.
+		EXPECT().
+		GetServiceEndpointDetails(clients.ctx, expectedArgs).
+		Return(nil, errors.New("GetServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Read(resourceData, clients)
+	require.Contains(t, err.Error(), "GetServiceEndpoint() Failed")
+}
+
+// verifies that if an error is produced on a delete, it is not swallowed
+func TestAzureDevOpsServiceEndpointGcp_Delete_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := resourceServiceEndpointGcp()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	serviceEndpointGcpArgs.flatten(resourceData, &gcpTestServiceEndpoint, gcpTestServiceEndpointProjectID, nil)
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &aggregatedClient{ServiceEndpointClient: buildClient, ctx: context.Background()}
+
+	expectedArgs := serviceendpoint.DeleteServiceEndpointArgs{EndpointId: gcpTestServiceEndpoint.Id, Project: gcpTestServiceEndpointProjectID}
+	buildClient.
+		EXPECT().
+		DeleteServiceEndpoint(clients.ctx, expectedArgs).
+		Return(errors.New("DeleteServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Delete(resourceData, clients)
+	require.Contains(t, err.Error(), "DeleteServiceEndpoint() Failed")
+}
+
+// verifies that if an error is produced on an update, it is not swallowed
+func TestAzureDevOpsServiceEndpointGcp_Update_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := resourceServiceEndpointGcp()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	serviceEndpointGcpArgs.flatten(resourceData, &gcpTestServiceEndpoint, gcpTestServiceEndpointProjectID, nil)
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &aggregatedClient{ServiceEndpointClient: buildClient, ctx: context.Background()}
+
+	expectedArgs := serviceendpoint.UpdateServiceEndpointArgs{
+		Endpoint:   &gcpTestServiceEndpoint,
+		EndpointId: gcpTestServiceEndpoint.Id,
+		Project:    gcpTestServiceEndpointProjectID,
+	}
+
+	buildClient.
+		EXPECT().
+		UpdateServiceEndpoint(clients.ctx, expectedArgs).
+		Return(nil, errors.New("UpdateServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Update(resourceData, clients)
+	require.Contains(t, err.Error(), "UpdateServiceEndpoint() Failed")
+}
+
+/**
+ * Begin acceptance tests
+ */
+
+// validates that an apply followed by another apply (i.e., resource update) will be reflected in AzDO and the
+// underlying terraform state.
+func TestAccAzureDevOpsServiceEndpointGcp_CreateAndUpdate(t *testing.T) {
+	projectName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	serviceEndpointNameFirst := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	serviceEndpointNameSecond := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	tfSvcEpNode := "azuredevops_serviceendpoint_gcp.serviceendpoint"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccServiceEndpointCheckDestroyByType("azuredevops_serviceendpoint_gcp"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceEndpointGcpResource(projectName, serviceEndpointNameFirst),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "gcp_project_id", "gcp-project"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "token", ""),
+					resource.TestCheckResourceAttrSet(tfSvcEpNode, "token_hash"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "service_endpoint_name", serviceEndpointNameFirst),
+					testAccCheckServiceEndpointResourceExistsByNode(tfSvcEpNode, serviceEndpointNameFirst),
+				),
+			}, {
+				Config: testAccServiceEndpointGcpResource(projectName, serviceEndpointNameSecond),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "gcp_project_id", "gcp-project"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "token", ""),
+					resource.TestCheckResourceAttrSet(tfSvcEpNode, "token_hash"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "service_endpoint_name", serviceEndpointNameSecond),
+					testAccCheckServiceEndpointResourceExistsByNode(tfSvcEpNode, serviceEndpointNameSecond),
+				),
+			},
+		},
+	})
+}
+
+// HCL describing an AzDO GCP service endpoint
+func testAccServiceEndpointGcpResource(projectName string, serviceEndpointName string) string {
+	serviceEndpointResource := fmt.Sprintf(`
+resource "azuredevops_serviceendpoint_gcp" "serviceendpoint" {
+	project_id            = azuredevops_project.project.id
+	service_endpoint_name = "%s"
+	gcp_project_id        = "gcp-project"
+	token                 = jsonencode({
+		type         = "service_account"
+		client_email = "terraform@gcp-project.iam.gserviceaccount.com"
+		private_key  = "private-key"
+	})
+}`, serviceEndpointName)
+
+	projectResource := testAccProjectResource(projectName)
+	return fmt.Sprintf("%s\n%s", projectResource, serviceEndpointResource)
+}
@@ -0,0 +1,57 @@
+package azuredevops
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/secretmemo"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/tfhelper"
+)
+
+func resourceServiceEndpointGeneric() *schema.Resource {
+	r := genServiceEndpointResourceFromArgs(serviceEndpointGenericArgs)
+
+	r.Schema["server_url"] = &schema.Schema{
+		Type:             schema.TypeString,
+		Required:         true,
+		ValidateFunc:     validation.NoZeroValues,
+		DiffSuppressFunc: tfhelper.DiffFuncSuppressURLEquivalence,
+		Description:      "The server URL of the generic service connection.",
+	}
+	r.Schema["username"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Default:     "",
+		Description: "The username to use for the generic service connection.",
+	}
+
+	secretHashKey, secretHashSchema := tfhelper.GenerateSecreteMemoSchema("password")
+	r.Schema["password"] = &schema.Schema{
+		Type:             schema.TypeString,
+		Optional:         true,
+		DefaultFunc:      schema.EnvDefaultFunc("AZDO_GENERIC_SERVICE_CONNECTION_PASSWORD", nil),
+		Description:      "The password or token key to use for the generic service connection.",
+		Sensitive:        true,
+		DiffSuppressFunc: tfhelper.DiffFuncSupressSecretChanged,
+	}
+	r.Schema[secretHashKey] = secretHashSchema
+
+	return r
+}
+
+var serviceEndpointGenericArgs = &serviceEndpointCRUDArgs{
+	endpointType: "generic",
+	authScheme:   "UsernamePassword",
+	urlKey:       "server_url",
+	expandParameters: func(d *schema.ResourceData) (map[string]string, map[string]string) {
+		return map[string]string{
+			"username": d.Get("username").(string),
+			"password": d.Get("password").(string),
+		}, nil
+	},
+	flattenParameters: func(d *schema.ResourceData, parameters map[string]string, data map[string]string, secretSettings *secretmemo.Settings) {
+		d.Set("username", parameters["username"])
+
+		tfhelper.HelpFlattenSecret(d, "password", secretSettings)
+		d.Set("password", parameters["password"])
+	},
+}
@@ -0,0 +1,114 @@
+package azuredevops
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/secretmemo"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/tfhelper"
+)
+
+const (
+	githubPersonalAuthKey = "auth_personal"
+	githubOAuthKey        = "auth_oauth"
+)
+
+func resourceServiceEndpointGitHub() *schema.Resource {
+	r := genServiceEndpointResourceFromArgs(serviceEndpointGitHubArgs)
+	r.CustomizeDiff = customizeDiffServiceEndpointGitHub
+
+	patHashKey, patHashSchema := tfhelper.GenerateSecreteMemoSchema("personal_access_token")
+	r.Schema[githubPersonalAuthKey] = &schema.Schema{
+		Type:          schema.TypeList,
+		Optional:      true,
+		MaxItems:      1,
+		ConflictsWith: []string{githubOAuthKey},
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"personal_access_token": {
+					Type:             schema.TypeString,
+					Required:         true,
+					DefaultFunc:      schema.EnvDefaultFunc("AZDO_GITHUB_SERVICE_CONNECTION_PAT", nil),
+					Description:      "The GitHub personal access token which should be used.",
+					Sensitive:        true,
+					DiffSuppressFunc: tfhelper.DiffFuncSupressSecretChanged,
+				},
+				patHashKey: patHashSchema,
+			},
+		},
+	}
+	r.Schema[githubOAuthKey] = &schema.Schema{
+		Type:          schema.TypeList,
+		Optional:      true,
+		MaxItems:      1,
+		ConflictsWith: []string{githubPersonalAuthKey},
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"oauth_configuration_id": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.NoZeroValues,
+					Description:  "The ID of the OAuth configuration which should be used.",
+				},
+			},
+		},
+	}
+
+	return r
+}
+
+// Verifies at plan time that exactly one authentication block is configured
+func customizeDiffServiceEndpointGitHub(d *schema.ResourceDiff, m interface{}) error {
+	personal := d.Get(githubPersonalAuthKey).([]interface{})
+	oauth := d.Get(githubOAuthKey).([]interface{})
+	if len(personal)+len(oauth) != 1 {
+		return fmt.Errorf("exactly one of %s or %s must be configured", githubPersonalAuthKey, githubOAuthKey)
+	}
+	return nil
+}
+
+var serviceEndpointGitHubArgs = &serviceEndpointCRUDArgs{
+	endpointType: "github",
+	url:          "https://github.com",
+	authSchemes: []serviceEndpointAuthScheme{
+		{
+			name:       "PersonalAccessToken",
+			attributes: []string{githubPersonalAuthKey},
+			expandParameters: func(d *schema.ResourceData) (map[string]string, map[string]string) {
+				accessToken, _ := expandSingleItemBlock(d, githubPersonalAuthKey)["personal_access_token"].(string)
+				return map[string]string{
+					"accessToken": accessToken,
+				}, nil
+			},
+			flattenParameters: func(d *schema.ResourceData, parameters map[string]string, data map[string]string, secretSettings *secretmemo.Settings) {
+				configuration := map[string]interface{}{
+					"personal_access_token": parameters["accessToken"],
+				}
+				tfhelper.HelpFlattenSecretNested(d, githubPersonalAuthKey, configuration, "personal_access_token", secretSettings)
+				d.Set(githubPersonalAuthKey, []interface{}{configuration})
+			},
+		},
+		{
+			name:       "OAuth",
+			attributes: []string{githubOAuthKey},
+			expandParameters: func(d *schema.ResourceData) (map[string]string, map[string]string) {
+				return map[string]string{
+					"ConfigurationId": expandSingleItemBlock(d, githubOAuthKey)["oauth_configuration_id"].(string),
+				}, nil
+			},
+			flattenParameters: func(d *schema.ResourceData, parameters map[string]string, data map[string]string, secretSettings *secretmemo.Settings) {
+				d.Set(githubOAuthKey, []interface{}{map[string]interface{}{
+					"oauth_configuration_id": parameters["ConfigurationId"],
+				}})
+			},
+		},
+	},
+	// the personal access token is used unless an OAuth configuration is configured
+	expandAuthScheme: func(d *schema.ResourceData) string {
+		if len(expandSingleItemBlock(d, githubOAuthKey)) > 0 {
+			return "OAuth"
+		}
+		return "PersonalAccessToken"
+	},
+}
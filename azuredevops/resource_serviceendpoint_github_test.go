@@ -0,0 +1,240 @@
+package azuredevops
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/serviceendpoint"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var gitHubTestServiceEndpointID = uuid.New()
+var gitHubRandomServiceEndpointProjectID = uuid.New().String()
+var gitHubTestServiceEndpointProjectID = &gitHubRandomServiceEndpointProjectID
+
+var gitHubTestServiceEndpoint = serviceendpoint.ServiceEndpoint{
+	Authorization: &serviceendpoint.EndpointAuthorization{
+		Parameters: &map[string]string{
+			"accessToken": "UNIT_TEST_ACCESS_TOKEN",
+		},
+		Scheme: converter.String("PersonalAccessToken"),
+	},
+	Id:    &gitHubTestServiceEndpointID,
+	Name:  converter.String("UNIT_TEST_NAME"),
+	Owner: converter.String("library"),
+	Type:  converter.String("github"),
+	Url:   converter.String("https://github.com"),
+}
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that the flatten/expand round trip yields the same service endpoint
+func TestAzureDevOpsServiceEndpointGitHub_ExpandFlatten_Roundtrip(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, resourceServiceEndpointGitHub().Schema, nil)
+	serviceEndpointGitHubArgs.flatten(resourceData, &gitHubTestServiceEndpoint, gitHubTestServiceEndpointProjectID, nil)
+
+	serviceEndpointAfterRoundTrip, projectID := serviceEndpointGitHubArgs.expand(resourceData)
+
+	require.Equal(t, gitHubTestServiceEndpoint, *serviceEndpointAfterRoundTrip)
+	require.Equal(t, gitHubTestServiceEndpointProjectID, projectID)
+}
+
+// verifies that the flatten/expand round trip yields the same service endpoint when OAuth is used
+func TestAzureDevOpsServiceEndpointGitHub_ExpandFlatten_RoundtripOAuth(t *testing.T) {
+	oauthServiceEndpoint := gitHubTestServiceEndpoint
+	oauthServiceEndpoint.Authorization = &serviceendpoint.EndpointAuthorization{
+		Parameters: &map[string]string{
+			"ConfigurationId": "UNIT_TEST_CONFIGURATION_ID",
+		},
+		Scheme: converter.String("OAuth"),
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceServiceEndpointGitHub().Schema, nil)
+	serviceEndpointGitHubArgs.flatten(resourceData, &oauthServiceEndpoint, gitHubTestServiceEndpointProjectID, nil)
+
+	serviceEndpointAfterRoundTrip, projectID := serviceEndpointGitHubArgs.expand(resourceData)
+
+	require.Equal(t, oauthServiceEndpoint, *serviceEndpointAfterRoundTrip)
+	require.Equal(t, gitHubTestServiceEndpointProjectID, projectID)
+}
+
+// verifies that a plan is rejected unless exactly one authentication block is configured
+func TestAzureDevOpsServiceEndpointGitHub_Plan_RequiresExactlyOneAuthBlock(t *testing.T) {
+	r := resourceServiceEndpointGitHub()
+	baseConfig := map[string]interface{}{
+		"project_id":            gitHubRandomServiceEndpointProjectID,
+		"service_endpoint_name": "UNIT_TEST_NAME",
+	}
+
+	_, err := r.Diff(nil, terraform.NewResourceConfigRaw(baseConfig), nil)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "exactly one of auth_personal or auth_oauth must be configured")
+
+	baseConfig["auth_oauth"] = []interface{}{map[string]interface{}{"oauth_configuration_id": "UNIT_TEST_CONFIGURATION_ID"}}
+	_, err = r.Diff(nil, terraform.NewResourceConfigRaw(baseConfig), nil)
+	require.Nil(t, err)
+}
+
+// verifies that if an error is produced on create, the error is not swallowed
+func TestAzureDevOpsServiceEndpointGitHub_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := resourceServiceEndpointGitHub()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	serviceEndpointGitHubArgs.flatten(resourceData, &gitHubTestServiceEndpoint, gitHubTestServiceEndpointProjectID, nil)
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &aggregatedClient{ServiceEndpointClient: buildClient, ctx: context.Background()}
+
+	expectedArgs := serviceendpoint.CreateServiceEndpointArgs{Endpoint: &gitHubTestServiceEndpoint, Project: gitHubTestServiceEndpointProjectID}
+	buildClient.
+		EXPECT().
+		CreateServiceEndpoint(clients.ctx, expectedArgs).
+		Return(nil, errors.New("CreateServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Create(resourceData, clients)
+	require.Contains(t, err.Error(), "CreateServiceEndpoint() Failed")
+}
+
+// verifies that if an error is produced on a read, it is not swallowed
+func TestAzureDevOpsServiceEndpointGitHub_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := resourceServiceEndpointGitHub()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	serviceEndpointGitHubArgs.flatten(resourceData, &gitHubTestServiceEndpoint, gitHubTestServiceEndpointProjectID, nil)
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &aggregatedClient{ServiceEndpointClient: buildClient, ctx: context.Background()}
+
+	expectedArgs := serviceendpoint.GetServiceEndpointDetailsArgs{EndpointId: gitHubTestServiceEndpoint.Id, Project: gitHubTestServiceEndpointProjectID}
+	buildClient.
+		EXPECT().
+		GetServiceEndpointDetails(clients.ctx, expectedArgs).
+		Return(nil, errors.New("GetServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Read(resourceData, clients)
+	require.Contains(t, err.Error(), "GetServiceEndpoint() Failed")
+}
+
+// verifies that if an error is produced on a delete, it is not swallowed
+func TestAzureDevOpsServiceEndpointGitHub_Delete_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := resourceServiceEndpointGitHub()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	serviceEndpointGitHubArgs.flatten(resourceData, &gitHubTestServiceEndpoint, gitHubTestServiceEndpointProjectID, nil)
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &aggregatedClient{ServiceEndpointClient: buildClient, ctx: context.Background()}
+
+	expectedArgs := serviceendpoint.DeleteServiceEndpointArgs{EndpointId: gitHubTestServiceEndpoint.Id, Project: gitHubTestServiceEndpointProjectID}
+	buildClient.
+		EXPECT().
+		DeleteServiceEndpoint(clients.ctx, expectedArgs).
+		Return(errors.New("DeleteServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Delete(resourceData, clients)
+	require.Contains(t, err.Error(), "DeleteServiceEndpoint() Failed")
+}
+
+// verifies that if an error is produced on an update, it is not swallowed
+func TestAzureDevOpsServiceEndpointGitHub_Update_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := resourceServiceEndpointGitHub()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	serviceEndpointGitHubArgs.flatten(resourceData, &gitHubTestServiceEndpoint, gitHubTestServiceEndpointProjectID, nil)
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &aggregatedClient{ServiceEndpointClient: buildClient, ctx: context.Background()}
+
+	expectedArgs := serviceendpoint.UpdateServiceEndpointArgs{
+		Endpoint:   &gitHubTestServiceEndpoint,
+		EndpointId: gitHubTestServiceEndpoint.Id,
+		Project:    gitHubTestServiceEndpointProjectID,
+	}
+
+	buildClient.
+		EXPECT().
+		UpdateServiceEndpoint(clients.ctx, expectedArgs).
+		Return(nil, errors.New("UpdateServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Update(resourceData, clients)
+	require.Contains(t, err.Error(), "UpdateServiceEndpoint() Failed")
+}
+
+/**
+ * Begin acceptance tests
+ */
+
+// validates that an apply followed by another apply (i.e., resource update) will be reflected in AzDO and the
+// underlying terraform state.
+func TestAccAzureDevOpsServiceEndpointGitHub_CreateAndUpdate(t *testing.T) {
+	projectName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	serviceEndpointNameFirst := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	serviceEndpointNameSecond := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	tfSvcEpNode := "azuredevops_serviceendpoint_github.serviceendpoint"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccServiceEndpointCheckDestroyByType("azuredevops_serviceendpoint_github"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceEndpointGitHubResource(projectName, serviceEndpointNameFirst),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "auth_personal.0.personal_access_token", ""),
+					resource.TestCheckResourceAttrSet(tfSvcEpNode, "auth_personal.0.personal_access_token_hash"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "service_endpoint_name", serviceEndpointNameFirst),
+					testAccCheckServiceEndpointResourceExistsByNode(tfSvcEpNode, serviceEndpointNameFirst),
+				),
+			}, {
+				Config: testAccServiceEndpointGitHubResource(projectName, serviceEndpointNameSecond),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "auth_personal.0.personal_access_token", ""),
+					resource.TestCheckResourceAttrSet(tfSvcEpNode, "auth_personal.0.personal_access_token_hash"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "service_endpoint_name", serviceEndpointNameSecond),
+					testAccCheckServiceEndpointResourceExistsByNode(tfSvcEpNode, serviceEndpointNameSecond),
+				),
+			},
+		},
+	})
+}
+
+// HCL describing an AzDO GitHub service endpoint
+func testAccServiceEndpointGitHubResource(projectName string, serviceEndpointName string) string {
+	serviceEndpointResource := fmt.Sprintf(`
+resource "azuredevops_serviceendpoint_github" "serviceendpoint" {
+	project_id            = azuredevops_project.project.id
+	service_endpoint_name = "%s"
+
+	auth_personal {
+	}
+}`, serviceEndpointName)
+
+	projectResource := testAccProjectResource(projectName)
+	return fmt.Sprintf("%s\n%s", projectResource, serviceEndpointResource)
+}
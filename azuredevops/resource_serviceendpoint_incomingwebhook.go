@@ -0,0 +1,68 @@
+package azuredevops
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/secretmemo"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/tfhelper"
+)
+
+func resourceServiceEndpointIncomingWebhook() *schema.Resource {
+	r := genServiceEndpointResourceFromArgs(serviceEndpointIncomingWebhookArgs)
+
+	r.Schema["webhook_name"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		Description:  "The name of the webhook, which is part of the URL the external service sends its events to.",
+		ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[A-Za-z0-9_]+$`), "must only contain letters, digits and underscores"),
+	}
+
+	secretHashKey, secretHashSchema := tfhelper.GenerateSecreteMemoSchema("secret")
+	r.Schema["secret"] = &schema.Schema{
+		Type:             schema.TypeString,
+		Optional:         true,
+		Description:      "The secret the payloads of the events are signed with, which is used to verify the events.",
+		Sensitive:        true,
+		DiffSuppressFunc: tfhelper.DiffFuncSupressSecretChanged,
+	}
+	r.Schema[secretHashKey] = secretHashSchema
+
+	r.Schema["http_header"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "The name of the HTTP header that holds the signature of the payload.",
+	}
+
+	r.Schema["webhook_url_path"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The path of the URL of the organization the external service sends its events to.",
+	}
+
+	return r
+}
+
+// Incoming webhook endpoints receive events from external services, which trigger the pipelines that use the
+// endpoint as a webhook resource. They do not connect to anything, so their URL is always the same.
+var serviceEndpointIncomingWebhookArgs = &serviceEndpointCRUDArgs{
+	endpointType: "incomingwebhook",
+	authScheme:   "None",
+	url:          "https://dev.azure.com",
+	expandParameters: func(d *schema.ResourceData) (map[string]string, map[string]string) {
+		return map[string]string{
+			"webhookname": d.Get("webhook_name").(string),
+			"secret":      d.Get("secret").(string),
+			"header":      d.Get("http_header").(string),
+		}, nil
+	},
+	flattenParameters: func(d *schema.ResourceData, parameters map[string]string, data map[string]string, secretSettings *secretmemo.Settings) {
+		d.Set("webhook_name", parameters["webhookname"])
+		d.Set("http_header", parameters["header"])
+		d.Set("webhook_url_path", "_apis/public/distributedtask/webhooks/"+parameters["webhookname"])
+
+		tfhelper.HelpFlattenSecret(d, "secret", secretSettings)
+		d.Set("secret", parameters["secret"])
+	},
+}
@@ -0,0 +1,233 @@
+package azuredevops
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/serviceendpoint"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var incomingWebhookTestServiceEndpointID = uuid.New()
+var incomingWebhookRandomServiceEndpointProjectID = uuid.New().String()
+var incomingWebhookTestServiceEndpointProjectID = &incomingWebhookRandomServiceEndpointProjectID
+
+var incomingWebhookTestServiceEndpoint = serviceendpoint.ServiceEndpoint{
+	Authorization: &serviceendpoint.EndpointAuthorization{
+		Parameters: &map[string]string{
+			"webhookname": "UNIT_TEST_WEBHOOK",
+			"secret":      "UNIT_TEST_SECRET",
+			"header":      "X-Hub-Signature",
+		},
+		Scheme: converter.String("None"),
+	},
+	Id:    &incomingWebhookTestServiceEndpointID,
+	Name:  converter.String("UNIT_TEST_NAME"),
+	Owner: converter.String("library"),
+	Type:  converter.String("incomingwebhook"),
+	Url:   converter.String("https://dev.azure.com"),
+}
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that the flatten/expand round trip yields the same service endpoint
+func TestAzureDevOpsServiceEndpointIncomingWebhook_ExpandFlatten_Roundtrip(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, resourceServiceEndpointIncomingWebhook().Schema, nil)
+	serviceEndpointIncomingWebhookArgs.flatten(resourceData, &incomingWebhookTestServiceEndpoint, incomingWebhookTestServiceEndpointProjectID, nil)
+
+	serviceEndpointAfterRoundTrip, projectID := serviceEndpointIncomingWebhookArgs.expand(resourceData)
+
+	require.Equal(t, incomingWebhookTestServiceEndpoint, *serviceEndpointAfterRoundTrip)
+	require.Equal(t, incomingWebhookTestServiceEndpointProjectID, projectID)
+}
+
+// verifies that the path of the webhook URL is derived from the name of the webhook
+func TestAzureDevOpsServiceEndpointIncomingWebhook_Flatten_SetsWebhookURLPath(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, resourceServiceEndpointIncomingWebhook().Schema, nil)
+	serviceEndpointIncomingWebhookArgs.flatten(resourceData, &incomingWebhookTestServiceEndpoint, incomingWebhookTestServiceEndpointProjectID, nil)
+
+	require.Equal(t, "_apis/public/distributedtask/webhooks/UNIT_TEST_WEBHOOK", resourceData.Get("webhook_url_path"))
+	require.Equal(t, "X-Hub-Signature", resourceData.Get("http_header"))
+}
+
+// verifies that only webhook names the service accepts in the URL of the webhook pass the validation
+func TestAzureDevOpsServiceEndpointIncomingWebhook_ValidateWebhookName(t *testing.T) {
+	webhookNameSchema := resourceServiceEndpointIncomingWebhook().Schema["webhook_name"]
+	for _, name := range []string{"github", "Release_Trigger_2"} {
+		_, errs := webhookNameSchema.ValidateFunc(name, "webhook_name")
+		require.Empty(t, errs, name)
+	}
+	for _, name := range []string{"", "release-trigger", "release trigger", "hooks/release"} {
+		_, errs := webhookNameSchema.ValidateFunc(name, "webhook_name")
+		require.NotEmpty(t, errs, name)
+	}
+}
+
+// verifies that if an error is produced on create, the error is not swallowed
+func TestAzureDevOpsServiceEndpointIncomingWebhook_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := resourceServiceEndpointIncomingWebhook()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	serviceEndpointIncomingWebhookArgs.flatten(resourceData, &incomingWebhookTestServiceEndpoint, incomingWebhookTestServiceEndpointProjectID, nil)
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &aggregatedClient{ServiceEndpointClient: buildClient, ctx: context.Background()}
+
+	expectedArgs := serviceendpoint.CreateServiceEndpointArgs{Endpoint: &incomingWebhookTestServiceEndpoint, Project: incomingWebhookTestServiceEndpointProjectID}
+	buildClient.
+		EXPECT().
+		CreateServiceEndpoint(clients.ctx, expectedArgs).
+		Return(nil, errors.New("CreateServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Create(resourceData, clients)
+	require.Contains(t, err.Error(), "CreateServiceEndpoint() Failed")
+}
+
+// verifies that if an error is produced on a read, it is not swallowed
+func TestAzureDevOpsServiceEndpointIncomingWebhook_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := resourceServiceEndpointIncomingWebhook()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	serviceEndpointIncomingWebhookArgs.flatten(resourceData, &incomingWebhookTestServiceEndpoint, incomingWebhookTestServiceEndpointProjectID, nil)
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &aggregatedClient{ServiceEndpointClient: buildClient, ctx: context.Background()}
+
+	expectedArgs := serviceendpoint.GetServiceEndpointDetailsArgs{EndpointId: incomingWebhookTestServiceEndpoint.Id, Project: incomingWebhookTestServiceEndpointProjectID}
+	buildClient.
+		EXPECT().
+		GetServiceEndpointDetails(clients.ctx, expectedArgs).
+		Return(nil, errors.New("GetServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Read(resourceData, clients)
+	require.Contains(t, err.Error(), "GetServiceEndpoint() Failed")
+}
+
+// verifies that if an error is produced on a delete, it is not swallowed
+func TestAzureDevOpsServiceEndpointIncomingWebhook_Delete_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := resourceServiceEndpointIncomingWebhook()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	serviceEndpointIncomingWebhookArgs.flatten(resourceData, &incomingWebhookTestServiceEndpoint, incomingWebhookTestServiceEndpointProjectID, nil)
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &aggregatedClient{ServiceEndpointClient: buildClient, ctx: context.Background()}
+
+	expectedArgs := serviceendpoint.DeleteServiceEndpointArgs{EndpointId: incomingWebhookTestServiceEndpoint.Id, Project: incomingWebhookTestServiceEndpointProjectID}
+	buildClient.
+		EXPECT().
+		DeleteServiceEndpoint(clients.ctx, expectedArgs).
+		Return(errors.New("DeleteServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Delete(resourceData, clients)
+	require.Contains(t, err.Error(), "DeleteServiceEndpoint() Failed")
+}
+
+// verifies that if an error is produced on an update, it is not swallowed
+func TestAzureDevOpsServiceEndpointIncomingWebhook_Update_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := resourceServiceEndpointIncomingWebhook()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	serviceEndpointIncomingWebhookArgs.flatten(resourceData, &incomingWebhookTestServiceEndpoint, incomingWebhookTestServiceEndpointProjectID, nil)
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &aggregatedClient{ServiceEndpointClient: buildClient, ctx: context.Background()}
+
+	expectedArgs := serviceendpoint.UpdateServiceEndpointArgs{
+		Endpoint:   &incomingWebhookTestServiceEndpoint,
+		EndpointId: incomingWebhookTestServiceEndpoint.Id,
+		Project:    incomingWebhookTestServiceEndpointProjectID,
+	}
+
+	buildClient.
+		EXPECT().
+		UpdateServiceEndpoint(clients.ctx, expectedArgs).
+		Return(nil, errors.New("UpdateServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Update(resourceData, clients)
+	require.Contains(t, err.Error(), "UpdateServiceEndpoint() Failed")
+}
+
+/**
+ * Begin acceptance tests
+ */
+
+// validates that an apply followed by another apply (i.e., resource update) will be reflected in AzDO and the
+// underlying terraform state.
+func TestAccAzureDevOpsServiceEndpointIncomingWebhook_CreateAndUpdate(t *testing.T) {
+	projectName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	serviceEndpointNameFirst := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	serviceEndpointNameSecond := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	tfSvcEpNode := "azuredevops_serviceendpoint_incomingwebhook.serviceendpoint"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccServiceEndpointCheckDestroyByType("azuredevops_serviceendpoint_incomingwebhook"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceEndpointIncomingWebhookResource(projectName, serviceEndpointNameFirst),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "webhook_name", "terraform_acceptance_test"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "http_header", "X-Hub-Signature"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "webhook_url_path", "_apis/public/distributedtask/webhooks/terraform_acceptance_test"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "secret", ""),
+					resource.TestCheckResourceAttrSet(tfSvcEpNode, "secret_hash"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "service_endpoint_name", serviceEndpointNameFirst),
+					testAccCheckServiceEndpointResourceExistsByNode(tfSvcEpNode, serviceEndpointNameFirst),
+				),
+			}, {
+				Config: testAccServiceEndpointIncomingWebhookResource(projectName, serviceEndpointNameSecond),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "webhook_name", "terraform_acceptance_test"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "http_header", "X-Hub-Signature"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "webhook_url_path", "_apis/public/distributedtask/webhooks/terraform_acceptance_test"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "secret", ""),
+					resource.TestCheckResourceAttrSet(tfSvcEpNode, "secret_hash"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "service_endpoint_name", serviceEndpointNameSecond),
+					testAccCheckServiceEndpointResourceExistsByNode(tfSvcEpNode, serviceEndpointNameSecond),
+				),
+			},
+		},
+	})
+}
+
+// HCL describing an AzDO incoming webhook service endpoint
+func testAccServiceEndpointIncomingWebhookResource(projectName string, serviceEndpointName string) string {
+	serviceEndpointResource := fmt.Sprintf(`
+resource "azuredevops_serviceendpoint_incomingwebhook" "serviceendpoint" {
+	project_id            = azuredevops_project.project.id
+	service_endpoint_name = "%s"
+	webhook_name          = "terraform_acceptance_test"
+	secret                = "0000000000000000000000000000000000000000"
+	http_header           = "X-Hub-Signature"
+}`, serviceEndpointName)
+
+	projectResource := testAccProjectResource(projectName)
+	return fmt.Sprintf("%s\n%s", projectResource, serviceEndpointResource)
+}
@@ -0,0 +1,72 @@
+package azuredevops
+
+import (
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/secretmemo"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/tfhelper"
+)
+
+func resourceServiceEndpointJenkins() *schema.Resource {
+	r := genServiceEndpointResourceFromArgs(serviceEndpointJenkinsArgs)
+
+	r.Schema["url"] = &schema.Schema{
+		Type:             schema.TypeString,
+		Required:         true,
+		ValidateFunc:     validation.NoZeroValues,
+		DiffSuppressFunc: tfhelper.DiffFuncSuppressURLEquivalence,
+		Description:      "The URL of the Jenkins server.",
+	}
+
+	r.Schema["username"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		Description:  "The user name used to authenticate to the Jenkins server.",
+		ValidateFunc: validation.NoZeroValues,
+	}
+
+	secretHashKey, secretHashSchema := tfhelper.GenerateSecreteMemoSchema("password")
+	r.Schema["password"] = &schema.Schema{
+		Type:             schema.TypeString,
+		Required:         true,
+		Description:      "The password or API token used to authenticate to the Jenkins server.",
+		Sensitive:        true,
+		DiffSuppressFunc: tfhelper.DiffFuncSupressSecretChanged,
+		ValidateFunc:     validation.NoZeroValues,
+	}
+	r.Schema[secretHashKey] = secretHashSchema
+
+	r.Schema["accept_untrusted_certs"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Allows the connection to Jenkins servers with self-signed certificates.",
+	}
+
+	return r
+}
+
+var serviceEndpointJenkinsArgs = &serviceEndpointCRUDArgs{
+	endpointType: "jenkins",
+	authScheme:   "UsernamePassword",
+	urlKey:       "url",
+	expandParameters: func(d *schema.ResourceData) (map[string]string, map[string]string) {
+		return map[string]string{
+			"username": d.Get("username").(string),
+			"password": d.Get("password").(string),
+		}, map[string]string{
+			"acceptUntrustedCerts": strconv.FormatBool(d.Get("accept_untrusted_certs").(bool)),
+		}
+	},
+	flattenParameters: func(d *schema.ResourceData, parameters map[string]string, data map[string]string, secretSettings *secretmemo.Settings) {
+		d.Set("username", parameters["username"])
+
+		tfhelper.HelpFlattenSecret(d, "password", secretSettings)
+		d.Set("password", parameters["password"])
+
+		acceptUntrustedCerts, _ := strconv.ParseBool(data["acceptUntrustedCerts"])
+		d.Set("accept_untrusted_certs", acceptUntrustedCerts)
+	},
+}
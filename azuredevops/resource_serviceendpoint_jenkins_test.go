@@ -0,0 +1,227 @@
+package azuredevops
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/serviceendpoint"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var jenkinsTestServiceEndpointID = uuid.New()
+var jenkinsRandomServiceEndpointProjectID = uuid.New().String()
+var jenkinsTestServiceEndpointProjectID = &jenkinsRandomServiceEndpointProjectID
+
+var jenkinsTestServiceEndpoint = serviceendpoint.ServiceEndpoint{
+	Authorization: &serviceendpoint.EndpointAuthorization{
+		Parameters: &map[string]string{
+			"username": "JENKINS_TEST_username",
+			"password": "JENKINS_TEST_password",
+		},
+		Scheme: converter.String("UsernamePassword"),
+	},
+	Data: &map[string]string{
+		"acceptUntrustedCerts": "false",
+	},
+	Id:    &jenkinsTestServiceEndpointID,
+	Name:  converter.String("UNIT_TEST_NAME"),
+	Owner: converter.String("library"),
+	Type:  converter.String("jenkins"),
+	Url:   converter.String("https://jenkins.contoso.com"),
+}
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that the flatten/expand round trip yields the same service endpoint
+func TestAzureDevOpsServiceEndpointJenkins_ExpandFlatten_Roundtrip(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, resourceServiceEndpointJenkins().Schema, nil)
+	serviceEndpointJenkinsArgs.flatten(resourceData, &jenkinsTestServiceEndpoint, jenkinsTestServiceEndpointProjectID, nil)
+
+	serviceEndpointAfterRoundTrip, projectID := serviceEndpointJenkinsArgs.expand(resourceData)
+
+	require.Equal(t, jenkinsTestServiceEndpoint, *serviceEndpointAfterRoundTrip)
+	require.Equal(t, jenkinsTestServiceEndpointProjectID, projectID)
+}
+
+// verifies that the untrusted certificates flag round trips through the data of the endpoint
+func TestAzureDevOpsServiceEndpointJenkins_AcceptUntrustedCerts_Roundtrip(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, resourceServiceEndpointJenkins().Schema, nil)
+	serviceEndpointJenkinsArgs.flatten(resourceData, &jenkinsTestServiceEndpoint, jenkinsTestServiceEndpointProjectID, nil)
+	require.False(t, resourceData.Get("accept_untrusted_certs").(bool))
+
+	resourceData.Set("accept_untrusted_certs", true)
+	serviceEndpoint, _ := serviceEndpointJenkinsArgs.expand(resourceData)
+	require.Equal(t, "true", (*serviceEndpoint.Data)["acceptUntrustedCerts"])
+
+	serviceEndpointJenkinsArgs.flatten(resourceData, serviceEndpoint, jenkinsTestServiceEndpointProjectID, nil)
+	require.True(t, resourceData.Get("accept_untrusted_certs").(bool))
+}
+
+// verifies that if an error is produced on create, the error is not swallowed
+func TestAzureDevOpsServiceEndpointJenkins_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := resourceServiceEndpointJenkins()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	serviceEndpointJenkinsArgs.flatten(resourceData, &jenkinsTestServiceEndpoint, jenkinsTestServiceEndpointProjectID, nil)
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &aggregatedClient{ServiceEndpointClient: buildClient, ctx: context.Background()}
+
+	expectedArgs := serviceendpoint.CreateServiceEndpointArgs{Endpoint: &jenkinsTestServiceEndpoint, Project: jenkinsTestServiceEndpointProjectID}
+	buildClient.
+		EXPECT().
+		CreateServiceEndpoint(clients.ctx, expectedArgs).
+		Return(nil, errors.New("CreateServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Create(resourceData, clients)
+	require.Contains(t, err.Error(), "CreateServiceEndpoint() Failed")
+}
+
+// verifies that if an error is produced on a read, it is not swallowed
+func TestAzureDevOpsServiceEndpointJenkins_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := resourceServiceEndpointJenkins()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	serviceEndpointJenkinsArgs.flatten(resourceData, &jenkinsTestServiceEndpoint, jenkinsTestServiceEndpointProjectID, nil)
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &aggregatedClient{ServiceEndpointClient: buildClient, ctx: context.Background()}
+
+	expectedArgs := serviceendpoint.GetServiceEndpointDetailsArgs{EndpointId: jenkinsTestServiceEndpoint.Id, Project: jenkinsTestServiceEndpointProjectID}
+	buildClient.
+		EXPECT().
+		GetServiceEndpointDetails(clients.ctx, expectedArgs).
+		Return(nil, errors.New("GetServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Read(resourceData, clients)
+	require.Contains(t, err.Error(), "GetServiceEndpoint() Failed")
+}
+
+// verifies that if an error is produced on a delete, it is not swallowed
+func TestAzureDevOpsServiceEndpointJenkins_Delete_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := resourceServiceEndpointJenkins()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	serviceEndpointJenkinsArgs.flatten(resourceData, &jenkinsTestServiceEndpoint, jenkinsTestServiceEndpointProjectID, nil)
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &aggregatedClient{ServiceEndpointClient: buildClient, ctx: context.Background()}
+
+	expectedArgs := serviceendpoint.DeleteServiceEndpointArgs{EndpointId: jenkinsTestServiceEndpoint.Id, Project: jenkinsTestServiceEndpointProjectID}
+	buildClient.
+		EXPECT().
+		DeleteServiceEndpoint(clients.ctx, expectedArgs).
+		Return(errors.New("DeleteServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Delete(resourceData, clients)
+	require.Contains(t, err.Error(), "DeleteServiceEndpoint() Failed")
+}
+
+// verifies that if an error is produced on an update, it is not swallowed
+func TestAzureDevOpsServiceEndpointJenkins_Update_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := resourceServiceEndpointJenkins()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	serviceEndpointJenkinsArgs.flatten(resourceData, &jenkinsTestServiceEndpoint, jenkinsTestServiceEndpointProjectID, nil)
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &aggregatedClient{ServiceEndpointClient: buildClient, ctx: context.Background()}
+
+	expectedArgs := serviceendpoint.UpdateServiceEndpointArgs{
+		Endpoint:   &jenkinsTestServiceEndpoint,
+		EndpointId: jenkinsTestServiceEndpoint.Id,
+		Project:    jenkinsTestServiceEndpointProjectID,
+	}
+
+	buildClient.
+		EXPECT().
+		UpdateServiceEndpoint(clients.ctx, expectedArgs).
+		Return(nil, errors.New("UpdateServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Update(resourceData, clients)
+	require.Contains(t, err.Error(), "UpdateServiceEndpoint() Failed")
+}
+
+/**
+ * Begin acceptance tests
+ */
+
+// validates that an apply followed by another apply (i.e., resource update) will be reflected in AzDO and the
+// underlying terraform state.
+func TestAccAzureDevOpsServiceEndpointJenkins_CreateAndUpdate(t *testing.T) {
+	projectName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	serviceEndpointNameFirst := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	serviceEndpointNameSecond := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	tfSvcEpNode := "azuredevops_serviceendpoint_jenkins.serviceendpoint"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccServiceEndpointCheckDestroyByType("azuredevops_serviceendpoint_jenkins"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceEndpointJenkinsResource(projectName, serviceEndpointNameFirst),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "url", "https://jenkins.contoso.com"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "username", "username"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "accept_untrusted_certs", "false"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "password", ""),
+					resource.TestCheckResourceAttrSet(tfSvcEpNode, "password_hash"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "service_endpoint_name", serviceEndpointNameFirst),
+					testAccCheckServiceEndpointResourceExistsByNode(tfSvcEpNode, serviceEndpointNameFirst),
+				),
+			}, {
+				Config: testAccServiceEndpointJenkinsResource(projectName, serviceEndpointNameSecond),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "url", "https://jenkins.contoso.com"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "username", "username"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "accept_untrusted_certs", "false"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "password", ""),
+					resource.TestCheckResourceAttrSet(tfSvcEpNode, "password_hash"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "service_endpoint_name", serviceEndpointNameSecond),
+					testAccCheckServiceEndpointResourceExistsByNode(tfSvcEpNode, serviceEndpointNameSecond),
+				),
+			},
+		},
+	})
+}
+
+// HCL describing an AzDO jenkins service endpoint
+func testAccServiceEndpointJenkinsResource(projectName string, serviceEndpointName string) string {
+	serviceEndpointResource := fmt.Sprintf(`
+resource "azuredevops_serviceendpoint_jenkins" "serviceendpoint" {
+	project_id            = azuredevops_project.project.id
+	service_endpoint_name = "%s"
+	url                   = "https://jenkins.contoso.com"
+	username              = "username"
+	password              = "password"
+}`, serviceEndpointName)
+
+	projectResource := testAccProjectResource(projectName)
+	return fmt.Sprintf("%s\n%s", projectResource, serviceEndpointResource)
+}
@@ -0,0 +1,379 @@
+package azuredevops
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/secretmemo"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/tfhelper"
+)
+
+const (
+	k8sAuthTypeAzureSubscription = "AzureSubscription"
+	k8sAuthTypeServiceAccount    = "ServiceAccount"
+	k8sAuthTypeKubeconfig        = "Kubeconfig"
+)
+
+// maps each supported authorization type to the schema block that configures it
+var k8sAuthTypeBlocks = map[string]string{
+	k8sAuthTypeAzureSubscription: "azure_subscription",
+	k8sAuthTypeServiceAccount:    "service_account",
+	k8sAuthTypeKubeconfig:        "kubeconfig",
+}
+
+func resourceServiceEndpointKubernetes() *schema.Resource {
+	r := genServiceEndpointResourceFromArgs(serviceEndpointKubernetesArgs)
+	r.CustomizeDiff = customizeDiffServiceEndpointKubernetes
+
+	r.Schema["apiserver_url"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.NoZeroValues,
+		Description:  "URL to Kubernete's API-Server",
+	}
+	r.Schema["authorization_type"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		Description:  "Type of credentials to use",
+		ValidateFunc: validation.StringInSlice([]string{k8sAuthTypeAzureSubscription, k8sAuthTypeServiceAccount, k8sAuthTypeKubeconfig}, false),
+	}
+	r.Schema["azure_subscription"] = &schema.Schema{
+		Type:          schema.TypeList,
+		Optional:      true,
+		MaxItems:      1,
+		Description:   "'AzureSubscription'-type of configuration",
+		ConflictsWith: []string{"service_account", "kubeconfig"},
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"azure_environment": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "AzureCloud",
+					Description:  "The Azure cloud the cluster is hosted in",
+					ValidateFunc: validation.StringInSlice([]string{"AzureCloud", "AzureChinaCloud", "AzureUSGovernment", "AzureGermanCloud"}, false),
+				},
+				"subscription_id": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"subscription_name": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"tenant_id": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"cluster_id": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "The resource ID of the AKS cluster. Alternative to resourcegroup_id and cluster_name",
+					ValidateFunc: validateAzureKubernetesClusterID,
+				},
+				"resourcegroup_id": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"namespace": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "default",
+					ValidateFunc: validation.NoZeroValues,
+				},
+				"cluster_name": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+			},
+		},
+	}
+
+	kubeConfigHashKey, kubeConfigHashSchema := tfhelper.GenerateSecreteMemoSchema("kube_config")
+	r.Schema["kubeconfig"] = &schema.Schema{
+		Type:          schema.TypeList,
+		Optional:      true,
+		MaxItems:      1,
+		Description:   "'Kubeconfig'-type of configuration",
+		ConflictsWith: []string{"azure_subscription", "service_account"},
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"kube_config": {
+					Type:             schema.TypeString,
+					Required:         true,
+					Description:      "Content of the kubeconfig file. The configuration information in your kubeconfig file allows Kubernetes clients to talk to your Kubernetes API servers. This file is used by kubectl and all supported Kubernetes clients.",
+					Sensitive:        true,
+					DiffSuppressFunc: tfhelper.DiffFuncSupressSecretChanged,
+				},
+				kubeConfigHashKey: kubeConfigHashSchema,
+				"cluster_context": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "Context within the kubeconfig file that is to be used for identifying the cluster. Default value is the current-context set in kubeconfig.",
+				},
+				"accept_untrusted_certs": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Enable this if your authentication uses untrusted certificates.",
+				},
+			},
+		},
+	}
+
+	tokenHashKey, tokenHashSchema := tfhelper.GenerateSecreteMemoSchema("token")
+	caCertHashKey, caCertHashSchema := tfhelper.GenerateSecreteMemoSchema("ca_cert")
+	r.Schema["service_account"] = &schema.Schema{
+		Type:          schema.TypeList,
+		Optional:      true,
+		MaxItems:      1,
+		Description:   "'ServiceAccount'-type of configuration",
+		ConflictsWith: []string{"azure_subscription", "kubeconfig"},
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"token": {
+					Type:             schema.TypeString,
+					Required:         true,
+					Description:      "Secret token of the service account",
+					Sensitive:        true,
+					DiffSuppressFunc: tfhelper.DiffFuncSupressSecretChanged,
+				},
+				tokenHashKey: tokenHashSchema,
+				"ca_cert": {
+					Type:             schema.TypeString,
+					Required:         true,
+					Description:      "Certificate of the cluster's certificate authority",
+					Sensitive:        true,
+					DiffSuppressFunc: tfhelper.DiffFuncSupressSecretChanged,
+				},
+				caCertHashKey: caCertHashSchema,
+			},
+		},
+	}
+
+	return r
+}
+
+// Verifies at plan time that the configuration block matching the selected authorization type is present
+func customizeDiffServiceEndpointKubernetes(d *schema.ResourceDiff, m interface{}) error {
+	authorizationType := d.Get("authorization_type").(string)
+	blockName, ok := k8sAuthTypeBlocks[authorizationType]
+	if !ok {
+		return nil
+	}
+
+	if blocks := d.Get(blockName).([]interface{}); len(blocks) != 1 {
+		return fmt.Errorf("authorization_type %s requires a %s block to be configured", authorizationType, blockName)
+	}
+
+	if authorizationType == k8sAuthTypeAzureSubscription {
+		return validateAzureSubscriptionCluster(d)
+	}
+	return nil
+}
+
+// Verifies that the cluster of an AzureSubscription configuration is identified either by its resource ID, or by
+// its resource group and name, and that it is deployed to a namespace. Values that are not known yet are skipped.
+func validateAzureSubscriptionCluster(d *schema.ResourceDiff) error {
+	value := func(key string) (string, bool) {
+		fullKey := "azure_subscription.0." + key
+		return d.Get(fullKey).(string), d.NewValueKnown(fullKey)
+	}
+	isEmpty := func(key string) bool {
+		v, known := value(key)
+		return known && v == ""
+	}
+	isConfigured := func(key string) bool {
+		v, known := value(key)
+		return known && v != ""
+	}
+
+	if isEmpty("namespace") {
+		return fmt.Errorf("azure_subscription.namespace must not be empty")
+	}
+	if isConfigured("cluster_id") && (isConfigured("resourcegroup_id") || isConfigured("cluster_name")) {
+		return fmt.Errorf("azure_subscription.cluster_id conflicts with azure_subscription.resourcegroup_id and azure_subscription.cluster_name")
+	}
+	if isEmpty("cluster_id") && (isEmpty("resourcegroup_id") || isEmpty("cluster_name")) {
+		return fmt.Errorf("azure_subscription requires either cluster_id, or both resourcegroup_id and cluster_name")
+	}
+	return nil
+}
+
+// Verifies that a cluster ID is the resource ID of an AKS cluster, i.e.
+// /subscriptions/{id}/resourceGroups/{group}/providers/Microsoft.ContainerService/managedClusters/{name}
+func validateAzureKubernetesClusterID(i interface{}, k string) ([]string, []error) {
+	clusterID, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %q to be string", k)}
+	}
+	if _, err := parseAzureKubernetesClusterID(clusterID); err != nil {
+		return nil, []error{fmt.Errorf("%q is invalid: %v", k, err)}
+	}
+	return nil, nil
+}
+
+// The segments of the resource ID of an AKS cluster
+type azureKubernetesClusterID struct {
+	subscriptionID string
+	resourceGroup  string
+	name           string
+}
+
+func parseAzureKubernetesClusterID(clusterID string) (*azureKubernetesClusterID, error) {
+	segments := strings.Split(strings.Trim(clusterID, "/"), "/")
+	parsed := parseAzureResourceID(clusterID)
+	if len(segments) != 8 || !strings.HasPrefix(clusterID, "/") ||
+		parsed["subscriptions"] == "" || parsed["resourcegroups"] == "" || parsed["managedclusters"] == "" ||
+		!strings.EqualFold(parsed["providers"], "Microsoft.ContainerService") {
+		return nil, fmt.Errorf("expected the resource ID of an AKS cluster of the form /subscriptions/{subscription ID}/resourceGroups/{resource group}/providers/Microsoft.ContainerService/managedClusters/{cluster name}, got %q", clusterID)
+	}
+
+	return &azureKubernetesClusterID{
+		subscriptionID: parsed["subscriptions"],
+		resourceGroup:  parsed["resourcegroups"],
+		name:           parsed["managedclusters"],
+	}, nil
+}
+
+var serviceEndpointKubernetesArgs = &serviceEndpointCRUDArgs{
+	endpointType: "kubernetes",
+	urlKey:       "apiserver_url",
+	flattenParameters: func(d *schema.ResourceData, parameters map[string]string, data map[string]string, secretSettings *secretmemo.Settings) {
+		d.Set("authorization_type", data["authorizationType"])
+	},
+	authSchemes: []serviceEndpointAuthScheme{
+		{
+			name:       k8sAuthTypeAzureSubscription,
+			attributes: []string{k8sAuthTypeBlocks[k8sAuthTypeAzureSubscription]},
+			authScheme: "Kubernetes",
+			expandParameters: func(d *schema.ResourceData) (map[string]string, map[string]string) {
+				configuration := expandSingleItemBlock(d, "azure_subscription")
+				clusterID := configuration["cluster_id"].(string)
+				if clusterID == "" {
+					clusterID = fmt.Sprintf("/subscriptions/%s/resourcegroups/%s/providers/Microsoft.ContainerService/managedClusters/%s",
+						configuration["subscription_id"].(string), configuration["resourcegroup_id"].(string), configuration["cluster_name"].(string))
+				}
+				return map[string]string{
+					"azureEnvironment":      configuration["azure_environment"].(string),
+					"azureSubscriptionId":   configuration["subscription_id"].(string),
+					"azureSubscriptionName": configuration["subscription_name"].(string),
+					"azureTenantId":         configuration["tenant_id"].(string),
+				}, map[string]string{
+					"authorizationType":     k8sAuthTypeAzureSubscription,
+					"azureSubscriptionId":   configuration["subscription_id"].(string),
+					"azureSubscriptionName": configuration["subscription_name"].(string),
+					"clusterId":             clusterID,
+					"namespace":             configuration["namespace"].(string),
+				}
+			},
+			flattenParameters: func(d *schema.ResourceData, parameters map[string]string, data map[string]string, secretSettings *secretmemo.Settings) {
+				azureEnvironment := parameters["azureEnvironment"]
+				if azureEnvironment == "" {
+					azureEnvironment = "AzureCloud"
+				}
+				configuration := map[string]interface{}{
+					"azure_environment": azureEnvironment,
+					"subscription_id":   data["azureSubscriptionId"],
+					"subscription_name": data["azureSubscriptionName"],
+					"tenant_id":         parameters["azureTenantId"],
+					"namespace":         data["namespace"],
+				}
+				flattenAzureSubscriptionCluster(d, configuration, data["clusterId"])
+				d.Set("azure_subscription", []interface{}{configuration})
+			},
+		},
+		{
+			name:       k8sAuthTypeKubeconfig,
+			attributes: []string{k8sAuthTypeBlocks[k8sAuthTypeKubeconfig]},
+			authScheme: "Kubernetes",
+			expandParameters: func(d *schema.ResourceData) (map[string]string, map[string]string) {
+				configuration := expandSingleItemBlock(d, "kubeconfig")
+				return map[string]string{
+					"clusterContext": configuration["cluster_context"].(string),
+					"kubeconfig":     configuration["kube_config"].(string),
+				}, map[string]string{
+					"authorizationType":    k8sAuthTypeKubeconfig,
+					"acceptUntrustedCerts": strconv.FormatBool(configuration["accept_untrusted_certs"].(bool)),
+				}
+			},
+			flattenParameters: func(d *schema.ResourceData, parameters map[string]string, data map[string]string, secretSettings *secretmemo.Settings) {
+				acceptUntrustedCerts, _ := strconv.ParseBool(data["acceptUntrustedCerts"])
+				configuration := map[string]interface{}{
+					"kube_config":            parameters["kubeconfig"],
+					"cluster_context":        parameters["clusterContext"],
+					"accept_untrusted_certs": acceptUntrustedCerts,
+				}
+				tfhelper.HelpFlattenSecretNested(d, "kubeconfig", configuration, "kube_config", secretSettings)
+				d.Set("kubeconfig", []interface{}{configuration})
+			},
+		},
+		{
+			name:       k8sAuthTypeServiceAccount,
+			attributes: []string{k8sAuthTypeBlocks[k8sAuthTypeServiceAccount]},
+			authScheme: "Token",
+			expandParameters: func(d *schema.ResourceData) (map[string]string, map[string]string) {
+				configuration := expandSingleItemBlock(d, "service_account")
+				return map[string]string{
+					"apiToken":                  configuration["token"].(string),
+					"serviceAccountCertificate": configuration["ca_cert"].(string),
+				}, map[string]string{
+					"authorizationType": k8sAuthTypeServiceAccount,
+				}
+			},
+			flattenParameters: func(d *schema.ResourceData, parameters map[string]string, data map[string]string, secretSettings *secretmemo.Settings) {
+				configuration := map[string]interface{}{
+					"token":   parameters["apiToken"],
+					"ca_cert": parameters["serviceAccountCertificate"],
+				}
+				tfhelper.HelpFlattenSecretNested(d, "service_account", configuration, "token", secretSettings)
+				tfhelper.HelpFlattenSecretNested(d, "service_account", configuration, "ca_cert", secretSettings)
+				d.Set("service_account", []interface{}{configuration})
+			},
+		},
+	},
+	// both the AzureSubscription and the Kubeconfig type use the Kubernetes authorization scheme, so the type is
+	// stored in the data of the endpoint
+	expandAuthScheme: func(d *schema.ResourceData) string {
+		return d.Get("authorization_type").(string)
+	},
+	flattenAuthScheme: func(authScheme string, parameters map[string]string, data map[string]string) string {
+		return data["authorizationType"]
+	},
+}
+
+// Returns the attributes of a single-item block, or an empty map if the block is not configured
+func expandSingleItemBlock(d *schema.ResourceData, key string) map[string]interface{} {
+	blocks := d.Get(key).([]interface{})
+	if len(blocks) != 1 || blocks[0] == nil {
+		return map[string]interface{}{}
+	}
+	return blocks[0].(map[string]interface{})
+}
+
+// The cluster is flattened in the form it is configured in. The resource group and name can only be used if the
+// cluster is hosted in the subscription of the service endpoint, otherwise the full resource ID is kept.
+func flattenAzureSubscriptionCluster(d *schema.ResourceData, configuration map[string]interface{}, clusterID string) {
+	parsed, err := parseAzureKubernetesClusterID(clusterID)
+	configuredByID := d.Get("azure_subscription.0.cluster_id").(string) != ""
+	if configuredByID || err != nil || parsed.subscriptionID != configuration["subscription_id"] {
+		configuration["cluster_id"] = clusterID
+		return
+	}
+
+	configuration["resourcegroup_id"] = parsed.resourceGroup
+	configuration["cluster_name"] = parsed.name
+}
+
+// Splits an Azure resource ID of the form /key1/value1/key2/value2/... into a map keyed by the lower cased keys
+func parseAzureResourceID(resourceID string) map[string]string {
+	segments := strings.Split(strings.Trim(resourceID, "/"), "/")
+	parsed := map[string]string{}
+	for i := 0; i+1 < len(segments); i += 2 {
+		parsed[strings.ToLower(segments[i])] = segments[i+1]
+	}
+	return parsed
+}
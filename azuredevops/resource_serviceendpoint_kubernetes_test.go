@@ -0,0 +1,340 @@
+package azuredevops
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/serviceendpoint"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var kubernetesTestServiceEndpointID = uuid.New()
+var kubernetesRandomServiceEndpointProjectID = uuid.New().String()
+var kubernetesTestServiceEndpointProjectID = &kubernetesRandomServiceEndpointProjectID
+
+var kubernetesTestServiceEndpoint = serviceendpoint.ServiceEndpoint{
+	Authorization: &serviceendpoint.EndpointAuthorization{
+		Parameters: &map[string]string{
+			"clusterContext": "dev-frontend",
+			"kubeconfig":     "apiVersion: v1",
+		},
+		Scheme: converter.String("Kubernetes"),
+	},
+	Data: &map[string]string{
+		"authorizationType":    "Kubeconfig",
+		"acceptUntrustedCerts": "true",
+	},
+	Id:    &kubernetesTestServiceEndpointID,
+	Name:  converter.String("UNIT_TEST_NAME"),
+	Owner: converter.String("library"),
+	Type:  converter.String("kubernetes"),
+	Url:   converter.String("https://kubernetes.apiserver.com/"),
+}
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that the flatten/expand round trip yields the same service endpoint
+func TestAzureDevOpsServiceEndpointKubernetes_ExpandFlatten_Roundtrip(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, resourceServiceEndpointKubernetes().Schema, nil)
+	serviceEndpointKubernetesArgs.flatten(resourceData, &kubernetesTestServiceEndpoint, kubernetesTestServiceEndpointProjectID, nil)
+
+	serviceEndpointAfterRoundTrip, projectID := serviceEndpointKubernetesArgs.expand(resourceData)
+
+	require.Equal(t, kubernetesTestServiceEndpoint, *serviceEndpointAfterRoundTrip)
+	require.Equal(t, kubernetesTestServiceEndpointProjectID, projectID)
+}
+
+// verifies that the flatten/expand round trip yields the same service endpoint for every authorization type
+func TestAzureDevOpsServiceEndpointKubernetes_ExpandFlatten_RoundtripAllAuthorizationTypes(t *testing.T) {
+	azureSubscriptionServiceEndpoint := kubernetesTestServiceEndpoint
+	azureSubscriptionServiceEndpoint.Authorization = &serviceendpoint.EndpointAuthorization{
+		Parameters: &map[string]string{
+			"azureEnvironment":      "AzureCloud",
+			"azureSubscriptionId":   "kubernetes_TEST_subscription_id",
+			"azureSubscriptionName": "kubernetes_TEST_subscription_name",
+			"azureTenantId":         "kubernetes_TEST_tenant_id",
+		},
+		Scheme: converter.String("Kubernetes"),
+	}
+	azureSubscriptionServiceEndpoint.Data = &map[string]string{
+		"authorizationType":     "AzureSubscription",
+		"azureSubscriptionId":   "kubernetes_TEST_subscription_id",
+		"azureSubscriptionName": "kubernetes_TEST_subscription_name",
+		"clusterId":             "/subscriptions/kubernetes_TEST_subscription_id/resourcegroups/kubernetes_TEST_resource_group_id/providers/Microsoft.ContainerService/managedClusters/kubernetes_TEST_cluster_name",
+		"namespace":             "default",
+	}
+
+	serviceAccountServiceEndpoint := kubernetesTestServiceEndpoint
+	serviceAccountServiceEndpoint.Authorization = &serviceendpoint.EndpointAuthorization{
+		Parameters: &map[string]string{
+			"apiToken":                  "kubernetes_TEST_api_token",
+			"serviceAccountCertificate": "kubernetes_TEST_ca_cert",
+		},
+		Scheme: converter.String("Token"),
+	}
+	serviceAccountServiceEndpoint.Data = &map[string]string{
+		"authorizationType": "ServiceAccount",
+	}
+
+	for _, expected := range []serviceendpoint.ServiceEndpoint{azureSubscriptionServiceEndpoint, serviceAccountServiceEndpoint} {
+		resourceData := schema.TestResourceDataRaw(t, resourceServiceEndpointKubernetes().Schema, nil)
+		serviceEndpointKubernetesArgs.flatten(resourceData, &expected, kubernetesTestServiceEndpointProjectID, nil)
+
+		serviceEndpointAfterRoundTrip, projectID := serviceEndpointKubernetesArgs.expand(resourceData)
+
+		require.Equal(t, expected, *serviceEndpointAfterRoundTrip)
+		require.Equal(t, kubernetesTestServiceEndpointProjectID, projectID)
+	}
+}
+
+// verifies that a cluster configured by its resource ID is sent and read back unchanged
+func TestAzureDevOpsServiceEndpointKubernetes_ExpandFlatten_AzureSubscriptionWithClusterID(t *testing.T) {
+	clusterID := "/subscriptions/other_subscription/resourceGroups/aks/providers/Microsoft.ContainerService/managedClusters/cluster"
+	resourceData := schema.TestResourceDataRaw(t, resourceServiceEndpointKubernetes().Schema, map[string]interface{}{
+		"project_id":            kubernetesRandomServiceEndpointProjectID,
+		"service_endpoint_name": "UNIT_TEST_NAME",
+		"apiserver_url":         "https://kubernetes.apiserver.com/",
+		"authorization_type":    "AzureSubscription",
+		"azure_subscription": []interface{}{map[string]interface{}{
+			"azure_environment": "AzureChinaCloud",
+			"subscription_id":   "subscription",
+			"subscription_name": "Subscription",
+			"tenant_id":         "tenant",
+			"cluster_id":        clusterID,
+			"namespace":         "apps",
+		}},
+	})
+
+	serviceEndpoint, _ := serviceEndpointKubernetesArgs.expand(resourceData)
+	require.Equal(t, "AzureChinaCloud", (*serviceEndpoint.Authorization.Parameters)["azureEnvironment"])
+	require.Equal(t, "subscription", (*serviceEndpoint.Authorization.Parameters)["azureSubscriptionId"])
+	require.Equal(t, clusterID, (*serviceEndpoint.Data)["clusterId"])
+	require.Equal(t, "apps", (*serviceEndpoint.Data)["namespace"])
+
+	// the cluster is hosted in another subscription, so it can only be represented by its resource ID
+	serviceEndpoint.Id = &kubernetesTestServiceEndpointID
+	flattenedData := schema.TestResourceDataRaw(t, resourceServiceEndpointKubernetes().Schema, nil)
+	serviceEndpointKubernetesArgs.flatten(flattenedData, serviceEndpoint, kubernetesTestServiceEndpointProjectID, nil)
+	require.Equal(t, clusterID, flattenedData.Get("azure_subscription.0.cluster_id"))
+	require.Equal(t, "", flattenedData.Get("azure_subscription.0.cluster_name"))
+	require.Equal(t, "AzureChinaCloud", flattenedData.Get("azure_subscription.0.azure_environment"))
+}
+
+// verifies that only resource IDs of AKS clusters are accepted as cluster IDs
+func TestAzureDevOpsServiceEndpointKubernetes_ValidateClusterID(t *testing.T) {
+	valid := []string{
+		"/subscriptions/sub/resourceGroups/rg/providers/Microsoft.ContainerService/managedClusters/aks",
+		"/subscriptions/sub/resourcegroups/rg/providers/microsoft.containerservice/managedclusters/aks",
+	}
+	for _, clusterID := range valid {
+		_, errs := validateAzureKubernetesClusterID(clusterID, "cluster_id")
+		require.Empty(t, errs, clusterID)
+	}
+
+	invalid := []string{
+		"",
+		"aks",
+		"/subscriptions/sub/resourceGroups/rg",
+		"/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Compute/virtualMachines/vm",
+		"subscriptions/sub/resourceGroups/rg/providers/Microsoft.ContainerService/managedClusters/aks",
+		"/subscriptions/sub/resourceGroups/rg/providers/Microsoft.ContainerService/managedClusters/aks/agentPools/pool",
+	}
+	for _, clusterID := range invalid {
+		_, errs := validateAzureKubernetesClusterID(clusterID, "cluster_id")
+		require.NotEmpty(t, errs, clusterID)
+	}
+}
+
+// verifies that the cluster has to be identified and that the namespace must not be empty
+func TestAzureDevOpsServiceEndpointKubernetes_CustomizeDiff_ValidatesAzureSubscriptionCluster(t *testing.T) {
+	diffWithConfiguration := func(configuration map[string]interface{}) error {
+		configuration["subscription_id"] = "subscription"
+		configuration["subscription_name"] = "Subscription"
+		configuration["tenant_id"] = "tenant"
+		_, err := resourceServiceEndpointKubernetes().Diff(nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+			"project_id":            kubernetesRandomServiceEndpointProjectID,
+			"service_endpoint_name": "name",
+			"apiserver_url":         "https://kubernetes.apiserver.com/",
+			"authorization_type":    "AzureSubscription",
+			"azure_subscription":    []interface{}{configuration},
+		}), nil)
+		return err
+	}
+
+	clusterID := "/subscriptions/subscription/resourceGroups/rg/providers/Microsoft.ContainerService/managedClusters/aks"
+	require.Nil(t, diffWithConfiguration(map[string]interface{}{"cluster_id": clusterID}))
+	require.Nil(t, diffWithConfiguration(map[string]interface{}{"resourcegroup_id": "rg", "cluster_name": "aks"}))
+	require.NotNil(t, diffWithConfiguration(map[string]interface{}{"cluster_name": "aks"}))
+	require.NotNil(t, diffWithConfiguration(map[string]interface{}{"cluster_id": clusterID, "cluster_name": "aks"}))
+	require.NotNil(t, diffWithConfiguration(map[string]interface{}{"cluster_id": clusterID, "namespace": ""}))
+}
+
+// verifies that if an error is produced on create, the error is not swallowed
+func TestAzureDevOpsServiceEndpointKubernetes_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := resourceServiceEndpointKubernetes()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	serviceEndpointKubernetesArgs.flatten(resourceData, &kubernetesTestServiceEndpoint, kubernetesTestServiceEndpointProjectID, nil)
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &aggregatedClient{ServiceEndpointClient: buildClient, ctx: context.Background()}
+
+	expectedArgs := serviceendpoint.CreateServiceEndpointArgs{Endpoint: &kubernetesTestServiceEndpoint, Project: kubernetesTestServiceEndpointProjectID}
+	buildClient.
+		EXPECT().
+		CreateServiceEndpoint(clients.ctx, expectedArgs).
+		Return(nil, errors.New("CreateServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Create(resourceData, clients)
+	require.Contains(t, err.Error(), "CreateServiceEndpoint() Failed")
+}
+
+// verifies that if an error is produced on a read, it is not swallowed
+func TestAzureDevOpsServiceEndpointKubernetes_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := resourceServiceEndpointKubernetes()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	serviceEndpointKubernetesArgs.flatten(resourceData, &kubernetesTestServiceEndpoint, kubernetesTestServiceEndpointProjectID, nil)
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &aggregatedClient{ServiceEndpointClient: buildClient, ctx: context.Background()}
+
+	expectedArgs := serviceendpoint.GetServiceEndpointDetailsArgs{EndpointId: kubernetesTestServiceEndpoint.Id, Project: kubernetesTestServiceEndpointProjectID}
+	buildClient.
+		EXPECT().
+		GetServiceEndpointDetails(clients.ctx, expectedArgs).
+		Return(nil, errors.New("GetServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Read(resourceData, clients)
+	require.Contains(t, err.Error(), "GetServiceEndpoint() Failed")
+}
+
+// verifies that if an error is produced on a delete, it is not swallowed
+func TestAzureDevOpsServiceEndpointKubernetes_Delete_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := resourceServiceEndpointKubernetes()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	serviceEndpointKubernetesArgs.flatten(resourceData, &kubernetesTestServiceEndpoint, kubernetesTestServiceEndpointProjectID, nil)
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &aggregatedClient{ServiceEndpointClient: buildClient, ctx: context.Background()}
+
+	expectedArgs := serviceendpoint.DeleteServiceEndpointArgs{EndpointId: kubernetesTestServiceEndpoint.Id, Project: kubernetesTestServiceEndpointProjectID}
+	buildClient.
+		EXPECT().
+		DeleteServiceEndpoint(clients.ctx, expectedArgs).
+		Return(errors.New("DeleteServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Delete(resourceData, clients)
+	require.Contains(t, err.Error(), "DeleteServiceEndpoint() Failed")
+}
+
+// verifies that if an error is produced on an update, it is not swallowed
+func TestAzureDevOpsServiceEndpointKubernetes_Update_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := resourceServiceEndpointKubernetes()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	serviceEndpointKubernetesArgs.flatten(resourceData, &kubernetesTestServiceEndpoint, kubernetesTestServiceEndpointProjectID, nil)
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &aggregatedClient{ServiceEndpointClient: buildClient, ctx: context.Background()}
+
+	expectedArgs := serviceendpoint.UpdateServiceEndpointArgs{
+		Endpoint:   &kubernetesTestServiceEndpoint,
+		EndpointId: kubernetesTestServiceEndpoint.Id,
+		Project:    kubernetesTestServiceEndpointProjectID,
+	}
+
+	buildClient.
+		EXPECT().
+		UpdateServiceEndpoint(clients.ctx, expectedArgs).
+		Return(nil, errors.New("UpdateServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Update(resourceData, clients)
+	require.Contains(t, err.Error(), "UpdateServiceEndpoint() Failed")
+}
+
+/**
+ * Begin acceptance tests
+ */
+
+// validates that an apply followed by another apply (i.e., resource update) will be reflected in AzDO and the
+// underlying terraform state.
+func TestAccAzureDevOpsServiceEndpointKubernetes_CreateAndUpdate(t *testing.T) {
+	projectName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	serviceEndpointNameFirst := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	serviceEndpointNameSecond := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	tfSvcEpNode := "azuredevops_serviceendpoint_kubernetes.serviceendpoint"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccServiceEndpointCheckDestroyByType("azuredevops_serviceendpoint_kubernetes"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceEndpointKubernetesResource(projectName, serviceEndpointNameFirst),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "authorization_type", "ServiceAccount"),
+					resource.TestCheckResourceAttrSet(tfSvcEpNode, "service_account.0.token_hash"),
+					resource.TestCheckResourceAttrSet(tfSvcEpNode, "service_account.0.ca_cert_hash"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "service_endpoint_name", serviceEndpointNameFirst),
+					testAccCheckServiceEndpointResourceExistsByNode(tfSvcEpNode, serviceEndpointNameFirst),
+				),
+			}, {
+				Config: testAccServiceEndpointKubernetesResource(projectName, serviceEndpointNameSecond),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "authorization_type", "ServiceAccount"),
+					resource.TestCheckResourceAttrSet(tfSvcEpNode, "service_account.0.token_hash"),
+					resource.TestCheckResourceAttrSet(tfSvcEpNode, "service_account.0.ca_cert_hash"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "service_endpoint_name", serviceEndpointNameSecond),
+					testAccCheckServiceEndpointResourceExistsByNode(tfSvcEpNode, serviceEndpointNameSecond),
+				),
+			},
+		},
+	})
+}
+
+// HCL describing an AzDO Kubernetes service endpoint
+func testAccServiceEndpointKubernetesResource(projectName string, serviceEndpointName string) string {
+	serviceEndpointResource := fmt.Sprintf(`
+resource "azuredevops_serviceendpoint_kubernetes" "serviceendpoint" {
+	project_id            = azuredevops_project.project.id
+	service_endpoint_name = "%s"
+	apiserver_url         = "https://sample-kubernetes-cluster.hcp.westeurope.azmk8s.io"
+	authorization_type    = "ServiceAccount"
+
+	service_account {
+		token   = "bXktYXBw"
+		ca_cert = "Mzk1MjgkdmRnN0pi"
+	}
+}`, serviceEndpointName)
+
+	projectResource := testAccProjectResource(projectName)
+	return fmt.Sprintf("%s\n%s", projectResource, serviceEndpointResource)
+}
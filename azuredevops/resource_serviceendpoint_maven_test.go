@@ -0,0 +1,246 @@
+package azuredevops
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/serviceendpoint"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var mavenTestServiceEndpointID = uuid.New()
+var mavenRandomServiceEndpointProjectID = uuid.New().String()
+var mavenTestServiceEndpointProjectID = &mavenRandomServiceEndpointProjectID
+
+var mavenTestServiceEndpoint = serviceendpoint.ServiceEndpoint{
+	Authorization: &serviceendpoint.EndpointAuthorization{
+		Parameters: &map[string]string{
+			"repositoryId": "MAVEN_TEST_REPOSITORY",
+			"username":     "MAVEN_TEST_USERNAME",
+			"password":     "MAVEN_TEST_PASSWORD",
+		},
+		Scheme: converter.String("UsernamePassword"),
+	},
+	Id:    &mavenTestServiceEndpointID,
+	Name:  converter.String("UNIT_TEST_NAME"),
+	Owner: converter.String("library"),
+	Type:  converter.String("externalmavenrepository"),
+	Url:   converter.String("https://repo.example.com/maven2"),
+}
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that the flatten/expand round trip yields the same service endpoint
+func TestAzureDevOpsServiceEndpointMaven_ExpandFlatten_Roundtrip(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, resourceServiceEndpointMaven().Schema, nil)
+	serviceEndpointMavenArgs.flatten(resourceData, &mavenTestServiceEndpoint, mavenTestServiceEndpointProjectID, nil)
+
+	serviceEndpointAfterRoundTrip, projectID := serviceEndpointMavenArgs.expand(resourceData)
+
+	require.Equal(t, mavenTestServiceEndpoint, *serviceEndpointAfterRoundTrip)
+	require.Equal(t, mavenTestServiceEndpointProjectID, projectID)
+}
+
+// verifies that the flatten/expand round trip of an endpoint that authenticates with a token yields the same
+// service endpoint
+func TestAzureDevOpsServiceEndpointMaven_ExpandFlatten_RoundtripToken(t *testing.T) {
+	tokenServiceEndpoint := mavenTestServiceEndpoint
+	tokenServiceEndpoint.Authorization = &serviceendpoint.EndpointAuthorization{
+		Parameters: &map[string]string{
+			"repositoryId": "MAVEN_TEST_REPOSITORY",
+			"apitoken":     "MAVEN_TEST_TOKEN",
+		},
+		Scheme: converter.String("Token"),
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceServiceEndpointMaven().Schema, nil)
+	serviceEndpointMavenArgs.flatten(resourceData, &tokenServiceEndpoint, mavenTestServiceEndpointProjectID, nil)
+
+	serviceEndpointAfterRoundTrip, _ := serviceEndpointMavenArgs.expand(resourceData)
+	require.Equal(t, tokenServiceEndpoint, *serviceEndpointAfterRoundTrip)
+}
+
+// verifies that exactly one authentication scheme has to be configured
+func TestAzureDevOpsServiceEndpointMaven_CustomizeDiff_RequiresSingleAuthScheme(t *testing.T) {
+	diffWithAuth := func(auth map[string]interface{}) error {
+		config := map[string]interface{}{
+			"project_id":            "project",
+			"service_endpoint_name": "name",
+			"url":                   "https://repo.example.com/maven2",
+			"repository_id":         "example",
+		}
+		for key, value := range auth {
+			config[key] = value
+		}
+		_, err := resourceServiceEndpointMaven().Diff(nil, terraform.NewResourceConfigRaw(config), nil)
+		return err
+	}
+
+	require.Nil(t, diffWithAuth(map[string]interface{}{"username": "user", "password": "password"}))
+	require.Nil(t, diffWithAuth(map[string]interface{}{"personal_access_token": "token"}))
+	require.NotNil(t, diffWithAuth(map[string]interface{}{}))
+	require.NotNil(t, diffWithAuth(map[string]interface{}{"password": "password"}))
+	require.NotNil(t, diffWithAuth(map[string]interface{}{"username": "user", "password": "password", "personal_access_token": "token"}))
+}
+
+// verifies that if an error is produced on create, the error is not swallowed
+func TestAzureDevOpsServiceEndpointMaven_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := resourceServiceEndpointMaven()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	serviceEndpointMavenArgs.flatten(resourceData, &mavenTestServiceEndpoint, mavenTestServiceEndpointProjectID, nil)
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &aggregatedClient{ServiceEndpointClient: buildClient, ctx: context.Background()}
+
+	expectedArgs := serviceendpoint.CreateServiceEndpointArgs{Endpoint: &mavenTestServiceEndpoint, Project: mavenTestServiceEndpointProjectID}
+	buildClient.
+		EXPECT().
+		CreateServiceEndpoint(clients.ctx, expectedArgs).
+		Return(nil, errors.New("CreateServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Create(resourceData, clients)
+	require.Contains(t, err.Error(), "CreateServiceEndpoint() Failed")
+}
+
+// verifies that if an error is produced on a read, it is not swallowed
+func TestAzureDevOpsServiceEndpointMaven_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := resourceServiceEndpointMaven()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	serviceEndpointMavenArgs.flatten(resourceData, &mavenTestServiceEndpoint, mavenTestServiceEndpointProjectID, nil)
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &aggregatedClient{ServiceEndpointClient: buildClient, ctx: context.Background()}
+
+	expectedArgs := serviceendpoint.GetServiceEndpointDetailsArgs{EndpointId: mavenTestServiceEndpoint.Id, Project: mavenTestServiceEndpointProjectID}
+	buildClient.
+		EXPECT().
+		GetServiceEndpointDetails(clients.ctx, expectedArgs).
+		Return(nil, errors.New("GetServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Read(resourceData, clients)
+	require.Contains(t, err.Error(), "GetServiceEndpoint() Failed")
+}
+
+// verifies that if an error is produced on a delete, it is not swallowed
+func TestAzureDevOpsServiceEndpointMaven_Delete_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := resourceServiceEndpointMaven()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	serviceEndpointMavenArgs.flatten(resourceData, &mavenTestServiceEndpoint, mavenTestServiceEndpointProjectID, nil)
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &aggregatedClient{ServiceEndpointClient: buildClient, ctx: context.Background()}
+
+	expectedArgs := serviceendpoint.DeleteServiceEndpointArgs{EndpointId: mavenTestServiceEndpoint.Id, Project: mavenTestServiceEndpointProjectID}
+	buildClient.
+		EXPECT().
+		DeleteServiceEndpoint(clients.ctx, expectedArgs).
+		Return(errors.New("DeleteServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Delete(resourceData, clients)
+	require.Contains(t, err.Error(), "DeleteServiceEndpoint() Failed")
+}
+
+// verifies that if an error is produced on an update, it is not swallowed
+func TestAzureDevOpsServiceEndpointMaven_Update_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := resourceServiceEndpointMaven()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	serviceEndpointMavenArgs.flatten(resourceData, &mavenTestServiceEndpoint, mavenTestServiceEndpointProjectID, nil)
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &aggregatedClient{ServiceEndpointClient: buildClient, ctx: context.Background()}
+
+	expectedArgs := serviceendpoint.UpdateServiceEndpointArgs{
+		Endpoint:   &mavenTestServiceEndpoint,
+		EndpointId: mavenTestServiceEndpoint.Id,
+		Project:    mavenTestServiceEndpointProjectID,
+	}
+
+	buildClient.
+		EXPECT().
+		UpdateServiceEndpoint(clients.ctx, expectedArgs).
+		Return(nil, errors.New("UpdateServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Update(resourceData, clients)
+	require.Contains(t, err.Error(), "UpdateServiceEndpoint() Failed")
+}
+
+/**
+ * Begin acceptance tests
+ */
+
+// validates that an apply followed by another apply (i.e., resource update) will be reflected in AzDO and the
+// underlying terraform state.
+func TestAccAzureDevOpsServiceEndpointMaven_CreateAndUpdate(t *testing.T) {
+	projectName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	serviceEndpointNameFirst := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	serviceEndpointNameSecond := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	tfSvcEpNode := "azuredevops_serviceendpoint_maven.serviceendpoint"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccServiceEndpointCheckDestroyByType("azuredevops_serviceendpoint_maven"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceEndpointMavenResource(projectName, serviceEndpointNameFirst),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
+					resource.TestCheckResourceAttrSet(tfSvcEpNode, "personal_access_token_hash"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "service_endpoint_name", serviceEndpointNameFirst),
+					testAccCheckServiceEndpointResourceExistsByNode(tfSvcEpNode, serviceEndpointNameFirst),
+				),
+			}, {
+				Config: testAccServiceEndpointMavenResource(projectName, serviceEndpointNameSecond),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
+					resource.TestCheckResourceAttrSet(tfSvcEpNode, "personal_access_token_hash"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "service_endpoint_name", serviceEndpointNameSecond),
+					testAccCheckServiceEndpointResourceExistsByNode(tfSvcEpNode, serviceEndpointNameSecond),
+				),
+			},
+		},
+	})
+}
+
+// HCL describing an AzDO Maven service endpoint
+func testAccServiceEndpointMavenResource(projectName string, serviceEndpointName string) string {
+	serviceEndpointResource := fmt.Sprintf(`
+resource "azuredevops_serviceendpoint_maven" "serviceendpoint" {
+	project_id            = azuredevops_project.project.id
+	service_endpoint_name = "%s"
+	url                   = "https://repo.example.com/maven2"
+	repository_id         = "example"
+	personal_access_token = "token"
+}`, serviceEndpointName)
+
+	projectResource := testAccProjectResource(projectName)
+	return fmt.Sprintf("%s\n%s", projectResource, serviceEndpointResource)
+}
@@ -0,0 +1,85 @@
+package azuredevops
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/secretmemo"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/tfhelper"
+)
+
+func resourceServiceEndpointNpm() *schema.Resource {
+	r := genServiceEndpointResourceFromArgs(serviceEndpointNpmArgs)
+	r.CustomizeDiff = func(d *schema.ResourceDiff, m interface{}) error {
+		return validateServiceEndpointAuthSchemes(d, serviceEndpointNpmArgs.authSchemes)
+	}
+
+	r.Schema["registry_url"] = &schema.Schema{
+		Type:             schema.TypeString,
+		Required:         true,
+		Description:      "The URL of the npm registry.",
+		ValidateFunc:     validation.NoZeroValues,
+		DiffSuppressFunc: tfhelper.DiffFuncSuppressURLEquivalence,
+	}
+	r.Schema["username"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "The user name used to authenticate with the registry.",
+	}
+
+	passwordHashKey, passwordHashSchema := tfhelper.GenerateSecreteMemoSchema("password")
+	r.Schema["password"] = &schema.Schema{
+		Type:             schema.TypeString,
+		Optional:         true,
+		Description:      "The password used to authenticate with the registry.",
+		Sensitive:        true,
+		DiffSuppressFunc: tfhelper.DiffFuncSupressSecretChanged,
+	}
+	r.Schema[passwordHashKey] = passwordHashSchema
+
+	tokenHashKey, tokenHashSchema := tfhelper.GenerateSecreteMemoSchema("personal_access_token")
+	r.Schema["personal_access_token"] = &schema.Schema{
+		Type:             schema.TypeString,
+		Optional:         true,
+		Description:      "The personal access token used to authenticate with the registry.",
+		Sensitive:        true,
+		DiffSuppressFunc: tfhelper.DiffFuncSupressSecretChanged,
+	}
+	r.Schema[tokenHashKey] = tokenHashSchema
+
+	return r
+}
+
+var serviceEndpointNpmArgs = &serviceEndpointCRUDArgs{
+	endpointType: "externalnpmregistry",
+	urlKey:       "registry_url",
+	authSchemes: []serviceEndpointAuthScheme{
+		{
+			name:       "UsernamePassword",
+			attributes: []string{"username", "password"},
+			expandParameters: func(d *schema.ResourceData) (map[string]string, map[string]string) {
+				return map[string]string{
+					"username": d.Get("username").(string),
+					"password": d.Get("password").(string),
+				}, nil
+			},
+			flattenParameters: func(d *schema.ResourceData, parameters map[string]string, data map[string]string, secretSettings *secretmemo.Settings) {
+				tfhelper.HelpFlattenSecret(d, "password", secretSettings)
+				d.Set("username", parameters["username"])
+				d.Set("password", parameters["password"])
+			},
+		},
+		{
+			name:       "Token",
+			attributes: []string{"personal_access_token"},
+			expandParameters: func(d *schema.ResourceData) (map[string]string, map[string]string) {
+				return map[string]string{
+					"apitoken": d.Get("personal_access_token").(string),
+				}, nil
+			},
+			flattenParameters: func(d *schema.ResourceData, parameters map[string]string, data map[string]string, secretSettings *secretmemo.Settings) {
+				tfhelper.HelpFlattenSecret(d, "personal_access_token", secretSettings)
+				d.Set("personal_access_token", parameters["apitoken"])
+			},
+		},
+	},
+}
@@ -0,0 +1,233 @@
+package azuredevops
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/serviceendpoint"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var nugetTestServiceEndpointID = uuid.New()
+var nugetRandomServiceEndpointProjectID = uuid.New().String()
+var nugetTestServiceEndpointProjectID = &nugetRandomServiceEndpointProjectID
+
+var nugetTestServiceEndpoint = serviceendpoint.ServiceEndpoint{
+	Authorization: &serviceendpoint.EndpointAuthorization{
+		Parameters: &map[string]string{
+			"nugetkey": "NUGET_TEST_API_KEY",
+		},
+		Scheme: converter.String("None"),
+	},
+	Id:    &nugetTestServiceEndpointID,
+	Name:  converter.String("UNIT_TEST_NAME"),
+	Owner: converter.String("library"),
+	Type:  converter.String("externalnugetfeed"),
+	Url:   converter.String("https://api.nuget.org/v3/index.json"),
+}
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that the flatten/expand round trip yields the same service endpoint
+func TestAzureDevOpsServiceEndpointNuGet_ExpandFlatten_Roundtrip(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, resourceServiceEndpointNuGet().Schema, nil)
+	serviceEndpointNuGetArgs.flatten(resourceData, &nugetTestServiceEndpoint, nugetTestServiceEndpointProjectID, nil)
+
+	serviceEndpointAfterRoundTrip, projectID := serviceEndpointNuGetArgs.expand(resourceData)
+
+	require.Equal(t, nugetTestServiceEndpoint, *serviceEndpointAfterRoundTrip)
+	require.Equal(t, nugetTestServiceEndpointProjectID, projectID)
+}
+
+// verifies that exactly one authentication scheme has to be configured
+func TestAzureDevOpsServiceEndpointNuGet_CustomizeDiff_RequiresSingleAuthScheme(t *testing.T) {
+	diffWithAuth := func(auth map[string]interface{}) error {
+		config := map[string]interface{}{
+			"project_id":            "project",
+			"service_endpoint_name": "name",
+			"feed_url":              "https://api.nuget.org/v3/index.json",
+		}
+		for key, value := range auth {
+			config[key] = value
+		}
+		_, err := resourceServiceEndpointNuGet().Diff(nil, terraform.NewResourceConfigRaw(config), nil)
+		return err
+	}
+
+	require.Nil(t, diffWithAuth(map[string]interface{}{"api_key": "key"}))
+	require.Nil(t, diffWithAuth(map[string]interface{}{"username": "user", "password": "password"}))
+	require.Nil(t, diffWithAuth(map[string]interface{}{"personal_access_token": "token"}))
+	require.NotNil(t, diffWithAuth(map[string]interface{}{}))
+	require.NotNil(t, diffWithAuth(map[string]interface{}{"username": "user"}))
+	require.NotNil(t, diffWithAuth(map[string]interface{}{"api_key": "key", "personal_access_token": "token"}))
+}
+
+// verifies that a secret which is only known by its hash in the state still determines the authentication scheme
+func TestAzureDevOpsServiceEndpointNuGet_Expand_UsesSchemeOfHashedSecret(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, resourceServiceEndpointNuGet().Schema, nil)
+	resourceData.Set("personal_access_token_hash", "hash")
+
+	serviceEndpoint, _ := serviceEndpointNuGetArgs.expand(resourceData)
+	require.Equal(t, "Token", *serviceEndpoint.Authorization.Scheme)
+}
+
+// verifies that if an error is produced on create, the error is not swallowed
+func TestAzureDevOpsServiceEndpointNuGet_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := resourceServiceEndpointNuGet()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	serviceEndpointNuGetArgs.flatten(resourceData, &nugetTestServiceEndpoint, nugetTestServiceEndpointProjectID, nil)
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &aggregatedClient{ServiceEndpointClient: buildClient, ctx: context.Background()}
+
+	expectedArgs := serviceendpoint.CreateServiceEndpointArgs{Endpoint: &nugetTestServiceEndpoint, Project: nugetTestServiceEndpointProjectID}
+	buildClient.
+		EXPECT().
+		CreateServiceEndpoint(clients.ctx, expectedArgs).
+		Return(nil, errors.New("CreateServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Create(resourceData, clients)
+	require.Contains(t, err.Error(), "CreateServiceEndpoint() Failed")
+}
+
+// verifies that if an error is produced on a read, it is not swallowed
+func TestAzureDevOpsServiceEndpointNuGet_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := resourceServiceEndpointNuGet()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	serviceEndpointNuGetArgs.flatten(resourceData, &nugetTestServiceEndpoint, nugetTestServiceEndpointProjectID, nil)
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &aggregatedClient{ServiceEndpointClient: buildClient, ctx: context.Background()}
+
+	expectedArgs := serviceendpoint.GetServiceEndpointDetailsArgs{EndpointId: nugetTestServiceEndpoint.Id, Project: nugetTestServiceEndpointProjectID}
+	buildClient.
+		EXPECT().
+		GetServiceEndpointDetails(clients.ctx, expectedArgs).
+		Return(nil, errors.New("GetServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Read(resourceData, clients)
+	require.Contains(t, err.Error(), "GetServiceEndpoint() Failed")
+}
+
+// verifies that if an error is produced on a delete, it is not swallowed
+func TestAzureDevOpsServiceEndpointNuGet_Delete_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := resourceServiceEndpointNuGet()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	serviceEndpointNuGetArgs.flatten(resourceData, &nugetTestServiceEndpoint, nugetTestServiceEndpointProjectID, nil)
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &aggregatedClient{ServiceEndpointClient: buildClient, ctx: context.Background()}
+
+	expectedArgs := serviceendpoint.DeleteServiceEndpointArgs{EndpointId: nugetTestServiceEndpoint.Id, Project: nugetTestServiceEndpointProjectID}
+	buildClient.
+		EXPECT().
+		DeleteServiceEndpoint(clients.ctx, expectedArgs).
+		Return(errors.New("DeleteServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Delete(resourceData, clients)
+	require.Contains(t, err.Error(), "DeleteServiceEndpoint() Failed")
+}
+
+// verifies that if an error is produced on an update, it is not swallowed
+func TestAzureDevOpsServiceEndpointNuGet_Update_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := resourceServiceEndpointNuGet()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	serviceEndpointNuGetArgs.flatten(resourceData, &nugetTestServiceEndpoint, nugetTestServiceEndpointProjectID, nil)
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &aggregatedClient{ServiceEndpointClient: buildClient, ctx: context.Background()}
+
+	expectedArgs := serviceendpoint.UpdateServiceEndpointArgs{
+		Endpoint:   &nugetTestServiceEndpoint,
+		EndpointId: nugetTestServiceEndpoint.Id,
+		Project:    nugetTestServiceEndpointProjectID,
+	}
+
+	buildClient.
+		EXPECT().
+		UpdateServiceEndpoint(clients.ctx, expectedArgs).
+		Return(nil, errors.New("UpdateServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Update(resourceData, clients)
+	require.Contains(t, err.Error(), "UpdateServiceEndpoint() Failed")
+}
+
+/**
+ * Begin acceptance tests
+ */
+
+// validates that an apply followed by another apply (i.e., resource update) will be reflected in AzDO and the
+// underlying terraform state.
+func TestAccAzureDevOpsServiceEndpointNuGet_CreateAndUpdate(t *testing.T) {
+	projectName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	serviceEndpointNameFirst := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	serviceEndpointNameSecond := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	tfSvcEpNode := "azuredevops_serviceendpoint_nuget.serviceendpoint"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccServiceEndpointCheckDestroyByType("azuredevops_serviceendpoint_nuget"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceEndpointNuGetResource(projectName, serviceEndpointNameFirst),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
+					resource.TestCheckResourceAttrSet(tfSvcEpNode, "api_key_hash"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "service_endpoint_name", serviceEndpointNameFirst),
+					testAccCheckServiceEndpointResourceExistsByNode(tfSvcEpNode, serviceEndpointNameFirst),
+				),
+			}, {
+				Config: testAccServiceEndpointNuGetResource(projectName, serviceEndpointNameSecond),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
+					resource.TestCheckResourceAttrSet(tfSvcEpNode, "api_key_hash"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "service_endpoint_name", serviceEndpointNameSecond),
+					testAccCheckServiceEndpointResourceExistsByNode(tfSvcEpNode, serviceEndpointNameSecond),
+				),
+			},
+		},
+	})
+}
+
+// HCL describing an AzDO NuGet service endpoint
+func testAccServiceEndpointNuGetResource(projectName string, serviceEndpointName string) string {
+	serviceEndpointResource := fmt.Sprintf(`
+resource "azuredevops_serviceendpoint_nuget" "serviceendpoint" {
+	project_id            = azuredevops_project.project.id
+	service_endpoint_name = "%s"
+	feed_url              = "https://api.nuget.org/v3/index.json"
+	api_key               = "key"
+}`, serviceEndpointName)
+
+	projectResource := testAccProjectResource(projectName)
+	return fmt.Sprintf("%s\n%s", projectResource, serviceEndpointResource)
+}
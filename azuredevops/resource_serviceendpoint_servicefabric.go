@@ -0,0 +1,234 @@
+package azuredevops
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/secretmemo"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/tfhelper"
+)
+
+// maps the authorization scheme of each authentication mode to the schema block that configures it
+const (
+	sfAuthSchemeCertificate      = "Certificate"
+	sfAuthSchemeUsernamePassword = "UsernamePassword"
+	sfAuthSchemeNone             = "None"
+)
+
+var sfAuthSchemeBlocks = map[string]string{
+	sfAuthSchemeCertificate:      "certificate",
+	sfAuthSchemeUsernamePassword: "azure_active_directory",
+	sfAuthSchemeNone:             "none",
+}
+
+func resourceServiceEndpointServiceFabric() *schema.Resource {
+	r := genServiceEndpointResourceFromArgs(serviceEndpointServiceFabricArgs)
+	r.CustomizeDiff = customizeDiffServiceEndpointServiceFabric
+
+	r.Schema["cluster_endpoint"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		Description:  "The client connection endpoint of the cluster, e.g. tcp://mycluster.westeurope.cloudapp.azure.com:19000",
+		ValidateFunc: validation.NoZeroValues,
+	}
+
+	clientCertificateHashKey, clientCertificateHashSchema := tfhelper.GenerateSecreteMemoSchema("client_certificate")
+	clientCertificatePasswordHashKey, clientCertificatePasswordHashSchema := tfhelper.GenerateSecreteMemoSchema("client_certificate_password")
+	r.Schema["certificate"] = &schema.Schema{
+		Type:          schema.TypeList,
+		Optional:      true,
+		MaxItems:      1,
+		Description:   "Authenticates with a client certificate",
+		ConflictsWith: []string{"azure_active_directory", "none"},
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"server_certificate_thumbprint": {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "The thumbprint of the certificate of the cluster, which is used to verify the identity of the cluster",
+					ValidateFunc: validation.NoZeroValues,
+				},
+				"client_certificate": {
+					Type:             schema.TypeString,
+					Required:         true,
+					Description:      "The base64 encoded client certificate, including its private key",
+					Sensitive:        true,
+					DiffSuppressFunc: tfhelper.DiffFuncSupressSecretChanged,
+					ValidateFunc:     validation.NoZeroValues,
+				},
+				clientCertificateHashKey: clientCertificateHashSchema,
+				"client_certificate_password": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					Description:      "The password of the client certificate",
+					Sensitive:        true,
+					DiffSuppressFunc: tfhelper.DiffFuncSupressSecretChanged,
+				},
+				clientCertificatePasswordHashKey: clientCertificatePasswordHashSchema,
+			},
+		},
+	}
+
+	passwordHashKey, passwordHashSchema := tfhelper.GenerateSecreteMemoSchema("password")
+	r.Schema["azure_active_directory"] = &schema.Schema{
+		Type:          schema.TypeList,
+		Optional:      true,
+		MaxItems:      1,
+		Description:   "Authenticates with the credentials of an Azure Active Directory user",
+		ConflictsWith: []string{"certificate", "none"},
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"server_certificate_thumbprint": {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "The thumbprint of the certificate of the cluster, which is used to verify the identity of the cluster",
+					ValidateFunc: validation.NoZeroValues,
+				},
+				"username": {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "The name of the Azure Active Directory user",
+					ValidateFunc: validation.NoZeroValues,
+				},
+				"password": {
+					Type:             schema.TypeString,
+					Required:         true,
+					Description:      "The password of the Azure Active Directory user",
+					Sensitive:        true,
+					DiffSuppressFunc: tfhelper.DiffFuncSupressSecretChanged,
+					ValidateFunc:     validation.NoZeroValues,
+				},
+				passwordHashKey: passwordHashSchema,
+			},
+		},
+	}
+
+	r.Schema["none"] = &schema.Schema{
+		Type:          schema.TypeList,
+		Optional:      true,
+		MaxItems:      1,
+		Description:   "Connects to an unsecured cluster, or authenticates with the Windows credentials of the agent",
+		ConflictsWith: []string{"certificate", "azure_active_directory"},
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"unsecured": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     true,
+					Description: "Whether the cluster is unsecured. Windows authentication is used if it is false",
+				},
+				"cluster_spn": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The fully qualified domain SPN of the gMSA account of the cluster, used with Windows authentication",
+				},
+			},
+		},
+	}
+
+	return r
+}
+
+// Verifies at plan time that exactly one authentication mode is configured. Configuring more than one
+// is already rejected through ConflictsWith.
+func customizeDiffServiceEndpointServiceFabric(d *schema.ResourceDiff, m interface{}) error {
+	for _, blockName := range sfAuthSchemeBlocks {
+		if !d.NewValueKnown(blockName) {
+			return nil
+		}
+	}
+
+	configured := 0
+	for _, blockName := range sfAuthSchemeBlocks {
+		configured += len(d.Get(blockName).([]interface{}))
+	}
+	if configured != 1 {
+		return fmt.Errorf("exactly one of certificate, azure_active_directory or none must be configured")
+	}
+	return nil
+}
+
+// The service does not return secrets, so they are kept as configured and only their hashes are updated.
+var serviceEndpointServiceFabricArgs = &serviceEndpointCRUDArgs{
+	endpointType: "servicefabric",
+	urlKey:       "cluster_endpoint",
+	authSchemes: []serviceEndpointAuthScheme{
+		{
+			name:       sfAuthSchemeCertificate,
+			attributes: []string{sfAuthSchemeBlocks[sfAuthSchemeCertificate]},
+			expandParameters: func(d *schema.ResourceData) (map[string]string, map[string]string) {
+				configuration := expandSingleItemBlock(d, "certificate")
+				return map[string]string{
+					"certLookup":           "Thumbprint",
+					"servercertthumbprint": configuration["server_certificate_thumbprint"].(string),
+					"certificate":          configuration["client_certificate"].(string),
+					"certificatepassword":  configuration["client_certificate_password"].(string),
+				}, nil
+			},
+			flattenParameters: func(d *schema.ResourceData, parameters map[string]string, data map[string]string, secretSettings *secretmemo.Settings) {
+				configuration := map[string]interface{}{
+					"server_certificate_thumbprint": parameters["servercertthumbprint"],
+					"client_certificate":            d.Get("certificate.0.client_certificate").(string),
+					"client_certificate_password":   d.Get("certificate.0.client_certificate_password").(string),
+				}
+				tfhelper.HelpFlattenSecretNested(d, "certificate", configuration, "client_certificate", secretSettings)
+				tfhelper.HelpFlattenSecretNested(d, "certificate", configuration, "client_certificate_password", secretSettings)
+				d.Set("certificate", []interface{}{configuration})
+			},
+		},
+		{
+			name:       sfAuthSchemeUsernamePassword,
+			attributes: []string{sfAuthSchemeBlocks[sfAuthSchemeUsernamePassword]},
+			expandParameters: func(d *schema.ResourceData) (map[string]string, map[string]string) {
+				configuration := expandSingleItemBlock(d, "azure_active_directory")
+				return map[string]string{
+					"certLookup":           "Thumbprint",
+					"servercertthumbprint": configuration["server_certificate_thumbprint"].(string),
+					"username":             configuration["username"].(string),
+					"password":             configuration["password"].(string),
+				}, nil
+			},
+			flattenParameters: func(d *schema.ResourceData, parameters map[string]string, data map[string]string, secretSettings *secretmemo.Settings) {
+				configuration := map[string]interface{}{
+					"server_certificate_thumbprint": parameters["servercertthumbprint"],
+					"username":                      parameters["username"],
+					"password":                      d.Get("azure_active_directory.0.password").(string),
+				}
+				tfhelper.HelpFlattenSecretNested(d, "azure_active_directory", configuration, "password", secretSettings)
+				d.Set("azure_active_directory", []interface{}{configuration})
+			},
+		},
+		{
+			name:       sfAuthSchemeNone,
+			attributes: []string{sfAuthSchemeBlocks[sfAuthSchemeNone]},
+			expandParameters: func(d *schema.ResourceData) (map[string]string, map[string]string) {
+				configuration := expandSingleItemBlock(d, "none")
+				return map[string]string{
+					"Unsecured":  strconv.FormatBool(configuration["unsecured"].(bool)),
+					"ClusterSpn": configuration["cluster_spn"].(string),
+				}, nil
+			},
+			flattenParameters: func(d *schema.ResourceData, parameters map[string]string, data map[string]string, secretSettings *secretmemo.Settings) {
+				unsecured, err := strconv.ParseBool(parameters["Unsecured"])
+				if err != nil {
+					unsecured = true
+				}
+				d.Set("none", []interface{}{map[string]interface{}{
+					"unsecured":   unsecured,
+					"cluster_spn": parameters["ClusterSpn"],
+				}})
+			},
+		},
+	},
+	// the scheme whose block is configured is used
+	expandAuthScheme: func(d *schema.ResourceData) string {
+		for _, authScheme := range []string{sfAuthSchemeCertificate, sfAuthSchemeUsernamePassword, sfAuthSchemeNone} {
+			if len(expandSingleItemBlock(d, sfAuthSchemeBlocks[authScheme])) > 0 {
+				return authScheme
+			}
+		}
+		return ""
+	},
+}
@@ -0,0 +1,326 @@
+package azuredevops
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/serviceendpoint"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var serviceFabricTestServiceEndpointID = uuid.New()
+var serviceFabricRandomServiceEndpointProjectID = uuid.New().String()
+var serviceFabricTestServiceEndpointProjectID = &serviceFabricRandomServiceEndpointProjectID
+
+var serviceFabricTestServiceEndpoint = serviceendpoint.ServiceEndpoint{
+	Authorization: &serviceendpoint.EndpointAuthorization{
+		Parameters: &map[string]string{
+			"Unsecured":  "false",
+			"ClusterSpn": "HTTP/cluster.contoso.com",
+		},
+		Scheme: converter.String("None"),
+	},
+	Id:    &serviceFabricTestServiceEndpointID,
+	Name:  converter.String("UNIT_TEST_NAME"),
+	Owner: converter.String("library"),
+	Type:  converter.String("servicefabric"),
+	Url:   converter.String("tcp://cluster.contoso.com:19000"),
+}
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that the flatten/expand round trip yields the same service endpoint
+func TestAzureDevOpsServiceEndpointServiceFabric_ExpandFlatten_Roundtrip(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, resourceServiceEndpointServiceFabric().Schema, nil)
+	serviceEndpointServiceFabricArgs.flatten(resourceData, &serviceFabricTestServiceEndpoint, serviceFabricTestServiceEndpointProjectID, nil)
+
+	serviceEndpointAfterRoundTrip, projectID := serviceEndpointServiceFabricArgs.expand(resourceData)
+
+	require.Equal(t, serviceFabricTestServiceEndpoint, *serviceEndpointAfterRoundTrip)
+	require.Equal(t, serviceFabricTestServiceEndpointProjectID, projectID)
+}
+
+// verifies that each authentication mode is mapped onto its authorization scheme, and that the secrets, which the
+// service does not return, are kept as configured when the endpoint is read
+func TestAzureDevOpsServiceEndpointServiceFabric_ExpandFlatten_AuthenticationModes(t *testing.T) {
+	tests := []struct {
+		blockName          string
+		configuration      map[string]interface{}
+		expectedScheme     string
+		expectedParameters map[string]string
+		secrets            []string
+	}{
+		{
+			blockName: "certificate",
+			configuration: map[string]interface{}{
+				"server_certificate_thumbprint": "0123456789ABCDEF",
+				"client_certificate":            "MIIKcQIBAzCCCi0GCSqGSIb3DQEHAaCCCh4Egg",
+				"client_certificate_password":   "certificate-password",
+			},
+			expectedScheme: "Certificate",
+			expectedParameters: map[string]string{
+				"certLookup":           "Thumbprint",
+				"servercertthumbprint": "0123456789ABCDEF",
+				"certificate":          "MIIKcQIBAzCCCi0GCSqGSIb3DQEHAaCCCh4Egg",
+				"certificatepassword":  "certificate-password",
+			},
+			secrets: []string{"client_certificate", "client_certificate_password"},
+		},
+		{
+			blockName: "azure_active_directory",
+			configuration: map[string]interface{}{
+				"server_certificate_thumbprint": "0123456789ABCDEF",
+				"username":                      "deploy@contoso.com",
+				"password":                      "aad-password",
+			},
+			expectedScheme: "UsernamePassword",
+			expectedParameters: map[string]string{
+				"certLookup":           "Thumbprint",
+				"servercertthumbprint": "0123456789ABCDEF",
+				"username":             "deploy@contoso.com",
+				"password":             "aad-password",
+			},
+			secrets: []string{"password"},
+		},
+		{
+			blockName:          "none",
+			configuration:      map[string]interface{}{},
+			expectedScheme:     "None",
+			expectedParameters: map[string]string{"Unsecured": "true", "ClusterSpn": ""},
+		},
+	}
+
+	for _, test := range tests {
+		resourceData := schema.TestResourceDataRaw(t, resourceServiceEndpointServiceFabric().Schema, map[string]interface{}{
+			"project_id":            serviceFabricRandomServiceEndpointProjectID,
+			"service_endpoint_name": "UNIT_TEST_NAME",
+			"cluster_endpoint":      "tcp://cluster.contoso.com:19000",
+			test.blockName:          []interface{}{test.configuration},
+		})
+
+		serviceEndpoint, _ := serviceEndpointServiceFabricArgs.expand(resourceData)
+		require.Equal(t, test.expectedScheme, *serviceEndpoint.Authorization.Scheme, test.blockName)
+		require.Equal(t, test.expectedParameters, *serviceEndpoint.Authorization.Parameters, test.blockName)
+
+		// the service omits secrets from its responses
+		parameters := *serviceEndpoint.Authorization.Parameters
+		returnedParameters := map[string]string{}
+		for key, value := range parameters {
+			returnedParameters[key] = value
+		}
+		for _, key := range []string{"certificate", "certificatepassword", "password"} {
+			delete(returnedParameters, key)
+		}
+		serviceEndpoint.Id = &serviceFabricTestServiceEndpointID
+		serviceEndpoint.Authorization.Parameters = &returnedParameters
+
+		serviceEndpointServiceFabricArgs.flatten(resourceData, serviceEndpoint, serviceFabricTestServiceEndpointProjectID, nil)
+		for _, secret := range test.secrets {
+			require.Equal(t, test.configuration[secret], resourceData.Get(test.blockName+".0."+secret), secret)
+			require.NotEmpty(t, resourceData.Get(test.blockName+".0."+secret+"_hash"), secret)
+		}
+
+		serviceEndpointAfterRoundTrip, _ := serviceEndpointServiceFabricArgs.expand(resourceData)
+		require.Equal(t, parameters, *serviceEndpointAfterRoundTrip.Authorization.Parameters, test.blockName)
+	}
+}
+
+// verifies that exactly one authentication mode has to be configured
+func TestAzureDevOpsServiceEndpointServiceFabric_RequiresOneAuthenticationMode(t *testing.T) {
+	configWithModes := func(modes map[string]interface{}) *terraform.ResourceConfig {
+		config := map[string]interface{}{
+			"project_id":            "project",
+			"service_endpoint_name": "name",
+			"cluster_endpoint":      "tcp://cluster.contoso.com:19000",
+		}
+		for key, value := range modes {
+			config[key] = value
+		}
+		return terraform.NewResourceConfigRaw(config)
+	}
+	diffWithModes := func(modes map[string]interface{}) error {
+		_, err := resourceServiceEndpointServiceFabric().Diff(nil, configWithModes(modes), nil)
+		return err
+	}
+
+	none := []interface{}{map[string]interface{}{"unsecured": true}}
+	aad := []interface{}{map[string]interface{}{
+		"server_certificate_thumbprint": "0123456789ABCDEF",
+		"username":                      "deploy@contoso.com",
+		"password":                      "aad-password",
+	}}
+
+	require.Nil(t, diffWithModes(map[string]interface{}{"none": none}))
+	require.Nil(t, diffWithModes(map[string]interface{}{"azure_active_directory": aad}))
+	require.NotNil(t, diffWithModes(map[string]interface{}{}))
+
+	_, errs := resourceServiceEndpointServiceFabric().Validate(configWithModes(map[string]interface{}{"none": none, "azure_active_directory": aad}))
+	require.NotEmpty(t, errs)
+}
+
+// verifies that if an error is produced on create, the error is not swallowed
+func TestAzureDevOpsServiceEndpointServiceFabric_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := resourceServiceEndpointServiceFabric()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	serviceEndpointServiceFabricArgs.flatten(resourceData, &serviceFabricTestServiceEndpoint, serviceFabricTestServiceEndpointProjectID, nil)
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &aggregatedClient{ServiceEndpointClient: buildClient, ctx: context.Background()}
+
+	expectedArgs := serviceendpoint.CreateServiceEndpointArgs{Endpoint: &serviceFabricTestServiceEndpoint, Project: serviceFabricTestServiceEndpointProjectID}
+	buildClient.
+		EXPECT().
+		CreateServiceEndpoint(clients.ctx, expectedArgs).
+		Return(nil, errors.New("CreateServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Create(resourceData, clients)
+	require.Contains(t, err.Error(), "CreateServiceEndpoint() Failed")
+}
+
+// verifies that if an error is produced on a read, it is not swallowed
+func TestAzureDevOpsServiceEndpointServiceFabric_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := resourceServiceEndpointServiceFabric()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	serviceEndpointServiceFabricArgs.flatten(resourceData, &serviceFabricTestServiceEndpoint, serviceFabricTestServiceEndpointProjectID, nil)
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &aggregatedClient{ServiceEndpointClient: buildClient, ctx: context.Background()}
+
+	expectedArgs := serviceendpoint.GetServiceEndpointDetailsArgs{EndpointId: serviceFabricTestServiceEndpoint.Id, Project: serviceFabricTestServiceEndpointProjectID}
+	buildClient.
+		EXPECT().
+		GetServiceEndpointDetails(clients.ctx, expectedArgs).
+		Return(nil, errors.New("GetServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Read(resourceData, clients)
+	require.Contains(t, err.Error(), "GetServiceEndpoint() Failed")
+}
+
+// verifies that if an error is produced on a delete, it is not swallowed
+func TestAzureDevOpsServiceEndpointServiceFabric_Delete_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := resourceServiceEndpointServiceFabric()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	serviceEndpointServiceFabricArgs.flatten(resourceData, &serviceFabricTestServiceEndpoint, serviceFabricTestServiceEndpointProjectID, nil)
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &aggregatedClient{ServiceEndpointClient: buildClient, ctx: context.Background()}
+
+	expectedArgs := serviceendpoint.DeleteServiceEndpointArgs{EndpointId: serviceFabricTestServiceEndpoint.Id, Project: serviceFabricTestServiceEndpointProjectID}
+	buildClient.
+		EXPECT().
+		DeleteServiceEndpoint(clients.ctx, expectedArgs).
+		Return(errors.New("DeleteServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Delete(resourceData, clients)
+	require.Contains(t, err.Error(), "DeleteServiceEndpoint() Failed")
+}
+
+// verifies that if an error is produced on an update, it is not swallowed
+func TestAzureDevOpsServiceEndpointServiceFabric_Update_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := resourceServiceEndpointServiceFabric()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	serviceEndpointServiceFabricArgs.flatten(resourceData, &serviceFabricTestServiceEndpoint, serviceFabricTestServiceEndpointProjectID, nil)
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &aggregatedClient{ServiceEndpointClient: buildClient, ctx: context.Background()}
+
+	expectedArgs := serviceendpoint.UpdateServiceEndpointArgs{
+		Endpoint:   &serviceFabricTestServiceEndpoint,
+		EndpointId: serviceFabricTestServiceEndpoint.Id,
+		Project:    serviceFabricTestServiceEndpointProjectID,
+	}
+
+	buildClient.
+		EXPECT().
+		UpdateServiceEndpoint(clients.ctx, expectedArgs).
+		Return(nil, errors.New("UpdateServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Update(resourceData, clients)
+	require.Contains(t, err.Error(), "UpdateServiceEndpoint() Failed")
+}
+
+/**
+ * Begin acceptance tests
+ */
+
+// validates that an apply followed by another apply (i.e., resource update) will be reflected in AzDO and the
+// underlying terraform state.
+func TestAccAzureDevOpsServiceEndpointServiceFabric_CreateAndUpdate(t *testing.T) {
+	projectName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	serviceEndpointNameFirst := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	serviceEndpointNameSecond := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	tfSvcEpNode := "azuredevops_serviceendpoint_servicefabric.serviceendpoint"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccServiceEndpointCheckDestroyByType("azuredevops_serviceendpoint_servicefabric"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceEndpointServiceFabricResource(projectName, serviceEndpointNameFirst),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "cluster_endpoint", "tcp://servicefabric.example.com:19000"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "azure_active_directory.0.username", "deploy@example.com"),
+					resource.TestCheckResourceAttrSet(tfSvcEpNode, "azure_active_directory.0.password_hash"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "service_endpoint_name", serviceEndpointNameFirst),
+					testAccCheckServiceEndpointResourceExistsByNode(tfSvcEpNode, serviceEndpointNameFirst),
+				),
+			}, {
+				Config: testAccServiceEndpointServiceFabricResource(projectName, serviceEndpointNameSecond),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "service_endpoint_name", serviceEndpointNameSecond),
+					testAccCheckServiceEndpointResourceExistsByNode(tfSvcEpNode, serviceEndpointNameSecond),
+				),
+			},
+		},
+	})
+}
+
+// HCL describing an AzDO Service Fabric service endpoint
+func testAccServiceEndpointServiceFabricResource(projectName string, serviceEndpointName string) string {
+	serviceEndpointResource := fmt.Sprintf(`
+resource "azuredevops_serviceendpoint_servicefabric" "serviceendpoint" {
+	project_id            = azuredevops_project.project.id
+	service_endpoint_name = "%s"
+	cluster_endpoint      = "tcp://servicefabric.example.com:19000"
+
+	azure_active_directory {
+		server_certificate_thumbprint = "0123456789ABCDEF0123456789ABCDEF01234567"
+		username                      = "deploy@example.com"
+		password                      = "password"
+	}
+}`, serviceEndpointName)
+
+	projectResource := testAccProjectResource(projectName)
+	return fmt.Sprintf("%s\n%s", projectResource, serviceEndpointResource)
+}
@@ -0,0 +1,49 @@
+package azuredevops
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/secretmemo"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/tfhelper"
+)
+
+func resourceServiceEndpointSonarQube() *schema.Resource {
+	r := genServiceEndpointResourceFromArgs(serviceEndpointSonarQubeArgs)
+
+	r.Schema["url"] = &schema.Schema{
+		Type:             schema.TypeString,
+		Required:         true,
+		ValidateFunc:     validation.NoZeroValues,
+		DiffSuppressFunc: tfhelper.DiffFuncSuppressURLEquivalence,
+		Description:      "The URL of the SonarQube server.",
+	}
+
+	tokenHashKey, tokenHashSchema := tfhelper.GenerateSecreteMemoSchema("token")
+	r.Schema["token"] = &schema.Schema{
+		Type:             schema.TypeString,
+		Required:         true,
+		Description:      "The authentication token generated through SonarQube.",
+		Sensitive:        true,
+		DiffSuppressFunc: tfhelper.DiffFuncSupressSecretChanged,
+		ValidateFunc:     validation.NoZeroValues,
+	}
+	r.Schema[tokenHashKey] = tokenHashSchema
+
+	return r
+}
+
+// SonarQube authenticates with a token, which is sent as the user name of the UsernamePassword scheme
+var serviceEndpointSonarQubeArgs = &serviceEndpointCRUDArgs{
+	endpointType: "sonarqube",
+	authScheme:   "UsernamePassword",
+	urlKey:       "url",
+	expandParameters: func(d *schema.ResourceData) (map[string]string, map[string]string) {
+		return map[string]string{
+			"username": d.Get("token").(string),
+		}, nil
+	},
+	flattenParameters: func(d *schema.ResourceData, parameters map[string]string, data map[string]string, secretSettings *secretmemo.Settings) {
+		tfhelper.HelpFlattenSecret(d, "token", secretSettings)
+		d.Set("token", parameters["username"])
+	},
+}
@@ -0,0 +1,104 @@
+package azuredevops
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/secretmemo"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/tfhelper"
+)
+
+func resourceServiceEndpointSSH() *schema.Resource {
+	r := genServiceEndpointResourceFromArgs(serviceEndpointSSHArgs)
+	r.CustomizeDiff = customizeDiffServiceEndpointSSH
+
+	r.Schema["host"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		Description:  "The host name or IP address of the remote machine.",
+		ValidateFunc: validation.NoZeroValues,
+	}
+	r.Schema["port"] = &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Default:      22,
+		Description:  "The port on which the SSH server listens.",
+		ValidateFunc: validation.IntBetween(1, 65535),
+	}
+	r.Schema["username"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		Description:  "The user name used to log in to the remote machine.",
+		ValidateFunc: validation.NoZeroValues,
+	}
+
+	passwordHashKey, passwordHashSchema := tfhelper.GenerateSecreteMemoSchema("password")
+	r.Schema["password"] = &schema.Schema{
+		Type:             schema.TypeString,
+		Optional:         true,
+		Description:      "The password used to log in to the remote machine.",
+		Sensitive:        true,
+		DiffSuppressFunc: tfhelper.DiffFuncSupressSecretChanged,
+		ConflictsWith:    []string{"private_key"},
+	}
+	r.Schema[passwordHashKey] = passwordHashSchema
+
+	privateKeyHashKey, privateKeyHashSchema := tfhelper.GenerateSecreteMemoSchema("private_key")
+	r.Schema["private_key"] = &schema.Schema{
+		Type:             schema.TypeString,
+		Optional:         true,
+		Description:      "The private key used to log in to the remote machine.",
+		Sensitive:        true,
+		DiffSuppressFunc: tfhelper.DiffFuncSupressSecretChanged,
+		ConflictsWith:    []string{"password"},
+	}
+	r.Schema[privateKeyHashKey] = privateKeyHashSchema
+
+	return r
+}
+
+// Verifies at plan time that either a password or a private key is configured
+func customizeDiffServiceEndpointSSH(d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("password") || !d.NewValueKnown("private_key") {
+		return nil
+	}
+	if d.Get("password").(string) == "" && d.Get("private_key").(string) == "" {
+		return fmt.Errorf("one of password or private_key must be set")
+	}
+	return nil
+}
+
+var serviceEndpointSSHArgs = &serviceEndpointCRUDArgs{
+	endpointType: "ssh",
+	authScheme:   "UsernamePassword",
+	expandURL: func(d *schema.ResourceData) string {
+		return "ssh://" + net.JoinHostPort(d.Get("host").(string), strconv.Itoa(d.Get("port").(int)))
+	},
+	expandParameters: func(d *schema.ResourceData) (map[string]string, map[string]string) {
+		parameters := map[string]string{
+			"username": d.Get("username").(string),
+			"password": d.Get("password").(string),
+		}
+		data := map[string]string{
+			"Host":       d.Get("host").(string),
+			"Port":       strconv.Itoa(d.Get("port").(int)),
+			"PrivateKey": d.Get("private_key").(string),
+		}
+		return parameters, data
+	},
+	flattenParameters: func(d *schema.ResourceData, parameters map[string]string, data map[string]string, secretSettings *secretmemo.Settings) {
+		d.Set("host", data["Host"])
+		if port, err := strconv.Atoi(data["Port"]); err == nil {
+			d.Set("port", port)
+		}
+		d.Set("username", parameters["username"])
+
+		tfhelper.HelpFlattenSecret(d, "password", secretSettings)
+		tfhelper.HelpFlattenSecret(d, "private_key", secretSettings)
+		d.Set("password", parameters["password"])
+		d.Set("private_key", data["PrivateKey"])
+	},
+}
@@ -0,0 +1,239 @@
+package azuredevops
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/serviceendpoint"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var sshTestServiceEndpointID = uuid.New()
+var sshRandomServiceEndpointProjectID = uuid.New().String()
+var sshTestServiceEndpointProjectID = &sshRandomServiceEndpointProjectID
+
+var sshTestServiceEndpoint = serviceendpoint.ServiceEndpoint{
+	Authorization: &serviceendpoint.EndpointAuthorization{
+		Parameters: &map[string]string{
+			"username": "SSH_TEST_USERNAME",
+			"password": "SSH_TEST_PASSWORD",
+		},
+		Scheme: converter.String("UsernamePassword"),
+	},
+	Data: &map[string]string{
+		"Host":       "fe80::1",
+		"Port":       "2222",
+		"PrivateKey": "",
+	},
+	Id:    &sshTestServiceEndpointID,
+	Name:  converter.String("UNIT_TEST_NAME"),
+	Owner: converter.String("library"),
+	Type:  converter.String("ssh"),
+	Url:   converter.String("ssh://[fe80::1]:2222"),
+}
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that the flatten/expand round trip yields the same service endpoint
+func TestAzureDevOpsServiceEndpointSSH_ExpandFlatten_Roundtrip(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, resourceServiceEndpointSSH().Schema, nil)
+	serviceEndpointSSHArgs.flatten(resourceData, &sshTestServiceEndpoint, sshTestServiceEndpointProjectID, nil)
+
+	serviceEndpointAfterRoundTrip, projectID := serviceEndpointSSHArgs.expand(resourceData)
+
+	require.Equal(t, sshTestServiceEndpoint, *serviceEndpointAfterRoundTrip)
+	require.Equal(t, sshTestServiceEndpointProjectID, projectID)
+}
+
+// verifies that exactly one of a password or a private key has to be configured
+func TestAzureDevOpsServiceEndpointSSH_RequiresPasswordOrPrivateKey(t *testing.T) {
+	configWithSecrets := func(secrets map[string]interface{}) *terraform.ResourceConfig {
+		config := map[string]interface{}{
+			"project_id":            "project",
+			"service_endpoint_name": "name",
+			"host":                  "example.com",
+			"username":              "user",
+		}
+		for key, value := range secrets {
+			config[key] = value
+		}
+		return terraform.NewResourceConfigRaw(config)
+	}
+	diffWithSecrets := func(secrets map[string]interface{}) error {
+		_, err := resourceServiceEndpointSSH().Diff(nil, configWithSecrets(secrets), nil)
+		return err
+	}
+
+	require.Nil(t, diffWithSecrets(map[string]interface{}{"password": "password"}))
+	require.Nil(t, diffWithSecrets(map[string]interface{}{"private_key": "key"}))
+	require.NotNil(t, diffWithSecrets(map[string]interface{}{}))
+
+	_, errs := resourceServiceEndpointSSH().Validate(configWithSecrets(map[string]interface{}{"password": "password", "private_key": "key"}))
+	require.NotEmpty(t, errs)
+}
+
+// verifies that if an error is produced on create, the error is not swallowed
+func TestAzureDevOpsServiceEndpointSSH_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := resourceServiceEndpointSSH()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	serviceEndpointSSHArgs.flatten(resourceData, &sshTestServiceEndpoint, sshTestServiceEndpointProjectID, nil)
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &aggregatedClient{ServiceEndpointClient: buildClient, ctx: context.Background()}
+
+	expectedArgs := serviceendpoint.CreateServiceEndpointArgs{Endpoint: &sshTestServiceEndpoint, Project: sshTestServiceEndpointProjectID}
+	buildClient.
+		EXPECT().
+		CreateServiceEndpoint(clients.ctx, expectedArgs).
+		Return(nil, errors.New("CreateServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Create(resourceData, clients)
+	require.Contains(t, err.Error(), "CreateServiceEndpoint() Failed")
+}
+
+// verifies that if an error is produced on a read, it is not swallowed
+func TestAzureDevOpsServiceEndpointSSH_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := resourceServiceEndpointSSH()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	serviceEndpointSSHArgs.flatten(resourceData, &sshTestServiceEndpoint, sshTestServiceEndpointProjectID, nil)
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &aggregatedClient{ServiceEndpointClient: buildClient, ctx: context.Background()}
+
+	expectedArgs := serviceendpoint.GetServiceEndpointDetailsArgs{EndpointId: sshTestServiceEndpoint.Id, Project: sshTestServiceEndpointProjectID}
+	buildClient.
+		EXPECT().
+		GetServiceEndpointDetails(clients.ctx, expectedArgs).
+		Return(nil, errors.New("GetServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Read(resourceData, clients)
+	require.Contains(t, err.Error(), "GetServiceEndpoint() Failed")
+}
+
+// verifies that if an error is produced on a delete, it is not swallowed
+func TestAzureDevOpsServiceEndpointSSH_Delete_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := resourceServiceEndpointSSH()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	serviceEndpointSSHArgs.flatten(resourceData, &sshTestServiceEndpoint, sshTestServiceEndpointProjectID, nil)
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &aggregatedClient{ServiceEndpointClient: buildClient, ctx: context.Background()}
+
+	expectedArgs := serviceendpoint.DeleteServiceEndpointArgs{EndpointId: sshTestServiceEndpoint.Id, Project: sshTestServiceEndpointProjectID}
+	buildClient.
+		EXPECT().
+		DeleteServiceEndpoint(clients.ctx, expectedArgs).
+		Return(errors.New("DeleteServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Delete(resourceData, clients)
+	require.Contains(t, err.Error(), "DeleteServiceEndpoint() Failed")
+}
+
+// verifies that if an error is produced on an update, it is not swallowed
+func TestAzureDevOpsServiceEndpointSSH_Update_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := resourceServiceEndpointSSH()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	serviceEndpointSSHArgs.flatten(resourceData, &sshTestServiceEndpoint, sshTestServiceEndpointProjectID, nil)
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &aggregatedClient{ServiceEndpointClient: buildClient, ctx: context.Background()}
+
+	expectedArgs := serviceendpoint.UpdateServiceEndpointArgs{
+		Endpoint:   &sshTestServiceEndpoint,
+		EndpointId: sshTestServiceEndpoint.Id,
+		Project:    sshTestServiceEndpointProjectID,
+	}
+
+	buildClient.
+		EXPECT().
+		UpdateServiceEndpoint(clients.ctx, expectedArgs).
+		Return(nil, errors.New("UpdateServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Update(resourceData, clients)
+	require.Contains(t, err.Error(), "UpdateServiceEndpoint() Failed")
+}
+
+/**
+ * Begin acceptance tests
+ */
+
+// validates that an apply followed by another apply (i.e., resource update) will be reflected in AzDO and the
+// underlying terraform state.
+func TestAccAzureDevOpsServiceEndpointSSH_CreateAndUpdate(t *testing.T) {
+	projectName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	serviceEndpointNameFirst := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	serviceEndpointNameSecond := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	tfSvcEpNode := "azuredevops_serviceendpoint_ssh.serviceendpoint"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccServiceEndpointCheckDestroyByType("azuredevops_serviceendpoint_ssh"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceEndpointSSHResource(projectName, serviceEndpointNameFirst),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "host", "ssh.example.com"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "port", "2222"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "username", "deploy"),
+					resource.TestCheckResourceAttrSet(tfSvcEpNode, "password_hash"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "service_endpoint_name", serviceEndpointNameFirst),
+					testAccCheckServiceEndpointResourceExistsByNode(tfSvcEpNode, serviceEndpointNameFirst),
+				),
+			}, {
+				Config: testAccServiceEndpointSSHResource(projectName, serviceEndpointNameSecond),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfSvcEpNode, "project_id"),
+					resource.TestCheckResourceAttrSet(tfSvcEpNode, "password_hash"),
+					resource.TestCheckResourceAttr(tfSvcEpNode, "service_endpoint_name", serviceEndpointNameSecond),
+					testAccCheckServiceEndpointResourceExistsByNode(tfSvcEpNode, serviceEndpointNameSecond),
+				),
+			},
+		},
+	})
+}
+
+// HCL describing an AzDO SSH service endpoint
+func testAccServiceEndpointSSHResource(projectName string, serviceEndpointName string) string {
+	serviceEndpointResource := fmt.Sprintf(`
+resource "azuredevops_serviceendpoint_ssh" "serviceendpoint" {
+	project_id            = azuredevops_project.project.id
+	service_endpoint_name = "%s"
+	host                  = "ssh.example.com"
+	port                  = 2222
+	username              = "deploy"
+	password              = "password"
+}`, serviceEndpointName)
+
+	projectResource := testAccProjectResource(projectName)
+	return fmt.Sprintf("%s\n%s", projectResource, serviceEndpointResource)
+}
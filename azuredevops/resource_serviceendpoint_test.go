@@ -45,9 +45,9 @@ var testServiceEndpoint = serviceendpoint.ServiceEndpoint{
 // verifies that the flatten/expand round trip yields the same service endpoint
 func TestAzureDevOpsServiceEndpoint_ExpandFlatten_Roundtrip(t *testing.T) {
 	resourceData := schema.TestResourceDataRaw(t, resourceServiceEndpoint().Schema, nil)
-	flattenServiceEndpoint(resourceData, &testServiceEndpoint, testServiceEndpointProjectID)
+	serviceEndpointArgs.flatten(resourceData, &testServiceEndpoint, testServiceEndpointProjectID, nil)
 
-	serviceEndpointAfterRoundTrip, projectID := expandServiceEndpoint(resourceData)
+	serviceEndpointAfterRoundTrip, projectID := serviceEndpointArgs.expand(resourceData)
 
 	require.Equal(t, testServiceEndpoint, *serviceEndpointAfterRoundTrip)
 	require.Equal(t, testServiceEndpointProjectID, projectID)
@@ -59,7 +59,7 @@ func TestAzureDevOpsServiceEndpoint_Create_DoesNotSwallowError(t *testing.T) {
 	defer ctrl.Finish()
 
 	resourceData := schema.TestResourceDataRaw(t, resourceServiceEndpoint().Schema, nil)
-	flattenServiceEndpoint(resourceData, &testServiceEndpoint, testServiceEndpointProjectID)
+	serviceEndpointArgs.flatten(resourceData, &testServiceEndpoint, testServiceEndpointProjectID, nil)
 
 	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
 	clients := &aggregatedClient{ServiceEndpointClient: buildClient, ctx: context.Background()}
@@ -71,7 +71,7 @@ func TestAzureDevOpsServiceEndpoint_Create_DoesNotSwallowError(t *testing.T) {
 		Return(nil, errors.New("CreateServiceEndpoint() Failed")).
 		Times(1)
 
-	err := resourceServiceEndpointCreate(resourceData, clients)
+	err := resourceServiceEndpoint().Create(resourceData, clients)
 	require.Contains(t, err.Error(), "CreateServiceEndpoint() Failed")
 }
 
@@ -81,7 +81,7 @@ func TestAzureDevOpsServiceEndpoint_Read_DoesNotSwallowError(t *testing.T) {
 	defer ctrl.Finish()
 
 	resourceData := schema.TestResourceDataRaw(t, resourceServiceEndpoint().Schema, nil)
-	flattenServiceEndpoint(resourceData, &testServiceEndpoint, testServiceEndpointProjectID)
+	serviceEndpointArgs.flatten(resourceData, &testServiceEndpoint, testServiceEndpointProjectID, nil)
 
 	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
 	clients := &aggregatedClient{ServiceEndpointClient: buildClient, ctx: context.Background()}
@@ -93,7 +93,7 @@ func TestAzureDevOpsServiceEndpoint_Read_DoesNotSwallowError(t *testing.T) {
 		Return(nil, errors.New("GetServiceEndpoint() Failed")).
 		Times(1)
 
-	err := resourceServiceEndpointRead(resourceData, clients)
+	err := resourceServiceEndpoint().Read(resourceData, clients)
 	require.Contains(t, err.Error(), "GetServiceEndpoint() Failed")
 }
 
@@ -103,7 +103,7 @@ func TestAzureDevOpsServiceEndpoint_Delete_DoesNotSwallowError(t *testing.T) {
 	defer ctrl.Finish()
 
 	resourceData := schema.TestResourceDataRaw(t, resourceServiceEndpoint().Schema, nil)
-	flattenServiceEndpoint(resourceData, &testServiceEndpoint, testServiceEndpointProjectID)
+	serviceEndpointArgs.flatten(resourceData, &testServiceEndpoint, testServiceEndpointProjectID, nil)
 
 	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
 	clients := &aggregatedClient{ServiceEndpointClient: buildClient, ctx: context.Background()}
@@ -115,7 +115,7 @@ func TestAzureDevOpsServiceEndpoint_Delete_DoesNotSwallowError(t *testing.T) {
 		Return(errors.New("DeleteServiceEndpoint() Failed")).
 		Times(1)
 
-	err := resourceServiceEndpointDelete(resourceData, clients)
+	err := resourceServiceEndpoint().Delete(resourceData, clients)
 	require.Contains(t, err.Error(), "DeleteServiceEndpoint() Failed")
 }
 
@@ -125,7 +125,7 @@ func TestAzureDevOpsServiceEndpoint_Update_DoesNotSwallowError(t *testing.T) {
 	defer ctrl.Finish()
 
 	resourceData := schema.TestResourceDataRaw(t, resourceServiceEndpoint().Schema, nil)
-	flattenServiceEndpoint(resourceData, &testServiceEndpoint, testServiceEndpointProjectID)
+	serviceEndpointArgs.flatten(resourceData, &testServiceEndpoint, testServiceEndpointProjectID, nil)
 
 	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
 	clients := &aggregatedClient{ServiceEndpointClient: buildClient, ctx: context.Background()}
@@ -142,7 +142,7 @@ func TestAzureDevOpsServiceEndpoint_Update_DoesNotSwallowError(t *testing.T) {
 		Return(nil, errors.New("UpdateServiceEndpoint() Failed")).
 		Times(1)
 
-	err := resourceServiceEndpointUpdate(resourceData, clients)
+	err := resourceServiceEndpoint().Update(resourceData, clients)
 	require.Contains(t, err.Error(), "UpdateServiceEndpoint() Failed")
 }
 
@@ -243,6 +243,48 @@ func testAccServiceEndpointCheckDestroy(s *terraform.State) error {
 	return nil
 }
 
+// Given the address of a service endpoint in the TF state and its expected name, this will return a function
+// that will check whether or not the resource (1) exists in the state and (2) exist in AzDO and (3) has the
+// correct name
+func testAccCheckServiceEndpointResourceExistsByNode(tfNode string, expectedName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		serviceEndpointDef, ok := s.RootModule().Resources[tfNode]
+		if !ok {
+			return fmt.Errorf("Did not find a service endpoint in the TF state")
+		}
+
+		serviceEndpoint, err := getServiceEndpointFromResource(serviceEndpointDef)
+		if err != nil {
+			return err
+		}
+
+		if *serviceEndpoint.Name != expectedName {
+			return fmt.Errorf("Service Endpoint has Name=%s, but expected Name=%s", *serviceEndpoint.Name, expectedName)
+		}
+
+		return nil
+	}
+}
+
+// verifies that all service endpoints of the given type referenced in the state are destroyed. This will be
+// invoked *after* terrafform destroys the resource but *before* the state is wiped clean.
+func testAccServiceEndpointCheckDestroyByType(resourceType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, resource := range s.RootModule().Resources {
+			if resource.Type != resourceType {
+				continue
+			}
+
+			// indicates the service endpoint still exists - this should fail the test
+			if _, err := getServiceEndpointFromResource(resource); err == nil {
+				return fmt.Errorf("Unexpectedly found a service endpoint that should be deleted")
+			}
+		}
+
+		return nil
+	}
+}
+
 // given a resource from the state, return a service endpoint (and error)
 func getServiceEndpointFromResource(resource *terraform.ResourceState) (*serviceendpoint.ServiceEndpoint, error) {
 	serviceEndpointDefID, err := uuid.Parse(resource.Primary.ID)
@@ -0,0 +1,432 @@
+package azuredevops
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/core"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/graph"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/identity"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/security"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/azdoerror"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+)
+
+// The security namespace that controls who may manage identities like teams
+var securityNamespaceIdentity, _ = uuid.Parse("5a27515b-ccd7-42c9-84f1-54c998f03866")
+
+// Team administrators are allowed to read, write, delete, manage the membership of and create scopes in a team
+const teamAdministratorPermissions = 31
+
+func resourceTeam() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTeamCreate,
+		Read:   resourceTeamRead,
+		Update: resourceTeamUpdate,
+		Delete: resourceTeamDelete,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "",
+			},
+			"adopt_default_team": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"administrators": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.NoZeroValues,
+				},
+				Set: schema.HashString,
+			},
+			"members": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.NoZeroValues,
+				},
+				Set: schema.HashString,
+			},
+			"descriptor": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceTeamCreate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	projectID := d.Get("project_id").(string)
+	team := expandTeam(d)
+
+	var err error
+	if d.Get("adopt_default_team").(bool) {
+		team, err = adoptDefaultTeam(clients, projectID, team)
+	} else {
+		team, err = clients.CoreClient.CreateTeam(clients.ctx, core.CreateTeamArgs{
+			Team:      team,
+			ProjectId: &projectID,
+		})
+	}
+	if err != nil {
+		return fmt.Errorf("Error creating team %s in project %s. Error: %v", d.Get("name"), projectID, err)
+	}
+
+	d.SetId(team.Id.String())
+	if err := updateTeamMemberships(clients, d, false); err != nil {
+		return err
+	}
+	return resourceTeamRead(d, m)
+}
+
+func resourceTeamRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	projectID := d.Get("project_id").(string)
+
+	team, err := clients.CoreClient.GetTeam(clients.ctx, core.GetTeamArgs{
+		ProjectId: &projectID,
+		TeamId:    converter.String(d.Id()),
+	})
+	if err != nil {
+		if azdoerror.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error looking up team with ID %s in project %s. Error: %v", d.Id(), projectID, err)
+	}
+
+	d.Set("name", converter.ToString(team.Name, ""))
+	d.Set("description", converter.ToString(team.Description, ""))
+
+	descriptor, err := getTeamDescriptor(clients, team.Id)
+	if err != nil {
+		return err
+	}
+	d.Set("descriptor", descriptor)
+
+	members, err := getGroupMembers(clients, descriptor)
+	if err != nil {
+		return fmt.Errorf("Error listing the members of team %s. Error: %v", d.Id(), err)
+	}
+	d.Set("members", members)
+
+	administrators, err := getTeamAdministrators(clients, projectID, d.Id())
+	if err != nil {
+		return err
+	}
+	d.Set("administrators", administrators)
+	return nil
+}
+
+func resourceTeamUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	projectID := d.Get("project_id").(string)
+
+	if d.HasChange("name") || d.HasChange("description") {
+		_, err := clients.CoreClient.UpdateTeam(clients.ctx, core.UpdateTeamArgs{
+			TeamData:  expandTeam(d),
+			ProjectId: &projectID,
+			TeamId:    converter.String(d.Id()),
+		})
+		if err != nil {
+			return fmt.Errorf("Error updating team with ID %s in project %s. Error: %v", d.Id(), projectID, err)
+		}
+	}
+
+	if err := updateTeamMemberships(clients, d, true); err != nil {
+		return err
+	}
+	return resourceTeamRead(d, m)
+}
+
+func resourceTeamDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	projectID := d.Get("project_id").(string)
+
+	// the default team of a project cannot be deleted
+	if !d.Get("adopt_default_team").(bool) {
+		err := clients.CoreClient.DeleteTeam(clients.ctx, core.DeleteTeamArgs{
+			ProjectId: &projectID,
+			TeamId:    converter.String(d.Id()),
+		})
+		if err != nil {
+			return fmt.Errorf("Error deleting team with ID %s in project %s. Error: %v", d.Id(), projectID, err)
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// Renames the default team of a project and updates its description
+func adoptDefaultTeam(clients *aggregatedClient, projectID string, team *core.WebApiTeam) (*core.WebApiTeam, error) {
+	project, err := clients.CoreClient.GetProject(clients.ctx, core.GetProjectArgs{
+		ProjectId:           &projectID,
+		IncludeCapabilities: converter.Bool(false),
+		IncludeHistory:      converter.Bool(false),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if project.DefaultTeam == nil || project.DefaultTeam.Id == nil {
+		return nil, fmt.Errorf("Project %s does not have a default team", projectID)
+	}
+
+	return clients.CoreClient.UpdateTeam(clients.ctx, core.UpdateTeamArgs{
+		TeamData:  team,
+		ProjectId: &projectID,
+		TeamId:    converter.String(project.DefaultTeam.Id.String()),
+	})
+}
+
+// Updates the configured members and administrators of a team. The lists are authoritative, so members and
+// administrators that are not listed are removed. Lists that are not configured are left untouched.
+func updateTeamMemberships(clients *aggregatedClient, d *schema.ResourceData, onlyChanges bool) error {
+	projectID := d.Get("project_id").(string)
+	teamID, err := uuid.Parse(d.Id())
+	if err != nil {
+		return fmt.Errorf("Error parsing the team ID %s: %v", d.Id(), err)
+	}
+
+	members, membersConfigured := d.GetOk("members")
+	if membersConfigured && (!onlyChanges || d.HasChange("members")) {
+		descriptor, err := getTeamDescriptor(clients, &teamID)
+		if err != nil {
+			return err
+		}
+		if err := setTeamMembers(clients, descriptor, members.(*schema.Set)); err != nil {
+			return err
+		}
+	}
+
+	administrators, administratorsConfigured := d.GetOk("administrators")
+	if administratorsConfigured && (!onlyChanges || d.HasChange("administrators")) {
+		if err := setTeamAdministrators(clients, projectID, d.Id(), administrators.(*schema.Set)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Teams are backed by a group, which is identified by the graph descriptor of the team
+func getTeamDescriptor(clients *aggregatedClient, teamID *uuid.UUID) (string, error) {
+	descriptor, err := clients.GraphClient.GetDescriptor(clients.ctx, graph.GetDescriptorArgs{StorageKey: teamID})
+	if err != nil {
+		return "", fmt.Errorf("Error finding descriptor for team with ID %s. Error: %v", teamID, err)
+	}
+	return converter.ToString(descriptor.Value, ""), nil
+}
+
+func setTeamMembers(clients *aggregatedClient, descriptor string, desired *schema.Set) error {
+	actual, err := getGroupMembers(clients, descriptor)
+	if err != nil {
+		return fmt.Errorf("Error listing the members of team %s. Error: %v", descriptor, err)
+	}
+
+	if err := addTeamMembers(clients, descriptor, desired.Difference(actual)); err != nil {
+		return err
+	}
+	return removeTeamMembers(clients, descriptor, actual.Difference(desired))
+}
+
+func addTeamMembers(clients *aggregatedClient, descriptor string, added *schema.Set) error {
+	for _, member := range added.List() {
+		_, err := clients.GraphClient.AddMembership(clients.ctx, graph.AddMembershipArgs{
+			SubjectDescriptor:   converter.String(member.(string)),
+			ContainerDescriptor: &descriptor,
+		})
+		if err != nil {
+			return fmt.Errorf("Error adding member %s to team %s. Error: %v", member, descriptor, err)
+		}
+	}
+	return nil
+}
+
+func removeTeamMembers(clients *aggregatedClient, descriptor string, removed *schema.Set) error {
+	for _, member := range removed.List() {
+		if err := removeGroupMember(clients, descriptor, member.(string)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// The administrators of a team are the identities that have been granted all administrator permissions
+// on the team in the identity security namespace
+func getTeamAdministrators(clients *aggregatedClient, projectID string, teamID string) (*schema.Set, error) {
+	acls, err := clients.SecurityClient.QueryAccessControlLists(clients.ctx, security.QueryAccessControlListsArgs{
+		SecurityNamespaceId: &securityNamespaceIdentity,
+		Token:               converter.String(teamSecurityToken(projectID, teamID)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error reading the administrators of team %s. Error: %v", teamID, err)
+	}
+
+	var identityDescriptors []string
+	if acls != nil {
+		for _, acl := range *acls {
+			if acl.AcesDictionary == nil {
+				continue
+			}
+			for _, ace := range *acl.AcesDictionary {
+				if ace.Allow != nil && *ace.Allow&teamAdministratorPermissions == teamAdministratorPermissions {
+					identityDescriptors = append(identityDescriptors, converter.ToString(ace.Descriptor, ""))
+				}
+			}
+		}
+	}
+
+	administrators := schema.NewSet(schema.HashString, nil)
+	if len(identityDescriptors) == 0 {
+		return administrators, nil
+	}
+
+	identities, err := readIdentities(clients, identity.ReadIdentitiesArgs{
+		Descriptors: converter.String(strings.Join(identityDescriptors, ",")),
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, identity := range identities {
+		if identity.SubjectDescriptor != nil {
+			administrators.Add(*identity.SubjectDescriptor)
+		}
+	}
+	return administrators, nil
+}
+
+func setTeamAdministrators(clients *aggregatedClient, projectID string, teamID string, desired *schema.Set) error {
+	actual, err := getTeamAdministrators(clients, projectID, teamID)
+	if err != nil {
+		return err
+	}
+
+	if err := addTeamAdministrators(clients, projectID, teamID, desired.Difference(actual)); err != nil {
+		return err
+	}
+	return removeTeamAdministrators(clients, projectID, teamID, actual.Difference(desired))
+}
+
+func addTeamAdministrators(clients *aggregatedClient, projectID string, teamID string, added *schema.Set) error {
+	if added.Len() == 0 {
+		return nil
+	}
+	identityDescriptors, err := getIdentityDescriptors(clients, added)
+	if err != nil {
+		return err
+	}
+
+	var entries []security.AccessControlEntry
+	for _, identityDescriptor := range identityDescriptors {
+		entries = append(entries, security.AccessControlEntry{
+			Descriptor: converter.String(identityDescriptor),
+			Allow:      converter.Int(teamAdministratorPermissions),
+			Deny:       converter.Int(0),
+		})
+	}
+	_, err = clients.SecurityClient.SetAccessControlEntries(clients.ctx, security.SetAccessControlEntriesArgs{
+		SecurityNamespaceId: &securityNamespaceIdentity,
+		Container: map[string]interface{}{
+			"token":                teamSecurityToken(projectID, teamID),
+			"merge":                true,
+			"accessControlEntries": entries,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("Error adding administrators to team %s. Error: %v", teamID, err)
+	}
+	return nil
+}
+
+func removeTeamAdministrators(clients *aggregatedClient, projectID string, teamID string, removed *schema.Set) error {
+	if removed.Len() == 0 {
+		return nil
+	}
+	identityDescriptors, err := getIdentityDescriptors(clients, removed)
+	if err != nil {
+		return err
+	}
+
+	_, err = clients.SecurityClient.RemoveAccessControlEntries(clients.ctx, security.RemoveAccessControlEntriesArgs{
+		SecurityNamespaceId: &securityNamespaceIdentity,
+		Token:               converter.String(teamSecurityToken(projectID, teamID)),
+		Descriptors:         converter.String(strings.Join(identityDescriptors, ",")),
+	})
+	if err != nil {
+		return fmt.Errorf("Error removing administrators from team %s. Error: %v", teamID, err)
+	}
+	return nil
+}
+
+// Resolves the identity descriptors, which are used by the security service, of a set of subject descriptors
+func getIdentityDescriptors(clients *aggregatedClient, subjectDescriptors *schema.Set) ([]string, error) {
+	var descriptors []string
+	for _, subjectDescriptor := range subjectDescriptors.List() {
+		descriptors = append(descriptors, subjectDescriptor.(string))
+	}
+
+	identities, err := readIdentities(clients, identity.ReadIdentitiesArgs{
+		SubjectDescriptors: converter.String(strings.Join(descriptors, ",")),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var identityDescriptors []string
+	for _, identity := range identities {
+		if identity.Descriptor != nil {
+			identityDescriptors = append(identityDescriptors, *identity.Descriptor)
+		}
+	}
+	if len(identityDescriptors) != len(descriptors) {
+		return nil, fmt.Errorf("Could not resolve the identities of all of the subjects %s", strings.Join(descriptors, ", "))
+	}
+	return identityDescriptors, nil
+}
+
+func readIdentities(clients *aggregatedClient, args identity.ReadIdentitiesArgs) ([]identity.Identity, error) {
+	identities, err := clients.IdentityClient.ReadIdentities(clients.ctx, args)
+	if err != nil {
+		return nil, fmt.Errorf("Error resolving identities. Error: %v", err)
+	}
+	if identities == nil {
+		return nil, nil
+	}
+	return *identities, nil
+}
+
+func teamSecurityToken(projectID string, teamID string) string {
+	return projectID + `\` + teamID
+}
+
+// Convert internal Terraform data structure to an AzDO data structure
+func expandTeam(d *schema.ResourceData) *core.WebApiTeam {
+	return &core.WebApiTeam{
+		Name:        converter.String(d.Get("name").(string)),
+		Description: converter.String(d.Get("description").(string)),
+	}
+}
@@ -0,0 +1,17 @@
+package azuredevops
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceTeamAdministrators() *schema.Resource {
+	return genTeamMembershipResource(teamAdministratorsTarget)
+}
+
+// Accesses the administrators of a team, which are granted through the identity security namespace
+var teamAdministratorsTarget = &teamMembershipTarget{
+	key:    "administrators",
+	list:   getTeamAdministrators,
+	add:    addTeamAdministrators,
+	remove: removeTeamAdministrators,
+}
@@ -0,0 +1,203 @@
+package azuredevops
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/identity"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/security"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that only the missing administrators are added in add mode and that other administrators are ignored
+func TestAzureDevOpsTeamAdministrators_Create_AddModeOnlyAddsMissingAdministrators(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mocks, clients := createTeamMocks(ctrl)
+	resourceData := createTeamMembershipResourceData(t, resourceTeamAdministrators(), "administrators", membershipModeAdd, "aad.admin")
+
+	first := expectTeamAccessControlLists(mocks, "identity.other")
+	expectReadTeamAdministrators(mocks, "other").After(first)
+	mocks.identity.
+		EXPECT().
+		ReadIdentities(clients.ctx, identity.ReadIdentitiesArgs{SubjectDescriptors: converter.String("aad.admin")}).
+		Return(&[]identity.Identity{{Descriptor: converter.String("identity.admin")}}, nil).
+		Times(1)
+	mocks.security.
+		EXPECT().
+		SetAccessControlEntries(clients.ctx, security.SetAccessControlEntriesArgs{
+			SecurityNamespaceId: &securityNamespaceIdentity,
+			Container: map[string]interface{}{
+				"token": testTeamToken,
+				"merge": true,
+				"accessControlEntries": []security.AccessControlEntry{{
+					Descriptor: converter.String("identity.admin"),
+					Allow:      converter.Int(teamAdministratorPermissions),
+					Deny:       converter.Int(0),
+				}},
+			},
+		}).
+		Return(nil, nil).
+		Times(1)
+	mocks.security.
+		EXPECT().
+		RemoveAccessControlEntries(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	expectGetTestTeam(mocks)
+	expectTeamAccessControlLists(mocks, "identity.admin", "identity.other")
+	expectReadTeamAdministrators(mocks, "admin", "other")
+
+	err := resourceTeamAdministrators().Create(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, testTeamID.String(), resourceData.Id())
+	require.ElementsMatch(t, []interface{}{"aad.admin"}, resourceData.Get("administrators").(*schema.Set).List())
+}
+
+// verifies that only the listed administrators that are still administrators of the team are removed on delete
+func TestAzureDevOpsTeamAdministrators_Delete_RemovesListedAdministrators(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mocks, clients := createTeamMocks(ctrl)
+	resourceData := createTeamMembershipResourceData(t, resourceTeamAdministrators(), "administrators", membershipModeOverwrite, "aad.admin", "aad.gone")
+	resourceData.SetId(testTeamID.String())
+
+	expectTeamAccessControlLists(mocks, "identity.admin", "identity.other")
+	expectReadTeamAdministrators(mocks, "admin", "other")
+	mocks.identity.
+		EXPECT().
+		ReadIdentities(clients.ctx, identity.ReadIdentitiesArgs{SubjectDescriptors: converter.String("aad.admin")}).
+		Return(&[]identity.Identity{{Descriptor: converter.String("identity.admin")}}, nil).
+		Times(1)
+	mocks.security.
+		EXPECT().
+		RemoveAccessControlEntries(clients.ctx, security.RemoveAccessControlEntriesArgs{
+			SecurityNamespaceId: &securityNamespaceIdentity,
+			Token:               &testTeamToken,
+			Descriptors:         converter.String("identity.admin"),
+		}).
+		Return(converter.Bool(true), nil).
+		Times(1)
+
+	err := resourceTeamAdministrators().Delete(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "", resourceData.Id())
+}
+
+// expects the lookup of the subject descriptors of the administrators, given the names of their identities,
+// e.g. admin for the identity descriptor identity.admin and the subject descriptor aad.admin
+func expectReadTeamAdministrators(mocks *teamMocks, names ...string) *gomock.Call {
+	var identities []identity.Identity
+	descriptors := map[string]bool{}
+	for _, name := range names {
+		identities = append(identities, identity.Identity{
+			Descriptor:        converter.String("identity." + name),
+			SubjectDescriptor: converter.String("aad." + name),
+		})
+		descriptors["identity."+name] = true
+	}
+
+	return mocks.identity.
+		EXPECT().
+		ReadIdentities(gomock.Any(), identityDescriptorsMatcher(descriptors)).
+		Return(&identities, nil).
+		Times(1)
+}
+
+// Matches the lookup of a set of identity descriptors, which are listed in no particular order
+type identityDescriptorsMatcher map[string]bool
+
+func (m identityDescriptorsMatcher) Matches(x interface{}) bool {
+	args, ok := x.(identity.ReadIdentitiesArgs)
+	if !ok || args.Descriptors == nil {
+		return false
+	}
+	descriptors := strings.Split(*args.Descriptors, ",")
+	if len(descriptors) != len(m) {
+		return false
+	}
+	for _, descriptor := range descriptors {
+		if !m[descriptor] {
+			return false
+		}
+	}
+	return true
+}
+
+func (m identityDescriptorsMatcher) String() string {
+	return fmt.Sprintf("looks up the identity descriptors %v", map[string]bool(m))
+}
+
+/**
+ * Begin acceptance tests
+ */
+
+// Verifies that a group can be made an administrator of a team that is managed separately
+func TestAccAzureDevOpsTeamAdministrators_CreateAndUpdate(t *testing.T) {
+	projectName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	teamName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	groupName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	tfAdministratorsNode := "azuredevops_team_administrators.administrators"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTeamAdministratorsResource(projectName, teamName, groupName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfAdministratorsNode, "administrators.#", "1"),
+					testAccCheckTeamAdministratorExists(true),
+				),
+			}, {
+				// removing the administrators resource must revoke the administration of the team
+				Config: testAccTeamWithGroupResource(projectName, teamName, groupName),
+				Check:  testAccCheckTeamAdministratorExists(false),
+			},
+		},
+	})
+}
+
+// HCL describing a group that administers a team
+func testAccTeamAdministratorsResource(projectName string, teamName string, groupName string) string {
+	administratorsResource := `
+resource "azuredevops_team_administrators" "administrators" {
+	project_id     = azuredevops_team.team.project_id
+	team_id        = azuredevops_team.team.id
+	administrators = [azuredevops_group.group.descriptor]
+}`
+
+	return fmt.Sprintf("%s\n%s", testAccTeamWithGroupResource(projectName, teamName, groupName), administratorsResource)
+}
+
+// verifies whether the group created by the test is an administrator of the team
+func testAccCheckTeamAdministratorExists(expectAdministrator bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		team := s.RootModule().Resources["azuredevops_team.team"].Primary
+		group := s.RootModule().Resources["azuredevops_group.group"].Primary.ID
+
+		clients := testAccProvider.Meta().(*aggregatedClient)
+		administrators, err := getTeamAdministrators(clients, team.Attributes["project_id"], team.ID)
+		if err != nil {
+			return err
+		}
+
+		if administrators.Contains(group) != expectAdministrator {
+			return fmt.Errorf("Expected group %s to be an administrator of team %s: %t", group, team.ID, expectAdministrator)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,41 @@
+package azuredevops
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceTeamMembers() *schema.Resource {
+	return genTeamMembershipResource(teamMembersTarget)
+}
+
+// Accesses the members of a team, which are the members of the group that backs the team
+var teamMembersTarget = &teamMembershipTarget{
+	key: "members",
+	list: func(clients *aggregatedClient, projectID string, teamID string) (*schema.Set, error) {
+		descriptor, err := getTeamDescriptorByID(clients, teamID)
+		if err != nil {
+			return nil, err
+		}
+		return getGroupMembers(clients, descriptor)
+	},
+	add: func(clients *aggregatedClient, projectID string, teamID string, identities *schema.Set) error {
+		if identities.Len() == 0 {
+			return nil
+		}
+		descriptor, err := getTeamDescriptorByID(clients, teamID)
+		if err != nil {
+			return err
+		}
+		return addTeamMembers(clients, descriptor, identities)
+	},
+	remove: func(clients *aggregatedClient, projectID string, teamID string, identities *schema.Set) error {
+		if identities.Len() == 0 {
+			return nil
+		}
+		descriptor, err := getTeamDescriptorByID(clients, teamID)
+		if err != nil {
+			return err
+		}
+		return removeTeamMembers(clients, descriptor, identities)
+	},
+}
@@ -0,0 +1,215 @@
+package azuredevops
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/microsoft/azure-devops-go-api/azuredevops"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/core"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that only the missing members are added in add mode and that other members are ignored
+func TestAzureDevOpsTeamMembers_Create_AddModeOnlyAddsMissingMembers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mocks, clients := createTeamMocks(ctrl)
+	resourceData := createTeamMembershipResourceData(t, resourceTeamMembers(), "members", membershipModeAdd, "aad.a", "aad.b")
+
+	expectTeamDescriptor(mocks).AnyTimes()
+	first := expectListMemberships(mocks.graph, "aad.a", "aad.c")
+	expectAddMembership(mocks.graph, "aad.b").After(first)
+	expectGetTestTeam(mocks)
+	expectListMemberships(mocks.graph, "aad.a", "aad.b", "aad.c")
+
+	err := resourceTeamMembers().Create(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, testTeamID.String(), resourceData.Id())
+	require.ElementsMatch(t, []interface{}{"aad.a", "aad.b"}, resourceData.Get("members").(*schema.Set).List())
+}
+
+// verifies that members that are not listed are removed in overwrite mode
+func TestAzureDevOpsTeamMembers_Create_OverwriteModeRemovesOtherMembers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mocks, clients := createTeamMocks(ctrl)
+	resourceData := createTeamMembershipResourceData(t, resourceTeamMembers(), "members", membershipModeOverwrite, "aad.a")
+
+	expectTeamDescriptor(mocks).AnyTimes()
+	first := expectListMemberships(mocks.graph, "aad.a", "aad.c")
+	expectRemoveMembership(mocks.graph, "aad.c").After(first)
+	expectGetTestTeam(mocks)
+	expectListMemberships(mocks.graph, "aad.a")
+
+	err := resourceTeamMembers().Create(resourceData, clients)
+	require.Nil(t, err)
+	require.ElementsMatch(t, []interface{}{"aad.a"}, resourceData.Get("members").(*schema.Set).List())
+}
+
+// verifies that the members of a team that no longer exists are removed from the state
+func TestAzureDevOpsTeamMembers_Read_ClearsIDOfMissingTeam(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mocks, clients := createTeamMocks(ctrl)
+	resourceData := createTeamMembershipResourceData(t, resourceTeamMembers(), "members", membershipModeAdd, "aad.a")
+	resourceData.SetId(testTeamID.String())
+
+	statusCode := http.StatusNotFound
+	mocks.core.
+		EXPECT().
+		GetTeam(clients.ctx, gomock.Any()).
+		Return(nil, azuredevops.WrappedError{StatusCode: &statusCode}).
+		Times(1)
+
+	err := resourceTeamMembers().Read(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "", resourceData.Id())
+}
+
+// verifies that only the listed members that are still members of the team are removed on delete
+func TestAzureDevOpsTeamMembers_Delete_RemovesListedMembers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mocks, clients := createTeamMocks(ctrl)
+	resourceData := createTeamMembershipResourceData(t, resourceTeamMembers(), "members", membershipModeOverwrite, "aad.a", "aad.b")
+	resourceData.SetId(testTeamID.String())
+
+	expectTeamDescriptor(mocks).AnyTimes()
+	expectListMemberships(mocks.graph, "aad.a", "aad.c")
+	expectRemoveMembership(mocks.graph, "aad.a")
+
+	err := resourceTeamMembers().Delete(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "", resourceData.Id())
+}
+
+// verifies that if an error is produced while adding a member, the error is not swallowed
+func TestAzureDevOpsTeamMembers_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mocks, clients := createTeamMocks(ctrl)
+	resourceData := createTeamMembershipResourceData(t, resourceTeamMembers(), "members", membershipModeAdd, "aad.a")
+
+	expectTeamDescriptor(mocks).AnyTimes()
+	expectListMemberships(mocks.graph)
+	mocks.graph.
+		EXPECT().
+		AddMembership(clients.ctx, gomock.Any()).
+		Return(nil, errors.New("AddMembership() Failed")).
+		Times(1)
+
+	err := resourceTeamMembers().Create(resourceData, clients)
+	require.Contains(t, err.Error(), "AddMembership() Failed")
+}
+
+func createTeamMembershipResourceData(t *testing.T, r *schema.Resource, key string, mode string, identities ...interface{}) *schema.ResourceData {
+	return schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"project_id": testTeamProjectID,
+		"team_id":    testTeamID.String(),
+		key:          identities,
+		"mode":       mode,
+	})
+}
+
+func expectGetTestTeam(mocks *teamMocks) *gomock.Call {
+	return mocks.core.
+		EXPECT().
+		GetTeam(gomock.Any(), core.GetTeamArgs{ProjectId: &testTeamProjectID, TeamId: converter.String(testTeamID.String())}).
+		Return(&testTeam, nil).
+		Times(1)
+}
+
+/**
+ * Begin acceptance tests
+ */
+
+// Verifies that a group can be added to and removed from the members of a team that is managed separately
+func TestAccAzureDevOpsTeamMembers_CreateAndUpdate(t *testing.T) {
+	projectName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	teamName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	groupName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	tfMembersNode := "azuredevops_team_members.members"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTeamMembersResource(projectName, teamName, groupName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfMembersNode, "members.#", "1"),
+					testAccCheckTeamMemberExists(true),
+				),
+			}, {
+				// removing the members resource must remove the member from the team
+				Config: testAccTeamWithGroupResource(projectName, teamName, groupName),
+				Check:  testAccCheckTeamMemberExists(false),
+			},
+		},
+	})
+}
+
+// HCL describing a team and a group that is managed outside of the team
+func testAccTeamWithGroupResource(projectName string, teamName string, groupName string) string {
+	teamResource := fmt.Sprintf(`
+resource "azuredevops_team" "team" {
+	project_id = azuredevops_project.project.id
+	name       = "%s"
+}
+
+resource "azuredevops_group" "group" {
+	scope        = azuredevops_project.project.id
+	display_name = "%s"
+}`, teamName, groupName)
+
+	projectResource := testAccProjectResource(projectName)
+	return fmt.Sprintf("%s\n%s", projectResource, teamResource)
+}
+
+// HCL describing the membership of a group in a team
+func testAccTeamMembersResource(projectName string, teamName string, groupName string) string {
+	membersResource := `
+resource "azuredevops_team_members" "members" {
+	project_id = azuredevops_team.team.project_id
+	team_id    = azuredevops_team.team.id
+	members    = [azuredevops_group.group.descriptor]
+}`
+
+	return fmt.Sprintf("%s\n%s", testAccTeamWithGroupResource(projectName, teamName, groupName), membersResource)
+}
+
+// verifies whether the group created by the test is a member of the team
+func testAccCheckTeamMemberExists(expectMembership bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		team := s.RootModule().Resources["azuredevops_team.team"].Primary
+		group := s.RootModule().Resources["azuredevops_group.group"].Primary.ID
+
+		clients := testAccProvider.Meta().(*aggregatedClient)
+		members, err := teamMembersTarget.list(clients, team.Attributes["project_id"], team.ID)
+		if err != nil {
+			return err
+		}
+
+		if members.Contains(group) != expectMembership {
+			return fmt.Errorf("Expected membership of group %s in team %s to be %t", group, team.ID, expectMembership)
+		}
+		return nil
+	}
+}
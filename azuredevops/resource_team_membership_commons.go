@@ -0,0 +1,172 @@
+package azuredevops
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/core"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/azdoerror"
+)
+
+// teamMembershipTarget describes a list of identities of a team that is managed by a standalone resource,
+// e.g. the members of the team. The identities are subject descriptors.
+type teamMembershipTarget struct {
+	// the attribute that holds the identities, which is also used to describe them in errors
+	key    string
+	list   func(clients *aggregatedClient, projectID string, teamID string) (*schema.Set, error)
+	add    func(clients *aggregatedClient, projectID string, teamID string, identities *schema.Set) error
+	remove func(clients *aggregatedClient, projectID string, teamID string, identities *schema.Set) error
+}
+
+// genTeamMembershipResource creates a resource that manages the identities of an existing team in the same
+// way azuredevops_group_membership manages the members of a group
+func genTeamMembershipResource(target *teamMembershipTarget) *schema.Resource {
+	return &schema.Resource{
+		Create: genTeamMembershipCreateFunc(target),
+		Read:   genTeamMembershipReadFunc(target),
+		Update: genTeamMembershipUpdateFunc(target),
+		Delete: genTeamMembershipDeleteFunc(target),
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"team_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			target.key: {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.NoZeroValues,
+				},
+				Set: schema.HashString,
+			},
+			"mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      membershipModeAdd,
+				ValidateFunc: validation.StringInSlice([]string{membershipModeAdd, membershipModeOverwrite}, false),
+			},
+		},
+	}
+}
+
+func genTeamMembershipCreateFunc(target *teamMembershipTarget) schema.CreateFunc {
+	return func(d *schema.ResourceData, m interface{}) error {
+		clients := m.(*aggregatedClient)
+		if err := reconcileTeamMembership(clients, d, target, nil); err != nil {
+			return err
+		}
+
+		d.SetId(d.Get("team_id").(string))
+		return genTeamMembershipReadFunc(target)(d, m)
+	}
+}
+
+func genTeamMembershipReadFunc(target *teamMembershipTarget) schema.ReadFunc {
+	return func(d *schema.ResourceData, m interface{}) error {
+		clients := m.(*aggregatedClient)
+		projectID := d.Get("project_id").(string)
+		teamID := d.Get("team_id").(string)
+
+		_, err := clients.CoreClient.GetTeam(clients.ctx, core.GetTeamArgs{
+			ProjectId: &projectID,
+			TeamId:    &teamID,
+		})
+		if err != nil {
+			if azdoerror.IsNotFound(err) {
+				d.SetId("")
+				return nil
+			}
+			return fmt.Errorf("Error looking up team with ID %s in project %s. Error: %v", teamID, projectID, err)
+		}
+
+		actual, err := target.list(clients, projectID, teamID)
+		if err != nil {
+			return fmt.Errorf("Error listing the %s of team %s. Error: %v", target.key, teamID, err)
+		}
+
+		d.Set(target.key, managedMemberships(actual, d.Get(target.key).(*schema.Set), d.Get("mode").(string)))
+		return nil
+	}
+}
+
+func genTeamMembershipUpdateFunc(target *teamMembershipTarget) schema.UpdateFunc {
+	return func(d *schema.ResourceData, m interface{}) error {
+		clients := m.(*aggregatedClient)
+
+		// identities that are no longer listed are removed in both modes
+		var removed *schema.Set
+		if d.HasChange(target.key) {
+			oldIdentities, newIdentities := d.GetChange(target.key)
+			removed = oldIdentities.(*schema.Set).Difference(newIdentities.(*schema.Set))
+		}
+
+		if err := reconcileTeamMembership(clients, d, target, removed); err != nil {
+			return err
+		}
+		return genTeamMembershipReadFunc(target)(d, m)
+	}
+}
+
+// Only the identities listed by the resource are removed, even in overwrite mode
+func genTeamMembershipDeleteFunc(target *teamMembershipTarget) schema.DeleteFunc {
+	return func(d *schema.ResourceData, m interface{}) error {
+		clients := m.(*aggregatedClient)
+		projectID := d.Get("project_id").(string)
+		teamID := d.Get("team_id").(string)
+
+		actual, err := target.list(clients, projectID, teamID)
+		if err != nil {
+			if azdoerror.IsNotFound(err) {
+				d.SetId("")
+				return nil
+			}
+			return fmt.Errorf("Error listing the %s of team %s. Error: %v", target.key, teamID, err)
+		}
+
+		listed := d.Get(target.key).(*schema.Set)
+		if err := target.remove(clients, projectID, teamID, listed.Intersection(actual)); err != nil {
+			return err
+		}
+
+		d.SetId("")
+		return nil
+	}
+}
+
+// Adds the listed identities that are missing from the team and removes the identities that are no longer
+// wanted according to the mode of the resource
+func reconcileTeamMembership(clients *aggregatedClient, d *schema.ResourceData, target *teamMembershipTarget, removed *schema.Set) error {
+	projectID := d.Get("project_id").(string)
+	teamID := d.Get("team_id").(string)
+
+	actual, err := target.list(clients, projectID, teamID)
+	if err != nil {
+		return fmt.Errorf("Error listing the %s of team %s. Error: %v", target.key, teamID, err)
+	}
+
+	toAdd, toRemove := diffMemberships(d.Get(target.key).(*schema.Set), actual, removed, d.Get("mode").(string))
+	if err := target.add(clients, projectID, teamID, toAdd); err != nil {
+		return err
+	}
+	return target.remove(clients, projectID, teamID, toRemove)
+}
+
+// Looks up the descriptor of the group that backs a team given the ID of the team
+func getTeamDescriptorByID(clients *aggregatedClient, teamID string) (string, error) {
+	id, err := uuid.Parse(teamID)
+	if err != nil {
+		return "", fmt.Errorf("Error parsing the team ID %s: %v", teamID, err)
+	}
+	return getTeamDescriptor(clients, &id)
+}
@@ -0,0 +1,383 @@
+package azuredevops
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/microsoft/azure-devops-go-api/azuredevops"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/core"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/graph"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/identity"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/security"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var testTeamProjectID = uuid.New().String()
+var testTeamID = uuid.New()
+var testTeamToken = testTeamProjectID + `\` + testTeamID.String()
+
+var testTeam = core.WebApiTeam{
+	Id:          &testTeamID,
+	Name:        converter.String("Team"),
+	Description: converter.String("Description"),
+}
+
+type teamMocks struct {
+	core     *azdosdkmocks.MockCoreClient
+	graph    *azdosdkmocks.MockGraphClient
+	security *azdosdkmocks.MockSecurityClient
+	identity *azdosdkmocks.MockIdentityClient
+}
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that a team is created and that its configured members and administrators are added
+func TestAzureDevOpsTeam_Create_AddsMembersAndAdministrators(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mocks, clients := createTeamMocks(ctrl)
+	resourceData := createTeamResourceData(t, false, []interface{}{"aad.member"}, []interface{}{"aad.admin"})
+
+	mocks.core.
+		EXPECT().
+		CreateTeam(clients.ctx, core.CreateTeamArgs{
+			Team:      &core.WebApiTeam{Name: testTeam.Name, Description: testTeam.Description},
+			ProjectId: &testTeamProjectID,
+		}).
+		Return(&testTeam, nil).
+		Times(1)
+	expectTeamDescriptor(mocks).AnyTimes()
+
+	// members
+	expectListMemberships(mocks.graph, "aad.member").After(
+		expectAddMembership(mocks.graph, "aad.member").After(
+			expectListMemberships(mocks.graph)))
+
+	// administrators
+	first := expectTeamAccessControlLists(mocks)
+	mocks.identity.
+		EXPECT().
+		ReadIdentities(clients.ctx, identity.ReadIdentitiesArgs{SubjectDescriptors: converter.String("aad.admin")}).
+		Return(&[]identity.Identity{{Descriptor: converter.String("identity.admin")}}, nil).
+		Times(1)
+	mocks.security.
+		EXPECT().
+		SetAccessControlEntries(clients.ctx, security.SetAccessControlEntriesArgs{
+			SecurityNamespaceId: &securityNamespaceIdentity,
+			Container: map[string]interface{}{
+				"token": testTeamToken,
+				"merge": true,
+				"accessControlEntries": []security.AccessControlEntry{{
+					Descriptor: converter.String("identity.admin"),
+					Allow:      converter.Int(teamAdministratorPermissions),
+					Deny:       converter.Int(0),
+				}},
+			},
+		}).
+		Return(nil, nil).
+		After(first).
+		Times(1)
+
+	mocks.core.
+		EXPECT().
+		GetTeam(clients.ctx, core.GetTeamArgs{ProjectId: &testTeamProjectID, TeamId: converter.String(testTeamID.String())}).
+		Return(&testTeam, nil).
+		Times(1)
+	expectTeamAccessControlLists(mocks, "identity.admin")
+	mocks.identity.
+		EXPECT().
+		ReadIdentities(clients.ctx, identity.ReadIdentitiesArgs{Descriptors: converter.String("identity.admin")}).
+		Return(&[]identity.Identity{{SubjectDescriptor: converter.String("aad.admin")}}, nil).
+		Times(1)
+
+	err := resourceTeamCreate(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, testTeamID.String(), resourceData.Id())
+	require.Equal(t, testMembershipGroup, resourceData.Get("descriptor"))
+	require.ElementsMatch(t, []interface{}{"aad.member"}, resourceData.Get("members").(*schema.Set).List())
+	require.ElementsMatch(t, []interface{}{"aad.admin"}, resourceData.Get("administrators").(*schema.Set).List())
+}
+
+// verifies that the default team of the project is renamed instead of creating a new team
+func TestAzureDevOpsTeam_Create_AdoptsDefaultTeam(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mocks, clients := createTeamMocks(ctrl)
+	resourceData := createTeamResourceData(t, true, nil, nil)
+
+	mocks.core.
+		EXPECT().
+		GetProject(clients.ctx, gomock.Any()).
+		Return(&core.TeamProject{DefaultTeam: &core.WebApiTeamRef{Id: &testTeamID}}, nil).
+		Times(1)
+	mocks.core.
+		EXPECT().
+		UpdateTeam(clients.ctx, core.UpdateTeamArgs{
+			TeamData:  &core.WebApiTeam{Name: testTeam.Name, Description: testTeam.Description},
+			ProjectId: &testTeamProjectID,
+			TeamId:    converter.String(testTeamID.String()),
+		}).
+		Return(&testTeam, nil).
+		Times(1)
+	mocks.core.
+		EXPECT().
+		CreateTeam(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	mocks.core.EXPECT().GetTeam(clients.ctx, gomock.Any()).Return(&testTeam, nil).Times(1)
+	expectTeamDescriptor(mocks).Times(1)
+	expectListMemberships(mocks.graph, "aad.member")
+	expectTeamAccessControlLists(mocks)
+
+	err := resourceTeamCreate(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, testTeamID.String(), resourceData.Id())
+	require.ElementsMatch(t, []interface{}{"aad.member"}, resourceData.Get("members").(*schema.Set).List())
+}
+
+// verifies that administrators which are no longer listed are removed
+func TestAzureDevOpsTeam_SetAdministrators_RemovesUnlistedAdministrators(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mocks, clients := createTeamMocks(ctrl)
+
+	expectTeamAccessControlLists(mocks, "identity.admin")
+	mocks.identity.
+		EXPECT().
+		ReadIdentities(clients.ctx, identity.ReadIdentitiesArgs{Descriptors: converter.String("identity.admin")}).
+		Return(&[]identity.Identity{{
+			Descriptor:        converter.String("identity.admin"),
+			SubjectDescriptor: converter.String("aad.admin"),
+		}}, nil).
+		Times(1)
+	mocks.identity.
+		EXPECT().
+		ReadIdentities(clients.ctx, identity.ReadIdentitiesArgs{SubjectDescriptors: converter.String("aad.admin")}).
+		Return(&[]identity.Identity{{Descriptor: converter.String("identity.admin")}}, nil).
+		Times(1)
+	mocks.security.
+		EXPECT().
+		RemoveAccessControlEntries(clients.ctx, security.RemoveAccessControlEntriesArgs{
+			SecurityNamespaceId: &securityNamespaceIdentity,
+			Token:               &testTeamToken,
+			Descriptors:         converter.String("identity.admin"),
+		}).
+		Return(converter.Bool(true), nil).
+		Times(1)
+
+	err := setTeamAdministrators(clients, testTeamProjectID, testTeamID.String(), schema.NewSet(schema.HashString, nil))
+	require.Nil(t, err)
+}
+
+// verifies that a team that no longer exists is removed from the state
+func TestAzureDevOpsTeam_Read_ClearsIDOfMissingTeam(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mocks, clients := createTeamMocks(ctrl)
+	resourceData := createTeamResourceData(t, false, nil, nil)
+	resourceData.SetId(testTeamID.String())
+
+	statusCode := http.StatusNotFound
+	mocks.core.
+		EXPECT().
+		GetTeam(clients.ctx, gomock.Any()).
+		Return(nil, azuredevops.WrappedError{StatusCode: &statusCode}).
+		Times(1)
+
+	err := resourceTeamRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "", resourceData.Id())
+}
+
+// verifies that the default team of a project is not deleted
+func TestAzureDevOpsTeam_Delete_DoesNotDeleteDefaultTeam(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mocks, clients := createTeamMocks(ctrl)
+	resourceData := createTeamResourceData(t, true, nil, nil)
+	resourceData.SetId(testTeamID.String())
+
+	mocks.core.
+		EXPECT().
+		DeleteTeam(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	err := resourceTeamDelete(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "", resourceData.Id())
+}
+
+// verifies that if an error is produced on create, the error is not swallowed
+func TestAzureDevOpsTeam_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mocks, clients := createTeamMocks(ctrl)
+	resourceData := createTeamResourceData(t, false, nil, nil)
+
+	mocks.core.
+		EXPECT().
+		CreateTeam(clients.ctx, gomock.Any()).
+		Return(nil, errors.New("CreateTeam() Failed")).
+		Times(1)
+
+	err := resourceTeamCreate(resourceData, clients)
+	require.Contains(t, err.Error(), "CreateTeam() Failed")
+}
+
+func createTeamMocks(ctrl *gomock.Controller) (*teamMocks, *aggregatedClient) {
+	mocks := &teamMocks{
+		core:     azdosdkmocks.NewMockCoreClient(ctrl),
+		graph:    azdosdkmocks.NewMockGraphClient(ctrl),
+		security: azdosdkmocks.NewMockSecurityClient(ctrl),
+		identity: azdosdkmocks.NewMockIdentityClient(ctrl),
+	}
+	clients := &aggregatedClient{
+		CoreClient:     mocks.core,
+		GraphClient:    mocks.graph,
+		SecurityClient: mocks.security,
+		IdentityClient: mocks.identity,
+		ctx:            context.Background(),
+	}
+	return mocks, clients
+}
+
+func createTeamResourceData(t *testing.T, adopt bool, members []interface{}, administrators []interface{}) *schema.ResourceData {
+	raw := map[string]interface{}{
+		"project_id":         testTeamProjectID,
+		"name":               *testTeam.Name,
+		"description":        *testTeam.Description,
+		"adopt_default_team": adopt,
+	}
+	if members != nil {
+		raw["members"] = members
+	}
+	if administrators != nil {
+		raw["administrators"] = administrators
+	}
+	return schema.TestResourceDataRaw(t, resourceTeam().Schema, raw)
+}
+
+// the descriptor of the test team is the descriptor of the group used by the membership tests
+func expectTeamDescriptor(mocks *teamMocks) *gomock.Call {
+	return mocks.graph.
+		EXPECT().
+		GetDescriptor(gomock.Any(), graph.GetDescriptorArgs{StorageKey: &testTeamID}).
+		Return(&graph.GraphDescriptorResult{Value: &testMembershipGroup}, nil)
+}
+
+func expectTeamAccessControlLists(mocks *teamMocks, administrators ...string) *gomock.Call {
+	aces := map[string]security.AccessControlEntry{
+		// entries without all administrator permissions are ignored
+		"identity.reader": {Descriptor: converter.String("identity.reader"), Allow: converter.Int(1)},
+	}
+	for _, administrator := range administrators {
+		aces[administrator] = security.AccessControlEntry{
+			Descriptor: converter.String(administrator),
+			Allow:      converter.Int(teamAdministratorPermissions),
+		}
+	}
+
+	return mocks.security.
+		EXPECT().
+		QueryAccessControlLists(gomock.Any(), security.QueryAccessControlListsArgs{
+			SecurityNamespaceId: &securityNamespaceIdentity,
+			Token:               &testTeamToken,
+		}).
+		Return(&[]security.AccessControlList{{AcesDictionary: &aces}}, nil).
+		Times(1)
+}
+
+/**
+ * Begin acceptance tests
+ */
+
+// Verifies that a team can be created and renamed, and that the default team of a project can be adopted
+func TestAccAzureDevOpsTeam_CreateAndUpdate(t *testing.T) {
+	projectName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	teamName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	teamNameUpdated := teamName + "-updated"
+	tfTeamNode := "azuredevops_team.team"
+	tfDefaultTeamNode := "azuredevops_team.default"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccTeamCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTeamResource(projectName, teamName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfTeamNode, "name", teamName),
+					resource.TestCheckResourceAttrSet(tfTeamNode, "descriptor"),
+					resource.TestCheckResourceAttr(tfDefaultTeamNode, "name", teamName+"-default"),
+				),
+			}, {
+				Config: testAccTeamResource(projectName, teamNameUpdated),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfTeamNode, "name", teamNameUpdated),
+					resource.TestCheckResourceAttr(tfDefaultTeamNode, "name", teamNameUpdated+"-default"),
+				),
+			},
+		},
+	})
+}
+
+// HCL describing a team and the adopted default team of a project
+func testAccTeamResource(projectName string, teamName string) string {
+	teamResources := fmt.Sprintf(`
+resource "azuredevops_team" "team" {
+	project_id  = azuredevops_project.project.id
+	name        = "%s"
+	description = "Team description"
+}
+
+resource "azuredevops_team" "default" {
+	project_id         = azuredevops_project.project.id
+	name               = "%s-default"
+	adopt_default_team = true
+}`, teamName, teamName)
+
+	projectResource := testAccProjectResource(projectName)
+	return fmt.Sprintf("%s\n%s", projectResource, teamResources)
+}
+
+// verifies that all teams referenced in the state are destroyed. The adopted default team is deleted
+// along with its project.
+func testAccTeamCheckDestroy(s *terraform.State) error {
+	clients := testAccProvider.Meta().(*aggregatedClient)
+
+	for _, res := range s.RootModule().Resources {
+		if res.Type != "azuredevops_team" {
+			continue
+		}
+
+		projectID := res.Primary.Attributes["project_id"]
+		team, err := clients.CoreClient.GetTeam(clients.ctx, core.GetTeamArgs{
+			ProjectId: &projectID,
+			TeamId:    &res.Primary.ID,
+		})
+		if err == nil && team != nil {
+			return fmt.Errorf("Team with ID %s should not exist", res.Primary.ID)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,240 @@
+package azuredevops
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/accounts"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/graph"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/licensing"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/memberentitlementmanagement"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/webapi"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/azdoerror"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+)
+
+// The web UI calls the express license Basic, so both names are accepted for it
+const accountLicenseTypeBasic = "basic"
+
+func resourceUserEntitlement() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceUserEntitlementCreate,
+		Read:   resourceUserEntitlementRead,
+		Update: resourceUserEntitlementUpdate,
+		Delete: resourceUserEntitlementDelete,
+		Schema: map[string]*schema.Schema{
+			"principal_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"account_license_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  string(licensing.AccountLicenseTypeValues.Express),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(licensing.AccountLicenseTypeValues.Advanced),
+					accountLicenseTypeBasic,
+					string(licensing.AccountLicenseTypeValues.EarlyAdopter),
+					string(licensing.AccountLicenseTypeValues.Express),
+					string(licensing.AccountLicenseTypeValues.None),
+					string(licensing.AccountLicenseTypeValues.Professional),
+					string(licensing.AccountLicenseTypeValues.Stakeholder),
+				}, false),
+			},
+			"licensing_source": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  string(licensing.LicensingSourceValues.Account),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(licensing.LicensingSourceValues.Account),
+					string(licensing.LicensingSourceValues.Auto),
+					string(licensing.LicensingSourceValues.Msdn),
+					string(licensing.LicensingSourceValues.None),
+					string(licensing.LicensingSourceValues.Profile),
+					string(licensing.LicensingSourceValues.Trial),
+				}, false),
+			},
+			"descriptor": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Adding the entitlement of a user that is not a member of the organization yet invites the user
+func resourceUserEntitlementCreate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	principalName := d.Get("principal_name").(string)
+
+	response, err := clients.MemberEntitlementClient.AddUserEntitlement(clients.ctx, memberentitlementmanagement.AddUserEntitlementArgs{
+		UserEntitlement: &memberentitlementmanagement.UserEntitlement{
+			AccessLevel: expandUserEntitlementAccessLevel(d),
+			User: &graph.GraphUser{
+				PrincipalName: converter.String(principalName),
+				SubjectKind:   converter.String("user"),
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("Error adding entitlement for user %s. Error: %v", principalName, err)
+	}
+	if response == nil || !converter.ToBool(response.IsSuccess, false) {
+		var result *memberentitlementmanagement.UserEntitlementOperationResult
+		if response != nil {
+			result = response.OperationResult
+		}
+		return fmt.Errorf("Error adding entitlement for user %s. Error: %v", principalName, getUserEntitlementOperationError(result))
+	}
+	if response.UserEntitlement == nil || response.UserEntitlement.Id == nil {
+		return fmt.Errorf("Entitlement for user %s was not returned by the service", principalName)
+	}
+
+	d.SetId(response.UserEntitlement.Id.String())
+	return resourceUserEntitlementRead(d, m)
+}
+
+func resourceUserEntitlementRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	userID, err := uuid.Parse(d.Id())
+	if err != nil {
+		return fmt.Errorf("Error parsing user entitlement ID %s: %+v", d.Id(), err)
+	}
+
+	entitlement, err := clients.MemberEntitlementClient.GetUserEntitlement(clients.ctx, memberentitlementmanagement.GetUserEntitlementArgs{
+		UserId: &userID,
+	})
+	if err != nil {
+		if azdoerror.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error looking up entitlement of user with ID %s. Error: %v", d.Id(), err)
+	}
+
+	// users removed from the organization keep an entitlement with status deleted
+	if entitlement == nil || isUserEntitlementDeleted(entitlement) {
+		d.SetId("")
+		return nil
+	}
+
+	flattenUserEntitlement(d, entitlement)
+	return nil
+}
+
+func resourceUserEntitlementUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	userID, err := uuid.Parse(d.Id())
+	if err != nil {
+		return fmt.Errorf("Error parsing user entitlement ID %s: %+v", d.Id(), err)
+	}
+
+	if d.HasChange("account_license_type") || d.HasChange("licensing_source") {
+		response, err := clients.MemberEntitlementClient.UpdateUserEntitlement(clients.ctx, memberentitlementmanagement.UpdateUserEntitlementArgs{
+			UserId: &userID,
+			Document: &[]webapi.JsonPatchOperation{{
+				Op:    &webapi.OperationValues.Replace,
+				Path:  converter.String("/accessLevel"),
+				Value: expandUserEntitlementAccessLevel(d),
+			}},
+		})
+		if err != nil {
+			return fmt.Errorf("Error updating entitlement of user with ID %s. Error: %v", d.Id(), err)
+		}
+		if response == nil || !converter.ToBool(response.IsSuccess, false) {
+			var result *memberentitlementmanagement.UserEntitlementOperationResult
+			if response != nil && response.OperationResults != nil && len(*response.OperationResults) > 0 {
+				result = &(*response.OperationResults)[0]
+			}
+			return fmt.Errorf("Error updating entitlement of user with ID %s. Error: %v", d.Id(), getUserEntitlementOperationError(result))
+		}
+	}
+
+	return resourceUserEntitlementRead(d, m)
+}
+
+// Removes the user from the organization, which releases the assigned license
+func resourceUserEntitlementDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	userID, err := uuid.Parse(d.Id())
+	if err != nil {
+		return fmt.Errorf("Error parsing user entitlement ID %s: %+v", d.Id(), err)
+	}
+
+	err = clients.MemberEntitlementClient.DeleteUserEntitlement(clients.ctx, memberentitlementmanagement.DeleteUserEntitlementArgs{
+		UserId: &userID,
+	})
+	if err != nil && !azdoerror.IsNotFound(err) {
+		return fmt.Errorf("Error deleting entitlement of user with ID %s. Error: %v", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func isUserEntitlementDeleted(entitlement *memberentitlementmanagement.UserEntitlement) bool {
+	return entitlement.AccessLevel != nil && entitlement.AccessLevel.Status != nil &&
+		*entitlement.AccessLevel.Status == accounts.AccountUserStatusValues.Deleted
+}
+
+// Summarizes the errors reported for an entitlement operation that did not succeed
+func getUserEntitlementOperationError(result *memberentitlementmanagement.UserEntitlementOperationResult) error {
+	if result == nil || result.Errors == nil || len(*result.Errors) == 0 {
+		return fmt.Errorf("the service did not report a reason")
+	}
+
+	messages := make([]string, 0, len(*result.Errors))
+	for _, e := range *result.Errors {
+		var key, value interface{}
+		if e.Key != nil {
+			key = *e.Key
+		}
+		if e.Value != nil {
+			value = *e.Value
+		}
+		messages = append(messages, fmt.Sprintf("%v: %v", key, value))
+	}
+	return fmt.Errorf("%s", strings.Join(messages, "; "))
+}
+
+// Convert internal Terraform data structure to an AzDO data structure
+func expandUserEntitlementAccessLevel(d *schema.ResourceData) *licensing.AccessLevel {
+	licenseType := licensing.AccountLicenseType(d.Get("account_license_type").(string))
+	if licenseType == accountLicenseTypeBasic {
+		licenseType = licensing.AccountLicenseTypeValues.Express
+	}
+	licensingSource := licensing.LicensingSource(d.Get("licensing_source").(string))
+
+	return &licensing.AccessLevel{
+		AccountLicenseType: &licenseType,
+		LicensingSource:    &licensingSource,
+	}
+}
+
+// Convert AzDO data structure to internal Terraform data structure
+func flattenUserEntitlement(d *schema.ResourceData, entitlement *memberentitlementmanagement.UserEntitlement) {
+	if entitlement.User != nil {
+		d.Set("principal_name", converter.ToString(entitlement.User.PrincipalName, ""))
+		d.Set("descriptor", converter.ToString(entitlement.User.Descriptor, ""))
+	}
+
+	if entitlement.AccessLevel != nil {
+		if entitlement.AccessLevel.AccountLicenseType != nil {
+			licenseType := string(*entitlement.AccessLevel.AccountLicenseType)
+			// keep the configured alias, as it names the same license
+			if d.Get("account_license_type").(string) == accountLicenseTypeBasic &&
+				licenseType == string(licensing.AccountLicenseTypeValues.Express) {
+				licenseType = accountLicenseTypeBasic
+			}
+			d.Set("account_license_type", licenseType)
+		}
+		if entitlement.AccessLevel.LicensingSource != nil {
+			d.Set("licensing_source", string(*entitlement.AccessLevel.LicensingSource))
+		}
+	}
+}
@@ -0,0 +1,304 @@
+package azuredevops
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/microsoft/azure-devops-go-api/azuredevops"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/accounts"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/graph"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/licensing"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/memberentitlementmanagement"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/webapi"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+/**
+ * Begin unit tests
+ */
+
+var testUserEntitlementID = uuid.New()
+
+func testUserEntitlement(licenseType licensing.AccountLicenseType, status accounts.AccountUserStatus) *memberentitlementmanagement.UserEntitlement {
+	return &memberentitlementmanagement.UserEntitlement{
+		Id: &testUserEntitlementID,
+		AccessLevel: &licensing.AccessLevel{
+			AccountLicenseType: &licenseType,
+			LicensingSource:    &licensing.LicensingSourceValues.Account,
+			Status:             &status,
+		},
+		User: &graph.GraphUser{
+			PrincipalName: converter.String("user@example.com"),
+			Descriptor:    converter.String("aad.descriptor"),
+		},
+	}
+}
+
+// verifies that the user is invited with the configured license, and that basic is sent as express
+func TestAzureDevOpsUserEntitlement_Create_InvitesUserWithLicense(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	entitlementClient := azdosdkmocks.NewMockMemberEntitlementManagementClient(ctrl)
+	clients := &aggregatedClient{MemberEntitlementClient: entitlementClient, ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceUserEntitlement().Schema, map[string]interface{}{
+		"principal_name":       "user@example.com",
+		"account_license_type": "basic",
+	})
+
+	entitlement := testUserEntitlement(licensing.AccountLicenseTypeValues.Express, accounts.AccountUserStatusValues.Pending)
+	entitlementClient.
+		EXPECT().
+		AddUserEntitlement(clients.ctx, memberentitlementmanagement.AddUserEntitlementArgs{
+			UserEntitlement: &memberentitlementmanagement.UserEntitlement{
+				AccessLevel: &licensing.AccessLevel{
+					AccountLicenseType: &licensing.AccountLicenseTypeValues.Express,
+					LicensingSource:    &licensing.LicensingSourceValues.Account,
+				},
+				User: &graph.GraphUser{
+					PrincipalName: converter.String("user@example.com"),
+					SubjectKind:   converter.String("user"),
+				},
+			},
+		}).
+		Return(&memberentitlementmanagement.UserEntitlementsPostResponse{
+			IsSuccess:       converter.Bool(true),
+			UserEntitlement: entitlement,
+		}, nil).
+		Times(1)
+	entitlementClient.
+		EXPECT().
+		GetUserEntitlement(clients.ctx, memberentitlementmanagement.GetUserEntitlementArgs{UserId: &testUserEntitlementID}).
+		Return(entitlement, nil).
+		Times(1)
+
+	err := resourceUserEntitlementCreate(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, testUserEntitlementID.String(), resourceData.Id())
+	require.Equal(t, "basic", resourceData.Get("account_license_type"))
+	require.Equal(t, "aad.descriptor", resourceData.Get("descriptor"))
+}
+
+// verifies that the errors reported for an unsuccessful operation are surfaced
+func TestAzureDevOpsUserEntitlement_Create_ReportsOperationErrors(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	entitlementClient := azdosdkmocks.NewMockMemberEntitlementManagementClient(ctrl)
+	clients := &aggregatedClient{MemberEntitlementClient: entitlementClient, ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceUserEntitlement().Schema, map[string]interface{}{
+		"principal_name": "user@example.com",
+	})
+
+	var key interface{} = 5000
+	var value interface{} = "No licenses available"
+	entitlementClient.
+		EXPECT().
+		AddUserEntitlement(clients.ctx, gomock.Any()).
+		Return(&memberentitlementmanagement.UserEntitlementsPostResponse{
+			IsSuccess: converter.Bool(false),
+			OperationResult: &memberentitlementmanagement.UserEntitlementOperationResult{
+				Errors: &[]azuredevops.KeyValuePair{{Key: &key, Value: &value}},
+			},
+		}, nil).
+		Times(1)
+
+	err := resourceUserEntitlementCreate(resourceData, clients)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "No licenses available")
+	require.Equal(t, "", resourceData.Id())
+}
+
+// verifies that a license changed outside of Terraform is detected as drift
+func TestAzureDevOpsUserEntitlement_Read_ReconcilesLicense(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	entitlementClient := azdosdkmocks.NewMockMemberEntitlementManagementClient(ctrl)
+	clients := &aggregatedClient{MemberEntitlementClient: entitlementClient, ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceUserEntitlement().Schema, map[string]interface{}{
+		"principal_name":       "user@example.com",
+		"account_license_type": "basic",
+	})
+	resourceData.SetId(testUserEntitlementID.String())
+
+	entitlementClient.
+		EXPECT().
+		GetUserEntitlement(clients.ctx, gomock.Any()).
+		Return(testUserEntitlement(licensing.AccountLicenseTypeValues.Stakeholder, accounts.AccountUserStatusValues.Active), nil).
+		Times(1)
+
+	err := resourceUserEntitlementRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "stakeholder", resourceData.Get("account_license_type"))
+}
+
+// verifies that entitlements that no longer exist, or belong to removed users, are removed from the state
+func TestAzureDevOpsUserEntitlement_Read_ClearsIDIfDeleted(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	entitlementClient := azdosdkmocks.NewMockMemberEntitlementManagementClient(ctrl)
+	clients := &aggregatedClient{MemberEntitlementClient: entitlementClient, ctx: context.Background()}
+
+	notFound := http.StatusNotFound
+	responses := []struct {
+		entitlement *memberentitlementmanagement.UserEntitlement
+		err         error
+	}{
+		{nil, azuredevops.WrappedError{StatusCode: &notFound}},
+		{testUserEntitlement(licensing.AccountLicenseTypeValues.Express, accounts.AccountUserStatusValues.Deleted), nil},
+	}
+
+	for _, response := range responses {
+		resourceData := schema.TestResourceDataRaw(t, resourceUserEntitlement().Schema, map[string]interface{}{
+			"principal_name": "user@example.com",
+		})
+		resourceData.SetId(testUserEntitlementID.String())
+
+		entitlementClient.
+			EXPECT().
+			GetUserEntitlement(clients.ctx, gomock.Any()).
+			Return(response.entitlement, response.err).
+			Times(1)
+
+		err := resourceUserEntitlementRead(resourceData, clients)
+		require.Nil(t, err)
+		require.Equal(t, "", resourceData.Id())
+	}
+}
+
+// verifies that a changed license is replaced through a patch of the access level
+func TestAzureDevOpsUserEntitlement_Update_ReplacesAccessLevel(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	entitlementClient := azdosdkmocks.NewMockMemberEntitlementManagementClient(ctrl)
+	clients := &aggregatedClient{MemberEntitlementClient: entitlementClient, ctx: context.Background()}
+
+	entitlementSchema := schema.InternalMap(resourceUserEntitlement().Schema)
+	state := &terraform.InstanceState{
+		ID: testUserEntitlementID.String(),
+		Attributes: map[string]string{
+			"principal_name":       "user@example.com",
+			"account_license_type": "express",
+			"licensing_source":     "account",
+		},
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"principal_name":       "user@example.com",
+		"account_license_type": "stakeholder",
+	})
+	diff, err := entitlementSchema.Diff(state, config, nil, nil, true)
+	require.Nil(t, err)
+	resourceData, err := entitlementSchema.Data(state, diff)
+	require.Nil(t, err)
+
+	entitlementClient.
+		EXPECT().
+		UpdateUserEntitlement(clients.ctx, memberentitlementmanagement.UpdateUserEntitlementArgs{
+			UserId: &testUserEntitlementID,
+			Document: &[]webapi.JsonPatchOperation{{
+				Op:   &webapi.OperationValues.Replace,
+				Path: converter.String("/accessLevel"),
+				Value: &licensing.AccessLevel{
+					AccountLicenseType: &licensing.AccountLicenseTypeValues.Stakeholder,
+					LicensingSource:    &licensing.LicensingSourceValues.Account,
+				},
+			}},
+		}).
+		Return(&memberentitlementmanagement.UserEntitlementsPatchResponse{IsSuccess: converter.Bool(true)}, nil).
+		Times(1)
+	entitlementClient.
+		EXPECT().
+		GetUserEntitlement(clients.ctx, gomock.Any()).
+		Return(testUserEntitlement(licensing.AccountLicenseTypeValues.Stakeholder, accounts.AccountUserStatusValues.Active), nil).
+		Times(1)
+
+	err = resourceUserEntitlementUpdate(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "stakeholder", resourceData.Get("account_license_type"))
+}
+
+// verifies that if an error is produced on delete, the error is not swallowed
+func TestAzureDevOpsUserEntitlement_Delete_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	entitlementClient := azdosdkmocks.NewMockMemberEntitlementManagementClient(ctrl)
+	clients := &aggregatedClient{MemberEntitlementClient: entitlementClient, ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceUserEntitlement().Schema, map[string]interface{}{
+		"principal_name": "user@example.com",
+	})
+	resourceData.SetId(testUserEntitlementID.String())
+
+	entitlementClient.
+		EXPECT().
+		DeleteUserEntitlement(clients.ctx, memberentitlementmanagement.DeleteUserEntitlementArgs{UserId: &testUserEntitlementID}).
+		Return(errors.New("DeleteUserEntitlement() Failed")).
+		Times(1)
+
+	err := resourceUserEntitlementDelete(resourceData, clients)
+	require.Contains(t, err.Error(), "DeleteUserEntitlement() Failed")
+}
+
+/**
+ * Begin acceptance tests
+ */
+
+// Verifies that a user can be entitled to a license, and that the license can be changed. The principal name
+// of a user that is not a member of the organization is read from the AZDO_TEST_ENTITLEMENT_PRINCIPAL_NAME
+// environment variable, as the user is removed from the organization once the test completes.
+func TestAccAzureDevOpsUserEntitlement_CreateAndUpdate(t *testing.T) {
+	principalName := os.Getenv("AZDO_TEST_ENTITLEMENT_PRINCIPAL_NAME")
+	tfNode := "azuredevops_user_entitlement.user"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			if principalName == "" {
+				t.Skip("AZDO_TEST_ENTITLEMENT_PRINCIPAL_NAME must be set for this acceptance test")
+			}
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserEntitlementResource(principalName, "express"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfNode, "descriptor"),
+					resource.TestCheckResourceAttr(tfNode, "account_license_type", "express"),
+					resource.TestCheckResourceAttr(tfNode, "licensing_source", "account"),
+				),
+			}, {
+				Config: testAccUserEntitlementResource(principalName, "stakeholder"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "account_license_type", "stakeholder"),
+				),
+			},
+		},
+	})
+}
+
+// HCL describing the entitlement of a user
+func testAccUserEntitlementResource(principalName string, licenseType string) string {
+	return fmt.Sprintf(`
+resource "azuredevops_user_entitlement" "user" {
+	principal_name       = "%s"
+	account_license_type = "%s"
+}`, principalName, licenseType)
+}
@@ -0,0 +1,397 @@
+package azuredevops
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/build"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/secretmemo"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/tfhelper"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/variablegroup"
+)
+
+// The resource type used to authorize a variable group for use in all pipelines of a project
+const variableGroupResourceType = "variablegroup"
+
+func resourceVariableGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVariableGroupCreate,
+		Read:   resourceVariableGroupRead,
+		Update: resourceVariableGroupUpdate,
+		Delete: resourceVariableGroupDelete,
+
+		CustomizeDiff: customizeDiffVariableGroup,
+
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "",
+			},
+			"allow_access": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"key_vault": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"service_endpoint_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+					},
+				},
+			},
+			"variable": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "",
+						},
+						"secret_value": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Sensitive:        true,
+							Default:          "",
+							DiffSuppressFunc: tfhelper.DiffFuncSupressSecretChanged,
+						},
+						variableSecretHashKey: variableSecretHashSchema,
+						"is_secret": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func customizeDiffVariableGroup(d *schema.ResourceDiff, m interface{}) error {
+	variables := d.Get("variable").([]interface{})
+	if len(d.Get("key_vault").([]interface{})) == 0 {
+		return validateSecretVariables(variables)
+	}
+
+	// the values of the variables of a group linked to a vault are the secrets of the vault
+	for _, item := range variables {
+		variable := item.(map[string]interface{})
+		if variable["value"].(string) != "" || variable["secret_value"].(string) != "" || variable["is_secret"].(bool) {
+			return fmt.Errorf("variable %s of a variable group linked to an Azure Key Vault must only declare the name of a secret", variable["name"])
+		}
+	}
+	return nil
+}
+
+func resourceVariableGroupCreate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	variableGroupParameters, projectID, err := expandVariableGroupParameters(d)
+	if err != nil {
+		return err
+	}
+
+	addedVariableGroup, err := clients.VariableGroupClient.AddVariableGroup(clients.ctx, variablegroup.AddVariableGroupArgs{
+		Group:   variableGroupParameters,
+		Project: &projectID,
+	})
+	if err != nil {
+		return fmt.Errorf("Error creating variable group in Azure DevOps: %+v", err)
+	}
+
+	flattenVariableGroup(d, addedVariableGroup, projectID, clients.secretSettings)
+
+	allowAccess := d.Get("allow_access").(bool)
+	if allowAccess {
+		if err := authorizeVariableGroup(clients, projectID, *addedVariableGroup.Id, true); err != nil {
+			return err
+		}
+	}
+	d.Set("allow_access", allowAccess)
+	return nil
+}
+
+func resourceVariableGroupRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	projectID, variableGroupID, err := parseVariableGroupIdentifiers(d)
+	if err != nil {
+		return err
+	}
+
+	variableGroup, err := clients.VariableGroupClient.GetVariableGroup(clients.ctx, variablegroup.GetVariableGroupArgs{
+		Project: &projectID,
+		GroupId: &variableGroupID,
+	})
+	if err != nil {
+		return fmt.Errorf("Error looking up variable group with ID %d. Error: %v", variableGroupID, err)
+	}
+
+	// the service returns an empty response for variable groups that do not exist
+	if variableGroup == nil || variableGroup.Id == nil {
+		d.SetId("")
+		return nil
+	}
+
+	flattenVariableGroup(d, variableGroup, projectID, clients.secretSettings)
+
+	allowAccess, err := isVariableGroupAuthorized(clients, projectID, variableGroupID)
+	if err != nil {
+		return err
+	}
+	d.Set("allow_access", allowAccess)
+	return nil
+}
+
+func resourceVariableGroupUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	variableGroupParameters, projectID, err := expandVariableGroupParameters(d)
+	if err != nil {
+		return err
+	}
+	_, variableGroupID, err := parseVariableGroupIdentifiers(d)
+	if err != nil {
+		return err
+	}
+
+	updatedVariableGroup, err := clients.VariableGroupClient.UpdateVariableGroup(clients.ctx, variablegroup.UpdateVariableGroupArgs{
+		Group:   variableGroupParameters,
+		Project: &projectID,
+		GroupId: &variableGroupID,
+	})
+	if err != nil {
+		return fmt.Errorf("Error updating variable group in Azure DevOps: %+v", err)
+	}
+
+	flattenVariableGroup(d, updatedVariableGroup, projectID, clients.secretSettings)
+
+	if d.HasChange("allow_access") {
+		allowAccess := d.Get("allow_access").(bool)
+		if err := authorizeVariableGroup(clients, projectID, variableGroupID, allowAccess); err != nil {
+			return err
+		}
+		d.Set("allow_access", allowAccess)
+	}
+	return nil
+}
+
+func resourceVariableGroupDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	projectID, variableGroupID, err := parseVariableGroupIdentifiers(d)
+	if err != nil {
+		return err
+	}
+
+	// the authorization is a separate resource of the project, so it has to be revoked explicitly
+	if d.Get("allow_access").(bool) {
+		if err := authorizeVariableGroup(clients, projectID, variableGroupID, false); err != nil {
+			return err
+		}
+	}
+
+	err = clients.TaskAgentClient.DeleteVariableGroup(clients.ctx, taskagent.DeleteVariableGroupArgs{
+		Project: &projectID,
+		GroupId: &variableGroupID,
+	})
+	if err != nil {
+		return fmt.Errorf("Error deleting variable group with ID %d. Error: %v", variableGroupID, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func parseVariableGroupIdentifiers(d *schema.ResourceData) (string, int, error) {
+	projectID := d.Get("project_id").(string)
+	variableGroupID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return "", 0, fmt.Errorf("Error parsing the variable group ID %s: %v", d.Id(), err)
+	}
+	return projectID, variableGroupID, nil
+}
+
+// Grants or revokes the permission of all pipelines of the project to use the variable group
+func authorizeVariableGroup(clients *aggregatedClient, projectID string, variableGroupID int, authorized bool) error {
+	_, err := clients.BuildClient.AuthorizeProjectResources(clients.ctx, build.AuthorizeProjectResourcesArgs{
+		Project: &projectID,
+		Resources: &[]build.DefinitionResourceReference{{
+			Type:       converter.String(variableGroupResourceType),
+			Id:         converter.String(strconv.Itoa(variableGroupID)),
+			Authorized: &authorized,
+		}},
+	})
+	if err != nil {
+		return fmt.Errorf("Error updating the pipeline authorization of variable group with ID %d. Error: %v", variableGroupID, err)
+	}
+	return nil
+}
+
+func isVariableGroupAuthorized(clients *aggregatedClient, projectID string, variableGroupID int) (bool, error) {
+	resources, err := clients.BuildClient.GetProjectResources(clients.ctx, build.GetProjectResourcesArgs{
+		Project: &projectID,
+		Type:    converter.String(variableGroupResourceType),
+		Id:      converter.String(strconv.Itoa(variableGroupID)),
+	})
+	if err != nil {
+		return false, fmt.Errorf("Error looking up the pipeline authorization of variable group with ID %d. Error: %v", variableGroupID, err)
+	}
+
+	if resources == nil {
+		return false, nil
+	}
+	for _, resource := range *resources {
+		if converter.ToBool(resource.Authorized, false) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Convert internal Terraform data structure to an AzDO data structure
+func expandVariableGroupParameters(d *schema.ResourceData) (*variablegroup.Parameters, string, error) {
+	projectID := d.Get("project_id").(string)
+	variableGroupParameters := &variablegroup.Parameters{
+		Name:        converter.String(d.Get("name").(string)),
+		Description: converter.String(d.Get("description").(string)),
+		Type:        converter.String(variablegroup.TypeVsts),
+	}
+
+	variables := map[string]variablegroup.VariableValue{}
+	keyVault := expandSingleItemBlock(d, "key_vault")
+	if len(keyVault) > 0 {
+		serviceEndpointID, err := uuid.Parse(keyVault["service_endpoint_id"].(string))
+		if err != nil {
+			return nil, "", fmt.Errorf("Invalid service_endpoint_id UUID: %s", keyVault["service_endpoint_id"])
+		}
+		variableGroupParameters.Type = converter.String(variablegroup.TypeAzureKeyVault)
+		variableGroupParameters.ProviderData = &variablegroup.ProviderData{
+			ServiceEndpointID: &serviceEndpointID,
+			Vault:             converter.String(keyVault["name"].(string)),
+		}
+
+		// the values of the secrets are read from the vault when a pipeline runs
+		for _, item := range d.Get("variable").([]interface{}) {
+			variable := item.(map[string]interface{})
+			variables[variable["name"].(string)] = variablegroup.VariableValue{
+				Enabled:  converter.Bool(true),
+				IsSecret: converter.Bool(true),
+			}
+		}
+		variableGroupParameters.Variables = &variables
+		return variableGroupParameters, projectID, nil
+	}
+
+	for _, item := range d.Get("variable").([]interface{}) {
+		variable := item.(map[string]interface{})
+		isSecret := variable["is_secret"].(bool)
+
+		// the service keeps the stored value of a secret variable if no value is sent
+		var value *string
+		if isSecret {
+			if secretValue := variable["secret_value"].(string); secretValue != "" {
+				value = converter.String(secretValue)
+			}
+		} else {
+			value = converter.String(variable["value"].(string))
+		}
+
+		variables[variable["name"].(string)] = variablegroup.VariableValue{
+			Value:    value,
+			IsSecret: converter.Bool(isSecret),
+		}
+	}
+	variableGroupParameters.Variables = &variables
+	return variableGroupParameters, projectID, nil
+}
+
+// Convert AzDO data structure to internal Terraform data structure. The service never returns the values of
+// secret variables, so the hash of the configured secret value is kept in the state to detect changes.
+func flattenVariableGroup(d *schema.ResourceData, variableGroup *variablegroup.VariableGroup, projectID string, secretSettings *secretmemo.Settings) {
+	d.SetId(strconv.Itoa(*variableGroup.Id))
+	d.Set("project_id", projectID)
+	d.Set("name", converter.ToString(variableGroup.Name, ""))
+	d.Set("description", converter.ToString(variableGroup.Description, ""))
+
+	isKeyVault := converter.ToString(variableGroup.Type, "") == variablegroup.TypeAzureKeyVault
+	if isKeyVault && variableGroup.ProviderData != nil {
+		keyVault := map[string]interface{}{
+			"name":                converter.ToString(variableGroup.ProviderData.Vault, ""),
+			"service_endpoint_id": "",
+		}
+		if variableGroup.ProviderData.ServiceEndpointID != nil {
+			keyVault["service_endpoint_id"] = variableGroup.ProviderData.ServiceEndpointID.String()
+		}
+		d.Set("key_vault", []interface{}{keyVault})
+	} else {
+		d.Set("key_vault", nil)
+	}
+
+	if variableGroup.Variables == nil {
+		d.Set("variable", nil)
+		return
+	}
+
+	var existingNames []string
+	for name := range *variableGroup.Variables {
+		existingNames = append(existingNames, name)
+	}
+	names, knownIndexes := orderVariableNames(d.Get("variable").([]interface{}), existingNames)
+
+	variables := make([]interface{}, len(names))
+	for i, name := range names {
+		variable := (*variableGroup.Variables)[name]
+		isSecret := converter.ToBool(variable.IsSecret, false)
+
+		flattened := map[string]interface{}{
+			"name":         name,
+			"value":        "",
+			"secret_value": "",
+			"is_secret":    isSecret,
+		}
+		if isKeyVault {
+			// the variables of a group linked to a vault only name the secrets of the vault
+			flattened["is_secret"] = false
+		} else if !isSecret {
+			flattened["value"] = converter.ToString(variable.Value, "")
+		} else if index, ok := knownIndexes[name]; ok {
+			tfhelper.HelpFlattenSecretNestedAt(d, "variable", index, flattened, "secret_value", secretSettings)
+		}
+		variables[i] = flattened
+	}
+	d.Set("variable", variables)
+}
@@ -0,0 +1,432 @@
+package azuredevops
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/build"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/variablegroup"
+	"github.com/stretchr/testify/require"
+)
+
+var testVariableGroupProjectID = "project-id"
+
+var testVariableGroup = variablegroup.VariableGroup{
+	Id:          converter.Int(7),
+	Name:        converter.String("Name"),
+	Description: converter.String("Description"),
+	Type:        converter.String(variablegroup.TypeVsts),
+	Variables: &map[string]variablegroup.VariableValue{
+		"FOO": {
+			Value:    converter.String("bar"),
+			IsSecret: converter.Bool(false),
+		},
+		"SECRET": {
+			IsSecret: converter.Bool(true),
+		},
+	},
+}
+
+var testKeyVaultServiceEndpointID = uuid.New()
+
+var testKeyVaultVariableGroup = variablegroup.VariableGroup{
+	Id:          converter.Int(8),
+	Name:        converter.String("Name"),
+	Description: converter.String("Description"),
+	Type:        converter.String(variablegroup.TypeAzureKeyVault),
+	ProviderData: &variablegroup.ProviderData{
+		ServiceEndpointID: &testKeyVaultServiceEndpointID,
+		Vault:             converter.String("vault"),
+	},
+	Variables: &map[string]variablegroup.VariableValue{
+		"secret": {
+			Enabled:  converter.Bool(true),
+			IsSecret: converter.Bool(true),
+		},
+	},
+}
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that the flatten/expand round trip yields the same variable group
+func TestAzureDevOpsVariableGroup_ExpandFlatten_Roundtrip(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, resourceVariableGroup().Schema, nil)
+	flattenVariableGroup(resourceData, &testVariableGroup, testVariableGroupProjectID, nil)
+
+	variableGroupParameters, projectID, err := expandVariableGroupParameters(resourceData)
+
+	require.Nil(t, err)
+	require.Equal(t, testVariableGroupProjectID, projectID)
+	require.Equal(t, *testVariableGroup.Name, *variableGroupParameters.Name)
+	require.Equal(t, *testVariableGroup.Description, *variableGroupParameters.Description)
+	require.Equal(t, *testVariableGroup.Type, *variableGroupParameters.Type)
+	require.Nil(t, variableGroupParameters.ProviderData)
+	require.Equal(t, *testVariableGroup.Variables, *variableGroupParameters.Variables)
+}
+
+// verifies that the flatten/expand round trip keeps the linkage of a variable group to a key vault
+func TestAzureDevOpsVariableGroup_ExpandFlatten_KeyVaultRoundtrip(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, resourceVariableGroup().Schema, nil)
+	flattenVariableGroup(resourceData, &testKeyVaultVariableGroup, testVariableGroupProjectID, nil)
+
+	require.Equal(t, "vault", resourceData.Get("key_vault.0.name"))
+	require.Equal(t, testKeyVaultServiceEndpointID.String(), resourceData.Get("key_vault.0.service_endpoint_id"))
+	require.Equal(t, "secret", resourceData.Get("variable.0.name"))
+	require.Equal(t, false, resourceData.Get("variable.0.is_secret"))
+
+	variableGroupParameters, _, err := expandVariableGroupParameters(resourceData)
+
+	require.Nil(t, err)
+	require.Equal(t, variablegroup.TypeAzureKeyVault, *variableGroupParameters.Type)
+	require.Equal(t, *testKeyVaultVariableGroup.ProviderData, *variableGroupParameters.ProviderData)
+	require.Equal(t, *testKeyVaultVariableGroup.Variables, *variableGroupParameters.Variables)
+}
+
+// verifies that the variables of a variable group linked to a key vault cannot declare values
+func TestAzureDevOpsVariableGroup_CustomizeDiff_KeyVaultVariablesHaveNoValues(t *testing.T) {
+	for _, variable := range []map[string]interface{}{
+		{"name": "secret", "value": "value"},
+		{"name": "secret", "secret_value": "value", "is_secret": true},
+		{"name": "secret", "is_secret": true},
+	} {
+		_, err := resourceVariableGroup().Diff(nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+			"project_id": testVariableGroupProjectID,
+			"name":       "Name",
+			"key_vault": []interface{}{map[string]interface{}{
+				"name":                "vault",
+				"service_endpoint_id": testKeyVaultServiceEndpointID.String(),
+			}},
+			"variable": []interface{}{variable},
+		}), nil)
+		require.NotNil(t, err)
+		require.Contains(t, err.Error(), "must only declare the name of a secret")
+	}
+
+	_, err := resourceVariableGroup().Diff(nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"project_id": testVariableGroupProjectID,
+		"name":       "Name",
+		"key_vault": []interface{}{map[string]interface{}{
+			"name":                "vault",
+			"service_endpoint_id": testKeyVaultServiceEndpointID.String(),
+		}},
+		"variable": []interface{}{map[string]interface{}{"name": "secret"}},
+	}), nil)
+	require.Nil(t, err)
+}
+
+// verifies that the service endpoint of the key vault has to be a UUID
+func TestAzureDevOpsVariableGroup_Expand_ChecksServiceEndpointID(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, resourceVariableGroup().Schema, map[string]interface{}{
+		"project_id": testVariableGroupProjectID,
+		"name":       "Name",
+		"key_vault":  []interface{}{map[string]interface{}{"name": "vault", "service_endpoint_id": "not-a-uuid"}},
+		"variable":   []interface{}{map[string]interface{}{"name": "secret"}},
+	})
+
+	_, _, err := expandVariableGroupParameters(resourceData)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "Invalid service_endpoint_id UUID")
+}
+
+// verifies that the pipeline permissions are granted after the variable group has been created
+func TestAzureDevOpsVariableGroup_Create_AuthorizesPipelines(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	variableGroupClient := azdosdkmocks.NewMockVariablegroupClient(ctrl)
+	buildClient := azdosdkmocks.NewMockBuildClient(ctrl)
+	clients := &aggregatedClient{VariableGroupClient: variableGroupClient, BuildClient: buildClient, ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceVariableGroup().Schema, map[string]interface{}{
+		"project_id":   testVariableGroupProjectID,
+		"name":         "Name",
+		"allow_access": true,
+		"variable":     []interface{}{map[string]interface{}{"name": "FOO", "value": "bar"}},
+	})
+
+	variableGroupClient.
+		EXPECT().
+		AddVariableGroup(clients.ctx, gomock.Any()).
+		Return(&testVariableGroup, nil).
+		Times(1)
+
+	buildClient.
+		EXPECT().
+		AuthorizeProjectResources(clients.ctx, build.AuthorizeProjectResourcesArgs{
+			Project: &testVariableGroupProjectID,
+			Resources: &[]build.DefinitionResourceReference{{
+				Type:       converter.String("variablegroup"),
+				Id:         converter.String("7"),
+				Authorized: converter.Bool(true),
+			}},
+		}).
+		Return(nil, nil).
+		Times(1)
+
+	err := resourceVariableGroupCreate(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "7", resourceData.Id())
+}
+
+// verifies that if an error is produced on create, the error is not swallowed
+func TestAzureDevOpsVariableGroup_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	variableGroupClient := azdosdkmocks.NewMockVariablegroupClient(ctrl)
+	clients := &aggregatedClient{VariableGroupClient: variableGroupClient, ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceVariableGroup().Schema, nil)
+	flattenVariableGroup(resourceData, &testVariableGroup, testVariableGroupProjectID, nil)
+
+	variableGroupClient.
+		EXPECT().
+		AddVariableGroup(clients.ctx, gomock.Any()).
+		Return(nil, errors.New("AddVariableGroup() Failed")).
+		Times(1)
+
+	err := resourceVariableGroupCreate(resourceData, clients)
+	require.Contains(t, err.Error(), "AddVariableGroup() Failed")
+}
+
+// verifies that the pipeline authorization is read along with the variable group
+func TestAzureDevOpsVariableGroup_Read_ReadsAuthorization(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	variableGroupClient := azdosdkmocks.NewMockVariablegroupClient(ctrl)
+	buildClient := azdosdkmocks.NewMockBuildClient(ctrl)
+	clients := &aggregatedClient{VariableGroupClient: variableGroupClient, BuildClient: buildClient, ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceVariableGroup().Schema, nil)
+	flattenVariableGroup(resourceData, &testVariableGroup, testVariableGroupProjectID, nil)
+
+	variableGroupClient.
+		EXPECT().
+		GetVariableGroup(clients.ctx, variablegroup.GetVariableGroupArgs{Project: &testVariableGroupProjectID, GroupId: converter.Int(7)}).
+		Return(&testVariableGroup, nil).
+		Times(1)
+
+	buildClient.
+		EXPECT().
+		GetProjectResources(clients.ctx, build.GetProjectResourcesArgs{
+			Project: &testVariableGroupProjectID,
+			Type:    converter.String("variablegroup"),
+			Id:      converter.String("7"),
+		}).
+		Return(&[]build.DefinitionResourceReference{{Authorized: converter.Bool(true)}}, nil).
+		Times(1)
+
+	err := resourceVariableGroupRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, true, resourceData.Get("allow_access"))
+}
+
+// verifies that a variable group that no longer exists is removed from the state
+func TestAzureDevOpsVariableGroup_Read_ClearsIDOfMissingGroup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	variableGroupClient := azdosdkmocks.NewMockVariablegroupClient(ctrl)
+	clients := &aggregatedClient{VariableGroupClient: variableGroupClient, ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceVariableGroup().Schema, nil)
+	flattenVariableGroup(resourceData, &testVariableGroup, testVariableGroupProjectID, nil)
+
+	variableGroupClient.
+		EXPECT().
+		GetVariableGroup(clients.ctx, gomock.Any()).
+		Return(nil, nil).
+		Times(1)
+
+	err := resourceVariableGroupRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "", resourceData.Id())
+}
+
+// verifies that the pipeline permissions are revoked before the variable group is deleted
+func TestAzureDevOpsVariableGroup_Delete_RevokesAuthorization(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskAgentClient := azdosdkmocks.NewMockTaskagentClient(ctrl)
+	buildClient := azdosdkmocks.NewMockBuildClient(ctrl)
+	clients := &aggregatedClient{TaskAgentClient: taskAgentClient, BuildClient: buildClient, ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceVariableGroup().Schema, nil)
+	flattenVariableGroup(resourceData, &testVariableGroup, testVariableGroupProjectID, nil)
+	resourceData.Set("allow_access", true)
+
+	revoke := buildClient.
+		EXPECT().
+		AuthorizeProjectResources(clients.ctx, build.AuthorizeProjectResourcesArgs{
+			Project: &testVariableGroupProjectID,
+			Resources: &[]build.DefinitionResourceReference{{
+				Type:       converter.String("variablegroup"),
+				Id:         converter.String("7"),
+				Authorized: converter.Bool(false),
+			}},
+		}).
+		Return(nil, nil).
+		Times(1)
+
+	taskAgentClient.
+		EXPECT().
+		DeleteVariableGroup(clients.ctx, taskagent.DeleteVariableGroupArgs{Project: &testVariableGroupProjectID, GroupId: converter.Int(7)}).
+		Return(nil).
+		After(revoke).
+		Times(1)
+
+	err := resourceVariableGroupDelete(resourceData, clients)
+	require.Nil(t, err)
+}
+
+// verifies that if an error is produced on delete, the error is not swallowed
+func TestAzureDevOpsVariableGroup_Delete_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskAgentClient := azdosdkmocks.NewMockTaskagentClient(ctrl)
+	clients := &aggregatedClient{TaskAgentClient: taskAgentClient, ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceVariableGroup().Schema, nil)
+	flattenVariableGroup(resourceData, &testVariableGroup, testVariableGroupProjectID, nil)
+
+	taskAgentClient.
+		EXPECT().
+		DeleteVariableGroup(clients.ctx, gomock.Any()).
+		Return(errors.New("DeleteVariableGroup() Failed")).
+		Times(1)
+
+	err := resourceVariableGroupDelete(resourceData, clients)
+	require.Contains(t, err.Error(), "DeleteVariableGroup() Failed")
+}
+
+/**
+ * Begin acceptance tests
+ */
+
+// Verifies that a variable group can be created, updated and destroyed
+func TestAccAzureDevOpsVariableGroup_CreateAndUpdate(t *testing.T) {
+	projectName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	variableGroupNameFirst := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	variableGroupNameSecond := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	tfVarGroupNode := "azuredevops_variable_group.vg"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccVariableGroupCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVariableGroupResource(projectName, variableGroupNameFirst, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfVarGroupNode, "name", variableGroupNameFirst),
+					resource.TestCheckResourceAttr(tfVarGroupNode, "allow_access", "true"),
+					resource.TestCheckResourceAttrSet(tfVarGroupNode, "variable.1.secret_value_hash"),
+					testAccCheckVariableGroupResourceExists(variableGroupNameFirst),
+				),
+			}, {
+				Config: testAccVariableGroupResource(projectName, variableGroupNameSecond, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfVarGroupNode, "name", variableGroupNameSecond),
+					resource.TestCheckResourceAttr(tfVarGroupNode, "allow_access", "false"),
+					testAccCheckVariableGroupResourceExists(variableGroupNameSecond),
+				),
+			},
+		},
+	})
+}
+
+// HCL describing an AzDO variable group
+func testAccVariableGroupResource(projectName string, variableGroupName string, allowAccess bool) string {
+	variableGroupResource := fmt.Sprintf(`
+resource "azuredevops_variable_group" "vg" {
+	project_id   = azuredevops_project.project.id
+	name         = "%s"
+	description  = "A sample variable group."
+	allow_access = %t
+
+	variable {
+		name  = "key1"
+		value = "value1"
+	}
+
+	variable {
+		name         = "key2"
+		secret_value = "value2"
+		is_secret    = true
+	}
+}`, variableGroupName, allowAccess)
+
+	projectResource := testAccProjectResource(projectName)
+	return fmt.Sprintf("%s\n%s", projectResource, variableGroupResource)
+}
+
+// Given the name of a variable group, this will return a function that will check whether
+// or not the variable group (1) exists in the state and (2) exists in AzDO and (3) has the correct name
+func testAccCheckVariableGroupResourceExists(expectedName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		varGroup, ok := s.RootModule().Resources["azuredevops_variable_group.vg"]
+		if !ok {
+			return fmt.Errorf("Did not find a variable group in the TF state")
+		}
+
+		variableGroup, err := getVariableGroupFromResource(varGroup)
+		if err != nil {
+			return err
+		}
+		if variableGroup == nil || variableGroup.Id == nil {
+			return fmt.Errorf("Variable group with ID %s does not exist", varGroup.Primary.ID)
+		}
+
+		if *variableGroup.Name != expectedName {
+			return fmt.Errorf("Variable group has Name=%s, but expected Name=%s", *variableGroup.Name, expectedName)
+		}
+		return nil
+	}
+}
+
+// verifies that all variable groups referenced in the state are destroyed
+func testAccVariableGroupCheckDestroy(s *terraform.State) error {
+	for _, resource := range s.RootModule().Resources {
+		if resource.Type != "azuredevops_variable_group" {
+			continue
+		}
+
+		// the service returns an empty response for variable groups that do not exist
+		variableGroup, err := getVariableGroupFromResource(resource)
+		if err == nil && variableGroup != nil && variableGroup.Id != nil {
+			return fmt.Errorf("Unexpectedly found a variable group that should be deleted")
+		}
+	}
+	return nil
+}
+
+func getVariableGroupFromResource(resource *terraform.ResourceState) (*variablegroup.VariableGroup, error) {
+	variableGroupID, err := strconv.Atoi(resource.Primary.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	projectID := resource.Primary.Attributes["project_id"]
+	clients := testAccProvider.Meta().(*aggregatedClient)
+	return clients.VariableGroupClient.GetVariableGroup(clients.ctx, variablegroup.GetVariableGroupArgs{
+		Project: &projectID,
+		GroupId: &variableGroupID,
+	})
+}
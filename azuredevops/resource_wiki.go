@@ -0,0 +1,291 @@
+package azuredevops
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/git"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/wiki"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/azdoerror"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+)
+
+func resourceWiki() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceWikiCreate,
+		Read:          resourceWikiRead,
+		Update:        resourceWikiUpdate,
+		Delete:        resourceWikiDelete,
+		CustomizeDiff: customizeDiffWiki,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  string(wiki.WikiTypeValues.ProjectWiki),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(wiki.WikiTypeValues.ProjectWiki),
+					string(wiki.WikiTypeValues.CodeWiki),
+				}, false),
+			},
+			"repository_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"version": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.NoZeroValues,
+				DiffSuppressFunc: suppressRefsHeadsPrefixDiff,
+			},
+			"mapped_path": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"remote_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Verifies at plan time that the repository settings are given for code wikis, and only for code wikis
+func customizeDiffWiki(d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("type") {
+		return nil
+	}
+
+	isCodeWiki := d.Get("type").(string) == string(wiki.WikiTypeValues.CodeWiki)
+	for _, key := range []string{"repository_id", "version", "mapped_path"} {
+		if !d.NewValueKnown(key) {
+			continue
+		}
+		isSet := d.Get(key).(string) != ""
+		if isCodeWiki && !isSet {
+			return fmt.Errorf("%s is required for wikis of type %s", key, wiki.WikiTypeValues.CodeWiki)
+		}
+		if !isCodeWiki && isSet {
+			return fmt.Errorf("%s can only be set for wikis of type %s", key, wiki.WikiTypeValues.CodeWiki)
+		}
+	}
+	return nil
+}
+
+func resourceWikiCreate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	params, projectID, err := expandWiki(d)
+	if err != nil {
+		return err
+	}
+
+	if *params.Type == wiki.WikiTypeValues.ProjectWiki {
+		existingWiki, err := getProjectWiki(clients, projectID)
+		if err != nil {
+			return err
+		}
+		if existingWiki != nil {
+			return adoptProjectWiki(clients, d, existingWiki)
+		}
+	}
+
+	createdWiki, err := clients.WikiClient.CreateWiki(clients.ctx, wiki.CreateWikiArgs{
+		WikiCreateParams: params,
+		Project:          &projectID,
+	})
+	if err != nil {
+		return fmt.Errorf("Error creating wiki %s in project %s. Error: %v", *params.Name, projectID, err)
+	}
+
+	return flattenWiki(d, createdWiki)
+}
+
+// A project can only have a single project wiki, which may already have been created through the web UI. Such a
+// wiki is taken over instead of failing the creation, and renamed if its name differs from the configured one.
+func adoptProjectWiki(clients *aggregatedClient, d *schema.ResourceData, existingWiki *wiki.WikiV2) error {
+	name := d.Get("name").(string)
+	log.Printf("[INFO] Adopting existing project wiki %s of project %s", converter.ToString(existingWiki.Name, ""), d.Get("project_id").(string))
+	if strings.EqualFold(converter.ToString(existingWiki.Name, ""), name) {
+		return flattenWiki(d, existingWiki)
+	}
+
+	updatedWiki, err := clients.WikiClient.UpdateWiki(clients.ctx, wiki.UpdateWikiArgs{
+		UpdateParameters: &wiki.WikiUpdateParameters{Name: &name},
+		WikiIdentifier:   converter.String(existingWiki.Id.String()),
+		Project:          converter.String(d.Get("project_id").(string)),
+	})
+	if err != nil {
+		return fmt.Errorf("Error renaming existing project wiki %s to %s. Error: %v", existingWiki.Id.String(), name, err)
+	}
+	return flattenWiki(d, updatedWiki)
+}
+
+// Returns the project wiki of a project, or nil if the project does not have one yet
+func getProjectWiki(clients *aggregatedClient, projectID string) (*wiki.WikiV2, error) {
+	wikis, err := clients.WikiClient.GetAllWikis(clients.ctx, wiki.GetAllWikisArgs{
+		Project: &projectID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error looking up wikis of project %s. Error: %v", projectID, err)
+	}
+	if wikis == nil {
+		return nil, nil
+	}
+
+	for _, w := range *wikis {
+		if w.Type != nil && *w.Type == wiki.WikiTypeValues.ProjectWiki && w.Id != nil {
+			return &w, nil
+		}
+	}
+	return nil, nil
+}
+
+func resourceWikiRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	projectID := d.Get("project_id").(string)
+
+	w, err := clients.WikiClient.GetWiki(clients.ctx, wiki.GetWikiArgs{
+		WikiIdentifier: converter.String(d.Id()),
+		Project:        &projectID,
+	})
+	if err != nil {
+		if azdoerror.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error looking up wiki with ID %s in project %s. Error: %v", d.Id(), projectID, err)
+	}
+
+	return flattenWiki(d, w)
+}
+
+func resourceWikiUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*aggregatedClient)
+	projectID := d.Get("project_id").(string)
+
+	params := &wiki.WikiUpdateParameters{
+		Name: converter.String(d.Get("name").(string)),
+	}
+	if version := d.Get("version").(string); version != "" {
+		params.Versions = &[]git.GitVersionDescriptor{expandWikiVersion(version)}
+	}
+
+	updatedWiki, err := clients.WikiClient.UpdateWiki(clients.ctx, wiki.UpdateWikiArgs{
+		UpdateParameters: params,
+		WikiIdentifier:   converter.String(d.Id()),
+		Project:          &projectID,
+	})
+	if err != nil {
+		return fmt.Errorf("Error updating wiki with ID %s in project %s. Error: %v", d.Id(), projectID, err)
+	}
+
+	return flattenWiki(d, updatedWiki)
+}
+
+// Code wikis are unpublished, which leaves the content in the repository untouched. Project wikis can not be
+// deleted through the service, so they are only removed from the Terraform state.
+func resourceWikiDelete(d *schema.ResourceData, m interface{}) error {
+	if d.Get("type").(string) == string(wiki.WikiTypeValues.ProjectWiki) {
+		log.Printf("[INFO] Project wiki %s can not be deleted and is only removed from the state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	clients := m.(*aggregatedClient)
+	projectID := d.Get("project_id").(string)
+	_, err := clients.WikiClient.DeleteWiki(clients.ctx, wiki.DeleteWikiArgs{
+		WikiIdentifier: converter.String(d.Id()),
+		Project:        &projectID,
+	})
+	if err != nil {
+		return fmt.Errorf("Error deleting wiki with ID %s in project %s. Error: %v", d.Id(), projectID, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// Convert internal Terraform data structure to an AzDO data structure
+func expandWiki(d *schema.ResourceData) (*wiki.WikiCreateParametersV2, string, error) {
+	projectID := d.Get("project_id").(string)
+	projectUUID, err := uuid.Parse(projectID)
+	if err != nil {
+		return nil, "", fmt.Errorf("Error parsing project ID %s: %+v", projectID, err)
+	}
+
+	wikiType := wiki.WikiType(d.Get("type").(string))
+	params := &wiki.WikiCreateParametersV2{
+		Name:      converter.String(d.Get("name").(string)),
+		ProjectId: &projectUUID,
+		Type:      &wikiType,
+	}
+
+	if wikiType == wiki.WikiTypeValues.CodeWiki {
+		repositoryID := d.Get("repository_id").(string)
+		repositoryUUID, err := uuid.Parse(repositoryID)
+		if err != nil {
+			return nil, "", fmt.Errorf("Error parsing repository ID %s: %+v", repositoryID, err)
+		}
+		version := expandWikiVersion(d.Get("version").(string))
+
+		params.RepositoryId = &repositoryUUID
+		params.MappedPath = converter.String(d.Get("mapped_path").(string))
+		params.Version = &version
+	}
+	return params, projectID, nil
+}
+
+// The version of a code wiki is the name of a branch, without the refs/heads/ prefix
+func expandWikiVersion(branch string) git.GitVersionDescriptor {
+	return git.GitVersionDescriptor{
+		Version:     converter.String(strings.TrimPrefix(branch, refsHeadsPrefix)),
+		VersionType: &git.GitVersionTypeValues.Branch,
+	}
+}
+
+// Convert AzDO data structure to internal Terraform data structure
+func flattenWiki(d *schema.ResourceData, w *wiki.WikiV2) error {
+	if w == nil || w.Id == nil {
+		return fmt.Errorf("Wiki was not returned by the service")
+	}
+
+	d.SetId(w.Id.String())
+	d.Set("name", converter.ToString(w.Name, ""))
+	d.Set("remote_url", converter.ToString(w.RemoteUrl, ""))
+	if w.Type != nil {
+		d.Set("type", string(*w.Type))
+	}
+	if w.ProjectId != nil {
+		d.Set("project_id", w.ProjectId.String())
+	}
+
+	if w.Type != nil && *w.Type == wiki.WikiTypeValues.CodeWiki {
+		if w.RepositoryId != nil {
+			d.Set("repository_id", w.RepositoryId.String())
+		}
+		d.Set("mapped_path", converter.ToString(w.MappedPath, ""))
+		if w.Versions != nil && len(*w.Versions) > 0 {
+			d.Set("version", converter.ToString((*w.Versions)[0].Version, ""))
+		}
+	}
+	return nil
+}
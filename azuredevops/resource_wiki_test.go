@@ -0,0 +1,256 @@
+package azuredevops
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/microsoft/azure-devops-go-api/azuredevops"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/git"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/wiki"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+/**
+ * Begin unit tests
+ */
+
+var testWikiProjectID = uuid.New()
+var testWikiID = uuid.New()
+
+// verifies that the repository settings are required for code wikis and rejected for project wikis
+func TestAzureDevOpsWiki_ValidatesRepositorySettings(t *testing.T) {
+	diffWithConfig := func(config map[string]interface{}) error {
+		config["project_id"] = testWikiProjectID.String()
+		config["name"] = "wiki"
+		_, err := resourceWiki().Diff(nil, terraform.NewResourceConfigRaw(config), nil)
+		return err
+	}
+
+	require.Nil(t, diffWithConfig(map[string]interface{}{}))
+	require.NotNil(t, diffWithConfig(map[string]interface{}{"mapped_path": "/docs"}))
+	require.Nil(t, diffWithConfig(map[string]interface{}{
+		"type":          "codeWiki",
+		"repository_id": uuid.New().String(),
+		"version":       "master",
+		"mapped_path":   "/docs",
+	}))
+	require.NotNil(t, diffWithConfig(map[string]interface{}{
+		"type":          "codeWiki",
+		"repository_id": uuid.New().String(),
+		"mapped_path":   "/docs",
+	}))
+}
+
+// verifies that an existing project wiki is adopted instead of creating a second one
+func TestAzureDevOpsWiki_Create_AdoptsExistingProjectWiki(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	wikiClient := azdosdkmocks.NewMockWikiClient(ctrl)
+	clients := &aggregatedClient{WikiClient: wikiClient, ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceWiki().Schema, map[string]interface{}{
+		"project_id": testWikiProjectID.String(),
+		"name":       "Project.wiki",
+		"type":       "projectWiki",
+	})
+
+	projectID := testWikiProjectID.String()
+	codeWikiID := uuid.New()
+	wikiClient.
+		EXPECT().
+		GetAllWikis(clients.ctx, wiki.GetAllWikisArgs{Project: &projectID}).
+		Return(&[]wiki.WikiV2{
+			{Id: &codeWikiID, Type: &wiki.WikiTypeValues.CodeWiki, Name: converter.String("code")},
+			{Id: &testWikiID, Type: &wiki.WikiTypeValues.ProjectWiki, Name: converter.String("Project.wiki"), ProjectId: &testWikiProjectID, RemoteUrl: converter.String("https://remote")},
+		}, nil).
+		Times(1)
+	wikiClient.EXPECT().CreateWiki(gomock.Any(), gomock.Any()).Times(0)
+	wikiClient.EXPECT().UpdateWiki(gomock.Any(), gomock.Any()).Times(0)
+
+	err := resourceWikiCreate(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, testWikiID.String(), resourceData.Id())
+	require.Equal(t, "https://remote", resourceData.Get("remote_url"))
+}
+
+// verifies that a code wiki is published from the configured repository, branch and folder
+func TestAzureDevOpsWiki_Create_PublishesCodeWiki(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	wikiClient := azdosdkmocks.NewMockWikiClient(ctrl)
+	clients := &aggregatedClient{WikiClient: wikiClient, ctx: context.Background()}
+
+	repositoryID := uuid.New()
+	resourceData := schema.TestResourceDataRaw(t, resourceWiki().Schema, map[string]interface{}{
+		"project_id":    testWikiProjectID.String(),
+		"name":          "docs",
+		"type":          "codeWiki",
+		"repository_id": repositoryID.String(),
+		"version":       "refs/heads/master",
+		"mapped_path":   "/docs",
+	})
+
+	projectID := testWikiProjectID.String()
+	codeWiki := wiki.WikiTypeValues.CodeWiki
+	wikiClient.
+		EXPECT().
+		CreateWiki(clients.ctx, wiki.CreateWikiArgs{
+			WikiCreateParams: &wiki.WikiCreateParametersV2{
+				Name:         converter.String("docs"),
+				ProjectId:    &testWikiProjectID,
+				Type:         &codeWiki,
+				RepositoryId: &repositoryID,
+				MappedPath:   converter.String("/docs"),
+				Version: &git.GitVersionDescriptor{
+					Version:     converter.String("master"),
+					VersionType: &git.GitVersionTypeValues.Branch,
+				},
+			},
+			Project: &projectID,
+		}).
+		Return(&wiki.WikiV2{
+			Id:           &testWikiID,
+			Name:         converter.String("docs"),
+			Type:         &codeWiki,
+			ProjectId:    &testWikiProjectID,
+			RepositoryId: &repositoryID,
+			MappedPath:   converter.String("/docs"),
+			Versions:     &[]git.GitVersionDescriptor{{Version: converter.String("master")}},
+		}, nil).
+		Times(1)
+
+	err := resourceWikiCreate(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, testWikiID.String(), resourceData.Id())
+	require.Equal(t, "master", resourceData.Get("version"))
+}
+
+// verifies that a wiki that no longer exists is removed from the state
+func TestAzureDevOpsWiki_Read_ClearsIDIfNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	wikiClient := azdosdkmocks.NewMockWikiClient(ctrl)
+	clients := &aggregatedClient{WikiClient: wikiClient, ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceWiki().Schema, map[string]interface{}{
+		"project_id": testWikiProjectID.String(),
+		"name":       "Project.wiki",
+	})
+	resourceData.SetId(testWikiID.String())
+
+	notFound := http.StatusNotFound
+	wikiClient.
+		EXPECT().
+		GetWiki(clients.ctx, gomock.Any()).
+		Return(nil, azuredevops.WrappedError{StatusCode: &notFound}).
+		Times(1)
+
+	err := resourceWikiRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "", resourceData.Id())
+}
+
+// verifies that project wikis are only removed from the state, because the service can not delete them
+func TestAzureDevOpsWiki_Delete_DoesNotDeleteProjectWiki(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	wikiClient := azdosdkmocks.NewMockWikiClient(ctrl)
+	clients := &aggregatedClient{WikiClient: wikiClient, ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceWiki().Schema, map[string]interface{}{
+		"project_id": testWikiProjectID.String(),
+		"name":       "Project.wiki",
+	})
+	resourceData.SetId(testWikiID.String())
+
+	wikiClient.EXPECT().DeleteWiki(gomock.Any(), gomock.Any()).Times(0)
+
+	err := resourceWikiDelete(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "", resourceData.Id())
+}
+
+// verifies that errors unpublishing a code wiki are surfaced
+func TestAzureDevOpsWiki_Delete_DoesNotSwallowErrorForCodeWiki(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	wikiClient := azdosdkmocks.NewMockWikiClient(ctrl)
+	clients := &aggregatedClient{WikiClient: wikiClient, ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceWiki().Schema, map[string]interface{}{
+		"project_id":    testWikiProjectID.String(),
+		"name":          "docs",
+		"type":          "codeWiki",
+		"repository_id": uuid.New().String(),
+		"version":       "master",
+		"mapped_path":   "/docs",
+	})
+	resourceData.SetId(testWikiID.String())
+
+	wikiClient.
+		EXPECT().
+		DeleteWiki(clients.ctx, gomock.Any()).
+		Return(nil, errors.New("DeleteWiki() Failed")).
+		Times(1)
+
+	err := resourceWikiDelete(resourceData, clients)
+	require.Contains(t, err.Error(), "DeleteWiki() Failed")
+}
+
+/**
+ * Begin acceptance tests
+ */
+
+// Verifies that the project wiki of a project can be created and renamed
+func TestAccAzureDevOpsWiki_ProjectWiki_CreateAndUpdate(t *testing.T) {
+	projectName := testAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	tfNode := "azuredevops_wiki.wiki"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectWikiResource(projectName, projectName+".wiki"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfNode, "id"),
+					resource.TestCheckResourceAttrSet(tfNode, "remote_url"),
+					resource.TestCheckResourceAttr(tfNode, "type", "projectWiki"),
+				),
+			}, {
+				Config: testAccProjectWikiResource(projectName, projectName+"-renamed.wiki"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "name", projectName+"-renamed.wiki"),
+				),
+			},
+		},
+	})
+}
+
+// HCL describing a project wiki
+func testAccProjectWikiResource(projectName string, wikiName string) string {
+	wikiResource := fmt.Sprintf(`
+resource "azuredevops_wiki" "wiki" {
+	project_id = azuredevops_project.project.id
+	name       = "%s"
+}`, wikiName)
+
+	projectResource := testAccProjectResource(projectName)
+	return fmt.Sprintf("%s\n%s", projectResource, wikiResource)
+}
@@ -0,0 +1,81 @@
+// Package azdoclient sends the requests of the clients in this repository, which cover the APIs of Azure DevOps
+// that the SDK has no client for.
+//
+// The client packages only describe their endpoints, arguments and models. Looking up the client of the
+// organization, encoding the request body and decoding the response are left to this package, so that they
+// work the same way for all clients.
+package azdoclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/google/uuid"
+	"github.com/microsoft/azure-devops-go-api/azuredevops"
+)
+
+// Request describes a request to an endpoint of Azure DevOps, whose URL the SDK looks up by the location ID
+type Request struct {
+	// (required) HTTP method of the request
+	Method string
+	// (required) ID of the location of the endpoint
+	LocationID uuid.UUID
+	// (required) Version of the API of the endpoint
+	APIVersion string
+	// (optional) Values of the route template of the endpoint
+	RouteValues map[string]string
+	// (optional) Query parameters of the request
+	QueryParams url.Values
+	// (optional) Value that is sent as the JSON body of the request
+	Body interface{}
+}
+
+// NewResourceAreaClient gets the client for a resource area of the organization of the connection, for
+// endpoints that are served at the location of the resource area
+func NewResourceAreaClient(ctx context.Context, connection *azuredevops.Connection, resourceAreaID uuid.UUID) (*azuredevops.Client, error) {
+	return connection.GetClientByResourceAreaId(ctx, resourceAreaID)
+}
+
+// NewOrganizationClient gets the client for the organization of the connection, for endpoints whose location
+// is looked up at the organization itself
+func NewOrganizationClient(connection *azuredevops.Connection) *azuredevops.Client {
+	return connection.GetClientByUrl(connection.BaseUrl)
+}
+
+// Send sends the request and unmarshals the response into responseValue unless it is nil
+func Send(ctx context.Context, client *azuredevops.Client, request Request, responseValue interface{}) error {
+	resp, err := send(ctx, client, request)
+	if err != nil || responseValue == nil {
+		return err
+	}
+	return client.UnmarshalBody(resp, responseValue)
+}
+
+// SendCollection sends the request and unmarshals the items of the collection in the response into
+// responseValue unless it is nil
+func SendCollection(ctx context.Context, client *azuredevops.Client, request Request, responseValue interface{}) error {
+	resp, err := send(ctx, client, request)
+	if err != nil || responseValue == nil {
+		return err
+	}
+	return client.UnmarshalCollectionBody(resp, responseValue)
+}
+
+func send(ctx context.Context, client *azuredevops.Client, request Request) (*http.Response, error) {
+	var body io.Reader
+	mediaType := ""
+	if request.Body != nil {
+		marshalled, err := json.Marshal(request.Body)
+		if err != nil {
+			return nil, err
+		}
+		body = bytes.NewReader(marshalled)
+		mediaType = "application/json"
+	}
+
+	return client.Send(ctx, request.Method, request.LocationID, request.APIVersion, request.RouteValues, request.QueryParams, body, mediaType, "application/json", nil)
+}
@@ -0,0 +1,149 @@
+package azdoclient
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/microsoft/azure-devops-go-api/azuredevops"
+	"github.com/stretchr/testify/require"
+)
+
+var testLocationID, _ = uuid.Parse("b5a1dbb8-b4a3-4ba1-b2a3-0c9d1fd41fa6")
+
+// the resource location the SDK looks up before sending a request to the test resource
+const testResourceLocations = `{
+	"count": 1,
+	"value": [{
+		"id": "b5a1dbb8-b4a3-4ba1-b2a3-0c9d1fd41fa6",
+		"area": "test",
+		"resourceName": "items",
+		"routeTemplate": "{project}/_apis/{area}/{resource}/{itemId}",
+		"resourceVersion": 1,
+		"minVersion": "5.0",
+		"maxVersion": "5.1",
+		"releasedVersion": "0.0"
+	}]
+}`
+
+type item struct {
+	Name *string `json:"name,omitempty"`
+}
+
+// records the request that was sent to the test resource and replies with a fixed response
+type fakeService struct {
+	method      string
+	path        string
+	query       string
+	contentType string
+	body        string
+	response    string
+}
+
+func (f *fakeService) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodOptions {
+		w.Write([]byte(testResourceLocations))
+		return
+	}
+
+	body, _ := ioutil.ReadAll(r.Body)
+	f.method = r.Method
+	f.path = r.URL.Path
+	f.query = r.URL.RawQuery
+	f.contentType = r.Header.Get("Content-Type")
+	f.body = string(body)
+	w.Write([]byte(f.response))
+}
+
+func newTestClient(server *httptest.Server) *azuredevops.Client {
+	return NewOrganizationClient(azuredevops.NewPatConnection(server.URL, "pat"))
+}
+
+func TestSend_SendsBodyAndUnmarshalsResponse(t *testing.T) {
+	service := &fakeService{response: `{"name": "updated"}`}
+	server := httptest.NewServer(service)
+	defer server.Close()
+
+	name := "item"
+	var responseValue item
+	err := Send(context.Background(), newTestClient(server), Request{
+		Method:      http.MethodPatch,
+		LocationID:  testLocationID,
+		APIVersion:  "5.1-preview.1",
+		RouteValues: map[string]string{"project": "project", "itemId": "7"},
+		QueryParams: url.Values{"force": []string{"true"}},
+		Body:        &item{Name: &name},
+	}, &responseValue)
+
+	require.Nil(t, err)
+	require.Equal(t, "updated", *responseValue.Name)
+	require.Equal(t, http.MethodPatch, service.method)
+	require.Equal(t, "/project/_apis/test/items/7", service.path)
+	require.Equal(t, "force=true", service.query)
+	require.Contains(t, service.contentType, "application/json")
+	require.JSONEq(t, `{"name": "item"}`, service.body)
+}
+
+func TestSend_SendsNoBodyAndIgnoresResponseIfNotRequested(t *testing.T) {
+	service := &fakeService{response: `not json`}
+	server := httptest.NewServer(service)
+	defer server.Close()
+
+	err := Send(context.Background(), newTestClient(server), Request{
+		Method:      http.MethodDelete,
+		LocationID:  testLocationID,
+		APIVersion:  "5.1-preview.1",
+		RouteValues: map[string]string{"project": "project", "itemId": "7"},
+	}, nil)
+
+	require.Nil(t, err)
+	require.Equal(t, http.MethodDelete, service.method)
+	require.Equal(t, "", service.contentType)
+	require.Equal(t, "", service.body)
+}
+
+func TestSendCollection_UnmarshalsItemsOfCollection(t *testing.T) {
+	service := &fakeService{response: `{"count": 2, "value": [{"name": "first"}, {"name": "second"}]}`}
+	server := httptest.NewServer(service)
+	defer server.Close()
+
+	var responseValue []item
+	err := SendCollection(context.Background(), newTestClient(server), Request{
+		Method:      http.MethodGet,
+		LocationID:  testLocationID,
+		APIVersion:  "5.1-preview.1",
+		RouteValues: map[string]string{"project": "project"},
+	}, &responseValue)
+
+	require.Nil(t, err)
+	require.Len(t, responseValue, 2)
+	require.Equal(t, "second", *responseValue[1].Name)
+	require.Equal(t, "/project/_apis/test/items", service.path)
+}
+
+func TestSend_ReturnsErrorOfService(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodOptions {
+			w.Write([]byte(testResourceLocations))
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"message": "item 7 does not exist"}`))
+	}))
+	defer server.Close()
+
+	var responseValue item
+	err := Send(context.Background(), newTestClient(server), Request{
+		Method:      http.MethodGet,
+		LocationID:  testLocationID,
+		APIVersion:  "5.1-preview.1",
+		RouteValues: map[string]string{"project": "project", "itemId": "7"},
+	}, &responseValue)
+
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "item 7 does not exist")
+}
@@ -0,0 +1,105 @@
+package azdoerror
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops"
+)
+
+// IsNotFound Determines whether an error returned by the Azure DevOps API indicates that the requested
+// resource does not exist. The status code is not always populated by the SDK, which is why the type of
+// the exception reported by the service is checked as well. Besides the ...NotFoundException types, some
+// services report missing objects as ...DoesNotExist...Exception, e.g. ProjectDoesNotExistWithNameException.
+func IsNotFound(err error) bool {
+	wrapped, ok := asWrappedError(err)
+	if !ok {
+		return false
+	}
+
+	if wrapped.StatusCode != nil && *wrapped.StatusCode == http.StatusNotFound {
+		return true
+	}
+	if wrapped.TypeKey == nil {
+		return false
+	}
+	return strings.HasSuffix(*wrapped.TypeKey, "NotFoundException") || strings.Contains(*wrapped.TypeKey, "DoesNotExist")
+}
+
+// IsConflict Determines whether an error returned by the Azure DevOps API indicates that the request conflicts
+// with the current state of the resource, e.g. because a git ref was moved by someone else in the meantime.
+func IsConflict(err error) bool {
+	wrapped, ok := asWrappedError(err)
+	if !ok {
+		return false
+	}
+	if wrapped.StatusCode != nil && *wrapped.StatusCode == http.StatusConflict {
+		return true
+	}
+	return wrapped.TypeKey != nil && strings.HasSuffix(*wrapped.TypeKey, "StaleException")
+}
+
+// IsGitRepositoryNameAlreadyExists Determines whether an error returned by the Azure DevOps API indicates that a git
+// repository could not be created because a repository with the same name already exists. Other conflicts are
+// reported with the same status code, which is why only the type of the exception is checked.
+func IsGitRepositoryNameAlreadyExists(err error) bool {
+	wrapped, ok := asWrappedError(err)
+	if !ok {
+		return false
+	}
+	return wrapped.TypeKey != nil && *wrapped.TypeKey == "GitRepositoryNameAlreadyExistsException"
+}
+
+// RequestIDProperty is the custom property of a WrappedError that holds the ID the service assigned to the
+// failed request. The service does not include it in the error itself, but returns it in the ActivityId header.
+const RequestIDProperty = "ActivityId"
+
+// Wrap Attaches the HTTP status and the ID of the request to the message of an error returned by the Azure DevOps
+// API, so that the error reads "HTTP 403 (request <id>): <message>". The ID is taken from the RequestIDProperty and
+// left out if the property is not set. Other errors, and errors without a status, are returned unchanged. The
+// result is still a WrappedError, so IsNotFound and IsConflict keep working.
+func Wrap(err error) error {
+	wrapped, ok := asWrappedError(err)
+	if !ok || wrapped.StatusCode == nil {
+		return err
+	}
+
+	prefix := fmt.Sprintf("HTTP %d", *wrapped.StatusCode)
+	if requestID := requestID(wrapped); requestID != "" {
+		prefix += fmt.Sprintf(" (request %s)", requestID)
+	}
+
+	message := ""
+	if wrapped.Message != nil {
+		message = *wrapped.Message
+	}
+	if strings.HasPrefix(message, prefix) {
+		return err
+	}
+
+	annotated := *wrapped
+	message = prefix + ": " + message
+	annotated.Message = &message
+	return annotated
+}
+
+func requestID(wrapped *azuredevops.WrappedError) string {
+	if wrapped.CustomProperties != nil {
+		if id, ok := (*wrapped.CustomProperties)[RequestIDProperty].(string); ok && id != "" {
+			return id
+		}
+	}
+	return ""
+}
+
+// The SDK returns wrapped errors both by value and by reference
+func asWrappedError(err error) (*azuredevops.WrappedError, bool) {
+	switch wrapped := err.(type) {
+	case azuredevops.WrappedError:
+		return &wrapped, true
+	case *azuredevops.WrappedError:
+		return wrapped, wrapped != nil
+	}
+	return nil, false
+}
@@ -0,0 +1,95 @@
+package azdoerror
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsNotFound_StatusCode(t *testing.T) {
+	notFound := http.StatusNotFound
+	require.True(t, IsNotFound(&azuredevops.WrappedError{StatusCode: &notFound}))
+	require.True(t, IsNotFound(azuredevops.WrappedError{StatusCode: &notFound}))
+
+	badRequest := http.StatusBadRequest
+	require.False(t, IsNotFound(&azuredevops.WrappedError{StatusCode: &badRequest}))
+}
+
+func TestIsNotFound_TypeKey(t *testing.T) {
+	require.True(t, IsNotFound(azuredevops.WrappedError{TypeKey: converter.String("DefinitionNotFoundException")}))
+	require.True(t, IsNotFound(azuredevops.WrappedError{TypeKey: converter.String("ProjectDoesNotExistWithNameException")}))
+	require.True(t, IsNotFound(azuredevops.WrappedError{TypeKey: converter.String("ProjectDoesNotExistException")}))
+	require.False(t, IsNotFound(azuredevops.WrappedError{TypeKey: converter.String("InvalidArgumentValueException")}))
+}
+
+func TestIsNotFound_OtherErrors(t *testing.T) {
+	require.False(t, IsNotFound(nil))
+	require.False(t, IsNotFound(errors.New("not found")))
+	require.False(t, IsNotFound((*azuredevops.WrappedError)(nil)))
+}
+
+func TestIsConflict_StatusCode(t *testing.T) {
+	conflict := http.StatusConflict
+	require.True(t, IsConflict(&azuredevops.WrappedError{StatusCode: &conflict}))
+	require.True(t, IsConflict(azuredevops.WrappedError{StatusCode: &conflict}))
+
+	notFound := http.StatusNotFound
+	require.False(t, IsConflict(&azuredevops.WrappedError{StatusCode: &notFound}))
+}
+
+func TestIsConflict_TypeKey(t *testing.T) {
+	require.True(t, IsConflict(azuredevops.WrappedError{TypeKey: converter.String("GitReferenceStaleException")}))
+	require.False(t, IsConflict(azuredevops.WrappedError{TypeKey: converter.String("GitItemNotFoundException")}))
+}
+
+func TestIsConflict_OtherErrors(t *testing.T) {
+	require.False(t, IsConflict(nil))
+	require.False(t, IsConflict(errors.New("conflict")))
+}
+
+func TestIsGitRepositoryNameAlreadyExists(t *testing.T) {
+	conflict := http.StatusConflict
+	require.True(t, IsGitRepositoryNameAlreadyExists(&azuredevops.WrappedError{StatusCode: &conflict, TypeKey: converter.String("GitRepositoryNameAlreadyExistsException")}))
+	require.True(t, IsGitRepositoryNameAlreadyExists(azuredevops.WrappedError{TypeKey: converter.String("GitRepositoryNameAlreadyExistsException")}))
+	require.False(t, IsGitRepositoryNameAlreadyExists(&azuredevops.WrappedError{StatusCode: &conflict}))
+	require.False(t, IsGitRepositoryNameAlreadyExists(azuredevops.WrappedError{StatusCode: &conflict, TypeKey: converter.String("GitRefUpdateStaleException")}))
+	require.False(t, IsGitRepositoryNameAlreadyExists(azuredevops.WrappedError{TypeKey: converter.String("GitItemNotFoundException")}))
+	require.False(t, IsGitRepositoryNameAlreadyExists(errors.New("already exists")))
+}
+
+func TestWrap_AttachesStatusAndRequestID(t *testing.T) {
+	forbidden := http.StatusForbidden
+	err := Wrap(&azuredevops.WrappedError{
+		Message:          converter.String("Access denied"),
+		StatusCode:       &forbidden,
+		CustomProperties: &map[string]interface{}{RequestIDProperty: "4f1e7c0a"},
+	})
+	require.Equal(t, "HTTP 403 (request 4f1e7c0a): Access denied", err.Error())
+
+	err = Wrap(azuredevops.WrappedError{Message: converter.String("Access denied"), StatusCode: &forbidden, ExceptionId: converter.String("7")})
+	require.Equal(t, "HTTP 403: Access denied", err.Error())
+
+	err = Wrap(azuredevops.WrappedError{Message: converter.String("Access denied"), StatusCode: &forbidden})
+	require.Equal(t, "HTTP 403: Access denied", err.Error())
+}
+
+func TestWrap_IsIdempotent(t *testing.T) {
+	notFound := http.StatusNotFound
+	err := Wrap(Wrap(azuredevops.WrappedError{Message: converter.String("Not found"), StatusCode: &notFound}))
+	require.Equal(t, "HTTP 404: Not found", err.Error())
+	require.True(t, IsNotFound(err))
+}
+
+func TestWrap_KeepsOtherErrors(t *testing.T) {
+	require.Nil(t, Wrap(nil))
+
+	err := errors.New("connection refused")
+	require.Equal(t, err, Wrap(err))
+
+	withoutStatus := azuredevops.WrappedError{Message: converter.String("Not found")}
+	require.Equal(t, withoutStatus, Wrap(withoutStatus))
+}
@@ -0,0 +1,94 @@
+package azdoerror
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops"
+)
+
+// RoundTripper is an http.RoundTripper that attaches the HTTP status and the ID of the request to the errors
+// returned by the Azure DevOps API. The SDK decodes errors from the body of the response but neither keeps the
+// status of JSON errors nor the ID of the request, so the body is rewritten before the SDK decodes it. As all
+// clients send their requests through it, every error surfaced by any resource carries both.
+type RoundTripper struct {
+	// Next is the RoundTripper used to send the requests
+	Next http.RoundTripper
+}
+
+// NewRoundTripper creates a RoundTripper that wraps next
+func NewRoundTripper(next http.RoundTripper) *RoundTripper {
+	return &RoundTripper{Next: next}
+}
+
+// RoundTrip implements http.RoundTripper
+func (rt *RoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := rt.Next.RoundTrip(req)
+	if err != nil || resp.StatusCode < http.StatusBadRequest || resp.Body == nil {
+		return resp, err
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	if annotated, ok := annotateBody(resp, body); ok {
+		body = annotated
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+	resp.ContentLength = int64(len(body))
+	resp.Header.Set("Content-Length", strconv.Itoa(len(body)))
+	return resp, nil
+}
+
+// Returns the body with the status and the request ID attached to the error it describes. Bodies that the SDK
+// cannot decode into a WrappedError, e.g. HTML pages of a proxy, are not changed.
+func annotateBody(resp *http.Response, body []byte) ([]byte, bool) {
+	var wrapped azuredevops.WrappedError
+	contentType := resp.Header.Get("Content-Type")
+	isText := strings.Contains(contentType, azuredevops.MediaTypeTextPlain)
+	switch {
+	case len(bytes.TrimSpace(body)) == 0:
+		// the SDK reports errors without a body by their status, which is kept as the message
+		message := "Request returned status: " + resp.Status
+		wrapped.Message = &message
+	case isText:
+		message := string(body)
+		wrapped.Message = &message
+	case strings.Contains(contentType, azuredevops.MediaTypeApplicationJson):
+		err := json.Unmarshal(bytes.TrimPrefix(body, []byte("\xef\xbb\xbf")), &wrapped)
+		if err != nil || wrapped.Message == nil {
+			return nil, false
+		}
+	default:
+		return nil, false
+	}
+
+	statusCode := resp.StatusCode
+	wrapped.StatusCode = &statusCode
+	if id := resp.Header.Get("ActivityId"); id != "" {
+		if wrapped.CustomProperties == nil {
+			wrapped.CustomProperties = &map[string]interface{}{}
+		}
+		(*wrapped.CustomProperties)[RequestIDProperty] = id
+	}
+	annotated := Wrap(wrapped).(azuredevops.WrappedError)
+
+	if isText {
+		return []byte(*annotated.Message), true
+	}
+
+	// the status has no JSON name, so it is encoded and decoded by its field name
+	encoded, err := json.Marshal(annotated)
+	if err != nil {
+		return nil, false
+	}
+	resp.Header.Set("Content-Type", azuredevops.MediaTypeApplicationJson)
+	return encoded, true
+}
@@ -0,0 +1,64 @@
+package azdoerror
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops"
+	"github.com/stretchr/testify/require"
+)
+
+// Sends a request through the RoundTripper to a server that answers with the status, content type and body
+func sendThroughRoundTripper(t *testing.T, status int, contentType string, body string) *http.Response {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("ActivityId", "4f1e7c0a")
+		if contentType != "" {
+			w.Header().Set("Content-Type", contentType)
+		}
+		w.WriteHeader(status)
+		w.Write([]byte(body))
+	}))
+	defer server.Close()
+
+	req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+	require.Nil(t, err)
+	resp, err := NewRoundTripper(http.DefaultTransport).RoundTrip(req)
+	require.Nil(t, err)
+	return resp
+}
+
+// Returns the error the SDK decodes from the response
+func unwrapError(resp *http.Response) error {
+	return (&azuredevops.Client{}).UnwrapError(resp)
+}
+
+func TestRoundTripper_AnnotatesJSONErrors(t *testing.T) {
+	err := unwrapError(sendThroughRoundTripper(t, http.StatusNotFound, "application/json; charset=utf-8",
+		`{"$id":"1","message":"The project does not exist.","typeKey":"ProjectDoesNotExistException"}`))
+
+	require.Equal(t, "HTTP 404 (request 4f1e7c0a): The project does not exist.", err.Error())
+	// the SDK does not keep the status of JSON errors by itself
+	require.True(t, IsNotFound(err))
+}
+
+func TestRoundTripper_AnnotatesTextErrors(t *testing.T) {
+	err := unwrapError(sendThroughRoundTripper(t, http.StatusForbidden, "text/plain", "Access denied"))
+	require.Equal(t, "HTTP 403 (request 4f1e7c0a): Access denied", err.Error())
+}
+
+func TestRoundTripper_AnnotatesErrorsWithoutBody(t *testing.T) {
+	err := unwrapError(sendThroughRoundTripper(t, http.StatusUnauthorized, "", ""))
+	require.Equal(t, "HTTP 401 (request 4f1e7c0a): Request returned status: 401 Unauthorized", err.Error())
+}
+
+func TestRoundTripper_KeepsOtherResponses(t *testing.T) {
+	resp := sendThroughRoundTripper(t, http.StatusOK, "application/json", `{"message":"not an error"}`)
+	body, _ := ioutil.ReadAll(resp.Body)
+	require.Equal(t, `{"message":"not an error"}`, string(body))
+
+	resp = sendThroughRoundTripper(t, http.StatusBadGateway, "text/html", "<html>Bad Gateway</html>")
+	body, _ = ioutil.ReadAll(resp.Body)
+	require.Equal(t, "<html>Bad Gateway</html>", string(body))
+}
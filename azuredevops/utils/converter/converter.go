@@ -23,3 +23,21 @@ func ToString(value *string, defaultValue string) string {
 
 	return defaultValue
 }
+
+// ToBool Given a pointer return its value, or a default value of the pointer is nil
+func ToBool(value *bool, defaultValue bool) bool {
+	if value != nil {
+		return *value
+	}
+
+	return defaultValue
+}
+
+// ToInt Given a pointer return its value, or a default value of the pointer is nil
+func ToInt(value *int, defaultValue int) int {
+	if value != nil {
+		return *value
+	}
+
+	return defaultValue
+}
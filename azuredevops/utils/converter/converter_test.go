@@ -35,3 +35,21 @@ func TestBoolFalse(t *testing.T) {
 		t.Errorf("The pointer returned references a different value")
 	}
 }
+
+func TestToBool(t *testing.T) {
+	if !ToBool(Bool(true), false) {
+		t.Errorf("The value referenced by the pointer was not returned")
+	}
+	if !ToBool(nil, true) {
+		t.Errorf("The default value was not returned for a nil pointer")
+	}
+}
+
+func TestToInt(t *testing.T) {
+	if ToInt(Int(3), 1) != 3 {
+		t.Errorf("The value referenced by the pointer was not returned")
+	}
+	if ToInt(nil, 1) != 1 {
+		t.Errorf("The default value was not returned for a nil pointer")
+	}
+}
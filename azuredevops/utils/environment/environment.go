@@ -0,0 +1,329 @@
+// Package environment is a client for the environments of the Azure DevOps distributed task service and the
+// resources within them.
+//
+// The taskagent client of the SDK declares the models of environments, which are the deployment targets of
+// YAML pipelines, but none of the operations on them. This client sends the requests for these models to
+// the environments endpoint of the same resource area.
+package environment
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/microsoft/azure-devops-go-api/azuredevops"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/azdoclient"
+)
+
+var environmentsLocationID, _ = uuid.Parse("8572b1fc-2482-47fa-8f74-7e3ed53ee54b")
+var kubernetesLocationID, _ = uuid.Parse("73fba52f-15ab-42b3-a538-ce67a9223a04")
+
+const apiVersion = "5.1-preview.1"
+
+// KubernetesResource is a namespace of a Kubernetes cluster within an environment. Unlike the model of the
+// taskagent client, it includes the tags of the resource.
+type KubernetesResource struct {
+	ClusterName          *string                            `json:"clusterName,omitempty"`
+	EnvironmentReference *taskagent.EnvironmentReference    `json:"environmentReference,omitempty"`
+	Id                   *int                               `json:"id,omitempty"`
+	Name                 *string                            `json:"name,omitempty"`
+	Namespace            *string                            `json:"namespace,omitempty"`
+	ServiceEndpointId    *uuid.UUID                         `json:"serviceEndpointId,omitempty"`
+	Tags                 *[]string                          `json:"tags,omitempty"`
+	Type                 *taskagent.EnvironmentResourceType `json:"type,omitempty"`
+}
+
+// KubernetesResourceCreateParameters describe a namespace that is added to an environment through an existing
+// Kubernetes service endpoint
+type KubernetesResourceCreateParameters struct {
+	ClusterName       *string    `json:"clusterName,omitempty"`
+	Name              *string    `json:"name,omitempty"`
+	Namespace         *string    `json:"namespace,omitempty"`
+	ServiceEndpointId *uuid.UUID `json:"serviceEndpointId,omitempty"`
+	Tags              *[]string  `json:"tags,omitempty"`
+}
+
+// Client manages the environments of a project
+type Client interface {
+	AddEnvironment(context.Context, AddEnvironmentArgs) (*taskagent.EnvironmentInstance, error)
+	GetEnvironmentById(context.Context, GetEnvironmentByIdArgs) (*taskagent.EnvironmentInstance, error)
+	GetEnvironments(context.Context, GetEnvironmentsArgs) (*[]taskagent.EnvironmentInstance, error)
+	UpdateEnvironment(context.Context, UpdateEnvironmentArgs) (*taskagent.EnvironmentInstance, error)
+	DeleteEnvironment(context.Context, DeleteEnvironmentArgs) error
+	AddKubernetesResource(context.Context, AddKubernetesResourceArgs) (*KubernetesResource, error)
+	GetKubernetesResource(context.Context, GetKubernetesResourceArgs) (*KubernetesResource, error)
+	DeleteKubernetesResource(context.Context, DeleteKubernetesResourceArgs) error
+}
+
+// ClientImpl sends the requests through the client of the task agent resource area
+type ClientImpl struct {
+	Client azuredevops.Client
+}
+
+// NewClient creates a client for the organization of the connection
+func NewClient(ctx context.Context, connection *azuredevops.Connection) (Client, error) {
+	client, err := azdoclient.NewResourceAreaClient(ctx, connection, taskagent.ResourceAreaId)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientImpl{
+		Client: *client,
+	}, nil
+}
+
+// AddEnvironmentArgs are the arguments for the AddEnvironment function
+type AddEnvironmentArgs struct {
+	// (required) Environment to create.
+	EnvironmentCreateParameter *taskagent.EnvironmentCreateParameter
+	// (required) Project ID or project name
+	Project *string
+}
+
+// AddEnvironment creates an environment
+func (client *ClientImpl) AddEnvironment(ctx context.Context, args AddEnvironmentArgs) (*taskagent.EnvironmentInstance, error) {
+	if args.EnvironmentCreateParameter == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.EnvironmentCreateParameter"}
+	}
+	if args.Project == nil || *args.Project == "" {
+		return nil, &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.Project"}
+	}
+	routeValues := map[string]string{"project": *args.Project}
+
+	var responseValue taskagent.EnvironmentInstance
+	err := azdoclient.Send(ctx, &client.Client, azdoclient.Request{
+		Method:      http.MethodPost,
+		LocationID:  environmentsLocationID,
+		APIVersion:  apiVersion,
+		RouteValues: routeValues,
+		Body:        args.EnvironmentCreateParameter,
+	}, &responseValue)
+	return &responseValue, err
+}
+
+// GetEnvironmentByIdArgs are the arguments for the GetEnvironmentById function
+type GetEnvironmentByIdArgs struct {
+	// (required) Project ID or project name
+	Project *string
+	// (required) ID of the environment.
+	EnvironmentId *int
+}
+
+// GetEnvironmentById gets an environment
+func (client *ClientImpl) GetEnvironmentById(ctx context.Context, args GetEnvironmentByIdArgs) (*taskagent.EnvironmentInstance, error) {
+	if args.Project == nil || *args.Project == "" {
+		return nil, &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.Project"}
+	}
+	if args.EnvironmentId == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.EnvironmentId"}
+	}
+	routeValues := map[string]string{"project": *args.Project, "environmentId": strconv.Itoa(*args.EnvironmentId)}
+
+	var responseValue taskagent.EnvironmentInstance
+	err := azdoclient.Send(ctx, &client.Client, azdoclient.Request{
+		Method:      http.MethodGet,
+		LocationID:  environmentsLocationID,
+		APIVersion:  apiVersion,
+		RouteValues: routeValues,
+	}, &responseValue)
+	return &responseValue, err
+}
+
+// GetEnvironmentsArgs are the arguments for the GetEnvironments function
+type GetEnvironmentsArgs struct {
+	// (required) Project ID or project name
+	Project *string
+	// (optional) Only return environments with this name
+	Name *string
+}
+
+// GetEnvironments lists the environments of a project
+func (client *ClientImpl) GetEnvironments(ctx context.Context, args GetEnvironmentsArgs) (*[]taskagent.EnvironmentInstance, error) {
+	if args.Project == nil || *args.Project == "" {
+		return nil, &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.Project"}
+	}
+	routeValues := map[string]string{"project": *args.Project}
+	queryParams := url.Values{}
+	if args.Name != nil {
+		queryParams.Add("name", *args.Name)
+	}
+
+	var responseValue []taskagent.EnvironmentInstance
+	err := azdoclient.SendCollection(ctx, &client.Client, azdoclient.Request{
+		Method:      http.MethodGet,
+		LocationID:  environmentsLocationID,
+		APIVersion:  apiVersion,
+		RouteValues: routeValues,
+		QueryParams: queryParams,
+	}, &responseValue)
+	if err != nil {
+		return nil, err
+	}
+	return &responseValue, nil
+}
+
+// UpdateEnvironmentArgs are the arguments for the UpdateEnvironment function
+type UpdateEnvironmentArgs struct {
+	// (required) The properties of the environment to update.
+	EnvironmentUpdateParameter *taskagent.EnvironmentUpdateParameter
+	// (required) Project ID or project name
+	Project *string
+	// (required) ID of the environment.
+	EnvironmentId *int
+}
+
+// UpdateEnvironment updates the name and description of an environment
+func (client *ClientImpl) UpdateEnvironment(ctx context.Context, args UpdateEnvironmentArgs) (*taskagent.EnvironmentInstance, error) {
+	if args.EnvironmentUpdateParameter == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.EnvironmentUpdateParameter"}
+	}
+	if args.Project == nil || *args.Project == "" {
+		return nil, &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.Project"}
+	}
+	if args.EnvironmentId == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.EnvironmentId"}
+	}
+	routeValues := map[string]string{"project": *args.Project, "environmentId": strconv.Itoa(*args.EnvironmentId)}
+
+	var responseValue taskagent.EnvironmentInstance
+	err := azdoclient.Send(ctx, &client.Client, azdoclient.Request{
+		Method:      http.MethodPatch,
+		LocationID:  environmentsLocationID,
+		APIVersion:  apiVersion,
+		RouteValues: routeValues,
+		Body:        args.EnvironmentUpdateParameter,
+	}, &responseValue)
+	return &responseValue, err
+}
+
+// DeleteEnvironmentArgs are the arguments for the DeleteEnvironment function
+type DeleteEnvironmentArgs struct {
+	// (required) Project ID or project name
+	Project *string
+	// (required) ID of the environment.
+	EnvironmentId *int
+}
+
+// DeleteEnvironment deletes an environment together with the resources within it
+func (client *ClientImpl) DeleteEnvironment(ctx context.Context, args DeleteEnvironmentArgs) error {
+	if args.Project == nil || *args.Project == "" {
+		return &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.Project"}
+	}
+	if args.EnvironmentId == nil {
+		return &azuredevops.ArgumentNilError{ArgumentName: "args.EnvironmentId"}
+	}
+	routeValues := map[string]string{"project": *args.Project, "environmentId": strconv.Itoa(*args.EnvironmentId)}
+
+	return azdoclient.Send(ctx, &client.Client, azdoclient.Request{
+		Method:      http.MethodDelete,
+		LocationID:  environmentsLocationID,
+		APIVersion:  apiVersion,
+		RouteValues: routeValues,
+	}, nil)
+}
+
+// AddKubernetesResourceArgs are the arguments for the AddKubernetesResource function
+type AddKubernetesResourceArgs struct {
+	// (required) The namespace to add.
+	CreateParameters *KubernetesResourceCreateParameters
+	// (required) Project ID or project name
+	Project *string
+	// (required) ID of the environment.
+	EnvironmentId *int
+}
+
+// AddKubernetesResource adds a namespace of a Kubernetes cluster to an environment
+func (client *ClientImpl) AddKubernetesResource(ctx context.Context, args AddKubernetesResourceArgs) (*KubernetesResource, error) {
+	if args.CreateParameters == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.CreateParameters"}
+	}
+	if args.Project == nil || *args.Project == "" {
+		return nil, &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.Project"}
+	}
+	if args.EnvironmentId == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.EnvironmentId"}
+	}
+	routeValues := map[string]string{"project": *args.Project, "environmentId": strconv.Itoa(*args.EnvironmentId)}
+
+	var responseValue KubernetesResource
+	err := azdoclient.Send(ctx, &client.Client, azdoclient.Request{
+		Method:      http.MethodPost,
+		LocationID:  kubernetesLocationID,
+		APIVersion:  apiVersion,
+		RouteValues: routeValues,
+		Body:        args.CreateParameters,
+	}, &responseValue)
+	return &responseValue, err
+}
+
+// GetKubernetesResourceArgs are the arguments for the GetKubernetesResource function
+type GetKubernetesResourceArgs struct {
+	// (required) Project ID or project name
+	Project *string
+	// (required) ID of the environment.
+	EnvironmentId *int
+	// (required) ID of the Kubernetes resource.
+	ResourceId *int
+}
+
+// GetKubernetesResource gets a namespace of a Kubernetes cluster within an environment
+func (client *ClientImpl) GetKubernetesResource(ctx context.Context, args GetKubernetesResourceArgs) (*KubernetesResource, error) {
+	routeValues, err := kubernetesResourceRouteValues(args.Project, args.EnvironmentId, args.ResourceId)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseValue KubernetesResource
+	err = azdoclient.Send(ctx, &client.Client, azdoclient.Request{
+		Method:      http.MethodGet,
+		LocationID:  kubernetesLocationID,
+		APIVersion:  apiVersion,
+		RouteValues: routeValues,
+	}, &responseValue)
+	return &responseValue, err
+}
+
+// DeleteKubernetesResourceArgs are the arguments for the DeleteKubernetesResource function
+type DeleteKubernetesResourceArgs struct {
+	// (required) Project ID or project name
+	Project *string
+	// (required) ID of the environment.
+	EnvironmentId *int
+	// (required) ID of the Kubernetes resource.
+	ResourceId *int
+}
+
+// DeleteKubernetesResource removes a namespace of a Kubernetes cluster from an environment. The environment
+// and the service endpoint of the namespace are kept.
+func (client *ClientImpl) DeleteKubernetesResource(ctx context.Context, args DeleteKubernetesResourceArgs) error {
+	routeValues, err := kubernetesResourceRouteValues(args.Project, args.EnvironmentId, args.ResourceId)
+	if err != nil {
+		return err
+	}
+
+	return azdoclient.Send(ctx, &client.Client, azdoclient.Request{
+		Method:      http.MethodDelete,
+		LocationID:  kubernetesLocationID,
+		APIVersion:  apiVersion,
+		RouteValues: routeValues,
+	}, nil)
+}
+
+func kubernetesResourceRouteValues(project *string, environmentID *int, resourceID *int) (map[string]string, error) {
+	if project == nil || *project == "" {
+		return nil, &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.Project"}
+	}
+	if environmentID == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.EnvironmentId"}
+	}
+	if resourceID == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.ResourceId"}
+	}
+	return map[string]string{
+		"project":       *project,
+		"environmentId": strconv.Itoa(*environmentID),
+		"resourceId":    strconv.Itoa(*resourceID),
+	}, nil
+}
@@ -0,0 +1,238 @@
+package environment
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/microsoft/azure-devops-go-api/azuredevops"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/taskagent"
+	"github.com/stretchr/testify/require"
+)
+
+// the resource locations the SDK looks up before sending a request to a resource
+const testResourceLocations = `{
+	"count": 2,
+	"value": [{
+		"id": "8572b1fc-2482-47fa-8f74-7e3ed53ee54b",
+		"area": "distributedtask",
+		"resourceName": "environments",
+		"routeTemplate": "{project}/_apis/{area}/{resource}/{environmentId}",
+		"resourceVersion": 1,
+		"minVersion": "5.0",
+		"maxVersion": "5.1",
+		"releasedVersion": "0.0"
+	}, {
+		"id": "73fba52f-15ab-42b3-a538-ce67a9223a04",
+		"area": "distributedtask",
+		"resourceName": "kubernetes",
+		"routeTemplate": "{project}/_apis/{area}/environments/{environmentId}/providers/{resource}/{resourceId}",
+		"resourceVersion": 1,
+		"minVersion": "5.0",
+		"maxVersion": "5.1",
+		"releasedVersion": "0.0"
+	}]
+}`
+
+// records the request that was sent to the environments endpoint and replies with a fixed response
+type fakeService struct {
+	method   string
+	path     string
+	query    string
+	body     string
+	response string
+}
+
+func (f *fakeService) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodOptions {
+		w.Write([]byte(testResourceLocations))
+		return
+	}
+
+	body, _ := ioutil.ReadAll(r.Body)
+	f.method = r.Method
+	f.path = r.URL.Path
+	f.query = r.URL.RawQuery
+	f.body = string(body)
+	w.Write([]byte(f.response))
+}
+
+func newTestClient(server *httptest.Server) *ClientImpl {
+	connection := azuredevops.NewPatConnection(server.URL, "pat")
+	return &ClientImpl{Client: *azuredevops.NewClient(connection, server.URL)}
+}
+
+func TestClient_AddEnvironment_SendsParameters(t *testing.T) {
+	service := &fakeService{response: `{"id": 7, "name": "production"}`}
+	server := httptest.NewServer(service)
+	defer server.Close()
+	client := newTestClient(server)
+
+	project := "project"
+	name := "production"
+	description := "Production clusters"
+	environment, err := client.AddEnvironment(context.Background(), AddEnvironmentArgs{
+		Project:                    &project,
+		EnvironmentCreateParameter: &taskagent.EnvironmentCreateParameter{Name: &name, Description: &description},
+	})
+
+	require.Nil(t, err)
+	require.Equal(t, 7, *environment.Id)
+	require.Equal(t, http.MethodPost, service.method)
+	require.Equal(t, "/project/_apis/distributedtask/environments", service.path)
+	require.JSONEq(t, `{"name": "production", "description": "Production clusters"}`, service.body)
+}
+
+func TestClient_GetEnvironments_FiltersByName(t *testing.T) {
+	service := &fakeService{response: `{"count": 1, "value": [{"id": 7, "name": "production"}]}`}
+	server := httptest.NewServer(service)
+	defer server.Close()
+	client := newTestClient(server)
+
+	project := "project"
+	name := "production"
+	environments, err := client.GetEnvironments(context.Background(), GetEnvironmentsArgs{Project: &project, Name: &name})
+
+	require.Nil(t, err)
+	require.Len(t, *environments, 1)
+	require.Equal(t, 7, *(*environments)[0].Id)
+	require.Equal(t, http.MethodGet, service.method)
+	require.Equal(t, "/project/_apis/distributedtask/environments", service.path)
+	require.Equal(t, "name=production", service.query)
+}
+
+func TestClient_UpdateEnvironment_PatchesEnvironment(t *testing.T) {
+	service := &fakeService{response: `{"id": 7, "name": "staging"}`}
+	server := httptest.NewServer(service)
+	defer server.Close()
+	client := newTestClient(server)
+
+	project := "project"
+	environmentID := 7
+	name := "staging"
+	environment, err := client.UpdateEnvironment(context.Background(), UpdateEnvironmentArgs{
+		Project:                    &project,
+		EnvironmentId:              &environmentID,
+		EnvironmentUpdateParameter: &taskagent.EnvironmentUpdateParameter{Name: &name},
+	})
+
+	require.Nil(t, err)
+	require.Equal(t, "staging", *environment.Name)
+	require.Equal(t, http.MethodPatch, service.method)
+	require.Equal(t, "/project/_apis/distributedtask/environments/7", service.path)
+	require.JSONEq(t, `{"name": "staging"}`, service.body)
+}
+
+func TestClient_DeleteEnvironment_SendsDelete(t *testing.T) {
+	service := &fakeService{}
+	server := httptest.NewServer(service)
+	defer server.Close()
+	client := newTestClient(server)
+
+	project := "project"
+	environmentID := 7
+	err := client.DeleteEnvironment(context.Background(), DeleteEnvironmentArgs{Project: &project, EnvironmentId: &environmentID})
+
+	require.Nil(t, err)
+	require.Equal(t, http.MethodDelete, service.method)
+	require.Equal(t, "/project/_apis/distributedtask/environments/7", service.path)
+}
+
+func TestClient_AddKubernetesResource_SendsParameters(t *testing.T) {
+	service := &fakeService{response: `{"id": 3, "namespace": "web", "tags": ["gitops"]}`}
+	server := httptest.NewServer(service)
+	defer server.Close()
+	client := newTestClient(server)
+
+	project := "project"
+	environmentID := 7
+	namespace := "web"
+	serviceEndpointID := uuid.MustParse("d3a4f2b1-5f61-4b54-b2a1-6b8d4c6a9a10")
+	resource, err := client.AddKubernetesResource(context.Background(), AddKubernetesResourceArgs{
+		Project:       &project,
+		EnvironmentId: &environmentID,
+		CreateParameters: &KubernetesResourceCreateParameters{
+			Name:              &namespace,
+			Namespace:         &namespace,
+			ServiceEndpointId: &serviceEndpointID,
+			Tags:              &[]string{"gitops"},
+		},
+	})
+
+	require.Nil(t, err)
+	require.Equal(t, 3, *resource.Id)
+	require.Equal(t, []string{"gitops"}, *resource.Tags)
+	require.Equal(t, http.MethodPost, service.method)
+	require.Equal(t, "/project/_apis/distributedtask/environments/7/providers/kubernetes", service.path)
+	require.JSONEq(t, `{
+		"name": "web",
+		"namespace": "web",
+		"serviceEndpointId": "d3a4f2b1-5f61-4b54-b2a1-6b8d4c6a9a10",
+		"tags": ["gitops"]
+	}`, service.body)
+}
+
+func TestClient_GetKubernetesResource_GetsResource(t *testing.T) {
+	service := &fakeService{response: `{"id": 3, "namespace": "web", "clusterName": "aks"}`}
+	server := httptest.NewServer(service)
+	defer server.Close()
+	client := newTestClient(server)
+
+	project := "project"
+	environmentID := 7
+	resourceID := 3
+	resource, err := client.GetKubernetesResource(context.Background(), GetKubernetesResourceArgs{
+		Project:       &project,
+		EnvironmentId: &environmentID,
+		ResourceId:    &resourceID,
+	})
+
+	require.Nil(t, err)
+	require.Equal(t, "aks", *resource.ClusterName)
+	require.Equal(t, http.MethodGet, service.method)
+	require.Equal(t, "/project/_apis/distributedtask/environments/7/providers/kubernetes/3", service.path)
+}
+
+func TestClient_DeleteKubernetesResource_SendsDelete(t *testing.T) {
+	service := &fakeService{}
+	server := httptest.NewServer(service)
+	defer server.Close()
+	client := newTestClient(server)
+
+	project := "project"
+	environmentID := 7
+	resourceID := 3
+	err := client.DeleteKubernetesResource(context.Background(), DeleteKubernetesResourceArgs{
+		Project:       &project,
+		EnvironmentId: &environmentID,
+		ResourceId:    &resourceID,
+	})
+
+	require.Nil(t, err)
+	require.Equal(t, http.MethodDelete, service.method)
+	require.Equal(t, "/project/_apis/distributedtask/environments/7/providers/kubernetes/3", service.path)
+}
+
+func TestClient_RequiresArguments(t *testing.T) {
+	client := &ClientImpl{}
+	project := "project"
+
+	_, err := client.AddEnvironment(context.Background(), AddEnvironmentArgs{Project: &project})
+	require.NotNil(t, err)
+	_, err = client.GetEnvironmentById(context.Background(), GetEnvironmentByIdArgs{Project: &project})
+	require.NotNil(t, err)
+	_, err = client.GetEnvironments(context.Background(), GetEnvironmentsArgs{})
+	require.NotNil(t, err)
+	err = client.DeleteEnvironment(context.Background(), DeleteEnvironmentArgs{Project: &project})
+	require.NotNil(t, err)
+	environmentID := 7
+	_, err = client.AddKubernetesResource(context.Background(), AddKubernetesResourceArgs{Project: &project, EnvironmentId: &environmentID})
+	require.NotNil(t, err)
+	_, err = client.GetKubernetesResource(context.Background(), GetKubernetesResourceArgs{Project: &project, EnvironmentId: &environmentID})
+	require.NotNil(t, err)
+	err = client.DeleteKubernetesResource(context.Background(), DeleteKubernetesResourceArgs{Project: &project})
+	require.NotNil(t, err)
+}
@@ -0,0 +1,94 @@
+// Package feedrecyclebin is a client for the recycle bin of the feeds of Azure Artifacts.
+//
+// Deleting a feed through the feed client of the SDK only moves it to the recycle bin of its scope, where it
+// keeps its name reserved until it is purged. The SDK has no operations on the recycle bin, so this client
+// sends these requests to the recycle bin endpoint of the same resource area.
+package feedrecyclebin
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/microsoft/azure-devops-go-api/azuredevops"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/feed"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/azdoclient"
+)
+
+var recycleBinLocationID, _ = uuid.Parse("0cee643d-beb9-41f8-9368-3ada763a8344")
+
+const apiVersion = "5.1-preview.1"
+
+// Client manages the feeds within the recycle bin of an organization or project
+type Client interface {
+	GetFeedsFromRecycleBin(context.Context, GetFeedsFromRecycleBinArgs) (*[]feed.Feed, error)
+	PermanentDeleteFeed(context.Context, PermanentDeleteFeedArgs) error
+}
+
+// ClientImpl sends the requests through the client of the packaging resource area
+type ClientImpl struct {
+	Client azuredevops.Client
+}
+
+// NewClient creates a client for the organization of the connection
+func NewClient(ctx context.Context, connection *azuredevops.Connection) (Client, error) {
+	client, err := azdoclient.NewResourceAreaClient(ctx, connection, feed.ResourceAreaId)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientImpl{
+		Client: *client,
+	}, nil
+}
+
+// GetFeedsFromRecycleBinArgs are the arguments for the GetFeedsFromRecycleBin function
+type GetFeedsFromRecycleBinArgs struct {
+	// (optional) Project ID or project name. The recycle bin of the organization is used if it is not set.
+	Project *string
+}
+
+// GetFeedsFromRecycleBin lists the deleted feeds that have not been purged yet
+func (client *ClientImpl) GetFeedsFromRecycleBin(ctx context.Context, args GetFeedsFromRecycleBinArgs) (*[]feed.Feed, error) {
+	routeValues := map[string]string{}
+	if args.Project != nil && *args.Project != "" {
+		routeValues["project"] = *args.Project
+	}
+
+	var responseValue []feed.Feed
+	err := azdoclient.SendCollection(ctx, &client.Client, azdoclient.Request{
+		Method:      http.MethodGet,
+		LocationID:  recycleBinLocationID,
+		APIVersion:  apiVersion,
+		RouteValues: routeValues,
+	}, &responseValue)
+	if err != nil {
+		return nil, err
+	}
+	return &responseValue, nil
+}
+
+// PermanentDeleteFeedArgs are the arguments for the PermanentDeleteFeed function
+type PermanentDeleteFeedArgs struct {
+	// (required) Name or ID of the feed.
+	FeedId *string
+	// (optional) Project ID or project name. The recycle bin of the organization is used if it is not set.
+	Project *string
+}
+
+// PermanentDeleteFeed purges a deleted feed and all of its packages from the recycle bin. This cannot be undone.
+func (client *ClientImpl) PermanentDeleteFeed(ctx context.Context, args PermanentDeleteFeedArgs) error {
+	if args.FeedId == nil || *args.FeedId == "" {
+		return &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.FeedId"}
+	}
+	routeValues := map[string]string{"feedId": *args.FeedId}
+	if args.Project != nil && *args.Project != "" {
+		routeValues["project"] = *args.Project
+	}
+
+	return azdoclient.Send(ctx, &client.Client, azdoclient.Request{
+		Method:      http.MethodDelete,
+		LocationID:  recycleBinLocationID,
+		APIVersion:  apiVersion,
+		RouteValues: routeValues,
+	}, nil)
+}
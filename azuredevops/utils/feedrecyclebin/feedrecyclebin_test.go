@@ -0,0 +1,84 @@
+package feedrecyclebin
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops"
+	"github.com/stretchr/testify/require"
+)
+
+// the resource locations the SDK looks up before sending a request to a resource
+const testResourceLocations = `{
+	"count": 1,
+	"value": [{
+		"id": "0cee643d-beb9-41f8-9368-3ada763a8344",
+		"area": "Packaging",
+		"resourceName": "FeedRecycleBin",
+		"routeTemplate": "{project}/_apis/{area}/{resource}/{feedId}",
+		"resourceVersion": 1,
+		"minVersion": "5.0",
+		"maxVersion": "5.1",
+		"releasedVersion": "0.0"
+	}]
+}`
+
+// records the request that was sent to the recycle bin endpoint and replies with a fixed response
+type fakeService struct {
+	method   string
+	path     string
+	response string
+}
+
+func (f *fakeService) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodOptions {
+		w.Write([]byte(testResourceLocations))
+		return
+	}
+
+	f.method = r.Method
+	f.path = r.URL.Path
+	w.Write([]byte(f.response))
+}
+
+func newTestClient(server *httptest.Server) *ClientImpl {
+	connection := azuredevops.NewPatConnection(server.URL, "pat")
+	return &ClientImpl{Client: *azuredevops.NewClient(connection, server.URL)}
+}
+
+func TestClient_GetFeedsFromRecycleBin_ListsFeedsOfProject(t *testing.T) {
+	service := &fakeService{response: `{"count": 1, "value": [{"id": "d1d4b9d4-8f2a-4b86-9e0c-3f5f0d2f4b6a", "name": "packages"}]}`}
+	server := httptest.NewServer(service)
+	defer server.Close()
+	client := newTestClient(server)
+
+	project := "project"
+	feeds, err := client.GetFeedsFromRecycleBin(context.Background(), GetFeedsFromRecycleBinArgs{Project: &project})
+
+	require.Nil(t, err)
+	require.Len(t, *feeds, 1)
+	require.Equal(t, "packages", *(*feeds)[0].Name)
+	require.Equal(t, http.MethodGet, service.method)
+	require.Equal(t, "/project/_apis/Packaging/FeedRecycleBin", service.path)
+}
+
+func TestClient_PermanentDeleteFeed_UsesRecycleBinOfOrganization(t *testing.T) {
+	service := &fakeService{}
+	server := httptest.NewServer(service)
+	defer server.Close()
+	client := newTestClient(server)
+
+	feedID := "d1d4b9d4-8f2a-4b86-9e0c-3f5f0d2f4b6a"
+	err := client.PermanentDeleteFeed(context.Background(), PermanentDeleteFeedArgs{FeedId: &feedID})
+
+	require.Nil(t, err)
+	require.Equal(t, http.MethodDelete, service.method)
+	require.Equal(t, "/_apis/Packaging/FeedRecycleBin/d1d4b9d4-8f2a-4b86-9e0c-3f5f0d2f4b6a", service.path)
+}
+
+func TestClient_PermanentDeleteFeed_RequiresFeed(t *testing.T) {
+	err := (&ClientImpl{}).PermanentDeleteFeed(context.Background(), PermanentDeleteFeedArgs{})
+	require.NotNil(t, err)
+}
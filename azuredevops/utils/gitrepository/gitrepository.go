@@ -0,0 +1,156 @@
+// Package gitrepository is a client for the state of the repositories of the Azure DevOps git service.
+//
+// The git client of the SDK models repositories without their disabled state, so it can neither report
+// whether a repository is disabled nor disable it. This client sends these requests to the repositories
+// endpoint of the same resource area.
+package gitrepository
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/microsoft/azure-devops-go-api/azuredevops"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/git"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/azdoclient"
+)
+
+var repositoriesLocationID, _ = uuid.Parse("225f7195-f9c7-4d14-ab28-a83f7ff77e1f")
+
+// The disabled state of repositories was added to the repositories endpoint with version 6.0
+const apiVersion = "6.0-preview.1"
+
+// RepositoryState is the state of a repository that is not part of the model of the SDK
+type RepositoryState struct {
+	Id         *uuid.UUID `json:"id,omitempty"`
+	Name       *string    `json:"name,omitempty"`
+	IsDisabled *bool      `json:"isDisabled,omitempty"`
+}
+
+// Client manages the state of the repositories of a project
+type Client interface {
+	GetRepositoryState(context.Context, GetRepositoryStateArgs) (*RepositoryState, error)
+	GetRepositoryStates(context.Context, GetRepositoryStatesArgs) (*[]RepositoryState, error)
+	SetRepositoryDisabled(context.Context, SetRepositoryDisabledArgs) (*RepositoryState, error)
+}
+
+// ClientImpl sends the requests through the client of the git resource area
+type ClientImpl struct {
+	Client azuredevops.Client
+}
+
+// NewClient creates a client for the organization of the connection
+func NewClient(ctx context.Context, connection *azuredevops.Connection) (Client, error) {
+	client, err := azdoclient.NewResourceAreaClient(ctx, connection, git.ResourceAreaId)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientImpl{
+		Client: *client,
+	}, nil
+}
+
+// GetRepositoryStateArgs are the arguments for the GetRepositoryState function
+type GetRepositoryStateArgs struct {
+	// (required) Project ID or project name
+	Project *string
+	// (required) ID of the repository.
+	RepositoryId *uuid.UUID
+}
+
+// GetRepositoryState gets the state of a repository
+func (client *ClientImpl) GetRepositoryState(ctx context.Context, args GetRepositoryStateArgs) (*RepositoryState, error) {
+	routeValues, err := repositoryRouteValues(args.Project, args.RepositoryId)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseValue RepositoryState
+	err = azdoclient.Send(ctx, &client.Client, azdoclient.Request{
+		Method:      http.MethodGet,
+		LocationID:  repositoriesLocationID,
+		APIVersion:  apiVersion,
+		RouteValues: routeValues,
+	}, &responseValue)
+	return &responseValue, err
+}
+
+// GetRepositoryStatesArgs are the arguments for the GetRepositoryStates function
+type GetRepositoryStatesArgs struct {
+	// (required) Project ID or project name
+	Project *string
+	// (optional) True to include hidden repositories, which are the disabled ones. The default value is false.
+	IncludeHidden *bool
+}
+
+// GetRepositoryStates gets the states of the repositories of a project
+func (client *ClientImpl) GetRepositoryStates(ctx context.Context, args GetRepositoryStatesArgs) (*[]RepositoryState, error) {
+	if args.Project == nil || *args.Project == "" {
+		return nil, &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.Project"}
+	}
+	routeValues := map[string]string{"project": *args.Project}
+	queryParams := url.Values{}
+	if args.IncludeHidden != nil {
+		queryParams.Add("includeHidden", strconv.FormatBool(*args.IncludeHidden))
+	}
+
+	var responseValue []RepositoryState
+	err := azdoclient.SendCollection(ctx, &client.Client, azdoclient.Request{
+		Method:      http.MethodGet,
+		LocationID:  repositoriesLocationID,
+		APIVersion:  apiVersion,
+		RouteValues: routeValues,
+		QueryParams: queryParams,
+	}, &responseValue)
+	if err != nil {
+		return nil, err
+	}
+	return &responseValue, nil
+}
+
+// SetRepositoryDisabledArgs are the arguments for the SetRepositoryDisabled function
+type SetRepositoryDisabledArgs struct {
+	// (required) Project ID or project name
+	Project *string
+	// (required) ID of the repository.
+	RepositoryId *uuid.UUID
+	// (required) Whether the repository is disabled.
+	IsDisabled *bool
+}
+
+// SetRepositoryDisabled disables or enables a repository. The content of a disabled repository is kept, but
+// it can neither be read nor written until it is enabled again.
+func (client *ClientImpl) SetRepositoryDisabled(ctx context.Context, args SetRepositoryDisabledArgs) (*RepositoryState, error) {
+	if args.IsDisabled == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.IsDisabled"}
+	}
+	routeValues, err := repositoryRouteValues(args.Project, args.RepositoryId)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseValue RepositoryState
+	err = azdoclient.Send(ctx, &client.Client, azdoclient.Request{
+		Method:      http.MethodPatch,
+		LocationID:  repositoriesLocationID,
+		APIVersion:  apiVersion,
+		RouteValues: routeValues,
+		Body:        &RepositoryState{IsDisabled: args.IsDisabled},
+	}, &responseValue)
+	return &responseValue, err
+}
+
+func repositoryRouteValues(project *string, repositoryID *uuid.UUID) (map[string]string, error) {
+	if project == nil || *project == "" {
+		return nil, &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.Project"}
+	}
+	if repositoryID == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.RepositoryId"}
+	}
+	return map[string]string{
+		"project":      *project,
+		"repositoryId": repositoryID.String(),
+	}, nil
+}
@@ -0,0 +1,125 @@
+package gitrepository
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/microsoft/azure-devops-go-api/azuredevops"
+	"github.com/stretchr/testify/require"
+)
+
+// the resource locations the SDK looks up before sending a request to a resource
+const testResourceLocations = `{
+	"count": 1,
+	"value": [{
+		"id": "225f7195-f9c7-4d14-ab28-a83f7ff77e1f",
+		"area": "git",
+		"resourceName": "repositories",
+		"routeTemplate": "{project}/_apis/{area}/{resource}/{repositoryId}",
+		"resourceVersion": 1,
+		"minVersion": "1.0",
+		"maxVersion": "6.0",
+		"releasedVersion": "0.0"
+	}]
+}`
+
+// records the request that was sent to the repositories endpoint and replies with a fixed response
+type fakeService struct {
+	method   string
+	path     string
+	query    string
+	body     string
+	response string
+}
+
+func (f *fakeService) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodOptions {
+		w.Write([]byte(testResourceLocations))
+		return
+	}
+
+	body, _ := ioutil.ReadAll(r.Body)
+	f.method = r.Method
+	f.path = r.URL.Path
+	f.query = r.URL.RawQuery
+	f.body = string(body)
+	w.Write([]byte(f.response))
+}
+
+func newTestClient(server *httptest.Server) *ClientImpl {
+	connection := azuredevops.NewPatConnection(server.URL, "pat")
+	return &ClientImpl{Client: *azuredevops.NewClient(connection, server.URL)}
+}
+
+var testRepositoryID = uuid.MustParse("5febef5a-833d-4e14-b9c0-14cb638f91e6")
+
+func TestClient_GetRepositoryState_ReadsDisabledState(t *testing.T) {
+	service := &fakeService{response: `{"id": "5febef5a-833d-4e14-b9c0-14cb638f91e6", "name": "repo", "isDisabled": true}`}
+	server := httptest.NewServer(service)
+	defer server.Close()
+	client := newTestClient(server)
+
+	project := "project"
+	state, err := client.GetRepositoryState(context.Background(), GetRepositoryStateArgs{Project: &project, RepositoryId: &testRepositoryID})
+
+	require.Nil(t, err)
+	require.True(t, *state.IsDisabled)
+	require.Equal(t, http.MethodGet, service.method)
+	require.Equal(t, "/project/_apis/git/repositories/5febef5a-833d-4e14-b9c0-14cb638f91e6", service.path)
+}
+
+func TestClient_GetRepositoryStates_ListsRepositoriesOfProject(t *testing.T) {
+	service := &fakeService{response: `{"count": 2, "value": [
+		{"id": "5febef5a-833d-4e14-b9c0-14cb638f91e6", "name": "repo", "isDisabled": true},
+		{"id": "0d7ae93c-2b4a-4a5e-9f5c-1f8b7c0b6c9e", "name": "other", "isDisabled": false}
+	]}`}
+	server := httptest.NewServer(service)
+	defer server.Close()
+	client := newTestClient(server)
+
+	project := "project"
+	includeHidden := true
+	states, err := client.GetRepositoryStates(context.Background(), GetRepositoryStatesArgs{Project: &project, IncludeHidden: &includeHidden})
+
+	require.Nil(t, err)
+	require.Len(t, *states, 2)
+	require.True(t, *(*states)[0].IsDisabled)
+	require.Equal(t, http.MethodGet, service.method)
+	require.Equal(t, "/project/_apis/git/repositories", service.path)
+	require.Equal(t, "includeHidden=true", service.query)
+}
+
+func TestClient_SetRepositoryDisabled_PatchesDisabledState(t *testing.T) {
+	service := &fakeService{response: `{"id": "5febef5a-833d-4e14-b9c0-14cb638f91e6", "name": "repo", "isDisabled": true}`}
+	server := httptest.NewServer(service)
+	defer server.Close()
+	client := newTestClient(server)
+
+	project := "project"
+	disabled := true
+	state, err := client.SetRepositoryDisabled(context.Background(), SetRepositoryDisabledArgs{
+		Project:      &project,
+		RepositoryId: &testRepositoryID,
+		IsDisabled:   &disabled,
+	})
+
+	require.Nil(t, err)
+	require.True(t, *state.IsDisabled)
+	require.Equal(t, http.MethodPatch, service.method)
+	require.Equal(t, "/project/_apis/git/repositories/5febef5a-833d-4e14-b9c0-14cb638f91e6", service.path)
+	require.JSONEq(t, `{"isDisabled": true}`, service.body)
+}
+
+func TestClient_SetRepositoryDisabled_RequiresDisabledState(t *testing.T) {
+	project := "project"
+	_, err := (&ClientImpl{}).SetRepositoryDisabled(context.Background(), SetRepositoryDisabledArgs{
+		Project:      &project,
+		RepositoryId: &testRepositoryID,
+	})
+
+	require.NotNil(t, err)
+}
@@ -0,0 +1,79 @@
+// Package graphgroup is a client that creates groups through the Azure DevOps graph service.
+//
+// The graph client of the SDK declares the creation context of a group as a struct that only carries a
+// storage key, which drops the display name and description of a new group and the origin ID of a group
+// that is materialized from AAD. This client uses the same endpoint and API version, but sends any of the
+// creation contexts of the graph package.
+package graphgroup
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/microsoft/azure-devops-go-api/azuredevops"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/graph"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/azdoclient"
+)
+
+var locationID, _ = uuid.Parse("ebbe6af8-0b91-4c13-8cf1-777c14858188")
+
+const apiVersion = "5.1-preview.1"
+
+// Client creates graph groups
+type Client interface {
+	CreateGroup(context.Context, CreateGroupArgs) (*graph.GraphGroup, error)
+}
+
+// ClientImpl sends the requests through the client of the graph resource area
+type ClientImpl struct {
+	Client azuredevops.Client
+}
+
+// NewClient creates a client for the organization of the connection
+func NewClient(ctx context.Context, connection *azuredevops.Connection) (Client, error) {
+	client, err := azdoclient.NewResourceAreaClient(ctx, connection, graph.ResourceAreaId)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientImpl{
+		Client: *client,
+	}, nil
+}
+
+// CreateGroupArgs are the arguments for the CreateGroup function
+type CreateGroupArgs struct {
+	// (required) The creation context of the group, e.g. a graph.GraphGroupVstsCreationContext or a
+	// graph.GraphGroupOriginIdCreationContext.
+	CreationContext interface{}
+	// (optional) A descriptor referencing the scope (collection, project) in which the group should be created. If omitted, will be created in the scope of the enclosing account or organization. Valid only for VSTS groups.
+	ScopeDescriptor *string
+	// (optional) A comma separated list of descriptors referencing groups you want the graph group to join
+	GroupDescriptors *[]string
+}
+
+// CreateGroup creates a new group, or materializes a group of an external provider
+func (client *ClientImpl) CreateGroup(ctx context.Context, args CreateGroupArgs) (*graph.GraphGroup, error) {
+	if args.CreationContext == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.CreationContext"}
+	}
+	queryParams := url.Values{}
+	if args.ScopeDescriptor != nil {
+		queryParams.Add("scopeDescriptor", *args.ScopeDescriptor)
+	}
+	if args.GroupDescriptors != nil {
+		queryParams.Add("groupDescriptors", strings.Join(*args.GroupDescriptors, ","))
+	}
+
+	var responseValue graph.GraphGroup
+	err := azdoclient.Send(ctx, &client.Client, azdoclient.Request{
+		Method:      http.MethodPost,
+		LocationID:  locationID,
+		APIVersion:  apiVersion,
+		QueryParams: queryParams,
+		Body:        args.CreationContext,
+	}, &responseValue)
+	return &responseValue, err
+}
@@ -0,0 +1,81 @@
+package graphgroup
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/graph"
+	"github.com/stretchr/testify/require"
+)
+
+// the resource locations the SDK looks up before sending a request to a resource
+const testResourceLocations = `{
+	"count": 1,
+	"value": [{
+		"id": "ebbe6af8-0b91-4c13-8cf1-777c14858188",
+		"area": "Graph",
+		"resourceName": "Groups",
+		"routeTemplate": "_apis/{area}/{resource}/{groupDescriptor}",
+		"resourceVersion": 1,
+		"minVersion": "4.0",
+		"maxVersion": "5.1",
+		"releasedVersion": "0.0"
+	}]
+}`
+
+// records the request that was sent to the groups endpoint and replies with a fixed response
+type fakeService struct {
+	method string
+	path   string
+	query  string
+	body   string
+}
+
+func (f *fakeService) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodOptions {
+		w.Write([]byte(testResourceLocations))
+		return
+	}
+
+	body, _ := ioutil.ReadAll(r.Body)
+	f.method = r.Method
+	f.path = r.URL.Path
+	f.query = r.URL.RawQuery
+	f.body = string(body)
+	w.Write([]byte(`{"descriptor": "vssgp.descriptor", "displayName": "Group"}`))
+}
+
+func TestClient_CreateGroup_SendsCreationContext(t *testing.T) {
+	service := &fakeService{}
+	server := httptest.NewServer(service)
+	defer server.Close()
+
+	connection := azuredevops.NewPatConnection(server.URL, "pat")
+	client := &ClientImpl{Client: *azuredevops.NewClient(connection, server.URL)}
+
+	displayName := "Group"
+	description := "Description"
+	scopeDescriptor := "scp.descriptor"
+	group, err := client.CreateGroup(context.Background(), CreateGroupArgs{
+		CreationContext: &graph.GraphGroupVstsCreationContext{DisplayName: &displayName, Description: &description},
+		ScopeDescriptor: &scopeDescriptor,
+	})
+
+	require.Nil(t, err)
+	require.Equal(t, "vssgp.descriptor", *group.Descriptor)
+	require.Equal(t, http.MethodPost, service.method)
+	require.Equal(t, "/_apis/Graph/Groups", service.path)
+	require.Equal(t, "scopeDescriptor=scp.descriptor", service.query)
+	require.JSONEq(t, `{"displayName": "Group", "description": "Description"}`, service.body)
+}
+
+func TestClient_CreateGroup_RequiresCreationContext(t *testing.T) {
+	client := &ClientImpl{}
+
+	_, err := client.CreateGroup(context.Background(), CreateGroupArgs{})
+	require.NotNil(t, err)
+}
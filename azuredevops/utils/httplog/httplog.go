@@ -0,0 +1,118 @@
+package httplog
+
+import (
+	"log"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+// RequestIDHeader is the header in which Azure DevOps returns the ID of a request, which is needed to look up
+// the request with Microsoft support
+const RequestIDHeader = "ActivityId"
+
+// The value logged in place of secrets
+const redacted = "[REDACTED]"
+
+// Headers whose values are never logged, as they carry credentials
+var sensitiveHeaders = map[string]bool{
+	"Authorization":       true,
+	"Proxy-Authorization": true,
+	"Cookie":              true,
+	"Set-Cookie":          true,
+}
+
+// Parts of the names of query parameters whose values are never logged, e.g. the signature of a SAS URL
+var sensitiveQueryParameters = []string{"token", "secret", "password", "key", "sig", "code"}
+
+// RoundTripper is an http.RoundTripper that logs each request sent through it and the response to it. Every
+// request is numbered, and the log entry of the response carries the same number, so that the entries of
+// concurrent requests can be told apart. Credentials are redacted from the headers and the URL of the request.
+type RoundTripper struct {
+	// Next is the RoundTripper used to send the requests
+	Next http.RoundTripper
+
+	requestCount uint64
+}
+
+// NewRoundTripper creates a RoundTripper that wraps next
+func NewRoundTripper(next http.RoundTripper) *RoundTripper {
+	return &RoundTripper{Next: next}
+}
+
+// RoundTrip implements http.RoundTripper
+func (rt *RoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	number := atomic.AddUint64(&rt.requestCount, 1)
+	log.Printf("[DEBUG] AzDO request #%d: %s %s %s", number, req.Method, RedactURL(req.URL), formatHeaders(req.Header))
+
+	start := time.Now()
+	resp, err := rt.Next.RoundTrip(req)
+	elapsed := time.Since(start).Round(time.Millisecond)
+	if err != nil {
+		log.Printf("[DEBUG] AzDO response #%d: %s %s failed after %s: %v", number, req.Method, RedactURL(req.URL), elapsed, err)
+		return resp, err
+	}
+
+	requestID := resp.Header.Get(RequestIDHeader)
+	if requestID == "" {
+		requestID = "unknown"
+	}
+	log.Printf("[DEBUG] AzDO response #%d: %s %s returned %s in %s (request %s)", number, req.Method, RedactURL(req.URL), resp.Status, elapsed, requestID)
+	return resp, nil
+}
+
+// RedactURL returns the URL with the password of its user info and the values of sensitive query parameters
+// replaced, so that it can be logged
+func RedactURL(u *url.URL) string {
+	if u == nil {
+		return ""
+	}
+
+	redactedURL := *u
+	if _, hasPassword := u.User.Password(); hasPassword {
+		redactedURL.User = url.UserPassword(u.User.Username(), redacted)
+	}
+
+	query := u.Query()
+	for name, values := range query {
+		if isSensitiveQueryParameter(name) {
+			for i := range values {
+				values[i] = redacted
+			}
+		}
+	}
+	redactedURL.RawQuery = query.Encode()
+	return redactedURL.String()
+}
+
+func isSensitiveQueryParameter(name string) bool {
+	name = strings.ToLower(name)
+	for _, part := range sensitiveQueryParameters {
+		if strings.Contains(name, part) {
+			return true
+		}
+	}
+	return false
+}
+
+// Formats the headers ordered by their name, with the values of headers that carry credentials replaced
+func formatHeaders(header http.Header) string {
+	names := make([]string, 0, len(header))
+	for name := range header {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	formatted := make([]string, 0, len(names))
+	for _, name := range names {
+		value := strings.Join(header[name], ", ")
+		if sensitiveHeaders[http.CanonicalHeaderKey(name)] {
+			value = redacted
+		}
+		formatted = append(formatted, name+": "+value)
+	}
+	return "[" + strings.Join(formatted, "; ") + "]"
+}
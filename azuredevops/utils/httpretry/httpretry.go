@@ -0,0 +1,155 @@
+package httpretry
+
+import (
+	"log"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// DefaultMaxDelay caps the delay between two attempts when no explicit cap is configured
+const DefaultMaxDelay = 30 * time.Second
+
+// RoundTripper is an http.RoundTripper that retries requests which were throttled (HTTP 429), which hit an
+// unavailable service (HTTP 503) or which failed with a transient network error. Network errors are only retried
+// for idempotent requests, because a request that failed that way might have been processed by the service
+// nevertheless. Throttled responses are retried after the delay requested by the `Retry-After` header, all other
+// failures back off exponentially.
+type RoundTripper struct {
+	// Next is the RoundTripper used to send each attempt
+	Next http.RoundTripper
+	// MaxRetries is the number of retries made after the initial attempt
+	MaxRetries int
+	// BaseDelay is the delay before the first retry. It doubles with each subsequent retry.
+	BaseDelay time.Duration
+	// MaxDelay caps the delay between two attempts, including delays requested by the `Retry-After` header.
+	// DefaultMaxDelay is used if it is not set.
+	MaxDelay time.Duration
+}
+
+// NewRoundTripper creates a RoundTripper that wraps next
+func NewRoundTripper(next http.RoundTripper, maxRetries int, baseDelay time.Duration) *RoundTripper {
+	return &RoundTripper{
+		Next:       next,
+		MaxRetries: maxRetries,
+		BaseDelay:  baseDelay,
+		MaxDelay:   DefaultMaxDelay,
+	}
+}
+
+// RoundTrip implements http.RoundTripper
+func (rt *RoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		resp, err := rt.Next.RoundTrip(req)
+
+		if attempt >= rt.MaxRetries || !isRetryable(req, resp, err) {
+			return resp, err
+		}
+
+		delay := rt.delay(attempt, resp)
+		if resp != nil {
+			log.Printf("[DEBUG] %s %s returned status %d, retrying in %s (retry %d of %d)", req.Method, req.URL, resp.StatusCode, delay, attempt+1, rt.MaxRetries)
+			resp.Body.Close()
+		} else {
+			log.Printf("[DEBUG] %s %s failed with error %v, retrying in %s (retry %d of %d)", req.Method, req.URL, err, delay, attempt+1, rt.MaxRetries)
+		}
+
+		if req, err = rewind(req); err != nil {
+			return nil, err
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// Determines whether or not a request can and should be sent again
+func isRetryable(req *http.Request, resp *http.Response, err error) bool {
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		// the body has been consumed by the failed attempt and cannot be sent again
+		return false
+	}
+
+	if err != nil {
+		// cancellation and deadlines of the caller are not transient
+		return req.Context().Err() == nil && isIdempotent(req.Method)
+	}
+
+	return resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable
+}
+
+// Determines whether or not sending a request more than once has the same effect as sending it once
+func isIdempotent(method string) bool {
+	switch method {
+	case "", http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete, http.MethodOptions, http.MethodTrace:
+		return true
+	}
+	return false
+}
+
+// Computes the delay before the next attempt, preferring the delay requested by the service. Neither delay
+// exceeds the cap of the RoundTripper.
+func (rt *RoundTripper) delay(attempt int, resp *http.Response) time.Duration {
+	maxDelay := rt.MaxDelay
+	if maxDelay <= 0 {
+		maxDelay = DefaultMaxDelay
+	}
+
+	if resp != nil {
+		if retryAfter, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			if retryAfter > maxDelay {
+				return maxDelay
+			}
+			return retryAfter
+		}
+	}
+
+	delay := time.Duration(float64(rt.BaseDelay) * math.Pow(2, float64(attempt)))
+	if delay > maxDelay || delay < 0 {
+		return maxDelay
+	}
+	return delay
+}
+
+// Parses the value of a `Retry-After` header, which is either a number of seconds or an HTTP date
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		delay := time.Until(date)
+		if delay < 0 {
+			delay = 0
+		}
+		return delay, true
+	}
+
+	return 0, false
+}
+
+// Creates a copy of the request with a fresh body so that it can be sent again
+func rewind(req *http.Request) (*http.Request, error) {
+	if req.GetBody == nil {
+		return req, nil
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+
+	rewound := req.WithContext(req.Context())
+	rewound.Body = body
+	return rewound, nil
+}
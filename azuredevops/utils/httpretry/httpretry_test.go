@@ -0,0 +1,167 @@
+package httpretry
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// a RoundTripper that replays a fixed set of results and records the bodies it was sent
+type fakeRoundTripper struct {
+	results []fakeResult
+	bodies  []string
+}
+
+type fakeResult struct {
+	status     int
+	retryAfter string
+	err        error
+}
+
+func (f *fakeRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		body, _ := ioutil.ReadAll(req.Body)
+		f.bodies = append(f.bodies, string(body))
+	}
+
+	result := f.results[0]
+	f.results = f.results[1:]
+	if result.err != nil {
+		return nil, result.err
+	}
+
+	resp := &http.Response{
+		StatusCode: result.status,
+		Header:     http.Header{},
+		Body:       ioutil.NopCloser(strings.NewReader("")),
+	}
+	if result.retryAfter != "" {
+		resp.Header.Set("Retry-After", result.retryAfter)
+	}
+	return resp, nil
+}
+
+func newTestRequest(t *testing.T) *http.Request {
+	req, err := http.NewRequest(http.MethodPost, "https://dev.azure.com/org/_apis/projects", strings.NewReader("payload"))
+	require.Nil(t, err)
+	return req
+}
+
+func TestRoundTripper_RetriesThrottledRequestsUntilSuccess(t *testing.T) {
+	next := &fakeRoundTripper{results: []fakeResult{
+		{status: http.StatusTooManyRequests, retryAfter: "0"},
+		{status: http.StatusServiceUnavailable},
+		{status: http.StatusOK},
+	}}
+	rt := NewRoundTripper(next, 3, time.Millisecond)
+
+	resp, err := rt.RoundTrip(newTestRequest(t))
+
+	require.Nil(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, []string{"payload", "payload", "payload"}, next.bodies)
+}
+
+func TestRoundTripper_RetriesTransientNetworkErrors(t *testing.T) {
+	next := &fakeRoundTripper{results: []fakeResult{
+		{err: errors.New("connection reset by peer")},
+		{status: http.StatusOK},
+	}}
+	rt := NewRoundTripper(next, 3, time.Millisecond)
+
+	req := newTestRequest(t)
+	req.Method = http.MethodPut
+	resp, err := rt.RoundTrip(req)
+
+	require.Nil(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, []string{"payload", "payload"}, next.bodies)
+}
+
+func TestRoundTripper_DoesNotRetryNetworkErrorsOfNonIdempotentRequests(t *testing.T) {
+	next := &fakeRoundTripper{results: []fakeResult{
+		{err: errors.New("connection reset by peer")},
+		{status: http.StatusOK},
+	}}
+	rt := NewRoundTripper(next, 3, time.Millisecond)
+
+	_, err := rt.RoundTrip(newTestRequest(t))
+
+	require.NotNil(t, err)
+	require.Len(t, next.results, 1)
+}
+
+func TestRoundTripper_GivesUpAfterMaxRetries(t *testing.T) {
+	next := &fakeRoundTripper{results: []fakeResult{
+		{status: http.StatusTooManyRequests},
+		{status: http.StatusTooManyRequests},
+		{status: http.StatusTooManyRequests},
+	}}
+	rt := NewRoundTripper(next, 2, time.Millisecond)
+
+	resp, err := rt.RoundTrip(newTestRequest(t))
+
+	require.Nil(t, err)
+	require.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+	require.Empty(t, next.results)
+}
+
+func TestRoundTripper_DoesNotRetryOtherStatusCodes(t *testing.T) {
+	next := &fakeRoundTripper{results: []fakeResult{
+		{status: http.StatusBadRequest},
+	}}
+	rt := NewRoundTripper(next, 3, time.Millisecond)
+
+	resp, err := rt.RoundTrip(newTestRequest(t))
+
+	require.Nil(t, err)
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestRoundTripper_StopsWhenContextIsCancelled(t *testing.T) {
+	next := &fakeRoundTripper{results: []fakeResult{
+		{status: http.StatusTooManyRequests, retryAfter: "60"},
+	}}
+	rt := NewRoundTripper(next, 3, time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err := rt.RoundTrip(newTestRequest(t).WithContext(ctx))
+
+	require.Equal(t, context.DeadlineExceeded, err)
+}
+
+func TestRoundTripper_DelayBacksOffExponentiallyUpToCap(t *testing.T) {
+	rt := &RoundTripper{BaseDelay: time.Second, MaxDelay: 5 * time.Second}
+
+	require.Equal(t, 1*time.Second, rt.delay(0, nil))
+	require.Equal(t, 2*time.Second, rt.delay(1, nil))
+	require.Equal(t, 4*time.Second, rt.delay(2, nil))
+	require.Equal(t, 5*time.Second, rt.delay(3, nil))
+}
+
+func TestRoundTripper_DelayHonorsRetryAfter(t *testing.T) {
+	rt := &RoundTripper{BaseDelay: time.Second}
+	resp := &http.Response{Header: http.Header{}}
+	resp.Header.Set("Retry-After", "7")
+
+	require.Equal(t, 7*time.Second, rt.delay(0, resp))
+}
+
+func TestRoundTripper_DelayCapsRetryAfter(t *testing.T) {
+	rt := &RoundTripper{BaseDelay: time.Second, MaxDelay: 5 * time.Second}
+	resp := &http.Response{Header: http.Header{}}
+	resp.Header.Set("Retry-After", "3600")
+
+	require.Equal(t, 5*time.Second, rt.delay(0, resp))
+
+	rt.MaxDelay = 0
+	require.Equal(t, DefaultMaxDelay, rt.delay(0, resp))
+}
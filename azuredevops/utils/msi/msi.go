@@ -0,0 +1,140 @@
+package msi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultEndpoint is the token endpoint of the Azure Instance Metadata Service (IMDS)
+const DefaultEndpoint = "http://169.254.169.254/metadata/identity/oauth2/token"
+
+// AzureDevOpsResourceID is the well known AAD application ID of Azure DevOps, used as the token audience
+const AzureDevOpsResourceID = "499b84ac-1321-427f-aa17-267ca6975798"
+
+// tokens are refreshed this long before they expire so that in-flight requests do not fail
+const refreshBuffer = 5 * time.Minute
+
+// TokenSource acquires AAD access tokens for Azure DevOps from a managed identity and caches them until
+// shortly before they expire.
+type TokenSource struct {
+	client   *http.Client
+	endpoint string
+	clientID string
+
+	mu        sync.Mutex
+	token     string
+	expiresOn time.Time
+}
+
+// NewTokenSource creates a TokenSource that requests tokens from endpoint using client. The clientID selects
+// a user-assigned managed identity; the system-assigned identity is used when it is empty.
+func NewTokenSource(client *http.Client, endpoint string, clientID string) *TokenSource {
+	return &TokenSource{
+		client:   client,
+		endpoint: endpoint,
+		clientID: clientID,
+	}
+}
+
+type tokenResponse struct {
+	AccessToken string `json:"access_token"`
+	ExpiresOn   string `json:"expires_on"`
+}
+
+// Token returns a valid access token, acquiring a new one if the cached token is missing or about to expire
+func (s *TokenSource) Token() (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token != "" && time.Now().Add(refreshBuffer).Before(s.expiresOn) {
+		return s.token, nil
+	}
+
+	token, expiresOn, err := s.acquire()
+	if err != nil {
+		return "", err
+	}
+
+	s.token, s.expiresOn = token, expiresOn
+	return s.token, nil
+}
+
+func (s *TokenSource) acquire() (string, time.Time, error) {
+	query := url.Values{}
+	query.Set("api-version", "2018-02-01")
+	query.Set("resource", AzureDevOpsResourceID)
+	if s.clientID != "" {
+		query.Set("client_id", s.clientID)
+	}
+
+	req, err := http.NewRequest(http.MethodGet, s.endpoint+"?"+query.Encode(), nil)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	req.Header.Set("Metadata", "true")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("Unable to reach the managed identity endpoint %s. Make sure the provider runs on an Azure resource with a managed identity assigned: %v", s.endpoint, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", time.Time{}, fmt.Errorf("The managed identity endpoint %s returned status %d. Make sure a managed identity is assigned and, for user-assigned identities, that the client ID is correct", s.endpoint, resp.StatusCode)
+	}
+
+	var parsed tokenResponse
+	if err := json.NewDecoder(resp.Body).Decode(&parsed); err != nil {
+		return "", time.Time{}, fmt.Errorf("Unable to parse the response of the managed identity endpoint: %v", err)
+	}
+
+	if parsed.AccessToken == "" {
+		return "", time.Time{}, fmt.Errorf("The managed identity endpoint did not return an access token")
+	}
+
+	expiresOn := time.Now()
+	if seconds, err := strconv.ParseInt(parsed.ExpiresOn, 10, 64); err == nil {
+		expiresOn = time.Unix(seconds, 0)
+	}
+
+	return parsed.AccessToken, expiresOn, nil
+}
+
+// RoundTripper replaces the bearer token of each request with a current token from a TokenSource so that
+// long running operations keep working after the initial token expires.
+type RoundTripper struct {
+	Next   http.RoundTripper
+	Source *TokenSource
+}
+
+// NewRoundTripper creates a RoundTripper that wraps next
+func NewRoundTripper(next http.RoundTripper, source *TokenSource) *RoundTripper {
+	return &RoundTripper{Next: next, Source: source}
+}
+
+// RoundTrip implements http.RoundTripper
+func (rt *RoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !strings.HasPrefix(req.Header.Get("Authorization"), "Bearer ") {
+		return rt.Next.RoundTrip(req)
+	}
+
+	token, err := rt.Source.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	// a RoundTripper must not modify the request it was given
+	authorized := req.WithContext(req.Context())
+	authorized.Header = make(http.Header, len(req.Header))
+	for key, values := range req.Header {
+		authorized.Header[key] = values
+	}
+	authorized.Header.Set("Authorization", "Bearer "+token)
+	return rt.Next.RoundTrip(authorized)
+}
@@ -0,0 +1,90 @@
+package msi
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// starts a fake IMDS endpoint that issues a new token on each call, valid for the given duration
+func newFakeIMDS(t *testing.T, validFor time.Duration) (*httptest.Server, *int) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		require.Equal(t, "true", r.Header.Get("Metadata"))
+		require.Equal(t, AzureDevOpsResourceID, r.URL.Query().Get("resource"))
+		require.Equal(t, "my-client-id", r.URL.Query().Get("client_id"))
+		fmt.Fprintf(w, `{"access_token": "token-%d", "expires_on": "%d"}`, calls, time.Now().Add(validFor).Unix())
+	}))
+	return server, &calls
+}
+
+func TestTokenSource_CachesValidToken(t *testing.T) {
+	server, calls := newFakeIMDS(t, time.Hour)
+	defer server.Close()
+
+	source := NewTokenSource(server.Client(), server.URL, "my-client-id")
+
+	first, err := source.Token()
+	require.Nil(t, err)
+	second, err := source.Token()
+	require.Nil(t, err)
+
+	require.Equal(t, "token-1", first)
+	require.Equal(t, first, second)
+	require.Equal(t, 1, *calls)
+}
+
+func TestTokenSource_RefreshesTokenCloseToExpiry(t *testing.T) {
+	server, calls := newFakeIMDS(t, time.Minute)
+	defer server.Close()
+
+	source := NewTokenSource(server.Client(), server.URL, "my-client-id")
+
+	first, _ := source.Token()
+	second, _ := source.Token()
+
+	require.NotEqual(t, first, second)
+	require.Equal(t, 2, *calls)
+}
+
+func TestTokenSource_ReturnsClearErrorWhenUnreachable(t *testing.T) {
+	server, _ := newFakeIMDS(t, time.Hour)
+	server.Close()
+
+	source := NewTokenSource(server.Client(), server.URL, "my-client-id")
+
+	_, err := source.Token()
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "Unable to reach the managed identity endpoint")
+}
+
+func TestRoundTripper_ReplacesBearerToken(t *testing.T) {
+	server, _ := newFakeIMDS(t, time.Hour)
+	defer server.Close()
+
+	var sentAuthorization string
+	next := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		sentAuthorization = req.Header.Get("Authorization")
+		return &http.Response{StatusCode: http.StatusOK}, nil
+	})
+	rt := NewRoundTripper(next, NewTokenSource(server.Client(), server.URL, "my-client-id"))
+
+	req, _ := http.NewRequest(http.MethodGet, "https://dev.azure.com/org", nil)
+	req.Header.Set("Authorization", "Bearer stale")
+	_, err := rt.RoundTrip(req)
+
+	require.Nil(t, err)
+	require.Equal(t, "Bearer token-1", sentAuthorization)
+	require.Equal(t, "Bearer stale", req.Header.Get("Authorization"))
+}
+
+type roundTripperFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
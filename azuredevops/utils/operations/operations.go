@@ -0,0 +1,89 @@
+// Package operations waits for the asynchronous operations that the Azure DevOps services return for long
+// running requests, like the creation or deletion of a project.
+package operations
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/operations"
+)
+
+// DefaultPollInterval is the delay between two status checks when no explicit interval is configured
+const DefaultPollInterval = 1 * time.Second
+
+// WaitForCompletion polls the operation behind operationRef until it reaches one of the final states succeeded,
+// failed or cancelled, and returns its last state. An error is returned if the operation failed or was cancelled,
+// if it did not complete within timeout, if the context is done before, or if its status could not be retrieved.
+func WaitForCompletion(ctx context.Context, client operations.Client, operationRef *operations.OperationReference, timeout time.Duration, pollInterval time.Duration) (*operations.Operation, error) {
+	if operationRef == nil || operationRef.Id == nil {
+		return nil, errors.New("Operation reference does not identify an operation")
+	}
+	if pollInterval <= 0 {
+		pollInterval = DefaultPollInterval
+	}
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			operation, err := client.GetOperation(ctx, operations.GetOperationArgs{
+				OperationId: operationRef.Id,
+				PluginId:    operationRef.PluginId,
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			done, err := isCompleted(operationRef.Id, operation)
+			if done {
+				return operation, err
+			}
+		case <-timer.C:
+			return nil, fmt.Errorf("Operation %s was not successful after %d seconds", operationRef.Id, int(timeout.Seconds()))
+		case <-ctx.Done():
+			return nil, fmt.Errorf("Operation %s was cancelled before it completed: %v", operationRef.Id, ctx.Err())
+		}
+	}
+}
+
+// Reports whether an operation reached a final state, and an error describing the outcome unless it succeeded
+func isCompleted(id *uuid.UUID, operation *operations.Operation) (bool, error) {
+	if operation == nil || operation.Status == nil {
+		return false, nil
+	}
+
+	switch *operation.Status {
+	case operations.OperationStatusValues.Succeeded:
+		return true, nil
+	case operations.OperationStatusValues.Failed, operations.OperationStatusValues.Cancelled:
+		err := fmt.Errorf("Operation %s finished with status %s", id, *operation.Status)
+		if message := describe(operation); message != "" {
+			err = fmt.Errorf("%v: %s", err, message)
+		}
+		return true, err
+	}
+	return false, nil
+}
+
+// Returns the messages the service attached to the outcome of an operation
+func describe(operation *operations.Operation) string {
+	var message string
+	if operation.ResultMessage != nil {
+		message = *operation.ResultMessage
+	}
+	if operation.DetailedMessage != nil && *operation.DetailedMessage != message {
+		if message != "" {
+			message += ". "
+		}
+		message += *operation.DetailedMessage
+	}
+	return message
+}
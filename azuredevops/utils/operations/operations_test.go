@@ -0,0 +1,135 @@
+package operations
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/operations"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/stretchr/testify/require"
+)
+
+var testOperationID = uuid.New()
+var testPluginID = uuid.New()
+var testOperationRef = &operations.OperationReference{Id: &testOperationID, PluginId: &testPluginID}
+var expectedOperationArgs = operations.GetOperationArgs{OperationId: &testOperationID, PluginId: &testPluginID}
+
+const testPollInterval = 10 * time.Millisecond
+
+func operationWithStatus(status operations.OperationStatus) *operations.Operation {
+	return &operations.Operation{Id: &testOperationID, Status: &status}
+}
+
+func TestWaitForCompletion_PollsUntilSucceeded(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := azdosdkmocks.NewMockOperationsClient(ctrl)
+	ctx := context.Background()
+
+	succeeded := operationWithStatus(operations.OperationStatusValues.Succeeded)
+	gomock.InOrder(
+		client.EXPECT().GetOperation(ctx, expectedOperationArgs).Return(operationWithStatus(operations.OperationStatusValues.Queued), nil),
+		client.EXPECT().GetOperation(ctx, expectedOperationArgs).Return(operationWithStatus(operations.OperationStatusValues.InProgress), nil),
+		client.EXPECT().GetOperation(ctx, expectedOperationArgs).Return(succeeded, nil),
+	)
+
+	operation, err := WaitForCompletion(ctx, client, testOperationRef, time.Minute, testPollInterval)
+	require.Nil(t, err)
+	require.Equal(t, succeeded, operation)
+}
+
+func TestWaitForCompletion_ReportsFailedOperation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := azdosdkmocks.NewMockOperationsClient(ctrl)
+	ctx := context.Background()
+
+	failed := operationWithStatus(operations.OperationStatusValues.Failed)
+	resultMessage := "Project could not be created"
+	detailedMessage := "The process template does not exist"
+	failed.ResultMessage = &resultMessage
+	failed.DetailedMessage = &detailedMessage
+	client.EXPECT().GetOperation(ctx, expectedOperationArgs).Return(failed, nil).Times(1)
+
+	operation, err := WaitForCompletion(ctx, client, testOperationRef, time.Minute, testPollInterval)
+	require.Equal(t, failed, operation)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), testOperationID.String())
+	require.Contains(t, err.Error(), "failed")
+	require.Contains(t, err.Error(), resultMessage)
+	require.Contains(t, err.Error(), detailedMessage)
+}
+
+func TestWaitForCompletion_ReportsCancelledOperation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := azdosdkmocks.NewMockOperationsClient(ctrl)
+	ctx := context.Background()
+
+	cancelled := operationWithStatus(operations.OperationStatusValues.Cancelled)
+	client.EXPECT().GetOperation(ctx, expectedOperationArgs).Return(cancelled, nil).Times(1)
+
+	_, err := WaitForCompletion(ctx, client, testOperationRef, time.Minute, testPollInterval)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "cancelled")
+}
+
+func TestWaitForCompletion_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := azdosdkmocks.NewMockOperationsClient(ctrl)
+	ctx := context.Background()
+
+	client.EXPECT().GetOperation(ctx, expectedOperationArgs).Return(nil, errors.New("GetOperation() Failed")).Times(1)
+
+	_, err := WaitForCompletion(ctx, client, testOperationRef, time.Minute, testPollInterval)
+	require.Equal(t, "GetOperation() Failed", err.Error())
+}
+
+func TestWaitForCompletion_ReportsTimeout(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := azdosdkmocks.NewMockOperationsClient(ctrl)
+	ctx := context.Background()
+
+	client.EXPECT().
+		GetOperation(ctx, expectedOperationArgs).
+		Return(operationWithStatus(operations.OperationStatusValues.InProgress), nil).
+		AnyTimes()
+
+	_, err := WaitForCompletion(ctx, client, testOperationRef, 50*time.Millisecond, testPollInterval)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "was not successful")
+}
+
+func TestWaitForCompletion_StopsWhenContextIsDone(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := azdosdkmocks.NewMockOperationsClient(ctrl)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	client.EXPECT().GetOperation(gomock.Any(), gomock.Any()).Times(0)
+
+	_, err := WaitForCompletion(ctx, client, testOperationRef, time.Minute, time.Minute)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "cancelled before it completed")
+}
+
+func TestWaitForCompletion_RequiresOperationReference(t *testing.T) {
+	_, err := WaitForCompletion(context.Background(), nil, nil, time.Minute, testPollInterval)
+	require.NotNil(t, err)
+
+	_, err = WaitForCompletion(context.Background(), nil, &operations.OperationReference{}, time.Minute, testPollInterval)
+	require.NotNil(t, err)
+}
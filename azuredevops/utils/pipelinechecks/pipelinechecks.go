@@ -0,0 +1,203 @@
+// Package pipelinechecks is a client for the approvals and checks of the Azure DevOps pipelines service.
+//
+// Checks gate the stages of YAML pipelines that use a protected resource, e.g. an environment, until they
+// pass. The SDK does not contain a client for the checks configuration API, so this client sends the
+// requests to its endpoint directly.
+package pipelinechecks
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/microsoft/azure-devops-go-api/azuredevops"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/azdoclient"
+)
+
+// The types of checks
+var (
+	ApprovalTypeID, _     = uuid.Parse("8c6f20a7-a545-4486-9777-f762fafe0d4d")
+	TaskCheckTypeID, _    = uuid.Parse("fe1de3ee-a436-41b4-bb20-f6eb4cb879a7")
+	ExtendsCheckTypeID, _ = uuid.Parse("4020e66e-b0f3-47e1-bc88-48f3cc59b5f3")
+)
+
+// The types of the resources checks are configured on
+const (
+	ResourceTypeEnvironment   = "environment"
+	ResourceTypeEndpoint      = "endpoint"
+	ResourceTypeVariableGroup = "variablegroup"
+)
+
+var locationID, _ = uuid.Parse("86c8381e-5aee-4cde-8ae4-25c0c7f5eaea")
+
+const apiVersion = "5.1-preview.1"
+
+// CheckType identifies the kind of a check
+type CheckType struct {
+	Id   *uuid.UUID `json:"id,omitempty"`
+	Name *string    `json:"name,omitempty"`
+}
+
+// Resource is the protected resource a check is configured on
+type Resource struct {
+	Id   *string `json:"id,omitempty"`
+	Name *string `json:"name,omitempty"`
+	Type *string `json:"type,omitempty"`
+}
+
+// CheckConfiguration is a check of a protected resource. The structure of the settings depends on the type
+// of the check.
+type CheckConfiguration struct {
+	Id       *int        `json:"id,omitempty"`
+	Resource *Resource   `json:"resource,omitempty"`
+	Settings interface{} `json:"settings,omitempty"`
+	// The time, in minutes, after which a check that has not passed fails
+	Timeout *int       `json:"timeout,omitempty"`
+	Type    *CheckType `json:"type,omitempty"`
+	Version *int       `json:"version,omitempty"`
+}
+
+// Client manages the checks of the protected resources of a project
+type Client interface {
+	AddCheckConfiguration(context.Context, AddCheckConfigurationArgs) (*CheckConfiguration, error)
+	GetCheckConfiguration(context.Context, GetCheckConfigurationArgs) (*CheckConfiguration, error)
+	UpdateCheckConfiguration(context.Context, UpdateCheckConfigurationArgs) (*CheckConfiguration, error)
+	DeleteCheckConfiguration(context.Context, DeleteCheckConfigurationArgs) error
+}
+
+// ClientImpl sends the requests through a client for the organization
+type ClientImpl struct {
+	Client azuredevops.Client
+}
+
+// NewClient creates a client for the organization of the connection. Like the pipelines client of the SDK,
+// it looks up the location of the checks endpoint at the organization itself.
+func NewClient(ctx context.Context, connection *azuredevops.Connection) Client {
+	client := azdoclient.NewOrganizationClient(connection)
+	return &ClientImpl{
+		Client: *client,
+	}
+}
+
+// AddCheckConfigurationArgs are the arguments for the AddCheckConfiguration function
+type AddCheckConfigurationArgs struct {
+	// (required) The check to add.
+	Configuration *CheckConfiguration
+	// (required) Project ID or project name
+	Project *string
+}
+
+// AddCheckConfiguration adds a check to a protected resource
+func (client *ClientImpl) AddCheckConfiguration(ctx context.Context, args AddCheckConfigurationArgs) (*CheckConfiguration, error) {
+	if args.Configuration == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.Configuration"}
+	}
+	if args.Project == nil || *args.Project == "" {
+		return nil, &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.Project"}
+	}
+	routeValues := map[string]string{"project": *args.Project}
+
+	var responseValue CheckConfiguration
+	err := azdoclient.Send(ctx, &client.Client, azdoclient.Request{
+		Method:      http.MethodPost,
+		LocationID:  locationID,
+		APIVersion:  apiVersion,
+		RouteValues: routeValues,
+		Body:        args.Configuration,
+	}, &responseValue)
+	return &responseValue, err
+}
+
+// GetCheckConfigurationArgs are the arguments for the GetCheckConfiguration function
+type GetCheckConfigurationArgs struct {
+	// (required) Project ID or project name
+	Project *string
+	// (required) ID of the check.
+	Id *int
+}
+
+// GetCheckConfiguration gets a check including its settings, which are omitted by the service unless they
+// are expanded explicitly
+func (client *ClientImpl) GetCheckConfiguration(ctx context.Context, args GetCheckConfigurationArgs) (*CheckConfiguration, error) {
+	if args.Project == nil || *args.Project == "" {
+		return nil, &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.Project"}
+	}
+	if args.Id == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.Id"}
+	}
+	routeValues := map[string]string{"project": *args.Project, "id": strconv.Itoa(*args.Id)}
+	queryParams := url.Values{}
+	queryParams.Add("$expand", "settings")
+
+	var responseValue CheckConfiguration
+	err := azdoclient.Send(ctx, &client.Client, azdoclient.Request{
+		Method:      http.MethodGet,
+		LocationID:  locationID,
+		APIVersion:  apiVersion,
+		RouteValues: routeValues,
+		QueryParams: queryParams,
+	}, &responseValue)
+	return &responseValue, err
+}
+
+// UpdateCheckConfigurationArgs are the arguments for the UpdateCheckConfiguration function
+type UpdateCheckConfigurationArgs struct {
+	// (required) The check to update.
+	Configuration *CheckConfiguration
+	// (required) Project ID or project name
+	Project *string
+	// (required) ID of the check.
+	Id *int
+}
+
+// UpdateCheckConfiguration updates the settings and the timeout of a check
+func (client *ClientImpl) UpdateCheckConfiguration(ctx context.Context, args UpdateCheckConfigurationArgs) (*CheckConfiguration, error) {
+	if args.Configuration == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.Configuration"}
+	}
+	if args.Project == nil || *args.Project == "" {
+		return nil, &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.Project"}
+	}
+	if args.Id == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.Id"}
+	}
+	routeValues := map[string]string{"project": *args.Project, "id": strconv.Itoa(*args.Id)}
+
+	var responseValue CheckConfiguration
+	err := azdoclient.Send(ctx, &client.Client, azdoclient.Request{
+		Method:      http.MethodPatch,
+		LocationID:  locationID,
+		APIVersion:  apiVersion,
+		RouteValues: routeValues,
+		Body:        args.Configuration,
+	}, &responseValue)
+	return &responseValue, err
+}
+
+// DeleteCheckConfigurationArgs are the arguments for the DeleteCheckConfiguration function
+type DeleteCheckConfigurationArgs struct {
+	// (required) Project ID or project name
+	Project *string
+	// (required) ID of the check.
+	Id *int
+}
+
+// DeleteCheckConfiguration removes a check from its protected resource
+func (client *ClientImpl) DeleteCheckConfiguration(ctx context.Context, args DeleteCheckConfigurationArgs) error {
+	if args.Project == nil || *args.Project == "" {
+		return &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.Project"}
+	}
+	if args.Id == nil {
+		return &azuredevops.ArgumentNilError{ArgumentName: "args.Id"}
+	}
+	routeValues := map[string]string{"project": *args.Project, "id": strconv.Itoa(*args.Id)}
+
+	return azdoclient.Send(ctx, &client.Client, azdoclient.Request{
+		Method:      http.MethodDelete,
+		LocationID:  locationID,
+		APIVersion:  apiVersion,
+		RouteValues: routeValues,
+	}, nil)
+}
@@ -0,0 +1,155 @@
+package pipelinechecks
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops"
+	"github.com/stretchr/testify/require"
+)
+
+// the resource locations the SDK looks up before sending a request to a resource
+const testResourceLocations = `{
+	"count": 1,
+	"value": [{
+		"id": "86c8381e-5aee-4cde-8ae4-25c0c7f5eaea",
+		"area": "PipelinesChecks",
+		"resourceName": "configurations",
+		"routeTemplate": "{project}/_apis/pipelines/checks/{resource}/{id}",
+		"resourceVersion": 1,
+		"minVersion": "5.0",
+		"maxVersion": "5.1",
+		"releasedVersion": "0.0"
+	}]
+}`
+
+// records the request that was sent to the checks endpoint and replies with a fixed response
+type fakeService struct {
+	method   string
+	path     string
+	query    string
+	body     string
+	response string
+}
+
+func (f *fakeService) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodOptions {
+		w.Write([]byte(testResourceLocations))
+		return
+	}
+
+	body, _ := ioutil.ReadAll(r.Body)
+	f.method = r.Method
+	f.path = r.URL.Path
+	f.query = r.URL.RawQuery
+	f.body = string(body)
+	w.Write([]byte(f.response))
+}
+
+func newTestClient(server *httptest.Server) *ClientImpl {
+	connection := azuredevops.NewPatConnection(server.URL, "pat")
+	return &ClientImpl{Client: *azuredevops.NewClient(connection, server.URL)}
+}
+
+func TestClient_AddCheckConfiguration_SendsConfiguration(t *testing.T) {
+	service := &fakeService{response: `{"id": 12, "timeout": 60}`}
+	server := httptest.NewServer(service)
+	defer server.Close()
+	client := newTestClient(server)
+
+	project := "project"
+	resourceType := ResourceTypeEnvironment
+	resourceID := "7"
+	timeout := 60
+	check, err := client.AddCheckConfiguration(context.Background(), AddCheckConfigurationArgs{
+		Project: &project,
+		Configuration: &CheckConfiguration{
+			Type:     &CheckType{Id: &ApprovalTypeID},
+			Resource: &Resource{Type: &resourceType, Id: &resourceID},
+			Settings: map[string]interface{}{"instructions": "Approve me"},
+			Timeout:  &timeout,
+		},
+	})
+
+	require.Nil(t, err)
+	require.Equal(t, 12, *check.Id)
+	require.Equal(t, http.MethodPost, service.method)
+	require.Equal(t, "/project/_apis/pipelines/checks/configurations", service.path)
+	require.JSONEq(t, `{
+		"type": {"id": "8c6f20a7-a545-4486-9777-f762fafe0d4d"},
+		"resource": {"type": "environment", "id": "7"},
+		"settings": {"instructions": "Approve me"},
+		"timeout": 60
+	}`, service.body)
+}
+
+func TestClient_GetCheckConfiguration_ExpandsSettings(t *testing.T) {
+	service := &fakeService{response: `{"id": 12, "settings": {"instructions": "Approve me"}}`}
+	server := httptest.NewServer(service)
+	defer server.Close()
+	client := newTestClient(server)
+
+	project := "project"
+	id := 12
+	check, err := client.GetCheckConfiguration(context.Background(), GetCheckConfigurationArgs{Project: &project, Id: &id})
+
+	require.Nil(t, err)
+	require.Equal(t, map[string]interface{}{"instructions": "Approve me"}, check.Settings)
+	require.Equal(t, http.MethodGet, service.method)
+	require.Equal(t, "/project/_apis/pipelines/checks/configurations/12", service.path)
+	require.Contains(t, service.query, "%24expand=settings")
+}
+
+func TestClient_UpdateCheckConfiguration_PatchesConfiguration(t *testing.T) {
+	service := &fakeService{response: `{"id": 12, "timeout": 120}`}
+	server := httptest.NewServer(service)
+	defer server.Close()
+	client := newTestClient(server)
+
+	project := "project"
+	id := 12
+	timeout := 120
+	check, err := client.UpdateCheckConfiguration(context.Background(), UpdateCheckConfigurationArgs{
+		Project:       &project,
+		Id:            &id,
+		Configuration: &CheckConfiguration{Id: &id, Timeout: &timeout},
+	})
+
+	require.Nil(t, err)
+	require.Equal(t, 120, *check.Timeout)
+	require.Equal(t, http.MethodPatch, service.method)
+	require.Equal(t, "/project/_apis/pipelines/checks/configurations/12", service.path)
+	require.JSONEq(t, `{"id": 12, "timeout": 120}`, service.body)
+}
+
+func TestClient_DeleteCheckConfiguration_SendsDelete(t *testing.T) {
+	service := &fakeService{}
+	server := httptest.NewServer(service)
+	defer server.Close()
+	client := newTestClient(server)
+
+	project := "project"
+	id := 12
+	err := client.DeleteCheckConfiguration(context.Background(), DeleteCheckConfigurationArgs{Project: &project, Id: &id})
+
+	require.Nil(t, err)
+	require.Equal(t, http.MethodDelete, service.method)
+	require.Equal(t, "/project/_apis/pipelines/checks/configurations/12", service.path)
+}
+
+func TestClient_RequiresArguments(t *testing.T) {
+	client := &ClientImpl{}
+	project := "project"
+
+	_, err := client.AddCheckConfiguration(context.Background(), AddCheckConfigurationArgs{Project: &project})
+	require.NotNil(t, err)
+	_, err = client.GetCheckConfiguration(context.Background(), GetCheckConfigurationArgs{Project: &project})
+	require.NotNil(t, err)
+	_, err = client.UpdateCheckConfiguration(context.Background(), UpdateCheckConfigurationArgs{Project: &project, Configuration: &CheckConfiguration{}})
+	require.NotNil(t, err)
+	err = client.DeleteCheckConfiguration(context.Background(), DeleteCheckConfigurationArgs{})
+	require.NotNil(t, err)
+}
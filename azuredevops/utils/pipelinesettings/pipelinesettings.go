@@ -0,0 +1,104 @@
+// Package pipelinesettings is a client for the general pipeline settings of the projects of Azure DevOps.
+//
+// The build client of the SDK only manages the retention settings of a project. The general settings, like
+// the scope of the job authorization, were added to the build resource area with version 6.0 and are not part
+// of the SDK, so this client sends these requests to the general settings endpoint of the same resource area.
+package pipelinesettings
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/microsoft/azure-devops-go-api/azuredevops"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/build"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/azdoclient"
+)
+
+var generalSettingsLocationID, _ = uuid.Parse("c4aefd19-30ff-405b-80ad-aca021e7242a")
+
+const apiVersion = "6.0-preview.1"
+
+// GeneralSettings are the general pipeline settings of a project
+type GeneralSettings struct {
+	// Limit the scope of the access tokens of non-release pipelines to the current project.
+	EnforceJobAuthScope *bool `json:"enforceJobAuthScope,omitempty"`
+	// Limit the scope of the access tokens of release pipelines to the current project.
+	EnforceJobAuthScopeForReleases *bool `json:"enforceJobAuthScopeForReleases,omitempty"`
+	// Limit the variables that can be set at queue time to the ones marked as settable.
+	EnforceSettableVar *bool `json:"enforceSettableVar,omitempty"`
+	// Prevent anonymous access to the status badges.
+	StatusBadgesArePrivate *bool `json:"statusBadgesArePrivate,omitempty"`
+}
+
+// Client manages the general pipeline settings of a project
+type Client interface {
+	GetGeneralSettings(context.Context, GetGeneralSettingsArgs) (*GeneralSettings, error)
+	UpdateGeneralSettings(context.Context, UpdateGeneralSettingsArgs) (*GeneralSettings, error)
+}
+
+// ClientImpl sends the requests through the client of the build resource area
+type ClientImpl struct {
+	Client azuredevops.Client
+}
+
+// NewClient creates a client for the organization of the connection
+func NewClient(ctx context.Context, connection *azuredevops.Connection) (Client, error) {
+	client, err := azdoclient.NewResourceAreaClient(ctx, connection, build.ResourceAreaId)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientImpl{
+		Client: *client,
+	}, nil
+}
+
+// GetGeneralSettingsArgs are the arguments for the GetGeneralSettings function
+type GetGeneralSettingsArgs struct {
+	// (required) Project ID or project name
+	Project *string
+}
+
+// GetGeneralSettings gets the general pipeline settings of a project
+func (client *ClientImpl) GetGeneralSettings(ctx context.Context, args GetGeneralSettingsArgs) (*GeneralSettings, error) {
+	if args.Project == nil || *args.Project == "" {
+		return nil, &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.Project"}
+	}
+
+	var responseValue GeneralSettings
+	err := azdoclient.Send(ctx, &client.Client, azdoclient.Request{
+		Method:      http.MethodGet,
+		LocationID:  generalSettingsLocationID,
+		APIVersion:  apiVersion,
+		RouteValues: map[string]string{"project": *args.Project},
+	}, &responseValue)
+	return &responseValue, err
+}
+
+// UpdateGeneralSettingsArgs are the arguments for the UpdateGeneralSettings function
+type UpdateGeneralSettingsArgs struct {
+	// (required) The settings to change. Settings that are not set are left unchanged.
+	Settings *GeneralSettings
+	// (required) Project ID or project name
+	Project *string
+}
+
+// UpdateGeneralSettings changes the general pipeline settings of a project and returns all of its settings
+func (client *ClientImpl) UpdateGeneralSettings(ctx context.Context, args UpdateGeneralSettingsArgs) (*GeneralSettings, error) {
+	if args.Settings == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.Settings"}
+	}
+	if args.Project == nil || *args.Project == "" {
+		return nil, &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.Project"}
+	}
+
+	var responseValue GeneralSettings
+	err := azdoclient.Send(ctx, &client.Client, azdoclient.Request{
+		Method:      http.MethodPatch,
+		LocationID:  generalSettingsLocationID,
+		APIVersion:  apiVersion,
+		RouteValues: map[string]string{"project": *args.Project},
+		Body:        args.Settings,
+	}, &responseValue)
+	return &responseValue, err
+}
@@ -0,0 +1,99 @@
+package pipelinesettings
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops"
+	"github.com/stretchr/testify/require"
+)
+
+// the resource locations the SDK looks up before sending a request to a resource
+const testResourceLocations = `{
+	"count": 1,
+	"value": [{
+		"id": "c4aefd19-30ff-405b-80ad-aca021e7242a",
+		"area": "build",
+		"resourceName": "generalSettings",
+		"routeTemplate": "{project}/_apis/{area}/{resource}",
+		"resourceVersion": 1,
+		"minVersion": "6.0",
+		"maxVersion": "6.0",
+		"releasedVersion": "0.0"
+	}]
+}`
+
+// records the request that was sent to the general settings endpoint and replies with a fixed response
+type fakeService struct {
+	method   string
+	path     string
+	body     string
+	response string
+}
+
+func (f *fakeService) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodOptions {
+		w.Write([]byte(testResourceLocations))
+		return
+	}
+
+	body, _ := ioutil.ReadAll(r.Body)
+	f.method = r.Method
+	f.path = r.URL.Path
+	f.body = string(body)
+	w.Write([]byte(f.response))
+}
+
+func newTestClient(server *httptest.Server) *ClientImpl {
+	connection := azuredevops.NewPatConnection(server.URL, "pat")
+	return &ClientImpl{Client: *azuredevops.NewClient(connection, server.URL)}
+}
+
+func TestClient_GetGeneralSettings_ReadsSettingsOfProject(t *testing.T) {
+	service := &fakeService{response: `{"enforceJobAuthScope": true, "enforceSettableVar": false}`}
+	server := httptest.NewServer(service)
+	defer server.Close()
+	client := newTestClient(server)
+
+	project := "project"
+	settings, err := client.GetGeneralSettings(context.Background(), GetGeneralSettingsArgs{Project: &project})
+
+	require.Nil(t, err)
+	require.True(t, *settings.EnforceJobAuthScope)
+	require.False(t, *settings.EnforceSettableVar)
+	require.Nil(t, settings.StatusBadgesArePrivate)
+	require.Equal(t, http.MethodGet, service.method)
+	require.Equal(t, "/project/_apis/build/generalSettings", service.path)
+}
+
+func TestClient_UpdateGeneralSettings_OnlySendsSetSettings(t *testing.T) {
+	service := &fakeService{response: `{"enforceJobAuthScope": false, "enforceSettableVar": true}`}
+	server := httptest.NewServer(service)
+	defer server.Close()
+	client := newTestClient(server)
+
+	project := "project"
+	enabled := true
+	settings, err := client.UpdateGeneralSettings(context.Background(), UpdateGeneralSettingsArgs{
+		Settings: &GeneralSettings{EnforceSettableVar: &enabled},
+		Project:  &project,
+	})
+
+	require.Nil(t, err)
+	require.True(t, *settings.EnforceSettableVar)
+	require.Equal(t, http.MethodPatch, service.method)
+	require.Equal(t, "/project/_apis/build/generalSettings", service.path)
+	require.JSONEq(t, `{"enforceSettableVar": true}`, service.body)
+}
+
+func TestClient_UpdateGeneralSettings_RequiresSettingsAndProject(t *testing.T) {
+	project := "project"
+	_, err := (&ClientImpl{}).UpdateGeneralSettings(context.Background(), UpdateGeneralSettingsArgs{Project: &project})
+	require.NotNil(t, err)
+
+	_, err = (&ClientImpl{}).UpdateGeneralSettings(context.Background(), UpdateGeneralSettingsArgs{Settings: &GeneralSettings{}})
+	require.NotNil(t, err)
+}
@@ -1,6 +1,12 @@
 package secretmemo
 
 import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
 	"strings"
 
 	"golang.org/x/crypto/bcrypt"
@@ -10,11 +16,52 @@ const isUpdating = true
 const isNotUpdating = false
 const isErr = false
 
+// The algorithms that can be used to calculate the memo of a secret
+const (
+	AlgorithmBcrypt       = "bcrypt"
+	AlgorithmSaltedSHA256 = "salted-sha256"
+)
+
+// Salted SHA-256 memos have the form $salted-sha256$<salt>$<hash>, where the hash is the SHA-256 of the random
+// salt followed by the secret. The salt is stored in the memo, so unlike bcrypt the memo is only as hard to
+// reverse as a single SHA-256, which is cheap to brute force for guessable secrets.
+const saltedMemoPrefix = "$salted-sha256$"
+const saltLength = 16
+
+// Memos of earlier versions have the form $hmac-sha256$<salt>$<mac>, where the mac is keyed with the salt. They
+// are still matched, but no longer calculated.
+const hmacMemoPrefix = "$hmac-sha256$"
+
+// Settings are the algorithm, and for bcrypt the cost, that new memos are calculated with. Every configured
+// provider has its own settings. Memos are matched regardless of the settings they have been calculated with, so
+// memos that have been calculated before, or by a provider that is configured differently, are still matched and
+// only replaced once the secret changes.
+type Settings struct {
+	algorithm  string
+	bcryptCost int
+}
+
+// DefaultSettings calculates memos with bcrypt at its minimum cost
+func DefaultSettings() *Settings {
+	return &Settings{algorithm: AlgorithmBcrypt, bcryptCost: bcrypt.MinCost}
+}
+
+// NewSettings validates the algorithm, and the cost that is used if the algorithm is bcrypt
+func NewSettings(algorithm string, bcryptCost int) (*Settings, error) {
+	if algorithm != AlgorithmBcrypt && algorithm != AlgorithmSaltedSHA256 {
+		return nil, fmt.Errorf("the secret hashing algorithm must be either %s or %s, got %s", AlgorithmBcrypt, AlgorithmSaltedSHA256, algorithm)
+	}
+	if bcryptCost < bcrypt.MinCost || bcryptCost > bcrypt.MaxCost {
+		return nil, fmt.Errorf("the bcrypt cost must be between %d and %d, got %d", bcrypt.MinCost, bcrypt.MaxCost, bcryptCost)
+	}
+	return &Settings{algorithm: algorithm, bcryptCost: bcryptCost}, nil
+}
+
 func isBlank(s string) bool {
 	return len(strings.TrimSpace(s)) == 0
 }
 
-func isValidMemo(memo string) bool {
+func isBcryptMemo(memo string) bool {
 	validBcryptHashPrefixes := [3]string{"$2a$", "$2b$", "$2y$"}
 	for _, s := range validBcryptHashPrefixes {
 		if strings.HasPrefix(memo, s) {
@@ -24,19 +71,76 @@ func isValidMemo(memo string) bool {
 	return false
 }
 
-func calcMementoForSecret(secret, memento string) (string, error) {
+func isSaltedMemo(memo string) bool {
+	return strings.HasPrefix(memo, saltedMemoPrefix)
+}
+
+func isHMACMemo(memo string) bool {
+	return strings.HasPrefix(memo, hmacMemoPrefix)
+}
+
+func isValidMemo(memo string) bool {
+	return isBcryptMemo(memo) || isSaltedMemo(memo) || isHMACMemo(memo)
+}
+
+func calcMementoForSecret(secret string, settings *Settings) (string, error) {
+	if settings == nil {
+		settings = DefaultSettings()
+	}
+
+	if settings.algorithm == AlgorithmSaltedSHA256 {
+		salt := make([]byte, saltLength)
+		if _, err := rand.Read(salt); err != nil {
+			return "", err
+		}
+		return calcSaltedMemo(secret, salt), nil
+	}
+
 	secretAsBytes := []byte(secret)
-	hash, err := bcrypt.GenerateFromPassword(secretAsBytes, bcrypt.MinCost)
+	hash, err := bcrypt.GenerateFromPassword(secretAsBytes, settings.bcryptCost)
 	if err != nil {
 		return "", err
 	}
 	return string(hash), nil
 }
 
+func calcSaltedMemo(secret string, salt []byte) string {
+	hash := sha256.Sum256(append(append([]byte{}, salt...), secret...))
+	encoding := base64.RawStdEncoding
+	return saltedMemoPrefix + encoding.EncodeToString(salt) + "$" + encoding.EncodeToString(hash[:])
+}
+
+func calcHMACMemo(secret string, salt []byte) string {
+	mac := hmac.New(sha256.New, salt)
+	mac.Write([]byte(secret))
+	encoding := base64.RawStdEncoding
+	return hmacMemoPrefix + encoding.EncodeToString(salt) + "$" + encoding.EncodeToString(mac.Sum(nil))
+}
+
+// doesSaltedMemoMatchSecret recalculates the memo with the salt stored in it, using calcMemo of the algorithm
+// the memo has been calculated with
+func doesSaltedMemoMatchSecret(secret, memento, prefix string, calcMemo func(string, []byte) string) bool {
+	parts := strings.Split(strings.TrimPrefix(memento, prefix), "$")
+	if len(parts) != 2 {
+		return false
+	}
+	salt, err := base64.RawStdEncoding.DecodeString(parts[0])
+	if err != nil {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(calcMemo(secret, salt)), []byte(memento)) == 1
+}
+
 func doesMemoMatchSecret(secret, memento string) bool {
 	if isBlank(memento) {
 		return false
 	}
+	if isSaltedMemo(memento) {
+		return doesSaltedMemoMatchSecret(secret, memento, saltedMemoPrefix, calcSaltedMemo)
+	}
+	if isHMACMemo(memento) {
+		return doesSaltedMemoMatchSecret(secret, memento, hmacMemoPrefix, calcHMACMemo)
+	}
 	secretAsBytes := []byte(secret)
 	mementoAsBytes := []byte(memento)
 	err := bcrypt.CompareHashAndPassword(mementoAsBytes, secretAsBytes)
@@ -47,17 +151,21 @@ func doesMemoMatchSecret(secret, memento string) bool {
 	return true
 }
 
-// IsUpdating is used to determine if the secret getting updated?
-func IsUpdating(secret, oldMemo string) (bool, string, error) {
-	if isBlank(secret) {
-		return isNotUpdating, oldMemo, nil
-	}
+// IsUnchanged is used to determine if the secret is unchanged since its memo was calculated. A blank secret is
+// considered unchanged, as the secret is not known then. The result does not depend on the settings, which only
+// affect the calculation of new memos.
+func IsUnchanged(secret, memo string) bool {
+	return isBlank(secret) || doesMemoMatchSecret(secret, memo)
+}
 
-	if doesMemoMatchSecret(secret, oldMemo) {
+// IsUpdating is used to determine if the secret getting updated? The new memo of an updated secret is calculated
+// with the given settings, or with the DefaultSettings if they are nil.
+func IsUpdating(secret, oldMemo string, settings *Settings) (bool, string, error) {
+	if IsUnchanged(secret, oldMemo) {
 		return isNotUpdating, oldMemo, nil
 	}
 
-	newMemo, err := calcMementoForSecret(secret, oldMemo)
+	newMemo, err := calcMementoForSecret(secret, settings)
 	if err != nil {
 		return isErr, "", err
 	}
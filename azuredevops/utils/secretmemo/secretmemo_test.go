@@ -1,21 +1,23 @@
 package secretmemo
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
 )
 
 func TestIsNewHappyPath(t *testing.T) {
-	result, memo, err := IsUpdating("mysecret", "")
+	result, memo, err := IsUpdating("mysecret", "", nil)
 	require.True(t, result)
 	require.NotEmpty(t, memo)
 	require.Nil(t, err)
 }
 
 func TestIsUpdatingHappyPath(t *testing.T) {
-	firstResult, firstMemo, err := IsUpdating("mysecret", "")
-	secondResult, secondMemo, err := IsUpdating("mychange", firstMemo)
+	firstResult, firstMemo, err := IsUpdating("mysecret", "", nil)
+	secondResult, secondMemo, err := IsUpdating("mychange", firstMemo, nil)
 	require.True(t, firstResult)
 	require.True(t, secondResult)
 	require.NotEqual(t, firstMemo, secondMemo)
@@ -23,8 +25,8 @@ func TestIsUpdatingHappyPath(t *testing.T) {
 }
 
 func TestIsSameValueAsBeforeHappyPath(t *testing.T) {
-	firstResult, firstMemo, err := IsUpdating("mysecret", "")
-	secondResult, secondMemo, err := IsUpdating("mysecret", firstMemo)
+	firstResult, firstMemo, err := IsUpdating("mysecret", "", nil)
+	secondResult, secondMemo, err := IsUpdating("mysecret", firstMemo, nil)
 	require.True(t, firstResult)
 	require.False(t, secondResult)
 	require.EqualValues(t, firstMemo, secondMemo)
@@ -32,14 +34,14 @@ func TestIsSameValueAsBeforeHappyPath(t *testing.T) {
 }
 
 func TestIsRottenMemo(t *testing.T) {
-	result, memo, err := IsUpdating("mysecret", "!@#$")
+	result, memo, err := IsUpdating("mysecret", "!@#$", nil)
 	require.True(t, result)
 	require.NotEmpty(t, memo)
 	require.Nil(t, err)
 }
 
 func TestIsMissingSecret(t *testing.T) {
-	result, memo, err := IsUpdating("", "anything")
+	result, memo, err := IsUpdating("", "anything", nil)
 	require.False(t, result)
 	require.Equal(t, "anything", memo)
 	require.Nil(t, err)
@@ -51,3 +53,122 @@ func TestIsValidMemo(t *testing.T) {
 	require.True(t, isValidMemo("$2b$"))
 	require.True(t, isValidMemo("$2y$"))
 }
+
+func TestIsValidSaltedMemo(t *testing.T) {
+	require.True(t, isValidMemo("$salted-sha256$"))
+	require.True(t, isValidMemo("$hmac-sha256$"))
+}
+
+func TestNewSettingsRejectsInvalidSettings(t *testing.T) {
+	_, err := NewSettings("md5", bcrypt.MinCost)
+	require.NotNil(t, err)
+	_, err = NewSettings(AlgorithmBcrypt, bcrypt.MinCost-1)
+	require.NotNil(t, err)
+	_, err = NewSettings(AlgorithmBcrypt, bcrypt.MaxCost+1)
+	require.NotNil(t, err)
+}
+
+func TestConfiguredBcryptCostIsUsed(t *testing.T) {
+	settings, err := NewSettings(AlgorithmBcrypt, bcrypt.MinCost+1)
+	require.Nil(t, err)
+
+	_, memo, err := IsUpdating("mysecret", "", settings)
+	require.Nil(t, err)
+	cost, err := bcrypt.Cost([]byte(memo))
+	require.Nil(t, err)
+	require.Equal(t, bcrypt.MinCost+1, cost)
+}
+
+// the settings of one provider do not affect the memos calculated for another one
+func TestSettingsOfDifferentProvidersAreKeptApart(t *testing.T) {
+	saltedSettings, err := NewSettings(AlgorithmSaltedSHA256, bcrypt.MinCost)
+	require.Nil(t, err)
+	bcryptSettings, err := NewSettings(AlgorithmBcrypt, bcrypt.MinCost+1)
+	require.Nil(t, err)
+
+	_, saltedMemo, err := IsUpdating("mysecret", "", saltedSettings)
+	require.Nil(t, err)
+	_, bcryptMemo, err := IsUpdating("mysecret", "", bcryptSettings)
+	require.Nil(t, err)
+
+	require.True(t, isSaltedMemo(saltedMemo))
+	cost, err := bcrypt.Cost([]byte(bcryptMemo))
+	require.Nil(t, err)
+	require.Equal(t, bcrypt.MinCost+1, cost)
+}
+
+func TestSaltedMemoHappyPath(t *testing.T) {
+	settings, err := NewSettings(AlgorithmSaltedSHA256, bcrypt.MinCost)
+	require.Nil(t, err)
+
+	firstResult, firstMemo, err := IsUpdating("mysecret", "", settings)
+	require.Nil(t, err)
+	require.True(t, firstResult)
+	require.True(t, strings.HasPrefix(firstMemo, saltedMemoPrefix))
+
+	secondResult, secondMemo, err := IsUpdating("mysecret", firstMemo, settings)
+	require.Nil(t, err)
+	require.False(t, secondResult)
+	require.Equal(t, firstMemo, secondMemo)
+
+	thirdResult, thirdMemo, err := IsUpdating("mychange", firstMemo, settings)
+	require.Nil(t, err)
+	require.True(t, thirdResult)
+	require.NotEqual(t, firstMemo, thirdMemo)
+}
+
+// memos calculated with another algorithm must still match, so that changing the algorithm does not force
+// an update. They are replaced with a memo of the configured algorithm once the secret changes.
+func TestMemosOfOtherAlgorithmsStillMatch(t *testing.T) {
+	saltedSettings, err := NewSettings(AlgorithmSaltedSHA256, bcrypt.MinCost)
+	require.Nil(t, err)
+
+	_, bcryptMemo, err := IsUpdating("mysecret", "", DefaultSettings())
+	require.Nil(t, err)
+
+	result, memo, err := IsUpdating("mysecret", bcryptMemo, saltedSettings)
+	require.Nil(t, err)
+	require.False(t, result)
+	require.Equal(t, bcryptMemo, memo)
+
+	result, memo, err = IsUpdating("mychange", bcryptMemo, saltedSettings)
+	require.Nil(t, err)
+	require.True(t, result)
+	require.True(t, isSaltedMemo(memo))
+
+	result, _, err = IsUpdating("mychange", memo, DefaultSettings())
+	require.Nil(t, err)
+	require.False(t, result)
+}
+
+// memos calculated with the HMAC-SHA256 of earlier versions must still match, but are replaced with a memo of
+// the configured algorithm once the secret changes
+func TestHMACMemosOfEarlierVersionsStillMatch(t *testing.T) {
+	hmacMemo := calcHMACMemo("mysecret", []byte("0123456789abcdef"))
+
+	result, memo, err := IsUpdating("mysecret", hmacMemo, nil)
+	require.Nil(t, err)
+	require.False(t, result)
+	require.Equal(t, hmacMemo, memo)
+
+	result, memo, err = IsUpdating("mychange", hmacMemo, nil)
+	require.Nil(t, err)
+	require.True(t, result)
+	require.True(t, isBcryptMemo(memo))
+}
+
+func TestHMACIsNoLongerAnAlgorithm(t *testing.T) {
+	_, err := NewSettings("hmac-sha256", bcrypt.MinCost)
+	require.NotNil(t, err)
+}
+
+func TestIsRottenSaltedMemo(t *testing.T) {
+	for _, memo := range []string{
+		"$salted-sha256$", "$salted-sha256$!!$abc", "$salted-sha256$abc$def$ghi",
+		"$hmac-sha256$", "$hmac-sha256$!!$abc", "$hmac-sha256$abc$def$ghi",
+	} {
+		result, _, err := IsUpdating("mysecret", memo, nil)
+		require.Nil(t, err)
+		require.True(t, result, memo)
+	}
+}
@@ -0,0 +1,176 @@
+// Package securityroles is a client for the security roles of Azure DevOps.
+//
+// Agent pools, agent queues, environments, service endpoints and other objects grant access through roles
+// that are assigned to identities. Roles are either assigned on the object itself or inherited from the
+// object's parent. The SDK does not contain a client for the security roles API, so this client sends the
+// requests to its endpoint directly.
+package securityroles
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/microsoft/azure-devops-go-api/azuredevops"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/azdoclient"
+)
+
+// The ways an identity can hold a role
+const (
+	AccessAssigned  = "assigned"
+	AccessInherited = "inherited"
+)
+
+var locationID, _ = uuid.Parse("9461c234-c84c-4ed2-b918-2f0f92ad0a35")
+
+const apiVersion = "5.1-preview.1"
+
+// IdentityRef is the identity a role is assigned to
+type IdentityRef struct {
+	DisplayName *string `json:"displayName,omitempty"`
+	Id          *string `json:"id,omitempty"`
+	UniqueName  *string `json:"uniqueName,omitempty"`
+}
+
+// SecurityRole is a role that grants a set of permissions on the objects of a scope
+type SecurityRole struct {
+	AllowPermissions *int    `json:"allowPermissions,omitempty"`
+	DenyPermissions  *int    `json:"denyPermissions,omitempty"`
+	Description      *string `json:"description,omitempty"`
+	DisplayName      *string `json:"displayName,omitempty"`
+	Identifier       *string `json:"identifier,omitempty"`
+	Name             *string `json:"name,omitempty"`
+	Scope            *string `json:"scope,omitempty"`
+}
+
+// RoleAssignment is a role held by an identity on an object
+type RoleAssignment struct {
+	// Whether the role is assigned on the object itself or inherited from its parent, see AccessAssigned and AccessInherited
+	Access            *string       `json:"access,omitempty"`
+	AccessDisplayName *string       `json:"accessDisplayName,omitempty"`
+	Identity          *IdentityRef  `json:"identity,omitempty"`
+	Role              *SecurityRole `json:"role,omitempty"`
+}
+
+// UserRoleAssignmentRef assigns a role to an identity
+type UserRoleAssignmentRef struct {
+	RoleName *string    `json:"roleName,omitempty"`
+	UserId   *uuid.UUID `json:"userId,omitempty"`
+}
+
+// Client manages the roles assigned on the objects of a scope
+type Client interface {
+	ListRoleAssignments(context.Context, ListRoleAssignmentsArgs) (*[]RoleAssignment, error)
+	SetRoleAssignments(context.Context, SetRoleAssignmentsArgs) (*[]RoleAssignment, error)
+	RemoveRoleAssignments(context.Context, RemoveRoleAssignmentsArgs) error
+}
+
+// ClientImpl sends the requests through a client for the organization
+type ClientImpl struct {
+	Client azuredevops.Client
+}
+
+// NewClient creates a client for the organization of the connection, which looks up the location of the
+// security roles endpoint at the organization itself
+func NewClient(ctx context.Context, connection *azuredevops.Connection) Client {
+	client := azdoclient.NewOrganizationClient(connection)
+	return &ClientImpl{
+		Client: *client,
+	}
+}
+
+// ListRoleAssignmentsArgs are the arguments for the ListRoleAssignments function
+type ListRoleAssignmentsArgs struct {
+	// (required) ID of the scope, e.g. distributedtask.agentpoolrole
+	Scope *string
+	// (required) ID of the object within the scope
+	ResourceId *string
+}
+
+// ListRoleAssignments lists the roles assigned on an object, including the inherited ones
+func (client *ClientImpl) ListRoleAssignments(ctx context.Context, args ListRoleAssignmentsArgs) (*[]RoleAssignment, error) {
+	routeValues, err := resourceRouteValues(args.Scope, args.ResourceId)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseValue []RoleAssignment
+	err = azdoclient.SendCollection(ctx, &client.Client, azdoclient.Request{
+		Method:      http.MethodGet,
+		LocationID:  locationID,
+		APIVersion:  apiVersion,
+		RouteValues: routeValues,
+	}, &responseValue)
+	return &responseValue, err
+}
+
+// SetRoleAssignmentsArgs are the arguments for the SetRoleAssignments function
+type SetRoleAssignmentsArgs struct {
+	// (required) The roles to assign.
+	RoleAssignments *[]UserRoleAssignmentRef
+	// (required) ID of the scope, e.g. distributedtask.agentpoolrole
+	Scope *string
+	// (required) ID of the object within the scope
+	ResourceId *string
+}
+
+// SetRoleAssignments assigns roles on an object, replacing the roles the identities held on it before
+func (client *ClientImpl) SetRoleAssignments(ctx context.Context, args SetRoleAssignmentsArgs) (*[]RoleAssignment, error) {
+	if args.RoleAssignments == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.RoleAssignments"}
+	}
+	routeValues, err := resourceRouteValues(args.Scope, args.ResourceId)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseValue []RoleAssignment
+	err = azdoclient.SendCollection(ctx, &client.Client, azdoclient.Request{
+		Method:      http.MethodPut,
+		LocationID:  locationID,
+		APIVersion:  apiVersion,
+		RouteValues: routeValues,
+		Body:        args.RoleAssignments,
+	}, &responseValue)
+	return &responseValue, err
+}
+
+// RemoveRoleAssignmentsArgs are the arguments for the RemoveRoleAssignments function
+type RemoveRoleAssignmentsArgs struct {
+	// (required) The identities whose roles are removed.
+	IdentityIds *[]uuid.UUID
+	// (required) ID of the scope, e.g. distributedtask.agentpoolrole
+	Scope *string
+	// (required) ID of the object within the scope
+	ResourceId *string
+}
+
+// RemoveRoleAssignments removes the roles assigned to identities on an object, after which the identities
+// only hold the roles they inherit from the parent of the object
+func (client *ClientImpl) RemoveRoleAssignments(ctx context.Context, args RemoveRoleAssignmentsArgs) error {
+	if args.IdentityIds == nil {
+		return &azuredevops.ArgumentNilError{ArgumentName: "args.IdentityIds"}
+	}
+	routeValues, err := resourceRouteValues(args.Scope, args.ResourceId)
+	if err != nil {
+		return err
+	}
+
+	return azdoclient.SendCollection(ctx, &client.Client, azdoclient.Request{
+		Method:      http.MethodPatch,
+		LocationID:  locationID,
+		APIVersion:  apiVersion,
+		RouteValues: routeValues,
+		Body:        args.IdentityIds,
+	}, nil)
+}
+
+func resourceRouteValues(scope *string, resourceID *string) (map[string]string, error) {
+	if scope == nil || *scope == "" {
+		return nil, &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.Scope"}
+	}
+	if resourceID == nil || *resourceID == "" {
+		return nil, &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.ResourceId"}
+	}
+	return map[string]string{"scopeId": *scope, "resourceId": *resourceID}, nil
+}
@@ -0,0 +1,131 @@
+package securityroles
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/microsoft/azure-devops-go-api/azuredevops"
+	"github.com/stretchr/testify/require"
+)
+
+// the resource locations the SDK looks up before sending a request to a resource
+const testResourceLocations = `{
+	"count": 1,
+	"value": [{
+		"id": "9461c234-c84c-4ed2-b918-2f0f92ad0a35",
+		"area": "securityroles",
+		"resourceName": "roleassignments",
+		"routeTemplate": "_apis/securityroles/scopes/{scopeId}/roleassignments/resources/{resourceId}/{identityId}",
+		"resourceVersion": 1,
+		"minVersion": "5.0",
+		"maxVersion": "5.1",
+		"releasedVersion": "0.0"
+	}]
+}`
+
+// records the request that was sent to the security roles endpoint and replies with a fixed response
+type fakeService struct {
+	method   string
+	path     string
+	body     string
+	response string
+}
+
+func (f *fakeService) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodOptions {
+		w.Write([]byte(testResourceLocations))
+		return
+	}
+
+	body, _ := ioutil.ReadAll(r.Body)
+	f.method = r.Method
+	f.path = r.URL.Path
+	f.body = string(body)
+	w.Write([]byte(f.response))
+}
+
+func newTestClient(server *httptest.Server) *ClientImpl {
+	connection := azuredevops.NewPatConnection(server.URL, "pat")
+	return &ClientImpl{Client: *azuredevops.NewClient(connection, server.URL)}
+}
+
+var (
+	testScope      = "distributedtask.agentpoolrole"
+	testResourceID = "12"
+	testIdentityID = uuid.MustParse("d5d2f4c1-3cfa-4c5b-a1a9-ef0a3b36c4a7")
+)
+
+func TestClient_ListRoleAssignments_ReturnsAssignments(t *testing.T) {
+	service := &fakeService{response: `{"count": 1, "value": [{
+		"access": "assigned",
+		"identity": {"id": "d5d2f4c1-3cfa-4c5b-a1a9-ef0a3b36c4a7", "displayName": "Builders"},
+		"role": {"name": "Administrator", "scope": "distributedtask.agentpoolrole"}
+	}]}`}
+	server := httptest.NewServer(service)
+	defer server.Close()
+	client := newTestClient(server)
+
+	assignments, err := client.ListRoleAssignments(context.Background(), ListRoleAssignmentsArgs{
+		Scope:      &testScope,
+		ResourceId: &testResourceID,
+	})
+
+	require.Nil(t, err)
+	require.Len(t, *assignments, 1)
+	require.Equal(t, AccessAssigned, *(*assignments)[0].Access)
+	require.Equal(t, "Administrator", *(*assignments)[0].Role.Name)
+	require.Equal(t, testIdentityID.String(), *(*assignments)[0].Identity.Id)
+	require.Equal(t, http.MethodGet, service.method)
+	require.Equal(t, "/_apis/securityroles/scopes/distributedtask.agentpoolrole/roleassignments/resources/12", service.path)
+}
+
+func TestClient_SetRoleAssignments_PutsAssignments(t *testing.T) {
+	service := &fakeService{response: `{"count": 0, "value": []}`}
+	server := httptest.NewServer(service)
+	defer server.Close()
+	client := newTestClient(server)
+
+	roleName := "User"
+	_, err := client.SetRoleAssignments(context.Background(), SetRoleAssignmentsArgs{
+		Scope:           &testScope,
+		ResourceId:      &testResourceID,
+		RoleAssignments: &[]UserRoleAssignmentRef{{UserId: &testIdentityID, RoleName: &roleName}},
+	})
+
+	require.Nil(t, err)
+	require.Equal(t, http.MethodPut, service.method)
+	require.Equal(t, "/_apis/securityroles/scopes/distributedtask.agentpoolrole/roleassignments/resources/12", service.path)
+	require.JSONEq(t, `[{"userId": "d5d2f4c1-3cfa-4c5b-a1a9-ef0a3b36c4a7", "roleName": "User"}]`, service.body)
+}
+
+func TestClient_RemoveRoleAssignments_PatchesIdentities(t *testing.T) {
+	service := &fakeService{}
+	server := httptest.NewServer(service)
+	defer server.Close()
+	client := newTestClient(server)
+
+	err := client.RemoveRoleAssignments(context.Background(), RemoveRoleAssignmentsArgs{
+		Scope:       &testScope,
+		ResourceId:  &testResourceID,
+		IdentityIds: &[]uuid.UUID{testIdentityID},
+	})
+
+	require.Nil(t, err)
+	require.Equal(t, http.MethodPatch, service.method)
+	require.Equal(t, "/_apis/securityroles/scopes/distributedtask.agentpoolrole/roleassignments/resources/12", service.path)
+	require.JSONEq(t, `["d5d2f4c1-3cfa-4c5b-a1a9-ef0a3b36c4a7"]`, service.body)
+}
+
+func TestClient_RequiresScopeAndResource(t *testing.T) {
+	client := &ClientImpl{}
+	empty := ""
+
+	_, err := client.ListRoleAssignments(context.Background(), ListRoleAssignmentsArgs{Scope: &empty, ResourceId: &testResourceID})
+	require.NotNil(t, err)
+	_, err = client.ListRoleAssignments(context.Background(), ListRoleAssignmentsArgs{Scope: &testScope})
+	require.NotNil(t, err)
+}
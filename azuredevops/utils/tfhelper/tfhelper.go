@@ -3,48 +3,110 @@ package tfhelper
 import (
 	"fmt"
 	"log"
+	"net"
+	"net/url"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/azdoerror"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/secretmemo"
 )
 
-// DiffFuncSupressCaseSensitivity Suppress case sensitivity when comparing string values
+// DiffFuncSupressCaseSensitivity Suppress case sensitivity when comparing string values. Values are compared
+// using Unicode case folding, which unlike lowercasing also matches e.g. the long s with an S.
 func DiffFuncSupressCaseSensitivity(k, old, new string, d *schema.ResourceData) bool {
-	if strings.ToLower(old) == strings.ToLower(new) {
+	return strings.EqualFold(old, new)
+}
+
+// DiffFuncSuppressURLEquivalence suppresses diffs between URLs that only differ in the case of their scheme
+// or host, in an explicit default port, or in a single trailing slash of their path. Values that are not
+// absolute URLs are compared exactly.
+func DiffFuncSuppressURLEquivalence(k, old, new string, d *schema.ResourceData) bool {
+	if old == new {
 		return true
 	}
-	return false
+
+	normalizedOld, ok := normalizeURL(old)
+	if !ok {
+		return false
+	}
+	normalizedNew, ok := normalizeURL(new)
+	if !ok {
+		return false
+	}
+	return normalizedOld == normalizedNew
+}
+
+var defaultPorts = map[string]string{
+	"http":  "80",
+	"https": "443",
+}
+
+func normalizeURL(value string) (string, bool) {
+	u, err := url.Parse(value)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return "", false
+	}
+
+	u.Scheme = strings.ToLower(u.Scheme)
+	host := strings.ToLower(u.Hostname())
+	port := u.Port()
+	if port == defaultPorts[u.Scheme] {
+		port = ""
+	}
+
+	if port != "" {
+		u.Host = net.JoinHostPort(host, port)
+	} else if strings.Contains(host, ":") {
+		// IPv6 addresses have to be enclosed in brackets
+		u.Host = "[" + host + "]"
+	} else {
+		u.Host = host
+	}
+
+	u.Path = strings.TrimSuffix(u.Path, "/")
+	u.RawPath = strings.TrimSuffix(u.RawPath, "/")
+	return u.String(), true
 }
 
 func calcSecretHashKey(secretKey string) string {
 	return secretKey + "_hash"
 }
 
+// SkipSecretHashKey is the attribute of a resource that turns off the diff suppression of its secrets. Resources
+// add it with GenerateSkipSecretHashSchema.
+const SkipSecretHashKey = "skip_secret_hash"
+
 // DiffFuncSupressSecretChanged is used to supress unneeded `apply` updates to a resource.
 //
 // It returns `true` when `new` appears to be the same value
-// as a previously stored and bcrypt'd value stored in state during a previous `apply`.
+// as a previously stored and hashed value stored in state during a previous `apply`.
 // Relies on flatten/expand logic to help store that hash. See FlattenSecret, below.*/
+//
+// Whether a secret matches its hash does not depend on the secret hashing settings of the provider, which diff
+// functions have no access to. The settings only affect the hashes that are calculated while flattening.
+//
+// Nothing is suppressed if the SkipSecretHashKey attribute of the resource is set, so that the configured secret is
+// sent on every apply.
 func DiffFuncSupressSecretChanged(k, old, new string, d *schema.ResourceData) bool {
+	if skip, ok := d.GetOk(SkipSecretHashKey); ok && skip.(bool) {
+		log.Printf("Change forced. %s is set, so the secret %s is always updated", SkipSecretHashKey, k)
+		return false
+	}
+
 	memoKey := calcSecretHashKey(k)
 	memoValue := d.Get(memoKey).(string)
 
-	isUpdating, _, err := secretmemo.IsUpdating(new, memoValue)
-	isUnchanged := !isUpdating
-
-	if nil != err {
-		log.Printf("Change forced. Swallowing err while using secret hashing: %s", err)
-		return false
-	}
+	isUnchanged := secretmemo.IsUnchanged(new, memoValue)
 
 	log.Printf("\nk: %s, old: %s, new: %s, memoKey: %s, memoValue: %s, isUnchanged: %t\n",
 		k, old, new, memoKey, memoValue, isUnchanged)
 	return isUnchanged
 }
 
-// HelpFlattenSecret is used to store a hashed secret value into `tfstate`
-func HelpFlattenSecret(d *schema.ResourceData, secretKey string) {
+// HelpFlattenSecret is used to store a hashed secret value into `tfstate`. The hash of a changed secret is
+// calculated with the secret hashing settings of the provider that manages the resource.
+func HelpFlattenSecret(d *schema.ResourceData, secretKey string, settings *secretmemo.Settings) {
 	if !d.HasChange(secretKey) {
 		log.Printf("Secret key %s didn't get updated.", secretKey)
 		return
@@ -52,7 +114,7 @@ func HelpFlattenSecret(d *schema.ResourceData, secretKey string) {
 	hashKey := calcSecretHashKey(secretKey)
 	newSecret := d.Get(secretKey).(string)
 	oldHash := d.Get(hashKey).(string)
-	_, newHash, err := secretmemo.IsUpdating(newSecret, oldHash)
+	_, newHash, err := secretmemo.IsUpdating(newSecret, oldHash, settings)
 	if nil != err {
 		log.Printf("Swallowing err while using secret hashing: %s", err)
 	}
@@ -60,14 +122,72 @@ func HelpFlattenSecret(d *schema.ResourceData, secretKey string) {
 	d.Set(hashKey, newHash)
 }
 
+// HelpFlattenSecretNested is used to store a hashed secret value of a single-item nested block into `tfstate`.
+// Because a nested attribute cannot be set on its own, the hash is written into the flattened block instead.
+func HelpFlattenSecretNested(d *schema.ResourceData, parentKey string, flattened map[string]interface{}, secretKey string, settings *secretmemo.Settings) {
+	HelpFlattenSecretNestedAt(d, parentKey, 0, flattened, secretKey, settings)
+}
+
+// HelpFlattenSecretNestedAt is used to store a hashed secret value of the item at the given index of a nested block
+// into `tfstate`. The index refers to the position of the item in the configuration or the current state.
+func HelpFlattenSecretNestedAt(d *schema.ResourceData, parentKey string, index int, flattened map[string]interface{}, secretKey string, settings *secretmemo.Settings) {
+	hashKey := calcSecretHashKey(secretKey)
+	secretPath := fmt.Sprintf("%s.%d.%s", parentKey, index, secretKey)
+	hashPath := fmt.Sprintf("%s.%d.%s", parentKey, index, hashKey)
+	oldHash, _ := d.Get(hashPath).(string)
+	if !d.HasChange(secretPath) {
+		log.Printf("Secret key %s didn't get updated.", secretPath)
+		flattened[hashKey] = oldHash
+		return
+	}
+	newSecret, _ := d.Get(secretPath).(string)
+	_, newHash, err := secretmemo.IsUpdating(newSecret, oldHash, settings)
+	if nil != err {
+		log.Printf("Swallowing err while using secret hashing: %s", err)
+	}
+	log.Printf("Secret key %s is updated. It's new hash key and value is %s and %s.", secretPath, hashPath, newHash)
+	flattened[hashKey] = newHash
+}
+
 // GenerateSecreteMemoSchema is used to create Schema defs to house the hashed secret in `tfstate`
 func GenerateSecreteMemoSchema(secretKey string) (string, *schema.Schema) {
 	out := schema.Schema{
 		Type:        schema.TypeString,
 		Computed:    true,
 		Default:     nil,
-		Description: fmt.Sprintf("A bcrypted hash of the attribute '%s'", secretKey),
+		Description: fmt.Sprintf("A hash of the attribute '%s'", secretKey),
 		Sensitive:   true,
 	}
 	return calcSecretHashKey(secretKey), &out
 }
+
+// GenerateSkipSecretHashSchema is used to create the Schema def of the SkipSecretHashKey attribute of a resource
+func GenerateSkipSecretHashSchema() (string, *schema.Schema) {
+	out := schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Always update the secrets of the resource on apply, instead of only when they changed",
+	}
+	return SkipSecretHashKey, &out
+}
+
+// ClearIDIfNotFound Removes a resource from the state if err indicates that its object was deleted outside of
+// Terraform, so that Terraform plans to create the object again instead of failing to refresh it. Reads return
+// nil if the resource was removed, and their own error otherwise:
+//
+//	if err != nil {
+//		if tfhelper.ClearIDIfNotFound(d, err) {
+//			return nil
+//		}
+//		return fmt.Errorf("Error looking up ...: %v", err)
+//	}
+func ClearIDIfNotFound(d *schema.ResourceData, err error) bool {
+	if err == nil || !azdoerror.IsNotFound(err) {
+		return false
+	}
+
+	log.Printf("[WARN] %s no longer exists, removing it from the state: %v", d.Id(), err)
+	d.SetId("")
+	return true
+}
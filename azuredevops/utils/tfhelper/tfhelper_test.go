@@ -1,7 +1,16 @@
 package tfhelper
 
 import (
+	"errors"
+	"net/http"
 	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/microsoft/azure-devops-go-api/azuredevops"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/secretmemo"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
 )
 
 func TestDiffFuncSupressCaseSensitivity(t *testing.T) {
@@ -13,10 +22,18 @@ func TestDiffFuncSupressCaseSensitivity(t *testing.T) {
 	}
 
 	tests := []testParams{
-		{"hello", "HELLO", true},  // logically the same
-		{"hello", "hElLo", true},  // logically the same
-		{"hello", "world", false}, // logically different
-		{"hello", "WORLD", false}, // logically different
+		{"hello", "HELLO", true},               // logically the same
+		{"hello", "hElLo", true},               // logically the same
+		{"hello", "world", false},              // logically different
+		{"hello", "WORLD", false},              // logically different
+		{"", "", true},                         // logically the same
+		{"hello", "hello ", false},             // logically different
+		{"Project-1", "pROJECT-1", true},       // logically the same
+		{"\u212Aelvin", "kelvin", true},        // the Kelvin sign folds to k
+		{"\u017Fervice", "SERVICE", true},      // the long s folds to s
+		{"\u03A3\u03C3", "\u03C2\u03C2", true}, // all forms of sigma fold to each other
+		{"\u0131d", "ID", false},               // the dotless i has no simple case folding
+		{"\u0130d", "id", false},               // neither has the dotted capital I
 	}
 
 	for _, test := range tests {
@@ -25,3 +42,148 @@ func TestDiffFuncSupressCaseSensitivity(t *testing.T) {
 		}
 	}
 }
+
+func TestDiffFuncSuppressURLEquivalence(t *testing.T) {
+	tests := []struct {
+		old        string
+		new        string
+		equivalent bool
+	}{
+		{"https://contoso.com/path", "https://contoso.com/path", true},
+		{"https://contoso.com/path", "https://contoso.com/path/", true},
+		{"https://contoso.com/", "https://contoso.com", true},
+		{"HTTPS://Contoso.COM/path", "https://contoso.com/path", true},
+		{"https://contoso.com/Path", "https://contoso.com/path", false},
+		{"http://contoso.com", "https://contoso.com", false},
+		{"https://contoso.com:443/path", "https://contoso.com/path", true},
+		{"http://contoso.com:80/path", "http://contoso.com/path", true},
+		{"http://contoso.com:443/path", "http://contoso.com/path", false},
+		{"https://contoso.com:8443/path", "https://contoso.com:8443/path/", true},
+		{"https://contoso.com:8443", "https://contoso.com", false},
+		{"https://[::1]:443/", "https://[::1]", true},
+		{"https://contoso.com/path//", "https://contoso.com/path", false},
+		{"https://contoso.com/path?a=b", "https://contoso.com/path/?a=b", true},
+		{"", "", true},
+		{"", "https://contoso.com", false},
+		{"contoso.com/", "contoso.com", false},
+		{"not a url", "not a url", true},
+		{"https://contoso.com/%zz", "https://contoso.com/%zz/", false},
+	}
+
+	for _, test := range tests {
+		actual := DiffFuncSuppressURLEquivalence("", test.old, test.new, nil)
+		require.Equal(t, test.equivalent, actual, "%s compared to %s", test.old, test.new)
+	}
+}
+
+func TestHelpFlattenSecretNested_StoresHashOfChangedSecret(t *testing.T) {
+	nestedSchema := map[string]*schema.Schema{
+		"block": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"secret":      {Type: schema.TypeString, Optional: true},
+					"secret_hash": {Type: schema.TypeString, Computed: true},
+				},
+			},
+		},
+	}
+	d := schema.TestResourceDataRaw(t, nestedSchema, map[string]interface{}{
+		"block": []interface{}{map[string]interface{}{"secret": "mysecret"}},
+	})
+
+	flattened := map[string]interface{}{}
+	HelpFlattenSecretNested(d, "block", flattened, "secret", nil)
+
+	hash := flattened["secret_hash"].(string)
+	require.NotEmpty(t, hash)
+	require.Nil(t, bcrypt.CompareHashAndPassword([]byte(hash), []byte("mysecret")))
+}
+
+func TestHelpFlattenSecretNestedAt_StoresHashOfSecretAtIndex(t *testing.T) {
+	nestedSchema := map[string]*schema.Schema{
+		"block": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"secret":      {Type: schema.TypeString, Optional: true},
+					"secret_hash": {Type: schema.TypeString, Computed: true},
+				},
+			},
+		},
+	}
+	d := schema.TestResourceDataRaw(t, nestedSchema, map[string]interface{}{
+		"block": []interface{}{
+			map[string]interface{}{"secret": "first"},
+			map[string]interface{}{"secret": "second"},
+		},
+	})
+
+	flattened := map[string]interface{}{}
+	HelpFlattenSecretNestedAt(d, "block", 1, flattened, "secret", nil)
+
+	hash := flattened["secret_hash"].(string)
+	require.NotEmpty(t, hash)
+	require.Nil(t, bcrypt.CompareHashAndPassword([]byte(hash), []byte("second")))
+}
+
+func testSecretSchema() map[string]*schema.Schema {
+	hashKey, hashSchema := GenerateSecreteMemoSchema("secret")
+	return map[string]*schema.Schema{
+		"secret": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Sensitive:        true,
+			DiffSuppressFunc: DiffFuncSupressSecretChanged,
+		},
+		hashKey: hashSchema,
+	}
+}
+
+// verifies that an unchanged secret is planned to change on every apply if the diff suppression is skipped
+func TestDiffFuncSupressSecretChanged_SkipSecretHash(t *testing.T) {
+	secretSchema := testSecretSchema()
+	skipKey, skipSchema := GenerateSkipSecretHashSchema()
+	secretSchema[skipKey] = skipSchema
+
+	_, hash, err := secretmemo.IsUpdating("mysecret", "", nil)
+	require.Nil(t, err)
+	state := &terraform.InstanceState{ID: "id", Attributes: map[string]string{"id": "id", "secret_hash": hash}}
+
+	planned := func(skip bool) bool {
+		cfg := terraform.NewResourceConfigRaw(map[string]interface{}{"secret": "mysecret", skipKey: skip})
+		diff, err := schema.InternalMap(secretSchema).Diff(state, cfg, nil, nil, true)
+		require.Nil(t, err)
+		if diff == nil {
+			return false
+		}
+		attr, ok := diff.Attributes["secret"]
+		return ok && attr.Old != attr.New
+	}
+
+	require.False(t, planned(false))
+	require.True(t, planned(true))
+}
+
+func TestClearIDIfNotFound_ClearsIDOfDeletedObject(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{}, map[string]interface{}{})
+	d.SetId("id")
+
+	notFound := http.StatusNotFound
+	require.True(t, ClearIDIfNotFound(d, azuredevops.WrappedError{StatusCode: &notFound}))
+	require.Equal(t, "", d.Id())
+}
+
+func TestClearIDIfNotFound_KeepsIDOnOtherErrors(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{}, map[string]interface{}{})
+	d.SetId("id")
+
+	forbidden := http.StatusForbidden
+	require.False(t, ClearIDIfNotFound(d, azuredevops.WrappedError{StatusCode: &forbidden}))
+	require.False(t, ClearIDIfNotFound(d, errors.New("connection reset")))
+	require.False(t, ClearIDIfNotFound(d, nil))
+	require.Equal(t, "id", d.Id())
+}
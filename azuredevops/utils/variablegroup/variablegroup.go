@@ -0,0 +1,175 @@
+// Package variablegroup is a client for the variable groups of the Azure DevOps distributed task service.
+//
+// The models of the taskagent client of the SDK declare the provider data of a variable group as an empty
+// struct, which drops the Azure Key Vault linkage of a group on both requests and responses. This client
+// uses the same endpoint and API version, but keeps the provider data.
+package variablegroup
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/microsoft/azure-devops-go-api/azuredevops"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/azdoclient"
+)
+
+// The types of variable groups
+const (
+	TypeVsts          = "Vsts"
+	TypeAzureKeyVault = "AzureKeyVault"
+)
+
+var locationID, _ = uuid.Parse("f5b09dd5-9d54-45a1-8b5a-1c8287d634cc")
+
+const apiVersion = "5.1-preview.1"
+
+// ProviderData links a variable group of type AzureKeyVault to a vault
+type ProviderData struct {
+	LastRefreshedOn   *azuredevops.Time `json:"lastRefreshedOn,omitempty"`
+	ServiceEndpointID *uuid.UUID        `json:"serviceEndpointId,omitempty"`
+	Vault             *string           `json:"vault,omitempty"`
+}
+
+// VariableValue is the value of a variable. The values of the secrets of a group linked to an Azure Key Vault
+// are never returned, and those secrets are only used by pipelines while they are enabled.
+type VariableValue struct {
+	Enabled  *bool   `json:"enabled,omitempty"`
+	IsSecret *bool   `json:"isSecret,omitempty"`
+	Value    *string `json:"value,omitempty"`
+}
+
+// VariableGroup is a variable group as returned by the service
+type VariableGroup struct {
+	Description  *string                   `json:"description,omitempty"`
+	Id           *int                      `json:"id,omitempty"`
+	Name         *string                   `json:"name,omitempty"`
+	ProviderData *ProviderData             `json:"providerData,omitempty"`
+	Type         *string                   `json:"type,omitempty"`
+	Variables    *map[string]VariableValue `json:"variables,omitempty"`
+}
+
+// Parameters describe a variable group that is created or updated
+type Parameters struct {
+	Description  *string                   `json:"description,omitempty"`
+	Name         *string                   `json:"name,omitempty"`
+	ProviderData *ProviderData             `json:"providerData,omitempty"`
+	Type         *string                   `json:"type,omitempty"`
+	Variables    *map[string]VariableValue `json:"variables,omitempty"`
+}
+
+// Client manages variable groups including their provider data
+type Client interface {
+	AddVariableGroup(context.Context, AddVariableGroupArgs) (*VariableGroup, error)
+	GetVariableGroup(context.Context, GetVariableGroupArgs) (*VariableGroup, error)
+	UpdateVariableGroup(context.Context, UpdateVariableGroupArgs) (*VariableGroup, error)
+}
+
+// ClientImpl sends the requests through the client of the task agent resource area
+type ClientImpl struct {
+	Client azuredevops.Client
+}
+
+// NewClient creates a client for the organization of the connection
+func NewClient(ctx context.Context, connection *azuredevops.Connection) (Client, error) {
+	client, err := azdoclient.NewResourceAreaClient(ctx, connection, taskagent.ResourceAreaId)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientImpl{
+		Client: *client,
+	}, nil
+}
+
+// AddVariableGroupArgs are the arguments for the AddVariableGroup function
+type AddVariableGroupArgs struct {
+	// (required) Variable group to add.
+	Group *Parameters
+	// (required) Project ID or project name
+	Project *string
+}
+
+// AddVariableGroup adds a variable group
+func (client *ClientImpl) AddVariableGroup(ctx context.Context, args AddVariableGroupArgs) (*VariableGroup, error) {
+	if args.Group == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.Group"}
+	}
+	if args.Project == nil || *args.Project == "" {
+		return nil, &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.Project"}
+	}
+	routeValues := map[string]string{"project": *args.Project}
+
+	var responseValue VariableGroup
+	err := azdoclient.Send(ctx, &client.Client, azdoclient.Request{
+		Method:      http.MethodPost,
+		LocationID:  locationID,
+		APIVersion:  apiVersion,
+		RouteValues: routeValues,
+		Body:        args.Group,
+	}, &responseValue)
+	return &responseValue, err
+}
+
+// GetVariableGroupArgs are the arguments for the GetVariableGroup function
+type GetVariableGroupArgs struct {
+	// (required) Project ID or project name
+	Project *string
+	// (required) Id of the variable group.
+	GroupId *int
+}
+
+// GetVariableGroup gets a variable group. The service returns an empty variable group if it does not exist.
+func (client *ClientImpl) GetVariableGroup(ctx context.Context, args GetVariableGroupArgs) (*VariableGroup, error) {
+	if args.Project == nil || *args.Project == "" {
+		return nil, &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.Project"}
+	}
+	if args.GroupId == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.GroupId"}
+	}
+	routeValues := map[string]string{"project": *args.Project, "groupId": strconv.Itoa(*args.GroupId)}
+
+	var responseValue VariableGroup
+	err := azdoclient.Send(ctx, &client.Client, azdoclient.Request{
+		Method:      http.MethodGet,
+		LocationID:  locationID,
+		APIVersion:  apiVersion,
+		RouteValues: routeValues,
+	}, &responseValue)
+	return &responseValue, err
+}
+
+// UpdateVariableGroupArgs are the arguments for the UpdateVariableGroup function
+type UpdateVariableGroupArgs struct {
+	// (required) Variable group to update.
+	Group *Parameters
+	// (required) Project ID or project name
+	Project *string
+	// (required) Id of the variable group to update.
+	GroupId *int
+}
+
+// UpdateVariableGroup updates a variable group
+func (client *ClientImpl) UpdateVariableGroup(ctx context.Context, args UpdateVariableGroupArgs) (*VariableGroup, error) {
+	if args.Group == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.Group"}
+	}
+	if args.Project == nil || *args.Project == "" {
+		return nil, &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.Project"}
+	}
+	if args.GroupId == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.GroupId"}
+	}
+	routeValues := map[string]string{"project": *args.Project, "groupId": strconv.Itoa(*args.GroupId)}
+
+	var responseValue VariableGroup
+	err := azdoclient.Send(ctx, &client.Client, azdoclient.Request{
+		Method:      http.MethodPut,
+		LocationID:  locationID,
+		APIVersion:  apiVersion,
+		RouteValues: routeValues,
+		Body:        args.Group,
+	}, &responseValue)
+	return &responseValue, err
+}
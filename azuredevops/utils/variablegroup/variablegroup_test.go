@@ -0,0 +1,147 @@
+package variablegroup
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/microsoft/azure-devops-go-api/azuredevops"
+	"github.com/stretchr/testify/require"
+)
+
+// the resource locations the SDK looks up before sending a request to a resource
+const testResourceLocations = `{
+	"count": 1,
+	"value": [{
+		"id": "f5b09dd5-9d54-45a1-8b5a-1c8287d634cc",
+		"area": "distributedtask",
+		"resourceName": "variablegroups",
+		"routeTemplate": "{project}/_apis/{area}/{resource}/{groupId}",
+		"resourceVersion": 1,
+		"minVersion": "3.2",
+		"maxVersion": "5.1",
+		"releasedVersion": "0.0"
+	}]
+}`
+
+// records the request that was sent to the variable groups endpoint and replies with a fixed response
+type fakeService struct {
+	method   string
+	path     string
+	body     string
+	response string
+}
+
+func (f *fakeService) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodOptions {
+		w.Write([]byte(testResourceLocations))
+		return
+	}
+
+	body, _ := ioutil.ReadAll(r.Body)
+	f.method = r.Method
+	f.path = r.URL.Path
+	f.body = string(body)
+	w.Write([]byte(f.response))
+}
+
+func newTestClient(server *httptest.Server) *ClientImpl {
+	connection := azuredevops.NewPatConnection(server.URL, "pat")
+	return &ClientImpl{Client: *azuredevops.NewClient(connection, server.URL)}
+}
+
+func TestClient_AddVariableGroup_SendsProviderData(t *testing.T) {
+	service := &fakeService{response: `{"id": 7, "type": "AzureKeyVault"}`}
+	server := httptest.NewServer(service)
+	defer server.Close()
+	client := newTestClient(server)
+
+	project := "project"
+	serviceEndpointID := uuid.New()
+	vault := "vault"
+	groupType := TypeAzureKeyVault
+	group, err := client.AddVariableGroup(context.Background(), AddVariableGroupArgs{
+		Project: &project,
+		Group: &Parameters{
+			Type:         &groupType,
+			ProviderData: &ProviderData{ServiceEndpointID: &serviceEndpointID, Vault: &vault},
+		},
+	})
+
+	require.Nil(t, err)
+	require.Equal(t, 7, *group.Id)
+	require.Equal(t, http.MethodPost, service.method)
+	require.Equal(t, "/project/_apis/distributedtask/variablegroups", service.path)
+
+	var sent Parameters
+	require.Nil(t, json.Unmarshal([]byte(service.body), &sent))
+	require.Equal(t, serviceEndpointID, *sent.ProviderData.ServiceEndpointID)
+	require.Equal(t, vault, *sent.ProviderData.Vault)
+}
+
+func TestClient_GetVariableGroup_ReadsProviderData(t *testing.T) {
+	serviceEndpointID := uuid.New()
+	service := &fakeService{response: `{
+		"id": 7,
+		"type": "AzureKeyVault",
+		"providerData": {"serviceEndpointId": "` + serviceEndpointID.String() + `", "vault": "vault"},
+		"variables": {"secret": {"isSecret": true, "enabled": true}}
+	}`}
+	server := httptest.NewServer(service)
+	defer server.Close()
+	client := newTestClient(server)
+
+	project := "project"
+	groupID := 7
+	group, err := client.GetVariableGroup(context.Background(), GetVariableGroupArgs{Project: &project, GroupId: &groupID})
+
+	require.Nil(t, err)
+	require.Equal(t, http.MethodGet, service.method)
+	require.Equal(t, "/project/_apis/distributedtask/variablegroups/7", service.path)
+	require.Equal(t, TypeAzureKeyVault, *group.Type)
+	require.Equal(t, serviceEndpointID, *group.ProviderData.ServiceEndpointID)
+	require.Equal(t, "vault", *group.ProviderData.Vault)
+	require.Equal(t, true, *(*group.Variables)["secret"].IsSecret)
+}
+
+func TestClient_UpdateVariableGroup_UsesGroupID(t *testing.T) {
+	service := &fakeService{response: `{"id": 7}`}
+	server := httptest.NewServer(service)
+	defer server.Close()
+	client := newTestClient(server)
+
+	project := "project"
+	groupID := 7
+	name := "name"
+	_, err := client.UpdateVariableGroup(context.Background(), UpdateVariableGroupArgs{
+		Project: &project,
+		GroupId: &groupID,
+		Group: &Parameters{
+			Name:      &name,
+			Variables: &map[string]VariableValue{},
+		},
+	})
+
+	require.Nil(t, err)
+	require.Equal(t, http.MethodPut, service.method)
+	require.Equal(t, "/project/_apis/distributedtask/variablegroups/7", service.path)
+	require.Contains(t, service.body, `"name":"name"`)
+}
+
+func TestClient_RequiresArguments(t *testing.T) {
+	client := &ClientImpl{}
+	project := "project"
+
+	_, err := client.AddVariableGroup(context.Background(), AddVariableGroupArgs{Project: &project})
+	require.NotNil(t, err)
+
+	_, err = client.GetVariableGroup(context.Background(), GetVariableGroupArgs{Project: &project})
+	require.NotNil(t, err)
+
+	_, err = client.UpdateVariableGroup(context.Background(), UpdateVariableGroupArgs{Project: &project, Group: &Parameters{}})
+	require.NotNil(t, err)
+}
@@ -0,0 +1,146 @@
+// Package yamlpipeline is a client for the YAML pipelines of the Azure DevOps pipelines service.
+//
+// The pipelines client of the SDK creates pipelines without the location of their YAML file, as its
+// configuration model only contains the type of the configuration. This client sends the complete YAML
+// configuration, i.e. the repository and the path of the file, to the same endpoint.
+package yamlpipeline
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/microsoft/azure-devops-go-api/azuredevops"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/azdoclient"
+)
+
+var locationID, _ = uuid.Parse("28e1305e-2afe-47bf-abaf-cbb0e6a91988")
+
+const apiVersion = "5.1-preview.1"
+
+// The types of the configuration of a pipeline
+const (
+	ConfigurationTypeYaml = "yaml"
+)
+
+// The types of the repositories a YAML file can be read from
+const (
+	RepositoryTypeAzureReposGit = "azureReposGit"
+	RepositoryTypeGitHub        = "gitHub"
+)
+
+// ServiceConnection references the service endpoint used to connect to a repository outside of Azure DevOps
+type ServiceConnection struct {
+	Id *string `json:"id,omitempty"`
+}
+
+// Repository is the repository that contains the YAML file of a pipeline. Repositories in Azure DevOps are
+// identified by their ID, repositories on GitHub by their full name.
+type Repository struct {
+	Id         *string            `json:"id,omitempty"`
+	FullName   *string            `json:"fullName,omitempty"`
+	Type       *string            `json:"type,omitempty"`
+	Connection *ServiceConnection `json:"connection,omitempty"`
+}
+
+// Configuration describes where the definition of a pipeline comes from
+type Configuration struct {
+	Type       *string     `json:"type,omitempty"`
+	Path       *string     `json:"path,omitempty"`
+	Repository *Repository `json:"repository,omitempty"`
+}
+
+// Pipeline is a pipeline including its configuration
+type Pipeline struct {
+	Id            *int           `json:"id,omitempty"`
+	Name          *string        `json:"name,omitempty"`
+	Folder        *string        `json:"folder,omitempty"`
+	Revision      *int           `json:"revision,omitempty"`
+	Configuration *Configuration `json:"configuration,omitempty"`
+	Url           *string        `json:"url,omitempty"`
+}
+
+// CreatePipelineParameters are the parameters of a new pipeline
+type CreatePipelineParameters struct {
+	Name          *string        `json:"name,omitempty"`
+	Folder        *string        `json:"folder,omitempty"`
+	Configuration *Configuration `json:"configuration,omitempty"`
+}
+
+// Client manages the YAML pipelines of a project
+type Client interface {
+	CreatePipeline(context.Context, CreatePipelineArgs) (*Pipeline, error)
+	GetPipeline(context.Context, GetPipelineArgs) (*Pipeline, error)
+}
+
+// ClientImpl sends the requests through a client for the organization
+type ClientImpl struct {
+	Client azuredevops.Client
+}
+
+// NewClient creates a client for the organization of the connection. Like the pipelines client of the SDK,
+// it looks up the location of the pipelines endpoint at the organization itself.
+func NewClient(ctx context.Context, connection *azuredevops.Connection) Client {
+	client := azdoclient.NewOrganizationClient(connection)
+	return &ClientImpl{
+		Client: *client,
+	}
+}
+
+// CreatePipelineArgs are the arguments for the CreatePipeline function
+type CreatePipelineArgs struct {
+	// (required) The pipeline to create.
+	InputParameters *CreatePipelineParameters
+	// (required) Project ID or project name
+	Project *string
+}
+
+// CreatePipeline creates a pipeline
+func (client *ClientImpl) CreatePipeline(ctx context.Context, args CreatePipelineArgs) (*Pipeline, error) {
+	if args.InputParameters == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.InputParameters"}
+	}
+	if args.Project == nil || *args.Project == "" {
+		return nil, &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.Project"}
+	}
+	routeValues := map[string]string{"project": *args.Project}
+
+	var responseValue Pipeline
+	err := azdoclient.Send(ctx, &client.Client, azdoclient.Request{
+		Method:      http.MethodPost,
+		LocationID:  locationID,
+		APIVersion:  apiVersion,
+		RouteValues: routeValues,
+		Body:        args.InputParameters,
+	}, &responseValue)
+	return &responseValue, err
+}
+
+// GetPipelineArgs are the arguments for the GetPipeline function
+type GetPipelineArgs struct {
+	// (required) Project ID or project name
+	Project *string
+	// (required) The pipeline id
+	PipelineId *int
+}
+
+// GetPipeline gets the latest revision of a pipeline
+func (client *ClientImpl) GetPipeline(ctx context.Context, args GetPipelineArgs) (*Pipeline, error) {
+	if args.Project == nil || *args.Project == "" {
+		return nil, &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.Project"}
+	}
+	if args.PipelineId == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.PipelineId"}
+	}
+	routeValues := map[string]string{"project": *args.Project, "pipelineId": strconv.Itoa(*args.PipelineId)}
+
+	var responseValue Pipeline
+	err := azdoclient.Send(ctx, &client.Client, azdoclient.Request{
+		Method:      http.MethodGet,
+		LocationID:  locationID,
+		APIVersion:  apiVersion,
+		RouteValues: routeValues,
+	}, &responseValue)
+	return &responseValue, err
+}
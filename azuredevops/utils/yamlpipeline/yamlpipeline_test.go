@@ -0,0 +1,127 @@
+package yamlpipeline
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops"
+	"github.com/stretchr/testify/require"
+)
+
+// the resource locations the SDK looks up before sending a request to a resource
+const testResourceLocations = `{
+	"count": 1,
+	"value": [{
+		"id": "28e1305e-2afe-47bf-abaf-cbb0e6a91988",
+		"area": "pipelines",
+		"resourceName": "pipelines",
+		"routeTemplate": "{project}/_apis/{resource}/{pipelineId}",
+		"resourceVersion": 1,
+		"minVersion": "5.1",
+		"maxVersion": "5.1",
+		"releasedVersion": "0.0"
+	}]
+}`
+
+// records the request that was sent to the pipelines endpoint and replies with a fixed response
+type fakeService struct {
+	method   string
+	path     string
+	body     string
+	response string
+}
+
+func (f *fakeService) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodOptions {
+		w.Write([]byte(testResourceLocations))
+		return
+	}
+
+	body, _ := ioutil.ReadAll(r.Body)
+	f.method = r.Method
+	f.path = r.URL.Path
+	f.body = string(body)
+	w.Write([]byte(f.response))
+}
+
+func newTestClient(server *httptest.Server) *ClientImpl {
+	connection := azuredevops.NewPatConnection(server.URL, "pat")
+	return &ClientImpl{Client: *azuredevops.NewClient(connection, server.URL)}
+}
+
+func TestClient_CreatePipeline_SendsYamlConfiguration(t *testing.T) {
+	service := &fakeService{response: `{"id": 7, "name": "pipeline", "folder": "\\", "revision": 1}`}
+	server := httptest.NewServer(service)
+	defer server.Close()
+	client := newTestClient(server)
+
+	project := "project"
+	name := "pipeline"
+	configurationType := ConfigurationTypeYaml
+	path := "azure-pipelines.yml"
+	repositoryID := "5febef5a-833d-4e14-b9c0-14cb638f91e6"
+	repositoryType := RepositoryTypeAzureReposGit
+	pipeline, err := client.CreatePipeline(context.Background(), CreatePipelineArgs{
+		Project: &project,
+		InputParameters: &CreatePipelineParameters{
+			Name: &name,
+			Configuration: &Configuration{
+				Type:       &configurationType,
+				Path:       &path,
+				Repository: &Repository{Id: &repositoryID, Type: &repositoryType},
+			},
+		},
+	})
+
+	require.Nil(t, err)
+	require.Equal(t, 7, *pipeline.Id)
+	require.Equal(t, http.MethodPost, service.method)
+	require.Equal(t, "/project/_apis/pipelines", service.path)
+	require.JSONEq(t, `{
+		"name": "pipeline",
+		"configuration": {
+			"type": "yaml",
+			"path": "azure-pipelines.yml",
+			"repository": {"id": "5febef5a-833d-4e14-b9c0-14cb638f91e6", "type": "azureReposGit"}
+		}
+	}`, service.body)
+}
+
+func TestClient_GetPipeline_ReadsYamlConfiguration(t *testing.T) {
+	service := &fakeService{response: `{
+		"id": 7,
+		"name": "pipeline",
+		"revision": 3,
+		"configuration": {
+			"type": "yaml",
+			"path": "ci/build.yml",
+			"repository": {"fullName": "owner/repo", "type": "gitHub", "connection": {"id": "connection"}}
+		}
+	}`}
+	server := httptest.NewServer(service)
+	defer server.Close()
+	client := newTestClient(server)
+
+	project := "project"
+	pipelineID := 7
+	pipeline, err := client.GetPipeline(context.Background(), GetPipelineArgs{Project: &project, PipelineId: &pipelineID})
+
+	require.Nil(t, err)
+	require.Equal(t, http.MethodGet, service.method)
+	require.Equal(t, "/project/_apis/pipelines/7", service.path)
+	require.Equal(t, 3, *pipeline.Revision)
+	require.Equal(t, "ci/build.yml", *pipeline.Configuration.Path)
+	require.Equal(t, "owner/repo", *pipeline.Configuration.Repository.FullName)
+	require.Equal(t, "connection", *pipeline.Configuration.Repository.Connection.Id)
+}
+
+func TestClient_GetPipeline_RequiresPipelineID(t *testing.T) {
+	client := &ClientImpl{}
+	project := "project"
+
+	_, err := client.GetPipeline(context.Background(), GetPipelineArgs{Project: &project})
+	require.NotNil(t, err)
+}
@@ -20,7 +20,7 @@ function compile() {
     (
         cd "$SOURCE_DIR"
         go mod download 
-        go build -o "$BUILD_DIR/$BUILD_ARTIFACT"
+        go build -ldflags "-X github.com/microsoft/terraform-provider-azuredevops/azuredevops.providerVersion=${VERSION}" -o "$BUILD_DIR/$BUILD_ARTIFACT"
     )
 }
 
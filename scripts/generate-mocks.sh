@@ -6,6 +6,20 @@ set -euo pipefail
 
 MOCK_PKG_NAME="azdosdkmocks"
 
+# clients of APIs the Azure DevOps Go SDK does not cover, which are implemented in this repository. A package
+# can be followed by the prefix of its mock, separated by a colon, if the prefix is not the package name.
+IN_REPO_CLIENT_PACKAGES=(
+    "variablegroup"
+    "graphgroup"
+    "environment"
+    "pipelinechecks:PipelineChecks"
+    "gitrepository:GitRepository"
+    "feedrecyclebin:FeedRecycleBin"
+    "yamlpipeline:YamlPipeline"
+    "securityroles:SecurityRoles"
+    "pipelinesettings:PipelineSettings"
+)
+
 
 function install_gomock() {
     info "Installing GoMock tools"
@@ -34,7 +48,7 @@ function generate_single_mock_client() {
     OUTPUT_FILE="${PACKAGE_NAME_SIMPLE}_sdk_mock.go"
 
     # the prefix of the mock, used to give the generated mock a unique name
-    MOCK_PREFIX="$(tr '[:lower:]' '[:upper:]' <<< ${PACKAGE_NAME_SIMPLE:0:1})${PACKAGE_NAME_SIMPLE:1}"
+    MOCK_PREFIX="${3:-$(tr '[:lower:]' '[:upper:]' <<< ${PACKAGE_NAME_SIMPLE:0:1})${PACKAGE_NAME_SIMPLE:1}}"
     MOCK_NAME="Mock${MOCK_PREFIX}${2}"
 
     OUTPUT_DIR="$SOURCE_DIR/$MOCK_PKG_NAME"
@@ -73,9 +87,23 @@ function generate_mock_clients() {
     done
 }
 
+function generate_in_repo_mock_clients() {
+    info "Generating mock clients of the in-repo clients"
+
+    for ENTRY in "${IN_REPO_CLIENT_PACKAGES[@]}"; do
+        PACKAGE="${ENTRY%%:*}"
+        MOCK_PREFIX_OVERRIDE=""
+        if [[ "$ENTRY" == *:* ]]; then
+            MOCK_PREFIX_OVERRIDE="${ENTRY#*:}"
+        fi
+        generate_single_mock_client "github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/$PACKAGE" "Client" "$MOCK_PREFIX_OVERRIDE"
+    done
+}
+
 function generate_mocks() {
     check_gomock
     generate_mock_clients
+    generate_in_repo_mock_clients
     info "Mocks generated successfully"
 }
 